@@ -19,6 +19,7 @@ import (
 	"route256/libs/limiter"
 	"route256/libs/logger"
 	transactor "route256/libs/postgres_transactor"
+	"route256/libs/sweeper"
 	"route256/libs/tracing"
 	"sync"
 	"syscall"
@@ -111,10 +112,19 @@ func runGRPC(ctx context.Context) error {
 		WithCancelOnError: config.ConfigData.WorkerPool.WithCancelOnError,
 	}
 	c := cache.NewCache(5, 30*time.Second, 180*time.Second, 4)
-	businessLogic, err := domain.New(lomsClient, productsServiceClient, repo, tm, limiter, poolConfig, c)
+	holdConfig := domain.HoldConfig{
+		Enabled: config.ConfigData.Holds.Enabled,
+		TTL:     config.ConfigData.Holds.TTL,
+	}
+	guestCartConfig := domain.GuestCartConfig{
+		TTL: config.ConfigData.GuestCarts.TTL,
+	}
+	businessLogic, err := domain.New(lomsClient, productsServiceClient, repo, tm, limiter, poolConfig, c, holdConfig, guestCartConfig)
 	if err != nil {
 		logger.Fatal("init business logic", zap.Error(err))
 	}
+	guestCartSweeper := sweeper.New("delete expired guest carts", businessLogic.DeleteExpiredGuestCarts, config.ConfigData.GuestCarts.SweepInterval)
+	go guestCartSweeper.Run(ctx)
 
 	desc.RegisterCheckoutV1Server(grpcServer, checkout.New(businessLogic))
 
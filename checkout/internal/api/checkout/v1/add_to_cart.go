@@ -8,7 +8,11 @@ import (
 )
 
 func (i *Implementation) AddToCart(ctx context.Context, req *desc.AddToCartRequest) (*emptypb.Empty, error) {
-	err := i.checkoutService.AddToCart(ctx, req.GetUser(), req.GetSku(), uint16(req.GetCount()))
+	owner, err := i.cartOwner(ctx, req.GetUser(), req.GetSessionID())
+	if err != nil {
+		return nil, err
+	}
+	err = i.checkoutService.AddToCart(ctx, owner, req.GetSku(), uint16(req.GetCount()))
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,21 @@
+package checkout
+
+import (
+	"context"
+	desc "route256/checkout/pkg/checkout/v1"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (i *Implementation) ClearCart(ctx context.Context, req *desc.ClearCartRequest) (*emptypb.Empty, error) {
+	owner, err := i.cartOwner(ctx, req.GetUser(), req.GetSessionID())
+	if err != nil {
+		return nil, err
+	}
+	err = i.checkoutService.ClearCart(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,42 @@
+package checkout
+
+import (
+	"fmt"
+	"route256/checkout/internal/domain"
+
+	"github.com/pkg/errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func toStatusError(err error) error {
+	var unavailable *domain.ItemsUnavailableError
+	if errors.As(err, &unavailable) {
+		return itemsUnavailableStatus(unavailable)
+	}
+	if errors.Is(err, domain.ErrGuestCartNotFound) || errors.Is(err, domain.ErrNoSavedItem) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Is(err, domain.ErrCartItemCountOverflow) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return err
+}
+
+func itemsUnavailableStatus(unavailable *domain.ItemsUnavailableError) error {
+	st := status.New(codes.FailedPrecondition, unavailable.Error())
+	violations := make([]*errdetails.PreconditionFailure_Violation, 0, len(unavailable.Items))
+	for _, item := range unavailable.Items {
+		violations = append(violations, &errdetails.PreconditionFailure_Violation{
+			Type:        "STOCK",
+			Subject:     fmt.Sprintf("sku:%d", item.Sku),
+			Description: fmt.Sprintf("missing %d of %d", item.Missing, item.Requested),
+		})
+	}
+	detailed, err := st.WithDetails(&errdetails.PreconditionFailure{Violations: violations})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
@@ -0,0 +1,38 @@
+package checkout
+
+import (
+	"context"
+	"route256/checkout/internal/domain"
+	desc "route256/checkout/pkg/checkout/v1"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (i *Implementation) CreateGuestCart(ctx context.Context, _ *emptypb.Empty) (*desc.CreateGuestCartResponse, error) {
+	sessionID, err := i.checkoutService.CreateGuestCart(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.CreateGuestCartResponse{SessionID: sessionID}, nil
+}
+
+func (i *Implementation) ConvertGuestCart(ctx context.Context, req *desc.ConvertGuestCartRequest) (*emptypb.Empty, error) {
+	err := i.checkoutService.ConvertGuestCart(ctx, req.GetSessionID(), req.GetUser())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (i *Implementation) cartOwner(ctx context.Context, user int64, sessionID string) (domain.CartOwner, error) {
+	if sessionID == "" {
+		return domain.CartOwner{User: user}, nil
+	}
+	guest, err := i.checkoutService.GuestCart(ctx, sessionID)
+	if err != nil {
+		return domain.CartOwner{}, toStatusError(err)
+	}
+	return guest, nil
+}
@@ -6,7 +6,11 @@ import (
 )
 
 func (i *Implementation) ListCart(ctx context.Context, req *desc.ListCartRequest) (*desc.ListCartResponse, error) {
-	cartItems, err := i.checkoutService.ListCart(ctx, req.GetUser())
+	owner, err := i.cartOwner(ctx, req.GetUser(), req.GetSessionID())
+	if err != nil {
+		return nil, err
+	}
+	cartItems, err := i.checkoutService.ListCart(ctx, owner)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,17 @@
+package checkout
+
+import (
+	"context"
+	desc "route256/checkout/pkg/checkout/v1"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (i *Implementation) MergeCarts(ctx context.Context, req *desc.MergeCartsRequest) (*emptypb.Empty, error) {
+	err := i.checkoutService.MergeCarts(ctx, req.GetSessionID(), req.GetUser())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
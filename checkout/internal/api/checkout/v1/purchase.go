@@ -6,9 +6,9 @@ import (
 )
 
 func (i *Implementation) Purchase(ctx context.Context, req *desc.PurchaseRequest) (*desc.PurchaseResponse, error) {
-	orderID, err := i.checkoutService.Purchase(ctx, req.GetUser())
+	orderID, err := i.checkoutService.Purchase(ctx, req.GetUser(), req.GetIdempotencyKey())
 	if err != nil {
-		return nil, err
+		return nil, toStatusError(err)
 	}
 
 	return &desc.PurchaseResponse{OrderID: orderID}, nil
@@ -0,0 +1,47 @@
+package checkout
+
+import (
+	"context"
+	desc "route256/checkout/pkg/checkout/v1"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (i *Implementation) SaveForLater(ctx context.Context, req *desc.SaveForLaterRequest) (*emptypb.Empty, error) {
+	err := i.checkoutService.SaveForLater(ctx, req.GetUser(), req.GetSku())
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (i *Implementation) MoveToCart(ctx context.Context, req *desc.MoveToCartRequest) (*emptypb.Empty, error) {
+	err := i.checkoutService.MoveToCart(ctx, req.GetUser(), req.GetSku())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (i *Implementation) ListSaved(ctx context.Context, req *desc.ListSavedRequest) (*desc.ListSavedResponse, error) {
+	savedItems, err := i.checkoutService.ListSaved(ctx, req.GetUser())
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*desc.SavedItem, 0, len(savedItems))
+	for _, item := range savedItems {
+		items = append(items, &desc.SavedItem{
+			Sku:       item.Sku,
+			Count:     uint32(item.Count),
+			Name:      item.Name,
+			Price:     item.Price,
+			Available: item.Available,
+		})
+	}
+
+	return &desc.ListSavedResponse{
+		Items: items,
+	}, nil
+}
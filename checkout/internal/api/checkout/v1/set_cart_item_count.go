@@ -0,0 +1,21 @@
+package checkout
+
+import (
+	"context"
+	desc "route256/checkout/pkg/checkout/v1"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (i *Implementation) SetCartItemCount(ctx context.Context, req *desc.SetCartItemCountRequest) (*emptypb.Empty, error) {
+	owner, err := i.cartOwner(ctx, req.GetUser(), req.GetSessionID())
+	if err != nil {
+		return nil, err
+	}
+	err = i.checkoutService.SetCartItemCount(ctx, owner, req.GetSku(), uint16(req.GetCount()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -4,9 +4,12 @@ import (
 	"context"
 	"route256/checkout/internal/domain"
 	loms "route256/loms/pkg/loms/v1"
+	"time"
 
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type Client struct {
@@ -31,13 +34,59 @@ func (c *Client) Stocks(ctx context.Context, sku uint32) ([]domain.Stock, error)
 		stocks = append(stocks, domain.Stock{
 			WarehouseID: stock.GetWarehouseID(),
 			Count:       stock.GetCount(),
+			SellerID:    stock.GetSellerID(),
 		})
 	}
 	return stocks, nil
 }
 
-func (c *Client) CreateOrder(ctx context.Context, user int64, cartItems []domain.CartItem) (int64, error) {
-	request := &loms.CreateOrderRequest{User: user}
+func (c *Client) BatchStocks(ctx context.Context, skus []uint32) (map[uint32][]domain.Stock, error) {
+	request := &loms.BatchStocksRequest{Skus: skus}
+	response, err := c.c.BatchStocks(ctx, request)
+	if err != nil {
+		return nil, errors.Wrap(err, "client request")
+	}
+	stocks := make(map[uint32][]domain.Stock, len(response.GetItems()))
+	for _, item := range response.GetItems() {
+		for _, stock := range item.GetStocks() {
+			stocks[item.GetSku()] = append(stocks[item.GetSku()], domain.Stock{
+				WarehouseID: stock.GetWarehouseID(),
+				Count:       stock.GetCount(),
+				SellerID:    stock.GetSellerID(),
+			})
+		}
+	}
+	return stocks, nil
+}
+
+func (c *Client) HoldStock(ctx context.Context, user int64, sku uint32, count uint16, ttl time.Duration) error {
+	request := &loms.HoldStockRequest{
+		User:       user,
+		Sku:        sku,
+		Count:      uint32(count),
+		TtlSeconds: uint32(ttl / time.Second),
+	}
+	_, err := c.c.HoldStock(ctx, request)
+	if status.Code(err) == codes.FailedPrecondition {
+		return domain.ErrInsufficientStocks
+	}
+	if err != nil {
+		return errors.Wrap(err, "client request")
+	}
+	return nil
+}
+
+func (c *Client) ReleaseHold(ctx context.Context, user int64, sku uint32) error {
+	request := &loms.ReleaseHoldRequest{User: user, Sku: sku}
+	_, err := c.c.ReleaseHold(ctx, request)
+	if err != nil {
+		return errors.Wrap(err, "client request")
+	}
+	return nil
+}
+
+func (c *Client) CreateOrder(ctx context.Context, user int64, cartItems []domain.CartItem, idempotencyKey string) (int64, error) {
+	request := &loms.CreateOrderRequest{User: user, IdempotencyKey: idempotencyKey, ReserveNow: true}
 	for _, v := range cartItems {
 		request.Items = append(request.Items, &loms.Item{Sku: v.Sku, Count: uint32(v.Count)})
 	}
@@ -45,5 +94,16 @@ func (c *Client) CreateOrder(ctx context.Context, user int64, cartItems []domain
 	if err != nil {
 		return 0, errors.Wrap(err, "client request")
 	}
+	if response.GetStatus() == loms.OrderStatus_Failed {
+		unavailable := &domain.ItemsUnavailableError{OrderID: response.GetOrderID()}
+		for _, shortage := range response.GetShortages() {
+			unavailable.Items = append(unavailable.Items, domain.MissingItem{
+				Sku:       shortage.GetSku(),
+				Requested: shortage.GetRequested(),
+				Missing:   shortage.GetMissing(),
+			})
+		}
+		return 0, unavailable
+	}
 	return response.GetOrderID(), nil
 }
@@ -2,6 +2,7 @@ package config
 
 import (
 	"os"
+	"time"
 
 	"github.com/pkg/errors"
 	"gopkg.in/yaml.v3"
@@ -23,6 +24,14 @@ type ConfigStruct struct {
 		Retries           uint8  `yaml:"retries"`
 		WithCancelOnError bool   `yaml:"with_cancel_on_error"`
 	} `yaml:"worker_pool"`
+	Holds struct {
+		Enabled bool          `yaml:"enabled"`
+		TTL     time.Duration `yaml:"ttl"`
+	} `yaml:"holds"`
+	GuestCarts struct {
+		TTL           time.Duration `yaml:"ttl"`
+		SweepInterval time.Duration `yaml:"sweep_interval"`
+	} `yaml:"guest_carts"`
 }
 
 var ConfigData ConfigStruct
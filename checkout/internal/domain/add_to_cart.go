@@ -9,6 +9,7 @@ import (
 type Stock struct {
 	WarehouseID int64
 	Count       uint64
+	SellerID    int64
 }
 
 var (
@@ -17,34 +18,32 @@ var (
 	ErrInvalidSKU         = errors.New("invalid sku")
 )
 
-func (d *domain) AddToCart(ctx context.Context, user int64, sku uint32, count uint16) error {
+func (d *domain) AddToCart(ctx context.Context, owner CartOwner, sku uint32, count uint16) error {
 	_, ok := d.skus[sku]
 	if !ok {
 		return ErrInvalidSKU
 	}
 	err := d.tm.RunTransaction(ctx, isoLevelRepeatableRead, func(ctxTX context.Context) error {
-		item, err := d.repo.GetCartItem(ctxTX, user, sku)
+		item, err := d.repo.GetCartItem(ctxTX, owner, sku)
 		if err != nil && !errors.Is(err, ErrNoSameItemsInCart) {
 			return errors.Wrap(err, "get cart item")
 		}
 		if errors.Is(err, ErrNoSameItemsInCart) {
 			item = &CartItem{}
 		}
-		stocks, err := d.lOMSCaller.Stocks(ctxTX, sku)
-		if err != nil {
-			return errors.WithMessage(err, "checking stocks")
-		}
-		counter := int64(count + item.Count)
-		for _, stock := range stocks {
-			counter -= int64(stock.Count)
-			if counter <= 0 {
-				break
+		if d.holdsEnabled(owner) {
+			//Холд заменяет предыдущий, поэтому удерживаем все количество товара в корзине
+			err = d.lOMSCaller.HoldStock(ctxTX, owner.User, sku, count+item.Count, d.holdConfig.TTL)
+			if err != nil {
+				return errors.WithMessage(err, "holding stocks")
+			}
+		} else {
+			err = d.checkStocks(ctxTX, sku, count+item.Count)
+			if err != nil {
+				return err
 			}
 		}
-		if counter > 0 {
-			return ErrInsufficientStocks
-		}
-		err = d.repo.AddToCart(ctxTX, user, sku, count)
+		err = d.repo.AddToCart(ctxTX, owner, sku, count)
 		if err != nil {
 			return errors.Wrap(err, "add to cart")
 		}
@@ -52,3 +51,21 @@ func (d *domain) AddToCart(ctx context.Context, user int64, sku uint32, count ui
 	})
 	return err
 }
+
+func (d *domain) checkStocks(ctx context.Context, sku uint32, count uint16) error {
+	stocks, err := d.lOMSCaller.Stocks(ctx, sku)
+	if err != nil {
+		return errors.WithMessage(err, "checking stocks")
+	}
+	counter := int64(count)
+	for _, stock := range stocks {
+		counter -= int64(stock.Count)
+		if counter <= 0 {
+			break
+		}
+	}
+	if counter > 0 {
+		return ErrInsufficientStocks
+	}
+	return nil
+}
@@ -91,8 +91,8 @@ func TestAddToCart(t *testing.T) {
 			},
 			repositoryMock: func(mc *minimock.Controller) CartsRepository {
 				mock := NewCartsRepositoryMock(t)
-				mock.GetCartItemMock.Expect(ctxTx, user, sku).Return(cartItem, nil)
-				mock.AddToCartMock.Expect(ctxTx, user, sku, count).Return(nil)
+				mock.GetCartItemMock.Expect(ctxTx, CartOwner{User: user}, sku).Return(cartItem, nil)
+				mock.AddToCartMock.Expect(ctxTx, CartOwner{User: user}, sku, count).Return(nil)
 				return mock
 			},
 			lomsMock: func(mc *minimock.Controller) LOMSCaller {
@@ -152,7 +152,7 @@ func TestAddToCart(t *testing.T) {
 			},
 			repositoryMock: func(mc *minimock.Controller) CartsRepository {
 				mock := NewCartsRepositoryMock(t)
-				mock.GetCartItemMock.Expect(ctxTx, user, sku).Return(nil, itemErr)
+				mock.GetCartItemMock.Expect(ctxTx, CartOwner{User: user}, sku).Return(nil, itemErr)
 				return mock
 			},
 			lomsMock: func(mc *minimock.Controller) LOMSCaller {
@@ -184,7 +184,7 @@ func TestAddToCart(t *testing.T) {
 			},
 			repositoryMock: func(mc *minimock.Controller) CartsRepository {
 				mock := NewCartsRepositoryMock(t)
-				mock.GetCartItemMock.Expect(ctxTx, user, sku).Return(cartItem, nil)
+				mock.GetCartItemMock.Expect(ctxTx, CartOwner{User: user}, sku).Return(cartItem, nil)
 				return mock
 			},
 			lomsMock: func(mc *minimock.Controller) LOMSCaller {
@@ -217,7 +217,7 @@ func TestAddToCart(t *testing.T) {
 			},
 			repositoryMock: func(mc *minimock.Controller) CartsRepository {
 				mock := NewCartsRepositoryMock(t)
-				mock.GetCartItemMock.Expect(ctxTx, user, sku).Return(cartItem, nil)
+				mock.GetCartItemMock.Expect(ctxTx, CartOwner{User: user}, sku).Return(cartItem, nil)
 				return mock
 			},
 			lomsMock: func(mc *minimock.Controller) LOMSCaller {
@@ -250,8 +250,8 @@ func TestAddToCart(t *testing.T) {
 			},
 			repositoryMock: func(mc *minimock.Controller) CartsRepository {
 				mock := NewCartsRepositoryMock(t)
-				mock.GetCartItemMock.Expect(ctxTx, user, sku).Return(cartItem, nil)
-				mock.AddToCartMock.Expect(ctxTx, user, sku, count).Return(addToCartErr)
+				mock.GetCartItemMock.Expect(ctxTx, CartOwner{User: user}, sku).Return(cartItem, nil)
+				mock.AddToCartMock.Expect(ctxTx, CartOwner{User: user}, sku, count).Return(addToCartErr)
 				return mock
 			},
 			lomsMock: func(mc *minimock.Controller) LOMSCaller {
@@ -284,8 +284,8 @@ func TestAddToCart(t *testing.T) {
 			},
 			repositoryMock: func(mc *minimock.Controller) CartsRepository {
 				mock := NewCartsRepositoryMock(t)
-				mock.GetCartItemMock.Expect(ctxTx, user, sku).Return(nil, ErrNoSameItemsInCart)
-				mock.AddToCartMock.Expect(ctxTx, user, sku, count).Return(nil)
+				mock.GetCartItemMock.Expect(ctxTx, CartOwner{User: user}, sku).Return(nil, ErrNoSameItemsInCart)
+				mock.AddToCartMock.Expect(ctxTx, CartOwner{User: user}, sku, count).Return(nil)
 				return mock
 			},
 			lomsMock: func(mc *minimock.Controller) LOMSCaller {
@@ -317,7 +317,7 @@ func TestAddToCart(t *testing.T) {
 			if err != nil {
 				require.Equal(t, nil, err)
 			}
-			err = api.AddToCart(tt.args.ctx, tt.args.user, tt.args.sku, tt.args.count)
+			err = api.AddToCart(tt.args.ctx, CartOwner{User: tt.args.user}, tt.args.sku, tt.args.count)
 			if tt.err != nil {
 				require.ErrorContains(t, err, tt.err.Error())
 			} else {
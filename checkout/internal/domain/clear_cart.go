@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"context"
+	"route256/libs/logger"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+func (d *domain) ClearCart(ctx context.Context, owner CartOwner) error {
+	var items []CartItem
+	err := d.tm.RunTransaction(ctx, isoLevelRepeatableRead, func(ctxTX context.Context) error {
+		var err error
+		items, err = d.repo.GetCart(ctxTX, owner)
+		if err != nil {
+			return errors.Wrap(err, "get cart")
+		}
+		err = d.repo.DeleteCart(ctxTX, owner)
+		if err != nil {
+			return errors.Wrap(err, "delete cart")
+		}
+		return nil
+	})
+	if err != nil || !d.holdsEnabled(owner) {
+		return err
+	}
+	//Корзина уже очищена, а оставшиеся холды истекут сами
+	for _, item := range items {
+		err = d.lOMSCaller.ReleaseHold(ctx, owner.User, item.Sku)
+		if err != nil {
+			logger.Error(ctx, "release stock hold", zap.Int64("user", owner.User), zap.Uint32("sku", item.Sku), zap.Error(err))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"context"
+	transactor "route256/libs/postgres_transactor"
+	txMock "route256/libs/postgres_transactor/mocks"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClearCart(t *testing.T) {
+	type repositoryMockFunc func(mc *minimock.Controller) CartsRepository
+
+	var (
+		mc    = minimock.NewController(t)
+		tx    = txMock.NewTxMock(t)
+		ctx   = context.Background()
+		ctxTx = context.WithValue(ctx, transactor.TxKey("tx"), tx)
+
+		deleteErr = errors.New("delete error")
+
+		user int64 = 1
+	)
+	t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name           string
+		err            error
+		repositoryMock repositoryMockFunc
+	}{
+		{
+			name: "positive case",
+			err:  nil,
+			repositoryMock: func(mc *minimock.Controller) CartsRepository {
+				mock := NewCartsRepositoryMock(t)
+				mock.GetCartMock.Expect(ctxTx, CartOwner{User: user}).Return([]CartItem{{Sku: 1, Count: 2}}, nil)
+				mock.DeleteCartMock.Expect(ctxTx, CartOwner{User: user}).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "negative case - delete error",
+			err:  deleteErr,
+			repositoryMock: func(mc *minimock.Controller) CartsRepository {
+				mock := NewCartsRepositoryMock(t)
+				mock.GetCartMock.Expect(ctxTx, CartOwner{User: user}).Return(nil, nil)
+				mock.DeleteCartMock.Expect(ctxTx, CartOwner{User: user}).Return(deleteErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tm := NewTransactionManagerMock(t)
+			tm.RunTransactionMock.Set(func(ctx context.Context, isoLevel string, f func(ctxTX context.Context) error) error {
+				return f(ctxTx)
+			})
+			api, err := NewMock(tt.repositoryMock(mc), tm)
+			require.NoError(t, err)
+			err = api.ClearCart(ctx, CartOwner{User: user})
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
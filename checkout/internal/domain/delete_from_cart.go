@@ -2,8 +2,10 @@ package domain
 
 import (
 	"context"
+	"route256/libs/logger"
 
 	"github.com/pkg/errors"
+	"go.uber.org/zap"
 )
 
 var (
@@ -12,20 +14,34 @@ var (
 	ErrNoSoManyItems = errors.New("no so many items in cart")
 )
 
-func (d *domain) DeleteFromCart(ctx context.Context, user int64, sku uint32, count uint16) error {
+func (d *domain) DeleteFromCart(ctx context.Context, owner CartOwner, sku uint32, count uint16) error {
+	var left uint16
 	err := d.tm.RunTransaction(ctx, isoLevelRepeatableRead, func(ctxTX context.Context) error {
-		item, err := d.repo.GetCartItem(ctxTX, user, sku)
+		item, err := d.repo.GetCartItem(ctxTX, owner, sku)
 		if err != nil {
 			return errors.Wrap(err, "get cart item")
 		}
 		if count > item.Count {
 			return ErrNoSoManyItems
 		}
-		err = d.repo.DeleteFromCart(ctxTX, user, sku, count, count == item.Count)
+		err = d.repo.DeleteFromCart(ctxTX, owner, sku, count, count == item.Count)
 		if err != nil {
 			return errors.Wrap(err, "delete from cart")
 		}
+		left = item.Count - count
 		return nil
 	})
-	return err
+	if err != nil || !d.holdsEnabled(owner) {
+		return err
+	}
+	//Товар уже удален из корзины, а лишний холд истечет сам, поэтому ошибку только логируем
+	if left > 0 {
+		err = d.lOMSCaller.HoldStock(ctx, owner.User, sku, left, d.holdConfig.TTL)
+	} else {
+		err = d.lOMSCaller.ReleaseHold(ctx, owner.User, sku)
+	}
+	if err != nil {
+		logger.Error(ctx, "update stock hold", zap.Int64("user", owner.User), zap.Uint32("sku", sku), zap.Error(err))
+	}
+	return nil
 }
@@ -62,8 +62,8 @@ func TestDeleteFromCart(t *testing.T) {
 			err: nil,
 			repositoryMock: func(mc *minimock.Controller) CartsRepository {
 				mock := NewCartsRepositoryMock(t)
-				mock.GetCartItemMock.Expect(ctxTx, user, sku).Return(cartItem, nil)
-				mock.DeleteFromCartMock.Expect(ctxTx, user, sku, count, cartItem.Count == count).Return(nil)
+				mock.GetCartItemMock.Expect(ctxTx, CartOwner{User: user}, sku).Return(cartItem, nil)
+				mock.DeleteFromCartMock.Expect(ctxTx, CartOwner{User: user}, sku, count, cartItem.Count == count).Return(nil)
 				return mock
 			},
 			tmMock: func(mc *minimock.Controller) TransactionManager {
@@ -86,7 +86,7 @@ func TestDeleteFromCart(t *testing.T) {
 			err: itemErr,
 			repositoryMock: func(mc *minimock.Controller) CartsRepository {
 				mock := NewCartsRepositoryMock(t)
-				mock.GetCartItemMock.Expect(ctxTx, user, sku).Return(nil, itemErr)
+				mock.GetCartItemMock.Expect(ctxTx, CartOwner{User: user}, sku).Return(nil, itemErr)
 				return mock
 			},
 			tmMock: func(mc *minimock.Controller) TransactionManager {
@@ -109,8 +109,8 @@ func TestDeleteFromCart(t *testing.T) {
 			err: deleteErr,
 			repositoryMock: func(mc *minimock.Controller) CartsRepository {
 				mock := NewCartsRepositoryMock(t)
-				mock.GetCartItemMock.Expect(ctxTx, user, sku).Return(cartItem, nil)
-				mock.DeleteFromCartMock.Expect(ctxTx, user, sku, count, count == cartItem.Count).Return(deleteErr)
+				mock.GetCartItemMock.Expect(ctxTx, CartOwner{User: user}, sku).Return(cartItem, nil)
+				mock.DeleteFromCartMock.Expect(ctxTx, CartOwner{User: user}, sku, count, count == cartItem.Count).Return(deleteErr)
 				return mock
 			},
 			tmMock: func(mc *minimock.Controller) TransactionManager {
@@ -133,7 +133,7 @@ func TestDeleteFromCart(t *testing.T) {
 			err: ErrNoSoManyItems,
 			repositoryMock: func(mc *minimock.Controller) CartsRepository {
 				mock := NewCartsRepositoryMock(t)
-				mock.GetCartItemMock.Expect(ctxTx, user, sku).Return(cartItem, nil)
+				mock.GetCartItemMock.Expect(ctxTx, CartOwner{User: user}, sku).Return(cartItem, nil)
 				return mock
 			},
 			tmMock: func(mc *minimock.Controller) TransactionManager {
@@ -156,8 +156,8 @@ func TestDeleteFromCart(t *testing.T) {
 			err: nil,
 			repositoryMock: func(mc *minimock.Controller) CartsRepository {
 				mock := NewCartsRepositoryMock(t)
-				mock.GetCartItemMock.Expect(ctxTx, user, sku).Return(cartItem, nil)
-				mock.DeleteFromCartMock.Expect(ctxTx, user, sku, sameCount, sameCount == cartItem.Count).Return(nil)
+				mock.GetCartItemMock.Expect(ctxTx, CartOwner{User: user}, sku).Return(cartItem, nil)
+				mock.DeleteFromCartMock.Expect(ctxTx, CartOwner{User: user}, sku, sameCount, sameCount == cartItem.Count).Return(nil)
 				return mock
 			},
 			tmMock: func(mc *minimock.Controller) TransactionManager {
@@ -182,7 +182,7 @@ func TestDeleteFromCart(t *testing.T) {
 			if err != nil {
 				require.Equal(t, nil, err)
 			}
-			err = api.DeleteFromCart(tt.args.ctx, tt.args.user, tt.args.sku, tt.args.count)
+			err = api.DeleteFromCart(tt.args.ctx, CartOwner{User: tt.args.user}, tt.args.sku, tt.args.count)
 			if tt.err != nil {
 				require.ErrorContains(t, err, tt.err.Error())
 			} else {
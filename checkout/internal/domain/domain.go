@@ -28,23 +28,48 @@ type TransactionManager interface {
 }
 
 type CartsRepository interface {
-	GetCartItem(ctx context.Context, user int64, sku uint32) (*CartItem, error)
-	AddToCart(ctx context.Context, user int64, sku uint32, count uint16) error
-	DeleteFromCart(ctx context.Context, user int64, sku uint32, count uint16, full bool) error
-	GetCart(ctx context.Context, user int64) ([]CartItem, error)
-	DeleteCart(ctx context.Context, user int64) error
+	GetCartItem(ctx context.Context, owner CartOwner, sku uint32) (*CartItem, error)
+	AddToCart(ctx context.Context, owner CartOwner, sku uint32, count uint16) error
+	DeleteFromCart(ctx context.Context, owner CartOwner, sku uint32, count uint16, full bool) error
+	GetCart(ctx context.Context, owner CartOwner) ([]CartItem, error)
+	SetCartItemCount(ctx context.Context, owner CartOwner, sku uint32, count uint16) error
+	MergeCarts(ctx context.Context, guestCartID int64, user int64) error
+	DeleteCart(ctx context.Context, owner CartOwner) error
+	GetPurchase(ctx context.Context, user int64, idempotencyKey string) (int64, error)
+	SavePurchase(ctx context.Context, user int64, idempotencyKey string, orderID int64) error
+	CreateGuestCart(ctx context.Context, sessionID string, expiresAt time.Time) (int64, error)
+	ProlongGuestCart(ctx context.Context, sessionID string, now time.Time, expiresAt time.Time) (int64, error)
+	DeleteGuestCart(ctx context.Context, sessionID string) error
+	DeleteExpiredGuestCarts(ctx context.Context, now time.Time) error
+	GetSavedItem(ctx context.Context, user int64, sku uint32) (*CartItem, error)
+	GetSavedItems(ctx context.Context, user int64) ([]CartItem, error)
+	SaveItem(ctx context.Context, user int64, sku uint32, count uint16) error
+	DeleteSavedItem(ctx context.Context, user int64, sku uint32) error
 }
 
 type Domain interface {
-	AddToCart(ctx context.Context, user int64, sku uint32, count uint16) error
-	DeleteFromCart(ctx context.Context, user int64, sku uint32, count uint16) error
-	ListCart(ctx context.Context, user int64) ([]CartItem, error)
-	Purchase(ctx context.Context, user int64) (int64, error)
+	AddToCart(ctx context.Context, owner CartOwner, sku uint32, count uint16) error
+	DeleteFromCart(ctx context.Context, owner CartOwner, sku uint32, count uint16) error
+	SetCartItemCount(ctx context.Context, owner CartOwner, sku uint32, count uint16) error
+	ClearCart(ctx context.Context, owner CartOwner) error
+	MergeCarts(ctx context.Context, sessionID string, user int64) error
+	ListCart(ctx context.Context, owner CartOwner) ([]CartItem, error)
+	Purchase(ctx context.Context, user int64, idempotencyKey string) (int64, error)
+	CreateGuestCart(ctx context.Context) (string, error)
+	GuestCart(ctx context.Context, sessionID string) (CartOwner, error)
+	ConvertGuestCart(ctx context.Context, sessionID string, user int64) error
+	DeleteExpiredGuestCarts(ctx context.Context) error
+	SaveForLater(ctx context.Context, user int64, sku uint32) error
+	MoveToCart(ctx context.Context, user int64, sku uint32) error
+	ListSaved(ctx context.Context, user int64) ([]SavedItem, error)
 }
 
 type LOMSCaller interface {
 	Stocks(ctx context.Context, sku uint32) ([]Stock, error)
-	CreateOrder(ctx context.Context, user int64, cartItems []CartItem) (int64, error)
+	BatchStocks(ctx context.Context, skus []uint32) (map[uint32][]Stock, error)
+	HoldStock(ctx context.Context, user int64, sku uint32, count uint16, ttl time.Duration) error
+	ReleaseHold(ctx context.Context, user int64, sku uint32) error
+	CreateOrder(ctx context.Context, user int64, cartItems []CartItem, idempotencyKey string) (int64, error)
 }
 
 type ProductServiceCaller interface {
@@ -69,6 +94,8 @@ type domain struct {
 	tm                   TransactionManager
 	cache                Cache
 	poolConfig           PoolConfig
+	holdConfig           HoldConfig
+	guestCartConfig      GuestCartConfig
 	skus                 SKUs
 }
 
@@ -78,9 +105,22 @@ type PoolConfig struct {
 	WithCancelOnError bool
 }
 
+type HoldConfig struct {
+	Enabled bool
+	TTL     time.Duration
+}
+
+const defaultHoldTTL = 15 * time.Minute
+
 type SKUs map[uint32]struct{}
 
-func New(lOMSCaller LOMSCaller, productServiceCaller ProductServiceCaller, repo CartsRepository, tm TransactionManager, limiter Limiter, poolConfig PoolConfig, cache Cache) (*domain, error) {
+func New(lOMSCaller LOMSCaller, productServiceCaller ProductServiceCaller, repo CartsRepository, tm TransactionManager, limiter Limiter, poolConfig PoolConfig, cache Cache, holdConfig HoldConfig, guestCartConfig GuestCartConfig) (*domain, error) {
+	if holdConfig.TTL == 0 {
+		holdConfig.TTL = defaultHoldTTL
+	}
+	if guestCartConfig.TTL == 0 {
+		guestCartConfig.TTL = defaultGuestCartTTL
+	}
 	d := &domain{
 		lOMSCaller:           lOMSCaller,
 		productServiceCaller: productServiceCaller,
@@ -89,6 +129,8 @@ func New(lOMSCaller LOMSCaller, productServiceCaller ProductServiceCaller, repo
 		cache:                cache,
 		tm:                   tm,
 		poolConfig:           poolConfig,
+		holdConfig:           holdConfig,
+		guestCartConfig:      guestCartConfig,
 	}
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
 	defer cancel()
@@ -127,6 +169,12 @@ func NewMock(deps ...interface{}) (*domain, error) {
 			d.tm = s
 		case PoolConfig:
 			d.poolConfig = s
+		case HoldConfig:
+			d.holdConfig = s
+		case GuestCartConfig:
+			d.guestCartConfig = s
+		case Cache:
+			d.cache = s
 		}
 	}
 	return d, nil
@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultGuestCartTTL = 72 * time.Hour
+	guestSessionIDBytes = 16
+)
+
+var ErrGuestCartNotFound = errors.New("guest cart not found")
+
+type GuestCartConfig struct {
+	TTL time.Duration
+}
+
+type CartOwner struct {
+	User        int64
+	GuestCartID int64
+}
+
+func (o CartOwner) isGuest() bool {
+	return o.GuestCartID != 0
+}
+
+func (d *domain) holdsEnabled(owner CartOwner) bool {
+	return d.holdConfig.Enabled && !owner.isGuest()
+}
+
+func (d *domain) CreateGuestCart(ctx context.Context) (string, error) {
+	buf := make([]byte, guestSessionIDBytes)
+	_, err := rand.Read(buf)
+	if err != nil {
+		return "", errors.Wrap(err, "generate session id")
+	}
+	sessionID := hex.EncodeToString(buf)
+	_, err = d.repo.CreateGuestCart(ctx, sessionID, time.Now().Add(d.guestCartConfig.TTL))
+	if err != nil {
+		return "", errors.Wrap(err, "create guest cart")
+	}
+	return sessionID, nil
+}
+
+func (d *domain) GuestCart(ctx context.Context, sessionID string) (CartOwner, error) {
+	now := time.Now()
+	id, err := d.repo.ProlongGuestCart(ctx, sessionID, now, now.Add(d.guestCartConfig.TTL))
+	if err != nil {
+		return CartOwner{}, errors.WithMessage(err, "prolong guest cart")
+	}
+	return CartOwner{GuestCartID: id}, nil
+}
+
+func (d *domain) ConvertGuestCart(ctx context.Context, sessionID string, user int64) error {
+	return d.mergeGuestCart(ctx, sessionID, user, true)
+}
+
+func (d *domain) MergeCarts(ctx context.Context, sessionID string, user int64) error {
+	return d.mergeGuestCart(ctx, sessionID, user, false)
+}
+
+func (d *domain) mergeGuestCart(ctx context.Context, sessionID string, user int64, closeSession bool) error {
+	var moved, merged []CartItem
+	err := d.tm.RunTransaction(ctx, isoLevelRepeatableRead, func(ctxTX context.Context) error {
+		guest, err := d.GuestCart(ctxTX, sessionID)
+		if err != nil {
+			return err
+		}
+		moved, merged, err = d.mergeCarts(ctxTX, guest, user)
+		if err != nil {
+			return err
+		}
+		if !closeSession {
+			return nil
+		}
+		err = d.repo.DeleteGuestCart(ctxTX, sessionID)
+		if err != nil {
+			return errors.Wrap(err, "delete guest cart")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	d.holdMergedItems(ctx, user, moved, merged)
+	return nil
+}
+
+func (d *domain) DeleteExpiredGuestCarts(ctx context.Context) error {
+	err := d.repo.DeleteExpiredGuestCarts(ctx, time.Now())
+	if err != nil {
+		return errors.Wrap(err, "delete expired guest carts")
+	}
+	return nil
+}
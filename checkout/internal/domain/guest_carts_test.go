@@ -0,0 +1,247 @@
+package domain
+
+import (
+	"context"
+	"route256/libs/logger"
+	transactor "route256/libs/postgres_transactor"
+	txMock "route256/libs/postgres_transactor/mocks"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGuestCarts(t *testing.T) {
+	logger.Init(true)
+
+	type repositoryMockFunc func(mc *minimock.Controller) CartsRepository
+	type lomsMockFunc func(mc *minimock.Controller) LOMSCaller
+
+	var (
+		mc    = minimock.NewController(t)
+		tx    = txMock.NewTxMock(t)
+		ctx   = context.Background()
+		ctxTx = context.WithValue(ctx, transactor.TxKey("tx"), tx)
+
+		createErr = errors.New("create error")
+
+		user      int64  = 1
+		guestID   int64  = 7
+		guest            = CartOwner{GuestCartID: guestID}
+		sessionID        = "5f1d7a8a0c3e4b2f9a6d1e0b7c4a2f31"
+		sku       uint32 = 4678816
+		ttl              = time.Hour
+	)
+	t.Cleanup(mc.Finish)
+
+	productsMock := func(mc *minimock.Controller) ProductServiceCaller {
+		mock := NewProductServiceCallerMock(t)
+		mock.GetSKUsMock.Expect(ctx).Return(map[uint32]struct{}{sku: {}}, nil)
+		return mock
+	}
+	tmMock := func(mc *minimock.Controller) TransactionManager {
+		mock := NewTransactionManagerMock(t)
+		mock.RunTransactionMock.Set(func(ctx context.Context, isoLevel string, f func(ctxTX context.Context) error) error {
+			return f(ctxTx)
+		})
+		return mock
+	}
+	prolong := func(id int64, err error) func(ctx context.Context, s string, now time.Time, expiresAt time.Time) (int64, error) {
+		return func(ctx context.Context, s string, now time.Time, expiresAt time.Time) (int64, error) {
+			require.Equal(t, sessionID, s)
+			require.Equal(t, ttl, expiresAt.Sub(now))
+			return id, err
+		}
+	}
+
+	tests := []struct {
+		name           string
+		call           func(d *domain) error
+		err            error
+		repositoryMock repositoryMockFunc
+		lomsMock       lomsMockFunc
+	}{
+		{
+			name: "create guest cart",
+			call: func(d *domain) error {
+				id, err := d.CreateGuestCart(ctx)
+				require.Len(t, id, 2*guestSessionIDBytes)
+				return err
+			},
+			err: nil,
+			repositoryMock: func(mc *minimock.Controller) CartsRepository {
+				mock := NewCartsRepositoryMock(t)
+				mock.CreateGuestCartMock.Set(func(ctx context.Context, s string, expiresAt time.Time) (int64, error) {
+					require.Len(t, s, 2*guestSessionIDBytes)
+					require.WithinDuration(t, time.Now().Add(ttl), expiresAt, time.Minute)
+					return guestID, nil
+				})
+				return mock
+			},
+			lomsMock: func(mc *minimock.Controller) LOMSCaller {
+				return NewLOMSCallerMock(t)
+			},
+		},
+		{
+			name: "create guest cart - repository error",
+			call: func(d *domain) error {
+				_, err := d.CreateGuestCart(ctx)
+				return err
+			},
+			err: createErr,
+			repositoryMock: func(mc *minimock.Controller) CartsRepository {
+				mock := NewCartsRepositoryMock(t)
+				mock.CreateGuestCartMock.Return(0, createErr)
+				return mock
+			},
+			lomsMock: func(mc *minimock.Controller) LOMSCaller {
+				return NewLOMSCallerMock(t)
+			},
+		},
+		{
+			name: "guest cart - owned by guest cart id",
+			call: func(d *domain) error {
+				owner, err := d.GuestCart(ctx, sessionID)
+				require.Equal(t, guest, owner)
+				return err
+			},
+			err: nil,
+			repositoryMock: func(mc *minimock.Controller) CartsRepository {
+				mock := NewCartsRepositoryMock(t)
+				mock.ProlongGuestCartMock.Set(prolong(guestID, nil))
+				return mock
+			},
+			lomsMock: func(mc *minimock.Controller) LOMSCaller {
+				return NewLOMSCallerMock(t)
+			},
+		},
+		{
+			name: "guest cart - expired",
+			call: func(d *domain) error {
+				_, err := d.GuestCart(ctx, sessionID)
+				return err
+			},
+			err: ErrGuestCartNotFound,
+			repositoryMock: func(mc *minimock.Controller) CartsRepository {
+				mock := NewCartsRepositoryMock(t)
+				mock.ProlongGuestCartMock.Set(prolong(0, ErrGuestCartNotFound))
+				return mock
+			},
+			lomsMock: func(mc *minimock.Controller) LOMSCaller {
+				return NewLOMSCallerMock(t)
+			},
+		},
+		{
+			name: "add to guest cart - stocks checked instead of hold",
+			call: func(d *domain) error {
+				return d.AddToCart(ctx, guest, sku, 2)
+			},
+			err: nil,
+			repositoryMock: func(mc *minimock.Controller) CartsRepository {
+				mock := NewCartsRepositoryMock(t)
+				mock.GetCartItemMock.Expect(ctxTx, guest, sku).Return(nil, ErrNoSameItemsInCart)
+				mock.AddToCartMock.Expect(ctxTx, guest, sku, 2).Return(nil)
+				return mock
+			},
+			lomsMock: func(mc *minimock.Controller) LOMSCaller {
+				mock := NewLOMSCallerMock(t)
+				mock.StocksMock.Expect(ctxTx, sku).Return([]Stock{{WarehouseID: 1, Count: 2}}, nil)
+				return mock
+			},
+		},
+		{
+			name: "convert guest cart - holds only for user",
+			call: func(d *domain) error {
+				return d.ConvertGuestCart(ctx, sessionID, user)
+			},
+			err: nil,
+			repositoryMock: func(mc *minimock.Controller) CartsRepository {
+				mock := NewCartsRepositoryMock(t)
+				mock.ProlongGuestCartMock.Set(prolong(guestID, nil))
+				mock.GetCartMock.When(ctxTx, guest).Then([]CartItem{{Sku: sku, Count: 2}}, nil)
+				mock.GetCartMock.When(ctxTx, CartOwner{User: user}).Then([]CartItem{{Sku: sku, Count: 1}}, nil)
+				mock.MergeCartsMock.Expect(ctxTx, guestID, user).Return(nil)
+				mock.DeleteGuestCartMock.Expect(ctxTx, sessionID).Return(nil)
+				return mock
+			},
+			lomsMock: func(mc *minimock.Controller) LOMSCaller {
+				mock := NewLOMSCallerMock(t)
+				mock.HoldStockMock.Expect(ctx, user, sku, 3, ttl).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "merge carts - session stays open",
+			call: func(d *domain) error {
+				return d.MergeCarts(ctx, sessionID, user)
+			},
+			err: nil,
+			repositoryMock: func(mc *minimock.Controller) CartsRepository {
+				mock := NewCartsRepositoryMock(t)
+				mock.ProlongGuestCartMock.Set(prolong(guestID, nil))
+				mock.GetCartMock.When(ctxTx, guest).Then([]CartItem{{Sku: sku, Count: 2}}, nil)
+				mock.GetCartMock.When(ctxTx, CartOwner{User: user}).Then([]CartItem{{Sku: sku, Count: 1}}, nil)
+				mock.MergeCartsMock.Expect(ctxTx, guestID, user).Return(nil)
+				return mock
+			},
+			lomsMock: func(mc *minimock.Controller) LOMSCaller {
+				mock := NewLOMSCallerMock(t)
+				mock.HoldStockMock.Expect(ctx, user, sku, 3, ttl).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "convert guest cart - expired",
+			call: func(d *domain) error {
+				return d.ConvertGuestCart(ctx, sessionID, user)
+			},
+			err: ErrGuestCartNotFound,
+			repositoryMock: func(mc *minimock.Controller) CartsRepository {
+				mock := NewCartsRepositoryMock(t)
+				mock.ProlongGuestCartMock.Set(prolong(0, ErrGuestCartNotFound))
+				return mock
+			},
+			lomsMock: func(mc *minimock.Controller) LOMSCaller {
+				return NewLOMSCallerMock(t)
+			},
+		},
+		{
+			name: "delete expired guest carts",
+			call: func(d *domain) error {
+				return d.DeleteExpiredGuestCarts(ctx)
+			},
+			err: nil,
+			repositoryMock: func(mc *minimock.Controller) CartsRepository {
+				mock := NewCartsRepositoryMock(t)
+				mock.DeleteExpiredGuestCartsMock.Set(func(ctx context.Context, now time.Time) error {
+					require.WithinDuration(t, time.Now(), now, time.Minute)
+					return nil
+				})
+				return mock
+			},
+			lomsMock: func(mc *minimock.Controller) LOMSCaller {
+				return NewLOMSCallerMock(t)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			api, err := NewMock(
+				productsMock(mc),
+				tt.repositoryMock(mc),
+				tmMock(mc),
+				tt.lomsMock(mc),
+				HoldConfig{Enabled: true, TTL: ttl},
+				GuestCartConfig{TTL: ttl},
+			)
+			require.NoError(t, err)
+			err = tt.call(api)
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
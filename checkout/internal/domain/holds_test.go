@@ -0,0 +1,199 @@
+package domain
+
+import (
+	"context"
+	"route256/libs/logger"
+	transactor "route256/libs/postgres_transactor"
+	txMock "route256/libs/postgres_transactor/mocks"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCartHolds(t *testing.T) {
+	logger.Init(true)
+
+	type repositoryMockFunc func(mc *minimock.Controller) CartsRepository
+	type lomsMockFunc func(mc *minimock.Controller) LOMSCaller
+
+	var (
+		mc    = minimock.NewController(t)
+		tx    = txMock.NewTxMock(t)
+		ctx   = context.Background()
+		ctxTx = context.WithValue(ctx, transactor.TxKey("tx"), tx)
+
+		releaseErr = errors.New("release error")
+
+		user     int64  = 1
+		sku      uint32 = 4678816
+		ttl             = time.Minute
+		cartItem        = &CartItem{Sku: sku, Count: 5}
+
+		holdConfig = HoldConfig{Enabled: true, TTL: ttl}
+	)
+	t.Cleanup(mc.Finish)
+
+	productsMock := func(mc *minimock.Controller) ProductServiceCaller {
+		mock := NewProductServiceCallerMock(t)
+		mock.GetSKUsMock.Expect(ctx).Return(map[uint32]struct{}{sku: {}}, nil)
+		return mock
+	}
+	tmMock := func(mc *minimock.Controller) TransactionManager {
+		mock := NewTransactionManagerMock(t)
+		mock.RunTransactionMock.Set(func(ctx context.Context, isoLevel string, f func(ctxTX context.Context) error) error {
+			return f(ctxTx)
+		})
+		return mock
+	}
+
+	tests := []struct {
+		name           string
+		call           func(d *domain) error
+		err            error
+		repositoryMock repositoryMockFunc
+		lomsMock       lomsMockFunc
+	}{
+		{
+			name: "add to cart - hold whole cart quantity",
+			call: func(d *domain) error {
+				return d.AddToCart(ctx, CartOwner{User: user}, sku, 3)
+			},
+			err: nil,
+			repositoryMock: func(mc *minimock.Controller) CartsRepository {
+				mock := NewCartsRepositoryMock(t)
+				mock.GetCartItemMock.Expect(ctxTx, CartOwner{User: user}, sku).Return(cartItem, nil)
+				mock.AddToCartMock.Expect(ctxTx, CartOwner{User: user}, sku, 3).Return(nil)
+				return mock
+			},
+			lomsMock: func(mc *minimock.Controller) LOMSCaller {
+				mock := NewLOMSCallerMock(t)
+				mock.HoldStockMock.Expect(ctxTx, user, sku, 8, ttl).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "add to cart - insufficient stocks",
+			call: func(d *domain) error {
+				return d.AddToCart(ctx, CartOwner{User: user}, sku, 3)
+			},
+			err: ErrInsufficientStocks,
+			repositoryMock: func(mc *minimock.Controller) CartsRepository {
+				mock := NewCartsRepositoryMock(t)
+				mock.GetCartItemMock.Expect(ctxTx, CartOwner{User: user}, sku).Return(nil, ErrNoSameItemsInCart)
+				return mock
+			},
+			lomsMock: func(mc *minimock.Controller) LOMSCaller {
+				mock := NewLOMSCallerMock(t)
+				mock.HoldStockMock.Expect(ctxTx, user, sku, 3, ttl).Return(ErrInsufficientStocks)
+				return mock
+			},
+		},
+		{
+			name: "delete from cart - hold shrinks",
+			call: func(d *domain) error {
+				return d.DeleteFromCart(ctx, CartOwner{User: user}, sku, 2)
+			},
+			err: nil,
+			repositoryMock: func(mc *minimock.Controller) CartsRepository {
+				mock := NewCartsRepositoryMock(t)
+				mock.GetCartItemMock.Expect(ctxTx, CartOwner{User: user}, sku).Return(cartItem, nil)
+				mock.DeleteFromCartMock.Expect(ctxTx, CartOwner{User: user}, sku, 2, false).Return(nil)
+				return mock
+			},
+			lomsMock: func(mc *minimock.Controller) LOMSCaller {
+				mock := NewLOMSCallerMock(t)
+				mock.HoldStockMock.Expect(ctx, user, sku, 3, ttl).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "delete from cart - release error is not returned",
+			call: func(d *domain) error {
+				return d.DeleteFromCart(ctx, CartOwner{User: user}, sku, 5)
+			},
+			err: nil,
+			repositoryMock: func(mc *minimock.Controller) CartsRepository {
+				mock := NewCartsRepositoryMock(t)
+				mock.GetCartItemMock.Expect(ctxTx, CartOwner{User: user}, sku).Return(cartItem, nil)
+				mock.DeleteFromCartMock.Expect(ctxTx, CartOwner{User: user}, sku, 5, true).Return(nil)
+				return mock
+			},
+			lomsMock: func(mc *minimock.Controller) LOMSCaller {
+				mock := NewLOMSCallerMock(t)
+				mock.ReleaseHoldMock.Expect(ctx, user, sku).Return(releaseErr)
+				return mock
+			},
+		},
+		{
+			name: "set cart item count - hold exact quantity",
+			call: func(d *domain) error {
+				return d.SetCartItemCount(ctx, CartOwner{User: user}, sku, 2)
+			},
+			err: nil,
+			repositoryMock: func(mc *minimock.Controller) CartsRepository {
+				mock := NewCartsRepositoryMock(t)
+				mock.SetCartItemCountMock.Expect(ctxTx, CartOwner{User: user}, sku, 2).Return(nil)
+				return mock
+			},
+			lomsMock: func(mc *minimock.Controller) LOMSCaller {
+				mock := NewLOMSCallerMock(t)
+				mock.HoldStockMock.Expect(ctxTx, user, sku, 2, ttl).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "clear cart - holds released",
+			call: func(d *domain) error {
+				return d.ClearCart(ctx, CartOwner{User: user})
+			},
+			err: nil,
+			repositoryMock: func(mc *minimock.Controller) CartsRepository {
+				mock := NewCartsRepositoryMock(t)
+				mock.GetCartMock.Expect(ctxTx, CartOwner{User: user}).Return([]CartItem{*cartItem}, nil)
+				mock.DeleteCartMock.Expect(ctxTx, CartOwner{User: user}).Return(nil)
+				return mock
+			},
+			lomsMock: func(mc *minimock.Controller) LOMSCaller {
+				mock := NewLOMSCallerMock(t)
+				mock.ReleaseHoldMock.Expect(ctx, user, sku).Return(releaseErr)
+				return mock
+			},
+		},
+		{
+			name: "merged items - whole count held for user",
+			call: func(d *domain) error {
+				d.holdMergedItems(ctx, user, []CartItem{{Sku: sku, Count: 2}}, []CartItem{{Sku: sku, Count: 7}})
+				return nil
+			},
+			err: nil,
+			repositoryMock: func(mc *minimock.Controller) CartsRepository {
+				return NewCartsRepositoryMock(t)
+			},
+			lomsMock: func(mc *minimock.Controller) LOMSCaller {
+				mock := NewLOMSCallerMock(t)
+				mock.HoldStockMock.Expect(ctx, user, sku, 7, ttl).Return(nil)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			api, err := NewMock(
+				productsMock(mc),
+				tt.repositoryMock(mc),
+				tmMock(mc),
+				tt.lomsMock(mc),
+				holdConfig,
+			)
+			require.NoError(t, err)
+			err = tt.call(api)
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
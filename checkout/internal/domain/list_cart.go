@@ -20,12 +20,20 @@ type CartItem struct {
 	ProductInfo
 }
 
-func (d *domain) ListCart(ctx context.Context, user int64) ([]CartItem, error) {
+func (d *domain) ListCart(ctx context.Context, owner CartOwner) ([]CartItem, error) {
 
-	items, err := d.repo.GetCart(ctx, user)
+	items, err := d.repo.GetCart(ctx, owner)
 	if err != nil {
 		return nil, errors.Wrap(err, "get cart")
 	}
+	err = d.enrichItems(ctx, items)
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func (d *domain) enrichItems(ctx context.Context, items []CartItem) error {
 	wp, errorsChan := pool.NewPool(ctx, d.poolConfig.AmountWorkers, d.poolConfig.MaxRetries, d.poolConfig.WithCancelOnError)
 	for i, item := range items {
 		if pi, ok := d.cache.Get(fmt.Sprintf("%d", item.Sku)); ok {
@@ -53,7 +61,7 @@ func (d *domain) ListCart(ctx context.Context, user int64) ([]CartItem, error) {
 	//Сделал так, чтобы при получении ошибки сразу выходить.
 	//Выйдем из цикла, когда отработает закрытие пула после выполнения всех задач или по cancel
 	for err := range errorsChan {
-		return nil, errors.Wrap(err, "getting product info")
+		return errors.Wrap(err, "getting product info")
 	}
-	return items, nil
+	return nil
 }
@@ -2,7 +2,9 @@ package domain
 
 import (
 	"context"
+	"route256/libs/cache"
 	"testing"
+	"time"
 
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/gojuno/minimock/v3"
@@ -86,7 +88,7 @@ func TestListCart(t *testing.T) {
 			},
 			repositoryMock: func(mc *minimock.Controller) CartsRepository {
 				mock := NewCartsRepositoryMock(t)
-				mock.GetCartMock.Expect(ctx, user).Return(cartItemsWithoutInfo, nil)
+				mock.GetCartMock.Expect(ctx, CartOwner{User: user}).Return(cartItemsWithoutInfo, nil)
 				return mock
 			},
 			limiterMock: func(mc *minimock.Controller) Limiter {
@@ -113,7 +115,7 @@ func TestListCart(t *testing.T) {
 			},
 			repositoryMock: func(mc *minimock.Controller) CartsRepository {
 				mock := NewCartsRepositoryMock(t)
-				mock.GetCartMock.Expect(ctx, user).Return(cartItemsWithoutInfo, nil)
+				mock.GetCartMock.Expect(ctx, CartOwner{User: user}).Return(cartItemsWithoutInfo, nil)
 				return mock
 			},
 			limiterMock: func(mc *minimock.Controller) Limiter {
@@ -137,7 +139,7 @@ func TestListCart(t *testing.T) {
 			},
 			repositoryMock: func(mc *minimock.Controller) CartsRepository {
 				mock := NewCartsRepositoryMock(t)
-				mock.GetCartMock.Expect(ctx, user).Return(nil, repoErr)
+				mock.GetCartMock.Expect(ctx, CartOwner{User: user}).Return(nil, repoErr)
 				return mock
 			},
 			limiterMock: func(mc *minimock.Controller) Limiter {
@@ -161,12 +163,13 @@ func TestListCart(t *testing.T) {
 				tt.repositoryMock(mc),
 				tt.limiterMock(mc),
 				poolConfig,
+				cache.NewCache(1, time.Minute, time.Minute, 10),
 			)
 			//tt.poolMock(mc)
 			if err != nil {
 				require.Equal(t, nil, err)
 			}
-			res, err := api.ListCart(tt.args.ctx, tt.args.user)
+			res, err := api.ListCart(tt.args.ctx, CartOwner{User: tt.args.user})
 			require.Equal(t, tt.want, res)
 			if tt.err != nil {
 				require.ErrorContains(t, err, tt.err.Error())
@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"context"
+	"math"
+	"route256/libs/logger"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+var ErrCartItemCountOverflow = errors.New("total count of the sku exceeds 65535")
+
+func (d *domain) mergeCarts(ctxTX context.Context, guest CartOwner, user int64) ([]CartItem, []CartItem, error) {
+	moved, err := d.repo.GetCart(ctxTX, guest)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "get cart")
+	}
+	if len(moved) == 0 {
+		return nil, nil, nil
+	}
+	merged, err := d.repo.GetCart(ctxTX, CartOwner{User: user})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "get user cart")
+	}
+	merged, err = sumCartItems(merged, moved)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = d.repo.MergeCarts(ctxTX, guest.GuestCartID, user)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "merge carts")
+	}
+	return moved, merged, nil
+}
+
+func sumCartItems(items []CartItem, added []CartItem) ([]CartItem, error) {
+	res := make([]CartItem, len(items), len(items)+len(added))
+	copy(res, items)
+	index := make(map[uint32]int, len(res))
+	for i, item := range res {
+		index[item.Sku] = i
+	}
+	for _, item := range added {
+		i, ok := index[item.Sku]
+		if !ok {
+			index[item.Sku] = len(res)
+			res = append(res, item)
+			continue
+		}
+		//Количество в корзине хранится в uint16
+		if uint32(res[i].Count)+uint32(item.Count) > math.MaxUint16 {
+			return nil, ErrCartItemCountOverflow
+		}
+		res[i].Count += item.Count
+	}
+	return res, nil
+}
+
+func (d *domain) holdMergedItems(ctx context.Context, user int64, moved []CartItem, merged []CartItem) {
+	if !d.holdsEnabled(CartOwner{User: user}) {
+		return
+	}
+	counts := make(map[uint32]uint16, len(merged))
+	for _, item := range merged {
+		counts[item.Sku] = item.Count
+	}
+	//При ошибке товар проверится при покупке
+	for _, item := range moved {
+		err := d.lOMSCaller.HoldStock(ctx, user, item.Sku, counts[item.Sku], d.holdConfig.TTL)
+		if err != nil {
+			logger.Error(ctx, "hold merged stock", zap.Int64("user", user), zap.Uint32("sku", item.Sku), zap.Error(err))
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package domain
+
+import (
+	"context"
+	transactor "route256/libs/postgres_transactor"
+	txMock "route256/libs/postgres_transactor/mocks"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeCarts(t *testing.T) {
+	type repositoryMockFunc func(mc *minimock.Controller) CartsRepository
+
+	type args struct {
+		guest CartOwner
+		user  int64
+	}
+
+	var (
+		mc    = minimock.NewController(t)
+		tx    = txMock.NewTxMock(t)
+		ctx   = context.Background()
+		ctxTx = context.WithValue(ctx, transactor.TxKey("tx"), tx)
+
+		mergeErr = errors.New("merge error")
+
+		guestID int64 = 7
+		user    int64 = 2
+		guest         = CartOwner{GuestCartID: guestID}
+
+		guestItems  = []CartItem{{Sku: 1, Count: 2}, {Sku: 3, Count: 1}}
+		userItems   = []CartItem{{Sku: 1, Count: 5}}
+		mergedItems = []CartItem{{Sku: 1, Count: 7}, {Sku: 3, Count: 1}}
+	)
+	t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name           string
+		args           args
+		moved          []CartItem
+		merged         []CartItem
+		err            error
+		repositoryMock repositoryMockFunc
+	}{
+		{
+			name:   "positive case",
+			args:   args{guest: guest, user: user},
+			moved:  guestItems,
+			merged: mergedItems,
+			err:    nil,
+			repositoryMock: func(mc *minimock.Controller) CartsRepository {
+				mock := NewCartsRepositoryMock(t)
+				mock.GetCartMock.When(ctxTx, guest).Then(guestItems, nil)
+				mock.GetCartMock.When(ctxTx, CartOwner{User: user}).Then(userItems, nil)
+				mock.MergeCartsMock.Expect(ctxTx, guestID, user).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "positive case - empty guest cart",
+			args: args{guest: guest, user: user},
+			err:  nil,
+			repositoryMock: func(mc *minimock.Controller) CartsRepository {
+				mock := NewCartsRepositoryMock(t)
+				mock.GetCartMock.Expect(ctxTx, guest).Return(nil, nil)
+				return mock
+			},
+		},
+		{
+			name: "negative case - merge error",
+			args: args{guest: guest, user: user},
+			err:  mergeErr,
+			repositoryMock: func(mc *minimock.Controller) CartsRepository {
+				mock := NewCartsRepositoryMock(t)
+				mock.GetCartMock.When(ctxTx, guest).Then(guestItems, nil)
+				mock.GetCartMock.When(ctxTx, CartOwner{User: user}).Then(userItems, nil)
+				mock.MergeCartsMock.Expect(ctxTx, guestID, user).Return(mergeErr)
+				return mock
+			},
+		},
+		{
+			name: "negative case - merged count overflow",
+			args: args{guest: guest, user: user},
+			err:  ErrCartItemCountOverflow,
+			repositoryMock: func(mc *minimock.Controller) CartsRepository {
+				mock := NewCartsRepositoryMock(t)
+				mock.GetCartMock.When(ctxTx, guest).Then(guestItems, nil)
+				mock.GetCartMock.When(ctxTx, CartOwner{User: user}).Then([]CartItem{{Sku: 1, Count: 65535}}, nil)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			api, err := NewMock(tt.repositoryMock(mc), NewTransactionManagerMock(t))
+			require.NoError(t, err)
+			moved, merged, err := api.mergeCarts(ctxTx, tt.args.guest, tt.args.user)
+			require.ErrorIs(t, err, tt.err)
+			require.Equal(t, tt.moved, moved)
+			require.Equal(t, tt.merged, merged)
+		})
+	}
+}
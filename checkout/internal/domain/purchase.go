@@ -2,25 +2,75 @@ package domain
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/pkg/errors"
 )
 
-var ErrNotItemsInCart = errors.New("no items in cart")
+var (
+	ErrNotItemsInCart   = errors.New("no items in cart")
+	ErrPurchaseNotFound = errors.New("purchase not found")
+	ErrItemsUnavailable = errors.New("items unavailable")
+)
+
+type MissingItem struct {
+	Sku       uint32
+	Requested uint32
+	Missing   uint32
+}
+
+type ItemsUnavailableError struct {
+	OrderID int64
+	Items   []MissingItem
+}
+
+func (e *ItemsUnavailableError) Error() string {
+	if len(e.Items) == 0 {
+		return ErrItemsUnavailable.Error()
+	}
+	missing := make([]string, 0, len(e.Items))
+	for _, item := range e.Items {
+		missing = append(missing, fmt.Sprintf("sku %d: missing %d of %d", item.Sku, item.Missing, item.Requested))
+	}
+	return ErrItemsUnavailable.Error() + ": " + strings.Join(missing, ", ")
+}
 
-func (d *domain) Purchase(ctx context.Context, user int64) (int64, error) {
-	items, err := d.repo.GetCart(ctx, user)
+func (e *ItemsUnavailableError) Is(target error) bool {
+	return target == ErrItemsUnavailable
+}
+
+func (d *domain) Purchase(ctx context.Context, user int64, idempotencyKey string) (int64, error) {
+	if idempotencyKey != "" {
+		orderID, err := d.repo.GetPurchase(ctx, user, idempotencyKey)
+		if err == nil {
+			return orderID, nil
+		}
+		if !errors.Is(err, ErrPurchaseNotFound) {
+			return 0, errors.Wrap(err, "get purchase")
+		}
+	}
+	cart := CartOwner{User: user}
+	items, err := d.repo.GetCart(ctx, cart)
 	if err != nil {
 		return 0, errors.Wrap(err, "get cart")
 	}
 	if len(items) == 0 {
 		return 0, ErrNotItemsInCart
 	}
-	orderID, err := d.lOMSCaller.CreateOrder(ctx, user, items)
+	orderID, err := d.lOMSCaller.CreateOrder(ctx, user, items, idempotencyKey)
 	if err != nil {
 		return 0, errors.WithMessage(err, "creating order")
 	}
-	err = d.repo.DeleteCart(ctx, user)
+	err = d.tm.RunTransaction(ctx, isoLevelReadCommitted, func(ctxTX context.Context) error {
+		if idempotencyKey != "" {
+			err := d.repo.SavePurchase(ctxTX, user, idempotencyKey, orderID)
+			if err != nil {
+				return errors.Wrap(err, "save purchase")
+			}
+		}
+		return d.repo.DeleteCart(ctxTX, cart)
+	})
 	if err != nil {
 		return orderID, errors.Wrap(err, "delete cart after create order")
 	}
@@ -2,6 +2,8 @@ package domain
 
 import (
 	"context"
+	transactor "route256/libs/postgres_transactor"
+	txMock "route256/libs/postgres_transactor/mocks"
 	"testing"
 
 	"github.com/gojuno/minimock/v3"
@@ -12,21 +14,27 @@ import (
 func TestPurchase(t *testing.T) {
 	type repositoryMockFunc func(mc *minimock.Controller) CartsRepository
 	type lomsCallerMockFunc func(mc *minimock.Controller) LOMSCaller
+	type tmMockFunc func(mc *minimock.Controller) TransactionManager
 
 	type args struct {
-		ctx  context.Context
-		user int64
+		ctx            context.Context
+		user           int64
+		idempotencyKey string
 	}
 
 	var (
-		mc                 = minimock.NewController(t)
-		ctx                = context.Background()
-		lomsRes      int64 = 5
-		lomsErrorRes int64 = 0
-		orderIDError       = lomsErrorRes
-		repoErr            = errors.New("repo error")
-		lomsErr            = errors.New("loms error")
-		user         int64 = 1
+		mc                   = minimock.NewController(t)
+		tx                   = txMock.NewTxMock(t)
+		ctx                  = context.Background()
+		ctxTx                = context.WithValue(ctx, transactor.TxKey("tx"), tx)
+		key                  = "purchase-key"
+		lomsRes        int64 = 5
+		lomsErrorRes   int64 = 0
+		orderIDError         = lomsErrorRes
+		repoErr              = errors.New("repo error")
+		lomsErr              = errors.New("loms error")
+		unavailableErr       = &ItemsUnavailableError{Items: []MissingItem{{Sku: 6967749, Requested: 2, Missing: 1}}}
+		user           int64 = 1
 
 		cartItems = []CartItem{
 			{
@@ -50,6 +58,7 @@ func TestPurchase(t *testing.T) {
 		err            error
 		repositoryMock repositoryMockFunc
 		lomsMock       lomsCallerMockFunc
+		tmMock         tmMockFunc
 	}{
 		{
 			name: "positive case",
@@ -61,13 +70,20 @@ func TestPurchase(t *testing.T) {
 			err:  nil,
 			repositoryMock: func(mc *minimock.Controller) CartsRepository {
 				mock := NewCartsRepositoryMock(t)
-				mock.GetCartMock.Expect(ctx, user).Return(cartItems, nil)
-				mock.DeleteCartMock.Expect(ctx, user).Return(nil)
+				mock.GetCartMock.Expect(ctx, CartOwner{User: user}).Return(cartItems, nil)
+				mock.DeleteCartMock.Expect(ctxTx, CartOwner{User: user}).Return(nil)
 				return mock
 			},
 			lomsMock: func(mc *minimock.Controller) LOMSCaller {
 				mock := NewLOMSCallerMock(t)
-				mock.CreateOrderMock.Expect(ctx, user, cartItems).Return(lomsRes, nil)
+				mock.CreateOrderMock.Expect(ctx, user, cartItems, "").Return(lomsRes, nil)
+				return mock
+			},
+			tmMock: func(mc *minimock.Controller) TransactionManager {
+				mock := NewTransactionManagerMock(t)
+				mock.RunTransactionMock.Set(func(ctx context.Context, isoLevel string, f func(ctxTX context.Context) error) error {
+					return f(ctxTx)
+				})
 				return mock
 			},
 		},
@@ -81,13 +97,16 @@ func TestPurchase(t *testing.T) {
 			err:  repoErr,
 			repositoryMock: func(mc *minimock.Controller) CartsRepository {
 				mock := NewCartsRepositoryMock(t)
-				mock.GetCartMock.Expect(ctx, user).Return(nil, repoErr)
+				mock.GetCartMock.Expect(ctx, CartOwner{User: user}).Return(nil, repoErr)
 				return mock
 			},
 			lomsMock: func(mc *minimock.Controller) LOMSCaller {
 				mock := NewLOMSCallerMock(t)
 				return mock
 			},
+			tmMock: func(mc *minimock.Controller) TransactionManager {
+				return NewTransactionManagerMock(t)
+			},
 		},
 		{
 			name: "negative case - empty cart",
@@ -99,13 +118,16 @@ func TestPurchase(t *testing.T) {
 			err:  ErrNotItemsInCart,
 			repositoryMock: func(mc *minimock.Controller) CartsRepository {
 				mock := NewCartsRepositoryMock(t)
-				mock.GetCartMock.Expect(ctx, user).Return(emptyCart, nil)
+				mock.GetCartMock.Expect(ctx, CartOwner{User: user}).Return(emptyCart, nil)
 				return mock
 			},
 			lomsMock: func(mc *minimock.Controller) LOMSCaller {
 				mock := NewLOMSCallerMock(t)
 				return mock
 			},
+			tmMock: func(mc *minimock.Controller) TransactionManager {
+				return NewTransactionManagerMock(t)
+			},
 		},
 		{
 			name: "negative case - loms error",
@@ -117,14 +139,39 @@ func TestPurchase(t *testing.T) {
 			err:  lomsErr,
 			repositoryMock: func(mc *minimock.Controller) CartsRepository {
 				mock := NewCartsRepositoryMock(t)
-				mock.GetCartMock.Expect(ctx, user).Return(cartItems, nil)
+				mock.GetCartMock.Expect(ctx, CartOwner{User: user}).Return(cartItems, nil)
 				return mock
 			},
 			lomsMock: func(mc *minimock.Controller) LOMSCaller {
 				mock := NewLOMSCallerMock(t)
-				mock.CreateOrderMock.Expect(ctx, user, cartItems).Return(lomsErrorRes, lomsErr)
+				mock.CreateOrderMock.Expect(ctx, user, cartItems, "").Return(lomsErrorRes, lomsErr)
 				return mock
 			},
+			tmMock: func(mc *minimock.Controller) TransactionManager {
+				return NewTransactionManagerMock(t)
+			},
+		},
+		{
+			name: "negative case - items unavailable keeps cart",
+			args: args{
+				ctx:  ctx,
+				user: user,
+			},
+			want: orderIDError,
+			err:  unavailableErr,
+			repositoryMock: func(mc *minimock.Controller) CartsRepository {
+				mock := NewCartsRepositoryMock(t)
+				mock.GetCartMock.Expect(ctx, CartOwner{User: user}).Return(cartItems, nil)
+				return mock
+			},
+			lomsMock: func(mc *minimock.Controller) LOMSCaller {
+				mock := NewLOMSCallerMock(t)
+				mock.CreateOrderMock.Expect(ctx, user, cartItems, "").Return(lomsErrorRes, unavailableErr)
+				return mock
+			},
+			tmMock: func(mc *minimock.Controller) TransactionManager {
+				return NewTransactionManagerMock(t)
+			},
 		},
 		{
 			name: "negative case - repository error on delete",
@@ -136,13 +183,121 @@ func TestPurchase(t *testing.T) {
 			err:  repoErr,
 			repositoryMock: func(mc *minimock.Controller) CartsRepository {
 				mock := NewCartsRepositoryMock(t)
-				mock.GetCartMock.Expect(ctx, user).Return(cartItems, nil)
-				mock.DeleteCartMock.Expect(ctx, user).Return(repoErr)
+				mock.GetCartMock.Expect(ctx, CartOwner{User: user}).Return(cartItems, nil)
+				mock.DeleteCartMock.Expect(ctxTx, CartOwner{User: user}).Return(repoErr)
 				return mock
 			},
 			lomsMock: func(mc *minimock.Controller) LOMSCaller {
 				mock := NewLOMSCallerMock(t)
-				mock.CreateOrderMock.Expect(ctx, user, cartItems).Return(lomsRes, nil)
+				mock.CreateOrderMock.Expect(ctx, user, cartItems, "").Return(lomsRes, nil)
+				return mock
+			},
+			tmMock: func(mc *minimock.Controller) TransactionManager {
+				mock := NewTransactionManagerMock(t)
+				mock.RunTransactionMock.Set(func(ctx context.Context, isoLevel string, f func(ctxTX context.Context) error) error {
+					return f(ctxTx)
+				})
+				return mock
+			},
+		},
+		{
+			name: "positive case - new idempotency key",
+			args: args{
+				ctx:            ctx,
+				user:           user,
+				idempotencyKey: key,
+			},
+			want: orderID,
+			err:  nil,
+			repositoryMock: func(mc *minimock.Controller) CartsRepository {
+				mock := NewCartsRepositoryMock(t)
+				mock.GetPurchaseMock.Expect(ctx, user, key).Return(0, ErrPurchaseNotFound)
+				mock.GetCartMock.Expect(ctx, CartOwner{User: user}).Return(cartItems, nil)
+				mock.SavePurchaseMock.Expect(ctxTx, user, key, orderID).Return(nil)
+				mock.DeleteCartMock.Expect(ctxTx, CartOwner{User: user}).Return(nil)
+				return mock
+			},
+			lomsMock: func(mc *minimock.Controller) LOMSCaller {
+				mock := NewLOMSCallerMock(t)
+				mock.CreateOrderMock.Expect(ctx, user, cartItems, key).Return(lomsRes, nil)
+				return mock
+			},
+			tmMock: func(mc *minimock.Controller) TransactionManager {
+				mock := NewTransactionManagerMock(t)
+				mock.RunTransactionMock.Set(func(ctx context.Context, isoLevel string, f func(ctxTX context.Context) error) error {
+					return f(ctxTx)
+				})
+				return mock
+			},
+		},
+		{
+			name: "positive case - repeated idempotency key",
+			args: args{
+				ctx:            ctx,
+				user:           user,
+				idempotencyKey: key,
+			},
+			want: orderID,
+			err:  nil,
+			repositoryMock: func(mc *minimock.Controller) CartsRepository {
+				mock := NewCartsRepositoryMock(t)
+				mock.GetPurchaseMock.Expect(ctx, user, key).Return(orderID, nil)
+				return mock
+			},
+			lomsMock: func(mc *minimock.Controller) LOMSCaller {
+				return NewLOMSCallerMock(t)
+			},
+			tmMock: func(mc *minimock.Controller) TransactionManager {
+				return NewTransactionManagerMock(t)
+			},
+		},
+		{
+			name: "negative case - repository error on get purchase",
+			args: args{
+				ctx:            ctx,
+				user:           user,
+				idempotencyKey: key,
+			},
+			want: orderIDError,
+			err:  repoErr,
+			repositoryMock: func(mc *minimock.Controller) CartsRepository {
+				mock := NewCartsRepositoryMock(t)
+				mock.GetPurchaseMock.Expect(ctx, user, key).Return(0, repoErr)
+				return mock
+			},
+			lomsMock: func(mc *minimock.Controller) LOMSCaller {
+				return NewLOMSCallerMock(t)
+			},
+			tmMock: func(mc *minimock.Controller) TransactionManager {
+				return NewTransactionManagerMock(t)
+			},
+		},
+		{
+			name: "negative case - repository error on save purchase",
+			args: args{
+				ctx:            ctx,
+				user:           user,
+				idempotencyKey: key,
+			},
+			want: orderID,
+			err:  repoErr,
+			repositoryMock: func(mc *minimock.Controller) CartsRepository {
+				mock := NewCartsRepositoryMock(t)
+				mock.GetPurchaseMock.Expect(ctx, user, key).Return(0, ErrPurchaseNotFound)
+				mock.GetCartMock.Expect(ctx, CartOwner{User: user}).Return(cartItems, nil)
+				mock.SavePurchaseMock.Expect(ctxTx, user, key, orderID).Return(repoErr)
+				return mock
+			},
+			lomsMock: func(mc *minimock.Controller) LOMSCaller {
+				mock := NewLOMSCallerMock(t)
+				mock.CreateOrderMock.Expect(ctx, user, cartItems, key).Return(lomsRes, nil)
+				return mock
+			},
+			tmMock: func(mc *minimock.Controller) TransactionManager {
+				mock := NewTransactionManagerMock(t)
+				mock.RunTransactionMock.Set(func(ctx context.Context, isoLevel string, f func(ctxTX context.Context) error) error {
+					return f(ctxTx)
+				})
 				return mock
 			},
 		},
@@ -155,11 +310,12 @@ func TestPurchase(t *testing.T) {
 			api, err := NewMock(
 				tt.lomsMock(mc),
 				tt.repositoryMock(mc),
+				tt.tmMock(mc),
 			)
 			if err != nil {
 				require.Equal(t, nil, err)
 			}
-			res, err := api.Purchase(tt.args.ctx, tt.args.user)
+			res, err := api.Purchase(tt.args.ctx, tt.args.user, tt.args.idempotencyKey)
 			require.Equal(t, tt.want, res)
 			if tt.err != nil {
 				require.ErrorContains(t, err, tt.err.Error())
@@ -0,0 +1,138 @@
+package domain
+
+import (
+	"context"
+	"route256/libs/logger"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+var ErrNoSavedItem = errors.New("no such saved item")
+
+type SavedItem struct {
+	CartItem
+	Available bool
+}
+
+func (d *domain) SaveForLater(ctx context.Context, user int64, sku uint32) error {
+	_, ok := d.skus[sku]
+	if !ok {
+		return ErrInvalidSKU
+	}
+	cart := CartOwner{User: user}
+	var moved bool
+	err := d.tm.RunTransaction(ctx, isoLevelRepeatableRead, func(ctxTX context.Context) error {
+		item, err := d.repo.GetCartItem(ctxTX, cart, sku)
+		if err != nil && !errors.Is(err, ErrNoSameItemsInCart) {
+			return errors.Wrap(err, "get cart item")
+		}
+		if errors.Is(err, ErrNoSameItemsInCart) {
+			//Повторное добавление в список желаемого ничего не меняет
+			_, err = d.repo.GetSavedItem(ctxTX, user, sku)
+			if err == nil {
+				return nil
+			}
+			if !errors.Is(err, ErrNoSavedItem) {
+				return errors.Wrap(err, "get saved item")
+			}
+			item = &CartItem{Sku: sku, Count: 1}
+		} else {
+			err = d.repo.DeleteFromCart(ctxTX, cart, sku, item.Count, true)
+			if err != nil {
+				return errors.Wrap(err, "delete from cart")
+			}
+			moved = true
+		}
+		err = d.repo.SaveItem(ctxTX, user, sku, item.Count)
+		if err != nil {
+			return errors.Wrap(err, "save item")
+		}
+		return nil
+	})
+	if err != nil || !moved || !d.holdsEnabled(cart) {
+		return err
+	}
+	//Отложенный товар не удерживаем, холд истечет сам, если снять не получилось
+	err = d.lOMSCaller.ReleaseHold(ctx, user, sku)
+	if err != nil {
+		logger.Error(ctx, "release stock hold", zap.Int64("user", user), zap.Uint32("sku", sku), zap.Error(err))
+	}
+	return nil
+}
+
+func (d *domain) MoveToCart(ctx context.Context, user int64, sku uint32) error {
+	cart := CartOwner{User: user}
+	err := d.tm.RunTransaction(ctx, isoLevelRepeatableRead, func(ctxTX context.Context) error {
+		saved, err := d.repo.GetSavedItem(ctxTX, user, sku)
+		if err != nil {
+			return errors.WithMessage(err, "get saved item")
+		}
+		item, err := d.repo.GetCartItem(ctxTX, cart, sku)
+		if err != nil && !errors.Is(err, ErrNoSameItemsInCart) {
+			return errors.Wrap(err, "get cart item")
+		}
+		if errors.Is(err, ErrNoSameItemsInCart) {
+			item = &CartItem{}
+		}
+		if d.holdsEnabled(cart) {
+			err = d.lOMSCaller.HoldStock(ctxTX, user, sku, saved.Count+item.Count, d.holdConfig.TTL)
+			if err != nil {
+				return errors.WithMessage(err, "holding stocks")
+			}
+		} else {
+			err = d.checkStocks(ctxTX, sku, saved.Count+item.Count)
+			if err != nil {
+				return err
+			}
+		}
+		err = d.repo.AddToCart(ctxTX, cart, sku, saved.Count)
+		if err != nil {
+			return errors.Wrap(err, "add to cart")
+		}
+		err = d.repo.DeleteSavedItem(ctxTX, user, sku)
+		if err != nil {
+			return errors.Wrap(err, "delete saved item")
+		}
+		return nil
+	})
+	return err
+}
+
+func (d *domain) ListSaved(ctx context.Context, user int64) ([]SavedItem, error) {
+	items, err := d.repo.GetSavedItems(ctx, user)
+	if err != nil {
+		return nil, errors.Wrap(err, "get saved items")
+	}
+	if len(items) == 0 {
+		return []SavedItem{}, nil
+	}
+	skus := make([]uint32, 0, len(items))
+	for _, item := range items {
+		skus = append(skus, item.Sku)
+	}
+	stocks, err := d.lOMSCaller.BatchStocks(ctx, skus)
+	if err != nil {
+		return nil, errors.WithMessage(err, "checking stocks")
+	}
+	err = d.enrichItems(ctx, items)
+	if err != nil {
+		return nil, err
+	}
+	saved := make([]SavedItem, 0, len(items))
+	for _, item := range items {
+		var available uint64
+		for _, stock := range stocks[item.Sku] {
+			//Корзина покупает только со складов маркетплейса
+			if stock.SellerID != 0 {
+				continue
+			}
+			available += stock.Count
+		}
+		saved = append(saved, SavedItem{
+			CartItem:  item,
+			Available: available >= uint64(item.Count),
+		})
+	}
+	return saved, nil
+}
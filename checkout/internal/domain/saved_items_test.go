@@ -0,0 +1,258 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"route256/libs/cache"
+	"route256/libs/logger"
+	transactor "route256/libs/postgres_transactor"
+	txMock "route256/libs/postgres_transactor/mocks"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSavedItems(t *testing.T) {
+	logger.Init(true)
+
+	type repositoryMockFunc func(mc *minimock.Controller) CartsRepository
+	type lomsMockFunc func(mc *minimock.Controller) LOMSCaller
+
+	var (
+		mc    = minimock.NewController(t)
+		tx    = txMock.NewTxMock(t)
+		ctx   = context.Background()
+		ctxTx = context.WithValue(ctx, transactor.TxKey("tx"), tx)
+
+		stocksErr = errors.New("stocks error")
+
+		user     int64  = 1
+		sku      uint32 = 4678816
+		otherSku uint32 = 1148162
+		ttl             = time.Minute
+		info            = ProductInfo{Name: "Kettle", Price: 1500}
+
+		holdConfig = HoldConfig{Enabled: true, TTL: ttl}
+	)
+	t.Cleanup(mc.Finish)
+
+	productsMock := func(mc *minimock.Controller) ProductServiceCaller {
+		mock := NewProductServiceCallerMock(t)
+		mock.GetSKUsMock.Expect(ctx).Return(map[uint32]struct{}{sku: {}, otherSku: {}}, nil)
+		return mock
+	}
+	tmMock := func(mc *minimock.Controller) TransactionManager {
+		mock := NewTransactionManagerMock(t)
+		mock.RunTransactionMock.Set(func(ctx context.Context, isoLevel string, f func(ctxTX context.Context) error) error {
+			return f(ctxTx)
+		})
+		return mock
+	}
+
+	tests := []struct {
+		name           string
+		call           func(d *domain) error
+		err            error
+		enrich         bool
+		cached         []uint32
+		repositoryMock repositoryMockFunc
+		lomsMock       lomsMockFunc
+	}{
+		{
+			name: "save for later - moved from cart, hold released",
+			call: func(d *domain) error {
+				return d.SaveForLater(ctx, user, sku)
+			},
+			err: nil,
+			repositoryMock: func(mc *minimock.Controller) CartsRepository {
+				mock := NewCartsRepositoryMock(t)
+				mock.GetCartItemMock.Expect(ctxTx, CartOwner{User: user}, sku).Return(&CartItem{Sku: sku, Count: 3}, nil)
+				mock.DeleteFromCartMock.Expect(ctxTx, CartOwner{User: user}, sku, 3, true).Return(nil)
+				mock.SaveItemMock.Expect(ctxTx, user, sku, 3).Return(nil)
+				return mock
+			},
+			lomsMock: func(mc *minimock.Controller) LOMSCaller {
+				mock := NewLOMSCallerMock(t)
+				mock.ReleaseHoldMock.Expect(ctx, user, sku).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "save for later - wishlist item",
+			call: func(d *domain) error {
+				return d.SaveForLater(ctx, user, sku)
+			},
+			err: nil,
+			repositoryMock: func(mc *minimock.Controller) CartsRepository {
+				mock := NewCartsRepositoryMock(t)
+				mock.GetCartItemMock.Expect(ctxTx, CartOwner{User: user}, sku).Return(nil, ErrNoSameItemsInCart)
+				mock.GetSavedItemMock.Expect(ctxTx, user, sku).Return(nil, ErrNoSavedItem)
+				mock.SaveItemMock.Expect(ctxTx, user, sku, 1).Return(nil)
+				return mock
+			},
+			lomsMock: func(mc *minimock.Controller) LOMSCaller {
+				return NewLOMSCallerMock(t)
+			},
+		},
+		{
+			name: "save for later - already in wishlist",
+			call: func(d *domain) error {
+				return d.SaveForLater(ctx, user, sku)
+			},
+			err: nil,
+			repositoryMock: func(mc *minimock.Controller) CartsRepository {
+				mock := NewCartsRepositoryMock(t)
+				mock.GetCartItemMock.Expect(ctxTx, CartOwner{User: user}, sku).Return(nil, ErrNoSameItemsInCart)
+				mock.GetSavedItemMock.Expect(ctxTx, user, sku).Return(&CartItem{Sku: sku, Count: 1}, nil)
+				return mock
+			},
+			lomsMock: func(mc *minimock.Controller) LOMSCaller {
+				return NewLOMSCallerMock(t)
+			},
+		},
+		{
+			name: "save for later - invalid sku",
+			call: func(d *domain) error {
+				return d.SaveForLater(ctx, user, 1)
+			},
+			err: ErrInvalidSKU,
+			repositoryMock: func(mc *minimock.Controller) CartsRepository {
+				return NewCartsRepositoryMock(t)
+			},
+			lomsMock: func(mc *minimock.Controller) LOMSCaller {
+				return NewLOMSCallerMock(t)
+			},
+		},
+		{
+			name: "move to cart - hold whole cart quantity",
+			call: func(d *domain) error {
+				return d.MoveToCart(ctx, user, sku)
+			},
+			err: nil,
+			repositoryMock: func(mc *minimock.Controller) CartsRepository {
+				mock := NewCartsRepositoryMock(t)
+				mock.GetSavedItemMock.Expect(ctxTx, user, sku).Return(&CartItem{Sku: sku, Count: 2}, nil)
+				mock.GetCartItemMock.Expect(ctxTx, CartOwner{User: user}, sku).Return(&CartItem{Sku: sku, Count: 1}, nil)
+				mock.AddToCartMock.Expect(ctxTx, CartOwner{User: user}, sku, 2).Return(nil)
+				mock.DeleteSavedItemMock.Expect(ctxTx, user, sku).Return(nil)
+				return mock
+			},
+			lomsMock: func(mc *minimock.Controller) LOMSCaller {
+				mock := NewLOMSCallerMock(t)
+				mock.HoldStockMock.Expect(ctxTx, user, sku, 3, ttl).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "move to cart - insufficient stocks",
+			call: func(d *domain) error {
+				return d.MoveToCart(ctx, user, sku)
+			},
+			err: ErrInsufficientStocks,
+			repositoryMock: func(mc *minimock.Controller) CartsRepository {
+				mock := NewCartsRepositoryMock(t)
+				mock.GetSavedItemMock.Expect(ctxTx, user, sku).Return(&CartItem{Sku: sku, Count: 2}, nil)
+				mock.GetCartItemMock.Expect(ctxTx, CartOwner{User: user}, sku).Return(nil, ErrNoSameItemsInCart)
+				return mock
+			},
+			lomsMock: func(mc *minimock.Controller) LOMSCaller {
+				mock := NewLOMSCallerMock(t)
+				mock.HoldStockMock.Expect(ctxTx, user, sku, 2, ttl).Return(ErrInsufficientStocks)
+				return mock
+			},
+		},
+		{
+			name: "move to cart - not saved",
+			call: func(d *domain) error {
+				return d.MoveToCart(ctx, user, sku)
+			},
+			err: ErrNoSavedItem,
+			repositoryMock: func(mc *minimock.Controller) CartsRepository {
+				mock := NewCartsRepositoryMock(t)
+				mock.GetSavedItemMock.Expect(ctxTx, user, sku).Return(nil, ErrNoSavedItem)
+				return mock
+			},
+			lomsMock: func(mc *minimock.Controller) LOMSCaller {
+				return NewLOMSCallerMock(t)
+			},
+		},
+		{
+			name: "list saved - enriched with price and availability of own stock",
+			call: func(d *domain) error {
+				items, err := d.ListSaved(ctx, user)
+				require.Equal(t, []SavedItem{
+					{CartItem: CartItem{Sku: sku, Count: 2, ProductInfo: info}, Available: true},
+					{CartItem: CartItem{Sku: otherSku, Count: 5, ProductInfo: info}, Available: false},
+				}, items)
+				return err
+			},
+			err:    nil,
+			enrich: true,
+			repositoryMock: func(mc *minimock.Controller) CartsRepository {
+				mock := NewCartsRepositoryMock(t)
+				mock.GetSavedItemsMock.Expect(ctx, user).Return([]CartItem{{Sku: sku, Count: 2}, {Sku: otherSku, Count: 5}}, nil)
+				return mock
+			},
+			lomsMock: func(mc *minimock.Controller) LOMSCaller {
+				mock := NewLOMSCallerMock(t)
+				mock.BatchStocksMock.Expect(ctx, []uint32{sku, otherSku}).Return(map[uint32][]Stock{
+					sku:      {{WarehouseID: 1, Count: 1}, {WarehouseID: 2, Count: 1}},
+					otherSku: {{WarehouseID: 1, Count: 4}, {WarehouseID: 3, Count: 10, SellerID: 7}},
+				}, nil)
+				return mock
+			},
+		},
+		{
+			name: "list saved - stocks error",
+			call: func(d *domain) error {
+				_, err := d.ListSaved(ctx, user)
+				return err
+			},
+			err:    stocksErr,
+			cached: []uint32{sku},
+			repositoryMock: func(mc *minimock.Controller) CartsRepository {
+				mock := NewCartsRepositoryMock(t)
+				mock.GetSavedItemsMock.Expect(ctx, user).Return([]CartItem{{Sku: sku, Count: 2}}, nil)
+				return mock
+			},
+			lomsMock: func(mc *minimock.Controller) LOMSCaller {
+				mock := NewLOMSCallerMock(t)
+				mock.BatchStocksMock.Return(nil, stocksErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			products := productsMock(mc).(*ProductServiceCallerMock)
+			limiter := NewLimiterMock(t)
+			if tt.enrich {
+				products.GetProductMock.Return(info, nil)
+				limiter.WaitMock.Return(nil)
+			}
+			c := cache.NewCache(1, time.Minute, time.Minute, 10)
+			for _, cachedSku := range tt.cached {
+				c.Set(fmt.Sprintf("%d", cachedSku), info, time.Minute)
+			}
+			api, err := NewMock(
+				products,
+				tt.repositoryMock(mc),
+				tmMock(mc),
+				tt.lomsMock(mc),
+				limiter,
+				holdConfig,
+				PoolConfig{AmountWorkers: 2, MaxRetries: 1, WithCancelOnError: true},
+				c,
+			)
+			require.NoError(t, err)
+			err = tt.call(api)
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"context"
+	"route256/libs/logger"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+func (d *domain) SetCartItemCount(ctx context.Context, owner CartOwner, sku uint32, count uint16) error {
+	_, ok := d.skus[sku]
+	if !ok {
+		return ErrInvalidSKU
+	}
+	if count == 0 {
+		return d.removeCartItem(ctx, owner, sku)
+	}
+	err := d.tm.RunTransaction(ctx, isoLevelRepeatableRead, func(ctxTX context.Context) error {
+		if d.holdsEnabled(owner) {
+			err := d.lOMSCaller.HoldStock(ctxTX, owner.User, sku, count, d.holdConfig.TTL)
+			if err != nil {
+				return errors.WithMessage(err, "holding stocks")
+			}
+		} else {
+			err := d.checkStocks(ctxTX, sku, count)
+			if err != nil {
+				return err
+			}
+		}
+		err := d.repo.SetCartItemCount(ctxTX, owner, sku, count)
+		if err != nil {
+			return errors.Wrap(err, "set cart item count")
+		}
+		return nil
+	})
+	return err
+}
+
+func (d *domain) removeCartItem(ctx context.Context, owner CartOwner, sku uint32) error {
+	err := d.repo.DeleteFromCart(ctx, owner, sku, 0, true)
+	if err != nil {
+		return errors.Wrap(err, "delete from cart")
+	}
+	if !d.holdsEnabled(owner) {
+		return nil
+	}
+	err = d.lOMSCaller.ReleaseHold(ctx, owner.User, sku)
+	if err != nil {
+		logger.Error(ctx, "release stock hold", zap.Int64("user", owner.User), zap.Uint32("sku", sku), zap.Error(err))
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+package domain
+
+import (
+	"context"
+	transactor "route256/libs/postgres_transactor"
+	txMock "route256/libs/postgres_transactor/mocks"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetCartItemCount(t *testing.T) {
+	type repositoryMockFunc func(mc *minimock.Controller) CartsRepository
+	type lomsMockFunc func(mc *minimock.Controller) LOMSCaller
+
+	type args struct {
+		ctx   context.Context
+		user  int64
+		sku   uint32
+		count uint16
+	}
+
+	var (
+		mc    = minimock.NewController(t)
+		tx    = txMock.NewTxMock(t)
+		ctx   = context.Background()
+		ctxTx = context.WithValue(ctx, transactor.TxKey("tx"), tx)
+
+		setErr = errors.New("set error")
+
+		user       int64  = 1
+		sku        uint32 = 4678816
+		invalidSku uint32 = 1
+
+		stocks = []Stock{
+			{WarehouseID: gofakeit.Int64(), Count: 10},
+			{WarehouseID: gofakeit.Int64(), Count: 5},
+		}
+	)
+	t.Cleanup(mc.Finish)
+
+	productsMock := func(mc *minimock.Controller) ProductServiceCaller {
+		mock := NewProductServiceCallerMock(t)
+		mock.GetSKUsMock.Expect(ctx).Return(map[uint32]struct{}{sku: {}}, nil)
+		return mock
+	}
+	tmMock := func(mc *minimock.Controller) TransactionManager {
+		mock := NewTransactionManagerMock(t)
+		mock.RunTransactionMock.Set(func(ctx context.Context, isoLevel string, f func(ctxTX context.Context) error) error {
+			return f(ctxTx)
+		})
+		return mock
+	}
+
+	tests := []struct {
+		name           string
+		args           args
+		err            error
+		repositoryMock repositoryMockFunc
+		lomsMock       lomsMockFunc
+	}{
+		{
+			name: "positive case",
+			args: args{
+				ctx:   ctx,
+				user:  user,
+				sku:   sku,
+				count: 15,
+			},
+			err: nil,
+			repositoryMock: func(mc *minimock.Controller) CartsRepository {
+				mock := NewCartsRepositoryMock(t)
+				mock.SetCartItemCountMock.Expect(ctxTx, CartOwner{User: user}, sku, 15).Return(nil)
+				return mock
+			},
+			lomsMock: func(mc *minimock.Controller) LOMSCaller {
+				mock := NewLOMSCallerMock(t)
+				mock.StocksMock.Expect(ctxTx, sku).Return(stocks, nil)
+				return mock
+			},
+		},
+		{
+			name: "positive case - zero count removes item",
+			args: args{
+				ctx:  ctx,
+				user: user,
+				sku:  sku,
+			},
+			err: nil,
+			repositoryMock: func(mc *minimock.Controller) CartsRepository {
+				mock := NewCartsRepositoryMock(t)
+				mock.DeleteFromCartMock.Expect(ctx, CartOwner{User: user}, sku, 0, true).Return(nil)
+				return mock
+			},
+			lomsMock: func(mc *minimock.Controller) LOMSCaller {
+				return NewLOMSCallerMock(t)
+			},
+		},
+		{
+			name: "negative case - insufficient stocks",
+			args: args{
+				ctx:   ctx,
+				user:  user,
+				sku:   sku,
+				count: 16,
+			},
+			err: ErrInsufficientStocks,
+			repositoryMock: func(mc *minimock.Controller) CartsRepository {
+				return NewCartsRepositoryMock(t)
+			},
+			lomsMock: func(mc *minimock.Controller) LOMSCaller {
+				mock := NewLOMSCallerMock(t)
+				mock.StocksMock.Expect(ctxTx, sku).Return(stocks, nil)
+				return mock
+			},
+		},
+		{
+			name: "negative case - invalid sku",
+			args: args{
+				ctx:   ctx,
+				user:  user,
+				sku:   invalidSku,
+				count: 1,
+			},
+			err: ErrInvalidSKU,
+			repositoryMock: func(mc *minimock.Controller) CartsRepository {
+				return NewCartsRepositoryMock(t)
+			},
+			lomsMock: func(mc *minimock.Controller) LOMSCaller {
+				return NewLOMSCallerMock(t)
+			},
+		},
+		{
+			name: "negative case - set error",
+			args: args{
+				ctx:   ctx,
+				user:  user,
+				sku:   sku,
+				count: 1,
+			},
+			err: setErr,
+			repositoryMock: func(mc *minimock.Controller) CartsRepository {
+				mock := NewCartsRepositoryMock(t)
+				mock.SetCartItemCountMock.Expect(ctxTx, CartOwner{User: user}, sku, 1).Return(setErr)
+				return mock
+			},
+			lomsMock: func(mc *minimock.Controller) LOMSCaller {
+				mock := NewLOMSCallerMock(t)
+				mock.StocksMock.Expect(ctxTx, sku).Return(stocks, nil)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			api, err := NewMock(
+				productsMock(mc),
+				tt.repositoryMock(mc),
+				tmMock(mc),
+				tt.lomsMock(mc),
+			)
+			require.NoError(t, err)
+			err = api.SetCartItemCount(tt.args.ctx, CartOwner{User: tt.args.user}, tt.args.sku, tt.args.count)
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"sync"
 	mm_atomic "sync/atomic"
+	"time"
 	mm_time "time"
 
 	"github.com/gojuno/minimock/v3"
@@ -17,35 +18,107 @@ import (
 type CartsRepositoryMock struct {
 	t minimock.Tester
 
-	funcAddToCart          func(ctx context.Context, user int64, sku uint32, count uint16) (err error)
-	inspectFuncAddToCart   func(ctx context.Context, user int64, sku uint32, count uint16)
+	funcAddToCart          func(ctx context.Context, owner CartOwner, sku uint32, count uint16) (err error)
+	inspectFuncAddToCart   func(ctx context.Context, owner CartOwner, sku uint32, count uint16)
 	afterAddToCartCounter  uint64
 	beforeAddToCartCounter uint64
 	AddToCartMock          mCartsRepositoryMockAddToCart
 
-	funcDeleteCart          func(ctx context.Context, user int64) (err error)
-	inspectFuncDeleteCart   func(ctx context.Context, user int64)
+	funcCreateGuestCart          func(ctx context.Context, sessionID string, expiresAt time.Time) (i1 int64, err error)
+	inspectFuncCreateGuestCart   func(ctx context.Context, sessionID string, expiresAt time.Time)
+	afterCreateGuestCartCounter  uint64
+	beforeCreateGuestCartCounter uint64
+	CreateGuestCartMock          mCartsRepositoryMockCreateGuestCart
+
+	funcDeleteCart          func(ctx context.Context, owner CartOwner) (err error)
+	inspectFuncDeleteCart   func(ctx context.Context, owner CartOwner)
 	afterDeleteCartCounter  uint64
 	beforeDeleteCartCounter uint64
 	DeleteCartMock          mCartsRepositoryMockDeleteCart
 
-	funcDeleteFromCart          func(ctx context.Context, user int64, sku uint32, count uint16, full bool) (err error)
-	inspectFuncDeleteFromCart   func(ctx context.Context, user int64, sku uint32, count uint16, full bool)
+	funcDeleteExpiredGuestCarts          func(ctx context.Context, now time.Time) (err error)
+	inspectFuncDeleteExpiredGuestCarts   func(ctx context.Context, now time.Time)
+	afterDeleteExpiredGuestCartsCounter  uint64
+	beforeDeleteExpiredGuestCartsCounter uint64
+	DeleteExpiredGuestCartsMock          mCartsRepositoryMockDeleteExpiredGuestCarts
+
+	funcDeleteFromCart          func(ctx context.Context, owner CartOwner, sku uint32, count uint16, full bool) (err error)
+	inspectFuncDeleteFromCart   func(ctx context.Context, owner CartOwner, sku uint32, count uint16, full bool)
 	afterDeleteFromCartCounter  uint64
 	beforeDeleteFromCartCounter uint64
 	DeleteFromCartMock          mCartsRepositoryMockDeleteFromCart
 
-	funcGetCart          func(ctx context.Context, user int64) (ca1 []CartItem, err error)
-	inspectFuncGetCart   func(ctx context.Context, user int64)
+	funcDeleteGuestCart          func(ctx context.Context, sessionID string) (err error)
+	inspectFuncDeleteGuestCart   func(ctx context.Context, sessionID string)
+	afterDeleteGuestCartCounter  uint64
+	beforeDeleteGuestCartCounter uint64
+	DeleteGuestCartMock          mCartsRepositoryMockDeleteGuestCart
+
+	funcDeleteSavedItem          func(ctx context.Context, user int64, sku uint32) (err error)
+	inspectFuncDeleteSavedItem   func(ctx context.Context, user int64, sku uint32)
+	afterDeleteSavedItemCounter  uint64
+	beforeDeleteSavedItemCounter uint64
+	DeleteSavedItemMock          mCartsRepositoryMockDeleteSavedItem
+
+	funcGetCart          func(ctx context.Context, owner CartOwner) (ca1 []CartItem, err error)
+	inspectFuncGetCart   func(ctx context.Context, owner CartOwner)
 	afterGetCartCounter  uint64
 	beforeGetCartCounter uint64
 	GetCartMock          mCartsRepositoryMockGetCart
 
-	funcGetCartItem          func(ctx context.Context, user int64, sku uint32) (cp1 *CartItem, err error)
-	inspectFuncGetCartItem   func(ctx context.Context, user int64, sku uint32)
+	funcGetCartItem          func(ctx context.Context, owner CartOwner, sku uint32) (cp1 *CartItem, err error)
+	inspectFuncGetCartItem   func(ctx context.Context, owner CartOwner, sku uint32)
 	afterGetCartItemCounter  uint64
 	beforeGetCartItemCounter uint64
 	GetCartItemMock          mCartsRepositoryMockGetCartItem
+
+	funcGetPurchase          func(ctx context.Context, user int64, idempotencyKey string) (i1 int64, err error)
+	inspectFuncGetPurchase   func(ctx context.Context, user int64, idempotencyKey string)
+	afterGetPurchaseCounter  uint64
+	beforeGetPurchaseCounter uint64
+	GetPurchaseMock          mCartsRepositoryMockGetPurchase
+
+	funcGetSavedItem          func(ctx context.Context, user int64, sku uint32) (cp1 *CartItem, err error)
+	inspectFuncGetSavedItem   func(ctx context.Context, user int64, sku uint32)
+	afterGetSavedItemCounter  uint64
+	beforeGetSavedItemCounter uint64
+	GetSavedItemMock          mCartsRepositoryMockGetSavedItem
+
+	funcGetSavedItems          func(ctx context.Context, user int64) (ca1 []CartItem, err error)
+	inspectFuncGetSavedItems   func(ctx context.Context, user int64)
+	afterGetSavedItemsCounter  uint64
+	beforeGetSavedItemsCounter uint64
+	GetSavedItemsMock          mCartsRepositoryMockGetSavedItems
+
+	funcMergeCarts          func(ctx context.Context, guestCartID int64, user int64) (err error)
+	inspectFuncMergeCarts   func(ctx context.Context, guestCartID int64, user int64)
+	afterMergeCartsCounter  uint64
+	beforeMergeCartsCounter uint64
+	MergeCartsMock          mCartsRepositoryMockMergeCarts
+
+	funcProlongGuestCart          func(ctx context.Context, sessionID string, now time.Time, expiresAt time.Time) (i1 int64, err error)
+	inspectFuncProlongGuestCart   func(ctx context.Context, sessionID string, now time.Time, expiresAt time.Time)
+	afterProlongGuestCartCounter  uint64
+	beforeProlongGuestCartCounter uint64
+	ProlongGuestCartMock          mCartsRepositoryMockProlongGuestCart
+
+	funcSaveItem          func(ctx context.Context, user int64, sku uint32, count uint16) (err error)
+	inspectFuncSaveItem   func(ctx context.Context, user int64, sku uint32, count uint16)
+	afterSaveItemCounter  uint64
+	beforeSaveItemCounter uint64
+	SaveItemMock          mCartsRepositoryMockSaveItem
+
+	funcSavePurchase          func(ctx context.Context, user int64, idempotencyKey string, orderID int64) (err error)
+	inspectFuncSavePurchase   func(ctx context.Context, user int64, idempotencyKey string, orderID int64)
+	afterSavePurchaseCounter  uint64
+	beforeSavePurchaseCounter uint64
+	SavePurchaseMock          mCartsRepositoryMockSavePurchase
+
+	funcSetCartItemCount          func(ctx context.Context, owner CartOwner, sku uint32, count uint16) (err error)
+	inspectFuncSetCartItemCount   func(ctx context.Context, owner CartOwner, sku uint32, count uint16)
+	afterSetCartItemCountCounter  uint64
+	beforeSetCartItemCountCounter uint64
+	SetCartItemCountMock          mCartsRepositoryMockSetCartItemCount
 }
 
 // NewCartsRepositoryMock returns a mock for CartsRepository
@@ -58,18 +131,54 @@ func NewCartsRepositoryMock(t minimock.Tester) *CartsRepositoryMock {
 	m.AddToCartMock = mCartsRepositoryMockAddToCart{mock: m}
 	m.AddToCartMock.callArgs = []*CartsRepositoryMockAddToCartParams{}
 
+	m.CreateGuestCartMock = mCartsRepositoryMockCreateGuestCart{mock: m}
+	m.CreateGuestCartMock.callArgs = []*CartsRepositoryMockCreateGuestCartParams{}
+
 	m.DeleteCartMock = mCartsRepositoryMockDeleteCart{mock: m}
 	m.DeleteCartMock.callArgs = []*CartsRepositoryMockDeleteCartParams{}
 
+	m.DeleteExpiredGuestCartsMock = mCartsRepositoryMockDeleteExpiredGuestCarts{mock: m}
+	m.DeleteExpiredGuestCartsMock.callArgs = []*CartsRepositoryMockDeleteExpiredGuestCartsParams{}
+
 	m.DeleteFromCartMock = mCartsRepositoryMockDeleteFromCart{mock: m}
 	m.DeleteFromCartMock.callArgs = []*CartsRepositoryMockDeleteFromCartParams{}
 
+	m.DeleteGuestCartMock = mCartsRepositoryMockDeleteGuestCart{mock: m}
+	m.DeleteGuestCartMock.callArgs = []*CartsRepositoryMockDeleteGuestCartParams{}
+
+	m.DeleteSavedItemMock = mCartsRepositoryMockDeleteSavedItem{mock: m}
+	m.DeleteSavedItemMock.callArgs = []*CartsRepositoryMockDeleteSavedItemParams{}
+
 	m.GetCartMock = mCartsRepositoryMockGetCart{mock: m}
 	m.GetCartMock.callArgs = []*CartsRepositoryMockGetCartParams{}
 
 	m.GetCartItemMock = mCartsRepositoryMockGetCartItem{mock: m}
 	m.GetCartItemMock.callArgs = []*CartsRepositoryMockGetCartItemParams{}
 
+	m.GetPurchaseMock = mCartsRepositoryMockGetPurchase{mock: m}
+	m.GetPurchaseMock.callArgs = []*CartsRepositoryMockGetPurchaseParams{}
+
+	m.GetSavedItemMock = mCartsRepositoryMockGetSavedItem{mock: m}
+	m.GetSavedItemMock.callArgs = []*CartsRepositoryMockGetSavedItemParams{}
+
+	m.GetSavedItemsMock = mCartsRepositoryMockGetSavedItems{mock: m}
+	m.GetSavedItemsMock.callArgs = []*CartsRepositoryMockGetSavedItemsParams{}
+
+	m.MergeCartsMock = mCartsRepositoryMockMergeCarts{mock: m}
+	m.MergeCartsMock.callArgs = []*CartsRepositoryMockMergeCartsParams{}
+
+	m.ProlongGuestCartMock = mCartsRepositoryMockProlongGuestCart{mock: m}
+	m.ProlongGuestCartMock.callArgs = []*CartsRepositoryMockProlongGuestCartParams{}
+
+	m.SaveItemMock = mCartsRepositoryMockSaveItem{mock: m}
+	m.SaveItemMock.callArgs = []*CartsRepositoryMockSaveItemParams{}
+
+	m.SavePurchaseMock = mCartsRepositoryMockSavePurchase{mock: m}
+	m.SavePurchaseMock.callArgs = []*CartsRepositoryMockSavePurchaseParams{}
+
+	m.SetCartItemCountMock = mCartsRepositoryMockSetCartItemCount{mock: m}
+	m.SetCartItemCountMock.callArgs = []*CartsRepositoryMockSetCartItemCountParams{}
+
 	return m
 }
 
@@ -93,7 +202,7 @@ type CartsRepositoryMockAddToCartExpectation struct {
 // CartsRepositoryMockAddToCartParams contains parameters of the CartsRepository.AddToCart
 type CartsRepositoryMockAddToCartParams struct {
 	ctx   context.Context
-	user  int64
+	owner CartOwner
 	sku   uint32
 	count uint16
 }
@@ -104,7 +213,7 @@ type CartsRepositoryMockAddToCartResults struct {
 }
 
 // Expect sets up expected params for CartsRepository.AddToCart
-func (mmAddToCart *mCartsRepositoryMockAddToCart) Expect(ctx context.Context, user int64, sku uint32, count uint16) *mCartsRepositoryMockAddToCart {
+func (mmAddToCart *mCartsRepositoryMockAddToCart) Expect(ctx context.Context, owner CartOwner, sku uint32, count uint16) *mCartsRepositoryMockAddToCart {
 	if mmAddToCart.mock.funcAddToCart != nil {
 		mmAddToCart.mock.t.Fatalf("CartsRepositoryMock.AddToCart mock is already set by Set")
 	}
@@ -113,7 +222,7 @@ func (mmAddToCart *mCartsRepositoryMockAddToCart) Expect(ctx context.Context, us
 		mmAddToCart.defaultExpectation = &CartsRepositoryMockAddToCartExpectation{}
 	}
 
-	mmAddToCart.defaultExpectation.params = &CartsRepositoryMockAddToCartParams{ctx, user, sku, count}
+	mmAddToCart.defaultExpectation.params = &CartsRepositoryMockAddToCartParams{ctx, owner, sku, count}
 	for _, e := range mmAddToCart.expectations {
 		if minimock.Equal(e.params, mmAddToCart.defaultExpectation.params) {
 			mmAddToCart.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddToCart.defaultExpectation.params)
@@ -124,7 +233,7 @@ func (mmAddToCart *mCartsRepositoryMockAddToCart) Expect(ctx context.Context, us
 }
 
 // Inspect accepts an inspector function that has same arguments as the CartsRepository.AddToCart
-func (mmAddToCart *mCartsRepositoryMockAddToCart) Inspect(f func(ctx context.Context, user int64, sku uint32, count uint16)) *mCartsRepositoryMockAddToCart {
+func (mmAddToCart *mCartsRepositoryMockAddToCart) Inspect(f func(ctx context.Context, owner CartOwner, sku uint32, count uint16)) *mCartsRepositoryMockAddToCart {
 	if mmAddToCart.mock.inspectFuncAddToCart != nil {
 		mmAddToCart.mock.t.Fatalf("Inspect function is already set for CartsRepositoryMock.AddToCart")
 	}
@@ -148,7 +257,7 @@ func (mmAddToCart *mCartsRepositoryMockAddToCart) Return(err error) *CartsReposi
 }
 
 // Set uses given function f to mock the CartsRepository.AddToCart method
-func (mmAddToCart *mCartsRepositoryMockAddToCart) Set(f func(ctx context.Context, user int64, sku uint32, count uint16) (err error)) *CartsRepositoryMock {
+func (mmAddToCart *mCartsRepositoryMockAddToCart) Set(f func(ctx context.Context, owner CartOwner, sku uint32, count uint16) (err error)) *CartsRepositoryMock {
 	if mmAddToCart.defaultExpectation != nil {
 		mmAddToCart.mock.t.Fatalf("Default expectation is already set for the CartsRepository.AddToCart method")
 	}
@@ -163,14 +272,14 @@ func (mmAddToCart *mCartsRepositoryMockAddToCart) Set(f func(ctx context.Context
 
 // When sets expectation for the CartsRepository.AddToCart which will trigger the result defined by the following
 // Then helper
-func (mmAddToCart *mCartsRepositoryMockAddToCart) When(ctx context.Context, user int64, sku uint32, count uint16) *CartsRepositoryMockAddToCartExpectation {
+func (mmAddToCart *mCartsRepositoryMockAddToCart) When(ctx context.Context, owner CartOwner, sku uint32, count uint16) *CartsRepositoryMockAddToCartExpectation {
 	if mmAddToCart.mock.funcAddToCart != nil {
 		mmAddToCart.mock.t.Fatalf("CartsRepositoryMock.AddToCart mock is already set by Set")
 	}
 
 	expectation := &CartsRepositoryMockAddToCartExpectation{
 		mock:   mmAddToCart.mock,
-		params: &CartsRepositoryMockAddToCartParams{ctx, user, sku, count},
+		params: &CartsRepositoryMockAddToCartParams{ctx, owner, sku, count},
 	}
 	mmAddToCart.expectations = append(mmAddToCart.expectations, expectation)
 	return expectation
@@ -183,15 +292,15 @@ func (e *CartsRepositoryMockAddToCartExpectation) Then(err error) *CartsReposito
 }
 
 // AddToCart implements CartsRepository
-func (mmAddToCart *CartsRepositoryMock) AddToCart(ctx context.Context, user int64, sku uint32, count uint16) (err error) {
+func (mmAddToCart *CartsRepositoryMock) AddToCart(ctx context.Context, owner CartOwner, sku uint32, count uint16) (err error) {
 	mm_atomic.AddUint64(&mmAddToCart.beforeAddToCartCounter, 1)
 	defer mm_atomic.AddUint64(&mmAddToCart.afterAddToCartCounter, 1)
 
 	if mmAddToCart.inspectFuncAddToCart != nil {
-		mmAddToCart.inspectFuncAddToCart(ctx, user, sku, count)
+		mmAddToCart.inspectFuncAddToCart(ctx, owner, sku, count)
 	}
 
-	mm_params := &CartsRepositoryMockAddToCartParams{ctx, user, sku, count}
+	mm_params := &CartsRepositoryMockAddToCartParams{ctx, owner, sku, count}
 
 	// Record call args
 	mmAddToCart.AddToCartMock.mutex.Lock()
@@ -208,7 +317,7 @@ func (mmAddToCart *CartsRepositoryMock) AddToCart(ctx context.Context, user int6
 	if mmAddToCart.AddToCartMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmAddToCart.AddToCartMock.defaultExpectation.Counter, 1)
 		mm_want := mmAddToCart.AddToCartMock.defaultExpectation.params
-		mm_got := CartsRepositoryMockAddToCartParams{ctx, user, sku, count}
+		mm_got := CartsRepositoryMockAddToCartParams{ctx, owner, sku, count}
 		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmAddToCart.t.Errorf("CartsRepositoryMock.AddToCart got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
@@ -220,9 +329,9 @@ func (mmAddToCart *CartsRepositoryMock) AddToCart(ctx context.Context, user int6
 		return (*mm_results).err
 	}
 	if mmAddToCart.funcAddToCart != nil {
-		return mmAddToCart.funcAddToCart(ctx, user, sku, count)
+		return mmAddToCart.funcAddToCart(ctx, owner, sku, count)
 	}
-	mmAddToCart.t.Fatalf("Unexpected call to CartsRepositoryMock.AddToCart. %v %v %v %v", ctx, user, sku, count)
+	mmAddToCart.t.Fatalf("Unexpected call to CartsRepositoryMock.AddToCart. %v %v %v %v", ctx, owner, sku, count)
 	return
 }
 
@@ -291,6 +400,224 @@ func (m *CartsRepositoryMock) MinimockAddToCartInspect() {
 	}
 }
 
+type mCartsRepositoryMockCreateGuestCart struct {
+	mock               *CartsRepositoryMock
+	defaultExpectation *CartsRepositoryMockCreateGuestCartExpectation
+	expectations       []*CartsRepositoryMockCreateGuestCartExpectation
+
+	callArgs []*CartsRepositoryMockCreateGuestCartParams
+	mutex    sync.RWMutex
+}
+
+// CartsRepositoryMockCreateGuestCartExpectation specifies expectation struct of the CartsRepository.CreateGuestCart
+type CartsRepositoryMockCreateGuestCartExpectation struct {
+	mock    *CartsRepositoryMock
+	params  *CartsRepositoryMockCreateGuestCartParams
+	results *CartsRepositoryMockCreateGuestCartResults
+	Counter uint64
+}
+
+// CartsRepositoryMockCreateGuestCartParams contains parameters of the CartsRepository.CreateGuestCart
+type CartsRepositoryMockCreateGuestCartParams struct {
+	ctx       context.Context
+	sessionID string
+	expiresAt time.Time
+}
+
+// CartsRepositoryMockCreateGuestCartResults contains results of the CartsRepository.CreateGuestCart
+type CartsRepositoryMockCreateGuestCartResults struct {
+	i1  int64
+	err error
+}
+
+// Expect sets up expected params for CartsRepository.CreateGuestCart
+func (mmCreateGuestCart *mCartsRepositoryMockCreateGuestCart) Expect(ctx context.Context, sessionID string, expiresAt time.Time) *mCartsRepositoryMockCreateGuestCart {
+	if mmCreateGuestCart.mock.funcCreateGuestCart != nil {
+		mmCreateGuestCart.mock.t.Fatalf("CartsRepositoryMock.CreateGuestCart mock is already set by Set")
+	}
+
+	if mmCreateGuestCart.defaultExpectation == nil {
+		mmCreateGuestCart.defaultExpectation = &CartsRepositoryMockCreateGuestCartExpectation{}
+	}
+
+	mmCreateGuestCart.defaultExpectation.params = &CartsRepositoryMockCreateGuestCartParams{ctx, sessionID, expiresAt}
+	for _, e := range mmCreateGuestCart.expectations {
+		if minimock.Equal(e.params, mmCreateGuestCart.defaultExpectation.params) {
+			mmCreateGuestCart.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateGuestCart.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateGuestCart
+}
+
+// Inspect accepts an inspector function that has same arguments as the CartsRepository.CreateGuestCart
+func (mmCreateGuestCart *mCartsRepositoryMockCreateGuestCart) Inspect(f func(ctx context.Context, sessionID string, expiresAt time.Time)) *mCartsRepositoryMockCreateGuestCart {
+	if mmCreateGuestCart.mock.inspectFuncCreateGuestCart != nil {
+		mmCreateGuestCart.mock.t.Fatalf("Inspect function is already set for CartsRepositoryMock.CreateGuestCart")
+	}
+
+	mmCreateGuestCart.mock.inspectFuncCreateGuestCart = f
+
+	return mmCreateGuestCart
+}
+
+// Return sets up results that will be returned by CartsRepository.CreateGuestCart
+func (mmCreateGuestCart *mCartsRepositoryMockCreateGuestCart) Return(i1 int64, err error) *CartsRepositoryMock {
+	if mmCreateGuestCart.mock.funcCreateGuestCart != nil {
+		mmCreateGuestCart.mock.t.Fatalf("CartsRepositoryMock.CreateGuestCart mock is already set by Set")
+	}
+
+	if mmCreateGuestCart.defaultExpectation == nil {
+		mmCreateGuestCart.defaultExpectation = &CartsRepositoryMockCreateGuestCartExpectation{mock: mmCreateGuestCart.mock}
+	}
+	mmCreateGuestCart.defaultExpectation.results = &CartsRepositoryMockCreateGuestCartResults{i1, err}
+	return mmCreateGuestCart.mock
+}
+
+// Set uses given function f to mock the CartsRepository.CreateGuestCart method
+func (mmCreateGuestCart *mCartsRepositoryMockCreateGuestCart) Set(f func(ctx context.Context, sessionID string, expiresAt time.Time) (i1 int64, err error)) *CartsRepositoryMock {
+	if mmCreateGuestCart.defaultExpectation != nil {
+		mmCreateGuestCart.mock.t.Fatalf("Default expectation is already set for the CartsRepository.CreateGuestCart method")
+	}
+
+	if len(mmCreateGuestCart.expectations) > 0 {
+		mmCreateGuestCart.mock.t.Fatalf("Some expectations are already set for the CartsRepository.CreateGuestCart method")
+	}
+
+	mmCreateGuestCart.mock.funcCreateGuestCart = f
+	return mmCreateGuestCart.mock
+}
+
+// When sets expectation for the CartsRepository.CreateGuestCart which will trigger the result defined by the following
+// Then helper
+func (mmCreateGuestCart *mCartsRepositoryMockCreateGuestCart) When(ctx context.Context, sessionID string, expiresAt time.Time) *CartsRepositoryMockCreateGuestCartExpectation {
+	if mmCreateGuestCart.mock.funcCreateGuestCart != nil {
+		mmCreateGuestCart.mock.t.Fatalf("CartsRepositoryMock.CreateGuestCart mock is already set by Set")
+	}
+
+	expectation := &CartsRepositoryMockCreateGuestCartExpectation{
+		mock:   mmCreateGuestCart.mock,
+		params: &CartsRepositoryMockCreateGuestCartParams{ctx, sessionID, expiresAt},
+	}
+	mmCreateGuestCart.expectations = append(mmCreateGuestCart.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CartsRepository.CreateGuestCart return parameters for the expectation previously defined by the When method
+func (e *CartsRepositoryMockCreateGuestCartExpectation) Then(i1 int64, err error) *CartsRepositoryMock {
+	e.results = &CartsRepositoryMockCreateGuestCartResults{i1, err}
+	return e.mock
+}
+
+// CreateGuestCart implements CartsRepository
+func (mmCreateGuestCart *CartsRepositoryMock) CreateGuestCart(ctx context.Context, sessionID string, expiresAt time.Time) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreateGuestCart.beforeCreateGuestCartCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateGuestCart.afterCreateGuestCartCounter, 1)
+
+	if mmCreateGuestCart.inspectFuncCreateGuestCart != nil {
+		mmCreateGuestCart.inspectFuncCreateGuestCart(ctx, sessionID, expiresAt)
+	}
+
+	mm_params := &CartsRepositoryMockCreateGuestCartParams{ctx, sessionID, expiresAt}
+
+	// Record call args
+	mmCreateGuestCart.CreateGuestCartMock.mutex.Lock()
+	mmCreateGuestCart.CreateGuestCartMock.callArgs = append(mmCreateGuestCart.CreateGuestCartMock.callArgs, mm_params)
+	mmCreateGuestCart.CreateGuestCartMock.mutex.Unlock()
+
+	for _, e := range mmCreateGuestCart.CreateGuestCartMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreateGuestCart.CreateGuestCartMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateGuestCart.CreateGuestCartMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateGuestCart.CreateGuestCartMock.defaultExpectation.params
+		mm_got := CartsRepositoryMockCreateGuestCartParams{ctx, sessionID, expiresAt}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateGuestCart.t.Errorf("CartsRepositoryMock.CreateGuestCart got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateGuestCart.CreateGuestCartMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateGuestCart.t.Fatal("No results are set for the CartsRepositoryMock.CreateGuestCart")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreateGuestCart.funcCreateGuestCart != nil {
+		return mmCreateGuestCart.funcCreateGuestCart(ctx, sessionID, expiresAt)
+	}
+	mmCreateGuestCart.t.Fatalf("Unexpected call to CartsRepositoryMock.CreateGuestCart. %v %v %v", ctx, sessionID, expiresAt)
+	return
+}
+
+// CreateGuestCartAfterCounter returns a count of finished CartsRepositoryMock.CreateGuestCart invocations
+func (mmCreateGuestCart *CartsRepositoryMock) CreateGuestCartAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateGuestCart.afterCreateGuestCartCounter)
+}
+
+// CreateGuestCartBeforeCounter returns a count of CartsRepositoryMock.CreateGuestCart invocations
+func (mmCreateGuestCart *CartsRepositoryMock) CreateGuestCartBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateGuestCart.beforeCreateGuestCartCounter)
+}
+
+// Calls returns a list of arguments used in each call to CartsRepositoryMock.CreateGuestCart.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateGuestCart *mCartsRepositoryMockCreateGuestCart) Calls() []*CartsRepositoryMockCreateGuestCartParams {
+	mmCreateGuestCart.mutex.RLock()
+
+	argCopy := make([]*CartsRepositoryMockCreateGuestCartParams, len(mmCreateGuestCart.callArgs))
+	copy(argCopy, mmCreateGuestCart.callArgs)
+
+	mmCreateGuestCart.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateGuestCartDone returns true if the count of the CreateGuestCart invocations corresponds
+// the number of defined expectations
+func (m *CartsRepositoryMock) MinimockCreateGuestCartDone() bool {
+	for _, e := range m.CreateGuestCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateGuestCartMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateGuestCartCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateGuestCart != nil && mm_atomic.LoadUint64(&m.afterCreateGuestCartCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockCreateGuestCartInspect logs each unmet expectation
+func (m *CartsRepositoryMock) MinimockCreateGuestCartInspect() {
+	for _, e := range m.CreateGuestCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CartsRepositoryMock.CreateGuestCart with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateGuestCartMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateGuestCartCounter) < 1 {
+		if m.CreateGuestCartMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to CartsRepositoryMock.CreateGuestCart")
+		} else {
+			m.t.Errorf("Expected call to CartsRepositoryMock.CreateGuestCart with params: %#v", *m.CreateGuestCartMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateGuestCart != nil && mm_atomic.LoadUint64(&m.afterCreateGuestCartCounter) < 1 {
+		m.t.Error("Expected call to CartsRepositoryMock.CreateGuestCart")
+	}
+}
+
 type mCartsRepositoryMockDeleteCart struct {
 	mock               *CartsRepositoryMock
 	defaultExpectation *CartsRepositoryMockDeleteCartExpectation
@@ -310,8 +637,8 @@ type CartsRepositoryMockDeleteCartExpectation struct {
 
 // CartsRepositoryMockDeleteCartParams contains parameters of the CartsRepository.DeleteCart
 type CartsRepositoryMockDeleteCartParams struct {
-	ctx  context.Context
-	user int64
+	ctx   context.Context
+	owner CartOwner
 }
 
 // CartsRepositoryMockDeleteCartResults contains results of the CartsRepository.DeleteCart
@@ -320,7 +647,7 @@ type CartsRepositoryMockDeleteCartResults struct {
 }
 
 // Expect sets up expected params for CartsRepository.DeleteCart
-func (mmDeleteCart *mCartsRepositoryMockDeleteCart) Expect(ctx context.Context, user int64) *mCartsRepositoryMockDeleteCart {
+func (mmDeleteCart *mCartsRepositoryMockDeleteCart) Expect(ctx context.Context, owner CartOwner) *mCartsRepositoryMockDeleteCart {
 	if mmDeleteCart.mock.funcDeleteCart != nil {
 		mmDeleteCart.mock.t.Fatalf("CartsRepositoryMock.DeleteCart mock is already set by Set")
 	}
@@ -329,7 +656,7 @@ func (mmDeleteCart *mCartsRepositoryMockDeleteCart) Expect(ctx context.Context,
 		mmDeleteCart.defaultExpectation = &CartsRepositoryMockDeleteCartExpectation{}
 	}
 
-	mmDeleteCart.defaultExpectation.params = &CartsRepositoryMockDeleteCartParams{ctx, user}
+	mmDeleteCart.defaultExpectation.params = &CartsRepositoryMockDeleteCartParams{ctx, owner}
 	for _, e := range mmDeleteCart.expectations {
 		if minimock.Equal(e.params, mmDeleteCart.defaultExpectation.params) {
 			mmDeleteCart.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteCart.defaultExpectation.params)
@@ -340,7 +667,7 @@ func (mmDeleteCart *mCartsRepositoryMockDeleteCart) Expect(ctx context.Context,
 }
 
 // Inspect accepts an inspector function that has same arguments as the CartsRepository.DeleteCart
-func (mmDeleteCart *mCartsRepositoryMockDeleteCart) Inspect(f func(ctx context.Context, user int64)) *mCartsRepositoryMockDeleteCart {
+func (mmDeleteCart *mCartsRepositoryMockDeleteCart) Inspect(f func(ctx context.Context, owner CartOwner)) *mCartsRepositoryMockDeleteCart {
 	if mmDeleteCart.mock.inspectFuncDeleteCart != nil {
 		mmDeleteCart.mock.t.Fatalf("Inspect function is already set for CartsRepositoryMock.DeleteCart")
 	}
@@ -364,7 +691,7 @@ func (mmDeleteCart *mCartsRepositoryMockDeleteCart) Return(err error) *CartsRepo
 }
 
 // Set uses given function f to mock the CartsRepository.DeleteCart method
-func (mmDeleteCart *mCartsRepositoryMockDeleteCart) Set(f func(ctx context.Context, user int64) (err error)) *CartsRepositoryMock {
+func (mmDeleteCart *mCartsRepositoryMockDeleteCart) Set(f func(ctx context.Context, owner CartOwner) (err error)) *CartsRepositoryMock {
 	if mmDeleteCart.defaultExpectation != nil {
 		mmDeleteCart.mock.t.Fatalf("Default expectation is already set for the CartsRepository.DeleteCart method")
 	}
@@ -379,14 +706,14 @@ func (mmDeleteCart *mCartsRepositoryMockDeleteCart) Set(f func(ctx context.Conte
 
 // When sets expectation for the CartsRepository.DeleteCart which will trigger the result defined by the following
 // Then helper
-func (mmDeleteCart *mCartsRepositoryMockDeleteCart) When(ctx context.Context, user int64) *CartsRepositoryMockDeleteCartExpectation {
+func (mmDeleteCart *mCartsRepositoryMockDeleteCart) When(ctx context.Context, owner CartOwner) *CartsRepositoryMockDeleteCartExpectation {
 	if mmDeleteCart.mock.funcDeleteCart != nil {
 		mmDeleteCart.mock.t.Fatalf("CartsRepositoryMock.DeleteCart mock is already set by Set")
 	}
 
 	expectation := &CartsRepositoryMockDeleteCartExpectation{
 		mock:   mmDeleteCart.mock,
-		params: &CartsRepositoryMockDeleteCartParams{ctx, user},
+		params: &CartsRepositoryMockDeleteCartParams{ctx, owner},
 	}
 	mmDeleteCart.expectations = append(mmDeleteCart.expectations, expectation)
 	return expectation
@@ -399,15 +726,15 @@ func (e *CartsRepositoryMockDeleteCartExpectation) Then(err error) *CartsReposit
 }
 
 // DeleteCart implements CartsRepository
-func (mmDeleteCart *CartsRepositoryMock) DeleteCart(ctx context.Context, user int64) (err error) {
+func (mmDeleteCart *CartsRepositoryMock) DeleteCart(ctx context.Context, owner CartOwner) (err error) {
 	mm_atomic.AddUint64(&mmDeleteCart.beforeDeleteCartCounter, 1)
 	defer mm_atomic.AddUint64(&mmDeleteCart.afterDeleteCartCounter, 1)
 
 	if mmDeleteCart.inspectFuncDeleteCart != nil {
-		mmDeleteCart.inspectFuncDeleteCart(ctx, user)
+		mmDeleteCart.inspectFuncDeleteCart(ctx, owner)
 	}
 
-	mm_params := &CartsRepositoryMockDeleteCartParams{ctx, user}
+	mm_params := &CartsRepositoryMockDeleteCartParams{ctx, owner}
 
 	// Record call args
 	mmDeleteCart.DeleteCartMock.mutex.Lock()
@@ -424,7 +751,7 @@ func (mmDeleteCart *CartsRepositoryMock) DeleteCart(ctx context.Context, user in
 	if mmDeleteCart.DeleteCartMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmDeleteCart.DeleteCartMock.defaultExpectation.Counter, 1)
 		mm_want := mmDeleteCart.DeleteCartMock.defaultExpectation.params
-		mm_got := CartsRepositoryMockDeleteCartParams{ctx, user}
+		mm_got := CartsRepositoryMockDeleteCartParams{ctx, owner}
 		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmDeleteCart.t.Errorf("CartsRepositoryMock.DeleteCart got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
@@ -436,9 +763,9 @@ func (mmDeleteCart *CartsRepositoryMock) DeleteCart(ctx context.Context, user in
 		return (*mm_results).err
 	}
 	if mmDeleteCart.funcDeleteCart != nil {
-		return mmDeleteCart.funcDeleteCart(ctx, user)
+		return mmDeleteCart.funcDeleteCart(ctx, owner)
 	}
-	mmDeleteCart.t.Fatalf("Unexpected call to CartsRepositoryMock.DeleteCart. %v %v", ctx, user)
+	mmDeleteCart.t.Fatalf("Unexpected call to CartsRepositoryMock.DeleteCart. %v %v", ctx, owner)
 	return
 }
 
@@ -507,6 +834,222 @@ func (m *CartsRepositoryMock) MinimockDeleteCartInspect() {
 	}
 }
 
+type mCartsRepositoryMockDeleteExpiredGuestCarts struct {
+	mock               *CartsRepositoryMock
+	defaultExpectation *CartsRepositoryMockDeleteExpiredGuestCartsExpectation
+	expectations       []*CartsRepositoryMockDeleteExpiredGuestCartsExpectation
+
+	callArgs []*CartsRepositoryMockDeleteExpiredGuestCartsParams
+	mutex    sync.RWMutex
+}
+
+// CartsRepositoryMockDeleteExpiredGuestCartsExpectation specifies expectation struct of the CartsRepository.DeleteExpiredGuestCarts
+type CartsRepositoryMockDeleteExpiredGuestCartsExpectation struct {
+	mock    *CartsRepositoryMock
+	params  *CartsRepositoryMockDeleteExpiredGuestCartsParams
+	results *CartsRepositoryMockDeleteExpiredGuestCartsResults
+	Counter uint64
+}
+
+// CartsRepositoryMockDeleteExpiredGuestCartsParams contains parameters of the CartsRepository.DeleteExpiredGuestCarts
+type CartsRepositoryMockDeleteExpiredGuestCartsParams struct {
+	ctx context.Context
+	now time.Time
+}
+
+// CartsRepositoryMockDeleteExpiredGuestCartsResults contains results of the CartsRepository.DeleteExpiredGuestCarts
+type CartsRepositoryMockDeleteExpiredGuestCartsResults struct {
+	err error
+}
+
+// Expect sets up expected params for CartsRepository.DeleteExpiredGuestCarts
+func (mmDeleteExpiredGuestCarts *mCartsRepositoryMockDeleteExpiredGuestCarts) Expect(ctx context.Context, now time.Time) *mCartsRepositoryMockDeleteExpiredGuestCarts {
+	if mmDeleteExpiredGuestCarts.mock.funcDeleteExpiredGuestCarts != nil {
+		mmDeleteExpiredGuestCarts.mock.t.Fatalf("CartsRepositoryMock.DeleteExpiredGuestCarts mock is already set by Set")
+	}
+
+	if mmDeleteExpiredGuestCarts.defaultExpectation == nil {
+		mmDeleteExpiredGuestCarts.defaultExpectation = &CartsRepositoryMockDeleteExpiredGuestCartsExpectation{}
+	}
+
+	mmDeleteExpiredGuestCarts.defaultExpectation.params = &CartsRepositoryMockDeleteExpiredGuestCartsParams{ctx, now}
+	for _, e := range mmDeleteExpiredGuestCarts.expectations {
+		if minimock.Equal(e.params, mmDeleteExpiredGuestCarts.defaultExpectation.params) {
+			mmDeleteExpiredGuestCarts.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteExpiredGuestCarts.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteExpiredGuestCarts
+}
+
+// Inspect accepts an inspector function that has same arguments as the CartsRepository.DeleteExpiredGuestCarts
+func (mmDeleteExpiredGuestCarts *mCartsRepositoryMockDeleteExpiredGuestCarts) Inspect(f func(ctx context.Context, now time.Time)) *mCartsRepositoryMockDeleteExpiredGuestCarts {
+	if mmDeleteExpiredGuestCarts.mock.inspectFuncDeleteExpiredGuestCarts != nil {
+		mmDeleteExpiredGuestCarts.mock.t.Fatalf("Inspect function is already set for CartsRepositoryMock.DeleteExpiredGuestCarts")
+	}
+
+	mmDeleteExpiredGuestCarts.mock.inspectFuncDeleteExpiredGuestCarts = f
+
+	return mmDeleteExpiredGuestCarts
+}
+
+// Return sets up results that will be returned by CartsRepository.DeleteExpiredGuestCarts
+func (mmDeleteExpiredGuestCarts *mCartsRepositoryMockDeleteExpiredGuestCarts) Return(err error) *CartsRepositoryMock {
+	if mmDeleteExpiredGuestCarts.mock.funcDeleteExpiredGuestCarts != nil {
+		mmDeleteExpiredGuestCarts.mock.t.Fatalf("CartsRepositoryMock.DeleteExpiredGuestCarts mock is already set by Set")
+	}
+
+	if mmDeleteExpiredGuestCarts.defaultExpectation == nil {
+		mmDeleteExpiredGuestCarts.defaultExpectation = &CartsRepositoryMockDeleteExpiredGuestCartsExpectation{mock: mmDeleteExpiredGuestCarts.mock}
+	}
+	mmDeleteExpiredGuestCarts.defaultExpectation.results = &CartsRepositoryMockDeleteExpiredGuestCartsResults{err}
+	return mmDeleteExpiredGuestCarts.mock
+}
+
+// Set uses given function f to mock the CartsRepository.DeleteExpiredGuestCarts method
+func (mmDeleteExpiredGuestCarts *mCartsRepositoryMockDeleteExpiredGuestCarts) Set(f func(ctx context.Context, now time.Time) (err error)) *CartsRepositoryMock {
+	if mmDeleteExpiredGuestCarts.defaultExpectation != nil {
+		mmDeleteExpiredGuestCarts.mock.t.Fatalf("Default expectation is already set for the CartsRepository.DeleteExpiredGuestCarts method")
+	}
+
+	if len(mmDeleteExpiredGuestCarts.expectations) > 0 {
+		mmDeleteExpiredGuestCarts.mock.t.Fatalf("Some expectations are already set for the CartsRepository.DeleteExpiredGuestCarts method")
+	}
+
+	mmDeleteExpiredGuestCarts.mock.funcDeleteExpiredGuestCarts = f
+	return mmDeleteExpiredGuestCarts.mock
+}
+
+// When sets expectation for the CartsRepository.DeleteExpiredGuestCarts which will trigger the result defined by the following
+// Then helper
+func (mmDeleteExpiredGuestCarts *mCartsRepositoryMockDeleteExpiredGuestCarts) When(ctx context.Context, now time.Time) *CartsRepositoryMockDeleteExpiredGuestCartsExpectation {
+	if mmDeleteExpiredGuestCarts.mock.funcDeleteExpiredGuestCarts != nil {
+		mmDeleteExpiredGuestCarts.mock.t.Fatalf("CartsRepositoryMock.DeleteExpiredGuestCarts mock is already set by Set")
+	}
+
+	expectation := &CartsRepositoryMockDeleteExpiredGuestCartsExpectation{
+		mock:   mmDeleteExpiredGuestCarts.mock,
+		params: &CartsRepositoryMockDeleteExpiredGuestCartsParams{ctx, now},
+	}
+	mmDeleteExpiredGuestCarts.expectations = append(mmDeleteExpiredGuestCarts.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CartsRepository.DeleteExpiredGuestCarts return parameters for the expectation previously defined by the When method
+func (e *CartsRepositoryMockDeleteExpiredGuestCartsExpectation) Then(err error) *CartsRepositoryMock {
+	e.results = &CartsRepositoryMockDeleteExpiredGuestCartsResults{err}
+	return e.mock
+}
+
+// DeleteExpiredGuestCarts implements CartsRepository
+func (mmDeleteExpiredGuestCarts *CartsRepositoryMock) DeleteExpiredGuestCarts(ctx context.Context, now time.Time) (err error) {
+	mm_atomic.AddUint64(&mmDeleteExpiredGuestCarts.beforeDeleteExpiredGuestCartsCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteExpiredGuestCarts.afterDeleteExpiredGuestCartsCounter, 1)
+
+	if mmDeleteExpiredGuestCarts.inspectFuncDeleteExpiredGuestCarts != nil {
+		mmDeleteExpiredGuestCarts.inspectFuncDeleteExpiredGuestCarts(ctx, now)
+	}
+
+	mm_params := &CartsRepositoryMockDeleteExpiredGuestCartsParams{ctx, now}
+
+	// Record call args
+	mmDeleteExpiredGuestCarts.DeleteExpiredGuestCartsMock.mutex.Lock()
+	mmDeleteExpiredGuestCarts.DeleteExpiredGuestCartsMock.callArgs = append(mmDeleteExpiredGuestCarts.DeleteExpiredGuestCartsMock.callArgs, mm_params)
+	mmDeleteExpiredGuestCarts.DeleteExpiredGuestCartsMock.mutex.Unlock()
+
+	for _, e := range mmDeleteExpiredGuestCarts.DeleteExpiredGuestCartsMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteExpiredGuestCarts.DeleteExpiredGuestCartsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteExpiredGuestCarts.DeleteExpiredGuestCartsMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteExpiredGuestCarts.DeleteExpiredGuestCartsMock.defaultExpectation.params
+		mm_got := CartsRepositoryMockDeleteExpiredGuestCartsParams{ctx, now}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteExpiredGuestCarts.t.Errorf("CartsRepositoryMock.DeleteExpiredGuestCarts got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteExpiredGuestCarts.DeleteExpiredGuestCartsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteExpiredGuestCarts.t.Fatal("No results are set for the CartsRepositoryMock.DeleteExpiredGuestCarts")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteExpiredGuestCarts.funcDeleteExpiredGuestCarts != nil {
+		return mmDeleteExpiredGuestCarts.funcDeleteExpiredGuestCarts(ctx, now)
+	}
+	mmDeleteExpiredGuestCarts.t.Fatalf("Unexpected call to CartsRepositoryMock.DeleteExpiredGuestCarts. %v %v", ctx, now)
+	return
+}
+
+// DeleteExpiredGuestCartsAfterCounter returns a count of finished CartsRepositoryMock.DeleteExpiredGuestCarts invocations
+func (mmDeleteExpiredGuestCarts *CartsRepositoryMock) DeleteExpiredGuestCartsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteExpiredGuestCarts.afterDeleteExpiredGuestCartsCounter)
+}
+
+// DeleteExpiredGuestCartsBeforeCounter returns a count of CartsRepositoryMock.DeleteExpiredGuestCarts invocations
+func (mmDeleteExpiredGuestCarts *CartsRepositoryMock) DeleteExpiredGuestCartsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteExpiredGuestCarts.beforeDeleteExpiredGuestCartsCounter)
+}
+
+// Calls returns a list of arguments used in each call to CartsRepositoryMock.DeleteExpiredGuestCarts.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteExpiredGuestCarts *mCartsRepositoryMockDeleteExpiredGuestCarts) Calls() []*CartsRepositoryMockDeleteExpiredGuestCartsParams {
+	mmDeleteExpiredGuestCarts.mutex.RLock()
+
+	argCopy := make([]*CartsRepositoryMockDeleteExpiredGuestCartsParams, len(mmDeleteExpiredGuestCarts.callArgs))
+	copy(argCopy, mmDeleteExpiredGuestCarts.callArgs)
+
+	mmDeleteExpiredGuestCarts.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteExpiredGuestCartsDone returns true if the count of the DeleteExpiredGuestCarts invocations corresponds
+// the number of defined expectations
+func (m *CartsRepositoryMock) MinimockDeleteExpiredGuestCartsDone() bool {
+	for _, e := range m.DeleteExpiredGuestCartsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteExpiredGuestCartsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeleteExpiredGuestCartsCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteExpiredGuestCarts != nil && mm_atomic.LoadUint64(&m.afterDeleteExpiredGuestCartsCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockDeleteExpiredGuestCartsInspect logs each unmet expectation
+func (m *CartsRepositoryMock) MinimockDeleteExpiredGuestCartsInspect() {
+	for _, e := range m.DeleteExpiredGuestCartsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CartsRepositoryMock.DeleteExpiredGuestCarts with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteExpiredGuestCartsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeleteExpiredGuestCartsCounter) < 1 {
+		if m.DeleteExpiredGuestCartsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to CartsRepositoryMock.DeleteExpiredGuestCarts")
+		} else {
+			m.t.Errorf("Expected call to CartsRepositoryMock.DeleteExpiredGuestCarts with params: %#v", *m.DeleteExpiredGuestCartsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteExpiredGuestCarts != nil && mm_atomic.LoadUint64(&m.afterDeleteExpiredGuestCartsCounter) < 1 {
+		m.t.Error("Expected call to CartsRepositoryMock.DeleteExpiredGuestCarts")
+	}
+}
+
 type mCartsRepositoryMockDeleteFromCart struct {
 	mock               *CartsRepositoryMock
 	defaultExpectation *CartsRepositoryMockDeleteFromCartExpectation
@@ -527,7 +1070,7 @@ type CartsRepositoryMockDeleteFromCartExpectation struct {
 // CartsRepositoryMockDeleteFromCartParams contains parameters of the CartsRepository.DeleteFromCart
 type CartsRepositoryMockDeleteFromCartParams struct {
 	ctx   context.Context
-	user  int64
+	owner CartOwner
 	sku   uint32
 	count uint16
 	full  bool
@@ -539,7 +1082,7 @@ type CartsRepositoryMockDeleteFromCartResults struct {
 }
 
 // Expect sets up expected params for CartsRepository.DeleteFromCart
-func (mmDeleteFromCart *mCartsRepositoryMockDeleteFromCart) Expect(ctx context.Context, user int64, sku uint32, count uint16, full bool) *mCartsRepositoryMockDeleteFromCart {
+func (mmDeleteFromCart *mCartsRepositoryMockDeleteFromCart) Expect(ctx context.Context, owner CartOwner, sku uint32, count uint16, full bool) *mCartsRepositoryMockDeleteFromCart {
 	if mmDeleteFromCart.mock.funcDeleteFromCart != nil {
 		mmDeleteFromCart.mock.t.Fatalf("CartsRepositoryMock.DeleteFromCart mock is already set by Set")
 	}
@@ -548,7 +1091,7 @@ func (mmDeleteFromCart *mCartsRepositoryMockDeleteFromCart) Expect(ctx context.C
 		mmDeleteFromCart.defaultExpectation = &CartsRepositoryMockDeleteFromCartExpectation{}
 	}
 
-	mmDeleteFromCart.defaultExpectation.params = &CartsRepositoryMockDeleteFromCartParams{ctx, user, sku, count, full}
+	mmDeleteFromCart.defaultExpectation.params = &CartsRepositoryMockDeleteFromCartParams{ctx, owner, sku, count, full}
 	for _, e := range mmDeleteFromCart.expectations {
 		if minimock.Equal(e.params, mmDeleteFromCart.defaultExpectation.params) {
 			mmDeleteFromCart.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteFromCart.defaultExpectation.params)
@@ -559,7 +1102,7 @@ func (mmDeleteFromCart *mCartsRepositoryMockDeleteFromCart) Expect(ctx context.C
 }
 
 // Inspect accepts an inspector function that has same arguments as the CartsRepository.DeleteFromCart
-func (mmDeleteFromCart *mCartsRepositoryMockDeleteFromCart) Inspect(f func(ctx context.Context, user int64, sku uint32, count uint16, full bool)) *mCartsRepositoryMockDeleteFromCart {
+func (mmDeleteFromCart *mCartsRepositoryMockDeleteFromCart) Inspect(f func(ctx context.Context, owner CartOwner, sku uint32, count uint16, full bool)) *mCartsRepositoryMockDeleteFromCart {
 	if mmDeleteFromCart.mock.inspectFuncDeleteFromCart != nil {
 		mmDeleteFromCart.mock.t.Fatalf("Inspect function is already set for CartsRepositoryMock.DeleteFromCart")
 	}
@@ -583,7 +1126,7 @@ func (mmDeleteFromCart *mCartsRepositoryMockDeleteFromCart) Return(err error) *C
 }
 
 // Set uses given function f to mock the CartsRepository.DeleteFromCart method
-func (mmDeleteFromCart *mCartsRepositoryMockDeleteFromCart) Set(f func(ctx context.Context, user int64, sku uint32, count uint16, full bool) (err error)) *CartsRepositoryMock {
+func (mmDeleteFromCart *mCartsRepositoryMockDeleteFromCart) Set(f func(ctx context.Context, owner CartOwner, sku uint32, count uint16, full bool) (err error)) *CartsRepositoryMock {
 	if mmDeleteFromCart.defaultExpectation != nil {
 		mmDeleteFromCart.mock.t.Fatalf("Default expectation is already set for the CartsRepository.DeleteFromCart method")
 	}
@@ -598,14 +1141,14 @@ func (mmDeleteFromCart *mCartsRepositoryMockDeleteFromCart) Set(f func(ctx conte
 
 // When sets expectation for the CartsRepository.DeleteFromCart which will trigger the result defined by the following
 // Then helper
-func (mmDeleteFromCart *mCartsRepositoryMockDeleteFromCart) When(ctx context.Context, user int64, sku uint32, count uint16, full bool) *CartsRepositoryMockDeleteFromCartExpectation {
+func (mmDeleteFromCart *mCartsRepositoryMockDeleteFromCart) When(ctx context.Context, owner CartOwner, sku uint32, count uint16, full bool) *CartsRepositoryMockDeleteFromCartExpectation {
 	if mmDeleteFromCart.mock.funcDeleteFromCart != nil {
 		mmDeleteFromCart.mock.t.Fatalf("CartsRepositoryMock.DeleteFromCart mock is already set by Set")
 	}
 
 	expectation := &CartsRepositoryMockDeleteFromCartExpectation{
 		mock:   mmDeleteFromCart.mock,
-		params: &CartsRepositoryMockDeleteFromCartParams{ctx, user, sku, count, full},
+		params: &CartsRepositoryMockDeleteFromCartParams{ctx, owner, sku, count, full},
 	}
 	mmDeleteFromCart.expectations = append(mmDeleteFromCart.expectations, expectation)
 	return expectation
@@ -618,15 +1161,15 @@ func (e *CartsRepositoryMockDeleteFromCartExpectation) Then(err error) *CartsRep
 }
 
 // DeleteFromCart implements CartsRepository
-func (mmDeleteFromCart *CartsRepositoryMock) DeleteFromCart(ctx context.Context, user int64, sku uint32, count uint16, full bool) (err error) {
+func (mmDeleteFromCart *CartsRepositoryMock) DeleteFromCart(ctx context.Context, owner CartOwner, sku uint32, count uint16, full bool) (err error) {
 	mm_atomic.AddUint64(&mmDeleteFromCart.beforeDeleteFromCartCounter, 1)
 	defer mm_atomic.AddUint64(&mmDeleteFromCart.afterDeleteFromCartCounter, 1)
 
 	if mmDeleteFromCart.inspectFuncDeleteFromCart != nil {
-		mmDeleteFromCart.inspectFuncDeleteFromCart(ctx, user, sku, count, full)
+		mmDeleteFromCart.inspectFuncDeleteFromCart(ctx, owner, sku, count, full)
 	}
 
-	mm_params := &CartsRepositoryMockDeleteFromCartParams{ctx, user, sku, count, full}
+	mm_params := &CartsRepositoryMockDeleteFromCartParams{ctx, owner, sku, count, full}
 
 	// Record call args
 	mmDeleteFromCart.DeleteFromCartMock.mutex.Lock()
@@ -643,7 +1186,7 @@ func (mmDeleteFromCart *CartsRepositoryMock) DeleteFromCart(ctx context.Context,
 	if mmDeleteFromCart.DeleteFromCartMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmDeleteFromCart.DeleteFromCartMock.defaultExpectation.Counter, 1)
 		mm_want := mmDeleteFromCart.DeleteFromCartMock.defaultExpectation.params
-		mm_got := CartsRepositoryMockDeleteFromCartParams{ctx, user, sku, count, full}
+		mm_got := CartsRepositoryMockDeleteFromCartParams{ctx, owner, sku, count, full}
 		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmDeleteFromCart.t.Errorf("CartsRepositoryMock.DeleteFromCart got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
@@ -655,9 +1198,9 @@ func (mmDeleteFromCart *CartsRepositoryMock) DeleteFromCart(ctx context.Context,
 		return (*mm_results).err
 	}
 	if mmDeleteFromCart.funcDeleteFromCart != nil {
-		return mmDeleteFromCart.funcDeleteFromCart(ctx, user, sku, count, full)
+		return mmDeleteFromCart.funcDeleteFromCart(ctx, owner, sku, count, full)
 	}
-	mmDeleteFromCart.t.Fatalf("Unexpected call to CartsRepositoryMock.DeleteFromCart. %v %v %v %v %v", ctx, user, sku, count, full)
+	mmDeleteFromCart.t.Fatalf("Unexpected call to CartsRepositoryMock.DeleteFromCart. %v %v %v %v %v", ctx, owner, sku, count, full)
 	return
 }
 
@@ -726,37 +1269,470 @@ func (m *CartsRepositoryMock) MinimockDeleteFromCartInspect() {
 	}
 }
 
-type mCartsRepositoryMockGetCart struct {
+type mCartsRepositoryMockDeleteGuestCart struct {
 	mock               *CartsRepositoryMock
-	defaultExpectation *CartsRepositoryMockGetCartExpectation
-	expectations       []*CartsRepositoryMockGetCartExpectation
+	defaultExpectation *CartsRepositoryMockDeleteGuestCartExpectation
+	expectations       []*CartsRepositoryMockDeleteGuestCartExpectation
 
-	callArgs []*CartsRepositoryMockGetCartParams
+	callArgs []*CartsRepositoryMockDeleteGuestCartParams
 	mutex    sync.RWMutex
 }
 
-// CartsRepositoryMockGetCartExpectation specifies expectation struct of the CartsRepository.GetCart
-type CartsRepositoryMockGetCartExpectation struct {
+// CartsRepositoryMockDeleteGuestCartExpectation specifies expectation struct of the CartsRepository.DeleteGuestCart
+type CartsRepositoryMockDeleteGuestCartExpectation struct {
 	mock    *CartsRepositoryMock
-	params  *CartsRepositoryMockGetCartParams
-	results *CartsRepositoryMockGetCartResults
+	params  *CartsRepositoryMockDeleteGuestCartParams
+	results *CartsRepositoryMockDeleteGuestCartResults
 	Counter uint64
 }
 
-// CartsRepositoryMockGetCartParams contains parameters of the CartsRepository.GetCart
-type CartsRepositoryMockGetCartParams struct {
-	ctx  context.Context
-	user int64
+// CartsRepositoryMockDeleteGuestCartParams contains parameters of the CartsRepository.DeleteGuestCart
+type CartsRepositoryMockDeleteGuestCartParams struct {
+	ctx       context.Context
+	sessionID string
 }
 
-// CartsRepositoryMockGetCartResults contains results of the CartsRepository.GetCart
-type CartsRepositoryMockGetCartResults struct {
-	ca1 []CartItem
+// CartsRepositoryMockDeleteGuestCartResults contains results of the CartsRepository.DeleteGuestCart
+type CartsRepositoryMockDeleteGuestCartResults struct {
 	err error
 }
 
-// Expect sets up expected params for CartsRepository.GetCart
-func (mmGetCart *mCartsRepositoryMockGetCart) Expect(ctx context.Context, user int64) *mCartsRepositoryMockGetCart {
+// Expect sets up expected params for CartsRepository.DeleteGuestCart
+func (mmDeleteGuestCart *mCartsRepositoryMockDeleteGuestCart) Expect(ctx context.Context, sessionID string) *mCartsRepositoryMockDeleteGuestCart {
+	if mmDeleteGuestCart.mock.funcDeleteGuestCart != nil {
+		mmDeleteGuestCart.mock.t.Fatalf("CartsRepositoryMock.DeleteGuestCart mock is already set by Set")
+	}
+
+	if mmDeleteGuestCart.defaultExpectation == nil {
+		mmDeleteGuestCart.defaultExpectation = &CartsRepositoryMockDeleteGuestCartExpectation{}
+	}
+
+	mmDeleteGuestCart.defaultExpectation.params = &CartsRepositoryMockDeleteGuestCartParams{ctx, sessionID}
+	for _, e := range mmDeleteGuestCart.expectations {
+		if minimock.Equal(e.params, mmDeleteGuestCart.defaultExpectation.params) {
+			mmDeleteGuestCart.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteGuestCart.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteGuestCart
+}
+
+// Inspect accepts an inspector function that has same arguments as the CartsRepository.DeleteGuestCart
+func (mmDeleteGuestCart *mCartsRepositoryMockDeleteGuestCart) Inspect(f func(ctx context.Context, sessionID string)) *mCartsRepositoryMockDeleteGuestCart {
+	if mmDeleteGuestCart.mock.inspectFuncDeleteGuestCart != nil {
+		mmDeleteGuestCart.mock.t.Fatalf("Inspect function is already set for CartsRepositoryMock.DeleteGuestCart")
+	}
+
+	mmDeleteGuestCart.mock.inspectFuncDeleteGuestCart = f
+
+	return mmDeleteGuestCart
+}
+
+// Return sets up results that will be returned by CartsRepository.DeleteGuestCart
+func (mmDeleteGuestCart *mCartsRepositoryMockDeleteGuestCart) Return(err error) *CartsRepositoryMock {
+	if mmDeleteGuestCart.mock.funcDeleteGuestCart != nil {
+		mmDeleteGuestCart.mock.t.Fatalf("CartsRepositoryMock.DeleteGuestCart mock is already set by Set")
+	}
+
+	if mmDeleteGuestCart.defaultExpectation == nil {
+		mmDeleteGuestCart.defaultExpectation = &CartsRepositoryMockDeleteGuestCartExpectation{mock: mmDeleteGuestCart.mock}
+	}
+	mmDeleteGuestCart.defaultExpectation.results = &CartsRepositoryMockDeleteGuestCartResults{err}
+	return mmDeleteGuestCart.mock
+}
+
+// Set uses given function f to mock the CartsRepository.DeleteGuestCart method
+func (mmDeleteGuestCart *mCartsRepositoryMockDeleteGuestCart) Set(f func(ctx context.Context, sessionID string) (err error)) *CartsRepositoryMock {
+	if mmDeleteGuestCart.defaultExpectation != nil {
+		mmDeleteGuestCart.mock.t.Fatalf("Default expectation is already set for the CartsRepository.DeleteGuestCart method")
+	}
+
+	if len(mmDeleteGuestCart.expectations) > 0 {
+		mmDeleteGuestCart.mock.t.Fatalf("Some expectations are already set for the CartsRepository.DeleteGuestCart method")
+	}
+
+	mmDeleteGuestCart.mock.funcDeleteGuestCart = f
+	return mmDeleteGuestCart.mock
+}
+
+// When sets expectation for the CartsRepository.DeleteGuestCart which will trigger the result defined by the following
+// Then helper
+func (mmDeleteGuestCart *mCartsRepositoryMockDeleteGuestCart) When(ctx context.Context, sessionID string) *CartsRepositoryMockDeleteGuestCartExpectation {
+	if mmDeleteGuestCart.mock.funcDeleteGuestCart != nil {
+		mmDeleteGuestCart.mock.t.Fatalf("CartsRepositoryMock.DeleteGuestCart mock is already set by Set")
+	}
+
+	expectation := &CartsRepositoryMockDeleteGuestCartExpectation{
+		mock:   mmDeleteGuestCart.mock,
+		params: &CartsRepositoryMockDeleteGuestCartParams{ctx, sessionID},
+	}
+	mmDeleteGuestCart.expectations = append(mmDeleteGuestCart.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CartsRepository.DeleteGuestCart return parameters for the expectation previously defined by the When method
+func (e *CartsRepositoryMockDeleteGuestCartExpectation) Then(err error) *CartsRepositoryMock {
+	e.results = &CartsRepositoryMockDeleteGuestCartResults{err}
+	return e.mock
+}
+
+// DeleteGuestCart implements CartsRepository
+func (mmDeleteGuestCart *CartsRepositoryMock) DeleteGuestCart(ctx context.Context, sessionID string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteGuestCart.beforeDeleteGuestCartCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteGuestCart.afterDeleteGuestCartCounter, 1)
+
+	if mmDeleteGuestCart.inspectFuncDeleteGuestCart != nil {
+		mmDeleteGuestCart.inspectFuncDeleteGuestCart(ctx, sessionID)
+	}
+
+	mm_params := &CartsRepositoryMockDeleteGuestCartParams{ctx, sessionID}
+
+	// Record call args
+	mmDeleteGuestCart.DeleteGuestCartMock.mutex.Lock()
+	mmDeleteGuestCart.DeleteGuestCartMock.callArgs = append(mmDeleteGuestCart.DeleteGuestCartMock.callArgs, mm_params)
+	mmDeleteGuestCart.DeleteGuestCartMock.mutex.Unlock()
+
+	for _, e := range mmDeleteGuestCart.DeleteGuestCartMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteGuestCart.DeleteGuestCartMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteGuestCart.DeleteGuestCartMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteGuestCart.DeleteGuestCartMock.defaultExpectation.params
+		mm_got := CartsRepositoryMockDeleteGuestCartParams{ctx, sessionID}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteGuestCart.t.Errorf("CartsRepositoryMock.DeleteGuestCart got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteGuestCart.DeleteGuestCartMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteGuestCart.t.Fatal("No results are set for the CartsRepositoryMock.DeleteGuestCart")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteGuestCart.funcDeleteGuestCart != nil {
+		return mmDeleteGuestCart.funcDeleteGuestCart(ctx, sessionID)
+	}
+	mmDeleteGuestCart.t.Fatalf("Unexpected call to CartsRepositoryMock.DeleteGuestCart. %v %v", ctx, sessionID)
+	return
+}
+
+// DeleteGuestCartAfterCounter returns a count of finished CartsRepositoryMock.DeleteGuestCart invocations
+func (mmDeleteGuestCart *CartsRepositoryMock) DeleteGuestCartAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteGuestCart.afterDeleteGuestCartCounter)
+}
+
+// DeleteGuestCartBeforeCounter returns a count of CartsRepositoryMock.DeleteGuestCart invocations
+func (mmDeleteGuestCart *CartsRepositoryMock) DeleteGuestCartBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteGuestCart.beforeDeleteGuestCartCounter)
+}
+
+// Calls returns a list of arguments used in each call to CartsRepositoryMock.DeleteGuestCart.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteGuestCart *mCartsRepositoryMockDeleteGuestCart) Calls() []*CartsRepositoryMockDeleteGuestCartParams {
+	mmDeleteGuestCart.mutex.RLock()
+
+	argCopy := make([]*CartsRepositoryMockDeleteGuestCartParams, len(mmDeleteGuestCart.callArgs))
+	copy(argCopy, mmDeleteGuestCart.callArgs)
+
+	mmDeleteGuestCart.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteGuestCartDone returns true if the count of the DeleteGuestCart invocations corresponds
+// the number of defined expectations
+func (m *CartsRepositoryMock) MinimockDeleteGuestCartDone() bool {
+	for _, e := range m.DeleteGuestCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteGuestCartMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeleteGuestCartCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteGuestCart != nil && mm_atomic.LoadUint64(&m.afterDeleteGuestCartCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockDeleteGuestCartInspect logs each unmet expectation
+func (m *CartsRepositoryMock) MinimockDeleteGuestCartInspect() {
+	for _, e := range m.DeleteGuestCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CartsRepositoryMock.DeleteGuestCart with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteGuestCartMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeleteGuestCartCounter) < 1 {
+		if m.DeleteGuestCartMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to CartsRepositoryMock.DeleteGuestCart")
+		} else {
+			m.t.Errorf("Expected call to CartsRepositoryMock.DeleteGuestCart with params: %#v", *m.DeleteGuestCartMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteGuestCart != nil && mm_atomic.LoadUint64(&m.afterDeleteGuestCartCounter) < 1 {
+		m.t.Error("Expected call to CartsRepositoryMock.DeleteGuestCart")
+	}
+}
+
+type mCartsRepositoryMockDeleteSavedItem struct {
+	mock               *CartsRepositoryMock
+	defaultExpectation *CartsRepositoryMockDeleteSavedItemExpectation
+	expectations       []*CartsRepositoryMockDeleteSavedItemExpectation
+
+	callArgs []*CartsRepositoryMockDeleteSavedItemParams
+	mutex    sync.RWMutex
+}
+
+// CartsRepositoryMockDeleteSavedItemExpectation specifies expectation struct of the CartsRepository.DeleteSavedItem
+type CartsRepositoryMockDeleteSavedItemExpectation struct {
+	mock    *CartsRepositoryMock
+	params  *CartsRepositoryMockDeleteSavedItemParams
+	results *CartsRepositoryMockDeleteSavedItemResults
+	Counter uint64
+}
+
+// CartsRepositoryMockDeleteSavedItemParams contains parameters of the CartsRepository.DeleteSavedItem
+type CartsRepositoryMockDeleteSavedItemParams struct {
+	ctx  context.Context
+	user int64
+	sku  uint32
+}
+
+// CartsRepositoryMockDeleteSavedItemResults contains results of the CartsRepository.DeleteSavedItem
+type CartsRepositoryMockDeleteSavedItemResults struct {
+	err error
+}
+
+// Expect sets up expected params for CartsRepository.DeleteSavedItem
+func (mmDeleteSavedItem *mCartsRepositoryMockDeleteSavedItem) Expect(ctx context.Context, user int64, sku uint32) *mCartsRepositoryMockDeleteSavedItem {
+	if mmDeleteSavedItem.mock.funcDeleteSavedItem != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("CartsRepositoryMock.DeleteSavedItem mock is already set by Set")
+	}
+
+	if mmDeleteSavedItem.defaultExpectation == nil {
+		mmDeleteSavedItem.defaultExpectation = &CartsRepositoryMockDeleteSavedItemExpectation{}
+	}
+
+	mmDeleteSavedItem.defaultExpectation.params = &CartsRepositoryMockDeleteSavedItemParams{ctx, user, sku}
+	for _, e := range mmDeleteSavedItem.expectations {
+		if minimock.Equal(e.params, mmDeleteSavedItem.defaultExpectation.params) {
+			mmDeleteSavedItem.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteSavedItem.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteSavedItem
+}
+
+// Inspect accepts an inspector function that has same arguments as the CartsRepository.DeleteSavedItem
+func (mmDeleteSavedItem *mCartsRepositoryMockDeleteSavedItem) Inspect(f func(ctx context.Context, user int64, sku uint32)) *mCartsRepositoryMockDeleteSavedItem {
+	if mmDeleteSavedItem.mock.inspectFuncDeleteSavedItem != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("Inspect function is already set for CartsRepositoryMock.DeleteSavedItem")
+	}
+
+	mmDeleteSavedItem.mock.inspectFuncDeleteSavedItem = f
+
+	return mmDeleteSavedItem
+}
+
+// Return sets up results that will be returned by CartsRepository.DeleteSavedItem
+func (mmDeleteSavedItem *mCartsRepositoryMockDeleteSavedItem) Return(err error) *CartsRepositoryMock {
+	if mmDeleteSavedItem.mock.funcDeleteSavedItem != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("CartsRepositoryMock.DeleteSavedItem mock is already set by Set")
+	}
+
+	if mmDeleteSavedItem.defaultExpectation == nil {
+		mmDeleteSavedItem.defaultExpectation = &CartsRepositoryMockDeleteSavedItemExpectation{mock: mmDeleteSavedItem.mock}
+	}
+	mmDeleteSavedItem.defaultExpectation.results = &CartsRepositoryMockDeleteSavedItemResults{err}
+	return mmDeleteSavedItem.mock
+}
+
+// Set uses given function f to mock the CartsRepository.DeleteSavedItem method
+func (mmDeleteSavedItem *mCartsRepositoryMockDeleteSavedItem) Set(f func(ctx context.Context, user int64, sku uint32) (err error)) *CartsRepositoryMock {
+	if mmDeleteSavedItem.defaultExpectation != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("Default expectation is already set for the CartsRepository.DeleteSavedItem method")
+	}
+
+	if len(mmDeleteSavedItem.expectations) > 0 {
+		mmDeleteSavedItem.mock.t.Fatalf("Some expectations are already set for the CartsRepository.DeleteSavedItem method")
+	}
+
+	mmDeleteSavedItem.mock.funcDeleteSavedItem = f
+	return mmDeleteSavedItem.mock
+}
+
+// When sets expectation for the CartsRepository.DeleteSavedItem which will trigger the result defined by the following
+// Then helper
+func (mmDeleteSavedItem *mCartsRepositoryMockDeleteSavedItem) When(ctx context.Context, user int64, sku uint32) *CartsRepositoryMockDeleteSavedItemExpectation {
+	if mmDeleteSavedItem.mock.funcDeleteSavedItem != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("CartsRepositoryMock.DeleteSavedItem mock is already set by Set")
+	}
+
+	expectation := &CartsRepositoryMockDeleteSavedItemExpectation{
+		mock:   mmDeleteSavedItem.mock,
+		params: &CartsRepositoryMockDeleteSavedItemParams{ctx, user, sku},
+	}
+	mmDeleteSavedItem.expectations = append(mmDeleteSavedItem.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CartsRepository.DeleteSavedItem return parameters for the expectation previously defined by the When method
+func (e *CartsRepositoryMockDeleteSavedItemExpectation) Then(err error) *CartsRepositoryMock {
+	e.results = &CartsRepositoryMockDeleteSavedItemResults{err}
+	return e.mock
+}
+
+// DeleteSavedItem implements CartsRepository
+func (mmDeleteSavedItem *CartsRepositoryMock) DeleteSavedItem(ctx context.Context, user int64, sku uint32) (err error) {
+	mm_atomic.AddUint64(&mmDeleteSavedItem.beforeDeleteSavedItemCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteSavedItem.afterDeleteSavedItemCounter, 1)
+
+	if mmDeleteSavedItem.inspectFuncDeleteSavedItem != nil {
+		mmDeleteSavedItem.inspectFuncDeleteSavedItem(ctx, user, sku)
+	}
+
+	mm_params := &CartsRepositoryMockDeleteSavedItemParams{ctx, user, sku}
+
+	// Record call args
+	mmDeleteSavedItem.DeleteSavedItemMock.mutex.Lock()
+	mmDeleteSavedItem.DeleteSavedItemMock.callArgs = append(mmDeleteSavedItem.DeleteSavedItemMock.callArgs, mm_params)
+	mmDeleteSavedItem.DeleteSavedItemMock.mutex.Unlock()
+
+	for _, e := range mmDeleteSavedItem.DeleteSavedItemMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteSavedItem.DeleteSavedItemMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteSavedItem.DeleteSavedItemMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteSavedItem.DeleteSavedItemMock.defaultExpectation.params
+		mm_got := CartsRepositoryMockDeleteSavedItemParams{ctx, user, sku}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteSavedItem.t.Errorf("CartsRepositoryMock.DeleteSavedItem got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteSavedItem.DeleteSavedItemMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteSavedItem.t.Fatal("No results are set for the CartsRepositoryMock.DeleteSavedItem")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteSavedItem.funcDeleteSavedItem != nil {
+		return mmDeleteSavedItem.funcDeleteSavedItem(ctx, user, sku)
+	}
+	mmDeleteSavedItem.t.Fatalf("Unexpected call to CartsRepositoryMock.DeleteSavedItem. %v %v %v", ctx, user, sku)
+	return
+}
+
+// DeleteSavedItemAfterCounter returns a count of finished CartsRepositoryMock.DeleteSavedItem invocations
+func (mmDeleteSavedItem *CartsRepositoryMock) DeleteSavedItemAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteSavedItem.afterDeleteSavedItemCounter)
+}
+
+// DeleteSavedItemBeforeCounter returns a count of CartsRepositoryMock.DeleteSavedItem invocations
+func (mmDeleteSavedItem *CartsRepositoryMock) DeleteSavedItemBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteSavedItem.beforeDeleteSavedItemCounter)
+}
+
+// Calls returns a list of arguments used in each call to CartsRepositoryMock.DeleteSavedItem.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteSavedItem *mCartsRepositoryMockDeleteSavedItem) Calls() []*CartsRepositoryMockDeleteSavedItemParams {
+	mmDeleteSavedItem.mutex.RLock()
+
+	argCopy := make([]*CartsRepositoryMockDeleteSavedItemParams, len(mmDeleteSavedItem.callArgs))
+	copy(argCopy, mmDeleteSavedItem.callArgs)
+
+	mmDeleteSavedItem.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteSavedItemDone returns true if the count of the DeleteSavedItem invocations corresponds
+// the number of defined expectations
+func (m *CartsRepositoryMock) MinimockDeleteSavedItemDone() bool {
+	for _, e := range m.DeleteSavedItemMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteSavedItemMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeleteSavedItemCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteSavedItem != nil && mm_atomic.LoadUint64(&m.afterDeleteSavedItemCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockDeleteSavedItemInspect logs each unmet expectation
+func (m *CartsRepositoryMock) MinimockDeleteSavedItemInspect() {
+	for _, e := range m.DeleteSavedItemMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CartsRepositoryMock.DeleteSavedItem with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteSavedItemMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeleteSavedItemCounter) < 1 {
+		if m.DeleteSavedItemMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to CartsRepositoryMock.DeleteSavedItem")
+		} else {
+			m.t.Errorf("Expected call to CartsRepositoryMock.DeleteSavedItem with params: %#v", *m.DeleteSavedItemMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteSavedItem != nil && mm_atomic.LoadUint64(&m.afterDeleteSavedItemCounter) < 1 {
+		m.t.Error("Expected call to CartsRepositoryMock.DeleteSavedItem")
+	}
+}
+
+type mCartsRepositoryMockGetCart struct {
+	mock               *CartsRepositoryMock
+	defaultExpectation *CartsRepositoryMockGetCartExpectation
+	expectations       []*CartsRepositoryMockGetCartExpectation
+
+	callArgs []*CartsRepositoryMockGetCartParams
+	mutex    sync.RWMutex
+}
+
+// CartsRepositoryMockGetCartExpectation specifies expectation struct of the CartsRepository.GetCart
+type CartsRepositoryMockGetCartExpectation struct {
+	mock    *CartsRepositoryMock
+	params  *CartsRepositoryMockGetCartParams
+	results *CartsRepositoryMockGetCartResults
+	Counter uint64
+}
+
+// CartsRepositoryMockGetCartParams contains parameters of the CartsRepository.GetCart
+type CartsRepositoryMockGetCartParams struct {
+	ctx   context.Context
+	owner CartOwner
+}
+
+// CartsRepositoryMockGetCartResults contains results of the CartsRepository.GetCart
+type CartsRepositoryMockGetCartResults struct {
+	ca1 []CartItem
+	err error
+}
+
+// Expect sets up expected params for CartsRepository.GetCart
+func (mmGetCart *mCartsRepositoryMockGetCart) Expect(ctx context.Context, owner CartOwner) *mCartsRepositoryMockGetCart {
 	if mmGetCart.mock.funcGetCart != nil {
 		mmGetCart.mock.t.Fatalf("CartsRepositoryMock.GetCart mock is already set by Set")
 	}
@@ -765,7 +1741,7 @@ func (mmGetCart *mCartsRepositoryMockGetCart) Expect(ctx context.Context, user i
 		mmGetCart.defaultExpectation = &CartsRepositoryMockGetCartExpectation{}
 	}
 
-	mmGetCart.defaultExpectation.params = &CartsRepositoryMockGetCartParams{ctx, user}
+	mmGetCart.defaultExpectation.params = &CartsRepositoryMockGetCartParams{ctx, owner}
 	for _, e := range mmGetCart.expectations {
 		if minimock.Equal(e.params, mmGetCart.defaultExpectation.params) {
 			mmGetCart.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetCart.defaultExpectation.params)
@@ -776,7 +1752,7 @@ func (mmGetCart *mCartsRepositoryMockGetCart) Expect(ctx context.Context, user i
 }
 
 // Inspect accepts an inspector function that has same arguments as the CartsRepository.GetCart
-func (mmGetCart *mCartsRepositoryMockGetCart) Inspect(f func(ctx context.Context, user int64)) *mCartsRepositoryMockGetCart {
+func (mmGetCart *mCartsRepositoryMockGetCart) Inspect(f func(ctx context.Context, owner CartOwner)) *mCartsRepositoryMockGetCart {
 	if mmGetCart.mock.inspectFuncGetCart != nil {
 		mmGetCart.mock.t.Fatalf("Inspect function is already set for CartsRepositoryMock.GetCart")
 	}
@@ -800,7 +1776,7 @@ func (mmGetCart *mCartsRepositoryMockGetCart) Return(ca1 []CartItem, err error)
 }
 
 // Set uses given function f to mock the CartsRepository.GetCart method
-func (mmGetCart *mCartsRepositoryMockGetCart) Set(f func(ctx context.Context, user int64) (ca1 []CartItem, err error)) *CartsRepositoryMock {
+func (mmGetCart *mCartsRepositoryMockGetCart) Set(f func(ctx context.Context, owner CartOwner) (ca1 []CartItem, err error)) *CartsRepositoryMock {
 	if mmGetCart.defaultExpectation != nil {
 		mmGetCart.mock.t.Fatalf("Default expectation is already set for the CartsRepository.GetCart method")
 	}
@@ -815,14 +1791,14 @@ func (mmGetCart *mCartsRepositoryMockGetCart) Set(f func(ctx context.Context, us
 
 // When sets expectation for the CartsRepository.GetCart which will trigger the result defined by the following
 // Then helper
-func (mmGetCart *mCartsRepositoryMockGetCart) When(ctx context.Context, user int64) *CartsRepositoryMockGetCartExpectation {
+func (mmGetCart *mCartsRepositoryMockGetCart) When(ctx context.Context, owner CartOwner) *CartsRepositoryMockGetCartExpectation {
 	if mmGetCart.mock.funcGetCart != nil {
 		mmGetCart.mock.t.Fatalf("CartsRepositoryMock.GetCart mock is already set by Set")
 	}
 
 	expectation := &CartsRepositoryMockGetCartExpectation{
 		mock:   mmGetCart.mock,
-		params: &CartsRepositoryMockGetCartParams{ctx, user},
+		params: &CartsRepositoryMockGetCartParams{ctx, owner},
 	}
 	mmGetCart.expectations = append(mmGetCart.expectations, expectation)
 	return expectation
@@ -835,15 +1811,15 @@ func (e *CartsRepositoryMockGetCartExpectation) Then(ca1 []CartItem, err error)
 }
 
 // GetCart implements CartsRepository
-func (mmGetCart *CartsRepositoryMock) GetCart(ctx context.Context, user int64) (ca1 []CartItem, err error) {
+func (mmGetCart *CartsRepositoryMock) GetCart(ctx context.Context, owner CartOwner) (ca1 []CartItem, err error) {
 	mm_atomic.AddUint64(&mmGetCart.beforeGetCartCounter, 1)
 	defer mm_atomic.AddUint64(&mmGetCart.afterGetCartCounter, 1)
 
 	if mmGetCart.inspectFuncGetCart != nil {
-		mmGetCart.inspectFuncGetCart(ctx, user)
+		mmGetCart.inspectFuncGetCart(ctx, owner)
 	}
 
-	mm_params := &CartsRepositoryMockGetCartParams{ctx, user}
+	mm_params := &CartsRepositoryMockGetCartParams{ctx, owner}
 
 	// Record call args
 	mmGetCart.GetCartMock.mutex.Lock()
@@ -860,7 +1836,7 @@ func (mmGetCart *CartsRepositoryMock) GetCart(ctx context.Context, user int64) (
 	if mmGetCart.GetCartMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmGetCart.GetCartMock.defaultExpectation.Counter, 1)
 		mm_want := mmGetCart.GetCartMock.defaultExpectation.params
-		mm_got := CartsRepositoryMockGetCartParams{ctx, user}
+		mm_got := CartsRepositoryMockGetCartParams{ctx, owner}
 		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmGetCart.t.Errorf("CartsRepositoryMock.GetCart got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
@@ -872,9 +1848,9 @@ func (mmGetCart *CartsRepositoryMock) GetCart(ctx context.Context, user int64) (
 		return (*mm_results).ca1, (*mm_results).err
 	}
 	if mmGetCart.funcGetCart != nil {
-		return mmGetCart.funcGetCart(ctx, user)
+		return mmGetCart.funcGetCart(ctx, owner)
 	}
-	mmGetCart.t.Fatalf("Unexpected call to CartsRepositoryMock.GetCart. %v %v", ctx, user)
+	mmGetCart.t.Fatalf("Unexpected call to CartsRepositoryMock.GetCart. %v %v", ctx, owner)
 	return
 }
 
@@ -962,9 +1938,9 @@ type CartsRepositoryMockGetCartItemExpectation struct {
 
 // CartsRepositoryMockGetCartItemParams contains parameters of the CartsRepository.GetCartItem
 type CartsRepositoryMockGetCartItemParams struct {
-	ctx  context.Context
-	user int64
-	sku  uint32
+	ctx   context.Context
+	owner CartOwner
+	sku   uint32
 }
 
 // CartsRepositoryMockGetCartItemResults contains results of the CartsRepository.GetCartItem
@@ -974,7 +1950,7 @@ type CartsRepositoryMockGetCartItemResults struct {
 }
 
 // Expect sets up expected params for CartsRepository.GetCartItem
-func (mmGetCartItem *mCartsRepositoryMockGetCartItem) Expect(ctx context.Context, user int64, sku uint32) *mCartsRepositoryMockGetCartItem {
+func (mmGetCartItem *mCartsRepositoryMockGetCartItem) Expect(ctx context.Context, owner CartOwner, sku uint32) *mCartsRepositoryMockGetCartItem {
 	if mmGetCartItem.mock.funcGetCartItem != nil {
 		mmGetCartItem.mock.t.Fatalf("CartsRepositoryMock.GetCartItem mock is already set by Set")
 	}
@@ -983,7 +1959,7 @@ func (mmGetCartItem *mCartsRepositoryMockGetCartItem) Expect(ctx context.Context
 		mmGetCartItem.defaultExpectation = &CartsRepositoryMockGetCartItemExpectation{}
 	}
 
-	mmGetCartItem.defaultExpectation.params = &CartsRepositoryMockGetCartItemParams{ctx, user, sku}
+	mmGetCartItem.defaultExpectation.params = &CartsRepositoryMockGetCartItemParams{ctx, owner, sku}
 	for _, e := range mmGetCartItem.expectations {
 		if minimock.Equal(e.params, mmGetCartItem.defaultExpectation.params) {
 			mmGetCartItem.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetCartItem.defaultExpectation.params)
@@ -994,7 +1970,7 @@ func (mmGetCartItem *mCartsRepositoryMockGetCartItem) Expect(ctx context.Context
 }
 
 // Inspect accepts an inspector function that has same arguments as the CartsRepository.GetCartItem
-func (mmGetCartItem *mCartsRepositoryMockGetCartItem) Inspect(f func(ctx context.Context, user int64, sku uint32)) *mCartsRepositoryMockGetCartItem {
+func (mmGetCartItem *mCartsRepositoryMockGetCartItem) Inspect(f func(ctx context.Context, owner CartOwner, sku uint32)) *mCartsRepositoryMockGetCartItem {
 	if mmGetCartItem.mock.inspectFuncGetCartItem != nil {
 		mmGetCartItem.mock.t.Fatalf("Inspect function is already set for CartsRepositoryMock.GetCartItem")
 	}
@@ -1018,7 +1994,7 @@ func (mmGetCartItem *mCartsRepositoryMockGetCartItem) Return(cp1 *CartItem, err
 }
 
 // Set uses given function f to mock the CartsRepository.GetCartItem method
-func (mmGetCartItem *mCartsRepositoryMockGetCartItem) Set(f func(ctx context.Context, user int64, sku uint32) (cp1 *CartItem, err error)) *CartsRepositoryMock {
+func (mmGetCartItem *mCartsRepositoryMockGetCartItem) Set(f func(ctx context.Context, owner CartOwner, sku uint32) (cp1 *CartItem, err error)) *CartsRepositoryMock {
 	if mmGetCartItem.defaultExpectation != nil {
 		mmGetCartItem.mock.t.Fatalf("Default expectation is already set for the CartsRepository.GetCartItem method")
 	}
@@ -1033,14 +2009,14 @@ func (mmGetCartItem *mCartsRepositoryMockGetCartItem) Set(f func(ctx context.Con
 
 // When sets expectation for the CartsRepository.GetCartItem which will trigger the result defined by the following
 // Then helper
-func (mmGetCartItem *mCartsRepositoryMockGetCartItem) When(ctx context.Context, user int64, sku uint32) *CartsRepositoryMockGetCartItemExpectation {
+func (mmGetCartItem *mCartsRepositoryMockGetCartItem) When(ctx context.Context, owner CartOwner, sku uint32) *CartsRepositoryMockGetCartItemExpectation {
 	if mmGetCartItem.mock.funcGetCartItem != nil {
 		mmGetCartItem.mock.t.Fatalf("CartsRepositoryMock.GetCartItem mock is already set by Set")
 	}
 
 	expectation := &CartsRepositoryMockGetCartItemExpectation{
 		mock:   mmGetCartItem.mock,
-		params: &CartsRepositoryMockGetCartItemParams{ctx, user, sku},
+		params: &CartsRepositoryMockGetCartItemParams{ctx, owner, sku},
 	}
 	mmGetCartItem.expectations = append(mmGetCartItem.expectations, expectation)
 	return expectation
@@ -1053,15 +2029,15 @@ func (e *CartsRepositoryMockGetCartItemExpectation) Then(cp1 *CartItem, err erro
 }
 
 // GetCartItem implements CartsRepository
-func (mmGetCartItem *CartsRepositoryMock) GetCartItem(ctx context.Context, user int64, sku uint32) (cp1 *CartItem, err error) {
+func (mmGetCartItem *CartsRepositoryMock) GetCartItem(ctx context.Context, owner CartOwner, sku uint32) (cp1 *CartItem, err error) {
 	mm_atomic.AddUint64(&mmGetCartItem.beforeGetCartItemCounter, 1)
 	defer mm_atomic.AddUint64(&mmGetCartItem.afterGetCartItemCounter, 1)
 
 	if mmGetCartItem.inspectFuncGetCartItem != nil {
-		mmGetCartItem.inspectFuncGetCartItem(ctx, user, sku)
+		mmGetCartItem.inspectFuncGetCartItem(ctx, owner, sku)
 	}
 
-	mm_params := &CartsRepositoryMockGetCartItemParams{ctx, user, sku}
+	mm_params := &CartsRepositoryMockGetCartItemParams{ctx, owner, sku}
 
 	// Record call args
 	mmGetCartItem.GetCartItemMock.mutex.Lock()
@@ -1078,7 +2054,7 @@ func (mmGetCartItem *CartsRepositoryMock) GetCartItem(ctx context.Context, user
 	if mmGetCartItem.GetCartItemMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmGetCartItem.GetCartItemMock.defaultExpectation.Counter, 1)
 		mm_want := mmGetCartItem.GetCartItemMock.defaultExpectation.params
-		mm_got := CartsRepositoryMockGetCartItemParams{ctx, user, sku}
+		mm_got := CartsRepositoryMockGetCartItemParams{ctx, owner, sku}
 		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmGetCartItem.t.Errorf("CartsRepositoryMock.GetCartItem got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
@@ -1090,9 +2066,9 @@ func (mmGetCartItem *CartsRepositoryMock) GetCartItem(ctx context.Context, user
 		return (*mm_results).cp1, (*mm_results).err
 	}
 	if mmGetCartItem.funcGetCartItem != nil {
-		return mmGetCartItem.funcGetCartItem(ctx, user, sku)
+		return mmGetCartItem.funcGetCartItem(ctx, owner, sku)
 	}
-	mmGetCartItem.t.Fatalf("Unexpected call to CartsRepositoryMock.GetCartItem. %v %v %v", ctx, user, sku)
+	mmGetCartItem.t.Fatalf("Unexpected call to CartsRepositoryMock.GetCartItem. %v %v %v", ctx, owner, sku)
 	return
 }
 
@@ -1161,18 +2137,1785 @@ func (m *CartsRepositoryMock) MinimockGetCartItemInspect() {
 	}
 }
 
-// MinimockFinish checks that all mocked methods have been called the expected number of times
-func (m *CartsRepositoryMock) MinimockFinish() {
-	if !m.minimockDone() {
-		m.MinimockAddToCartInspect()
+type mCartsRepositoryMockGetPurchase struct {
+	mock               *CartsRepositoryMock
+	defaultExpectation *CartsRepositoryMockGetPurchaseExpectation
+	expectations       []*CartsRepositoryMockGetPurchaseExpectation
 
-		m.MinimockDeleteCartInspect()
+	callArgs []*CartsRepositoryMockGetPurchaseParams
+	mutex    sync.RWMutex
+}
 
-		m.MinimockDeleteFromCartInspect()
+// CartsRepositoryMockGetPurchaseExpectation specifies expectation struct of the CartsRepository.GetPurchase
+type CartsRepositoryMockGetPurchaseExpectation struct {
+	mock    *CartsRepositoryMock
+	params  *CartsRepositoryMockGetPurchaseParams
+	results *CartsRepositoryMockGetPurchaseResults
+	Counter uint64
+}
 
-		m.MinimockGetCartInspect()
+// CartsRepositoryMockGetPurchaseParams contains parameters of the CartsRepository.GetPurchase
+type CartsRepositoryMockGetPurchaseParams struct {
+	ctx            context.Context
+	user           int64
+	idempotencyKey string
+}
+
+// CartsRepositoryMockGetPurchaseResults contains results of the CartsRepository.GetPurchase
+type CartsRepositoryMockGetPurchaseResults struct {
+	i1  int64
+	err error
+}
+
+// Expect sets up expected params for CartsRepository.GetPurchase
+func (mmGetPurchase *mCartsRepositoryMockGetPurchase) Expect(ctx context.Context, user int64, idempotencyKey string) *mCartsRepositoryMockGetPurchase {
+	if mmGetPurchase.mock.funcGetPurchase != nil {
+		mmGetPurchase.mock.t.Fatalf("CartsRepositoryMock.GetPurchase mock is already set by Set")
+	}
+
+	if mmGetPurchase.defaultExpectation == nil {
+		mmGetPurchase.defaultExpectation = &CartsRepositoryMockGetPurchaseExpectation{}
+	}
+
+	mmGetPurchase.defaultExpectation.params = &CartsRepositoryMockGetPurchaseParams{ctx, user, idempotencyKey}
+	for _, e := range mmGetPurchase.expectations {
+		if minimock.Equal(e.params, mmGetPurchase.defaultExpectation.params) {
+			mmGetPurchase.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPurchase.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPurchase
+}
+
+// Inspect accepts an inspector function that has same arguments as the CartsRepository.GetPurchase
+func (mmGetPurchase *mCartsRepositoryMockGetPurchase) Inspect(f func(ctx context.Context, user int64, idempotencyKey string)) *mCartsRepositoryMockGetPurchase {
+	if mmGetPurchase.mock.inspectFuncGetPurchase != nil {
+		mmGetPurchase.mock.t.Fatalf("Inspect function is already set for CartsRepositoryMock.GetPurchase")
+	}
+
+	mmGetPurchase.mock.inspectFuncGetPurchase = f
+
+	return mmGetPurchase
+}
+
+// Return sets up results that will be returned by CartsRepository.GetPurchase
+func (mmGetPurchase *mCartsRepositoryMockGetPurchase) Return(i1 int64, err error) *CartsRepositoryMock {
+	if mmGetPurchase.mock.funcGetPurchase != nil {
+		mmGetPurchase.mock.t.Fatalf("CartsRepositoryMock.GetPurchase mock is already set by Set")
+	}
+
+	if mmGetPurchase.defaultExpectation == nil {
+		mmGetPurchase.defaultExpectation = &CartsRepositoryMockGetPurchaseExpectation{mock: mmGetPurchase.mock}
+	}
+	mmGetPurchase.defaultExpectation.results = &CartsRepositoryMockGetPurchaseResults{i1, err}
+	return mmGetPurchase.mock
+}
+
+// Set uses given function f to mock the CartsRepository.GetPurchase method
+func (mmGetPurchase *mCartsRepositoryMockGetPurchase) Set(f func(ctx context.Context, user int64, idempotencyKey string) (i1 int64, err error)) *CartsRepositoryMock {
+	if mmGetPurchase.defaultExpectation != nil {
+		mmGetPurchase.mock.t.Fatalf("Default expectation is already set for the CartsRepository.GetPurchase method")
+	}
+
+	if len(mmGetPurchase.expectations) > 0 {
+		mmGetPurchase.mock.t.Fatalf("Some expectations are already set for the CartsRepository.GetPurchase method")
+	}
+
+	mmGetPurchase.mock.funcGetPurchase = f
+	return mmGetPurchase.mock
+}
+
+// When sets expectation for the CartsRepository.GetPurchase which will trigger the result defined by the following
+// Then helper
+func (mmGetPurchase *mCartsRepositoryMockGetPurchase) When(ctx context.Context, user int64, idempotencyKey string) *CartsRepositoryMockGetPurchaseExpectation {
+	if mmGetPurchase.mock.funcGetPurchase != nil {
+		mmGetPurchase.mock.t.Fatalf("CartsRepositoryMock.GetPurchase mock is already set by Set")
+	}
+
+	expectation := &CartsRepositoryMockGetPurchaseExpectation{
+		mock:   mmGetPurchase.mock,
+		params: &CartsRepositoryMockGetPurchaseParams{ctx, user, idempotencyKey},
+	}
+	mmGetPurchase.expectations = append(mmGetPurchase.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CartsRepository.GetPurchase return parameters for the expectation previously defined by the When method
+func (e *CartsRepositoryMockGetPurchaseExpectation) Then(i1 int64, err error) *CartsRepositoryMock {
+	e.results = &CartsRepositoryMockGetPurchaseResults{i1, err}
+	return e.mock
+}
+
+// GetPurchase implements CartsRepository
+func (mmGetPurchase *CartsRepositoryMock) GetPurchase(ctx context.Context, user int64, idempotencyKey string) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmGetPurchase.beforeGetPurchaseCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPurchase.afterGetPurchaseCounter, 1)
+
+	if mmGetPurchase.inspectFuncGetPurchase != nil {
+		mmGetPurchase.inspectFuncGetPurchase(ctx, user, idempotencyKey)
+	}
+
+	mm_params := &CartsRepositoryMockGetPurchaseParams{ctx, user, idempotencyKey}
+
+	// Record call args
+	mmGetPurchase.GetPurchaseMock.mutex.Lock()
+	mmGetPurchase.GetPurchaseMock.callArgs = append(mmGetPurchase.GetPurchaseMock.callArgs, mm_params)
+	mmGetPurchase.GetPurchaseMock.mutex.Unlock()
+
+	for _, e := range mmGetPurchase.GetPurchaseMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmGetPurchase.GetPurchaseMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPurchase.GetPurchaseMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPurchase.GetPurchaseMock.defaultExpectation.params
+		mm_got := CartsRepositoryMockGetPurchaseParams{ctx, user, idempotencyKey}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPurchase.t.Errorf("CartsRepositoryMock.GetPurchase got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPurchase.GetPurchaseMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPurchase.t.Fatal("No results are set for the CartsRepositoryMock.GetPurchase")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmGetPurchase.funcGetPurchase != nil {
+		return mmGetPurchase.funcGetPurchase(ctx, user, idempotencyKey)
+	}
+	mmGetPurchase.t.Fatalf("Unexpected call to CartsRepositoryMock.GetPurchase. %v %v %v", ctx, user, idempotencyKey)
+	return
+}
+
+// GetPurchaseAfterCounter returns a count of finished CartsRepositoryMock.GetPurchase invocations
+func (mmGetPurchase *CartsRepositoryMock) GetPurchaseAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPurchase.afterGetPurchaseCounter)
+}
+
+// GetPurchaseBeforeCounter returns a count of CartsRepositoryMock.GetPurchase invocations
+func (mmGetPurchase *CartsRepositoryMock) GetPurchaseBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPurchase.beforeGetPurchaseCounter)
+}
+
+// Calls returns a list of arguments used in each call to CartsRepositoryMock.GetPurchase.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPurchase *mCartsRepositoryMockGetPurchase) Calls() []*CartsRepositoryMockGetPurchaseParams {
+	mmGetPurchase.mutex.RLock()
+
+	argCopy := make([]*CartsRepositoryMockGetPurchaseParams, len(mmGetPurchase.callArgs))
+	copy(argCopy, mmGetPurchase.callArgs)
+
+	mmGetPurchase.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPurchaseDone returns true if the count of the GetPurchase invocations corresponds
+// the number of defined expectations
+func (m *CartsRepositoryMock) MinimockGetPurchaseDone() bool {
+	for _, e := range m.GetPurchaseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPurchaseMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetPurchaseCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPurchase != nil && mm_atomic.LoadUint64(&m.afterGetPurchaseCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetPurchaseInspect logs each unmet expectation
+func (m *CartsRepositoryMock) MinimockGetPurchaseInspect() {
+	for _, e := range m.GetPurchaseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CartsRepositoryMock.GetPurchase with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPurchaseMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetPurchaseCounter) < 1 {
+		if m.GetPurchaseMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to CartsRepositoryMock.GetPurchase")
+		} else {
+			m.t.Errorf("Expected call to CartsRepositoryMock.GetPurchase with params: %#v", *m.GetPurchaseMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPurchase != nil && mm_atomic.LoadUint64(&m.afterGetPurchaseCounter) < 1 {
+		m.t.Error("Expected call to CartsRepositoryMock.GetPurchase")
+	}
+}
+
+type mCartsRepositoryMockGetSavedItem struct {
+	mock               *CartsRepositoryMock
+	defaultExpectation *CartsRepositoryMockGetSavedItemExpectation
+	expectations       []*CartsRepositoryMockGetSavedItemExpectation
+
+	callArgs []*CartsRepositoryMockGetSavedItemParams
+	mutex    sync.RWMutex
+}
+
+// CartsRepositoryMockGetSavedItemExpectation specifies expectation struct of the CartsRepository.GetSavedItem
+type CartsRepositoryMockGetSavedItemExpectation struct {
+	mock    *CartsRepositoryMock
+	params  *CartsRepositoryMockGetSavedItemParams
+	results *CartsRepositoryMockGetSavedItemResults
+	Counter uint64
+}
+
+// CartsRepositoryMockGetSavedItemParams contains parameters of the CartsRepository.GetSavedItem
+type CartsRepositoryMockGetSavedItemParams struct {
+	ctx  context.Context
+	user int64
+	sku  uint32
+}
+
+// CartsRepositoryMockGetSavedItemResults contains results of the CartsRepository.GetSavedItem
+type CartsRepositoryMockGetSavedItemResults struct {
+	cp1 *CartItem
+	err error
+}
+
+// Expect sets up expected params for CartsRepository.GetSavedItem
+func (mmGetSavedItem *mCartsRepositoryMockGetSavedItem) Expect(ctx context.Context, user int64, sku uint32) *mCartsRepositoryMockGetSavedItem {
+	if mmGetSavedItem.mock.funcGetSavedItem != nil {
+		mmGetSavedItem.mock.t.Fatalf("CartsRepositoryMock.GetSavedItem mock is already set by Set")
+	}
+
+	if mmGetSavedItem.defaultExpectation == nil {
+		mmGetSavedItem.defaultExpectation = &CartsRepositoryMockGetSavedItemExpectation{}
+	}
+
+	mmGetSavedItem.defaultExpectation.params = &CartsRepositoryMockGetSavedItemParams{ctx, user, sku}
+	for _, e := range mmGetSavedItem.expectations {
+		if minimock.Equal(e.params, mmGetSavedItem.defaultExpectation.params) {
+			mmGetSavedItem.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetSavedItem.defaultExpectation.params)
+		}
+	}
+
+	return mmGetSavedItem
+}
+
+// Inspect accepts an inspector function that has same arguments as the CartsRepository.GetSavedItem
+func (mmGetSavedItem *mCartsRepositoryMockGetSavedItem) Inspect(f func(ctx context.Context, user int64, sku uint32)) *mCartsRepositoryMockGetSavedItem {
+	if mmGetSavedItem.mock.inspectFuncGetSavedItem != nil {
+		mmGetSavedItem.mock.t.Fatalf("Inspect function is already set for CartsRepositoryMock.GetSavedItem")
+	}
+
+	mmGetSavedItem.mock.inspectFuncGetSavedItem = f
+
+	return mmGetSavedItem
+}
+
+// Return sets up results that will be returned by CartsRepository.GetSavedItem
+func (mmGetSavedItem *mCartsRepositoryMockGetSavedItem) Return(cp1 *CartItem, err error) *CartsRepositoryMock {
+	if mmGetSavedItem.mock.funcGetSavedItem != nil {
+		mmGetSavedItem.mock.t.Fatalf("CartsRepositoryMock.GetSavedItem mock is already set by Set")
+	}
+
+	if mmGetSavedItem.defaultExpectation == nil {
+		mmGetSavedItem.defaultExpectation = &CartsRepositoryMockGetSavedItemExpectation{mock: mmGetSavedItem.mock}
+	}
+	mmGetSavedItem.defaultExpectation.results = &CartsRepositoryMockGetSavedItemResults{cp1, err}
+	return mmGetSavedItem.mock
+}
+
+// Set uses given function f to mock the CartsRepository.GetSavedItem method
+func (mmGetSavedItem *mCartsRepositoryMockGetSavedItem) Set(f func(ctx context.Context, user int64, sku uint32) (cp1 *CartItem, err error)) *CartsRepositoryMock {
+	if mmGetSavedItem.defaultExpectation != nil {
+		mmGetSavedItem.mock.t.Fatalf("Default expectation is already set for the CartsRepository.GetSavedItem method")
+	}
+
+	if len(mmGetSavedItem.expectations) > 0 {
+		mmGetSavedItem.mock.t.Fatalf("Some expectations are already set for the CartsRepository.GetSavedItem method")
+	}
+
+	mmGetSavedItem.mock.funcGetSavedItem = f
+	return mmGetSavedItem.mock
+}
+
+// When sets expectation for the CartsRepository.GetSavedItem which will trigger the result defined by the following
+// Then helper
+func (mmGetSavedItem *mCartsRepositoryMockGetSavedItem) When(ctx context.Context, user int64, sku uint32) *CartsRepositoryMockGetSavedItemExpectation {
+	if mmGetSavedItem.mock.funcGetSavedItem != nil {
+		mmGetSavedItem.mock.t.Fatalf("CartsRepositoryMock.GetSavedItem mock is already set by Set")
+	}
+
+	expectation := &CartsRepositoryMockGetSavedItemExpectation{
+		mock:   mmGetSavedItem.mock,
+		params: &CartsRepositoryMockGetSavedItemParams{ctx, user, sku},
+	}
+	mmGetSavedItem.expectations = append(mmGetSavedItem.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CartsRepository.GetSavedItem return parameters for the expectation previously defined by the When method
+func (e *CartsRepositoryMockGetSavedItemExpectation) Then(cp1 *CartItem, err error) *CartsRepositoryMock {
+	e.results = &CartsRepositoryMockGetSavedItemResults{cp1, err}
+	return e.mock
+}
+
+// GetSavedItem implements CartsRepository
+func (mmGetSavedItem *CartsRepositoryMock) GetSavedItem(ctx context.Context, user int64, sku uint32) (cp1 *CartItem, err error) {
+	mm_atomic.AddUint64(&mmGetSavedItem.beforeGetSavedItemCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetSavedItem.afterGetSavedItemCounter, 1)
+
+	if mmGetSavedItem.inspectFuncGetSavedItem != nil {
+		mmGetSavedItem.inspectFuncGetSavedItem(ctx, user, sku)
+	}
+
+	mm_params := &CartsRepositoryMockGetSavedItemParams{ctx, user, sku}
+
+	// Record call args
+	mmGetSavedItem.GetSavedItemMock.mutex.Lock()
+	mmGetSavedItem.GetSavedItemMock.callArgs = append(mmGetSavedItem.GetSavedItemMock.callArgs, mm_params)
+	mmGetSavedItem.GetSavedItemMock.mutex.Unlock()
+
+	for _, e := range mmGetSavedItem.GetSavedItemMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cp1, e.results.err
+		}
+	}
+
+	if mmGetSavedItem.GetSavedItemMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetSavedItem.GetSavedItemMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetSavedItem.GetSavedItemMock.defaultExpectation.params
+		mm_got := CartsRepositoryMockGetSavedItemParams{ctx, user, sku}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetSavedItem.t.Errorf("CartsRepositoryMock.GetSavedItem got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetSavedItem.GetSavedItemMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetSavedItem.t.Fatal("No results are set for the CartsRepositoryMock.GetSavedItem")
+		}
+		return (*mm_results).cp1, (*mm_results).err
+	}
+	if mmGetSavedItem.funcGetSavedItem != nil {
+		return mmGetSavedItem.funcGetSavedItem(ctx, user, sku)
+	}
+	mmGetSavedItem.t.Fatalf("Unexpected call to CartsRepositoryMock.GetSavedItem. %v %v %v", ctx, user, sku)
+	return
+}
+
+// GetSavedItemAfterCounter returns a count of finished CartsRepositoryMock.GetSavedItem invocations
+func (mmGetSavedItem *CartsRepositoryMock) GetSavedItemAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetSavedItem.afterGetSavedItemCounter)
+}
+
+// GetSavedItemBeforeCounter returns a count of CartsRepositoryMock.GetSavedItem invocations
+func (mmGetSavedItem *CartsRepositoryMock) GetSavedItemBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetSavedItem.beforeGetSavedItemCounter)
+}
+
+// Calls returns a list of arguments used in each call to CartsRepositoryMock.GetSavedItem.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetSavedItem *mCartsRepositoryMockGetSavedItem) Calls() []*CartsRepositoryMockGetSavedItemParams {
+	mmGetSavedItem.mutex.RLock()
+
+	argCopy := make([]*CartsRepositoryMockGetSavedItemParams, len(mmGetSavedItem.callArgs))
+	copy(argCopy, mmGetSavedItem.callArgs)
+
+	mmGetSavedItem.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetSavedItemDone returns true if the count of the GetSavedItem invocations corresponds
+// the number of defined expectations
+func (m *CartsRepositoryMock) MinimockGetSavedItemDone() bool {
+	for _, e := range m.GetSavedItemMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetSavedItemMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetSavedItemCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetSavedItem != nil && mm_atomic.LoadUint64(&m.afterGetSavedItemCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetSavedItemInspect logs each unmet expectation
+func (m *CartsRepositoryMock) MinimockGetSavedItemInspect() {
+	for _, e := range m.GetSavedItemMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CartsRepositoryMock.GetSavedItem with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetSavedItemMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetSavedItemCounter) < 1 {
+		if m.GetSavedItemMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to CartsRepositoryMock.GetSavedItem")
+		} else {
+			m.t.Errorf("Expected call to CartsRepositoryMock.GetSavedItem with params: %#v", *m.GetSavedItemMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetSavedItem != nil && mm_atomic.LoadUint64(&m.afterGetSavedItemCounter) < 1 {
+		m.t.Error("Expected call to CartsRepositoryMock.GetSavedItem")
+	}
+}
+
+type mCartsRepositoryMockGetSavedItems struct {
+	mock               *CartsRepositoryMock
+	defaultExpectation *CartsRepositoryMockGetSavedItemsExpectation
+	expectations       []*CartsRepositoryMockGetSavedItemsExpectation
+
+	callArgs []*CartsRepositoryMockGetSavedItemsParams
+	mutex    sync.RWMutex
+}
+
+// CartsRepositoryMockGetSavedItemsExpectation specifies expectation struct of the CartsRepository.GetSavedItems
+type CartsRepositoryMockGetSavedItemsExpectation struct {
+	mock    *CartsRepositoryMock
+	params  *CartsRepositoryMockGetSavedItemsParams
+	results *CartsRepositoryMockGetSavedItemsResults
+	Counter uint64
+}
+
+// CartsRepositoryMockGetSavedItemsParams contains parameters of the CartsRepository.GetSavedItems
+type CartsRepositoryMockGetSavedItemsParams struct {
+	ctx  context.Context
+	user int64
+}
+
+// CartsRepositoryMockGetSavedItemsResults contains results of the CartsRepository.GetSavedItems
+type CartsRepositoryMockGetSavedItemsResults struct {
+	ca1 []CartItem
+	err error
+}
+
+// Expect sets up expected params for CartsRepository.GetSavedItems
+func (mmGetSavedItems *mCartsRepositoryMockGetSavedItems) Expect(ctx context.Context, user int64) *mCartsRepositoryMockGetSavedItems {
+	if mmGetSavedItems.mock.funcGetSavedItems != nil {
+		mmGetSavedItems.mock.t.Fatalf("CartsRepositoryMock.GetSavedItems mock is already set by Set")
+	}
+
+	if mmGetSavedItems.defaultExpectation == nil {
+		mmGetSavedItems.defaultExpectation = &CartsRepositoryMockGetSavedItemsExpectation{}
+	}
+
+	mmGetSavedItems.defaultExpectation.params = &CartsRepositoryMockGetSavedItemsParams{ctx, user}
+	for _, e := range mmGetSavedItems.expectations {
+		if minimock.Equal(e.params, mmGetSavedItems.defaultExpectation.params) {
+			mmGetSavedItems.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetSavedItems.defaultExpectation.params)
+		}
+	}
+
+	return mmGetSavedItems
+}
+
+// Inspect accepts an inspector function that has same arguments as the CartsRepository.GetSavedItems
+func (mmGetSavedItems *mCartsRepositoryMockGetSavedItems) Inspect(f func(ctx context.Context, user int64)) *mCartsRepositoryMockGetSavedItems {
+	if mmGetSavedItems.mock.inspectFuncGetSavedItems != nil {
+		mmGetSavedItems.mock.t.Fatalf("Inspect function is already set for CartsRepositoryMock.GetSavedItems")
+	}
+
+	mmGetSavedItems.mock.inspectFuncGetSavedItems = f
+
+	return mmGetSavedItems
+}
+
+// Return sets up results that will be returned by CartsRepository.GetSavedItems
+func (mmGetSavedItems *mCartsRepositoryMockGetSavedItems) Return(ca1 []CartItem, err error) *CartsRepositoryMock {
+	if mmGetSavedItems.mock.funcGetSavedItems != nil {
+		mmGetSavedItems.mock.t.Fatalf("CartsRepositoryMock.GetSavedItems mock is already set by Set")
+	}
+
+	if mmGetSavedItems.defaultExpectation == nil {
+		mmGetSavedItems.defaultExpectation = &CartsRepositoryMockGetSavedItemsExpectation{mock: mmGetSavedItems.mock}
+	}
+	mmGetSavedItems.defaultExpectation.results = &CartsRepositoryMockGetSavedItemsResults{ca1, err}
+	return mmGetSavedItems.mock
+}
+
+// Set uses given function f to mock the CartsRepository.GetSavedItems method
+func (mmGetSavedItems *mCartsRepositoryMockGetSavedItems) Set(f func(ctx context.Context, user int64) (ca1 []CartItem, err error)) *CartsRepositoryMock {
+	if mmGetSavedItems.defaultExpectation != nil {
+		mmGetSavedItems.mock.t.Fatalf("Default expectation is already set for the CartsRepository.GetSavedItems method")
+	}
+
+	if len(mmGetSavedItems.expectations) > 0 {
+		mmGetSavedItems.mock.t.Fatalf("Some expectations are already set for the CartsRepository.GetSavedItems method")
+	}
+
+	mmGetSavedItems.mock.funcGetSavedItems = f
+	return mmGetSavedItems.mock
+}
+
+// When sets expectation for the CartsRepository.GetSavedItems which will trigger the result defined by the following
+// Then helper
+func (mmGetSavedItems *mCartsRepositoryMockGetSavedItems) When(ctx context.Context, user int64) *CartsRepositoryMockGetSavedItemsExpectation {
+	if mmGetSavedItems.mock.funcGetSavedItems != nil {
+		mmGetSavedItems.mock.t.Fatalf("CartsRepositoryMock.GetSavedItems mock is already set by Set")
+	}
+
+	expectation := &CartsRepositoryMockGetSavedItemsExpectation{
+		mock:   mmGetSavedItems.mock,
+		params: &CartsRepositoryMockGetSavedItemsParams{ctx, user},
+	}
+	mmGetSavedItems.expectations = append(mmGetSavedItems.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CartsRepository.GetSavedItems return parameters for the expectation previously defined by the When method
+func (e *CartsRepositoryMockGetSavedItemsExpectation) Then(ca1 []CartItem, err error) *CartsRepositoryMock {
+	e.results = &CartsRepositoryMockGetSavedItemsResults{ca1, err}
+	return e.mock
+}
+
+// GetSavedItems implements CartsRepository
+func (mmGetSavedItems *CartsRepositoryMock) GetSavedItems(ctx context.Context, user int64) (ca1 []CartItem, err error) {
+	mm_atomic.AddUint64(&mmGetSavedItems.beforeGetSavedItemsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetSavedItems.afterGetSavedItemsCounter, 1)
+
+	if mmGetSavedItems.inspectFuncGetSavedItems != nil {
+		mmGetSavedItems.inspectFuncGetSavedItems(ctx, user)
+	}
+
+	mm_params := &CartsRepositoryMockGetSavedItemsParams{ctx, user}
+
+	// Record call args
+	mmGetSavedItems.GetSavedItemsMock.mutex.Lock()
+	mmGetSavedItems.GetSavedItemsMock.callArgs = append(mmGetSavedItems.GetSavedItemsMock.callArgs, mm_params)
+	mmGetSavedItems.GetSavedItemsMock.mutex.Unlock()
+
+	for _, e := range mmGetSavedItems.GetSavedItemsMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ca1, e.results.err
+		}
+	}
+
+	if mmGetSavedItems.GetSavedItemsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetSavedItems.GetSavedItemsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetSavedItems.GetSavedItemsMock.defaultExpectation.params
+		mm_got := CartsRepositoryMockGetSavedItemsParams{ctx, user}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetSavedItems.t.Errorf("CartsRepositoryMock.GetSavedItems got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetSavedItems.GetSavedItemsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetSavedItems.t.Fatal("No results are set for the CartsRepositoryMock.GetSavedItems")
+		}
+		return (*mm_results).ca1, (*mm_results).err
+	}
+	if mmGetSavedItems.funcGetSavedItems != nil {
+		return mmGetSavedItems.funcGetSavedItems(ctx, user)
+	}
+	mmGetSavedItems.t.Fatalf("Unexpected call to CartsRepositoryMock.GetSavedItems. %v %v", ctx, user)
+	return
+}
+
+// GetSavedItemsAfterCounter returns a count of finished CartsRepositoryMock.GetSavedItems invocations
+func (mmGetSavedItems *CartsRepositoryMock) GetSavedItemsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetSavedItems.afterGetSavedItemsCounter)
+}
+
+// GetSavedItemsBeforeCounter returns a count of CartsRepositoryMock.GetSavedItems invocations
+func (mmGetSavedItems *CartsRepositoryMock) GetSavedItemsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetSavedItems.beforeGetSavedItemsCounter)
+}
+
+// Calls returns a list of arguments used in each call to CartsRepositoryMock.GetSavedItems.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetSavedItems *mCartsRepositoryMockGetSavedItems) Calls() []*CartsRepositoryMockGetSavedItemsParams {
+	mmGetSavedItems.mutex.RLock()
+
+	argCopy := make([]*CartsRepositoryMockGetSavedItemsParams, len(mmGetSavedItems.callArgs))
+	copy(argCopy, mmGetSavedItems.callArgs)
+
+	mmGetSavedItems.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetSavedItemsDone returns true if the count of the GetSavedItems invocations corresponds
+// the number of defined expectations
+func (m *CartsRepositoryMock) MinimockGetSavedItemsDone() bool {
+	for _, e := range m.GetSavedItemsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetSavedItemsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetSavedItemsCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetSavedItems != nil && mm_atomic.LoadUint64(&m.afterGetSavedItemsCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetSavedItemsInspect logs each unmet expectation
+func (m *CartsRepositoryMock) MinimockGetSavedItemsInspect() {
+	for _, e := range m.GetSavedItemsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CartsRepositoryMock.GetSavedItems with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetSavedItemsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetSavedItemsCounter) < 1 {
+		if m.GetSavedItemsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to CartsRepositoryMock.GetSavedItems")
+		} else {
+			m.t.Errorf("Expected call to CartsRepositoryMock.GetSavedItems with params: %#v", *m.GetSavedItemsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetSavedItems != nil && mm_atomic.LoadUint64(&m.afterGetSavedItemsCounter) < 1 {
+		m.t.Error("Expected call to CartsRepositoryMock.GetSavedItems")
+	}
+}
+
+type mCartsRepositoryMockMergeCarts struct {
+	mock               *CartsRepositoryMock
+	defaultExpectation *CartsRepositoryMockMergeCartsExpectation
+	expectations       []*CartsRepositoryMockMergeCartsExpectation
+
+	callArgs []*CartsRepositoryMockMergeCartsParams
+	mutex    sync.RWMutex
+}
+
+// CartsRepositoryMockMergeCartsExpectation specifies expectation struct of the CartsRepository.MergeCarts
+type CartsRepositoryMockMergeCartsExpectation struct {
+	mock    *CartsRepositoryMock
+	params  *CartsRepositoryMockMergeCartsParams
+	results *CartsRepositoryMockMergeCartsResults
+	Counter uint64
+}
+
+// CartsRepositoryMockMergeCartsParams contains parameters of the CartsRepository.MergeCarts
+type CartsRepositoryMockMergeCartsParams struct {
+	ctx         context.Context
+	guestCartID int64
+	user        int64
+}
+
+// CartsRepositoryMockMergeCartsResults contains results of the CartsRepository.MergeCarts
+type CartsRepositoryMockMergeCartsResults struct {
+	err error
+}
+
+// Expect sets up expected params for CartsRepository.MergeCarts
+func (mmMergeCarts *mCartsRepositoryMockMergeCarts) Expect(ctx context.Context, guestCartID int64, user int64) *mCartsRepositoryMockMergeCarts {
+	if mmMergeCarts.mock.funcMergeCarts != nil {
+		mmMergeCarts.mock.t.Fatalf("CartsRepositoryMock.MergeCarts mock is already set by Set")
+	}
+
+	if mmMergeCarts.defaultExpectation == nil {
+		mmMergeCarts.defaultExpectation = &CartsRepositoryMockMergeCartsExpectation{}
+	}
+
+	mmMergeCarts.defaultExpectation.params = &CartsRepositoryMockMergeCartsParams{ctx, guestCartID, user}
+	for _, e := range mmMergeCarts.expectations {
+		if minimock.Equal(e.params, mmMergeCarts.defaultExpectation.params) {
+			mmMergeCarts.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMergeCarts.defaultExpectation.params)
+		}
+	}
+
+	return mmMergeCarts
+}
+
+// Inspect accepts an inspector function that has same arguments as the CartsRepository.MergeCarts
+func (mmMergeCarts *mCartsRepositoryMockMergeCarts) Inspect(f func(ctx context.Context, guestCartID int64, user int64)) *mCartsRepositoryMockMergeCarts {
+	if mmMergeCarts.mock.inspectFuncMergeCarts != nil {
+		mmMergeCarts.mock.t.Fatalf("Inspect function is already set for CartsRepositoryMock.MergeCarts")
+	}
+
+	mmMergeCarts.mock.inspectFuncMergeCarts = f
+
+	return mmMergeCarts
+}
+
+// Return sets up results that will be returned by CartsRepository.MergeCarts
+func (mmMergeCarts *mCartsRepositoryMockMergeCarts) Return(err error) *CartsRepositoryMock {
+	if mmMergeCarts.mock.funcMergeCarts != nil {
+		mmMergeCarts.mock.t.Fatalf("CartsRepositoryMock.MergeCarts mock is already set by Set")
+	}
+
+	if mmMergeCarts.defaultExpectation == nil {
+		mmMergeCarts.defaultExpectation = &CartsRepositoryMockMergeCartsExpectation{mock: mmMergeCarts.mock}
+	}
+	mmMergeCarts.defaultExpectation.results = &CartsRepositoryMockMergeCartsResults{err}
+	return mmMergeCarts.mock
+}
+
+// Set uses given function f to mock the CartsRepository.MergeCarts method
+func (mmMergeCarts *mCartsRepositoryMockMergeCarts) Set(f func(ctx context.Context, guestCartID int64, user int64) (err error)) *CartsRepositoryMock {
+	if mmMergeCarts.defaultExpectation != nil {
+		mmMergeCarts.mock.t.Fatalf("Default expectation is already set for the CartsRepository.MergeCarts method")
+	}
+
+	if len(mmMergeCarts.expectations) > 0 {
+		mmMergeCarts.mock.t.Fatalf("Some expectations are already set for the CartsRepository.MergeCarts method")
+	}
+
+	mmMergeCarts.mock.funcMergeCarts = f
+	return mmMergeCarts.mock
+}
+
+// When sets expectation for the CartsRepository.MergeCarts which will trigger the result defined by the following
+// Then helper
+func (mmMergeCarts *mCartsRepositoryMockMergeCarts) When(ctx context.Context, guestCartID int64, user int64) *CartsRepositoryMockMergeCartsExpectation {
+	if mmMergeCarts.mock.funcMergeCarts != nil {
+		mmMergeCarts.mock.t.Fatalf("CartsRepositoryMock.MergeCarts mock is already set by Set")
+	}
+
+	expectation := &CartsRepositoryMockMergeCartsExpectation{
+		mock:   mmMergeCarts.mock,
+		params: &CartsRepositoryMockMergeCartsParams{ctx, guestCartID, user},
+	}
+	mmMergeCarts.expectations = append(mmMergeCarts.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CartsRepository.MergeCarts return parameters for the expectation previously defined by the When method
+func (e *CartsRepositoryMockMergeCartsExpectation) Then(err error) *CartsRepositoryMock {
+	e.results = &CartsRepositoryMockMergeCartsResults{err}
+	return e.mock
+}
+
+// MergeCarts implements CartsRepository
+func (mmMergeCarts *CartsRepositoryMock) MergeCarts(ctx context.Context, guestCartID int64, user int64) (err error) {
+	mm_atomic.AddUint64(&mmMergeCarts.beforeMergeCartsCounter, 1)
+	defer mm_atomic.AddUint64(&mmMergeCarts.afterMergeCartsCounter, 1)
+
+	if mmMergeCarts.inspectFuncMergeCarts != nil {
+		mmMergeCarts.inspectFuncMergeCarts(ctx, guestCartID, user)
+	}
+
+	mm_params := &CartsRepositoryMockMergeCartsParams{ctx, guestCartID, user}
+
+	// Record call args
+	mmMergeCarts.MergeCartsMock.mutex.Lock()
+	mmMergeCarts.MergeCartsMock.callArgs = append(mmMergeCarts.MergeCartsMock.callArgs, mm_params)
+	mmMergeCarts.MergeCartsMock.mutex.Unlock()
+
+	for _, e := range mmMergeCarts.MergeCartsMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmMergeCarts.MergeCartsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMergeCarts.MergeCartsMock.defaultExpectation.Counter, 1)
+		mm_want := mmMergeCarts.MergeCartsMock.defaultExpectation.params
+		mm_got := CartsRepositoryMockMergeCartsParams{ctx, guestCartID, user}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMergeCarts.t.Errorf("CartsRepositoryMock.MergeCarts got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMergeCarts.MergeCartsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMergeCarts.t.Fatal("No results are set for the CartsRepositoryMock.MergeCarts")
+		}
+		return (*mm_results).err
+	}
+	if mmMergeCarts.funcMergeCarts != nil {
+		return mmMergeCarts.funcMergeCarts(ctx, guestCartID, user)
+	}
+	mmMergeCarts.t.Fatalf("Unexpected call to CartsRepositoryMock.MergeCarts. %v %v %v", ctx, guestCartID, user)
+	return
+}
+
+// MergeCartsAfterCounter returns a count of finished CartsRepositoryMock.MergeCarts invocations
+func (mmMergeCarts *CartsRepositoryMock) MergeCartsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMergeCarts.afterMergeCartsCounter)
+}
+
+// MergeCartsBeforeCounter returns a count of CartsRepositoryMock.MergeCarts invocations
+func (mmMergeCarts *CartsRepositoryMock) MergeCartsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMergeCarts.beforeMergeCartsCounter)
+}
+
+// Calls returns a list of arguments used in each call to CartsRepositoryMock.MergeCarts.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMergeCarts *mCartsRepositoryMockMergeCarts) Calls() []*CartsRepositoryMockMergeCartsParams {
+	mmMergeCarts.mutex.RLock()
+
+	argCopy := make([]*CartsRepositoryMockMergeCartsParams, len(mmMergeCarts.callArgs))
+	copy(argCopy, mmMergeCarts.callArgs)
+
+	mmMergeCarts.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMergeCartsDone returns true if the count of the MergeCarts invocations corresponds
+// the number of defined expectations
+func (m *CartsRepositoryMock) MinimockMergeCartsDone() bool {
+	for _, e := range m.MergeCartsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MergeCartsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterMergeCartsCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMergeCarts != nil && mm_atomic.LoadUint64(&m.afterMergeCartsCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockMergeCartsInspect logs each unmet expectation
+func (m *CartsRepositoryMock) MinimockMergeCartsInspect() {
+	for _, e := range m.MergeCartsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CartsRepositoryMock.MergeCarts with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MergeCartsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterMergeCartsCounter) < 1 {
+		if m.MergeCartsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to CartsRepositoryMock.MergeCarts")
+		} else {
+			m.t.Errorf("Expected call to CartsRepositoryMock.MergeCarts with params: %#v", *m.MergeCartsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMergeCarts != nil && mm_atomic.LoadUint64(&m.afterMergeCartsCounter) < 1 {
+		m.t.Error("Expected call to CartsRepositoryMock.MergeCarts")
+	}
+}
+
+type mCartsRepositoryMockProlongGuestCart struct {
+	mock               *CartsRepositoryMock
+	defaultExpectation *CartsRepositoryMockProlongGuestCartExpectation
+	expectations       []*CartsRepositoryMockProlongGuestCartExpectation
+
+	callArgs []*CartsRepositoryMockProlongGuestCartParams
+	mutex    sync.RWMutex
+}
+
+// CartsRepositoryMockProlongGuestCartExpectation specifies expectation struct of the CartsRepository.ProlongGuestCart
+type CartsRepositoryMockProlongGuestCartExpectation struct {
+	mock    *CartsRepositoryMock
+	params  *CartsRepositoryMockProlongGuestCartParams
+	results *CartsRepositoryMockProlongGuestCartResults
+	Counter uint64
+}
+
+// CartsRepositoryMockProlongGuestCartParams contains parameters of the CartsRepository.ProlongGuestCart
+type CartsRepositoryMockProlongGuestCartParams struct {
+	ctx       context.Context
+	sessionID string
+	now       time.Time
+	expiresAt time.Time
+}
+
+// CartsRepositoryMockProlongGuestCartResults contains results of the CartsRepository.ProlongGuestCart
+type CartsRepositoryMockProlongGuestCartResults struct {
+	i1  int64
+	err error
+}
+
+// Expect sets up expected params for CartsRepository.ProlongGuestCart
+func (mmProlongGuestCart *mCartsRepositoryMockProlongGuestCart) Expect(ctx context.Context, sessionID string, now time.Time, expiresAt time.Time) *mCartsRepositoryMockProlongGuestCart {
+	if mmProlongGuestCart.mock.funcProlongGuestCart != nil {
+		mmProlongGuestCart.mock.t.Fatalf("CartsRepositoryMock.ProlongGuestCart mock is already set by Set")
+	}
+
+	if mmProlongGuestCart.defaultExpectation == nil {
+		mmProlongGuestCart.defaultExpectation = &CartsRepositoryMockProlongGuestCartExpectation{}
+	}
+
+	mmProlongGuestCart.defaultExpectation.params = &CartsRepositoryMockProlongGuestCartParams{ctx, sessionID, now, expiresAt}
+	for _, e := range mmProlongGuestCart.expectations {
+		if minimock.Equal(e.params, mmProlongGuestCart.defaultExpectation.params) {
+			mmProlongGuestCart.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmProlongGuestCart.defaultExpectation.params)
+		}
+	}
+
+	return mmProlongGuestCart
+}
+
+// Inspect accepts an inspector function that has same arguments as the CartsRepository.ProlongGuestCart
+func (mmProlongGuestCart *mCartsRepositoryMockProlongGuestCart) Inspect(f func(ctx context.Context, sessionID string, now time.Time, expiresAt time.Time)) *mCartsRepositoryMockProlongGuestCart {
+	if mmProlongGuestCart.mock.inspectFuncProlongGuestCart != nil {
+		mmProlongGuestCart.mock.t.Fatalf("Inspect function is already set for CartsRepositoryMock.ProlongGuestCart")
+	}
+
+	mmProlongGuestCart.mock.inspectFuncProlongGuestCart = f
+
+	return mmProlongGuestCart
+}
+
+// Return sets up results that will be returned by CartsRepository.ProlongGuestCart
+func (mmProlongGuestCart *mCartsRepositoryMockProlongGuestCart) Return(i1 int64, err error) *CartsRepositoryMock {
+	if mmProlongGuestCart.mock.funcProlongGuestCart != nil {
+		mmProlongGuestCart.mock.t.Fatalf("CartsRepositoryMock.ProlongGuestCart mock is already set by Set")
+	}
+
+	if mmProlongGuestCart.defaultExpectation == nil {
+		mmProlongGuestCart.defaultExpectation = &CartsRepositoryMockProlongGuestCartExpectation{mock: mmProlongGuestCart.mock}
+	}
+	mmProlongGuestCart.defaultExpectation.results = &CartsRepositoryMockProlongGuestCartResults{i1, err}
+	return mmProlongGuestCart.mock
+}
+
+// Set uses given function f to mock the CartsRepository.ProlongGuestCart method
+func (mmProlongGuestCart *mCartsRepositoryMockProlongGuestCart) Set(f func(ctx context.Context, sessionID string, now time.Time, expiresAt time.Time) (i1 int64, err error)) *CartsRepositoryMock {
+	if mmProlongGuestCart.defaultExpectation != nil {
+		mmProlongGuestCart.mock.t.Fatalf("Default expectation is already set for the CartsRepository.ProlongGuestCart method")
+	}
+
+	if len(mmProlongGuestCart.expectations) > 0 {
+		mmProlongGuestCart.mock.t.Fatalf("Some expectations are already set for the CartsRepository.ProlongGuestCart method")
+	}
+
+	mmProlongGuestCart.mock.funcProlongGuestCart = f
+	return mmProlongGuestCart.mock
+}
+
+// When sets expectation for the CartsRepository.ProlongGuestCart which will trigger the result defined by the following
+// Then helper
+func (mmProlongGuestCart *mCartsRepositoryMockProlongGuestCart) When(ctx context.Context, sessionID string, now time.Time, expiresAt time.Time) *CartsRepositoryMockProlongGuestCartExpectation {
+	if mmProlongGuestCart.mock.funcProlongGuestCart != nil {
+		mmProlongGuestCart.mock.t.Fatalf("CartsRepositoryMock.ProlongGuestCart mock is already set by Set")
+	}
+
+	expectation := &CartsRepositoryMockProlongGuestCartExpectation{
+		mock:   mmProlongGuestCart.mock,
+		params: &CartsRepositoryMockProlongGuestCartParams{ctx, sessionID, now, expiresAt},
+	}
+	mmProlongGuestCart.expectations = append(mmProlongGuestCart.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CartsRepository.ProlongGuestCart return parameters for the expectation previously defined by the When method
+func (e *CartsRepositoryMockProlongGuestCartExpectation) Then(i1 int64, err error) *CartsRepositoryMock {
+	e.results = &CartsRepositoryMockProlongGuestCartResults{i1, err}
+	return e.mock
+}
+
+// ProlongGuestCart implements CartsRepository
+func (mmProlongGuestCart *CartsRepositoryMock) ProlongGuestCart(ctx context.Context, sessionID string, now time.Time, expiresAt time.Time) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmProlongGuestCart.beforeProlongGuestCartCounter, 1)
+	defer mm_atomic.AddUint64(&mmProlongGuestCart.afterProlongGuestCartCounter, 1)
+
+	if mmProlongGuestCart.inspectFuncProlongGuestCart != nil {
+		mmProlongGuestCart.inspectFuncProlongGuestCart(ctx, sessionID, now, expiresAt)
+	}
+
+	mm_params := &CartsRepositoryMockProlongGuestCartParams{ctx, sessionID, now, expiresAt}
+
+	// Record call args
+	mmProlongGuestCart.ProlongGuestCartMock.mutex.Lock()
+	mmProlongGuestCart.ProlongGuestCartMock.callArgs = append(mmProlongGuestCart.ProlongGuestCartMock.callArgs, mm_params)
+	mmProlongGuestCart.ProlongGuestCartMock.mutex.Unlock()
+
+	for _, e := range mmProlongGuestCart.ProlongGuestCartMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmProlongGuestCart.ProlongGuestCartMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmProlongGuestCart.ProlongGuestCartMock.defaultExpectation.Counter, 1)
+		mm_want := mmProlongGuestCart.ProlongGuestCartMock.defaultExpectation.params
+		mm_got := CartsRepositoryMockProlongGuestCartParams{ctx, sessionID, now, expiresAt}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmProlongGuestCart.t.Errorf("CartsRepositoryMock.ProlongGuestCart got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmProlongGuestCart.ProlongGuestCartMock.defaultExpectation.results
+		if mm_results == nil {
+			mmProlongGuestCart.t.Fatal("No results are set for the CartsRepositoryMock.ProlongGuestCart")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmProlongGuestCart.funcProlongGuestCart != nil {
+		return mmProlongGuestCart.funcProlongGuestCart(ctx, sessionID, now, expiresAt)
+	}
+	mmProlongGuestCart.t.Fatalf("Unexpected call to CartsRepositoryMock.ProlongGuestCart. %v %v %v %v", ctx, sessionID, now, expiresAt)
+	return
+}
+
+// ProlongGuestCartAfterCounter returns a count of finished CartsRepositoryMock.ProlongGuestCart invocations
+func (mmProlongGuestCart *CartsRepositoryMock) ProlongGuestCartAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmProlongGuestCart.afterProlongGuestCartCounter)
+}
+
+// ProlongGuestCartBeforeCounter returns a count of CartsRepositoryMock.ProlongGuestCart invocations
+func (mmProlongGuestCart *CartsRepositoryMock) ProlongGuestCartBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmProlongGuestCart.beforeProlongGuestCartCounter)
+}
+
+// Calls returns a list of arguments used in each call to CartsRepositoryMock.ProlongGuestCart.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmProlongGuestCart *mCartsRepositoryMockProlongGuestCart) Calls() []*CartsRepositoryMockProlongGuestCartParams {
+	mmProlongGuestCart.mutex.RLock()
+
+	argCopy := make([]*CartsRepositoryMockProlongGuestCartParams, len(mmProlongGuestCart.callArgs))
+	copy(argCopy, mmProlongGuestCart.callArgs)
+
+	mmProlongGuestCart.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockProlongGuestCartDone returns true if the count of the ProlongGuestCart invocations corresponds
+// the number of defined expectations
+func (m *CartsRepositoryMock) MinimockProlongGuestCartDone() bool {
+	for _, e := range m.ProlongGuestCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ProlongGuestCartMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterProlongGuestCartCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcProlongGuestCart != nil && mm_atomic.LoadUint64(&m.afterProlongGuestCartCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockProlongGuestCartInspect logs each unmet expectation
+func (m *CartsRepositoryMock) MinimockProlongGuestCartInspect() {
+	for _, e := range m.ProlongGuestCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CartsRepositoryMock.ProlongGuestCart with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ProlongGuestCartMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterProlongGuestCartCounter) < 1 {
+		if m.ProlongGuestCartMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to CartsRepositoryMock.ProlongGuestCart")
+		} else {
+			m.t.Errorf("Expected call to CartsRepositoryMock.ProlongGuestCart with params: %#v", *m.ProlongGuestCartMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcProlongGuestCart != nil && mm_atomic.LoadUint64(&m.afterProlongGuestCartCounter) < 1 {
+		m.t.Error("Expected call to CartsRepositoryMock.ProlongGuestCart")
+	}
+}
+
+type mCartsRepositoryMockSaveItem struct {
+	mock               *CartsRepositoryMock
+	defaultExpectation *CartsRepositoryMockSaveItemExpectation
+	expectations       []*CartsRepositoryMockSaveItemExpectation
+
+	callArgs []*CartsRepositoryMockSaveItemParams
+	mutex    sync.RWMutex
+}
+
+// CartsRepositoryMockSaveItemExpectation specifies expectation struct of the CartsRepository.SaveItem
+type CartsRepositoryMockSaveItemExpectation struct {
+	mock    *CartsRepositoryMock
+	params  *CartsRepositoryMockSaveItemParams
+	results *CartsRepositoryMockSaveItemResults
+	Counter uint64
+}
+
+// CartsRepositoryMockSaveItemParams contains parameters of the CartsRepository.SaveItem
+type CartsRepositoryMockSaveItemParams struct {
+	ctx   context.Context
+	user  int64
+	sku   uint32
+	count uint16
+}
+
+// CartsRepositoryMockSaveItemResults contains results of the CartsRepository.SaveItem
+type CartsRepositoryMockSaveItemResults struct {
+	err error
+}
+
+// Expect sets up expected params for CartsRepository.SaveItem
+func (mmSaveItem *mCartsRepositoryMockSaveItem) Expect(ctx context.Context, user int64, sku uint32, count uint16) *mCartsRepositoryMockSaveItem {
+	if mmSaveItem.mock.funcSaveItem != nil {
+		mmSaveItem.mock.t.Fatalf("CartsRepositoryMock.SaveItem mock is already set by Set")
+	}
+
+	if mmSaveItem.defaultExpectation == nil {
+		mmSaveItem.defaultExpectation = &CartsRepositoryMockSaveItemExpectation{}
+	}
+
+	mmSaveItem.defaultExpectation.params = &CartsRepositoryMockSaveItemParams{ctx, user, sku, count}
+	for _, e := range mmSaveItem.expectations {
+		if minimock.Equal(e.params, mmSaveItem.defaultExpectation.params) {
+			mmSaveItem.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveItem.defaultExpectation.params)
+		}
+	}
+
+	return mmSaveItem
+}
+
+// Inspect accepts an inspector function that has same arguments as the CartsRepository.SaveItem
+func (mmSaveItem *mCartsRepositoryMockSaveItem) Inspect(f func(ctx context.Context, user int64, sku uint32, count uint16)) *mCartsRepositoryMockSaveItem {
+	if mmSaveItem.mock.inspectFuncSaveItem != nil {
+		mmSaveItem.mock.t.Fatalf("Inspect function is already set for CartsRepositoryMock.SaveItem")
+	}
+
+	mmSaveItem.mock.inspectFuncSaveItem = f
+
+	return mmSaveItem
+}
+
+// Return sets up results that will be returned by CartsRepository.SaveItem
+func (mmSaveItem *mCartsRepositoryMockSaveItem) Return(err error) *CartsRepositoryMock {
+	if mmSaveItem.mock.funcSaveItem != nil {
+		mmSaveItem.mock.t.Fatalf("CartsRepositoryMock.SaveItem mock is already set by Set")
+	}
+
+	if mmSaveItem.defaultExpectation == nil {
+		mmSaveItem.defaultExpectation = &CartsRepositoryMockSaveItemExpectation{mock: mmSaveItem.mock}
+	}
+	mmSaveItem.defaultExpectation.results = &CartsRepositoryMockSaveItemResults{err}
+	return mmSaveItem.mock
+}
+
+// Set uses given function f to mock the CartsRepository.SaveItem method
+func (mmSaveItem *mCartsRepositoryMockSaveItem) Set(f func(ctx context.Context, user int64, sku uint32, count uint16) (err error)) *CartsRepositoryMock {
+	if mmSaveItem.defaultExpectation != nil {
+		mmSaveItem.mock.t.Fatalf("Default expectation is already set for the CartsRepository.SaveItem method")
+	}
+
+	if len(mmSaveItem.expectations) > 0 {
+		mmSaveItem.mock.t.Fatalf("Some expectations are already set for the CartsRepository.SaveItem method")
+	}
+
+	mmSaveItem.mock.funcSaveItem = f
+	return mmSaveItem.mock
+}
+
+// When sets expectation for the CartsRepository.SaveItem which will trigger the result defined by the following
+// Then helper
+func (mmSaveItem *mCartsRepositoryMockSaveItem) When(ctx context.Context, user int64, sku uint32, count uint16) *CartsRepositoryMockSaveItemExpectation {
+	if mmSaveItem.mock.funcSaveItem != nil {
+		mmSaveItem.mock.t.Fatalf("CartsRepositoryMock.SaveItem mock is already set by Set")
+	}
+
+	expectation := &CartsRepositoryMockSaveItemExpectation{
+		mock:   mmSaveItem.mock,
+		params: &CartsRepositoryMockSaveItemParams{ctx, user, sku, count},
+	}
+	mmSaveItem.expectations = append(mmSaveItem.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CartsRepository.SaveItem return parameters for the expectation previously defined by the When method
+func (e *CartsRepositoryMockSaveItemExpectation) Then(err error) *CartsRepositoryMock {
+	e.results = &CartsRepositoryMockSaveItemResults{err}
+	return e.mock
+}
+
+// SaveItem implements CartsRepository
+func (mmSaveItem *CartsRepositoryMock) SaveItem(ctx context.Context, user int64, sku uint32, count uint16) (err error) {
+	mm_atomic.AddUint64(&mmSaveItem.beforeSaveItemCounter, 1)
+	defer mm_atomic.AddUint64(&mmSaveItem.afterSaveItemCounter, 1)
+
+	if mmSaveItem.inspectFuncSaveItem != nil {
+		mmSaveItem.inspectFuncSaveItem(ctx, user, sku, count)
+	}
+
+	mm_params := &CartsRepositoryMockSaveItemParams{ctx, user, sku, count}
+
+	// Record call args
+	mmSaveItem.SaveItemMock.mutex.Lock()
+	mmSaveItem.SaveItemMock.callArgs = append(mmSaveItem.SaveItemMock.callArgs, mm_params)
+	mmSaveItem.SaveItemMock.mutex.Unlock()
+
+	for _, e := range mmSaveItem.SaveItemMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSaveItem.SaveItemMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSaveItem.SaveItemMock.defaultExpectation.Counter, 1)
+		mm_want := mmSaveItem.SaveItemMock.defaultExpectation.params
+		mm_got := CartsRepositoryMockSaveItemParams{ctx, user, sku, count}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSaveItem.t.Errorf("CartsRepositoryMock.SaveItem got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSaveItem.SaveItemMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSaveItem.t.Fatal("No results are set for the CartsRepositoryMock.SaveItem")
+		}
+		return (*mm_results).err
+	}
+	if mmSaveItem.funcSaveItem != nil {
+		return mmSaveItem.funcSaveItem(ctx, user, sku, count)
+	}
+	mmSaveItem.t.Fatalf("Unexpected call to CartsRepositoryMock.SaveItem. %v %v %v %v", ctx, user, sku, count)
+	return
+}
+
+// SaveItemAfterCounter returns a count of finished CartsRepositoryMock.SaveItem invocations
+func (mmSaveItem *CartsRepositoryMock) SaveItemAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveItem.afterSaveItemCounter)
+}
+
+// SaveItemBeforeCounter returns a count of CartsRepositoryMock.SaveItem invocations
+func (mmSaveItem *CartsRepositoryMock) SaveItemBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveItem.beforeSaveItemCounter)
+}
+
+// Calls returns a list of arguments used in each call to CartsRepositoryMock.SaveItem.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSaveItem *mCartsRepositoryMockSaveItem) Calls() []*CartsRepositoryMockSaveItemParams {
+	mmSaveItem.mutex.RLock()
+
+	argCopy := make([]*CartsRepositoryMockSaveItemParams, len(mmSaveItem.callArgs))
+	copy(argCopy, mmSaveItem.callArgs)
+
+	mmSaveItem.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSaveItemDone returns true if the count of the SaveItem invocations corresponds
+// the number of defined expectations
+func (m *CartsRepositoryMock) MinimockSaveItemDone() bool {
+	for _, e := range m.SaveItemMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveItemMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSaveItemCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSaveItem != nil && mm_atomic.LoadUint64(&m.afterSaveItemCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockSaveItemInspect logs each unmet expectation
+func (m *CartsRepositoryMock) MinimockSaveItemInspect() {
+	for _, e := range m.SaveItemMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CartsRepositoryMock.SaveItem with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveItemMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSaveItemCounter) < 1 {
+		if m.SaveItemMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to CartsRepositoryMock.SaveItem")
+		} else {
+			m.t.Errorf("Expected call to CartsRepositoryMock.SaveItem with params: %#v", *m.SaveItemMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSaveItem != nil && mm_atomic.LoadUint64(&m.afterSaveItemCounter) < 1 {
+		m.t.Error("Expected call to CartsRepositoryMock.SaveItem")
+	}
+}
+
+type mCartsRepositoryMockSavePurchase struct {
+	mock               *CartsRepositoryMock
+	defaultExpectation *CartsRepositoryMockSavePurchaseExpectation
+	expectations       []*CartsRepositoryMockSavePurchaseExpectation
+
+	callArgs []*CartsRepositoryMockSavePurchaseParams
+	mutex    sync.RWMutex
+}
+
+// CartsRepositoryMockSavePurchaseExpectation specifies expectation struct of the CartsRepository.SavePurchase
+type CartsRepositoryMockSavePurchaseExpectation struct {
+	mock    *CartsRepositoryMock
+	params  *CartsRepositoryMockSavePurchaseParams
+	results *CartsRepositoryMockSavePurchaseResults
+	Counter uint64
+}
+
+// CartsRepositoryMockSavePurchaseParams contains parameters of the CartsRepository.SavePurchase
+type CartsRepositoryMockSavePurchaseParams struct {
+	ctx            context.Context
+	user           int64
+	idempotencyKey string
+	orderID        int64
+}
+
+// CartsRepositoryMockSavePurchaseResults contains results of the CartsRepository.SavePurchase
+type CartsRepositoryMockSavePurchaseResults struct {
+	err error
+}
+
+// Expect sets up expected params for CartsRepository.SavePurchase
+func (mmSavePurchase *mCartsRepositoryMockSavePurchase) Expect(ctx context.Context, user int64, idempotencyKey string, orderID int64) *mCartsRepositoryMockSavePurchase {
+	if mmSavePurchase.mock.funcSavePurchase != nil {
+		mmSavePurchase.mock.t.Fatalf("CartsRepositoryMock.SavePurchase mock is already set by Set")
+	}
+
+	if mmSavePurchase.defaultExpectation == nil {
+		mmSavePurchase.defaultExpectation = &CartsRepositoryMockSavePurchaseExpectation{}
+	}
+
+	mmSavePurchase.defaultExpectation.params = &CartsRepositoryMockSavePurchaseParams{ctx, user, idempotencyKey, orderID}
+	for _, e := range mmSavePurchase.expectations {
+		if minimock.Equal(e.params, mmSavePurchase.defaultExpectation.params) {
+			mmSavePurchase.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSavePurchase.defaultExpectation.params)
+		}
+	}
+
+	return mmSavePurchase
+}
+
+// Inspect accepts an inspector function that has same arguments as the CartsRepository.SavePurchase
+func (mmSavePurchase *mCartsRepositoryMockSavePurchase) Inspect(f func(ctx context.Context, user int64, idempotencyKey string, orderID int64)) *mCartsRepositoryMockSavePurchase {
+	if mmSavePurchase.mock.inspectFuncSavePurchase != nil {
+		mmSavePurchase.mock.t.Fatalf("Inspect function is already set for CartsRepositoryMock.SavePurchase")
+	}
+
+	mmSavePurchase.mock.inspectFuncSavePurchase = f
+
+	return mmSavePurchase
+}
+
+// Return sets up results that will be returned by CartsRepository.SavePurchase
+func (mmSavePurchase *mCartsRepositoryMockSavePurchase) Return(err error) *CartsRepositoryMock {
+	if mmSavePurchase.mock.funcSavePurchase != nil {
+		mmSavePurchase.mock.t.Fatalf("CartsRepositoryMock.SavePurchase mock is already set by Set")
+	}
+
+	if mmSavePurchase.defaultExpectation == nil {
+		mmSavePurchase.defaultExpectation = &CartsRepositoryMockSavePurchaseExpectation{mock: mmSavePurchase.mock}
+	}
+	mmSavePurchase.defaultExpectation.results = &CartsRepositoryMockSavePurchaseResults{err}
+	return mmSavePurchase.mock
+}
+
+// Set uses given function f to mock the CartsRepository.SavePurchase method
+func (mmSavePurchase *mCartsRepositoryMockSavePurchase) Set(f func(ctx context.Context, user int64, idempotencyKey string, orderID int64) (err error)) *CartsRepositoryMock {
+	if mmSavePurchase.defaultExpectation != nil {
+		mmSavePurchase.mock.t.Fatalf("Default expectation is already set for the CartsRepository.SavePurchase method")
+	}
+
+	if len(mmSavePurchase.expectations) > 0 {
+		mmSavePurchase.mock.t.Fatalf("Some expectations are already set for the CartsRepository.SavePurchase method")
+	}
+
+	mmSavePurchase.mock.funcSavePurchase = f
+	return mmSavePurchase.mock
+}
+
+// When sets expectation for the CartsRepository.SavePurchase which will trigger the result defined by the following
+// Then helper
+func (mmSavePurchase *mCartsRepositoryMockSavePurchase) When(ctx context.Context, user int64, idempotencyKey string, orderID int64) *CartsRepositoryMockSavePurchaseExpectation {
+	if mmSavePurchase.mock.funcSavePurchase != nil {
+		mmSavePurchase.mock.t.Fatalf("CartsRepositoryMock.SavePurchase mock is already set by Set")
+	}
+
+	expectation := &CartsRepositoryMockSavePurchaseExpectation{
+		mock:   mmSavePurchase.mock,
+		params: &CartsRepositoryMockSavePurchaseParams{ctx, user, idempotencyKey, orderID},
+	}
+	mmSavePurchase.expectations = append(mmSavePurchase.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CartsRepository.SavePurchase return parameters for the expectation previously defined by the When method
+func (e *CartsRepositoryMockSavePurchaseExpectation) Then(err error) *CartsRepositoryMock {
+	e.results = &CartsRepositoryMockSavePurchaseResults{err}
+	return e.mock
+}
+
+// SavePurchase implements CartsRepository
+func (mmSavePurchase *CartsRepositoryMock) SavePurchase(ctx context.Context, user int64, idempotencyKey string, orderID int64) (err error) {
+	mm_atomic.AddUint64(&mmSavePurchase.beforeSavePurchaseCounter, 1)
+	defer mm_atomic.AddUint64(&mmSavePurchase.afterSavePurchaseCounter, 1)
+
+	if mmSavePurchase.inspectFuncSavePurchase != nil {
+		mmSavePurchase.inspectFuncSavePurchase(ctx, user, idempotencyKey, orderID)
+	}
+
+	mm_params := &CartsRepositoryMockSavePurchaseParams{ctx, user, idempotencyKey, orderID}
+
+	// Record call args
+	mmSavePurchase.SavePurchaseMock.mutex.Lock()
+	mmSavePurchase.SavePurchaseMock.callArgs = append(mmSavePurchase.SavePurchaseMock.callArgs, mm_params)
+	mmSavePurchase.SavePurchaseMock.mutex.Unlock()
+
+	for _, e := range mmSavePurchase.SavePurchaseMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSavePurchase.SavePurchaseMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSavePurchase.SavePurchaseMock.defaultExpectation.Counter, 1)
+		mm_want := mmSavePurchase.SavePurchaseMock.defaultExpectation.params
+		mm_got := CartsRepositoryMockSavePurchaseParams{ctx, user, idempotencyKey, orderID}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSavePurchase.t.Errorf("CartsRepositoryMock.SavePurchase got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSavePurchase.SavePurchaseMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSavePurchase.t.Fatal("No results are set for the CartsRepositoryMock.SavePurchase")
+		}
+		return (*mm_results).err
+	}
+	if mmSavePurchase.funcSavePurchase != nil {
+		return mmSavePurchase.funcSavePurchase(ctx, user, idempotencyKey, orderID)
+	}
+	mmSavePurchase.t.Fatalf("Unexpected call to CartsRepositoryMock.SavePurchase. %v %v %v %v", ctx, user, idempotencyKey, orderID)
+	return
+}
+
+// SavePurchaseAfterCounter returns a count of finished CartsRepositoryMock.SavePurchase invocations
+func (mmSavePurchase *CartsRepositoryMock) SavePurchaseAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSavePurchase.afterSavePurchaseCounter)
+}
+
+// SavePurchaseBeforeCounter returns a count of CartsRepositoryMock.SavePurchase invocations
+func (mmSavePurchase *CartsRepositoryMock) SavePurchaseBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSavePurchase.beforeSavePurchaseCounter)
+}
+
+// Calls returns a list of arguments used in each call to CartsRepositoryMock.SavePurchase.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSavePurchase *mCartsRepositoryMockSavePurchase) Calls() []*CartsRepositoryMockSavePurchaseParams {
+	mmSavePurchase.mutex.RLock()
+
+	argCopy := make([]*CartsRepositoryMockSavePurchaseParams, len(mmSavePurchase.callArgs))
+	copy(argCopy, mmSavePurchase.callArgs)
+
+	mmSavePurchase.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSavePurchaseDone returns true if the count of the SavePurchase invocations corresponds
+// the number of defined expectations
+func (m *CartsRepositoryMock) MinimockSavePurchaseDone() bool {
+	for _, e := range m.SavePurchaseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SavePurchaseMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSavePurchaseCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSavePurchase != nil && mm_atomic.LoadUint64(&m.afterSavePurchaseCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockSavePurchaseInspect logs each unmet expectation
+func (m *CartsRepositoryMock) MinimockSavePurchaseInspect() {
+	for _, e := range m.SavePurchaseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CartsRepositoryMock.SavePurchase with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SavePurchaseMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSavePurchaseCounter) < 1 {
+		if m.SavePurchaseMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to CartsRepositoryMock.SavePurchase")
+		} else {
+			m.t.Errorf("Expected call to CartsRepositoryMock.SavePurchase with params: %#v", *m.SavePurchaseMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSavePurchase != nil && mm_atomic.LoadUint64(&m.afterSavePurchaseCounter) < 1 {
+		m.t.Error("Expected call to CartsRepositoryMock.SavePurchase")
+	}
+}
+
+type mCartsRepositoryMockSetCartItemCount struct {
+	mock               *CartsRepositoryMock
+	defaultExpectation *CartsRepositoryMockSetCartItemCountExpectation
+	expectations       []*CartsRepositoryMockSetCartItemCountExpectation
+
+	callArgs []*CartsRepositoryMockSetCartItemCountParams
+	mutex    sync.RWMutex
+}
+
+// CartsRepositoryMockSetCartItemCountExpectation specifies expectation struct of the CartsRepository.SetCartItemCount
+type CartsRepositoryMockSetCartItemCountExpectation struct {
+	mock    *CartsRepositoryMock
+	params  *CartsRepositoryMockSetCartItemCountParams
+	results *CartsRepositoryMockSetCartItemCountResults
+	Counter uint64
+}
+
+// CartsRepositoryMockSetCartItemCountParams contains parameters of the CartsRepository.SetCartItemCount
+type CartsRepositoryMockSetCartItemCountParams struct {
+	ctx   context.Context
+	owner CartOwner
+	sku   uint32
+	count uint16
+}
+
+// CartsRepositoryMockSetCartItemCountResults contains results of the CartsRepository.SetCartItemCount
+type CartsRepositoryMockSetCartItemCountResults struct {
+	err error
+}
+
+// Expect sets up expected params for CartsRepository.SetCartItemCount
+func (mmSetCartItemCount *mCartsRepositoryMockSetCartItemCount) Expect(ctx context.Context, owner CartOwner, sku uint32, count uint16) *mCartsRepositoryMockSetCartItemCount {
+	if mmSetCartItemCount.mock.funcSetCartItemCount != nil {
+		mmSetCartItemCount.mock.t.Fatalf("CartsRepositoryMock.SetCartItemCount mock is already set by Set")
+	}
+
+	if mmSetCartItemCount.defaultExpectation == nil {
+		mmSetCartItemCount.defaultExpectation = &CartsRepositoryMockSetCartItemCountExpectation{}
+	}
+
+	mmSetCartItemCount.defaultExpectation.params = &CartsRepositoryMockSetCartItemCountParams{ctx, owner, sku, count}
+	for _, e := range mmSetCartItemCount.expectations {
+		if minimock.Equal(e.params, mmSetCartItemCount.defaultExpectation.params) {
+			mmSetCartItemCount.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetCartItemCount.defaultExpectation.params)
+		}
+	}
+
+	return mmSetCartItemCount
+}
+
+// Inspect accepts an inspector function that has same arguments as the CartsRepository.SetCartItemCount
+func (mmSetCartItemCount *mCartsRepositoryMockSetCartItemCount) Inspect(f func(ctx context.Context, owner CartOwner, sku uint32, count uint16)) *mCartsRepositoryMockSetCartItemCount {
+	if mmSetCartItemCount.mock.inspectFuncSetCartItemCount != nil {
+		mmSetCartItemCount.mock.t.Fatalf("Inspect function is already set for CartsRepositoryMock.SetCartItemCount")
+	}
+
+	mmSetCartItemCount.mock.inspectFuncSetCartItemCount = f
+
+	return mmSetCartItemCount
+}
+
+// Return sets up results that will be returned by CartsRepository.SetCartItemCount
+func (mmSetCartItemCount *mCartsRepositoryMockSetCartItemCount) Return(err error) *CartsRepositoryMock {
+	if mmSetCartItemCount.mock.funcSetCartItemCount != nil {
+		mmSetCartItemCount.mock.t.Fatalf("CartsRepositoryMock.SetCartItemCount mock is already set by Set")
+	}
+
+	if mmSetCartItemCount.defaultExpectation == nil {
+		mmSetCartItemCount.defaultExpectation = &CartsRepositoryMockSetCartItemCountExpectation{mock: mmSetCartItemCount.mock}
+	}
+	mmSetCartItemCount.defaultExpectation.results = &CartsRepositoryMockSetCartItemCountResults{err}
+	return mmSetCartItemCount.mock
+}
+
+// Set uses given function f to mock the CartsRepository.SetCartItemCount method
+func (mmSetCartItemCount *mCartsRepositoryMockSetCartItemCount) Set(f func(ctx context.Context, owner CartOwner, sku uint32, count uint16) (err error)) *CartsRepositoryMock {
+	if mmSetCartItemCount.defaultExpectation != nil {
+		mmSetCartItemCount.mock.t.Fatalf("Default expectation is already set for the CartsRepository.SetCartItemCount method")
+	}
+
+	if len(mmSetCartItemCount.expectations) > 0 {
+		mmSetCartItemCount.mock.t.Fatalf("Some expectations are already set for the CartsRepository.SetCartItemCount method")
+	}
+
+	mmSetCartItemCount.mock.funcSetCartItemCount = f
+	return mmSetCartItemCount.mock
+}
+
+// When sets expectation for the CartsRepository.SetCartItemCount which will trigger the result defined by the following
+// Then helper
+func (mmSetCartItemCount *mCartsRepositoryMockSetCartItemCount) When(ctx context.Context, owner CartOwner, sku uint32, count uint16) *CartsRepositoryMockSetCartItemCountExpectation {
+	if mmSetCartItemCount.mock.funcSetCartItemCount != nil {
+		mmSetCartItemCount.mock.t.Fatalf("CartsRepositoryMock.SetCartItemCount mock is already set by Set")
+	}
+
+	expectation := &CartsRepositoryMockSetCartItemCountExpectation{
+		mock:   mmSetCartItemCount.mock,
+		params: &CartsRepositoryMockSetCartItemCountParams{ctx, owner, sku, count},
+	}
+	mmSetCartItemCount.expectations = append(mmSetCartItemCount.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CartsRepository.SetCartItemCount return parameters for the expectation previously defined by the When method
+func (e *CartsRepositoryMockSetCartItemCountExpectation) Then(err error) *CartsRepositoryMock {
+	e.results = &CartsRepositoryMockSetCartItemCountResults{err}
+	return e.mock
+}
+
+// SetCartItemCount implements CartsRepository
+func (mmSetCartItemCount *CartsRepositoryMock) SetCartItemCount(ctx context.Context, owner CartOwner, sku uint32, count uint16) (err error) {
+	mm_atomic.AddUint64(&mmSetCartItemCount.beforeSetCartItemCountCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetCartItemCount.afterSetCartItemCountCounter, 1)
+
+	if mmSetCartItemCount.inspectFuncSetCartItemCount != nil {
+		mmSetCartItemCount.inspectFuncSetCartItemCount(ctx, owner, sku, count)
+	}
+
+	mm_params := &CartsRepositoryMockSetCartItemCountParams{ctx, owner, sku, count}
+
+	// Record call args
+	mmSetCartItemCount.SetCartItemCountMock.mutex.Lock()
+	mmSetCartItemCount.SetCartItemCountMock.callArgs = append(mmSetCartItemCount.SetCartItemCountMock.callArgs, mm_params)
+	mmSetCartItemCount.SetCartItemCountMock.mutex.Unlock()
+
+	for _, e := range mmSetCartItemCount.SetCartItemCountMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSetCartItemCount.SetCartItemCountMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetCartItemCount.SetCartItemCountMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetCartItemCount.SetCartItemCountMock.defaultExpectation.params
+		mm_got := CartsRepositoryMockSetCartItemCountParams{ctx, owner, sku, count}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetCartItemCount.t.Errorf("CartsRepositoryMock.SetCartItemCount got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetCartItemCount.SetCartItemCountMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetCartItemCount.t.Fatal("No results are set for the CartsRepositoryMock.SetCartItemCount")
+		}
+		return (*mm_results).err
+	}
+	if mmSetCartItemCount.funcSetCartItemCount != nil {
+		return mmSetCartItemCount.funcSetCartItemCount(ctx, owner, sku, count)
+	}
+	mmSetCartItemCount.t.Fatalf("Unexpected call to CartsRepositoryMock.SetCartItemCount. %v %v %v %v", ctx, owner, sku, count)
+	return
+}
+
+// SetCartItemCountAfterCounter returns a count of finished CartsRepositoryMock.SetCartItemCount invocations
+func (mmSetCartItemCount *CartsRepositoryMock) SetCartItemCountAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetCartItemCount.afterSetCartItemCountCounter)
+}
+
+// SetCartItemCountBeforeCounter returns a count of CartsRepositoryMock.SetCartItemCount invocations
+func (mmSetCartItemCount *CartsRepositoryMock) SetCartItemCountBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetCartItemCount.beforeSetCartItemCountCounter)
+}
+
+// Calls returns a list of arguments used in each call to CartsRepositoryMock.SetCartItemCount.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetCartItemCount *mCartsRepositoryMockSetCartItemCount) Calls() []*CartsRepositoryMockSetCartItemCountParams {
+	mmSetCartItemCount.mutex.RLock()
+
+	argCopy := make([]*CartsRepositoryMockSetCartItemCountParams, len(mmSetCartItemCount.callArgs))
+	copy(argCopy, mmSetCartItemCount.callArgs)
+
+	mmSetCartItemCount.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetCartItemCountDone returns true if the count of the SetCartItemCount invocations corresponds
+// the number of defined expectations
+func (m *CartsRepositoryMock) MinimockSetCartItemCountDone() bool {
+	for _, e := range m.SetCartItemCountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetCartItemCountMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSetCartItemCountCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetCartItemCount != nil && mm_atomic.LoadUint64(&m.afterSetCartItemCountCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockSetCartItemCountInspect logs each unmet expectation
+func (m *CartsRepositoryMock) MinimockSetCartItemCountInspect() {
+	for _, e := range m.SetCartItemCountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CartsRepositoryMock.SetCartItemCount with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetCartItemCountMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSetCartItemCountCounter) < 1 {
+		if m.SetCartItemCountMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to CartsRepositoryMock.SetCartItemCount")
+		} else {
+			m.t.Errorf("Expected call to CartsRepositoryMock.SetCartItemCount with params: %#v", *m.SetCartItemCountMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetCartItemCount != nil && mm_atomic.LoadUint64(&m.afterSetCartItemCountCounter) < 1 {
+		m.t.Error("Expected call to CartsRepositoryMock.SetCartItemCount")
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *CartsRepositoryMock) MinimockFinish() {
+	if !m.minimockDone() {
+		m.MinimockAddToCartInspect()
+
+		m.MinimockCreateGuestCartInspect()
+
+		m.MinimockDeleteCartInspect()
+
+		m.MinimockDeleteExpiredGuestCartsInspect()
+
+		m.MinimockDeleteFromCartInspect()
+
+		m.MinimockDeleteGuestCartInspect()
+
+		m.MinimockDeleteSavedItemInspect()
+
+		m.MinimockGetCartInspect()
 
 		m.MinimockGetCartItemInspect()
+
+		m.MinimockGetPurchaseInspect()
+
+		m.MinimockGetSavedItemInspect()
+
+		m.MinimockGetSavedItemsInspect()
+
+		m.MinimockMergeCartsInspect()
+
+		m.MinimockProlongGuestCartInspect()
+
+		m.MinimockSaveItemInspect()
+
+		m.MinimockSavePurchaseInspect()
+
+		m.MinimockSetCartItemCountInspect()
 		m.t.FailNow()
 	}
 }
@@ -1197,8 +3940,20 @@ func (m *CartsRepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
 		m.MinimockAddToCartDone() &&
+		m.MinimockCreateGuestCartDone() &&
 		m.MinimockDeleteCartDone() &&
+		m.MinimockDeleteExpiredGuestCartsDone() &&
 		m.MinimockDeleteFromCartDone() &&
+		m.MinimockDeleteGuestCartDone() &&
+		m.MinimockDeleteSavedItemDone() &&
 		m.MinimockGetCartDone() &&
-		m.MinimockGetCartItemDone()
+		m.MinimockGetCartItemDone() &&
+		m.MinimockGetPurchaseDone() &&
+		m.MinimockGetSavedItemDone() &&
+		m.MinimockGetSavedItemsDone() &&
+		m.MinimockMergeCartsDone() &&
+		m.MinimockProlongGuestCartDone() &&
+		m.MinimockSaveItemDone() &&
+		m.MinimockSavePurchaseDone() &&
+		m.MinimockSetCartItemCountDone()
 }
@@ -8,6 +8,7 @@ import (
 	"context"
 	"sync"
 	mm_atomic "sync/atomic"
+	"time"
 	mm_time "time"
 
 	"github.com/gojuno/minimock/v3"
@@ -17,12 +18,30 @@ import (
 type LOMSCallerMock struct {
 	t minimock.Tester
 
-	funcCreateOrder          func(ctx context.Context, user int64, cartItems []CartItem) (i1 int64, err error)
-	inspectFuncCreateOrder   func(ctx context.Context, user int64, cartItems []CartItem)
+	funcBatchStocks          func(ctx context.Context, skus []uint32) (m1 map[uint32][]Stock, err error)
+	inspectFuncBatchStocks   func(ctx context.Context, skus []uint32)
+	afterBatchStocksCounter  uint64
+	beforeBatchStocksCounter uint64
+	BatchStocksMock          mLOMSCallerMockBatchStocks
+
+	funcCreateOrder          func(ctx context.Context, user int64, cartItems []CartItem, idempotencyKey string) (i1 int64, err error)
+	inspectFuncCreateOrder   func(ctx context.Context, user int64, cartItems []CartItem, idempotencyKey string)
 	afterCreateOrderCounter  uint64
 	beforeCreateOrderCounter uint64
 	CreateOrderMock          mLOMSCallerMockCreateOrder
 
+	funcHoldStock          func(ctx context.Context, user int64, sku uint32, count uint16, ttl time.Duration) (err error)
+	inspectFuncHoldStock   func(ctx context.Context, user int64, sku uint32, count uint16, ttl time.Duration)
+	afterHoldStockCounter  uint64
+	beforeHoldStockCounter uint64
+	HoldStockMock          mLOMSCallerMockHoldStock
+
+	funcReleaseHold          func(ctx context.Context, user int64, sku uint32) (err error)
+	inspectFuncReleaseHold   func(ctx context.Context, user int64, sku uint32)
+	afterReleaseHoldCounter  uint64
+	beforeReleaseHoldCounter uint64
+	ReleaseHoldMock          mLOMSCallerMockReleaseHold
+
 	funcStocks          func(ctx context.Context, sku uint32) (sa1 []Stock, err error)
 	inspectFuncStocks   func(ctx context.Context, sku uint32)
 	afterStocksCounter  uint64
@@ -37,15 +56,241 @@ func NewLOMSCallerMock(t minimock.Tester) *LOMSCallerMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.BatchStocksMock = mLOMSCallerMockBatchStocks{mock: m}
+	m.BatchStocksMock.callArgs = []*LOMSCallerMockBatchStocksParams{}
+
 	m.CreateOrderMock = mLOMSCallerMockCreateOrder{mock: m}
 	m.CreateOrderMock.callArgs = []*LOMSCallerMockCreateOrderParams{}
 
+	m.HoldStockMock = mLOMSCallerMockHoldStock{mock: m}
+	m.HoldStockMock.callArgs = []*LOMSCallerMockHoldStockParams{}
+
+	m.ReleaseHoldMock = mLOMSCallerMockReleaseHold{mock: m}
+	m.ReleaseHoldMock.callArgs = []*LOMSCallerMockReleaseHoldParams{}
+
 	m.StocksMock = mLOMSCallerMockStocks{mock: m}
 	m.StocksMock.callArgs = []*LOMSCallerMockStocksParams{}
 
 	return m
 }
 
+type mLOMSCallerMockBatchStocks struct {
+	mock               *LOMSCallerMock
+	defaultExpectation *LOMSCallerMockBatchStocksExpectation
+	expectations       []*LOMSCallerMockBatchStocksExpectation
+
+	callArgs []*LOMSCallerMockBatchStocksParams
+	mutex    sync.RWMutex
+}
+
+// LOMSCallerMockBatchStocksExpectation specifies expectation struct of the LOMSCaller.BatchStocks
+type LOMSCallerMockBatchStocksExpectation struct {
+	mock    *LOMSCallerMock
+	params  *LOMSCallerMockBatchStocksParams
+	results *LOMSCallerMockBatchStocksResults
+	Counter uint64
+}
+
+// LOMSCallerMockBatchStocksParams contains parameters of the LOMSCaller.BatchStocks
+type LOMSCallerMockBatchStocksParams struct {
+	ctx  context.Context
+	skus []uint32
+}
+
+// LOMSCallerMockBatchStocksResults contains results of the LOMSCaller.BatchStocks
+type LOMSCallerMockBatchStocksResults struct {
+	m1  map[uint32][]Stock
+	err error
+}
+
+// Expect sets up expected params for LOMSCaller.BatchStocks
+func (mmBatchStocks *mLOMSCallerMockBatchStocks) Expect(ctx context.Context, skus []uint32) *mLOMSCallerMockBatchStocks {
+	if mmBatchStocks.mock.funcBatchStocks != nil {
+		mmBatchStocks.mock.t.Fatalf("LOMSCallerMock.BatchStocks mock is already set by Set")
+	}
+
+	if mmBatchStocks.defaultExpectation == nil {
+		mmBatchStocks.defaultExpectation = &LOMSCallerMockBatchStocksExpectation{}
+	}
+
+	mmBatchStocks.defaultExpectation.params = &LOMSCallerMockBatchStocksParams{ctx, skus}
+	for _, e := range mmBatchStocks.expectations {
+		if minimock.Equal(e.params, mmBatchStocks.defaultExpectation.params) {
+			mmBatchStocks.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmBatchStocks.defaultExpectation.params)
+		}
+	}
+
+	return mmBatchStocks
+}
+
+// Inspect accepts an inspector function that has same arguments as the LOMSCaller.BatchStocks
+func (mmBatchStocks *mLOMSCallerMockBatchStocks) Inspect(f func(ctx context.Context, skus []uint32)) *mLOMSCallerMockBatchStocks {
+	if mmBatchStocks.mock.inspectFuncBatchStocks != nil {
+		mmBatchStocks.mock.t.Fatalf("Inspect function is already set for LOMSCallerMock.BatchStocks")
+	}
+
+	mmBatchStocks.mock.inspectFuncBatchStocks = f
+
+	return mmBatchStocks
+}
+
+// Return sets up results that will be returned by LOMSCaller.BatchStocks
+func (mmBatchStocks *mLOMSCallerMockBatchStocks) Return(m1 map[uint32][]Stock, err error) *LOMSCallerMock {
+	if mmBatchStocks.mock.funcBatchStocks != nil {
+		mmBatchStocks.mock.t.Fatalf("LOMSCallerMock.BatchStocks mock is already set by Set")
+	}
+
+	if mmBatchStocks.defaultExpectation == nil {
+		mmBatchStocks.defaultExpectation = &LOMSCallerMockBatchStocksExpectation{mock: mmBatchStocks.mock}
+	}
+	mmBatchStocks.defaultExpectation.results = &LOMSCallerMockBatchStocksResults{m1, err}
+	return mmBatchStocks.mock
+}
+
+// Set uses given function f to mock the LOMSCaller.BatchStocks method
+func (mmBatchStocks *mLOMSCallerMockBatchStocks) Set(f func(ctx context.Context, skus []uint32) (m1 map[uint32][]Stock, err error)) *LOMSCallerMock {
+	if mmBatchStocks.defaultExpectation != nil {
+		mmBatchStocks.mock.t.Fatalf("Default expectation is already set for the LOMSCaller.BatchStocks method")
+	}
+
+	if len(mmBatchStocks.expectations) > 0 {
+		mmBatchStocks.mock.t.Fatalf("Some expectations are already set for the LOMSCaller.BatchStocks method")
+	}
+
+	mmBatchStocks.mock.funcBatchStocks = f
+	return mmBatchStocks.mock
+}
+
+// When sets expectation for the LOMSCaller.BatchStocks which will trigger the result defined by the following
+// Then helper
+func (mmBatchStocks *mLOMSCallerMockBatchStocks) When(ctx context.Context, skus []uint32) *LOMSCallerMockBatchStocksExpectation {
+	if mmBatchStocks.mock.funcBatchStocks != nil {
+		mmBatchStocks.mock.t.Fatalf("LOMSCallerMock.BatchStocks mock is already set by Set")
+	}
+
+	expectation := &LOMSCallerMockBatchStocksExpectation{
+		mock:   mmBatchStocks.mock,
+		params: &LOMSCallerMockBatchStocksParams{ctx, skus},
+	}
+	mmBatchStocks.expectations = append(mmBatchStocks.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LOMSCaller.BatchStocks return parameters for the expectation previously defined by the When method
+func (e *LOMSCallerMockBatchStocksExpectation) Then(m1 map[uint32][]Stock, err error) *LOMSCallerMock {
+	e.results = &LOMSCallerMockBatchStocksResults{m1, err}
+	return e.mock
+}
+
+// BatchStocks implements LOMSCaller
+func (mmBatchStocks *LOMSCallerMock) BatchStocks(ctx context.Context, skus []uint32) (m1 map[uint32][]Stock, err error) {
+	mm_atomic.AddUint64(&mmBatchStocks.beforeBatchStocksCounter, 1)
+	defer mm_atomic.AddUint64(&mmBatchStocks.afterBatchStocksCounter, 1)
+
+	if mmBatchStocks.inspectFuncBatchStocks != nil {
+		mmBatchStocks.inspectFuncBatchStocks(ctx, skus)
+	}
+
+	mm_params := &LOMSCallerMockBatchStocksParams{ctx, skus}
+
+	// Record call args
+	mmBatchStocks.BatchStocksMock.mutex.Lock()
+	mmBatchStocks.BatchStocksMock.callArgs = append(mmBatchStocks.BatchStocksMock.callArgs, mm_params)
+	mmBatchStocks.BatchStocksMock.mutex.Unlock()
+
+	for _, e := range mmBatchStocks.BatchStocksMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.m1, e.results.err
+		}
+	}
+
+	if mmBatchStocks.BatchStocksMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmBatchStocks.BatchStocksMock.defaultExpectation.Counter, 1)
+		mm_want := mmBatchStocks.BatchStocksMock.defaultExpectation.params
+		mm_got := LOMSCallerMockBatchStocksParams{ctx, skus}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmBatchStocks.t.Errorf("LOMSCallerMock.BatchStocks got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmBatchStocks.BatchStocksMock.defaultExpectation.results
+		if mm_results == nil {
+			mmBatchStocks.t.Fatal("No results are set for the LOMSCallerMock.BatchStocks")
+		}
+		return (*mm_results).m1, (*mm_results).err
+	}
+	if mmBatchStocks.funcBatchStocks != nil {
+		return mmBatchStocks.funcBatchStocks(ctx, skus)
+	}
+	mmBatchStocks.t.Fatalf("Unexpected call to LOMSCallerMock.BatchStocks. %v %v", ctx, skus)
+	return
+}
+
+// BatchStocksAfterCounter returns a count of finished LOMSCallerMock.BatchStocks invocations
+func (mmBatchStocks *LOMSCallerMock) BatchStocksAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBatchStocks.afterBatchStocksCounter)
+}
+
+// BatchStocksBeforeCounter returns a count of LOMSCallerMock.BatchStocks invocations
+func (mmBatchStocks *LOMSCallerMock) BatchStocksBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBatchStocks.beforeBatchStocksCounter)
+}
+
+// Calls returns a list of arguments used in each call to LOMSCallerMock.BatchStocks.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmBatchStocks *mLOMSCallerMockBatchStocks) Calls() []*LOMSCallerMockBatchStocksParams {
+	mmBatchStocks.mutex.RLock()
+
+	argCopy := make([]*LOMSCallerMockBatchStocksParams, len(mmBatchStocks.callArgs))
+	copy(argCopy, mmBatchStocks.callArgs)
+
+	mmBatchStocks.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockBatchStocksDone returns true if the count of the BatchStocks invocations corresponds
+// the number of defined expectations
+func (m *LOMSCallerMock) MinimockBatchStocksDone() bool {
+	for _, e := range m.BatchStocksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.BatchStocksMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterBatchStocksCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcBatchStocks != nil && mm_atomic.LoadUint64(&m.afterBatchStocksCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockBatchStocksInspect logs each unmet expectation
+func (m *LOMSCallerMock) MinimockBatchStocksInspect() {
+	for _, e := range m.BatchStocksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LOMSCallerMock.BatchStocks with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.BatchStocksMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterBatchStocksCounter) < 1 {
+		if m.BatchStocksMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to LOMSCallerMock.BatchStocks")
+		} else {
+			m.t.Errorf("Expected call to LOMSCallerMock.BatchStocks with params: %#v", *m.BatchStocksMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcBatchStocks != nil && mm_atomic.LoadUint64(&m.afterBatchStocksCounter) < 1 {
+		m.t.Error("Expected call to LOMSCallerMock.BatchStocks")
+	}
+}
+
 type mLOMSCallerMockCreateOrder struct {
 	mock               *LOMSCallerMock
 	defaultExpectation *LOMSCallerMockCreateOrderExpectation
@@ -65,9 +310,10 @@ type LOMSCallerMockCreateOrderExpectation struct {
 
 // LOMSCallerMockCreateOrderParams contains parameters of the LOMSCaller.CreateOrder
 type LOMSCallerMockCreateOrderParams struct {
-	ctx       context.Context
-	user      int64
-	cartItems []CartItem
+	ctx            context.Context
+	user           int64
+	cartItems      []CartItem
+	idempotencyKey string
 }
 
 // LOMSCallerMockCreateOrderResults contains results of the LOMSCaller.CreateOrder
@@ -77,7 +323,7 @@ type LOMSCallerMockCreateOrderResults struct {
 }
 
 // Expect sets up expected params for LOMSCaller.CreateOrder
-func (mmCreateOrder *mLOMSCallerMockCreateOrder) Expect(ctx context.Context, user int64, cartItems []CartItem) *mLOMSCallerMockCreateOrder {
+func (mmCreateOrder *mLOMSCallerMockCreateOrder) Expect(ctx context.Context, user int64, cartItems []CartItem, idempotencyKey string) *mLOMSCallerMockCreateOrder {
 	if mmCreateOrder.mock.funcCreateOrder != nil {
 		mmCreateOrder.mock.t.Fatalf("LOMSCallerMock.CreateOrder mock is already set by Set")
 	}
@@ -86,7 +332,7 @@ func (mmCreateOrder *mLOMSCallerMockCreateOrder) Expect(ctx context.Context, use
 		mmCreateOrder.defaultExpectation = &LOMSCallerMockCreateOrderExpectation{}
 	}
 
-	mmCreateOrder.defaultExpectation.params = &LOMSCallerMockCreateOrderParams{ctx, user, cartItems}
+	mmCreateOrder.defaultExpectation.params = &LOMSCallerMockCreateOrderParams{ctx, user, cartItems, idempotencyKey}
 	for _, e := range mmCreateOrder.expectations {
 		if minimock.Equal(e.params, mmCreateOrder.defaultExpectation.params) {
 			mmCreateOrder.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateOrder.defaultExpectation.params)
@@ -97,7 +343,7 @@ func (mmCreateOrder *mLOMSCallerMockCreateOrder) Expect(ctx context.Context, use
 }
 
 // Inspect accepts an inspector function that has same arguments as the LOMSCaller.CreateOrder
-func (mmCreateOrder *mLOMSCallerMockCreateOrder) Inspect(f func(ctx context.Context, user int64, cartItems []CartItem)) *mLOMSCallerMockCreateOrder {
+func (mmCreateOrder *mLOMSCallerMockCreateOrder) Inspect(f func(ctx context.Context, user int64, cartItems []CartItem, idempotencyKey string)) *mLOMSCallerMockCreateOrder {
 	if mmCreateOrder.mock.inspectFuncCreateOrder != nil {
 		mmCreateOrder.mock.t.Fatalf("Inspect function is already set for LOMSCallerMock.CreateOrder")
 	}
@@ -121,7 +367,7 @@ func (mmCreateOrder *mLOMSCallerMockCreateOrder) Return(i1 int64, err error) *LO
 }
 
 // Set uses given function f to mock the LOMSCaller.CreateOrder method
-func (mmCreateOrder *mLOMSCallerMockCreateOrder) Set(f func(ctx context.Context, user int64, cartItems []CartItem) (i1 int64, err error)) *LOMSCallerMock {
+func (mmCreateOrder *mLOMSCallerMockCreateOrder) Set(f func(ctx context.Context, user int64, cartItems []CartItem, idempotencyKey string) (i1 int64, err error)) *LOMSCallerMock {
 	if mmCreateOrder.defaultExpectation != nil {
 		mmCreateOrder.mock.t.Fatalf("Default expectation is already set for the LOMSCaller.CreateOrder method")
 	}
@@ -136,14 +382,14 @@ func (mmCreateOrder *mLOMSCallerMockCreateOrder) Set(f func(ctx context.Context,
 
 // When sets expectation for the LOMSCaller.CreateOrder which will trigger the result defined by the following
 // Then helper
-func (mmCreateOrder *mLOMSCallerMockCreateOrder) When(ctx context.Context, user int64, cartItems []CartItem) *LOMSCallerMockCreateOrderExpectation {
+func (mmCreateOrder *mLOMSCallerMockCreateOrder) When(ctx context.Context, user int64, cartItems []CartItem, idempotencyKey string) *LOMSCallerMockCreateOrderExpectation {
 	if mmCreateOrder.mock.funcCreateOrder != nil {
 		mmCreateOrder.mock.t.Fatalf("LOMSCallerMock.CreateOrder mock is already set by Set")
 	}
 
 	expectation := &LOMSCallerMockCreateOrderExpectation{
 		mock:   mmCreateOrder.mock,
-		params: &LOMSCallerMockCreateOrderParams{ctx, user, cartItems},
+		params: &LOMSCallerMockCreateOrderParams{ctx, user, cartItems, idempotencyKey},
 	}
 	mmCreateOrder.expectations = append(mmCreateOrder.expectations, expectation)
 	return expectation
@@ -156,15 +402,15 @@ func (e *LOMSCallerMockCreateOrderExpectation) Then(i1 int64, err error) *LOMSCa
 }
 
 // CreateOrder implements LOMSCaller
-func (mmCreateOrder *LOMSCallerMock) CreateOrder(ctx context.Context, user int64, cartItems []CartItem) (i1 int64, err error) {
+func (mmCreateOrder *LOMSCallerMock) CreateOrder(ctx context.Context, user int64, cartItems []CartItem, idempotencyKey string) (i1 int64, err error) {
 	mm_atomic.AddUint64(&mmCreateOrder.beforeCreateOrderCounter, 1)
 	defer mm_atomic.AddUint64(&mmCreateOrder.afterCreateOrderCounter, 1)
 
 	if mmCreateOrder.inspectFuncCreateOrder != nil {
-		mmCreateOrder.inspectFuncCreateOrder(ctx, user, cartItems)
+		mmCreateOrder.inspectFuncCreateOrder(ctx, user, cartItems, idempotencyKey)
 	}
 
-	mm_params := &LOMSCallerMockCreateOrderParams{ctx, user, cartItems}
+	mm_params := &LOMSCallerMockCreateOrderParams{ctx, user, cartItems, idempotencyKey}
 
 	// Record call args
 	mmCreateOrder.CreateOrderMock.mutex.Lock()
@@ -181,7 +427,7 @@ func (mmCreateOrder *LOMSCallerMock) CreateOrder(ctx context.Context, user int64
 	if mmCreateOrder.CreateOrderMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmCreateOrder.CreateOrderMock.defaultExpectation.Counter, 1)
 		mm_want := mmCreateOrder.CreateOrderMock.defaultExpectation.params
-		mm_got := LOMSCallerMockCreateOrderParams{ctx, user, cartItems}
+		mm_got := LOMSCallerMockCreateOrderParams{ctx, user, cartItems, idempotencyKey}
 		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmCreateOrder.t.Errorf("LOMSCallerMock.CreateOrder got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
@@ -193,9 +439,9 @@ func (mmCreateOrder *LOMSCallerMock) CreateOrder(ctx context.Context, user int64
 		return (*mm_results).i1, (*mm_results).err
 	}
 	if mmCreateOrder.funcCreateOrder != nil {
-		return mmCreateOrder.funcCreateOrder(ctx, user, cartItems)
+		return mmCreateOrder.funcCreateOrder(ctx, user, cartItems, idempotencyKey)
 	}
-	mmCreateOrder.t.Fatalf("Unexpected call to LOMSCallerMock.CreateOrder. %v %v %v", ctx, user, cartItems)
+	mmCreateOrder.t.Fatalf("Unexpected call to LOMSCallerMock.CreateOrder. %v %v %v %v", ctx, user, cartItems, idempotencyKey)
 	return
 }
 
@@ -264,6 +510,442 @@ func (m *LOMSCallerMock) MinimockCreateOrderInspect() {
 	}
 }
 
+type mLOMSCallerMockHoldStock struct {
+	mock               *LOMSCallerMock
+	defaultExpectation *LOMSCallerMockHoldStockExpectation
+	expectations       []*LOMSCallerMockHoldStockExpectation
+
+	callArgs []*LOMSCallerMockHoldStockParams
+	mutex    sync.RWMutex
+}
+
+// LOMSCallerMockHoldStockExpectation specifies expectation struct of the LOMSCaller.HoldStock
+type LOMSCallerMockHoldStockExpectation struct {
+	mock    *LOMSCallerMock
+	params  *LOMSCallerMockHoldStockParams
+	results *LOMSCallerMockHoldStockResults
+	Counter uint64
+}
+
+// LOMSCallerMockHoldStockParams contains parameters of the LOMSCaller.HoldStock
+type LOMSCallerMockHoldStockParams struct {
+	ctx   context.Context
+	user  int64
+	sku   uint32
+	count uint16
+	ttl   time.Duration
+}
+
+// LOMSCallerMockHoldStockResults contains results of the LOMSCaller.HoldStock
+type LOMSCallerMockHoldStockResults struct {
+	err error
+}
+
+// Expect sets up expected params for LOMSCaller.HoldStock
+func (mmHoldStock *mLOMSCallerMockHoldStock) Expect(ctx context.Context, user int64, sku uint32, count uint16, ttl time.Duration) *mLOMSCallerMockHoldStock {
+	if mmHoldStock.mock.funcHoldStock != nil {
+		mmHoldStock.mock.t.Fatalf("LOMSCallerMock.HoldStock mock is already set by Set")
+	}
+
+	if mmHoldStock.defaultExpectation == nil {
+		mmHoldStock.defaultExpectation = &LOMSCallerMockHoldStockExpectation{}
+	}
+
+	mmHoldStock.defaultExpectation.params = &LOMSCallerMockHoldStockParams{ctx, user, sku, count, ttl}
+	for _, e := range mmHoldStock.expectations {
+		if minimock.Equal(e.params, mmHoldStock.defaultExpectation.params) {
+			mmHoldStock.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmHoldStock.defaultExpectation.params)
+		}
+	}
+
+	return mmHoldStock
+}
+
+// Inspect accepts an inspector function that has same arguments as the LOMSCaller.HoldStock
+func (mmHoldStock *mLOMSCallerMockHoldStock) Inspect(f func(ctx context.Context, user int64, sku uint32, count uint16, ttl time.Duration)) *mLOMSCallerMockHoldStock {
+	if mmHoldStock.mock.inspectFuncHoldStock != nil {
+		mmHoldStock.mock.t.Fatalf("Inspect function is already set for LOMSCallerMock.HoldStock")
+	}
+
+	mmHoldStock.mock.inspectFuncHoldStock = f
+
+	return mmHoldStock
+}
+
+// Return sets up results that will be returned by LOMSCaller.HoldStock
+func (mmHoldStock *mLOMSCallerMockHoldStock) Return(err error) *LOMSCallerMock {
+	if mmHoldStock.mock.funcHoldStock != nil {
+		mmHoldStock.mock.t.Fatalf("LOMSCallerMock.HoldStock mock is already set by Set")
+	}
+
+	if mmHoldStock.defaultExpectation == nil {
+		mmHoldStock.defaultExpectation = &LOMSCallerMockHoldStockExpectation{mock: mmHoldStock.mock}
+	}
+	mmHoldStock.defaultExpectation.results = &LOMSCallerMockHoldStockResults{err}
+	return mmHoldStock.mock
+}
+
+// Set uses given function f to mock the LOMSCaller.HoldStock method
+func (mmHoldStock *mLOMSCallerMockHoldStock) Set(f func(ctx context.Context, user int64, sku uint32, count uint16, ttl time.Duration) (err error)) *LOMSCallerMock {
+	if mmHoldStock.defaultExpectation != nil {
+		mmHoldStock.mock.t.Fatalf("Default expectation is already set for the LOMSCaller.HoldStock method")
+	}
+
+	if len(mmHoldStock.expectations) > 0 {
+		mmHoldStock.mock.t.Fatalf("Some expectations are already set for the LOMSCaller.HoldStock method")
+	}
+
+	mmHoldStock.mock.funcHoldStock = f
+	return mmHoldStock.mock
+}
+
+// When sets expectation for the LOMSCaller.HoldStock which will trigger the result defined by the following
+// Then helper
+func (mmHoldStock *mLOMSCallerMockHoldStock) When(ctx context.Context, user int64, sku uint32, count uint16, ttl time.Duration) *LOMSCallerMockHoldStockExpectation {
+	if mmHoldStock.mock.funcHoldStock != nil {
+		mmHoldStock.mock.t.Fatalf("LOMSCallerMock.HoldStock mock is already set by Set")
+	}
+
+	expectation := &LOMSCallerMockHoldStockExpectation{
+		mock:   mmHoldStock.mock,
+		params: &LOMSCallerMockHoldStockParams{ctx, user, sku, count, ttl},
+	}
+	mmHoldStock.expectations = append(mmHoldStock.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LOMSCaller.HoldStock return parameters for the expectation previously defined by the When method
+func (e *LOMSCallerMockHoldStockExpectation) Then(err error) *LOMSCallerMock {
+	e.results = &LOMSCallerMockHoldStockResults{err}
+	return e.mock
+}
+
+// HoldStock implements LOMSCaller
+func (mmHoldStock *LOMSCallerMock) HoldStock(ctx context.Context, user int64, sku uint32, count uint16, ttl time.Duration) (err error) {
+	mm_atomic.AddUint64(&mmHoldStock.beforeHoldStockCounter, 1)
+	defer mm_atomic.AddUint64(&mmHoldStock.afterHoldStockCounter, 1)
+
+	if mmHoldStock.inspectFuncHoldStock != nil {
+		mmHoldStock.inspectFuncHoldStock(ctx, user, sku, count, ttl)
+	}
+
+	mm_params := &LOMSCallerMockHoldStockParams{ctx, user, sku, count, ttl}
+
+	// Record call args
+	mmHoldStock.HoldStockMock.mutex.Lock()
+	mmHoldStock.HoldStockMock.callArgs = append(mmHoldStock.HoldStockMock.callArgs, mm_params)
+	mmHoldStock.HoldStockMock.mutex.Unlock()
+
+	for _, e := range mmHoldStock.HoldStockMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmHoldStock.HoldStockMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmHoldStock.HoldStockMock.defaultExpectation.Counter, 1)
+		mm_want := mmHoldStock.HoldStockMock.defaultExpectation.params
+		mm_got := LOMSCallerMockHoldStockParams{ctx, user, sku, count, ttl}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmHoldStock.t.Errorf("LOMSCallerMock.HoldStock got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmHoldStock.HoldStockMock.defaultExpectation.results
+		if mm_results == nil {
+			mmHoldStock.t.Fatal("No results are set for the LOMSCallerMock.HoldStock")
+		}
+		return (*mm_results).err
+	}
+	if mmHoldStock.funcHoldStock != nil {
+		return mmHoldStock.funcHoldStock(ctx, user, sku, count, ttl)
+	}
+	mmHoldStock.t.Fatalf("Unexpected call to LOMSCallerMock.HoldStock. %v %v %v %v %v", ctx, user, sku, count, ttl)
+	return
+}
+
+// HoldStockAfterCounter returns a count of finished LOMSCallerMock.HoldStock invocations
+func (mmHoldStock *LOMSCallerMock) HoldStockAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHoldStock.afterHoldStockCounter)
+}
+
+// HoldStockBeforeCounter returns a count of LOMSCallerMock.HoldStock invocations
+func (mmHoldStock *LOMSCallerMock) HoldStockBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHoldStock.beforeHoldStockCounter)
+}
+
+// Calls returns a list of arguments used in each call to LOMSCallerMock.HoldStock.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmHoldStock *mLOMSCallerMockHoldStock) Calls() []*LOMSCallerMockHoldStockParams {
+	mmHoldStock.mutex.RLock()
+
+	argCopy := make([]*LOMSCallerMockHoldStockParams, len(mmHoldStock.callArgs))
+	copy(argCopy, mmHoldStock.callArgs)
+
+	mmHoldStock.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockHoldStockDone returns true if the count of the HoldStock invocations corresponds
+// the number of defined expectations
+func (m *LOMSCallerMock) MinimockHoldStockDone() bool {
+	for _, e := range m.HoldStockMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.HoldStockMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterHoldStockCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcHoldStock != nil && mm_atomic.LoadUint64(&m.afterHoldStockCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockHoldStockInspect logs each unmet expectation
+func (m *LOMSCallerMock) MinimockHoldStockInspect() {
+	for _, e := range m.HoldStockMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LOMSCallerMock.HoldStock with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.HoldStockMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterHoldStockCounter) < 1 {
+		if m.HoldStockMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to LOMSCallerMock.HoldStock")
+		} else {
+			m.t.Errorf("Expected call to LOMSCallerMock.HoldStock with params: %#v", *m.HoldStockMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcHoldStock != nil && mm_atomic.LoadUint64(&m.afterHoldStockCounter) < 1 {
+		m.t.Error("Expected call to LOMSCallerMock.HoldStock")
+	}
+}
+
+type mLOMSCallerMockReleaseHold struct {
+	mock               *LOMSCallerMock
+	defaultExpectation *LOMSCallerMockReleaseHoldExpectation
+	expectations       []*LOMSCallerMockReleaseHoldExpectation
+
+	callArgs []*LOMSCallerMockReleaseHoldParams
+	mutex    sync.RWMutex
+}
+
+// LOMSCallerMockReleaseHoldExpectation specifies expectation struct of the LOMSCaller.ReleaseHold
+type LOMSCallerMockReleaseHoldExpectation struct {
+	mock    *LOMSCallerMock
+	params  *LOMSCallerMockReleaseHoldParams
+	results *LOMSCallerMockReleaseHoldResults
+	Counter uint64
+}
+
+// LOMSCallerMockReleaseHoldParams contains parameters of the LOMSCaller.ReleaseHold
+type LOMSCallerMockReleaseHoldParams struct {
+	ctx  context.Context
+	user int64
+	sku  uint32
+}
+
+// LOMSCallerMockReleaseHoldResults contains results of the LOMSCaller.ReleaseHold
+type LOMSCallerMockReleaseHoldResults struct {
+	err error
+}
+
+// Expect sets up expected params for LOMSCaller.ReleaseHold
+func (mmReleaseHold *mLOMSCallerMockReleaseHold) Expect(ctx context.Context, user int64, sku uint32) *mLOMSCallerMockReleaseHold {
+	if mmReleaseHold.mock.funcReleaseHold != nil {
+		mmReleaseHold.mock.t.Fatalf("LOMSCallerMock.ReleaseHold mock is already set by Set")
+	}
+
+	if mmReleaseHold.defaultExpectation == nil {
+		mmReleaseHold.defaultExpectation = &LOMSCallerMockReleaseHoldExpectation{}
+	}
+
+	mmReleaseHold.defaultExpectation.params = &LOMSCallerMockReleaseHoldParams{ctx, user, sku}
+	for _, e := range mmReleaseHold.expectations {
+		if minimock.Equal(e.params, mmReleaseHold.defaultExpectation.params) {
+			mmReleaseHold.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReleaseHold.defaultExpectation.params)
+		}
+	}
+
+	return mmReleaseHold
+}
+
+// Inspect accepts an inspector function that has same arguments as the LOMSCaller.ReleaseHold
+func (mmReleaseHold *mLOMSCallerMockReleaseHold) Inspect(f func(ctx context.Context, user int64, sku uint32)) *mLOMSCallerMockReleaseHold {
+	if mmReleaseHold.mock.inspectFuncReleaseHold != nil {
+		mmReleaseHold.mock.t.Fatalf("Inspect function is already set for LOMSCallerMock.ReleaseHold")
+	}
+
+	mmReleaseHold.mock.inspectFuncReleaseHold = f
+
+	return mmReleaseHold
+}
+
+// Return sets up results that will be returned by LOMSCaller.ReleaseHold
+func (mmReleaseHold *mLOMSCallerMockReleaseHold) Return(err error) *LOMSCallerMock {
+	if mmReleaseHold.mock.funcReleaseHold != nil {
+		mmReleaseHold.mock.t.Fatalf("LOMSCallerMock.ReleaseHold mock is already set by Set")
+	}
+
+	if mmReleaseHold.defaultExpectation == nil {
+		mmReleaseHold.defaultExpectation = &LOMSCallerMockReleaseHoldExpectation{mock: mmReleaseHold.mock}
+	}
+	mmReleaseHold.defaultExpectation.results = &LOMSCallerMockReleaseHoldResults{err}
+	return mmReleaseHold.mock
+}
+
+// Set uses given function f to mock the LOMSCaller.ReleaseHold method
+func (mmReleaseHold *mLOMSCallerMockReleaseHold) Set(f func(ctx context.Context, user int64, sku uint32) (err error)) *LOMSCallerMock {
+	if mmReleaseHold.defaultExpectation != nil {
+		mmReleaseHold.mock.t.Fatalf("Default expectation is already set for the LOMSCaller.ReleaseHold method")
+	}
+
+	if len(mmReleaseHold.expectations) > 0 {
+		mmReleaseHold.mock.t.Fatalf("Some expectations are already set for the LOMSCaller.ReleaseHold method")
+	}
+
+	mmReleaseHold.mock.funcReleaseHold = f
+	return mmReleaseHold.mock
+}
+
+// When sets expectation for the LOMSCaller.ReleaseHold which will trigger the result defined by the following
+// Then helper
+func (mmReleaseHold *mLOMSCallerMockReleaseHold) When(ctx context.Context, user int64, sku uint32) *LOMSCallerMockReleaseHoldExpectation {
+	if mmReleaseHold.mock.funcReleaseHold != nil {
+		mmReleaseHold.mock.t.Fatalf("LOMSCallerMock.ReleaseHold mock is already set by Set")
+	}
+
+	expectation := &LOMSCallerMockReleaseHoldExpectation{
+		mock:   mmReleaseHold.mock,
+		params: &LOMSCallerMockReleaseHoldParams{ctx, user, sku},
+	}
+	mmReleaseHold.expectations = append(mmReleaseHold.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LOMSCaller.ReleaseHold return parameters for the expectation previously defined by the When method
+func (e *LOMSCallerMockReleaseHoldExpectation) Then(err error) *LOMSCallerMock {
+	e.results = &LOMSCallerMockReleaseHoldResults{err}
+	return e.mock
+}
+
+// ReleaseHold implements LOMSCaller
+func (mmReleaseHold *LOMSCallerMock) ReleaseHold(ctx context.Context, user int64, sku uint32) (err error) {
+	mm_atomic.AddUint64(&mmReleaseHold.beforeReleaseHoldCounter, 1)
+	defer mm_atomic.AddUint64(&mmReleaseHold.afterReleaseHoldCounter, 1)
+
+	if mmReleaseHold.inspectFuncReleaseHold != nil {
+		mmReleaseHold.inspectFuncReleaseHold(ctx, user, sku)
+	}
+
+	mm_params := &LOMSCallerMockReleaseHoldParams{ctx, user, sku}
+
+	// Record call args
+	mmReleaseHold.ReleaseHoldMock.mutex.Lock()
+	mmReleaseHold.ReleaseHoldMock.callArgs = append(mmReleaseHold.ReleaseHoldMock.callArgs, mm_params)
+	mmReleaseHold.ReleaseHoldMock.mutex.Unlock()
+
+	for _, e := range mmReleaseHold.ReleaseHoldMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmReleaseHold.ReleaseHoldMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReleaseHold.ReleaseHoldMock.defaultExpectation.Counter, 1)
+		mm_want := mmReleaseHold.ReleaseHoldMock.defaultExpectation.params
+		mm_got := LOMSCallerMockReleaseHoldParams{ctx, user, sku}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReleaseHold.t.Errorf("LOMSCallerMock.ReleaseHold got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReleaseHold.ReleaseHoldMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReleaseHold.t.Fatal("No results are set for the LOMSCallerMock.ReleaseHold")
+		}
+		return (*mm_results).err
+	}
+	if mmReleaseHold.funcReleaseHold != nil {
+		return mmReleaseHold.funcReleaseHold(ctx, user, sku)
+	}
+	mmReleaseHold.t.Fatalf("Unexpected call to LOMSCallerMock.ReleaseHold. %v %v %v", ctx, user, sku)
+	return
+}
+
+// ReleaseHoldAfterCounter returns a count of finished LOMSCallerMock.ReleaseHold invocations
+func (mmReleaseHold *LOMSCallerMock) ReleaseHoldAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReleaseHold.afterReleaseHoldCounter)
+}
+
+// ReleaseHoldBeforeCounter returns a count of LOMSCallerMock.ReleaseHold invocations
+func (mmReleaseHold *LOMSCallerMock) ReleaseHoldBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReleaseHold.beforeReleaseHoldCounter)
+}
+
+// Calls returns a list of arguments used in each call to LOMSCallerMock.ReleaseHold.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReleaseHold *mLOMSCallerMockReleaseHold) Calls() []*LOMSCallerMockReleaseHoldParams {
+	mmReleaseHold.mutex.RLock()
+
+	argCopy := make([]*LOMSCallerMockReleaseHoldParams, len(mmReleaseHold.callArgs))
+	copy(argCopy, mmReleaseHold.callArgs)
+
+	mmReleaseHold.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReleaseHoldDone returns true if the count of the ReleaseHold invocations corresponds
+// the number of defined expectations
+func (m *LOMSCallerMock) MinimockReleaseHoldDone() bool {
+	for _, e := range m.ReleaseHoldMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReleaseHoldMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterReleaseHoldCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReleaseHold != nil && mm_atomic.LoadUint64(&m.afterReleaseHoldCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockReleaseHoldInspect logs each unmet expectation
+func (m *LOMSCallerMock) MinimockReleaseHoldInspect() {
+	for _, e := range m.ReleaseHoldMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LOMSCallerMock.ReleaseHold with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReleaseHoldMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterReleaseHoldCounter) < 1 {
+		if m.ReleaseHoldMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to LOMSCallerMock.ReleaseHold")
+		} else {
+			m.t.Errorf("Expected call to LOMSCallerMock.ReleaseHold with params: %#v", *m.ReleaseHoldMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReleaseHold != nil && mm_atomic.LoadUint64(&m.afterReleaseHoldCounter) < 1 {
+		m.t.Error("Expected call to LOMSCallerMock.ReleaseHold")
+	}
+}
+
 type mLOMSCallerMockStocks struct {
 	mock               *LOMSCallerMock
 	defaultExpectation *LOMSCallerMockStocksExpectation
@@ -484,8 +1166,14 @@ func (m *LOMSCallerMock) MinimockStocksInspect() {
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *LOMSCallerMock) MinimockFinish() {
 	if !m.minimockDone() {
+		m.MinimockBatchStocksInspect()
+
 		m.MinimockCreateOrderInspect()
 
+		m.MinimockHoldStockInspect()
+
+		m.MinimockReleaseHoldInspect()
+
 		m.MinimockStocksInspect()
 		m.t.FailNow()
 	}
@@ -510,6 +1198,9 @@ func (m *LOMSCallerMock) MinimockWait(timeout mm_time.Duration) {
 func (m *LOMSCallerMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockBatchStocksDone() &&
 		m.MinimockCreateOrderDone() &&
+		m.MinimockHoldStockDone() &&
+		m.MinimockReleaseHoldDone() &&
 		m.MinimockStocksDone()
 }
@@ -30,13 +30,22 @@ var (
 )
 
 const (
-	itemsTable = "cart_items"
+	itemsTable      = "cart_items"
+	guestItemsTable = "guest_cart_items"
 )
 
-func (r *CartsRepo) GetCartItem(ctx context.Context, user int64, sku uint32) (*domain.CartItem, error) {
+func cartTable(owner domain.CartOwner) (string, string, int64) {
+	if owner.GuestCartID != 0 {
+		return guestItemsTable, "guest_cart_id", owner.GuestCartID
+	}
+	return itemsTable, "user_id", owner.User
+}
+
+func (r *CartsRepo) GetCartItem(ctx context.Context, owner domain.CartOwner, sku uint32) (*domain.CartItem, error) {
 	db := r.QueryEngineProvider.GetQueryEngine(ctx)
-	query := sq.Select(itemColumns...).From(itemsTable).
-		Where(sq.Eq{"user_id": user, "sku": sku}).PlaceholderFormat(sq.Dollar)
+	table, column, id := cartTable(owner)
+	query := sq.Select(itemColumns...).From(table).
+		Where(sq.Eq{column: id, "sku": sku}).PlaceholderFormat(sq.Dollar)
 	rawQuery, args, err := query.ToSql()
 	if err != nil {
 		return nil, errors.Wrap(err, "build orders query")
@@ -52,10 +61,11 @@ func (r *CartsRepo) GetCartItem(ctx context.Context, user int64, sku uint32) (*d
 	return &domain.CartItem{Sku: item.Sku, Count: item.Count}, nil
 }
 
-func (r *CartsRepo) GetCart(ctx context.Context, user int64) ([]domain.CartItem, error) {
+func (r *CartsRepo) GetCart(ctx context.Context, owner domain.CartOwner) ([]domain.CartItem, error) {
 	db := r.QueryEngineProvider.GetQueryEngine(ctx)
-	query := sq.Select(itemColumns...).From(itemsTable).
-		Where(sq.Eq{"user_id": user}).PlaceholderFormat(sq.Dollar)
+	table, column, id := cartTable(owner)
+	query := sq.Select(itemColumns...).From(table).
+		Where(sq.Eq{column: id}).PlaceholderFormat(sq.Dollar)
 	rawQuery, args, err := query.ToSql()
 	if err != nil {
 		return nil, errors.Wrap(err, "build orders query")
@@ -72,11 +82,12 @@ func (r *CartsRepo) GetCart(ctx context.Context, user int64) ([]domain.CartItem,
 	return result, nil
 }
 
-func (r *CartsRepo) AddToCart(ctx context.Context, user int64, sku uint32, count uint16) error {
+func (r *CartsRepo) AddToCart(ctx context.Context, owner domain.CartOwner, sku uint32, count uint16) error {
 	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	table, column, id := cartTable(owner)
 
-	query := sq.Insert(itemsTable).Columns("user_id", "sku", "count").Values(user, sku, count).
-		Suffix(fmt.Sprintf("ON CONFLICT(user_id, sku) DO UPDATE SET count = %s.count + ?", itemsTable), count).
+	query := sq.Insert(table).Columns(column, "sku", "count").Values(id, sku, count).
+		Suffix(fmt.Sprintf("ON CONFLICT(%s, sku) DO UPDATE SET count = %s.count + ?", column, table), count).
 		PlaceholderFormat(sq.Dollar)
 	rawQuery, args, err := query.ToSql()
 	if err != nil {
@@ -89,20 +100,21 @@ func (r *CartsRepo) AddToCart(ctx context.Context, user int64, sku uint32, count
 	return nil
 }
 
-func (r *CartsRepo) DeleteFromCart(ctx context.Context, user int64, sku uint32, count uint16, full bool) error {
+func (r *CartsRepo) DeleteFromCart(ctx context.Context, owner domain.CartOwner, sku uint32, count uint16, full bool) error {
 	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	table, column, id := cartTable(owner)
 	var rawQuery string
 	var args []interface{}
 	var err error
 	if full {
-		query := sq.Delete(itemsTable).Where(sq.Eq{"user_id": user}).Where(sq.Eq{"sku": sku}).PlaceholderFormat(sq.Dollar)
+		query := sq.Delete(table).Where(sq.Eq{column: id}).Where(sq.Eq{"sku": sku}).PlaceholderFormat(sq.Dollar)
 		rawQuery, args, err = query.ToSql()
 		if err != nil {
 			return errors.Wrap(err, "build delete query")
 		}
 	} else {
-		query := sq.Update(itemsTable).Set("count", sq.Expr("count - ?", count)).
-			Where(sq.Eq{"user_id": user, "sku": sku}).PlaceholderFormat(sq.Dollar)
+		query := sq.Update(table).Set("count", sq.Expr("count - ?", count)).
+			Where(sq.Eq{column: id, "sku": sku}).PlaceholderFormat(sq.Dollar)
 		rawQuery, args, err = query.ToSql()
 		if err != nil {
 			return errors.Wrap(err, "build update query")
@@ -115,9 +127,62 @@ func (r *CartsRepo) DeleteFromCart(ctx context.Context, user int64, sku uint32,
 	return nil
 }
 
-func (r *CartsRepo) DeleteCart(ctx context.Context, user int64) error {
+func (r *CartsRepo) SetCartItemCount(ctx context.Context, owner domain.CartOwner, sku uint32, count uint16) error {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	table, column, id := cartTable(owner)
+
+	query := sq.Insert(table).Columns(column, "sku", "count").Values(id, sku, count).
+		Suffix(fmt.Sprintf("ON CONFLICT(%s, sku) DO UPDATE SET count = EXCLUDED.count", column)).
+		PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err := query.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "build query")
+	}
+	_, err = db.Exec(ctx, rawQuery, args...)
+	if err != nil {
+		return errors.Wrap(err, "exec query")
+	}
+	return nil
+}
+
+func (r *CartsRepo) MergeCarts(ctx context.Context, guestCartID int64, user int64) error {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return errors.Wrap(err, "run transaction")
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+	query := `
+	INSERT INTO cart_items (user_id, sku, count)
+		SELECT $1, sku, count FROM guest_cart_items WHERE guest_cart_id = $2
+	ON CONFLICT(user_id, sku) DO UPDATE SET count = cart_items.count + EXCLUDED.count`
+	_, err = tx.Exec(ctx, query, user, guestCartID)
+	if err != nil {
+		return errors.Wrap(err, "exec merge query")
+	}
+	deleteQuery := sq.Delete(guestItemsTable).Where(sq.Eq{"guest_cart_id": guestCartID}).PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err := deleteQuery.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "build delete query")
+	}
+	_, err = tx.Exec(ctx, rawQuery, args...)
+	if err != nil {
+		return errors.Wrap(err, "exec delete query")
+	}
+	err = tx.Commit(ctx)
+	if err != nil {
+		return errors.Wrap(err, "commit transaction")
+	}
+	return nil
+}
+
+func (r *CartsRepo) DeleteCart(ctx context.Context, owner domain.CartOwner) error {
 	db := r.QueryEngineProvider.GetQueryEngine(ctx)
-	query := sq.Delete(itemsTable).Where(sq.Eq{"user_id": user}).PlaceholderFormat(sq.Dollar)
+	table, column, id := cartTable(owner)
+	query := sq.Delete(table).Where(sq.Eq{column: id}).PlaceholderFormat(sq.Dollar)
 	rawQuery, args, err := query.ToSql()
 	if err != nil {
 		return errors.Wrap(err, "build update query")
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"route256/checkout/internal/domain"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+)
+
+const guestCartsTable = "guest_carts"
+
+func (r *CartsRepo) CreateGuestCart(ctx context.Context, sessionID string, expiresAt time.Time) (int64, error) {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	query := sq.Insert(guestCartsTable).Columns("session_id", "expires_at").
+		Values(sessionID, expiresAt).Suffix("RETURNING id").PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err := query.ToSql()
+	if err != nil {
+		return 0, errors.Wrap(err, "build query")
+	}
+	var id int64
+	err = pgxscan.Get(ctx, db, &id, rawQuery, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "exec query")
+	}
+	return id, nil
+}
+
+func (r *CartsRepo) ProlongGuestCart(ctx context.Context, sessionID string, now time.Time, expiresAt time.Time) (int64, error) {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	query := sq.Update(guestCartsTable).Set("expires_at", expiresAt).
+		Where(sq.Eq{"session_id": sessionID}).Where(sq.Gt{"expires_at": now}).
+		Suffix("RETURNING id").PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err := query.ToSql()
+	if err != nil {
+		return 0, errors.Wrap(err, "build query")
+	}
+	var id int64
+	err = pgxscan.Get(ctx, db, &id, rawQuery, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, domain.ErrGuestCartNotFound
+		}
+		return 0, errors.Wrap(err, "exec query")
+	}
+	return id, nil
+}
+
+func (r *CartsRepo) DeleteGuestCart(ctx context.Context, sessionID string) error {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	query := sq.Delete(guestCartsTable).Where(sq.Eq{"session_id": sessionID}).PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err := query.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "build query")
+	}
+	_, err = db.Exec(ctx, rawQuery, args...)
+	if err != nil {
+		return errors.Wrap(err, "exec query")
+	}
+	return nil
+}
+
+func (r *CartsRepo) DeleteExpiredGuestCarts(ctx context.Context, now time.Time) error {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	query := sq.Delete(guestCartsTable).Where(sq.LtOrEq{"expires_at": now}).PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err := query.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "build query")
+	}
+	_, err = db.Exec(ctx, rawQuery, args...)
+	if err != nil {
+		return errors.Wrap(err, "exec query")
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+	"route256/checkout/internal/domain"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+)
+
+const purchasesTable = "purchases"
+
+func (r *CartsRepo) GetPurchase(ctx context.Context, user int64, idempotencyKey string) (int64, error) {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	query := sq.Select("order_id").From(purchasesTable).
+		Where(sq.Eq{"user_id": user, "idempotency_key": idempotencyKey}).PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err := query.ToSql()
+	if err != nil {
+		return 0, errors.Wrap(err, "build query")
+	}
+	var orderID int64
+	err = pgxscan.Get(ctx, db, &orderID, rawQuery, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, domain.ErrPurchaseNotFound
+		}
+		return 0, errors.Wrap(err, "exec query")
+	}
+	return orderID, nil
+}
+
+func (r *CartsRepo) SavePurchase(ctx context.Context, user int64, idempotencyKey string, orderID int64) error {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	//LOMS вернет тот же заказ на повторный запрос, поэтому конфликт не ошибка
+	query := sq.Insert(purchasesTable).Columns("user_id", "idempotency_key", "order_id").
+		Values(user, idempotencyKey, orderID).
+		Suffix("ON CONFLICT(user_id, idempotency_key) DO NOTHING").PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err := query.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "build query")
+	}
+	_, err = db.Exec(ctx, rawQuery, args...)
+	if err != nil {
+		return errors.Wrap(err, "exec query")
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"route256/checkout/internal/domain"
+	"route256/checkout/internal/repository/schema"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+)
+
+const savedItemsTable = "saved_items"
+
+func (r *CartsRepo) GetSavedItem(ctx context.Context, user int64, sku uint32) (*domain.CartItem, error) {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	query := sq.Select(itemColumns...).From(savedItemsTable).
+		Where(sq.Eq{"user_id": user, "sku": sku}).PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "build query")
+	}
+	var item schema.CartItem
+	err = pgxscan.Get(ctx, db, &item, rawQuery, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrNoSavedItem
+		}
+		return nil, errors.Wrap(err, "exec query")
+	}
+	return &domain.CartItem{Sku: item.Sku, Count: item.Count}, nil
+}
+
+func (r *CartsRepo) GetSavedItems(ctx context.Context, user int64) ([]domain.CartItem, error) {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	query := sq.Select(itemColumns...).From(savedItemsTable).
+		Where(sq.Eq{"user_id": user}).OrderBy("created_at DESC", "sku").PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "build query")
+	}
+	var items []schema.CartItem
+	err = pgxscan.Select(ctx, db, &items, rawQuery, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "exec query")
+	}
+	result := make([]domain.CartItem, 0, len(items))
+	for _, item := range items {
+		result = append(result, domain.CartItem{Sku: item.Sku, Count: item.Count})
+	}
+	return result, nil
+}
+
+func (r *CartsRepo) SaveItem(ctx context.Context, user int64, sku uint32, count uint16) error {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	query := sq.Insert(savedItemsTable).Columns("user_id", "sku", "count").Values(user, sku, count).
+		Suffix(fmt.Sprintf("ON CONFLICT(user_id, sku) DO UPDATE SET count = %s.count + ?", savedItemsTable), count).
+		PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err := query.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "build query")
+	}
+	_, err = db.Exec(ctx, rawQuery, args...)
+	if err != nil {
+		return errors.Wrap(err, "exec query")
+	}
+	return nil
+}
+
+func (r *CartsRepo) DeleteSavedItem(ctx context.Context, user int64, sku uint32) error {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	query := sq.Delete(savedItemsTable).Where(sq.Eq{"user_id": user, "sku": sku}).PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err := query.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "build query")
+	}
+	_, err = db.Exec(ctx, rawQuery, args...)
+	if err != nil {
+		return errors.Wrap(err, "exec query")
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS purchases (
+    user_id bigint NOT NULL,
+    idempotency_key text NOT NULL,
+    order_id bigint NOT NULL,
+    created_at timestamp NOT NULL DEFAULT now(),
+    PRIMARY KEY(user_id, idempotency_key)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS purchases;
+-- +goose StatementEnd
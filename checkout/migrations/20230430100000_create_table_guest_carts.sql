@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS guest_carts (
+    id bigserial PRIMARY KEY,
+    session_id text NOT NULL UNIQUE,
+    expires_at timestamp NOT NULL,
+    created_at timestamp NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS guest_carts_expires_at_idx ON guest_carts(expires_at);
+CREATE TABLE IF NOT EXISTS guest_cart_items (
+    guest_cart_id bigint NOT NULL REFERENCES guest_carts(id) ON DELETE CASCADE,
+    sku integer NOT NULL,
+    count integer NOT NULL,
+    PRIMARY KEY(guest_cart_id, sku)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS guest_cart_items;
+DROP TABLE IF EXISTS guest_carts;
+-- +goose StatementEnd
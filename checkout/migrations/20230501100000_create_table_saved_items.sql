@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS saved_items (
+    user_id bigint NOT NULL,
+    sku integer NOT NULL,
+    count integer NOT NULL,
+    created_at timestamp NOT NULL DEFAULT now(),
+    PRIMARY KEY(user_id, sku)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS saved_items;
+-- +goose StatementEnd
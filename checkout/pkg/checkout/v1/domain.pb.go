@@ -7,14 +7,13 @@
 package checkout_v1
 
 import (
-	reflect "reflect"
-	sync "sync"
-
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
 )
 
 const (
@@ -24,33 +23,714 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type AddToCartRequest struct {
+type AddToCartRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Owner:
+	//	*AddToCartRequest_User
+	//	*AddToCartRequest_SessionID
+	Owner isAddToCartRequest_Owner `protobuf_oneof:"owner"`
+	Sku   uint32                   `protobuf:"varint,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Count uint32                   `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *AddToCartRequest) Reset() {
+	*x = AddToCartRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_domain_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddToCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddToCartRequest) ProtoMessage() {}
+
+func (x *AddToCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddToCartRequest.ProtoReflect.Descriptor instead.
+func (*AddToCartRequest) Descriptor() ([]byte, []int) {
+	return file_domain_proto_rawDescGZIP(), []int{0}
+}
+
+func (m *AddToCartRequest) GetOwner() isAddToCartRequest_Owner {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (x *AddToCartRequest) GetUser() int64 {
+	if x, ok := x.GetOwner().(*AddToCartRequest_User); ok {
+		return x.User
+	}
+	return 0
+}
+
+func (x *AddToCartRequest) GetSessionID() string {
+	if x, ok := x.GetOwner().(*AddToCartRequest_SessionID); ok {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *AddToCartRequest) GetSku() uint32 {
+	if x != nil {
+		return x.Sku
+	}
+	return 0
+}
+
+func (x *AddToCartRequest) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type isAddToCartRequest_Owner interface {
+	isAddToCartRequest_Owner()
+}
+
+type AddToCartRequest_User struct {
+	User int64 `protobuf:"varint,1,opt,name=user,proto3,oneof"`
+}
+
+type AddToCartRequest_SessionID struct {
+	// Корзина гостя, выданная CreateGuestCart
+	SessionID string `protobuf:"bytes,4,opt,name=sessionID,json=session_id,proto3,oneof"`
+}
+
+func (*AddToCartRequest_User) isAddToCartRequest_Owner() {}
+
+func (*AddToCartRequest_SessionID) isAddToCartRequest_Owner() {}
+
+type DeleteFromCartRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Owner:
+	//	*DeleteFromCartRequest_User
+	//	*DeleteFromCartRequest_SessionID
+	Owner isDeleteFromCartRequest_Owner `protobuf_oneof:"owner"`
+	Sku   uint32                        `protobuf:"varint,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Count uint32                        `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *DeleteFromCartRequest) Reset() {
+	*x = DeleteFromCartRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_domain_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteFromCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFromCartRequest) ProtoMessage() {}
+
+func (x *DeleteFromCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFromCartRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFromCartRequest) Descriptor() ([]byte, []int) {
+	return file_domain_proto_rawDescGZIP(), []int{1}
+}
+
+func (m *DeleteFromCartRequest) GetOwner() isDeleteFromCartRequest_Owner {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (x *DeleteFromCartRequest) GetUser() int64 {
+	if x, ok := x.GetOwner().(*DeleteFromCartRequest_User); ok {
+		return x.User
+	}
+	return 0
+}
+
+func (x *DeleteFromCartRequest) GetSessionID() string {
+	if x, ok := x.GetOwner().(*DeleteFromCartRequest_SessionID); ok {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *DeleteFromCartRequest) GetSku() uint32 {
+	if x != nil {
+		return x.Sku
+	}
+	return 0
+}
+
+func (x *DeleteFromCartRequest) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type isDeleteFromCartRequest_Owner interface {
+	isDeleteFromCartRequest_Owner()
+}
+
+type DeleteFromCartRequest_User struct {
+	User int64 `protobuf:"varint,1,opt,name=user,proto3,oneof"`
+}
+
+type DeleteFromCartRequest_SessionID struct {
+	// Корзина гостя, выданная CreateGuestCart
+	SessionID string `protobuf:"bytes,4,opt,name=sessionID,json=session_id,proto3,oneof"`
+}
+
+func (*DeleteFromCartRequest_User) isDeleteFromCartRequest_Owner() {}
+
+func (*DeleteFromCartRequest_SessionID) isDeleteFromCartRequest_Owner() {}
+
+type SetCartItemCountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Owner:
+	//	*SetCartItemCountRequest_User
+	//	*SetCartItemCountRequest_SessionID
+	Owner isSetCartItemCountRequest_Owner `protobuf_oneof:"owner"`
+	Sku   uint32                          `protobuf:"varint,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Count uint32                          `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *SetCartItemCountRequest) Reset() {
+	*x = SetCartItemCountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_domain_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCartItemCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCartItemCountRequest) ProtoMessage() {}
+
+func (x *SetCartItemCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCartItemCountRequest.ProtoReflect.Descriptor instead.
+func (*SetCartItemCountRequest) Descriptor() ([]byte, []int) {
+	return file_domain_proto_rawDescGZIP(), []int{2}
+}
+
+func (m *SetCartItemCountRequest) GetOwner() isSetCartItemCountRequest_Owner {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (x *SetCartItemCountRequest) GetUser() int64 {
+	if x, ok := x.GetOwner().(*SetCartItemCountRequest_User); ok {
+		return x.User
+	}
+	return 0
+}
+
+func (x *SetCartItemCountRequest) GetSessionID() string {
+	if x, ok := x.GetOwner().(*SetCartItemCountRequest_SessionID); ok {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *SetCartItemCountRequest) GetSku() uint32 {
+	if x != nil {
+		return x.Sku
+	}
+	return 0
+}
+
+func (x *SetCartItemCountRequest) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type isSetCartItemCountRequest_Owner interface {
+	isSetCartItemCountRequest_Owner()
+}
+
+type SetCartItemCountRequest_User struct {
+	User int64 `protobuf:"varint,1,opt,name=user,proto3,oneof"`
+}
+
+type SetCartItemCountRequest_SessionID struct {
+	// Корзина гостя, выданная CreateGuestCart
+	SessionID string `protobuf:"bytes,4,opt,name=sessionID,json=session_id,proto3,oneof"`
+}
+
+func (*SetCartItemCountRequest_User) isSetCartItemCountRequest_Owner() {}
+
+func (*SetCartItemCountRequest_SessionID) isSetCartItemCountRequest_Owner() {}
+
+type ClearCartRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Owner:
+	//	*ClearCartRequest_User
+	//	*ClearCartRequest_SessionID
+	Owner isClearCartRequest_Owner `protobuf_oneof:"owner"`
+}
+
+func (x *ClearCartRequest) Reset() {
+	*x = ClearCartRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_domain_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearCartRequest) ProtoMessage() {}
+
+func (x *ClearCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearCartRequest.ProtoReflect.Descriptor instead.
+func (*ClearCartRequest) Descriptor() ([]byte, []int) {
+	return file_domain_proto_rawDescGZIP(), []int{3}
+}
+
+func (m *ClearCartRequest) GetOwner() isClearCartRequest_Owner {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (x *ClearCartRequest) GetUser() int64 {
+	if x, ok := x.GetOwner().(*ClearCartRequest_User); ok {
+		return x.User
+	}
+	return 0
+}
+
+func (x *ClearCartRequest) GetSessionID() string {
+	if x, ok := x.GetOwner().(*ClearCartRequest_SessionID); ok {
+		return x.SessionID
+	}
+	return ""
+}
+
+type isClearCartRequest_Owner interface {
+	isClearCartRequest_Owner()
+}
+
+type ClearCartRequest_User struct {
+	User int64 `protobuf:"varint,1,opt,name=user,proto3,oneof"`
+}
+
+type ClearCartRequest_SessionID struct {
+	// Корзина гостя, выданная CreateGuestCart
+	SessionID string `protobuf:"bytes,2,opt,name=sessionID,json=session_id,proto3,oneof"`
+}
+
+func (*ClearCartRequest_User) isClearCartRequest_Owner() {}
+
+func (*ClearCartRequest_SessionID) isClearCartRequest_Owner() {}
+
+type MergeCartsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Корзина гостя, выданная CreateGuestCart, после переноса она пуста
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,json=session_id,proto3" json:"sessionID,omitempty"`
+	User      int64  `protobuf:"varint,2,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *MergeCartsRequest) Reset() {
+	*x = MergeCartsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_domain_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergeCartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeCartsRequest) ProtoMessage() {}
+
+func (x *MergeCartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeCartsRequest.ProtoReflect.Descriptor instead.
+func (*MergeCartsRequest) Descriptor() ([]byte, []int) {
+	return file_domain_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MergeCartsRequest) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *MergeCartsRequest) GetUser() int64 {
+	if x != nil {
+		return x.User
+	}
+	return 0
+}
+
+type ListCartRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Owner:
+	//	*ListCartRequest_User
+	//	*ListCartRequest_SessionID
+	Owner isListCartRequest_Owner `protobuf_oneof:"owner"`
+}
+
+func (x *ListCartRequest) Reset() {
+	*x = ListCartRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_domain_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCartRequest) ProtoMessage() {}
+
+func (x *ListCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCartRequest.ProtoReflect.Descriptor instead.
+func (*ListCartRequest) Descriptor() ([]byte, []int) {
+	return file_domain_proto_rawDescGZIP(), []int{5}
+}
+
+func (m *ListCartRequest) GetOwner() isListCartRequest_Owner {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (x *ListCartRequest) GetUser() int64 {
+	if x, ok := x.GetOwner().(*ListCartRequest_User); ok {
+		return x.User
+	}
+	return 0
+}
+
+func (x *ListCartRequest) GetSessionID() string {
+	if x, ok := x.GetOwner().(*ListCartRequest_SessionID); ok {
+		return x.SessionID
+	}
+	return ""
+}
+
+type isListCartRequest_Owner interface {
+	isListCartRequest_Owner()
+}
+
+type ListCartRequest_User struct {
+	User int64 `protobuf:"varint,1,opt,name=user,proto3,oneof"`
+}
+
+type ListCartRequest_SessionID struct {
+	// Корзина гостя, выданная CreateGuestCart
+	SessionID string `protobuf:"bytes,2,opt,name=sessionID,json=session_id,proto3,oneof"`
+}
+
+func (*ListCartRequest_User) isListCartRequest_Owner() {}
+
+func (*ListCartRequest_SessionID) isListCartRequest_Owner() {}
+
+type CartItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sku   uint32 `protobuf:"varint,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Count uint32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Name  string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Price uint32 `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (x *CartItem) Reset() {
+	*x = CartItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_domain_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CartItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartItem) ProtoMessage() {}
+
+func (x *CartItem) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartItem.ProtoReflect.Descriptor instead.
+func (*CartItem) Descriptor() ([]byte, []int) {
+	return file_domain_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CartItem) GetSku() uint32 {
+	if x != nil {
+		return x.Sku
+	}
+	return 0
+}
+
+func (x *CartItem) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *CartItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CartItem) GetPrice() uint32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+type ListCartResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items      []*CartItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	TotalPrice uint32      `protobuf:"varint,2,opt,name=totalPrice,proto3" json:"totalPrice,omitempty"`
+}
+
+func (x *ListCartResponse) Reset() {
+	*x = ListCartResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_domain_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCartResponse) ProtoMessage() {}
+
+func (x *ListCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCartResponse.ProtoReflect.Descriptor instead.
+func (*ListCartResponse) Descriptor() ([]byte, []int) {
+	return file_domain_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListCartResponse) GetItems() []*CartItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListCartResponse) GetTotalPrice() uint32 {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return 0
+}
+
+type CreateGuestCartResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+}
+
+func (x *CreateGuestCartResponse) Reset() {
+	*x = CreateGuestCartResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_domain_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateGuestCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGuestCartResponse) ProtoMessage() {}
+
+func (x *CreateGuestCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGuestCartResponse.ProtoReflect.Descriptor instead.
+func (*CreateGuestCartResponse) Descriptor() ([]byte, []int) {
+	return file_domain_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateGuestCartResponse) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+type ConvertGuestCartRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	User  int64  `protobuf:"varint,1,opt,name=user,proto3" json:"user,omitempty"`
-	Sku   uint32 `protobuf:"varint,2,opt,name=sku,proto3" json:"sku,omitempty"`
-	Count uint32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,json=session_id,proto3" json:"sessionID,omitempty"`
+	User      int64  `protobuf:"varint,2,opt,name=user,proto3" json:"user,omitempty"`
 }
 
-func (x *AddToCartRequest) Reset() {
-	*x = AddToCartRequest{}
+func (x *ConvertGuestCartRequest) Reset() {
+	*x = ConvertGuestCartRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_domain_proto_msgTypes[0]
+		mi := &file_domain_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddToCartRequest) String() string {
+func (x *ConvertGuestCartRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddToCartRequest) ProtoMessage() {}
+func (*ConvertGuestCartRequest) ProtoMessage() {}
 
-func (x *AddToCartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_domain_proto_msgTypes[0]
+func (x *ConvertGuestCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -61,59 +741,106 @@ func (x *AddToCartRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddToCartRequest.ProtoReflect.Descriptor instead.
-func (*AddToCartRequest) Descriptor() ([]byte, []int) {
-	return file_domain_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use ConvertGuestCartRequest.ProtoReflect.Descriptor instead.
+func (*ConvertGuestCartRequest) Descriptor() ([]byte, []int) {
+	return file_domain_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *AddToCartRequest) GetUser() int64 {
+func (x *ConvertGuestCartRequest) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *ConvertGuestCartRequest) GetUser() int64 {
 	if x != nil {
 		return x.User
 	}
 	return 0
 }
 
-func (x *AddToCartRequest) GetSku() uint32 {
+type SaveForLaterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User int64  `protobuf:"varint,1,opt,name=user,proto3" json:"user,omitempty"`
+	Sku  uint32 `protobuf:"varint,2,opt,name=sku,proto3" json:"sku,omitempty"`
+}
+
+func (x *SaveForLaterRequest) Reset() {
+	*x = SaveForLaterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_domain_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveForLaterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveForLaterRequest) ProtoMessage() {}
+
+func (x *SaveForLaterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveForLaterRequest.ProtoReflect.Descriptor instead.
+func (*SaveForLaterRequest) Descriptor() ([]byte, []int) {
+	return file_domain_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SaveForLaterRequest) GetUser() int64 {
 	if x != nil {
-		return x.Sku
+		return x.User
 	}
 	return 0
 }
 
-func (x *AddToCartRequest) GetCount() uint32 {
+func (x *SaveForLaterRequest) GetSku() uint32 {
 	if x != nil {
-		return x.Count
+		return x.Sku
 	}
 	return 0
 }
 
-type DeleteFromCartRequest struct {
+type MoveToCartRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	User  int64  `protobuf:"varint,1,opt,name=user,proto3" json:"user,omitempty"`
-	Sku   uint32 `protobuf:"varint,2,opt,name=sku,proto3" json:"sku,omitempty"`
-	Count uint32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	User int64  `protobuf:"varint,1,opt,name=user,proto3" json:"user,omitempty"`
+	Sku  uint32 `protobuf:"varint,2,opt,name=sku,proto3" json:"sku,omitempty"`
 }
 
-func (x *DeleteFromCartRequest) Reset() {
-	*x = DeleteFromCartRequest{}
+func (x *MoveToCartRequest) Reset() {
+	*x = MoveToCartRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_domain_proto_msgTypes[1]
+		mi := &file_domain_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteFromCartRequest) String() string {
+func (x *MoveToCartRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteFromCartRequest) ProtoMessage() {}
+func (*MoveToCartRequest) ProtoMessage() {}
 
-func (x *DeleteFromCartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_domain_proto_msgTypes[1]
+func (x *MoveToCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -124,33 +851,26 @@ func (x *DeleteFromCartRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteFromCartRequest.ProtoReflect.Descriptor instead.
-func (*DeleteFromCartRequest) Descriptor() ([]byte, []int) {
-	return file_domain_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use MoveToCartRequest.ProtoReflect.Descriptor instead.
+func (*MoveToCartRequest) Descriptor() ([]byte, []int) {
+	return file_domain_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *DeleteFromCartRequest) GetUser() int64 {
+func (x *MoveToCartRequest) GetUser() int64 {
 	if x != nil {
 		return x.User
 	}
 	return 0
 }
 
-func (x *DeleteFromCartRequest) GetSku() uint32 {
+func (x *MoveToCartRequest) GetSku() uint32 {
 	if x != nil {
 		return x.Sku
 	}
 	return 0
 }
 
-func (x *DeleteFromCartRequest) GetCount() uint32 {
-	if x != nil {
-		return x.Count
-	}
-	return 0
-}
-
-type ListCartRequest struct {
+type ListSavedRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -158,23 +878,23 @@ type ListCartRequest struct {
 	User int64 `protobuf:"varint,1,opt,name=user,proto3" json:"user,omitempty"`
 }
 
-func (x *ListCartRequest) Reset() {
-	*x = ListCartRequest{}
+func (x *ListSavedRequest) Reset() {
+	*x = ListSavedRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_domain_proto_msgTypes[2]
+		mi := &file_domain_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListCartRequest) String() string {
+func (x *ListSavedRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCartRequest) ProtoMessage() {}
+func (*ListSavedRequest) ProtoMessage() {}
 
-func (x *ListCartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_domain_proto_msgTypes[2]
+func (x *ListSavedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -185,19 +905,19 @@ func (x *ListCartRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCartRequest.ProtoReflect.Descriptor instead.
-func (*ListCartRequest) Descriptor() ([]byte, []int) {
-	return file_domain_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ListSavedRequest.ProtoReflect.Descriptor instead.
+func (*ListSavedRequest) Descriptor() ([]byte, []int) {
+	return file_domain_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ListCartRequest) GetUser() int64 {
+func (x *ListSavedRequest) GetUser() int64 {
 	if x != nil {
 		return x.User
 	}
 	return 0
 }
 
-type CartItem struct {
+type SavedItem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -206,25 +926,27 @@ type CartItem struct {
 	Count uint32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 	Name  string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	Price uint32 `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
+	// Хватает ли остатков на складах на count
+	Available bool `protobuf:"varint,5,opt,name=available,proto3" json:"available,omitempty"`
 }
 
-func (x *CartItem) Reset() {
-	*x = CartItem{}
+func (x *SavedItem) Reset() {
+	*x = SavedItem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_domain_proto_msgTypes[3]
+		mi := &file_domain_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CartItem) String() string {
+func (x *SavedItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CartItem) ProtoMessage() {}
+func (*SavedItem) ProtoMessage() {}
 
-func (x *CartItem) ProtoReflect() protoreflect.Message {
-	mi := &file_domain_proto_msgTypes[3]
+func (x *SavedItem) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -235,65 +957,71 @@ func (x *CartItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CartItem.ProtoReflect.Descriptor instead.
-func (*CartItem) Descriptor() ([]byte, []int) {
-	return file_domain_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use SavedItem.ProtoReflect.Descriptor instead.
+func (*SavedItem) Descriptor() ([]byte, []int) {
+	return file_domain_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *CartItem) GetSku() uint32 {
+func (x *SavedItem) GetSku() uint32 {
 	if x != nil {
 		return x.Sku
 	}
 	return 0
 }
 
-func (x *CartItem) GetCount() uint32 {
+func (x *SavedItem) GetCount() uint32 {
 	if x != nil {
 		return x.Count
 	}
 	return 0
 }
 
-func (x *CartItem) GetName() string {
+func (x *SavedItem) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *CartItem) GetPrice() uint32 {
+func (x *SavedItem) GetPrice() uint32 {
 	if x != nil {
 		return x.Price
 	}
 	return 0
 }
 
-type ListCartResponse struct {
+func (x *SavedItem) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+type ListSavedResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Items      []*CartItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	TotalPrice uint32      `protobuf:"varint,2,opt,name=totalPrice,proto3" json:"totalPrice,omitempty"`
+	Items []*SavedItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 }
 
-func (x *ListCartResponse) Reset() {
-	*x = ListCartResponse{}
+func (x *ListSavedResponse) Reset() {
+	*x = ListSavedResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_domain_proto_msgTypes[4]
+		mi := &file_domain_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListCartResponse) String() string {
+func (x *ListSavedResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCartResponse) ProtoMessage() {}
+func (*ListSavedResponse) ProtoMessage() {}
 
-func (x *ListCartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_domain_proto_msgTypes[4]
+func (x *ListSavedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -304,37 +1032,32 @@ func (x *ListCartResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCartResponse.ProtoReflect.Descriptor instead.
-func (*ListCartResponse) Descriptor() ([]byte, []int) {
-	return file_domain_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ListSavedResponse.ProtoReflect.Descriptor instead.
+func (*ListSavedResponse) Descriptor() ([]byte, []int) {
+	return file_domain_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ListCartResponse) GetItems() []*CartItem {
+func (x *ListSavedResponse) GetItems() []*SavedItem {
 	if x != nil {
 		return x.Items
 	}
 	return nil
 }
 
-func (x *ListCartResponse) GetTotalPrice() uint32 {
-	if x != nil {
-		return x.TotalPrice
-	}
-	return 0
-}
-
 type PurchaseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	User int64 `protobuf:"varint,1,opt,name=user,proto3" json:"user,omitempty"`
+	// Повторный запрос с тем же ключом вернет уже созданный заказ
+	IdempotencyKey string `protobuf:"bytes,2,opt,name=idempotencyKey,json=idempotency_key,proto3" json:"idempotencyKey,omitempty"`
 }
 
 func (x *PurchaseRequest) Reset() {
 	*x = PurchaseRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_domain_proto_msgTypes[5]
+		mi := &file_domain_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -347,7 +1070,7 @@ func (x *PurchaseRequest) String() string {
 func (*PurchaseRequest) ProtoMessage() {}
 
 func (x *PurchaseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_domain_proto_msgTypes[5]
+	mi := &file_domain_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -360,7 +1083,7 @@ func (x *PurchaseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PurchaseRequest.ProtoReflect.Descriptor instead.
 func (*PurchaseRequest) Descriptor() ([]byte, []int) {
-	return file_domain_proto_rawDescGZIP(), []int{5}
+	return file_domain_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *PurchaseRequest) GetUser() int64 {
@@ -370,6 +1093,13 @@ func (x *PurchaseRequest) GetUser() int64 {
 	return 0
 }
 
+func (x *PurchaseRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 type PurchaseResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -381,7 +1111,7 @@ type PurchaseResponse struct {
 func (x *PurchaseResponse) Reset() {
 	*x = PurchaseResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_domain_proto_msgTypes[6]
+		mi := &file_domain_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -394,7 +1124,7 @@ func (x *PurchaseResponse) String() string {
 func (*PurchaseResponse) ProtoMessage() {}
 
 func (x *PurchaseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_domain_proto_msgTypes[6]
+	mi := &file_domain_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -407,7 +1137,7 @@ func (x *PurchaseResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PurchaseResponse.ProtoReflect.Descriptor instead.
 func (*PurchaseResponse) Descriptor() ([]byte, []int) {
-	return file_domain_proto_rawDescGZIP(), []int{6}
+	return file_domain_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *PurchaseResponse) GetOrderID() int64 {
@@ -427,73 +1157,202 @@ var file_domain_proto_rawDesc = []byte{
 	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
 	0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0x69, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72,
-	0x12, 0x19, 0x0a, 0x03, 0x73, 0x6b, 0x75, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa,
-	0x42, 0x04, 0x2a, 0x02, 0x20, 0x00, 0x52, 0x03, 0x73, 0x6b, 0x75, 0x12, 0x1d, 0x0a, 0x05, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a,
-	0x02, 0x20, 0x00, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x6e, 0x0a, 0x15, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72,
-	0x12, 0x19, 0x0a, 0x03, 0x73, 0x6b, 0x75, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa,
-	0x42, 0x04, 0x2a, 0x02, 0x20, 0x00, 0x52, 0x03, 0x73, 0x6b, 0x75, 0x12, 0x1d, 0x0a, 0x05, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a,
-	0x02, 0x20, 0x00, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2e, 0x0a, 0x0f, 0x4c, 0x69,
-	0x73, 0x74, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
-	0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04,
-	0x22, 0x02, 0x20, 0x00, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x5c, 0x0a, 0x08, 0x43, 0x61,
-	0x72, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x6b, 0x75, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x03, 0x73, 0x6b, 0x75, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x5f, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74,
-	0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x05,
-	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x68,
-	0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x49, 0x74,
-	0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0x2e, 0x0a, 0x0f, 0x50, 0x75, 0x72,
-	0x63, 0x68, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04,
+	0xa3, 0x01, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x48, 0x00, 0x52, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x48,
+	0x00, 0x52, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x12, 0x19, 0x0a,
+	0x03, 0x73, 0x6b, 0x75, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a,
+	0x02, 0x20, 0x00, 0x52, 0x03, 0x73, 0x6b, 0x75, 0x12, 0x1d, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x20, 0x00,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0c, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x12, 0x03, 0xf8, 0x42, 0x01, 0x22, 0xa8, 0x01, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x46, 0x72, 0x6f, 0x6d, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x48, 0x00, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x28,
+	0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x03, 0x73, 0x6b, 0x75, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x20, 0x00, 0x52, 0x03,
+	0x73, 0x6b, 0x75, 0x12, 0x1d, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x20, 0x00, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x42, 0x0c, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x03, 0xf8, 0x42, 0x01,
+	0x22, 0xac, 0x01, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x43, 0x61, 0x72, 0x74, 0x49, 0x74, 0x65, 0x6d,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x04,
 	0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22,
-	0x02, 0x20, 0x00, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x2c, 0x0a, 0x10, 0x50, 0x75, 0x72,
-	0x63, 0x68, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
-	0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x32, 0xc4, 0x03, 0x0a, 0x0a, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x6f, 0x75, 0x74, 0x56, 0x31, 0x12, 0x67, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x43,
-	0x61, 0x72, 0x74, 0x12, 0x1d, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x76,
-	0x31, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x1d, 0x22, 0x18, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x2f, 0x76, 0x31,
-	0x2f, 0x61, 0x64, 0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x63, 0x61, 0x72, 0x74, 0x3a, 0x01, 0x2a, 0x12,
-	0x76, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x61, 0x72,
-	0x74, 0x12, 0x22, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x76, 0x31, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x28, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x22, 0x22, 0x1d, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74,
-	0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f,
-	0x63, 0x61, 0x72, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x6a, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x43,
-	0x61, 0x72, 0x74, 0x12, 0x1c, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x76,
-	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x76, 0x31, 0x2e,
+	0x02, 0x20, 0x00, 0x48, 0x00, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x09, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x03, 0x73, 0x6b, 0x75, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x20, 0x00, 0x52, 0x03, 0x73, 0x6b, 0x75,
+	0x12, 0x1f, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42,
+	0x09, 0xfa, 0x42, 0x06, 0x2a, 0x04, 0x18, 0xff, 0xff, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x42, 0x0c, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x22,
+	0x69, 0x0a, 0x10, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x48, 0x00, 0x52, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x12, 0x28, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x48, 0x00,
+	0x52, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x42, 0x0c, 0x0a, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x22, 0x58, 0x0a, 0x11, 0x4d, 0x65,
+	0x72, 0x67, 0x65, 0x43, 0x61, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x26, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0a, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x22, 0x68, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x72, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x48, 0x00,
+	0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02,
+	0x10, 0x01, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x42, 0x0c, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x22, 0x5c,
+	0x0a, 0x08, 0x43, 0x61, 0x72, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x6b,
+	0x75, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x73, 0x6b, 0x75, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x5f, 0x0a, 0x10,
 	0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x22, 0x16, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b,
-	0x6f, 0x75, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x72, 0x74,
-	0x3a, 0x01, 0x2a, 0x12, 0x69, 0x0a, 0x08, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x12,
-	0x1c, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x76, 0x31, 0x2e, 0x50, 0x75,
-	0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
-	0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x72, 0x63,
-	0x68, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x1a, 0x22, 0x15, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x2f,
-	0x76, 0x31, 0x2f, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x3a, 0x01, 0x2a, 0x42, 0x2f,
-	0x5a, 0x2d, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x32, 0x35, 0x36, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b,
-	0x6f, 0x75, 0x74, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74,
-	0x5f, 0x76, 0x31, 0x3b, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x76, 0x31, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x2b, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x76, 0x31, 0x2e, 0x43, 0x61,
+	0x72, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0x37, 0x0a,
+	0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x75, 0x65, 0x73, 0x74, 0x43, 0x61, 0x72, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x5e, 0x0a, 0x17, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x47, 0x75, 0x65, 0x73, 0x74, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x26, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0a, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00,
+	0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x4d, 0x0a, 0x13, 0x53, 0x61, 0x76, 0x65, 0x46, 0x6f,
+	0x72, 0x4c, 0x61, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x22, 0x02, 0x20, 0x00, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x03, 0x73, 0x6b,
+	0x75, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x20, 0x00,
+	0x52, 0x03, 0x73, 0x6b, 0x75, 0x22, 0x4b, 0x0a, 0x11, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x43,
+	0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20,
+	0x00, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x03, 0x73, 0x6b, 0x75, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x20, 0x00, 0x52, 0x03, 0x73,
+	0x6b, 0x75, 0x22, 0x2f, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x22, 0x7b, 0x0a, 0x09, 0x53, 0x61, 0x76, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d,
+	0x12, 0x10, 0x0a, 0x03, 0x73, 0x6b, 0x75, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x73,
+	0x6b, 0x75, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x22, 0x41, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f,
+	0x76, 0x31, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x22, 0x60, 0x0a, 0x0f, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x72, 0x02, 0x18, 0x40, 0x52, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x5f, 0x6b, 0x65, 0x79, 0x22, 0x2c, 0x0a, 0x10, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x49, 0x44, 0x32, 0xde, 0x0a, 0x0a, 0x0a, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74,
+	0x56, 0x31, 0x12, 0x67, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x43, 0x61, 0x72, 0x74, 0x12,
+	0x1d, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x76, 0x31, 0x2e, 0x41, 0x64,
+	0x64, 0x54, 0x6f, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x22, 0x18,
+	0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x64,
+	0x5f, 0x74, 0x6f, 0x5f, 0x63, 0x61, 0x72, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x76, 0x0a, 0x0e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x61, 0x72, 0x74, 0x12, 0x22, 0x2e,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x22, 0x22, 0x1d, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x2f, 0x76, 0x31, 0x2f,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x63, 0x61, 0x72, 0x74,
+	0x3a, 0x01, 0x2a, 0x12, 0x7d, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x43, 0x61, 0x72, 0x74, 0x49, 0x74,
+	0x65, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f,
+	0x75, 0x74, 0x5f, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x61, 0x72, 0x74, 0x49, 0x74, 0x65,
+	0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x22, 0x20, 0x2f,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x74, 0x5f,
+	0x63, 0x61, 0x72, 0x74, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x3a,
+	0x01, 0x2a, 0x12, 0x66, 0x0a, 0x09, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x61, 0x72, 0x74, 0x12,
+	0x1d, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x76, 0x31, 0x2e, 0x43, 0x6c,
+	0x65, 0x61, 0x72, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01,
+	0x2a, 0x22, 0x17, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x2f, 0x76, 0x31, 0x2f,
+	0x63, 0x6c, 0x65, 0x61, 0x72, 0x5f, 0x63, 0x61, 0x72, 0x74, 0x12, 0x69, 0x0a, 0x0a, 0x4d, 0x65,
+	0x72, 0x67, 0x65, 0x43, 0x61, 0x72, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x6f, 0x75, 0x74, 0x5f, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x61, 0x72, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x22, 0x18, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x6f, 0x75, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x5f, 0x63, 0x61, 0x72,
+	0x74, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x6a, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x72,
+	0x74, 0x12, 0x1c, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x22, 0x16, 0x2f, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x6f, 0x75, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x72,
+	0x74, 0x12, 0x7a, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x75, 0x65, 0x73, 0x74,
+	0x43, 0x61, 0x72, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x24, 0x2e, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x47, 0x75, 0x65, 0x73, 0x74, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x22, 0x1e, 0x2f, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x6f, 0x75, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f,
+	0x67, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x72, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x7c, 0x0a,
+	0x10, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x47, 0x75, 0x65, 0x73, 0x74, 0x43, 0x61, 0x72,
+	0x74, 0x12, 0x24, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x76, 0x31, 0x2e,
+	0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x47, 0x75, 0x65, 0x73, 0x74, 0x43, 0x61, 0x72, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x22, 0x1f, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f,
+	0x75, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x5f, 0x67, 0x75,
+	0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x72, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x70, 0x0a, 0x0c, 0x53,
+	0x61, 0x76, 0x65, 0x46, 0x6f, 0x72, 0x4c, 0x61, 0x74, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x76, 0x31, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x46, 0x6f,
+	0x72, 0x4c, 0x61, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x22, 0x1b, 0x2f,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x61, 0x76, 0x65,
+	0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x3a, 0x01, 0x2a, 0x12, 0x6a, 0x0a,
+	0x0a, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x43, 0x61, 0x72, 0x74, 0x12, 0x1e, 0x2e, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f,
+	0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x22, 0x19, 0x2f, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x74,
+	0x6f, 0x5f, 0x63, 0x61, 0x72, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x6e, 0x0a, 0x09, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x12, 0x1d, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75,
+	0x74, 0x5f, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74,
+	0x5f, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22, 0x17, 0x2f,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x69, 0x73, 0x74,
+	0x5f, 0x73, 0x61, 0x76, 0x65, 0x64, 0x3a, 0x01, 0x2a, 0x12, 0x69, 0x0a, 0x08, 0x50, 0x75, 0x72,
+	0x63, 0x68, 0x61, 0x73, 0x65, 0x12, 0x1c, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74,
+	0x5f, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x76,
+	0x31, 0x2e, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a, 0x22, 0x15, 0x2f,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x75, 0x72, 0x63,
+	0x68, 0x61, 0x73, 0x65, 0x42, 0x2f, 0x5a, 0x2d, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x32, 0x35, 0x36,
+	0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x76, 0x31, 0x3b, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f,
+	0x75, 0x74, 0x5f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -508,32 +1367,59 @@ func file_domain_proto_rawDescGZIP() []byte {
 	return file_domain_proto_rawDescData
 }
 
-var file_domain_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_domain_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_domain_proto_goTypes = []interface{}{
-	(*AddToCartRequest)(nil),      // 0: checkout_v1.AddToCartRequest
-	(*DeleteFromCartRequest)(nil), // 1: checkout_v1.DeleteFromCartRequest
-	(*ListCartRequest)(nil),       // 2: checkout_v1.ListCartRequest
-	(*CartItem)(nil),              // 3: checkout_v1.CartItem
-	(*ListCartResponse)(nil),      // 4: checkout_v1.ListCartResponse
-	(*PurchaseRequest)(nil),       // 5: checkout_v1.PurchaseRequest
-	(*PurchaseResponse)(nil),      // 6: checkout_v1.PurchaseResponse
-	(*emptypb.Empty)(nil),         // 7: google.protobuf.Empty
+	(*AddToCartRequest)(nil),        // 0: checkout_v1.AddToCartRequest
+	(*DeleteFromCartRequest)(nil),   // 1: checkout_v1.DeleteFromCartRequest
+	(*SetCartItemCountRequest)(nil), // 2: checkout_v1.SetCartItemCountRequest
+	(*ClearCartRequest)(nil),        // 3: checkout_v1.ClearCartRequest
+	(*MergeCartsRequest)(nil),       // 4: checkout_v1.MergeCartsRequest
+	(*ListCartRequest)(nil),         // 5: checkout_v1.ListCartRequest
+	(*CartItem)(nil),                // 6: checkout_v1.CartItem
+	(*ListCartResponse)(nil),        // 7: checkout_v1.ListCartResponse
+	(*CreateGuestCartResponse)(nil), // 8: checkout_v1.CreateGuestCartResponse
+	(*ConvertGuestCartRequest)(nil), // 9: checkout_v1.ConvertGuestCartRequest
+	(*SaveForLaterRequest)(nil),     // 10: checkout_v1.SaveForLaterRequest
+	(*MoveToCartRequest)(nil),       // 11: checkout_v1.MoveToCartRequest
+	(*ListSavedRequest)(nil),        // 12: checkout_v1.ListSavedRequest
+	(*SavedItem)(nil),               // 13: checkout_v1.SavedItem
+	(*ListSavedResponse)(nil),       // 14: checkout_v1.ListSavedResponse
+	(*PurchaseRequest)(nil),         // 15: checkout_v1.PurchaseRequest
+	(*PurchaseResponse)(nil),        // 16: checkout_v1.PurchaseResponse
+	(*emptypb.Empty)(nil),           // 17: google.protobuf.Empty
 }
 var file_domain_proto_depIdxs = []int32{
-	3, // 0: checkout_v1.ListCartResponse.items:type_name -> checkout_v1.CartItem
-	0, // 1: checkout_v1.CheckoutV1.AddToCart:input_type -> checkout_v1.AddToCartRequest
-	1, // 2: checkout_v1.CheckoutV1.DeleteFromCart:input_type -> checkout_v1.DeleteFromCartRequest
-	2, // 3: checkout_v1.CheckoutV1.ListCart:input_type -> checkout_v1.ListCartRequest
-	5, // 4: checkout_v1.CheckoutV1.Purchase:input_type -> checkout_v1.PurchaseRequest
-	7, // 5: checkout_v1.CheckoutV1.AddToCart:output_type -> google.protobuf.Empty
-	7, // 6: checkout_v1.CheckoutV1.DeleteFromCart:output_type -> google.protobuf.Empty
-	4, // 7: checkout_v1.CheckoutV1.ListCart:output_type -> checkout_v1.ListCartResponse
-	6, // 8: checkout_v1.CheckoutV1.Purchase:output_type -> checkout_v1.PurchaseResponse
-	5, // [5:9] is the sub-list for method output_type
-	1, // [1:5] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	6,  // 0: checkout_v1.ListCartResponse.items:type_name -> checkout_v1.CartItem
+	13, // 1: checkout_v1.ListSavedResponse.items:type_name -> checkout_v1.SavedItem
+	0,  // 2: checkout_v1.CheckoutV1.AddToCart:input_type -> checkout_v1.AddToCartRequest
+	1,  // 3: checkout_v1.CheckoutV1.DeleteFromCart:input_type -> checkout_v1.DeleteFromCartRequest
+	2,  // 4: checkout_v1.CheckoutV1.SetCartItemCount:input_type -> checkout_v1.SetCartItemCountRequest
+	3,  // 5: checkout_v1.CheckoutV1.ClearCart:input_type -> checkout_v1.ClearCartRequest
+	4,  // 6: checkout_v1.CheckoutV1.MergeCarts:input_type -> checkout_v1.MergeCartsRequest
+	5,  // 7: checkout_v1.CheckoutV1.ListCart:input_type -> checkout_v1.ListCartRequest
+	17, // 8: checkout_v1.CheckoutV1.CreateGuestCart:input_type -> google.protobuf.Empty
+	9,  // 9: checkout_v1.CheckoutV1.ConvertGuestCart:input_type -> checkout_v1.ConvertGuestCartRequest
+	10, // 10: checkout_v1.CheckoutV1.SaveForLater:input_type -> checkout_v1.SaveForLaterRequest
+	11, // 11: checkout_v1.CheckoutV1.MoveToCart:input_type -> checkout_v1.MoveToCartRequest
+	12, // 12: checkout_v1.CheckoutV1.ListSaved:input_type -> checkout_v1.ListSavedRequest
+	15, // 13: checkout_v1.CheckoutV1.Purchase:input_type -> checkout_v1.PurchaseRequest
+	17, // 14: checkout_v1.CheckoutV1.AddToCart:output_type -> google.protobuf.Empty
+	17, // 15: checkout_v1.CheckoutV1.DeleteFromCart:output_type -> google.protobuf.Empty
+	17, // 16: checkout_v1.CheckoutV1.SetCartItemCount:output_type -> google.protobuf.Empty
+	17, // 17: checkout_v1.CheckoutV1.ClearCart:output_type -> google.protobuf.Empty
+	17, // 18: checkout_v1.CheckoutV1.MergeCarts:output_type -> google.protobuf.Empty
+	7,  // 19: checkout_v1.CheckoutV1.ListCart:output_type -> checkout_v1.ListCartResponse
+	8,  // 20: checkout_v1.CheckoutV1.CreateGuestCart:output_type -> checkout_v1.CreateGuestCartResponse
+	17, // 21: checkout_v1.CheckoutV1.ConvertGuestCart:output_type -> google.protobuf.Empty
+	17, // 22: checkout_v1.CheckoutV1.SaveForLater:output_type -> google.protobuf.Empty
+	17, // 23: checkout_v1.CheckoutV1.MoveToCart:output_type -> google.protobuf.Empty
+	14, // 24: checkout_v1.CheckoutV1.ListSaved:output_type -> checkout_v1.ListSavedResponse
+	16, // 25: checkout_v1.CheckoutV1.Purchase:output_type -> checkout_v1.PurchaseResponse
+	14, // [14:26] is the sub-list for method output_type
+	2,  // [2:14] is the sub-list for method input_type
+	2,  // [2:2] is the sub-list for extension type_name
+	2,  // [2:2] is the sub-list for extension extendee
+	0,  // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_domain_proto_init() }
@@ -567,7 +1453,7 @@ func file_domain_proto_init() {
 			}
 		}
 		file_domain_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListCartRequest); i {
+			switch v := v.(*SetCartItemCountRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -579,7 +1465,7 @@ func file_domain_proto_init() {
 			}
 		}
 		file_domain_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CartItem); i {
+			switch v := v.(*ClearCartRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -591,7 +1477,7 @@ func file_domain_proto_init() {
 			}
 		}
 		file_domain_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListCartResponse); i {
+			switch v := v.(*MergeCartsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -603,7 +1489,7 @@ func file_domain_proto_init() {
 			}
 		}
 		file_domain_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PurchaseRequest); i {
+			switch v := v.(*ListCartRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -615,6 +1501,126 @@ func file_domain_proto_init() {
 			}
 		}
 		file_domain_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CartItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_domain_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCartResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_domain_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGuestCartResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_domain_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConvertGuestCartRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_domain_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveForLaterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_domain_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveToCartRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_domain_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSavedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_domain_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SavedItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_domain_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSavedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_domain_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurchaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_domain_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PurchaseResponse); i {
 			case 0:
 				return &v.state
@@ -627,13 +1633,33 @@ func file_domain_proto_init() {
 			}
 		}
 	}
+	file_domain_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*AddToCartRequest_User)(nil),
+		(*AddToCartRequest_SessionID)(nil),
+	}
+	file_domain_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*DeleteFromCartRequest_User)(nil),
+		(*DeleteFromCartRequest_SessionID)(nil),
+	}
+	file_domain_proto_msgTypes[2].OneofWrappers = []interface{}{
+		(*SetCartItemCountRequest_User)(nil),
+		(*SetCartItemCountRequest_SessionID)(nil),
+	}
+	file_domain_proto_msgTypes[3].OneofWrappers = []interface{}{
+		(*ClearCartRequest_User)(nil),
+		(*ClearCartRequest_SessionID)(nil),
+	}
+	file_domain_proto_msgTypes[5].OneofWrappers = []interface{}{
+		(*ListCartRequest_User)(nil),
+		(*ListCartRequest_SessionID)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_domain_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -57,9 +57,206 @@ func (m *AddToCartRequest) validate(all bool) error {
 
 	var errors []error
 
-	if m.GetUser() <= 0 {
+	if m.GetSku() <= 0 {
 		err := AddToCartRequestValidationError{
-			field:  "User",
+			field:  "Sku",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCount() <= 0 {
+		err := AddToCartRequestValidationError{
+			field:  "Count",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	oneofOwnerPresent := false
+	switch v := m.Owner.(type) {
+	case *AddToCartRequest_User:
+		if v == nil {
+			err := AddToCartRequestValidationError{
+				field:  "Owner",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOwnerPresent = true
+
+		if m.GetUser() <= 0 {
+			err := AddToCartRequestValidationError{
+				field:  "User",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *AddToCartRequest_SessionID:
+		if v == nil {
+			err := AddToCartRequestValidationError{
+				field:  "Owner",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOwnerPresent = true
+
+		if utf8.RuneCountInString(m.GetSessionID()) < 1 {
+			err := AddToCartRequestValidationError{
+				field:  "SessionID",
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofOwnerPresent {
+		err := AddToCartRequestValidationError{
+			field:  "Owner",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AddToCartRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddToCartRequestMultiError is an error wrapping multiple validation errors
+// returned by AddToCartRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AddToCartRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddToCartRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddToCartRequestMultiError) AllErrors() []error { return m }
+
+// AddToCartRequestValidationError is the validation error returned by
+// AddToCartRequest.Validate if the designated constraints aren't met.
+type AddToCartRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddToCartRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddToCartRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddToCartRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddToCartRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddToCartRequestValidationError) ErrorName() string { return "AddToCartRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AddToCartRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddToCartRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddToCartRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddToCartRequestValidationError{}
+
+// Validate checks the field values on DeleteFromCartRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteFromCartRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteFromCartRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteFromCartRequestMultiError, or nil if none found.
+func (m *DeleteFromCartRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteFromCartRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSku() <= 0 {
+		err := DeleteFromCartRequestValidationError{
+			field:  "Sku",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCount() <= 0 {
+		err := DeleteFromCartRequestValidationError{
+			field:  "Count",
 			reason: "value must be greater than 0",
 		}
 		if !all {
@@ -68,10 +265,1163 @@ func (m *AddToCartRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetSku() <= 0 {
-		err := AddToCartRequestValidationError{
-			field:  "Sku",
-			reason: "value must be greater than 0",
+	oneofOwnerPresent := false
+	switch v := m.Owner.(type) {
+	case *DeleteFromCartRequest_User:
+		if v == nil {
+			err := DeleteFromCartRequestValidationError{
+				field:  "Owner",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOwnerPresent = true
+
+		if m.GetUser() <= 0 {
+			err := DeleteFromCartRequestValidationError{
+				field:  "User",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *DeleteFromCartRequest_SessionID:
+		if v == nil {
+			err := DeleteFromCartRequestValidationError{
+				field:  "Owner",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOwnerPresent = true
+
+		if utf8.RuneCountInString(m.GetSessionID()) < 1 {
+			err := DeleteFromCartRequestValidationError{
+				field:  "SessionID",
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofOwnerPresent {
+		err := DeleteFromCartRequestValidationError{
+			field:  "Owner",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteFromCartRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteFromCartRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteFromCartRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteFromCartRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteFromCartRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteFromCartRequestMultiError) AllErrors() []error { return m }
+
+// DeleteFromCartRequestValidationError is the validation error returned by
+// DeleteFromCartRequest.Validate if the designated constraints aren't met.
+type DeleteFromCartRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteFromCartRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteFromCartRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteFromCartRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteFromCartRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteFromCartRequestValidationError) ErrorName() string {
+	return "DeleteFromCartRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteFromCartRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteFromCartRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteFromCartRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteFromCartRequestValidationError{}
+
+// Validate checks the field values on SetCartItemCountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetCartItemCountRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetCartItemCountRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetCartItemCountRequestMultiError, or nil if none found.
+func (m *SetCartItemCountRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetCartItemCountRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSku() <= 0 {
+		err := SetCartItemCountRequestValidationError{
+			field:  "Sku",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCount() > 65535 {
+		err := SetCartItemCountRequestValidationError{
+			field:  "Count",
+			reason: "value must be less than or equal to 65535",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	oneofOwnerPresent := false
+	switch v := m.Owner.(type) {
+	case *SetCartItemCountRequest_User:
+		if v == nil {
+			err := SetCartItemCountRequestValidationError{
+				field:  "Owner",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOwnerPresent = true
+
+		if m.GetUser() <= 0 {
+			err := SetCartItemCountRequestValidationError{
+				field:  "User",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *SetCartItemCountRequest_SessionID:
+		if v == nil {
+			err := SetCartItemCountRequestValidationError{
+				field:  "Owner",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOwnerPresent = true
+
+		if utf8.RuneCountInString(m.GetSessionID()) < 1 {
+			err := SetCartItemCountRequestValidationError{
+				field:  "SessionID",
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofOwnerPresent {
+		err := SetCartItemCountRequestValidationError{
+			field:  "Owner",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SetCartItemCountRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetCartItemCountRequestMultiError is an error wrapping multiple validation
+// errors returned by SetCartItemCountRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetCartItemCountRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetCartItemCountRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetCartItemCountRequestMultiError) AllErrors() []error { return m }
+
+// SetCartItemCountRequestValidationError is the validation error returned by
+// SetCartItemCountRequest.Validate if the designated constraints aren't met.
+type SetCartItemCountRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetCartItemCountRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetCartItemCountRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetCartItemCountRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetCartItemCountRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetCartItemCountRequestValidationError) ErrorName() string {
+	return "SetCartItemCountRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetCartItemCountRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetCartItemCountRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetCartItemCountRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetCartItemCountRequestValidationError{}
+
+// Validate checks the field values on ClearCartRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ClearCartRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClearCartRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ClearCartRequestMultiError, or nil if none found.
+func (m *ClearCartRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClearCartRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	oneofOwnerPresent := false
+	switch v := m.Owner.(type) {
+	case *ClearCartRequest_User:
+		if v == nil {
+			err := ClearCartRequestValidationError{
+				field:  "Owner",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOwnerPresent = true
+
+		if m.GetUser() <= 0 {
+			err := ClearCartRequestValidationError{
+				field:  "User",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *ClearCartRequest_SessionID:
+		if v == nil {
+			err := ClearCartRequestValidationError{
+				field:  "Owner",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOwnerPresent = true
+
+		if utf8.RuneCountInString(m.GetSessionID()) < 1 {
+			err := ClearCartRequestValidationError{
+				field:  "SessionID",
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofOwnerPresent {
+		err := ClearCartRequestValidationError{
+			field:  "Owner",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ClearCartRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClearCartRequestMultiError is an error wrapping multiple validation errors
+// returned by ClearCartRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ClearCartRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClearCartRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClearCartRequestMultiError) AllErrors() []error { return m }
+
+// ClearCartRequestValidationError is the validation error returned by
+// ClearCartRequest.Validate if the designated constraints aren't met.
+type ClearCartRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClearCartRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClearCartRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClearCartRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClearCartRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClearCartRequestValidationError) ErrorName() string { return "ClearCartRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ClearCartRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClearCartRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClearCartRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClearCartRequestValidationError{}
+
+// Validate checks the field values on MergeCartsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MergeCartsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MergeCartsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MergeCartsRequestMultiError, or nil if none found.
+func (m *MergeCartsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MergeCartsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetSessionID()) < 1 {
+		err := MergeCartsRequestValidationError{
+			field:  "SessionID",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUser() <= 0 {
+		err := MergeCartsRequestValidationError{
+			field:  "User",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MergeCartsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MergeCartsRequestMultiError is an error wrapping multiple validation errors
+// returned by MergeCartsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MergeCartsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MergeCartsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MergeCartsRequestMultiError) AllErrors() []error { return m }
+
+// MergeCartsRequestValidationError is the validation error returned by
+// MergeCartsRequest.Validate if the designated constraints aren't met.
+type MergeCartsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MergeCartsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MergeCartsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MergeCartsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MergeCartsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MergeCartsRequestValidationError) ErrorName() string {
+	return "MergeCartsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MergeCartsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMergeCartsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MergeCartsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MergeCartsRequestValidationError{}
+
+// Validate checks the field values on ListCartRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListCartRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCartRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCartRequestMultiError, or nil if none found.
+func (m *ListCartRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCartRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	oneofOwnerPresent := false
+	switch v := m.Owner.(type) {
+	case *ListCartRequest_User:
+		if v == nil {
+			err := ListCartRequestValidationError{
+				field:  "Owner",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOwnerPresent = true
+
+		if m.GetUser() <= 0 {
+			err := ListCartRequestValidationError{
+				field:  "User",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *ListCartRequest_SessionID:
+		if v == nil {
+			err := ListCartRequestValidationError{
+				field:  "Owner",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOwnerPresent = true
+
+		if utf8.RuneCountInString(m.GetSessionID()) < 1 {
+			err := ListCartRequestValidationError{
+				field:  "SessionID",
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofOwnerPresent {
+		err := ListCartRequestValidationError{
+			field:  "Owner",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListCartRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCartRequestMultiError is an error wrapping multiple validation errors
+// returned by ListCartRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListCartRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCartRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCartRequestMultiError) AllErrors() []error { return m }
+
+// ListCartRequestValidationError is the validation error returned by
+// ListCartRequest.Validate if the designated constraints aren't met.
+type ListCartRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCartRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCartRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCartRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCartRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCartRequestValidationError) ErrorName() string { return "ListCartRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListCartRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCartRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCartRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCartRequestValidationError{}
+
+// Validate checks the field values on CartItem with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CartItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CartItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CartItemMultiError, or nil
+// if none found.
+func (m *CartItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CartItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Sku
+
+	// no validation rules for Count
+
+	// no validation rules for Name
+
+	// no validation rules for Price
+
+	if len(errors) > 0 {
+		return CartItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// CartItemMultiError is an error wrapping multiple validation errors returned
+// by CartItem.ValidateAll() if the designated constraints aren't met.
+type CartItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CartItemMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CartItemMultiError) AllErrors() []error { return m }
+
+// CartItemValidationError is the validation error returned by
+// CartItem.Validate if the designated constraints aren't met.
+type CartItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CartItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CartItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CartItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CartItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CartItemValidationError) ErrorName() string { return "CartItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CartItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCartItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CartItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CartItemValidationError{}
+
+// Validate checks the field values on ListCartResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListCartResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCartResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCartResponseMultiError, or nil if none found.
+func (m *ListCartResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCartResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListCartResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListCartResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListCartResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalPrice
+
+	if len(errors) > 0 {
+		return ListCartResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCartResponseMultiError is an error wrapping multiple validation errors
+// returned by ListCartResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListCartResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCartResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCartResponseMultiError) AllErrors() []error { return m }
+
+// ListCartResponseValidationError is the validation error returned by
+// ListCartResponse.Validate if the designated constraints aren't met.
+type ListCartResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCartResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCartResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCartResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCartResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCartResponseValidationError) ErrorName() string { return "ListCartResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListCartResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCartResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCartResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCartResponseValidationError{}
+
+// Validate checks the field values on CreateGuestCartResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateGuestCartResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateGuestCartResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateGuestCartResponseMultiError, or nil if none found.
+func (m *CreateGuestCartResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateGuestCartResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SessionID
+
+	if len(errors) > 0 {
+		return CreateGuestCartResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateGuestCartResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateGuestCartResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateGuestCartResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateGuestCartResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateGuestCartResponseMultiError) AllErrors() []error { return m }
+
+// CreateGuestCartResponseValidationError is the validation error returned by
+// CreateGuestCartResponse.Validate if the designated constraints aren't met.
+type CreateGuestCartResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateGuestCartResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateGuestCartResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateGuestCartResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateGuestCartResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateGuestCartResponseValidationError) ErrorName() string {
+	return "CreateGuestCartResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateGuestCartResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateGuestCartResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateGuestCartResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateGuestCartResponseValidationError{}
+
+// Validate checks the field values on ConvertGuestCartRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConvertGuestCartRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConvertGuestCartRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConvertGuestCartRequestMultiError, or nil if none found.
+func (m *ConvertGuestCartRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConvertGuestCartRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetSessionID()) < 1 {
+		err := ConvertGuestCartRequestValidationError{
+			field:  "SessionID",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -79,9 +1429,9 @@ func (m *AddToCartRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetCount() <= 0 {
-		err := AddToCartRequestValidationError{
-			field:  "Count",
+	if m.GetUser() <= 0 {
+		err := ConvertGuestCartRequestValidationError{
+			field:  "User",
 			reason: "value must be greater than 0",
 		}
 		if !all {
@@ -91,19 +1441,19 @@ func (m *AddToCartRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return AddToCartRequestMultiError(errors)
+		return ConvertGuestCartRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddToCartRequestMultiError is an error wrapping multiple validation errors
-// returned by AddToCartRequest.ValidateAll() if the designated constraints
-// aren't met.
-type AddToCartRequestMultiError []error
+// ConvertGuestCartRequestMultiError is an error wrapping multiple validation
+// errors returned by ConvertGuestCartRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ConvertGuestCartRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddToCartRequestMultiError) Error() string {
+func (m ConvertGuestCartRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -112,11 +1462,11 @@ func (m AddToCartRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddToCartRequestMultiError) AllErrors() []error { return m }
+func (m ConvertGuestCartRequestMultiError) AllErrors() []error { return m }
 
-// AddToCartRequestValidationError is the validation error returned by
-// AddToCartRequest.Validate if the designated constraints aren't met.
-type AddToCartRequestValidationError struct {
+// ConvertGuestCartRequestValidationError is the validation error returned by
+// ConvertGuestCartRequest.Validate if the designated constraints aren't met.
+type ConvertGuestCartRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -124,22 +1474,24 @@ type AddToCartRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddToCartRequestValidationError) Field() string { return e.field }
+func (e ConvertGuestCartRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddToCartRequestValidationError) Reason() string { return e.reason }
+func (e ConvertGuestCartRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddToCartRequestValidationError) Cause() error { return e.cause }
+func (e ConvertGuestCartRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddToCartRequestValidationError) Key() bool { return e.key }
+func (e ConvertGuestCartRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddToCartRequestValidationError) ErrorName() string { return "AddToCartRequestValidationError" }
+func (e ConvertGuestCartRequestValidationError) ErrorName() string {
+	return "ConvertGuestCartRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AddToCartRequestValidationError) Error() string {
+func (e ConvertGuestCartRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -151,14 +1503,14 @@ func (e AddToCartRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddToCartRequest.%s: %s%s",
+		"invalid %sConvertGuestCartRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AddToCartRequestValidationError{}
+var _ error = ConvertGuestCartRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -166,24 +1518,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddToCartRequestValidationError{}
+} = ConvertGuestCartRequestValidationError{}
 
-// Validate checks the field values on DeleteFromCartRequest with the rules
+// Validate checks the field values on SaveForLaterRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteFromCartRequest) Validate() error {
+func (m *SaveForLaterRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteFromCartRequest with the rules
+// ValidateAll checks the field values on SaveForLaterRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteFromCartRequestMultiError, or nil if none found.
-func (m *DeleteFromCartRequest) ValidateAll() error {
+// SaveForLaterRequestMultiError, or nil if none found.
+func (m *SaveForLaterRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteFromCartRequest) validate(all bool) error {
+func (m *SaveForLaterRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -191,7 +1543,7 @@ func (m *DeleteFromCartRequest) validate(all bool) error {
 	var errors []error
 
 	if m.GetUser() <= 0 {
-		err := DeleteFromCartRequestValidationError{
+		err := SaveForLaterRequestValidationError{
 			field:  "User",
 			reason: "value must be greater than 0",
 		}
@@ -202,7 +1554,7 @@ func (m *DeleteFromCartRequest) validate(all bool) error {
 	}
 
 	if m.GetSku() <= 0 {
-		err := DeleteFromCartRequestValidationError{
+		err := SaveForLaterRequestValidationError{
 			field:  "Sku",
 			reason: "value must be greater than 0",
 		}
@@ -212,9 +1564,122 @@ func (m *DeleteFromCartRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetCount() <= 0 {
-		err := DeleteFromCartRequestValidationError{
-			field:  "Count",
+	if len(errors) > 0 {
+		return SaveForLaterRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SaveForLaterRequestMultiError is an error wrapping multiple validation
+// errors returned by SaveForLaterRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SaveForLaterRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SaveForLaterRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SaveForLaterRequestMultiError) AllErrors() []error { return m }
+
+// SaveForLaterRequestValidationError is the validation error returned by
+// SaveForLaterRequest.Validate if the designated constraints aren't met.
+type SaveForLaterRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SaveForLaterRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SaveForLaterRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SaveForLaterRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SaveForLaterRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SaveForLaterRequestValidationError) ErrorName() string {
+	return "SaveForLaterRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SaveForLaterRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSaveForLaterRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SaveForLaterRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SaveForLaterRequestValidationError{}
+
+// Validate checks the field values on MoveToCartRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MoveToCartRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MoveToCartRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MoveToCartRequestMultiError, or nil if none found.
+func (m *MoveToCartRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MoveToCartRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUser() <= 0 {
+		err := MoveToCartRequestValidationError{
+			field:  "User",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSku() <= 0 {
+		err := MoveToCartRequestValidationError{
+			field:  "Sku",
 			reason: "value must be greater than 0",
 		}
 		if !all {
@@ -224,19 +1689,19 @@ func (m *DeleteFromCartRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return DeleteFromCartRequestMultiError(errors)
+		return MoveToCartRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteFromCartRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteFromCartRequest.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteFromCartRequestMultiError []error
+// MoveToCartRequestMultiError is an error wrapping multiple validation errors
+// returned by MoveToCartRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MoveToCartRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteFromCartRequestMultiError) Error() string {
+func (m MoveToCartRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -245,11 +1710,11 @@ func (m DeleteFromCartRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteFromCartRequestMultiError) AllErrors() []error { return m }
+func (m MoveToCartRequestMultiError) AllErrors() []error { return m }
 
-// DeleteFromCartRequestValidationError is the validation error returned by
-// DeleteFromCartRequest.Validate if the designated constraints aren't met.
-type DeleteFromCartRequestValidationError struct {
+// MoveToCartRequestValidationError is the validation error returned by
+// MoveToCartRequest.Validate if the designated constraints aren't met.
+type MoveToCartRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -257,24 +1722,24 @@ type DeleteFromCartRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteFromCartRequestValidationError) Field() string { return e.field }
+func (e MoveToCartRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteFromCartRequestValidationError) Reason() string { return e.reason }
+func (e MoveToCartRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteFromCartRequestValidationError) Cause() error { return e.cause }
+func (e MoveToCartRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteFromCartRequestValidationError) Key() bool { return e.key }
+func (e MoveToCartRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteFromCartRequestValidationError) ErrorName() string {
-	return "DeleteFromCartRequestValidationError"
+func (e MoveToCartRequestValidationError) ErrorName() string {
+	return "MoveToCartRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteFromCartRequestValidationError) Error() string {
+func (e MoveToCartRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -286,14 +1751,14 @@ func (e DeleteFromCartRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteFromCartRequest.%s: %s%s",
+		"invalid %sMoveToCartRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteFromCartRequestValidationError{}
+var _ error = MoveToCartRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -301,24 +1766,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteFromCartRequestValidationError{}
+} = MoveToCartRequestValidationError{}
 
-// Validate checks the field values on ListCartRequest with the rules defined
+// Validate checks the field values on ListSavedRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *ListCartRequest) Validate() error {
+func (m *ListSavedRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListCartRequest with the rules
+// ValidateAll checks the field values on ListSavedRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListCartRequestMultiError, or nil if none found.
-func (m *ListCartRequest) ValidateAll() error {
+// ListSavedRequestMultiError, or nil if none found.
+func (m *ListSavedRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListCartRequest) validate(all bool) error {
+func (m *ListSavedRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -326,7 +1791,7 @@ func (m *ListCartRequest) validate(all bool) error {
 	var errors []error
 
 	if m.GetUser() <= 0 {
-		err := ListCartRequestValidationError{
+		err := ListSavedRequestValidationError{
 			field:  "User",
 			reason: "value must be greater than 0",
 		}
@@ -337,19 +1802,19 @@ func (m *ListCartRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ListCartRequestMultiError(errors)
+		return ListSavedRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListCartRequestMultiError is an error wrapping multiple validation errors
-// returned by ListCartRequest.ValidateAll() if the designated constraints
+// ListSavedRequestMultiError is an error wrapping multiple validation errors
+// returned by ListSavedRequest.ValidateAll() if the designated constraints
 // aren't met.
-type ListCartRequestMultiError []error
+type ListSavedRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListCartRequestMultiError) Error() string {
+func (m ListSavedRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -358,11 +1823,11 @@ func (m ListCartRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListCartRequestMultiError) AllErrors() []error { return m }
+func (m ListSavedRequestMultiError) AllErrors() []error { return m }
 
-// ListCartRequestValidationError is the validation error returned by
-// ListCartRequest.Validate if the designated constraints aren't met.
-type ListCartRequestValidationError struct {
+// ListSavedRequestValidationError is the validation error returned by
+// ListSavedRequest.Validate if the designated constraints aren't met.
+type ListSavedRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -370,22 +1835,22 @@ type ListCartRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListCartRequestValidationError) Field() string { return e.field }
+func (e ListSavedRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListCartRequestValidationError) Reason() string { return e.reason }
+func (e ListSavedRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListCartRequestValidationError) Cause() error { return e.cause }
+func (e ListSavedRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListCartRequestValidationError) Key() bool { return e.key }
+func (e ListSavedRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListCartRequestValidationError) ErrorName() string { return "ListCartRequestValidationError" }
+func (e ListSavedRequestValidationError) ErrorName() string { return "ListSavedRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListCartRequestValidationError) Error() string {
+func (e ListSavedRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -397,14 +1862,14 @@ func (e ListCartRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListCartRequest.%s: %s%s",
+		"invalid %sListSavedRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListCartRequestValidationError{}
+var _ error = ListSavedRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -412,24 +1877,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListCartRequestValidationError{}
+} = ListSavedRequestValidationError{}
 
-// Validate checks the field values on CartItem with the rules defined in the
+// Validate checks the field values on SavedItem with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *CartItem) Validate() error {
+func (m *SavedItem) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CartItem with the rules defined in
+// ValidateAll checks the field values on SavedItem with the rules defined in
 // the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in CartItemMultiError, or nil
+// result is a list of violation errors wrapped in SavedItemMultiError, or nil
 // if none found.
-func (m *CartItem) ValidateAll() error {
+func (m *SavedItem) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CartItem) validate(all bool) error {
+func (m *SavedItem) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -444,19 +1909,21 @@ func (m *CartItem) validate(all bool) error {
 
 	// no validation rules for Price
 
+	// no validation rules for Available
+
 	if len(errors) > 0 {
-		return CartItemMultiError(errors)
+		return SavedItemMultiError(errors)
 	}
 
 	return nil
 }
 
-// CartItemMultiError is an error wrapping multiple validation errors returned
-// by CartItem.ValidateAll() if the designated constraints aren't met.
-type CartItemMultiError []error
+// SavedItemMultiError is an error wrapping multiple validation errors returned
+// by SavedItem.ValidateAll() if the designated constraints aren't met.
+type SavedItemMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CartItemMultiError) Error() string {
+func (m SavedItemMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -465,11 +1932,11 @@ func (m CartItemMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CartItemMultiError) AllErrors() []error { return m }
+func (m SavedItemMultiError) AllErrors() []error { return m }
 
-// CartItemValidationError is the validation error returned by
-// CartItem.Validate if the designated constraints aren't met.
-type CartItemValidationError struct {
+// SavedItemValidationError is the validation error returned by
+// SavedItem.Validate if the designated constraints aren't met.
+type SavedItemValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -477,22 +1944,22 @@ type CartItemValidationError struct {
 }
 
 // Field function returns field value.
-func (e CartItemValidationError) Field() string { return e.field }
+func (e SavedItemValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CartItemValidationError) Reason() string { return e.reason }
+func (e SavedItemValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CartItemValidationError) Cause() error { return e.cause }
+func (e SavedItemValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CartItemValidationError) Key() bool { return e.key }
+func (e SavedItemValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CartItemValidationError) ErrorName() string { return "CartItemValidationError" }
+func (e SavedItemValidationError) ErrorName() string { return "SavedItemValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CartItemValidationError) Error() string {
+func (e SavedItemValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -504,14 +1971,14 @@ func (e CartItemValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCartItem.%s: %s%s",
+		"invalid %sSavedItem.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CartItemValidationError{}
+var _ error = SavedItemValidationError{}
 
 var _ interface {
 	Field() string
@@ -519,24 +1986,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CartItemValidationError{}
+} = SavedItemValidationError{}
 
-// Validate checks the field values on ListCartResponse with the rules defined
+// Validate checks the field values on ListSavedResponse with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *ListCartResponse) Validate() error {
+func (m *ListSavedResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListCartResponse with the rules
+// ValidateAll checks the field values on ListSavedResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListCartResponseMultiError, or nil if none found.
-func (m *ListCartResponse) ValidateAll() error {
+// ListSavedResponseMultiError, or nil if none found.
+func (m *ListSavedResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListCartResponse) validate(all bool) error {
+func (m *ListSavedResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -550,7 +2017,7 @@ func (m *ListCartResponse) validate(all bool) error {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListCartResponseValidationError{
+					errors = append(errors, ListSavedResponseValidationError{
 						field:  fmt.Sprintf("Items[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -558,7 +2025,7 @@ func (m *ListCartResponse) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListCartResponseValidationError{
+					errors = append(errors, ListSavedResponseValidationError{
 						field:  fmt.Sprintf("Items[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -567,7 +2034,7 @@ func (m *ListCartResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListCartResponseValidationError{
+				return ListSavedResponseValidationError{
 					field:  fmt.Sprintf("Items[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -577,22 +2044,20 @@ func (m *ListCartResponse) validate(all bool) error {
 
 	}
 
-	// no validation rules for TotalPrice
-
 	if len(errors) > 0 {
-		return ListCartResponseMultiError(errors)
+		return ListSavedResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListCartResponseMultiError is an error wrapping multiple validation errors
-// returned by ListCartResponse.ValidateAll() if the designated constraints
+// ListSavedResponseMultiError is an error wrapping multiple validation errors
+// returned by ListSavedResponse.ValidateAll() if the designated constraints
 // aren't met.
-type ListCartResponseMultiError []error
+type ListSavedResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListCartResponseMultiError) Error() string {
+func (m ListSavedResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -601,11 +2066,11 @@ func (m ListCartResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListCartResponseMultiError) AllErrors() []error { return m }
+func (m ListSavedResponseMultiError) AllErrors() []error { return m }
 
-// ListCartResponseValidationError is the validation error returned by
-// ListCartResponse.Validate if the designated constraints aren't met.
-type ListCartResponseValidationError struct {
+// ListSavedResponseValidationError is the validation error returned by
+// ListSavedResponse.Validate if the designated constraints aren't met.
+type ListSavedResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -613,22 +2078,24 @@ type ListCartResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListCartResponseValidationError) Field() string { return e.field }
+func (e ListSavedResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListCartResponseValidationError) Reason() string { return e.reason }
+func (e ListSavedResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListCartResponseValidationError) Cause() error { return e.cause }
+func (e ListSavedResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListCartResponseValidationError) Key() bool { return e.key }
+func (e ListSavedResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListCartResponseValidationError) ErrorName() string { return "ListCartResponseValidationError" }
+func (e ListSavedResponseValidationError) ErrorName() string {
+	return "ListSavedResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ListCartResponseValidationError) Error() string {
+func (e ListSavedResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -640,14 +2107,14 @@ func (e ListCartResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListCartResponse.%s: %s%s",
+		"invalid %sListSavedResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListCartResponseValidationError{}
+var _ error = ListSavedResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -655,7 +2122,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListCartResponseValidationError{}
+} = ListSavedResponseValidationError{}
 
 // Validate checks the field values on PurchaseRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
@@ -690,6 +2157,17 @@ func (m *PurchaseRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if utf8.RuneCountInString(m.GetIdempotencyKey()) > 64 {
+		err := PurchaseRequestValidationError{
+			field:  "IdempotencyKey",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return PurchaseRequestMultiError(errors)
 	}
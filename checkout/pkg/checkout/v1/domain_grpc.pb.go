@@ -28,8 +28,24 @@ type CheckoutV1Client interface {
 	AddToCart(ctx context.Context, in *AddToCartRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Удаляет товар из корзины
 	DeleteFromCart(ctx context.Context, in *DeleteFromCartRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Устанавливает количество товара в корзине, 0 удаляет товар
+	SetCartItemCount(ctx context.Context, in *SetCartItemCountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Удаляет все товары из корзины
+	ClearCart(ctx context.Context, in *ClearCartRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Переносит корзину анонимной сессии в корзину пользователя
+	MergeCarts(ctx context.Context, in *MergeCartsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Показывает список товаров в корзине
 	ListCart(ctx context.Context, in *ListCartRequest, opts ...grpc.CallOption) (*ListCartResponse, error)
+	// Создает корзину гостя и выдает идентификатор сессии
+	CreateGuestCart(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CreateGuestCartResponse, error)
+	// Переносит корзину гостя в корзину пользователя после входа
+	ConvertGuestCart(ctx context.Context, in *ConvertGuestCartRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Откладывает товар из корзины, товар не из корзины попадает в список желаемого
+	SaveForLater(ctx context.Context, in *SaveForLaterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Возвращает отложенный товар в корзину
+	MoveToCart(ctx context.Context, in *MoveToCartRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Показывает отложенные товары
+	ListSaved(ctx context.Context, in *ListSavedRequest, opts ...grpc.CallOption) (*ListSavedResponse, error)
 	// Оформить заказ по все товарам корзины
 	Purchase(ctx context.Context, in *PurchaseRequest, opts ...grpc.CallOption) (*PurchaseResponse, error)
 }
@@ -60,6 +76,33 @@ func (c *checkoutV1Client) DeleteFromCart(ctx context.Context, in *DeleteFromCar
 	return out, nil
 }
 
+func (c *checkoutV1Client) SetCartItemCount(ctx context.Context, in *SetCartItemCountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/checkout_v1.CheckoutV1/SetCartItemCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checkoutV1Client) ClearCart(ctx context.Context, in *ClearCartRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/checkout_v1.CheckoutV1/ClearCart", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checkoutV1Client) MergeCarts(ctx context.Context, in *MergeCartsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/checkout_v1.CheckoutV1/MergeCarts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *checkoutV1Client) ListCart(ctx context.Context, in *ListCartRequest, opts ...grpc.CallOption) (*ListCartResponse, error) {
 	out := new(ListCartResponse)
 	err := c.cc.Invoke(ctx, "/checkout_v1.CheckoutV1/ListCart", in, out, opts...)
@@ -69,6 +112,51 @@ func (c *checkoutV1Client) ListCart(ctx context.Context, in *ListCartRequest, op
 	return out, nil
 }
 
+func (c *checkoutV1Client) CreateGuestCart(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CreateGuestCartResponse, error) {
+	out := new(CreateGuestCartResponse)
+	err := c.cc.Invoke(ctx, "/checkout_v1.CheckoutV1/CreateGuestCart", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checkoutV1Client) ConvertGuestCart(ctx context.Context, in *ConvertGuestCartRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/checkout_v1.CheckoutV1/ConvertGuestCart", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checkoutV1Client) SaveForLater(ctx context.Context, in *SaveForLaterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/checkout_v1.CheckoutV1/SaveForLater", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checkoutV1Client) MoveToCart(ctx context.Context, in *MoveToCartRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/checkout_v1.CheckoutV1/MoveToCart", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checkoutV1Client) ListSaved(ctx context.Context, in *ListSavedRequest, opts ...grpc.CallOption) (*ListSavedResponse, error) {
+	out := new(ListSavedResponse)
+	err := c.cc.Invoke(ctx, "/checkout_v1.CheckoutV1/ListSaved", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *checkoutV1Client) Purchase(ctx context.Context, in *PurchaseRequest, opts ...grpc.CallOption) (*PurchaseResponse, error) {
 	out := new(PurchaseResponse)
 	err := c.cc.Invoke(ctx, "/checkout_v1.CheckoutV1/Purchase", in, out, opts...)
@@ -86,8 +174,24 @@ type CheckoutV1Server interface {
 	AddToCart(context.Context, *AddToCartRequest) (*emptypb.Empty, error)
 	// Удаляет товар из корзины
 	DeleteFromCart(context.Context, *DeleteFromCartRequest) (*emptypb.Empty, error)
+	// Устанавливает количество товара в корзине, 0 удаляет товар
+	SetCartItemCount(context.Context, *SetCartItemCountRequest) (*emptypb.Empty, error)
+	// Удаляет все товары из корзины
+	ClearCart(context.Context, *ClearCartRequest) (*emptypb.Empty, error)
+	// Переносит корзину анонимной сессии в корзину пользователя
+	MergeCarts(context.Context, *MergeCartsRequest) (*emptypb.Empty, error)
 	// Показывает список товаров в корзине
 	ListCart(context.Context, *ListCartRequest) (*ListCartResponse, error)
+	// Создает корзину гостя и выдает идентификатор сессии
+	CreateGuestCart(context.Context, *emptypb.Empty) (*CreateGuestCartResponse, error)
+	// Переносит корзину гостя в корзину пользователя после входа
+	ConvertGuestCart(context.Context, *ConvertGuestCartRequest) (*emptypb.Empty, error)
+	// Откладывает товар из корзины, товар не из корзины попадает в список желаемого
+	SaveForLater(context.Context, *SaveForLaterRequest) (*emptypb.Empty, error)
+	// Возвращает отложенный товар в корзину
+	MoveToCart(context.Context, *MoveToCartRequest) (*emptypb.Empty, error)
+	// Показывает отложенные товары
+	ListSaved(context.Context, *ListSavedRequest) (*ListSavedResponse, error)
 	// Оформить заказ по все товарам корзины
 	Purchase(context.Context, *PurchaseRequest) (*PurchaseResponse, error)
 	mustEmbedUnimplementedCheckoutV1Server()
@@ -103,9 +207,33 @@ func (UnimplementedCheckoutV1Server) AddToCart(context.Context, *AddToCartReques
 func (UnimplementedCheckoutV1Server) DeleteFromCart(context.Context, *DeleteFromCartRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteFromCart not implemented")
 }
+func (UnimplementedCheckoutV1Server) SetCartItemCount(context.Context, *SetCartItemCountRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCartItemCount not implemented")
+}
+func (UnimplementedCheckoutV1Server) ClearCart(context.Context, *ClearCartRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearCart not implemented")
+}
+func (UnimplementedCheckoutV1Server) MergeCarts(context.Context, *MergeCartsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeCarts not implemented")
+}
 func (UnimplementedCheckoutV1Server) ListCart(context.Context, *ListCartRequest) (*ListCartResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListCart not implemented")
 }
+func (UnimplementedCheckoutV1Server) CreateGuestCart(context.Context, *emptypb.Empty) (*CreateGuestCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGuestCart not implemented")
+}
+func (UnimplementedCheckoutV1Server) ConvertGuestCart(context.Context, *ConvertGuestCartRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertGuestCart not implemented")
+}
+func (UnimplementedCheckoutV1Server) SaveForLater(context.Context, *SaveForLaterRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveForLater not implemented")
+}
+func (UnimplementedCheckoutV1Server) MoveToCart(context.Context, *MoveToCartRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveToCart not implemented")
+}
+func (UnimplementedCheckoutV1Server) ListSaved(context.Context, *ListSavedRequest) (*ListSavedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSaved not implemented")
+}
 func (UnimplementedCheckoutV1Server) Purchase(context.Context, *PurchaseRequest) (*PurchaseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Purchase not implemented")
 }
@@ -158,6 +286,60 @@ func _CheckoutV1_DeleteFromCart_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CheckoutV1_SetCartItemCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCartItemCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CheckoutV1Server).SetCartItemCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/checkout_v1.CheckoutV1/SetCartItemCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CheckoutV1Server).SetCartItemCount(ctx, req.(*SetCartItemCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CheckoutV1_ClearCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CheckoutV1Server).ClearCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/checkout_v1.CheckoutV1/ClearCart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CheckoutV1Server).ClearCart(ctx, req.(*ClearCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CheckoutV1_MergeCarts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeCartsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CheckoutV1Server).MergeCarts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/checkout_v1.CheckoutV1/MergeCarts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CheckoutV1Server).MergeCarts(ctx, req.(*MergeCartsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CheckoutV1_ListCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListCartRequest)
 	if err := dec(in); err != nil {
@@ -176,6 +358,96 @@ func _CheckoutV1_ListCart_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CheckoutV1_CreateGuestCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CheckoutV1Server).CreateGuestCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/checkout_v1.CheckoutV1/CreateGuestCart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CheckoutV1Server).CreateGuestCart(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CheckoutV1_ConvertGuestCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertGuestCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CheckoutV1Server).ConvertGuestCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/checkout_v1.CheckoutV1/ConvertGuestCart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CheckoutV1Server).ConvertGuestCart(ctx, req.(*ConvertGuestCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CheckoutV1_SaveForLater_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveForLaterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CheckoutV1Server).SaveForLater(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/checkout_v1.CheckoutV1/SaveForLater",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CheckoutV1Server).SaveForLater(ctx, req.(*SaveForLaterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CheckoutV1_MoveToCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveToCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CheckoutV1Server).MoveToCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/checkout_v1.CheckoutV1/MoveToCart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CheckoutV1Server).MoveToCart(ctx, req.(*MoveToCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CheckoutV1_ListSaved_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSavedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CheckoutV1Server).ListSaved(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/checkout_v1.CheckoutV1/ListSaved",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CheckoutV1Server).ListSaved(ctx, req.(*ListSavedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CheckoutV1_Purchase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PurchaseRequest)
 	if err := dec(in); err != nil {
@@ -209,10 +481,42 @@ var CheckoutV1_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteFromCart",
 			Handler:    _CheckoutV1_DeleteFromCart_Handler,
 		},
+		{
+			MethodName: "SetCartItemCount",
+			Handler:    _CheckoutV1_SetCartItemCount_Handler,
+		},
+		{
+			MethodName: "ClearCart",
+			Handler:    _CheckoutV1_ClearCart_Handler,
+		},
+		{
+			MethodName: "MergeCarts",
+			Handler:    _CheckoutV1_MergeCarts_Handler,
+		},
 		{
 			MethodName: "ListCart",
 			Handler:    _CheckoutV1_ListCart_Handler,
 		},
+		{
+			MethodName: "CreateGuestCart",
+			Handler:    _CheckoutV1_CreateGuestCart_Handler,
+		},
+		{
+			MethodName: "ConvertGuestCart",
+			Handler:    _CheckoutV1_ConvertGuestCart_Handler,
+		},
+		{
+			MethodName: "SaveForLater",
+			Handler:    _CheckoutV1_SaveForLater_Handler,
+		},
+		{
+			MethodName: "MoveToCart",
+			Handler:    _CheckoutV1_MoveToCart_Handler,
+		},
+		{
+			MethodName: "ListSaved",
+			Handler:    _CheckoutV1_ListSaved_Handler,
+		},
 		{
 			MethodName: "Purchase",
 			Handler:    _CheckoutV1_Purchase_Handler,
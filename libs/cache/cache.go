@@ -75,7 +75,7 @@ func (c *cache) Get(key string) (interface{}, bool) {
 	h.Write([]byte(key))
 	//По остатку от деления на количество бакетов значение берется из определенного
 	val, ok := c.buckets[int(h.Sum32())%int(c.numBuckets)].Get(key)
-	defer HistogramResponseTime.WithLabelValues(fmt.Sprintf("%v", ok)).Observe(time.Since(timeStart).Seconds())
+	HistogramResponseTime.WithLabelValues(fmt.Sprintf("%v", ok)).Observe(time.Since(timeStart).Seconds())
 	return val, ok
 }
 
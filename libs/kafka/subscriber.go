@@ -0,0 +1,75 @@
+package kafka
+
+import (
+	"context"
+	"sync"
+
+	"github.com/Shopify/sarama"
+	"github.com/pkg/errors"
+)
+
+type Subscriber struct {
+	brokers []string
+	topic   string
+	handler func([]byte)
+}
+
+func NewSubscriber(brokers []string, topic string, handler func([]byte)) *Subscriber {
+	return &Subscriber{
+		brokers: brokers,
+		topic:   topic,
+		handler: handler,
+	}
+}
+
+func (s *Subscriber) Run(ctx context.Context) error {
+	//Версия протокола по умолчанию, как у продюсера: старые брокеры отвергают новые версии
+	consumer, err := sarama.NewConsumer(s.brokers, sarama.NewConfig())
+	if err != nil {
+		return errors.Wrap(err, "create consumer")
+	}
+	defer consumer.Close()
+
+	partitions, err := consumer.Partitions(s.topic)
+	if err != nil {
+		return errors.Wrap(err, "get partitions")
+	}
+	//Сначала открываем все партиции, чтобы при ошибке закрыть уже открытые и не оставить их без читателя
+	pcs := make([]sarama.PartitionConsumer, 0, len(partitions))
+	for _, partition := range partitions {
+		pc, err := consumer.ConsumePartition(s.topic, partition, sarama.OffsetNewest)
+		if err != nil {
+			for _, started := range pcs {
+				started.Close()
+			}
+			return errors.Wrapf(err, "consume partition %d", partition)
+		}
+		pcs = append(pcs, pc)
+	}
+
+	wg := &sync.WaitGroup{}
+	for _, pc := range pcs {
+		pc := pc
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer pc.AsyncClose()
+			for {
+				select {
+				case message, ok := <-pc.Messages():
+					if !ok {
+						return
+					}
+					s.handler(message.Value)
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if ctx.Err() != nil {
+		return nil
+	}
+	return errors.New("partition consumers stopped")
+}
@@ -54,6 +54,18 @@ type TxKey string
 
 const key = TxKey("tx")
 
+const (
+	IsoLevelSerializable    = "serializable"
+	IsoLevelRepeatableRead  = "repeatable read"
+	IsoLevelReadCommitted   = "read committed"
+	IsoLevelReadUncommitted = "read uncommitted"
+)
+
+const (
+	serializationFailureCode = "40001"
+	deadlockDetectedCode     = "40P01"
+)
+
 func (tm *TransactionManager) RunTransaction(ctx context.Context, isoLevel string, fx func(ctxTX context.Context) error) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "transaction")
 	defer span.Finish()
@@ -61,13 +73,13 @@ func (tm *TransactionManager) RunTransaction(ctx context.Context, isoLevel strin
 
 	var txIsoLevel pgx.TxIsoLevel
 	switch isoLevel {
-	case "serializable":
+	case IsoLevelSerializable:
 		txIsoLevel = pgx.Serializable
-	case "repeatable read":
+	case IsoLevelRepeatableRead:
 		txIsoLevel = pgx.RepeatableRead
-	case "read committed":
+	case IsoLevelReadCommitted:
 		txIsoLevel = pgx.ReadCommitted
-	case "read uncommitted":
+	case IsoLevelReadUncommitted:
 		txIsoLevel = pgx.ReadUncommitted
 	default:
 		txIsoLevel = pgx.Serializable
@@ -91,6 +103,14 @@ func (tm *TransactionManager) RunTransaction(ctx context.Context, isoLevel strin
 	return nil
 }
 
+func IsSerializationFailure(err error) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+	return pgErr.Code == serializationFailureCode || pgErr.Code == deadlockDetectedCode
+}
+
 func (tm *TransactionManager) GetQueryEngine(ctx context.Context) QueryEngine {
 	tx, ok := ctx.Value(key).(QueryEngine)
 	if ok && tx != nil {
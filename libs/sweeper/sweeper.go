@@ -0,0 +1,46 @@
+package sweeper
+
+import (
+	"context"
+	"route256/libs/logger"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const defaultInterval = time.Minute
+
+type Sweep func(ctx context.Context) error
+
+type Sweeper struct {
+	name     string
+	sweep    Sweep
+	interval time.Duration
+}
+
+func New(name string, sweep Sweep, interval time.Duration) *Sweeper {
+	if interval == 0 {
+		interval = defaultInterval
+	}
+	return &Sweeper{
+		name:     name,
+		sweep:    sweep,
+		interval: interval,
+	}
+}
+
+func (s *Sweeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := s.sweep(ctx)
+			if err != nil {
+				logger.Error(ctx, s.name, zap.Error(err))
+			}
+		}
+	}
+}
@@ -11,12 +11,17 @@ import (
 	"route256/libs/kafka"
 	"route256/libs/logger"
 	transactor "route256/libs/postgres_transactor"
+	"route256/libs/sweeper"
 	"route256/libs/tracing"
 	"route256/loms/internal/api/loms/v1"
+	"route256/loms/internal/clients/productservice"
 	"route256/loms/internal/config"
 	"route256/loms/internal/domain"
+	"route256/loms/internal/payments"
 	repository "route256/loms/internal/repository/postgres"
+	"route256/loms/internal/reserver"
 	"route256/loms/internal/sender"
+	"route256/loms/internal/stockwatch"
 	desc "route256/loms/pkg/loms/v1"
 	"sync"
 	"syscall"
@@ -39,16 +44,73 @@ func main() {
 	if err != nil {
 		logger.Fatal("config init", zap.Error(err))
 	}
+	//Без секрета вебхук принял бы уведомление, подписанное кем угодно
+	if config.ConfigData.Payments.WebhookSecret == "" {
+		logger.Fatal("payments webhook secret is not set")
+	}
 	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer cancel()
 
+	tm, err := transactor.New(config.ConfigData.DBConnectURL)
+	if err != nil {
+		logger.Fatal("init transaction manager:", zap.Error(err))
+	}
+	repo := repository.NewItemsRepo(tm)
+	connProducts, err := grpc.Dial(config.ConfigData.Services.Products,
+		grpc.WithUnaryInterceptor(interceptors.ClientInterceptor("products")),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		logger.Fatal("failed create products client: failed to connect to server:", zap.Error(err))
+	}
+	defer connProducts.Close()
+	productsServiceClient := productservice.New(config.ConfigData.Token, connProducts)
+	paymentProvider := payments.NewFake(payments.FakeConfig{
+		WebhookURL:   config.ConfigData.Payments.Fake.WebhookURL,
+		Secret:       config.ConfigData.Payments.WebhookSecret,
+		ConfirmDelay: config.ConfigData.Payments.Fake.ConfirmDelay,
+	})
+	producer, err := kafka.NewSyncProducer(config.ConfigData.Kafka.Brokers)
+	if err != nil {
+		logger.Fatal("init kafka producer:", zap.Error(err))
+	}
+	relay := sender.NewRelay(repo, tm, sender.NewOrderSender(producer, config.ConfigData.Kafka.Topic), sender.RelayConfig{
+		Interval:  config.ConfigData.Outbox.Interval,
+		BatchSize: config.ConfigData.Outbox.BatchSize,
+		Retries:   config.ConfigData.Outbox.Retries,
+	})
+	stockRelay := sender.NewStockRelay(repo, tm, sender.NewStockSender(producer, config.ConfigData.Kafka.StockTopic), sender.RelayConfig{
+		Interval:  config.ConfigData.Outbox.Interval,
+		BatchSize: config.ConfigData.Outbox.BatchSize,
+		Retries:   config.ConfigData.Outbox.Retries,
+	})
+	stockHub := stockwatch.New()
+	stockSubscriber := kafka.NewSubscriber(config.ConfigData.Kafka.Brokers, config.ConfigData.Kafka.StockTopic, stockHub.HandleMessage)
+
+	strategy, err := domain.NewReservationStrategy(config.ConfigData.Reservation.Strategy)
+	if err != nil {
+		logger.Fatal("init reservation strategy:", zap.Error(err))
+	}
+	businessLogic := domain.New(repo, repo, repo, repo, tm, paymentProvider, productsServiceClient, domain.Config{
+		PaymentTimeout:      config.ConfigData.Orders.PaymentTimeout,
+		BackorderTimeout:    config.ConfigData.Orders.BackorderTimeout,
+		ReservationRetries:  config.ConfigData.Reservation.Retries,
+		ReservationStrategy: strategy,
+	})
+	paymentSweeper := sweeper.New("cancel expired orders", businessLogic.CancelExpiredOrders, config.ConfigData.Orders.SweepInterval)
+	holdSweeper := sweeper.New("release expired holds", businessLogic.ReleaseExpiredHolds, config.ConfigData.Holds.SweepInterval)
+	refundSender := sweeper.New("send refunds", businessLogic.SendRefunds, config.ConfigData.Outbox.Interval)
+	reservationPool := reserver.New(businessLogic, reserver.Config{
+		Workers:      config.ConfigData.Reservation.Workers,
+		PollInterval: config.ConfigData.Reservation.PollInterval,
+	})
+
 	var wg sync.WaitGroup
-	wg.Add(2)
+	wg.Add(9)
 
 	go func() {
 		defer wg.Done()
 
-		err := runGRPC(ctx)
+		err := runGRPC(ctx, businessLogic, stockHub)
 		if err != nil {
 			logger.Fatal("run grpc", zap.Error(err))
 		}
@@ -57,16 +119,91 @@ func main() {
 	go func() {
 		defer wg.Done()
 
-		err := runHTTP(ctx)
+		err := runHTTP(ctx, payments.NewWebhook(config.ConfigData.Payments.WebhookSecret, businessLogic))
 		if err != nil {
 			logger.Fatal("run http", zap.Error(err))
 		}
 	}()
 
+	go func() {
+		defer wg.Done()
+
+		relay.Run(ctx)
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		stockRelay.Run(ctx)
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		//Без подписки перестает работать только WatchStocks, поэтому сервис не останавливаем, а перезапускаем подписку
+		runWithRestarts(ctx, "run stock changes subscriber", stockSubscriber.Run)
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		paymentSweeper.Run(ctx)
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		holdSweeper.Run(ctx)
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		refundSender.Run(ctx)
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		reservationPool.Run(ctx)
+	}()
+
 	wg.Wait()
 }
 
-func runGRPC(ctx context.Context) error {
+const (
+	restartBackoff    = time.Second
+	maxRestartBackoff = time.Minute
+)
+
+func runWithRestarts(ctx context.Context, name string, run func(ctx context.Context) error) {
+	backoff := restartBackoff
+	for {
+		started := time.Now()
+		err := run(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		//После долгой успешной работы начинаем отсчет задержки заново
+		if time.Since(started) > maxRestartBackoff {
+			backoff = restartBackoff
+		}
+		if err != nil {
+			logger.Error(ctx, name, zap.Error(err))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+		if backoff > maxRestartBackoff {
+			backoff = maxRestartBackoff
+		}
+	}
+}
+
+func runGRPC(ctx context.Context, businessLogic domain.Domain, stockWatcher loms.StockWatcher) error {
 	lis, err := net.Listen("tcp", config.ConfigData.Ports.Grpc)
 	if err != nil {
 		return fmt.Errorf("failed listen tcp at %v port", config.ConfigData.Ports.Grpc)
@@ -80,18 +217,9 @@ func runGRPC(ctx context.Context) error {
 				grpcValidator.UnaryServerInterceptor(),
 			),
 		),
+		grpc.StreamInterceptor(grpcValidator.StreamServerInterceptor()),
 	)
-	tm, err := transactor.New(config.ConfigData.DBConnectURL)
-	if err != nil {
-		logger.Fatal("init transaction manager:", zap.Error(err))
-	}
-	repo := repository.NewItemsRepo(tm)
-	producer, err := kafka.NewSyncProducer(config.ConfigData.Kafka.Brokers)
-	if err != nil {
-		logger.Fatal("init kafka producer:", zap.Error(err))
-	}
-	ns := sender.NewOrderSender(producer, config.ConfigData.Kafka.Topic)
-	desc.RegisterLOMSV1Server(grpcServer, loms.New(domain.New(repo, tm, ns)))
+	desc.RegisterLOMSV1Server(grpcServer, loms.New(businessLogic, stockWatcher))
 	logger.Info("grps server running on port", zap.String("addr", config.ConfigData.Ports.Grpc))
 
 	go func() {
@@ -107,7 +235,7 @@ func runGRPC(ctx context.Context) error {
 	return nil
 }
 
-func runHTTP(ctx context.Context) error {
+func runHTTP(ctx context.Context, paymentWebhook http.Handler) error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -115,6 +243,7 @@ func runHTTP(ctx context.Context) error {
 	mux := runtime.NewServeMux()
 	r.PathPrefix("/loms").Handler(mux)
 	r.Handle("/metrics", promhttp.Handler())
+	r.Handle("/payments/webhook", paymentWebhook).Methods(http.MethodPost)
 
 	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
 	err := desc.RegisterLOMSV1HandlerFromEndpoint(ctx, mux, config.ConfigData.Ports.Grpc, opts)
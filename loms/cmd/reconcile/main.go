@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"route256/libs/logger"
+	transactor "route256/libs/postgres_transactor"
+	"route256/loms/internal/config"
+	"route256/loms/internal/domain"
+	repository "route256/loms/internal/repository/postgres"
+	"syscall"
+
+	"go.uber.org/zap"
+)
+
+func main() {
+	logger.Init(true)
+	err := config.Init()
+	if err != nil {
+		logger.Fatal("config init", zap.Error(err))
+	}
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	tm, err := transactor.New(config.ConfigData.DBConnectURL)
+	if err != nil {
+		logger.Fatal("init transaction manager:", zap.Error(err))
+	}
+	repo := repository.NewItemsRepo(tm)
+	businessLogic := domain.New(repo, repo, repo, repo, tm, nil, nil, domain.Config{})
+
+	drifts, err := businessLogic.ReconcileStocks(ctx)
+	if err != nil {
+		logger.Fatal("reconcile stocks:", zap.Error(err))
+	}
+	for _, drift := range drifts {
+		logger.Info("stock drift",
+			zap.Int64("warehouse_id", drift.WarehouseID),
+			zap.Uint32("sku", drift.Sku),
+			zap.Int64("count", drift.Count),
+			zap.Int64("ledger_count", drift.LedgerCount),
+			zap.Int64("reserved", drift.Reserved),
+			zap.Int64("ledger_reserved", drift.LedgerReserved),
+			zap.Int64("reserved_items", drift.ReservedItems),
+		)
+	}
+	if len(drifts) > 0 {
+		logger.Info("stocks drifted from ledger", zap.Int("stocks", len(drifts)))
+		cancel()
+		os.Exit(1)
+	}
+	logger.Info("stocks match ledger")
+}
@@ -0,0 +1,17 @@
+package loms
+
+import (
+	"context"
+	desc "route256/loms/pkg/loms/v1"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (i *Implementation) AdjustStock(ctx context.Context, req *desc.AdjustStockRequest) (*emptypb.Empty, error) {
+	err := i.lOMSService.AdjustStock(ctx, req.GetWarehouseID(), req.GetSku(), req.GetDelta(), req.GetReason())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
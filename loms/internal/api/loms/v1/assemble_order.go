@@ -0,0 +1,15 @@
+package loms
+
+import (
+	"context"
+	desc "route256/loms/pkg/loms/v1"
+)
+
+func (i *Implementation) AssembleOrder(ctx context.Context, req *desc.AssembleOrderRequest) (*desc.AssembleOrderResponse, error) {
+	shipments, err := i.lOMSService.AssembleOrder(ctx, req.GetOrderID())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &desc.AssembleOrderResponse{Shipments: ShipmentsToProto(shipments)}, nil
+}
@@ -0,0 +1,27 @@
+package loms
+
+import (
+	"context"
+	desc "route256/loms/pkg/loms/v1"
+)
+
+func (i *Implementation) BatchStocks(ctx context.Context, req *desc.BatchStocksRequest) (*desc.BatchStocksResponse, error) {
+	stocks, err := i.lOMSService.BatchStocks(ctx, req.GetSkus())
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*desc.SkuStocks, 0, len(req.GetSkus()))
+	for _, sku := range req.GetSkus() {
+		res := make([]*desc.Stock, 0, len(stocks[sku]))
+		for _, stock := range stocks[sku] {
+			res = append(res, &desc.Stock{
+				WarehouseID: stock.WarehouseID,
+				Count:       stock.Count,
+				SellerID:    stock.SellerID,
+			})
+		}
+		items = append(items, &desc.SkuStocks{Sku: sku, Stocks: res})
+	}
+
+	return &desc.BatchStocksResponse{Items: items}, nil
+}
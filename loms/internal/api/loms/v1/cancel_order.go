@@ -10,7 +10,7 @@ import (
 func (i *Implementation) CancelOrder(ctx context.Context, req *desc.CancelOrderRequest) (*emptypb.Empty, error) {
 	err := i.lOMSService.CancelOrder(ctx, req.GetOrderID())
 	if err != nil {
-		return nil, err
+		return nil, toStatusError(err)
 	}
 
 	return &emptypb.Empty{}, nil
@@ -0,0 +1,17 @@
+package loms
+
+import (
+	"context"
+	desc "route256/loms/pkg/loms/v1"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (i *Implementation) CompleteReturn(ctx context.Context, req *desc.CompleteReturnRequest) (*emptypb.Empty, error) {
+	err := i.lOMSService.CompleteReturn(ctx, req.GetOrderID(), req.GetWarehouseID())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
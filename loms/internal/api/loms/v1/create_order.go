@@ -7,17 +7,42 @@ import (
 )
 
 func (i *Implementation) CreateOrder(ctx context.Context, req *desc.CreateOrderRequest) (*desc.CreateOrderResponse, error) {
-	items := make([]domain.OrderItem, 0, len(req.GetItems()))
-	for _, item := range req.GetItems() {
-		items = append(items, domain.OrderItem{
-			Sku:   item.GetSku(),
-			Count: uint16(item.GetCount()),
-		})
+	order := &domain.Order{
+		User:                 req.GetUser(),
+		Items:                itemsFromProto(req.GetItems()),
+		IdempotencyKey:       req.GetIdempotencyKey(),
+		PreferredWarehouseID: req.GetPreferredWarehouseID(),
+		AllowBackorder:       req.GetAllowBackorder(),
+	}
+	if req.GetReserveNow() {
+		return i.createAndReserveOrder(ctx, order)
 	}
-	orderID, err := i.lOMSService.CreateOrder(ctx, req.GetUser(), items)
+	orderID, err := i.lOMSService.CreateOrder(ctx, order)
 	if err != nil {
-		return nil, err
+		return nil, toStatusError(err)
 	}
 
 	return &desc.CreateOrderResponse{OrderID: orderID}, nil
 }
+
+func (i *Implementation) createAndReserveOrder(ctx context.Context, order *domain.Order) (*desc.CreateOrderResponse, error) {
+	reservation, err := i.lOMSService.CreateAndReserveOrder(ctx, order)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	shortages := make([]*desc.ItemShortage, 0, len(reservation.Shortages))
+	for _, shortage := range reservation.Shortages {
+		shortages = append(shortages, &desc.ItemShortage{
+			Sku:       shortage.Sku,
+			Requested: uint32(shortage.Requested),
+			Missing:   uint32(shortage.Missing),
+			SellerID:  shortage.SellerID,
+		})
+	}
+
+	return &desc.CreateOrderResponse{
+		OrderID:   reservation.OrderID,
+		Status:    StatusToStatusCode(reservation.Status),
+		Shortages: shortages,
+	}, nil
+}
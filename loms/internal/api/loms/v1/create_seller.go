@@ -0,0 +1,15 @@
+package loms
+
+import (
+	"context"
+	desc "route256/loms/pkg/loms/v1"
+)
+
+func (i *Implementation) CreateSeller(ctx context.Context, req *desc.CreateSellerRequest) (*desc.CreateSellerResponse, error) {
+	sellerID, err := i.lOMSService.CreateSeller(ctx, req.GetName())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &desc.CreateSellerResponse{SellerID: sellerID}, nil
+}
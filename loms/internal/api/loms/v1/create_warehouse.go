@@ -0,0 +1,15 @@
+package loms
+
+import (
+	"context"
+	desc "route256/loms/pkg/loms/v1"
+)
+
+func (i *Implementation) CreateWarehouse(ctx context.Context, req *desc.CreateWarehouseRequest) (*desc.CreateWarehouseResponse, error) {
+	warehouseID, err := i.lOMSService.CreateWarehouse(ctx, req.GetName(), req.GetSellerID())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &desc.CreateWarehouseResponse{WarehouseID: warehouseID}, nil
+}
@@ -0,0 +1,17 @@
+package loms
+
+import (
+	"context"
+	desc "route256/loms/pkg/loms/v1"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (i *Implementation) DeliverShipment(ctx context.Context, req *desc.DeliverShipmentRequest) (*emptypb.Empty, error) {
+	err := i.lOMSService.DeliverShipment(ctx, req.GetShipmentID())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,41 @@
+package loms
+
+import (
+	"route256/loms/internal/domain"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func toStatusError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrIllegalTransition),
+		errors.Is(err, domain.ErrOrderNotEditable),
+		errors.Is(err, domain.ErrCantReserveItem),
+		errors.Is(err, domain.ErrStockBelowReserved),
+		errors.Is(err, domain.ErrPaymentFinished),
+		errors.Is(err, domain.ErrNothingToShip):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrOrderNotFound),
+		errors.Is(err, domain.ErrWarehouseNotFound),
+		errors.Is(err, domain.ErrStockNotFound),
+		errors.Is(err, domain.ErrPaymentNotFound),
+		errors.Is(err, domain.ErrShipmentNotFound),
+		errors.Is(err, domain.ErrSellerNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrWarehouseExists),
+		errors.Is(err, domain.ErrSellerExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, domain.ErrInvalidPageToken),
+		errors.Is(err, domain.ErrZeroStockChange),
+		errors.Is(err, domain.ErrStockChangeOutOfRange),
+		errors.Is(err, domain.ErrEmptyReason),
+		errors.Is(err, domain.ErrInvalidReturn),
+		errors.Is(err, domain.ErrEmptyTrackingNumber),
+		errors.Is(err, domain.ErrItemCountOverflow):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return err
+	}
+}
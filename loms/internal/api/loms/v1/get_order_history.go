@@ -0,0 +1,26 @@
+package loms
+
+import (
+	"context"
+	desc "route256/loms/pkg/loms/v1"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (i *Implementation) GetOrderHistory(ctx context.Context, req *desc.GetOrderHistoryRequest) (*desc.GetOrderHistoryResponse, error) {
+	history, err := i.lOMSService.GetOrderHistory(ctx, req.GetOrderID())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	changes := make([]*desc.StatusChange, 0, len(history))
+	for _, change := range history {
+		changes = append(changes, &desc.StatusChange{
+			From:      StatusToStatusCode(change.From),
+			To:        StatusToStatusCode(change.To),
+			Reason:    change.Reason,
+			CreatedAt: timestamppb.New(change.CreatedAt),
+		})
+	}
+
+	return &desc.GetOrderHistoryResponse{Changes: changes}, nil
+}
@@ -0,0 +1,19 @@
+package loms
+
+import (
+	"context"
+	desc "route256/loms/pkg/loms/v1"
+	"time"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (i *Implementation) HoldStock(ctx context.Context, req *desc.HoldStockRequest) (*emptypb.Empty, error) {
+	ttl := time.Duration(req.GetTtlSeconds()) * time.Second
+	err := i.lOMSService.HoldStock(ctx, req.GetUser(), req.GetSku(), uint16(req.GetCount()), ttl)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
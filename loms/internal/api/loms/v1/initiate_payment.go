@@ -0,0 +1,19 @@
+package loms
+
+import (
+	"context"
+	desc "route256/loms/pkg/loms/v1"
+)
+
+func (i *Implementation) InitiatePayment(ctx context.Context, req *desc.InitiatePaymentRequest) (*desc.InitiatePaymentResponse, error) {
+	payment, err := i.lOMSService.InitiatePayment(ctx, req.GetOrderID())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &desc.InitiatePaymentResponse{
+		PaymentID:       payment.ID,
+		Amount:          payment.Amount,
+		ConfirmationURL: payment.ConfirmationURL,
+	}, nil
+}
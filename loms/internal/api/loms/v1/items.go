@@ -0,0 +1,32 @@
+package loms
+
+import (
+	"route256/loms/internal/domain"
+	desc "route256/loms/pkg/loms/v1"
+)
+
+func ItemsToProto(items []domain.OrderItem) []*desc.Item {
+	result := make([]*desc.Item, 0, len(items))
+	for _, item := range items {
+		result = append(result, &desc.Item{
+			Sku:      item.Sku,
+			Count:    uint32(item.Count),
+			Name:     item.Name,
+			Price:    item.Price,
+			SellerID: item.SellerID,
+		})
+	}
+	return result
+}
+
+func itemsFromProto(items []*desc.Item) []domain.OrderItem {
+	result := make([]domain.OrderItem, 0, len(items))
+	for _, item := range items {
+		result = append(result, domain.OrderItem{
+			Sku:      item.GetSku(),
+			Count:    uint16(item.GetCount()),
+			SellerID: item.GetSellerID(),
+		})
+	}
+	return result
+}
@@ -9,36 +9,75 @@ import (
 func (i *Implementation) ListOrder(ctx context.Context, req *desc.ListOrderRequest) (*desc.ListOrderResponse, error) {
 	order, err := i.lOMSService.ListOrder(ctx, req.GetOrderID())
 	if err != nil {
-		return nil, err
-	}
-	items := make([]*desc.Item, 0, len(order.Items))
-	for _, item := range order.Items {
-		items = append(items, &desc.Item{
-			Sku:   item.Sku,
-			Count: uint32(item.Count),
-		})
+		return nil, toStatusError(err)
 	}
 
 	return &desc.ListOrderResponse{
-		Status: StatusToStatusCode(order.Status),
-		User:   order.User,
-		Items:  items,
+		Status:           StatusToStatusCode(order.Status),
+		User:             order.User,
+		Items:            ItemsToProto(order.Items),
+		FailureReason:    order.FailureReason,
+		TotalPrice:       order.TotalPrice(),
+		ReturnItems:      ItemsToProto(order.ReturnItems),
+		Shipments:        ShipmentsToProto(order.Shipments),
+		BackorderedItems: ItemsToProto(order.BackorderedItems),
 	}, nil
 }
 
-func StatusToStatusCode(status string) desc.OrderStatus {
+func StatusToStatusCode(status domain.OrderStatus) desc.OrderStatus {
 	switch status {
 	case domain.StatusNew:
 		return desc.OrderStatus_New
 	case domain.StatusAwaitingPayment:
 		return desc.OrderStatus_AwaitingPayment
+	case domain.StatusAwaitingStock:
+		return desc.OrderStatus_AwaitingStock
 	case domain.StatusFailed:
 		return desc.OrderStatus_Failed
 	case domain.StatusPayed:
 		return desc.OrderStatus_Payed
 	case domain.StatusCancelled:
 		return desc.OrderStatus_Cancelled
+	case domain.StatusReturnRequested:
+		return desc.OrderStatus_ReturnRequested
+	case domain.StatusReturned:
+		return desc.OrderStatus_Returned
+	case domain.StatusAssembling:
+		return desc.OrderStatus_Assembling
+	case domain.StatusShipped:
+		return desc.OrderStatus_Shipped
+	case domain.StatusDelivered:
+		return desc.OrderStatus_Delivered
 	default:
 		return desc.OrderStatus_Undefined
 	}
 }
+
+func StatusCodeToStatus(code desc.OrderStatus) domain.OrderStatus {
+	switch code {
+	case desc.OrderStatus_New:
+		return domain.StatusNew
+	case desc.OrderStatus_AwaitingPayment:
+		return domain.StatusAwaitingPayment
+	case desc.OrderStatus_AwaitingStock:
+		return domain.StatusAwaitingStock
+	case desc.OrderStatus_Failed:
+		return domain.StatusFailed
+	case desc.OrderStatus_Payed:
+		return domain.StatusPayed
+	case desc.OrderStatus_Cancelled:
+		return domain.StatusCancelled
+	case desc.OrderStatus_ReturnRequested:
+		return domain.StatusReturnRequested
+	case desc.OrderStatus_Returned:
+		return domain.StatusReturned
+	case desc.OrderStatus_Assembling:
+		return domain.StatusAssembling
+	case desc.OrderStatus_Shipped:
+		return domain.StatusShipped
+	case desc.OrderStatus_Delivered:
+		return domain.StatusDelivered
+	default:
+		return ""
+	}
+}
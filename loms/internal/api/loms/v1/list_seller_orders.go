@@ -0,0 +1,37 @@
+package loms
+
+import (
+	"context"
+	"route256/loms/internal/domain"
+	desc "route256/loms/pkg/loms/v1"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (i *Implementation) ListSellerOrders(ctx context.Context, req *desc.ListSellerOrdersRequest) (*desc.ListSellerOrdersResponse, error) {
+	orders, nextPageToken, err := i.lOMSService.ListSellerOrders(ctx, domain.SellerOrdersFilter{
+		SellerID:  req.GetSellerID(),
+		Status:    StatusCodeToStatus(req.GetStatus()),
+		PageToken: req.GetPageToken(),
+		PageSize:  uint64(req.GetPageSize()),
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	result := make([]*desc.SellerOrder, 0, len(orders))
+	for _, order := range orders {
+		result = append(result, &desc.SellerOrder{
+			Id:        order.ID,
+			OrderID:   order.OrderID,
+			SellerID:  order.SellerID,
+			Status:    StatusToStatusCode(order.Status),
+			Items:     ItemsToProto(order.Items),
+			CreatedAt: timestamppb.New(order.CreatedAt),
+		})
+	}
+
+	return &desc.ListSellerOrdersResponse{
+		Orders:        result,
+		NextPageToken: nextPageToken,
+	}, nil
+}
@@ -0,0 +1,44 @@
+package loms
+
+import (
+	"context"
+	"route256/loms/internal/domain"
+	desc "route256/loms/pkg/loms/v1"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (i *Implementation) ListUserOrders(ctx context.Context, req *desc.ListUserOrdersRequest) (*desc.ListUserOrdersResponse, error) {
+	filter := domain.UserOrdersFilter{
+		User:      req.GetUser(),
+		Status:    StatusCodeToStatus(req.GetStatus()),
+		PageToken: req.GetPageToken(),
+		PageSize:  uint64(req.GetPageSize()),
+	}
+	if req.GetCreatedFrom() != nil {
+		filter.CreatedFrom = req.GetCreatedFrom().AsTime()
+	}
+	if req.GetCreatedTo() != nil {
+		filter.CreatedTo = req.GetCreatedTo().AsTime()
+	}
+	orders, nextPageToken, err := i.lOMSService.ListUserOrders(ctx, filter)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	result := make([]*desc.Order, 0, len(orders))
+	for _, order := range orders {
+		result = append(result, &desc.Order{
+			Id:         order.ID,
+			Status:     StatusToStatusCode(order.Status),
+			User:       order.User,
+			Items:      ItemsToProto(order.Items),
+			CreatedAt:  timestamppb.New(order.CreatedAt),
+			TotalPrice: order.TotalPrice(),
+		})
+	}
+
+	return &desc.ListUserOrdersResponse{
+		Orders:        result,
+		NextPageToken: nextPageToken,
+	}, nil
+}
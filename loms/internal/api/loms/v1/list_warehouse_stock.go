@@ -0,0 +1,24 @@
+package loms
+
+import (
+	"context"
+	desc "route256/loms/pkg/loms/v1"
+)
+
+func (i *Implementation) ListWarehouseStock(ctx context.Context, req *desc.ListWarehouseStockRequest) (*desc.ListWarehouseStockResponse, error) {
+	stocks, err := i.lOMSService.ListWarehouseStock(ctx, req.GetWarehouseID())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	result := make([]*desc.WarehouseStock, 0, len(stocks))
+	for _, stock := range stocks {
+		result = append(result, &desc.WarehouseStock{
+			Sku:      stock.Sku,
+			Count:    stock.Count,
+			Reserved: stock.Reserved,
+			Held:     stock.Held,
+		})
+	}
+
+	return &desc.ListWarehouseStockResponse{Stocks: result}, nil
+}
@@ -10,7 +10,7 @@ import (
 func (i *Implementation) OrderPayed(ctx context.Context, req *desc.OrderPayedRequest) (*emptypb.Empty, error) {
 	err := i.lOMSService.OrderPayed(ctx, req.GetOrderID())
 	if err != nil {
-		return nil, err
+		return nil, toStatusError(err)
 	}
 
 	return &emptypb.Empty{}, nil
@@ -0,0 +1,17 @@
+package loms
+
+import (
+	"context"
+	desc "route256/loms/pkg/loms/v1"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (i *Implementation) ReceiveStock(ctx context.Context, req *desc.ReceiveStockRequest) (*emptypb.Empty, error) {
+	err := i.lOMSService.ReceiveStock(ctx, req.GetWarehouseID(), req.GetSku(), req.GetCount())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,17 @@
+package loms
+
+import (
+	"context"
+	desc "route256/loms/pkg/loms/v1"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (i *Implementation) ReleaseHold(ctx context.Context, req *desc.ReleaseHoldRequest) (*emptypb.Empty, error) {
+	err := i.lOMSService.ReleaseHold(ctx, req.GetUser(), req.GetSku())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -5,15 +5,21 @@ import (
 	desc "route256/loms/pkg/loms/v1"
 )
 
+type StockWatcher interface {
+	Subscribe(skus []uint32) (<-chan domain.StockChange, func())
+}
+
 type Implementation struct {
 	desc.UnimplementedLOMSV1Server
 
-	lOMSService domain.Domain
+	lOMSService  domain.Domain
+	stockWatcher StockWatcher
 }
 
-func New(lOMSService domain.Domain) *Implementation {
+func New(lOMSService domain.Domain, stockWatcher StockWatcher) *Implementation {
 	return &Implementation{
 		desc.UnimplementedLOMSV1Server{},
 		lOMSService,
+		stockWatcher,
 	}
 }
@@ -0,0 +1,17 @@
+package loms
+
+import (
+	"context"
+	desc "route256/loms/pkg/loms/v1"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (i *Implementation) ShipShipment(ctx context.Context, req *desc.ShipShipmentRequest) (*emptypb.Empty, error) {
+	err := i.lOMSService.ShipShipment(ctx, req.GetShipmentID(), req.GetTrackingNumber())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
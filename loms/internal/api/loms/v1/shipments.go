@@ -0,0 +1,34 @@
+package loms
+
+import (
+	"route256/loms/internal/domain"
+	desc "route256/loms/pkg/loms/v1"
+)
+
+func ShipmentsToProto(shipments []domain.Shipment) []*desc.Shipment {
+	result := make([]*desc.Shipment, 0, len(shipments))
+	for _, shipment := range shipments {
+		result = append(result, &desc.Shipment{
+			Id:             shipment.ID,
+			WarehouseID:    shipment.WarehouseID,
+			Status:         shipmentStatusToProto(shipment.Status),
+			TrackingNumber: shipment.TrackingNumber,
+			Items:          ItemsToProto(shipment.Items),
+			SellerID:       shipment.SellerID,
+		})
+	}
+	return result
+}
+
+func shipmentStatusToProto(status domain.ShipmentStatus) desc.ShipmentStatus {
+	switch status {
+	case domain.ShipmentAssembling:
+		return desc.ShipmentStatus_ShipmentAssembling
+	case domain.ShipmentShipped:
+		return desc.ShipmentStatus_ShipmentShipped
+	case domain.ShipmentDelivered:
+		return desc.ShipmentStatus_ShipmentDelivered
+	default:
+		return desc.ShipmentStatus_ShipmentUndefined
+	}
+}
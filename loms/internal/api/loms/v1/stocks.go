@@ -6,7 +6,7 @@ import (
 )
 
 func (i *Implementation) Stocks(ctx context.Context, req *desc.StocksRequest) (*desc.StocksResponse, error) {
-	stocks, err := i.lOMSService.Stocks(ctx, req.GetSku())
+	stocks, err := i.lOMSService.Stocks(ctx, req.GetSku(), req.GetSellerID())
 	if err != nil {
 		return nil, err
 	}
@@ -15,6 +15,7 @@ func (i *Implementation) Stocks(ctx context.Context, req *desc.StocksRequest) (*
 		res = append(res, &desc.Stock{
 			WarehouseID: stock.WarehouseID,
 			Count:       stock.Count,
+			SellerID:    stock.SellerID,
 		})
 	}
 
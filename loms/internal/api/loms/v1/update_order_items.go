@@ -0,0 +1,17 @@
+package loms
+
+import (
+	"context"
+	desc "route256/loms/pkg/loms/v1"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (i *Implementation) UpdateOrderItems(ctx context.Context, req *desc.UpdateOrderItemsRequest) (*emptypb.Empty, error) {
+	err := i.lOMSService.UpdateOrderItems(ctx, req.GetOrderID(), itemsFromProto(req.GetItems()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
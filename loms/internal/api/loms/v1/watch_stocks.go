@@ -0,0 +1,31 @@
+package loms
+
+import (
+	desc "route256/loms/pkg/loms/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (i *Implementation) WatchStocks(req *desc.WatchStocksRequest, stream desc.LOMSV1_WatchStocksServer) error {
+	changes, unsubscribe := i.stockWatcher.Subscribe(req.GetSkus())
+	defer unsubscribe()
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case change, ok := <-changes:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "stock changes are read too slowly, resubscribe")
+			}
+			err := stream.Send(&desc.StockChange{
+				WarehouseID: change.WarehouseID,
+				Sku:         change.Sku,
+				Delta:       change.Delta,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package productservice
+
+import (
+	"context"
+	"route256/loms/internal/domain"
+	product "route256/loms/pkg/products"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+)
+
+var _ domain.ProductService = (*Client)(nil)
+
+type Client struct {
+	token string
+	c     product.ProductServiceClient
+}
+
+func New(token string, conn *grpc.ClientConn) *Client {
+	c := product.NewProductServiceClient(conn)
+	return &Client{
+		token: token,
+		c:     c,
+	}
+}
+
+func (c *Client) GetProduct(ctx context.Context, sku uint32) (domain.Product, error) {
+	request := &product.GetProductRequest{
+		Token: c.token,
+		Sku:   sku,
+	}
+	response, err := c.c.GetProduct(ctx, request)
+	if err != nil {
+		return domain.Product{}, errors.Wrap(err, "client request")
+	}
+	return domain.Product{
+		Name:  response.GetName(),
+		Price: response.GetPrice(),
+	}, nil
+}
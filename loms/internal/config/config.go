@@ -2,6 +2,7 @@ package config
 
 import (
 	"os"
+	"time"
 
 	"github.com/pkg/errors"
 	yaml "gopkg.in/yaml.v3"
@@ -14,12 +15,44 @@ type ConfigStruct struct {
 		Grpc string `yaml:"grpc"`
 	} `yaml:"ports"`
 	DBConnectURL string `yaml:"db_connect_url"`
-	Kafka        struct {
-		Brokers []string `yaml:"brokers"`
-		Topic   string   `yaml:"topic"`
+	Services     struct {
+		Products string `yaml:"products"`
+	} `yaml:"services"`
+	Kafka struct {
+		Brokers    []string `yaml:"brokers"`
+		Topic      string   `yaml:"topic"`
+		StockTopic string   `yaml:"stock_topic"`
 	} `yaml:"kafka"`
+	Orders struct {
+		PaymentTimeout   time.Duration `yaml:"payment_timeout"`
+		BackorderTimeout time.Duration `yaml:"backorder_timeout"`
+		SweepInterval    time.Duration `yaml:"sweep_interval"`
+	} `yaml:"orders"`
+	Holds struct {
+		SweepInterval time.Duration `yaml:"sweep_interval"`
+	} `yaml:"holds"`
+	Reservation struct {
+		Workers      uint16        `yaml:"workers"`
+		PollInterval time.Duration `yaml:"poll_interval"`
+		Retries      uint8         `yaml:"retries"`
+		Strategy     string        `yaml:"strategy"`
+	} `yaml:"reservation"`
+	Payments struct {
+		WebhookSecret string `yaml:"webhook_secret"`
+		Fake          struct {
+			WebhookURL   string        `yaml:"webhook_url"`
+			ConfirmDelay time.Duration `yaml:"confirm_delay"`
+		} `yaml:"fake"`
+	} `yaml:"payments"`
+	Outbox struct {
+		Interval  time.Duration `yaml:"interval"`
+		BatchSize uint64        `yaml:"batch_size"`
+		Retries   uint8         `yaml:"retries"`
+	} `yaml:"outbox"`
 }
 
+const defaultStockTopic = "stock-changes"
+
 var ConfigData ConfigStruct
 
 func Init() error {
@@ -32,6 +65,9 @@ func Init() error {
 	if err != nil {
 		return errors.WithMessage(err, "parsing yaml")
 	}
+	if ConfigData.Kafka.StockTopic == "" {
+		ConfigData.Kafka.StockTopic = defaultStockTopic
+	}
 
 	return nil
 }
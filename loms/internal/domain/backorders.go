@@ -0,0 +1,170 @@
+package domain
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type Backorder struct {
+	OrderID  int64
+	Sku      uint32
+	SellerID int64
+	Count    uint16
+}
+
+func (d *domain) backorderOrder(ctxTX context.Context, order *Order, stocks map[uint32][]Stock) ([]ItemShortage, error) {
+	shortages := itemShortages(order, stocks)
+	available := *order
+	available.Items = availableItems(order.Items, shortages)
+	reserveFrom, err := d.config.ReservationStrategy.Plan(&available, stocks)
+	if err != nil {
+		return nil, err
+	}
+	err = d.reserveHeldItems(ctxTX, order, reserveFrom)
+	if err != nil {
+		return nil, err
+	}
+	err = d.OrdersRepository.CreateShortages(ctxTX, order.ID, shortages)
+	if err != nil {
+		return nil, errors.Wrap(err, "create shortages")
+	}
+	backorders := make([]OrderItem, 0, len(shortages))
+	for _, shortage := range shortages {
+		backorders = append(backorders, OrderItem{Sku: shortage.Sku, SellerID: shortage.SellerID, Count: uint16(shortage.Missing)})
+	}
+	err = d.OrdersRepository.CreateBackorders(ctxTX, order.ID, backorders)
+	if err != nil {
+		return nil, errors.Wrap(err, "create backorders")
+	}
+	//Заказ, не дождавшийся товара, отменяется вместе с резервом
+	err = d.OrdersRepository.SetBackorderDeadline(ctxTX, order.ID, time.Now().Add(d.config.BackorderTimeout))
+	if err != nil {
+		return nil, errors.Wrap(err, "set backorder deadline")
+	}
+	err = d.changeOrderStatus(ctxTX, order, StatusAwaitingStock, ReasonBackordered)
+	if err != nil {
+		return nil, err
+	}
+	return shortages, d.createSellerOrders(ctxTX, order)
+}
+
+func availableItems(items []OrderItem, shortages []ItemShortage) []OrderItem {
+	missing := make(map[offer]uint64, len(shortages))
+	for _, shortage := range shortages {
+		missing[offer{sku: shortage.Sku, sellerID: shortage.SellerID}] = shortage.Missing
+	}
+	result := make([]OrderItem, 0, len(items))
+	for _, item := range items {
+		cut := missing[itemOffer(item)]
+		if cut > uint64(item.Count) {
+			cut = uint64(item.Count)
+		}
+		missing[itemOffer(item)] -= cut
+		item.Count -= uint16(cut)
+		if item.Count > 0 {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+func (d *domain) allocateBackorders(ctxTX context.Context, warehouseID int64, sku uint32) error {
+	stocks, err := d.OrdersRepository.BatchStocks(ctxTX, []uint32{sku})
+	if err != nil {
+		return errors.Wrap(err, "get stocks")
+	}
+	var stock Stock
+	for _, s := range stocks[sku] {
+		if s.WarehouseID == warehouseID {
+			stock = s
+		}
+	}
+	if stock.Count == 0 {
+		return nil
+	}
+	backorders, err := d.OrdersRepository.ListSkuBackorders(ctxTX, sku, stock.SellerID)
+	if err != nil {
+		return errors.Wrap(err, "list backorders")
+	}
+	available := stock.Count
+	for _, backorder := range backorders {
+		if available == 0 {
+			break
+		}
+		count := uint64(backorder.Count)
+		if count > available {
+			count = available
+		}
+		//Количество в резерве ограничено размером позиции заказа
+		if count > math.MaxUint16 {
+			count = math.MaxUint16
+		}
+		err = d.OrdersRepository.ReserveStock(ctxTX, backorder.OrderID, ReservedItem{
+			OrderItem:   OrderItem{Sku: sku, Count: uint16(count), SellerID: stock.SellerID},
+			WarehouseID: warehouseID,
+		})
+		if err != nil {
+			return errors.Wrap(err, "reserve stock")
+		}
+		available -= count
+		backorder.Count -= uint16(count)
+		err = d.OrdersRepository.UpdateBackorder(ctxTX, backorder)
+		if err != nil {
+			return errors.Wrap(err, "update backorder")
+		}
+		if backorder.Count > 0 {
+			continue
+		}
+		err = d.completeBackorderedOrder(ctxTX, backorder.OrderID)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *domain) allocateReleased(ctxTX context.Context, items []ReservedItem) error {
+	type stockKey struct {
+		warehouseID int64
+		sku         uint32
+	}
+	seen := make(map[stockKey]struct{}, len(items))
+	for _, item := range items {
+		key := stockKey{warehouseID: item.WarehouseID, sku: item.Sku}
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		err := d.allocateBackorders(ctxTX, item.WarehouseID, item.Sku)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *domain) completeBackorderedOrder(ctxTX context.Context, orderID int64) error {
+	backorders, err := d.OrdersRepository.GetBackorders(ctxTX, orderID)
+	if err != nil {
+		return errors.Wrap(err, "get backorders")
+	}
+	if len(backorders) > 0 {
+		return nil
+	}
+	order, err := d.OrdersRepository.GetOrder(ctxTX, orderID)
+	if err != nil {
+		return errors.Wrap(err, "get order")
+	}
+	err = d.OrdersRepository.SetPaymentDeadline(ctxTX, order.ID, time.Now().Add(d.config.PaymentTimeout))
+	if err != nil {
+		return errors.Wrap(err, "set payment deadline")
+	}
+	err = d.changeOrderStatus(ctxTX, order, StatusAwaitingPayment, ReasonBackorderFilled)
+	if err != nil {
+		return err
+	}
+	return d.setSellerOrdersStatus(ctxTX, order.ID, StatusAwaitingPayment)
+}
@@ -6,36 +6,104 @@ import (
 	"github.com/pkg/errors"
 )
 
-var errWrongStatus = errors.New("wrong status")
-
 func (d *domain) CancelOrder(ctx context.Context, orderID int64) error {
-	var order *Order
-	var err error
-	err = d.TransactionManager.RunTransaction(ctx, isoLevelSerializable, func(ctxTX context.Context) error {
-		order, err = d.OrdersRepository.GetOrder(ctxTX, orderID)
+	err := d.TransactionManager.RunTransaction(ctx, isoLevelSerializable, func(ctxTX context.Context) error {
+		order, err := d.OrdersRepository.GetOrder(ctxTX, orderID)
 		if err != nil {
 			return errors.Wrap(err, "get order")
 		}
-		if order.Status != StatusAwaitingPayment {
-			return errWrongStatus
+		if order.Status == StatusPayed {
+			return d.cancelPaidOrder(ctxTX, order, ReasonCancelledByUser)
 		}
-		err = d.OrdersRepository.UpdateOrderStatus(ctxTX, orderID, StatusCancelled, order.Status)
+		return d.cancelUnpaidOrder(ctxTX, order, ReasonCancelledByUser)
+	})
+	if err != nil {
+		return errors.Wrap(err, "cancel order")
+	}
+	return nil
+}
+
+func (d *domain) cancelExpiredOrder(ctx context.Context, orderID int64, status OrderStatus, reason string) error {
+	err := d.TransactionManager.RunTransaction(ctx, isoLevelSerializable, func(ctxTX context.Context) error {
+		order, err := d.OrdersRepository.GetOrder(ctxTX, orderID)
 		if err != nil {
-			return errors.Wrap(err, "set order status")
+			return errors.Wrap(err, "get order")
 		}
-		order.Status = StatusCancelled
-		err = d.OrdersRepository.UnReserveItems(ctxTX, orderID)
-		if err != nil {
-			return errors.Wrap(err, "set items sold")
+		//Заказ мог сменить статус и получить новый срок, пока мы до него дошли
+		if order.Status != status {
+			return nil
 		}
-		return nil
+		return d.cancelUnpaidOrder(ctxTX, order, reason)
 	})
 	if err != nil {
 		return errors.Wrap(err, "cancel order")
 	}
-	err = d.NotificationsSender.SendOrder(order)
+	return nil
+}
+
+func (d *domain) cancelUnpaidOrder(ctxTX context.Context, order *Order, reason string) error {
+	//Оплаченный заказ отменяется только с возвратом денег
+	if order.Status == StatusPayed {
+		return &TransitionError{From: order.Status, To: StatusCancelled}
+	}
+	backordered := order.Status == StatusAwaitingStock
+	err := d.changeOrderStatus(ctxTX, order, StatusCancelled, reason)
 	if err != nil {
-		return errors.Wrap(err, "send order")
+		return err
 	}
-	return nil
+	err = d.setSellerOrdersStatus(ctxTX, order.ID, StatusCancelled)
+	if err != nil {
+		return err
+	}
+	released, err := d.OrdersRepository.UnReserveItems(ctxTX, order.ID)
+	if err != nil {
+		return errors.Wrap(err, "set items sold")
+	}
+	if backordered {
+		err = d.OrdersRepository.DeleteBackorders(ctxTX, order.ID)
+		if err != nil {
+			return errors.Wrap(err, "delete backorders")
+		}
+	}
+	return d.allocateReleased(ctxTX, released)
+}
+
+func (d *domain) cancelPaidOrder(ctxTX context.Context, order *Order, reason string) error {
+	err := d.changeOrderStatus(ctxTX, order, StatusCancelled, reason)
+	if err != nil {
+		return err
+	}
+	err = d.setSellerOrdersStatus(ctxTX, order.ID, StatusCancelled)
+	if err != nil {
+		return err
+	}
+	soldItems, err := d.WarehousesRepository.SoldItems(ctxTX, order.ID)
+	if err != nil {
+		return errors.Wrap(err, "get sold items")
+	}
+	for _, item := range soldItems {
+		err = d.WarehousesRepository.ChangeStock(ctxTX, StockMovement{
+			WarehouseID: item.WarehouseID,
+			Sku:         item.Sku,
+			Kind:        MovementReceive,
+			Delta:       int64(item.Count),
+			Reason:      reason,
+			OrderID:     order.ID,
+		})
+		if err != nil {
+			return errors.Wrap(err, "return sold items")
+		}
+	}
+	err = d.allocateReleased(ctxTX, soldItems)
+	if err != nil {
+		return err
+	}
+	payment, err := d.PaymentsRepository.GetActivePayment(ctxTX, order.ID)
+	if errors.Is(err, ErrPaymentNotFound) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "get active payment")
+	}
+	return d.refundPayment(ctxTX, payment, payment.Amount)
 }
@@ -6,6 +6,8 @@ import (
 	txMock "route256/libs/postgres_transactor/mocks"
 	"testing"
 
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 )
@@ -28,9 +30,13 @@ func TestCancelOrder(t *testing.T) {
 		getOrderErr  = errors.New("get error")
 		updateErr    = errors.New("update error")
 		unreserveErr = errors.New("unreserve error")
+		notifyErr    = errors.New("notification error")
 
-		orderID = gofakeit.Int64()
-		order   = &Order{
+		orderID      = gofakeit.Int64()
+		otherOrderID = orderID + 1
+		sku          = gofakeit.Uint32()
+		warehouseID  = gofakeit.Int64()
+		order        = &Order{
 			ID:     orderID,
 			Status: StatusAwaitingPayment,
 			User:   gofakeit.Int64(),
@@ -61,9 +67,14 @@ func TestCancelOrder(t *testing.T) {
 			err: nil,
 			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
 				mock := NewOrdersRepositoryMock(t)
-				mock.GetOrderMock.Expect(ctxTx, orderID).Return(order, nil)
+				mock.GetOrderMock.Expect(ctxTx, orderID).Return(cloneOrder(order), nil)
 				mock.UpdateOrderStatusMock.Expect(ctxTx, orderID, StatusCancelled, order.Status).Return(nil)
-				mock.UnReserveItemsMock.Expect(ctxTx, orderID).Return(nil)
+				mock.AddStatusChangeMock.Expect(ctxTx, StatusChange{OrderID: orderID, From: StatusAwaitingPayment, To: StatusCancelled, Reason: ReasonCancelledByUser}).Return(nil)
+				mock.UpdateSellerOrdersStatusMock.Expect(ctxTx, orderID, nil, StatusCancelled).Return(nil)
+				mock.UnReserveItemsMock.Expect(ctxTx, orderID).Return(nil, nil)
+				mock.CreateOrderNotificationMock.Set(func(ctx context.Context, order *Order) (err error) {
+					return nil
+				})
 				return mock
 			},
 			tmMock: func(mc *minimock.Controller) TransactionManager {
@@ -75,6 +86,68 @@ func TestCancelOrder(t *testing.T) {
 				return mock
 			},
 		},
+		{
+			name: "positive case - backordered order",
+			args: args{
+				ctx:     ctx,
+				orderID: orderID,
+			},
+			err: nil,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetOrderMock.Expect(ctxTx, orderID).Return(&Order{ID: orderID, Status: StatusAwaitingStock}, nil)
+				mock.UpdateOrderStatusMock.Expect(ctxTx, orderID, StatusCancelled, StatusAwaitingStock).Return(nil)
+				mock.AddStatusChangeMock.Expect(ctxTx, StatusChange{OrderID: orderID, From: StatusAwaitingStock, To: StatusCancelled, Reason: ReasonCancelledByUser}).Return(nil)
+				mock.UpdateSellerOrdersStatusMock.Expect(ctxTx, orderID, nil, StatusCancelled).Return(nil)
+				mock.UnReserveItemsMock.Expect(ctxTx, orderID).Return(nil, nil)
+				mock.DeleteBackordersMock.Expect(ctxTx, orderID).Return(nil)
+				mock.CreateOrderNotificationMock.Return(nil)
+				return mock
+			},
+			tmMock: func(mc *minimock.Controller) TransactionManager {
+				mock := NewTransactionManagerMock(t)
+				mock.RunTransactionMock.Set(func(ctx context.Context, isoLevel string, f func(ctxTX context.Context) error) error {
+					return f(ctxTx)
+				})
+				return mock
+			},
+		},
+		{
+			name: "positive case - released items go to backorders",
+			args: args{
+				ctx:     ctx,
+				orderID: orderID,
+			},
+			err: nil,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetOrderMock.Expect(ctxTx, orderID).Return(cloneOrder(order), nil)
+				mock.UpdateOrderStatusMock.Expect(ctxTx, orderID, StatusCancelled, order.Status).Return(nil)
+				mock.AddStatusChangeMock.Expect(ctxTx, StatusChange{OrderID: orderID, From: StatusAwaitingPayment, To: StatusCancelled, Reason: ReasonCancelledByUser}).Return(nil)
+				mock.UpdateSellerOrdersStatusMock.Expect(ctxTx, orderID, nil, StatusCancelled).Return(nil)
+				mock.UnReserveItemsMock.Expect(ctxTx, orderID).Return([]ReservedItem{
+					{OrderItem: OrderItem{Sku: sku, Count: 2}, WarehouseID: warehouseID},
+				}, nil)
+				mock.BatchStocksMock.Expect(ctxTx, []uint32{sku}).Return(map[uint32][]Stock{
+					sku: {{WarehouseID: warehouseID, Count: 2}},
+				}, nil)
+				mock.ListSkuBackordersMock.Expect(ctxTx, sku, 0).Return([]Backorder{{OrderID: otherOrderID, Sku: sku, Count: 3}}, nil)
+				mock.ReserveStockMock.Expect(ctxTx, otherOrderID, ReservedItem{
+					OrderItem:   OrderItem{Sku: sku, Count: 2},
+					WarehouseID: warehouseID,
+				}).Return(nil)
+				mock.UpdateBackorderMock.Expect(ctxTx, Backorder{OrderID: otherOrderID, Sku: sku, Count: 1}).Return(nil)
+				mock.CreateOrderNotificationMock.Return(nil)
+				return mock
+			},
+			tmMock: func(mc *minimock.Controller) TransactionManager {
+				mock := NewTransactionManagerMock(t)
+				mock.RunTransactionMock.Set(func(ctx context.Context, isoLevel string, f func(ctxTX context.Context) error) error {
+					return f(ctxTx)
+				})
+				return mock
+			},
+		},
 		{
 			name: "negative case - get order",
 			args: args{
@@ -105,7 +178,7 @@ func TestCancelOrder(t *testing.T) {
 			err: updateErr,
 			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
 				mock := NewOrdersRepositoryMock(t)
-				mock.GetOrderMock.Expect(ctxTx, orderID).Return(order, nil)
+				mock.GetOrderMock.Expect(ctxTx, orderID).Return(cloneOrder(order), nil)
 				mock.UpdateOrderStatusMock.Expect(ctxTx, orderID, StatusCancelled, order.Status).Return(updateErr)
 				return mock
 			},
@@ -127,9 +200,40 @@ func TestCancelOrder(t *testing.T) {
 			err: unreserveErr,
 			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
 				mock := NewOrdersRepositoryMock(t)
-				mock.GetOrderMock.Expect(ctxTx, orderID).Return(order, nil)
+				mock.GetOrderMock.Expect(ctxTx, orderID).Return(cloneOrder(order), nil)
 				mock.UpdateOrderStatusMock.Expect(ctxTx, orderID, StatusCancelled, order.Status).Return(nil)
-				mock.UnReserveItemsMock.Expect(ctxTx, orderID).Return(unreserveErr)
+				mock.AddStatusChangeMock.Expect(ctxTx, StatusChange{OrderID: orderID, From: StatusAwaitingPayment, To: StatusCancelled, Reason: ReasonCancelledByUser}).Return(nil)
+				mock.CreateOrderNotificationMock.Set(func(ctx context.Context, order *Order) (err error) {
+					return nil
+				})
+				mock.UpdateSellerOrdersStatusMock.Expect(ctxTx, orderID, nil, StatusCancelled).Return(nil)
+				mock.UnReserveItemsMock.Expect(ctxTx, orderID).Return(nil, unreserveErr)
+				return mock
+			},
+			tmMock: func(mc *minimock.Controller) TransactionManager {
+				mock := NewTransactionManagerMock(t)
+				mock.RunTransactionMock.Set(func(ctx context.Context, isoLevel string, f func(ctxTX context.Context) error) error {
+					err := f(ctxTx)
+					return err
+				})
+				return mock
+			},
+		},
+		{
+			name: "negative case - create notification",
+			args: args{
+				ctx:     ctx,
+				orderID: orderID,
+			},
+			err: notifyErr,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetOrderMock.Expect(ctxTx, orderID).Return(cloneOrder(order), nil)
+				mock.UpdateOrderStatusMock.Expect(ctxTx, orderID, StatusCancelled, order.Status).Return(nil)
+				mock.AddStatusChangeMock.Expect(ctxTx, StatusChange{OrderID: orderID, From: StatusAwaitingPayment, To: StatusCancelled, Reason: ReasonCancelledByUser}).Return(nil)
+				mock.CreateOrderNotificationMock.Set(func(ctx context.Context, order *Order) (err error) {
+					return notifyErr
+				})
 				return mock
 			},
 			tmMock: func(mc *minimock.Controller) TransactionManager {
@@ -147,10 +251,10 @@ func TestCancelOrder(t *testing.T) {
 				ctx:     ctx,
 				orderID: orderID,
 			},
-			err: errWrongStatus,
+			err: ErrIllegalTransition,
 			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
 				mock := NewOrdersRepositoryMock(t)
-				mock.GetOrderMock.Expect(ctxTx, orderID).Return(wrongOrder, nil)
+				mock.GetOrderMock.Expect(ctxTx, orderID).Return(cloneOrder(wrongOrder), nil)
 				return mock
 			},
 			tmMock: func(mc *minimock.Controller) TransactionManager {
@@ -182,3 +286,129 @@ func TestCancelOrder(t *testing.T) {
 	}
 
 }
+
+func cloneOrder(order *Order) *Order {
+	clone := *order
+	return &clone
+}
+
+func TestCancelPaidOrder(t *testing.T) {
+	type paymentsMockFunc func(mc *minimock.Controller) PaymentsRepository
+	type providerMockFunc func(mc *minimock.Controller) PaymentProvider
+
+	var (
+		mc    = minimock.NewController(t)
+		tx    = txMock.NewTxMock(t)
+		ctx   = context.Background()
+		ctxTx = context.WithValue(ctx, transactor.TxKey("tx"), tx)
+
+		refundErr = errors.New("refund error")
+
+		orderID = gofakeit.Int64()
+		order   = &Order{
+			ID:     orderID,
+			Status: StatusPayed,
+		}
+		soldItems = []ReservedItem{
+			{OrderItem: OrderItem{Sku: 1, Count: 2}, WarehouseID: 1},
+			{OrderItem: OrderItem{Sku: 1, Count: 1}, WarehouseID: 2},
+		}
+		payment = &Payment{ID: gofakeit.UUID(), OrderID: orderID, Amount: 300, Status: PaymentSucceeded}
+	)
+	t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name         string
+		err          error
+		paymentsMock paymentsMockFunc
+		providerMock providerMockFunc
+	}{
+		{
+			name: "positive case",
+			err:  nil,
+			paymentsMock: func(mc *minimock.Controller) PaymentsRepository {
+				mock := NewPaymentsRepositoryMock(t)
+				mock.GetActivePaymentMock.Expect(ctxTx, orderID).Return(payment, nil)
+				mock.CreateRefundMock.Expect(ctxTx, payment.ID, payment.Amount).Return(nil)
+				mock.UpdatePaymentStatusMock.Expect(ctxTx, payment.ID, PaymentRefunded, PaymentSucceeded).Return(nil)
+				return mock
+			},
+			//Провайдер вызывается после коммита из SendRefunds
+			providerMock: func(mc *minimock.Controller) PaymentProvider {
+				return NewPaymentProviderMock(t)
+			},
+		},
+		{
+			name: "positive case - paid without payment",
+			err:  nil,
+			paymentsMock: func(mc *minimock.Controller) PaymentsRepository {
+				mock := NewPaymentsRepositoryMock(t)
+				mock.GetActivePaymentMock.Expect(ctxTx, orderID).Return(nil, ErrPaymentNotFound)
+				return mock
+			},
+			providerMock: func(mc *minimock.Controller) PaymentProvider {
+				return NewPaymentProviderMock(t)
+			},
+		},
+		{
+			name: "negative case - create refund",
+			err:  refundErr,
+			paymentsMock: func(mc *minimock.Controller) PaymentsRepository {
+				mock := NewPaymentsRepositoryMock(t)
+				mock.GetActivePaymentMock.Expect(ctxTx, orderID).Return(payment, nil)
+				mock.CreateRefundMock.Return(refundErr)
+				return mock
+			},
+			providerMock: func(mc *minimock.Controller) PaymentProvider {
+				return NewPaymentProviderMock(t)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := NewOrdersRepositoryMock(t)
+			repo.GetOrderMock.Expect(ctxTx, orderID).Return(cloneOrder(order), nil)
+			repo.UpdateOrderStatusMock.Expect(ctxTx, orderID, StatusCancelled, StatusPayed).Return(nil)
+			repo.AddStatusChangeMock.Expect(ctxTx, StatusChange{OrderID: orderID, From: StatusPayed, To: StatusCancelled, Reason: ReasonCancelledByUser}).Return(nil)
+			repo.CreateOrderNotificationMock.Return(nil)
+			repo.UpdateSellerOrdersStatusMock.Expect(ctxTx, orderID, nil, StatusCancelled).Return(nil)
+			//Возвращенный товар распределяется по предзаказам
+			repo.BatchStocksMock.Expect(ctxTx, []uint32{1}).Return(map[uint32][]Stock{
+				1: {{WarehouseID: 1, Count: 2}, {WarehouseID: 2, Count: 1}},
+			}, nil)
+			repo.ListSkuBackordersMock.Expect(ctxTx, 1, 0).Return(nil, nil)
+			warehouses := NewWarehousesRepositoryMock(t)
+			warehouses.SoldItemsMock.Expect(ctxTx, orderID).Return(soldItems, nil)
+			for _, item := range soldItems {
+				warehouses.ChangeStockMock.When(ctxTx, StockMovement{
+					WarehouseID: item.WarehouseID,
+					Sku:         item.Sku,
+					Kind:        MovementReceive,
+					Delta:       int64(item.Count),
+					Reason:      ReasonCancelledByUser,
+					OrderID:     orderID,
+				}).Then(nil)
+			}
+			tm := NewTransactionManagerMock(t)
+			tm.RunTransactionMock.Set(func(ctx context.Context, isoLevel string, f func(ctxTX context.Context) error) error {
+				return f(ctxTx)
+			})
+			api := NewMock(
+				repo,
+				warehouses,
+				tt.paymentsMock(mc),
+				tt.providerMock(mc),
+				tm,
+			)
+			err := api.CancelOrder(ctx, orderID)
+			if tt.err != nil {
+				require.ErrorContains(t, err, tt.err.Error())
+			} else {
+				require.Equal(t, tt.err, err)
+			}
+		})
+	}
+}
@@ -2,114 +2,134 @@ package domain
 
 import (
 	"context"
-	"route256/libs/logger"
+	transactor "route256/libs/postgres_transactor"
 	"time"
 
 	"github.com/pkg/errors"
-	"go.uber.org/zap"
 )
 
-var (
-	ErrCantReserveItem = errors.New("can not reserve item")
-)
+var ErrDuplicateIdempotencyKey = errors.New("duplicate idempotency key")
 
-func (d *domain) CreateOrder(ctx context.Context, user int64, items []OrderItem) (int64, error) {
-	order := &Order{Status: StatusNew, User: user, Items: items}
-	err := d.TransactionManager.RunTransaction(ctx, isoLevelRepeatableRead, func(ctxTX context.Context) error {
+type OrderReservation struct {
+	OrderID int64
+	Status  OrderStatus
+	//Заполняется только для заказа, не зарезервированного полностью из-за нехватки товара
+	Shortages []ItemShortage
+}
+
+func (d *domain) CreateOrder(ctx context.Context, order *Order) (int64, error) {
+	if order.IdempotencyKey != "" {
+		orderID, err := d.OrdersRepository.GetOrderIDByIdempotencyKey(ctx, order.User, order.IdempotencyKey)
+		if err == nil {
+			return orderID, nil
+		}
+		if !errors.Is(err, ErrOrderNotFound) {
+			return 0, errors.Wrap(err, "get order by idempotency key")
+		}
+	}
+	err := d.setSnapshots(ctx, order.Items, nil)
+	if err != nil {
+		return 0, err
+	}
+	order.Status = StatusNew
+	err = d.TransactionManager.RunTransaction(ctx, isoLevelRepeatableRead, func(ctxTX context.Context) error {
 		orderID, err := d.OrdersRepository.CreateOrder(ctxTX, order)
 		if err != nil {
 			return errors.Wrap(err, "create order")
 		}
 		order.ID = orderID
+		err = d.OrdersRepository.CreateOrderNotification(ctxTX, order)
+		if err != nil {
+			return errors.Wrap(err, "create order notification")
+		}
 		return nil
 	})
+	//Параллельный запрос с тем же ключом успел создать заказ раньше
+	if errors.Is(err, ErrDuplicateIdempotencyKey) {
+		orderID, err := d.OrdersRepository.GetOrderIDByIdempotencyKey(ctx, order.User, order.IdempotencyKey)
+		if err != nil {
+			return 0, errors.Wrap(err, "get order by idempotency key")
+		}
+		return orderID, nil
+	}
 	if err != nil {
 		return 0, err
 	}
-	err = d.NotificationsSender.SendOrder(order)
+	return order.ID, nil
+}
+
+func (d *domain) CreateAndReserveOrder(ctx context.Context, order *Order) (*OrderReservation, error) {
+	if order.IdempotencyKey != "" {
+		reservation, err := d.orderReservationByKey(ctx, order)
+		if err == nil {
+			return reservation, nil
+		}
+		if !errors.Is(err, ErrOrderNotFound) {
+			return nil, err
+		}
+	}
+	err := d.setSnapshots(ctx, order.Items, nil)
 	if err != nil {
-		return 0, errors.Wrap(err, "send order")
+		return nil, err
 	}
-	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-		defer cancel()
-		err = d.TransactionManager.RunTransaction(ctx, isoLevelRepeatableRead, func(ctxTX context.Context) error {
-			var reserveFrom []ReservedItem
-			for _, item := range order.Items {
-				stocks, err := d.OrdersRepository.Stocks(ctxTX, item.Sku)
-				if err != nil {
-					return errors.Wrap(err, "check stocks")
-				}
-				var counter uint64 = 0
-				for _, stock := range stocks {
-					counter += stock.Count
-					if counter > uint64(item.Count) {
-						reserveFrom = append(reserveFrom, ReservedItem{
-							WarehouseID: stock.WarehouseID,
-							OrderItem: OrderItem{
-								Sku:   item.Sku,
-								Count: item.Count - uint16(counter-stock.Count),
-							}})
-					} else {
-						reserveFrom = append(reserveFrom, ReservedItem{
-							WarehouseID: stock.WarehouseID,
-							OrderItem: OrderItem{
-								Sku:   item.Sku,
-								Count: uint16(stock.Count),
-							}})
-					}
-					if counter == uint64(item.Count) {
-						break
-					}
-				}
-				if counter < uint64(item.Count) {
-					return ErrCantReserveItem
-				}
-			}
-			for _, v := range reserveFrom {
-				err = d.OrdersRepository.ReserveStock(ctxTX, order.ID, v)
-				if err != nil {
-					return errors.Wrap(err, "reserve stock")
-				}
-			}
-			err = d.OrdersRepository.UpdateOrderStatus(ctxTX, order.ID, StatusAwaitingPayment, order.Status)
-			if err != nil {
-				return errors.Wrap(err, "set order status")
-			}
-			order.Status = StatusAwaitingPayment
-			err = d.NotificationsSender.SendOrder(order)
-			if err != nil {
-				logger.Error(ctxTX, "send order", zap.Error(err))
-			}
-			return nil
-		})
-		if err != nil {
-			logger.Error(ctx, "error create order", zap.Error(err))
-			err = d.OrdersRepository.UpdateOrderStatus(ctx, order.ID, StatusFailed, order.Status)
-			if err != nil {
-				logger.Error(ctx, "error update order status", zap.Error(err))
-				return
-			}
-			order.Status = StatusFailed
-			err = d.NotificationsSender.SendOrder(order)
-			if err != nil {
-				logger.Error(ctx, "send order", zap.Error(err))
-			}
+	shortages, err := d.createAndReserveOrder(ctx, order)
+	for attempt := uint8(1); attempt < d.config.ReservationRetries && transactor.IsSerializationFailure(err); attempt++ {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(reservationBackoff << (attempt - 1)):
 		}
-	}()
-	time.AfterFunc(10*time.Minute, func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-		defer cancel()
-		err := d.OrdersRepository.UpdateOrderStatus(ctx, order.ID, StatusCancelled, StatusAwaitingPayment)
-		if err != nil && !errors.Is(err, ErrOrderNotFound) {
-			logger.Error(ctx, "error update order status", zap.Error(err))
-			return
+		shortages, err = d.createAndReserveOrder(ctx, order)
+	}
+	//Параллельный запрос с тем же ключом успел создать заказ раньше
+	if errors.Is(err, ErrDuplicateIdempotencyKey) {
+		return d.orderReservationByKey(ctx, order)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &OrderReservation{
+		OrderID:   order.ID,
+		Status:    order.Status,
+		Shortages: shortages,
+	}, nil
+}
+
+func (d *domain) createAndReserveOrder(ctx context.Context, order *Order) ([]ItemShortage, error) {
+	var shortages []ItemShortage
+	err := d.TransactionManager.RunTransaction(ctx, isoLevelRepeatableRead, func(ctxTX context.Context) error {
+		order.Status = StatusNew
+		orderID, err := d.OrdersRepository.CreateOrder(ctxTX, order)
+		if err != nil {
+			return errors.Wrap(err, "create order")
 		}
-		order.Status = StatusCancelled
-		err = d.NotificationsSender.SendOrder(order)
+		order.ID = orderID
+		err = d.OrdersRepository.CreateOrderNotification(ctxTX, order)
 		if err != nil {
-			logger.Error(ctx, "send order", zap.Error(err))
+			return errors.Wrap(err, "create order notification")
 		}
+		shortages, err = d.reserveOrder(ctxTX, order)
+		return err
 	})
-	return order.ID, nil
+	return shortages, err
+}
+
+func (d *domain) orderReservationByKey(ctx context.Context, order *Order) (*OrderReservation, error) {
+	orderID, err := d.OrdersRepository.GetOrderIDByIdempotencyKey(ctx, order.User, order.IdempotencyKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "get order by idempotency key")
+	}
+	existing, err := d.OrdersRepository.GetOrder(ctx, orderID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get order")
+	}
+	reservation := &OrderReservation{OrderID: orderID, Status: existing.Status}
+	if existing.Status != StatusFailed && existing.Status != StatusAwaitingStock {
+		return reservation, nil
+	}
+	reservation.Shortages, err = d.OrdersRepository.GetShortages(ctx, orderID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get shortages")
+	}
+	return reservation, nil
 }
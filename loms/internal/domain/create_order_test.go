@@ -16,11 +16,13 @@ import (
 func TestCreateOrder(t *testing.T) {
 	type repositoryMockFunc func(mc *minimock.Controller) OrdersRepository
 	type tmMockFunc func(mc *minimock.Controller) TransactionManager
+	type productsMockFunc func(mc *minimock.Controller) ProductService
 
 	type args struct {
-		ctx   context.Context
-		user  int64
-		items []OrderItem
+		ctx            context.Context
+		user           int64
+		items          []OrderItem
+		idempotencyKey string
 	}
 
 	var (
@@ -29,8 +31,10 @@ func TestCreateOrder(t *testing.T) {
 		ctx   = context.Background()
 		ctxTx = context.WithValue(ctx, transactor.TxKey("tx"), tx)
 
-		createErr = errors.New("create error")
-		updateErr = errors.New("update error")
+		createErr  = errors.New("create error")
+		notifyErr  = errors.New("notification error")
+		getKeyErr  = errors.New("get by key error")
+		productErr = errors.New("product error")
 
 		orderID = gofakeit.Int64()
 		user    = gofakeit.Int64()
@@ -44,15 +48,43 @@ func TestCreateOrder(t *testing.T) {
 				Sku:   gofakeit.Uint32(),
 				Count: count,
 			},
+		}
+		product  = Product{Name: gofakeit.BeerName(), Price: gofakeit.Uint32()}
+		products = func(mc *minimock.Controller) ProductService {
+			mock := NewProductServiceMock(t)
+			mock.GetProductMock.Set(func(ctx context.Context, sku uint32) (Product, error) {
+				return product, nil
+			})
+			return mock
+		}
+		noProducts = func(mc *minimock.Controller) ProductService {
+			return NewProductServiceMock(t)
+		}
+		snapshots = []OrderItem{
 			{
-				Sku:   gofakeit.Uint32(),
+				Sku:   items[0].Sku,
 				Count: count,
+				Name:  product.Name,
+				Price: product.Price,
+			},
+			{
+				Sku:   items[1].Sku,
+				Count: count,
+				Name:  product.Name,
+				Price: product.Price,
 			},
 		}
 		order = &Order{
 			Status: StatusNew,
 			User:   user,
-			Items:  items,
+			Items:  snapshots,
+		}
+		key          = gofakeit.UUID()
+		orderWithKey = &Order{
+			Status:         StatusNew,
+			User:           user,
+			Items:          snapshots,
+			IdempotencyKey: key,
 		}
 	)
 	t.Cleanup(mc.Finish)
@@ -64,6 +96,7 @@ func TestCreateOrder(t *testing.T) {
 		err            error
 		repositoryMock repositoryMockFunc
 		tmMock         tmMockFunc
+		productsMock   productsMockFunc
 	}{
 		{
 			name: "positive case",
@@ -72,21 +105,13 @@ func TestCreateOrder(t *testing.T) {
 				user:  user,
 				items: items,
 			},
-			want: orderID,
-			err:  nil,
+			want:         orderID,
+			err:          nil,
+			productsMock: products,
 			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
 				mock := NewOrdersRepositoryMock(t)
 				mock.CreateOrderMock.Expect(ctxTx, order).Return(orderID, nil)
-				mock.ReserveStockMock.Set(func(ctx context.Context, orderID int64, item ReservedItem) (err error) {
-					return nil
-				})
-				mock.UpdateOrderStatusMock.Expect(ctxTx, orderID, StatusAwaitingPayment, order.Status).Return(nil)
-				mock.StocksMock.Set(func(ctx context.Context, sku uint32) (sa1 []Stock, err error) {
-					return []Stock{{
-						WarehouseID: gofakeit.Int64(),
-						Count:       uint64(count),
-					}}, nil
-				})
+				mock.CreateOrderNotificationMock.Return(nil)
 				return mock
 			},
 			tmMock: func(mc *minimock.Controller) TransactionManager {
@@ -99,17 +124,21 @@ func TestCreateOrder(t *testing.T) {
 			},
 		},
 		{
-			name: "negative case - create order",
+			name: "positive case - new idempotency key",
 			args: args{
-				ctx:   ctx,
-				user:  user,
-				items: items,
+				ctx:            ctx,
+				user:           user,
+				items:          items,
+				idempotencyKey: key,
 			},
-			want: 0,
-			err:  createErr,
+			want:         orderID,
+			err:          nil,
+			productsMock: products,
 			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
 				mock := NewOrdersRepositoryMock(t)
-				mock.CreateOrderMock.Expect(ctxTx, order).Return(0, createErr)
+				mock.GetOrderIDByIdempotencyKeyMock.Expect(ctx, user, key).Return(0, ErrOrderNotFound)
+				mock.CreateOrderMock.Expect(ctxTx, orderWithKey).Return(orderID, nil)
+				mock.CreateOrderNotificationMock.Return(nil)
 				return mock
 			},
 			tmMock: func(mc *minimock.Controller) TransactionManager {
@@ -122,61 +151,48 @@ func TestCreateOrder(t *testing.T) {
 			},
 		},
 		{
-			name: "negative case - stocks error",
+			name: "positive case - repeated idempotency key",
 			args: args{
-				ctx:   ctx,
-				user:  user,
-				items: items,
+				ctx:            ctx,
+				user:           user,
+				items:          items,
+				idempotencyKey: key,
 			},
-			want: orderID,
-			err:  nil,
+			want:         orderID,
+			err:          nil,
+			productsMock: noProducts,
 			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
 				mock := NewOrdersRepositoryMock(t)
-				mock.CreateOrderMock.Expect(ctxTx, order).Return(orderID, nil)
-				mock.ReserveStockMock.Set(func(ctxTx context.Context, orderID int64, item ReservedItem) (err error) {
-					return nil
-				})
-				mock.UpdateOrderStatusMock.Set(func(ctx context.Context, id int64, status string, statusBefore string) (err error) {
-					return nil
-				})
-				mock.StocksMock.Set(func(ctx context.Context, sku uint32) (sa1 []Stock, err error) {
-					return nil, errors.New("stocks error")
-				})
+				mock.GetOrderIDByIdempotencyKeyMock.Expect(ctx, user, key).Return(orderID, nil)
 				return mock
 			},
 			tmMock: func(mc *minimock.Controller) TransactionManager {
 				mock := NewTransactionManagerMock(t)
-				mock.RunTransactionMock.Set(func(ctx context.Context, isoLevel string, f func(ctxTX context.Context) error) error {
-					err := f(ctxTx)
-					return err
-				})
 				return mock
 			},
 		},
 		{
-			name: "negative case - no stocks",
+			name: "positive case - concurrent idempotency key",
 			args: args{
-				ctx:   ctx,
-				user:  user,
-				items: items,
+				ctx:            ctx,
+				user:           user,
+				items:          items,
+				idempotencyKey: key,
 			},
-			want: orderID,
-			err:  nil,
+			want:         orderID,
+			err:          nil,
+			productsMock: products,
 			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
 				mock := NewOrdersRepositoryMock(t)
-				mock.CreateOrderMock.Expect(ctxTx, order).Return(orderID, nil)
-				mock.ReserveStockMock.Set(func(ctxTx context.Context, orderID int64, item ReservedItem) (err error) {
-					return nil
-				})
-				mock.UpdateOrderStatusMock.Set(func(ctx context.Context, id int64, status string, statusBefore string) (err error) {
-					return nil
-				})
-				mock.StocksMock.Set(func(ctx context.Context, sku uint32) (sa1 []Stock, err error) {
-					return []Stock{{
-						WarehouseID: gofakeit.Int64(),
-						Count:       0,
-					}}, nil
+				calls := 0
+				mock.GetOrderIDByIdempotencyKeyMock.Set(func(ctx context.Context, user int64, key string) (int64, error) {
+					calls++
+					if calls == 1 {
+						return 0, ErrOrderNotFound
+					}
+					return orderID, nil
 				})
+				mock.CreateOrderMock.Expect(ctxTx, orderWithKey).Return(0, ErrDuplicateIdempotencyKey)
 				return mock
 			},
 			tmMock: func(mc *minimock.Controller) TransactionManager {
@@ -189,64 +205,60 @@ func TestCreateOrder(t *testing.T) {
 			},
 		},
 		{
-			name: "negative case - update status",
+			name: "negative case - get by idempotency key",
 			args: args{
-				ctx:   ctx,
-				user:  user,
-				items: items,
+				ctx:            ctx,
+				user:           user,
+				items:          items,
+				idempotencyKey: key,
 			},
-			want: orderID,
-			err:  nil,
+			want:         0,
+			err:          getKeyErr,
+			productsMock: noProducts,
 			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
 				mock := NewOrdersRepositoryMock(t)
-				mock.CreateOrderMock.Expect(ctxTx, order).Return(orderID, nil)
-				mock.ReserveStockMock.Set(func(ctxTx context.Context, orderID int64, item ReservedItem) (err error) {
-					return nil
-				})
-				mock.UpdateOrderStatusMock.Set(func(ctx context.Context, id int64, status string, statusBefore string) (err error) {
-					return updateErr
-				})
-				mock.StocksMock.Set(func(ctx context.Context, sku uint32) (sa1 []Stock, err error) {
-					return []Stock{{
-						WarehouseID: gofakeit.Int64(),
-						Count:       0,
-					}}, nil
-				})
+				mock.GetOrderIDByIdempotencyKeyMock.Expect(ctx, user, key).Return(0, getKeyErr)
 				return mock
 			},
 			tmMock: func(mc *minimock.Controller) TransactionManager {
 				mock := NewTransactionManagerMock(t)
-				mock.RunTransactionMock.Set(func(ctx context.Context, isoLevel string, f func(ctxTX context.Context) error) error {
-					err := f(ctxTx)
-					return err
-				})
 				return mock
 			},
 		},
 		{
-			name: "negative case - update status2",
+			name: "negative case - get product",
 			args: args{
 				ctx:   ctx,
 				user:  user,
 				items: items,
 			},
-			want: orderID,
-			err:  nil,
+			want: 0,
+			err:  productErr,
+			productsMock: func(mc *minimock.Controller) ProductService {
+				mock := NewProductServiceMock(t)
+				mock.GetProductMock.Expect(ctx, items[0].Sku).Return(Product{}, productErr)
+				return mock
+			},
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				return NewOrdersRepositoryMock(t)
+			},
+			tmMock: func(mc *minimock.Controller) TransactionManager {
+				return NewTransactionManagerMock(t)
+			},
+		},
+		{
+			name: "negative case - create order",
+			args: args{
+				ctx:   ctx,
+				user:  user,
+				items: items,
+			},
+			want:         0,
+			err:          createErr,
+			productsMock: products,
 			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
 				mock := NewOrdersRepositoryMock(t)
-				mock.CreateOrderMock.Expect(ctxTx, order).Return(orderID, nil)
-				mock.ReserveStockMock.Set(func(ctxTx context.Context, orderID int64, item ReservedItem) (err error) {
-					return nil
-				})
-				mock.UpdateOrderStatusMock.Set(func(ctx context.Context, id int64, status string, statusBefore string) (err error) {
-					return updateErr
-				})
-				mock.StocksMock.Set(func(ctx context.Context, sku uint32) (sa1 []Stock, err error) {
-					return []Stock{{
-						WarehouseID: gofakeit.Int64(),
-						Count:       uint64(count),
-					}}, nil
-				})
+				mock.CreateOrderMock.Expect(ctxTx, order).Return(0, createErr)
 				return mock
 			},
 			tmMock: func(mc *minimock.Controller) TransactionManager {
@@ -259,29 +271,19 @@ func TestCreateOrder(t *testing.T) {
 			},
 		},
 		{
-			name: "negative case - not full stock",
+			name: "negative case - create notification",
 			args: args{
 				ctx:   ctx,
 				user:  user,
 				items: items,
 			},
-			want: orderID,
-			err:  nil,
+			want:         0,
+			err:          notifyErr,
+			productsMock: products,
 			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
 				mock := NewOrdersRepositoryMock(t)
 				mock.CreateOrderMock.Expect(ctxTx, order).Return(orderID, nil)
-				mock.ReserveStockMock.Set(func(ctxTx context.Context, orderID int64, item ReservedItem) (err error) {
-					return nil
-				})
-				mock.UpdateOrderStatusMock.Set(func(ctx context.Context, id int64, status string, statusBefore string) (err error) {
-					return nil
-				})
-				mock.StocksMock.Set(func(ctx context.Context, sku uint32) (sa1 []Stock, err error) {
-					return []Stock{{
-						WarehouseID: gofakeit.Int64(),
-						Count:       uint64(count) + 1,
-					}}, nil
-				})
+				mock.CreateOrderNotificationMock.Return(notifyErr)
 				return mock
 			},
 			tmMock: func(mc *minimock.Controller) TransactionManager {
@@ -293,40 +295,278 @@ func TestCreateOrder(t *testing.T) {
 				return mock
 			},
 		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			api := NewMock(
+				tt.repositoryMock(mc),
+				tt.tmMock(mc),
+				tt.productsMock(mc),
+			)
+			orderID, err := api.CreateOrder(tt.args.ctx, &Order{
+				User:           tt.args.user,
+				Items:          append([]OrderItem(nil), tt.args.items...),
+				IdempotencyKey: tt.args.idempotencyKey,
+			})
+			require.Equal(t, tt.want, orderID)
+			if tt.err != nil {
+				require.ErrorContains(t, err, tt.err.Error())
+			} else {
+				require.Equal(t, tt.err, err)
+			}
+		})
+	}
+
+}
+
+func TestCreateAndReserveOrder(t *testing.T) {
+	type repositoryMockFunc func(mc *minimock.Controller) OrdersRepository
+	type tmMockFunc func(mc *minimock.Controller) TransactionManager
+	type productsMockFunc func(mc *minimock.Controller) ProductService
+
+	type args struct {
+		ctx            context.Context
+		idempotencyKey string
+		allowBackorder bool
+	}
+
+	var (
+		mc    = minimock.NewController(t)
+		tx    = txMock.NewTxMock(t)
+		ctx   = context.Background()
+		ctxTx = context.WithValue(ctx, transactor.TxKey("tx"), tx)
+
+		createErr = errors.New("create error")
+
+		orderID = gofakeit.Int64()
+		user    = gofakeit.Int64()
+		count   = gofakeit.Uint16()%1000 + 3
+		items   = []OrderItem{
+			{
+				Sku:   gofakeit.Uint32(),
+				Count: count,
+			},
+			{
+				Sku:   gofakeit.Uint32(),
+				Count: count,
+			},
+		}
+		skus = []uint32{items[0].Sku, items[1].Sku}
+		key  = gofakeit.UUID()
+
+		product     = Product{Name: gofakeit.BeerName(), Price: gofakeit.Uint32()}
+		pricedItems = []OrderItem{
+			{Sku: skus[0], Count: count, Name: product.Name, Price: product.Price},
+			{Sku: skus[1], Count: count, Name: product.Name, Price: product.Price},
+		}
+		products = func(mc *minimock.Controller) ProductService {
+			mock := NewProductServiceMock(t)
+			mock.GetProductMock.Set(func(ctx context.Context, sku uint32) (Product, error) {
+				return product, nil
+			})
+			return mock
+		}
+		noProducts = func(mc *minimock.Controller) ProductService {
+			return NewProductServiceMock(t)
+		}
+
+		transaction = func(mc *minimock.Controller) TransactionManager {
+			mock := NewTransactionManagerMock(t)
+			mock.RunTransactionMock.Set(func(ctx context.Context, isoLevel string, f func(ctxTX context.Context) error) error {
+				return f(ctxTx)
+			})
+			return mock
+		}
+	)
+	t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name           string
+		args           args
+		want           *OrderReservation
+		err            error
+		repositoryMock repositoryMockFunc
+		tmMock         tmMockFunc
+		productsMock   productsMockFunc
+	}{
 		{
-			name: "negative case - not full stock",
+			name: "positive case - reserved",
 			args: args{
-				ctx:   ctx,
-				user:  user,
-				items: items,
+				ctx: ctx,
 			},
-			want: orderID,
-			err:  nil,
+			want:         &OrderReservation{OrderID: orderID, Status: StatusAwaitingPayment},
+			err:          nil,
+			productsMock: products,
 			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
 				mock := NewOrdersRepositoryMock(t)
-				mock.CreateOrderMock.Expect(ctxTx, order).Return(orderID, nil)
-				mock.ReserveStockMock.Set(func(ctxTx context.Context, orderID int64, item ReservedItem) (err error) {
-					return errors.New("reserve err")
-				})
-				mock.UpdateOrderStatusMock.Set(func(ctx context.Context, id int64, status string, statusBefore string) (err error) {
+				mock.CreateOrderMock.Return(orderID, nil)
+				mock.CreateOrderNotificationMock.Return(nil)
+				mock.UserStocksMock.Expect(ctxTx, user, skus).Return(map[uint32][]Stock{
+					skus[0]: {{WarehouseID: 1, Count: uint64(count)}},
+					skus[1]: {{WarehouseID: 1, Count: uint64(count)}},
+				}, nil)
+				mock.ReleaseHoldsMock.Expect(ctxTx, user, skus).Return(nil, nil)
+				mock.ReserveStockMock.Return(nil)
+				mock.SetPaymentDeadlineMock.Set(func(ctx context.Context, id int64, deadline time.Time) (err error) {
 					return nil
 				})
-				mock.StocksMock.Set(func(ctx context.Context, sku uint32) (sa1 []Stock, err error) {
-					return []Stock{{
-						WarehouseID: gofakeit.Int64(),
-						Count:       uint64(count),
-					}}, nil
+				mock.UpdateOrderStatusMock.Expect(ctxTx, orderID, StatusAwaitingPayment, StatusNew).Return(nil)
+				mock.AddStatusChangeMock.Expect(ctxTx, StatusChange{OrderID: orderID, From: StatusNew, To: StatusAwaitingPayment, Reason: ReasonItemsReserved}).Return(nil)
+				mock.CreateSellerOrderMock.Expect(ctxTx, SellerOrder{
+					OrderID: orderID,
+					Status:  StatusAwaitingPayment,
+					Items:   pricedItems,
+				}).Return(nil)
+				return mock
+			},
+			tmMock: transaction,
+		},
+		{
+			name: "positive case - insufficient stocks",
+			args: args{
+				ctx: ctx,
+			},
+			want: &OrderReservation{
+				OrderID: orderID,
+				Status:  StatusFailed,
+				Shortages: []ItemShortage{
+					{Sku: skus[0], Requested: uint64(count), Missing: 2},
+					{Sku: skus[1], Requested: uint64(count), Missing: uint64(count)},
+				},
+			},
+			err:          nil,
+			productsMock: products,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.CreateOrderMock.Return(orderID, nil)
+				mock.CreateOrderNotificationMock.Return(nil)
+				mock.UserStocksMock.Expect(ctxTx, user, skus).Return(map[uint32][]Stock{
+					skus[0]: {{WarehouseID: 1, Count: 1}, {WarehouseID: 2, Count: uint64(count) - 3}},
+				}, nil)
+				mock.CreateShortagesMock.Expect(ctxTx, orderID, []ItemShortage{
+					{Sku: skus[0], Requested: uint64(count), Missing: 2},
+					{Sku: skus[1], Requested: uint64(count), Missing: uint64(count)},
+				}).Return(nil)
+				mock.UpdateOrderStatusMock.Expect(ctxTx, orderID, StatusFailed, StatusNew).Return(nil)
+				mock.AddStatusChangeMock.Expect(ctxTx, StatusChange{OrderID: orderID, From: StatusNew, To: StatusFailed, Reason: ReasonInsufficientStock}).Return(nil)
+				return mock
+			},
+			tmMock: transaction,
+		},
+		{
+			name: "positive case - backordered",
+			args: args{
+				ctx:            ctx,
+				allowBackorder: true,
+			},
+			want: &OrderReservation{
+				OrderID: orderID,
+				Status:  StatusAwaitingStock,
+				Shortages: []ItemShortage{
+					{Sku: skus[0], Requested: uint64(count), Missing: 2},
+					{Sku: skus[1], Requested: uint64(count), Missing: uint64(count)},
+				},
+			},
+			err:          nil,
+			productsMock: products,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.CreateOrderMock.Return(orderID, nil)
+				mock.CreateOrderNotificationMock.Return(nil)
+				mock.UserStocksMock.Expect(ctxTx, user, skus).Return(map[uint32][]Stock{
+					skus[0]: {{WarehouseID: 2, Count: uint64(count) - 3}, {WarehouseID: 1, Count: 1}},
+				}, nil)
+				mock.ReserveStockMock.When(ctxTx, orderID, ReservedItem{
+					OrderItem:   OrderItem{Sku: skus[0], Count: count - 3},
+					WarehouseID: 2,
+				}).Then(nil)
+				mock.ReserveStockMock.When(ctxTx, orderID, ReservedItem{
+					OrderItem:   OrderItem{Sku: skus[0], Count: 1},
+					WarehouseID: 1,
+				}).Then(nil)
+				mock.ReleaseHoldsMock.Expect(ctxTx, user, skus).Return(nil, nil)
+				mock.CreateShortagesMock.Expect(ctxTx, orderID, []ItemShortage{
+					{Sku: skus[0], Requested: uint64(count), Missing: 2},
+					{Sku: skus[1], Requested: uint64(count), Missing: uint64(count)},
+				}).Return(nil)
+				mock.CreateBackordersMock.Expect(ctxTx, orderID, []OrderItem{
+					{Sku: skus[0], Count: 2},
+					{Sku: skus[1], Count: count},
+				}).Return(nil)
+				mock.SetBackorderDeadlineMock.Set(func(ctx context.Context, id int64, deadline time.Time) (err error) {
+					return nil
 				})
+				mock.UpdateOrderStatusMock.Expect(ctxTx, orderID, StatusAwaitingStock, StatusNew).Return(nil)
+				mock.AddStatusChangeMock.Expect(ctxTx, StatusChange{OrderID: orderID, From: StatusNew, To: StatusAwaitingStock, Reason: ReasonBackordered}).Return(nil)
+				mock.CreateSellerOrderMock.Expect(ctxTx, SellerOrder{
+					OrderID: orderID,
+					Status:  StatusAwaitingStock,
+					Items:   pricedItems,
+				}).Return(nil)
+				return mock
+			},
+			tmMock: transaction,
+		},
+		{
+			name: "positive case - repeated idempotency key",
+			args: args{
+				ctx:            ctx,
+				idempotencyKey: key,
+			},
+			want:         &OrderReservation{OrderID: orderID, Status: StatusAwaitingPayment},
+			err:          nil,
+			productsMock: noProducts,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetOrderIDByIdempotencyKeyMock.Expect(ctx, user, key).Return(orderID, nil)
+				mock.GetOrderMock.Expect(ctx, orderID).Return(&Order{ID: orderID, Status: StatusAwaitingPayment}, nil)
 				return mock
 			},
 			tmMock: func(mc *minimock.Controller) TransactionManager {
-				mock := NewTransactionManagerMock(t)
-				mock.RunTransactionMock.Set(func(ctx context.Context, isoLevel string, f func(ctxTX context.Context) error) error {
-					err := f(ctxTx)
-					return err
-				})
+				return NewTransactionManagerMock(t)
+			},
+		},
+		{
+			name: "positive case - repeated idempotency key of failed order",
+			args: args{
+				ctx:            ctx,
+				idempotencyKey: key,
+			},
+			want: &OrderReservation{
+				OrderID:   orderID,
+				Status:    StatusFailed,
+				Shortages: []ItemShortage{{Sku: skus[1], Requested: uint64(count), Missing: uint64(count)}},
+			},
+			err:          nil,
+			productsMock: noProducts,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetOrderIDByIdempotencyKeyMock.Expect(ctx, user, key).Return(orderID, nil)
+				mock.GetOrderMock.Expect(ctx, orderID).Return(&Order{ID: orderID, Status: StatusFailed}, nil)
+				mock.GetShortagesMock.Expect(ctx, orderID).Return([]ItemShortage{{Sku: skus[1], Requested: uint64(count), Missing: uint64(count)}}, nil)
+				return mock
+			},
+			tmMock: func(mc *minimock.Controller) TransactionManager {
+				return NewTransactionManagerMock(t)
+			},
+		},
+		{
+			name: "negative case - create order",
+			args: args{
+				ctx: ctx,
+			},
+			want:         nil,
+			err:          createErr,
+			productsMock: products,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.CreateOrderMock.Return(0, createErr)
 				return mock
 			},
+			tmMock: transaction,
 		},
 	}
 
@@ -337,16 +577,20 @@ func TestCreateOrder(t *testing.T) {
 			api := NewMock(
 				tt.repositoryMock(mc),
 				tt.tmMock(mc),
+				tt.productsMock(mc),
 			)
-			orderID, err := api.CreateOrder(tt.args.ctx, tt.args.user, tt.args.items)
-			require.Equal(t, tt.want, orderID)
+			reservation, err := api.CreateAndReserveOrder(tt.args.ctx, &Order{
+				User:           user,
+				Items:          append([]OrderItem(nil), items...),
+				IdempotencyKey: tt.args.idempotencyKey,
+				AllowBackorder: tt.args.allowBackorder,
+			})
+			require.Equal(t, tt.want, reservation)
 			if tt.err != nil {
 				require.ErrorContains(t, err, tt.err.Error())
 			} else {
 				require.Equal(t, tt.err, err)
 			}
-			time.Sleep(3 * time.Second)
 		})
 	}
-
 }
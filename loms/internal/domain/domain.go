@@ -3,19 +3,25 @@ package domain
 ////go:generate sh -c "rm ./zzz*"
 //go:generate minimock -i OrdersRepository -o "./zzz_carts_repo_minimock_test.go"
 //go:generate minimock -i TransactionManager -o "./zzz_tm_minimock_test.go"
-//go:generate minimock -i NotificationsSender -o "./zzz_ns_minimock_test.go"
+//go:generate minimock -i WarehousesRepository -o "./zzz_warehouses_repo_minimock_test.go"
+//go:generate minimock -i PaymentsRepository -o "./zzz_payments_repo_minimock_test.go"
+//go:generate minimock -i ShipmentsRepository -o "./zzz_shipments_repo_minimock_test.go"
+//go:generate minimock -i PaymentProvider -o "./zzz_payment_provider_minimock_test.go"
+//go:generate minimock -i ProductService -o "./zzz_products_minimock_test.go"
 
 import (
 	"context"
+	transactor "route256/libs/postgres_transactor"
+	"time"
 )
 
 var _ Domain = (*domain)(nil)
 
 const (
-	isoLevelSerializable    = "serializable"
-	isoLevelRepeatableRead  = "repeatable read"
-	isoLevelReadCommitted   = "read committed"
-	isoLevelReadUncommitted = "read uncommitted"
+	isoLevelSerializable    = transactor.IsoLevelSerializable
+	isoLevelRepeatableRead  = transactor.IsoLevelRepeatableRead
+	isoLevelReadCommitted   = transactor.IsoLevelReadCommitted
+	isoLevelReadUncommitted = transactor.IsoLevelReadUncommitted
 )
 
 type TransactionManager interface {
@@ -25,43 +31,138 @@ type TransactionManager interface {
 type OrdersRepository interface {
 	GetOrder(ctx context.Context, id int64) (*Order, error)
 	CreateOrder(ctx context.Context, order *Order) (int64, error)
-	UpdateOrderStatus(ctx context.Context, id int64, status string, statusBefore string) error
+	GetOrderIDByIdempotencyKey(ctx context.Context, user int64, key string) (int64, error)
+	ListUserOrders(ctx context.Context, query UserOrdersQuery) ([]*Order, error)
+	ClaimNewOrder(ctx context.Context) (int64, error)
+	UpdateOrderStatus(ctx context.Context, id int64, status OrderStatus, statusBefore OrderStatus) error
+	AddStatusChange(ctx context.Context, change StatusChange) error
+	GetOrderHistory(ctx context.Context, orderID int64) ([]StatusChange, error)
+	SetPaymentDeadline(ctx context.Context, id int64, deadline time.Time) error
+	SetBackorderDeadline(ctx context.Context, id int64, deadline time.Time) error
+	GetExpiredOrders(ctx context.Context, status OrderStatus, now time.Time, limit uint64) ([]int64, error)
+	UpdateOrderItems(ctx context.Context, orderID int64, items []OrderItem) error
 	ReserveStock(ctx context.Context, orderID int64, item ReservedItem) error
-	UnReserveItems(ctx context.Context, orderID int64) error
+	UnReserveItems(ctx context.Context, orderID int64) ([]ReservedItem, error)
 	RemoveSoldItems(ctx context.Context, orderID int64) error
-	Stocks(ctx context.Context, sku uint32) ([]Stock, error)
+	Stocks(ctx context.Context, sku uint32, sellerID int64) ([]Stock, error)
+	BatchStocks(ctx context.Context, skus []uint32) (map[uint32][]Stock, error)
+	UserStocks(ctx context.Context, user int64, skus []uint32) (map[uint32][]Stock, error)
+	HoldStock(ctx context.Context, user int64, item ReservedItem, expiresAt time.Time) error
+	ReleaseHolds(ctx context.Context, user int64, skus []uint32) ([]ReservedItem, error)
+	ReleaseExpiredHolds(ctx context.Context, now time.Time) ([]ReservedItem, error)
+	CreateOrderNotification(ctx context.Context, order *Order) error
+	CreateSellerOrder(ctx context.Context, order SellerOrder) error
+	UpdateSellerOrdersStatus(ctx context.Context, orderID int64, sellerIDs []int64, status OrderStatus) error
+	DeleteSellerOrders(ctx context.Context, orderID int64) error
+	ListSellerOrders(ctx context.Context, query SellerOrdersQuery) ([]SellerOrder, error)
+	CreateReturnItems(ctx context.Context, orderID int64, items []OrderItem) error
+	CreateBackorders(ctx context.Context, orderID int64, items []OrderItem) error
+	GetBackorders(ctx context.Context, orderID int64) ([]OrderItem, error)
+	ListSkuBackorders(ctx context.Context, sku uint32, sellerID int64) ([]Backorder, error)
+	UpdateBackorder(ctx context.Context, backorder Backorder) error
+	DeleteBackorders(ctx context.Context, orderID int64) error
+	GetReturnItems(ctx context.Context, orderID int64) ([]OrderItem, error)
+	CreateShortages(ctx context.Context, orderID int64, shortages []ItemShortage) error
+	GetShortages(ctx context.Context, orderID int64) ([]ItemShortage, error)
 }
 
-type NotificationsSender interface {
-	SendOrder(order *Order) error
+type WarehousesRepository interface {
+	CreateSeller(ctx context.Context, name string) (int64, error)
+	CreateWarehouse(ctx context.Context, name string, sellerID int64) (int64, error)
+	ChangeStock(ctx context.Context, movement StockMovement) error
+	ListWarehouseStock(ctx context.Context, warehouseID int64) ([]WarehouseStock, error)
+	StockDrifts(ctx context.Context) ([]StockDrift, error)
+	SoldItems(ctx context.Context, orderID int64) ([]ReservedItem, error)
+}
+
+type PaymentsRepository interface {
+	CreatePayment(ctx context.Context, payment *Payment) error
+	GetPayment(ctx context.Context, id string) (*Payment, error)
+	GetActivePayment(ctx context.Context, orderID int64) (*Payment, error)
+	UpdatePaymentStatus(ctx context.Context, id string, status PaymentStatus, statusBefore PaymentStatus) error
+	CreateRefund(ctx context.Context, paymentID string, amount uint64) error
+	GetUnsentRefunds(ctx context.Context, limit uint64) ([]Refund, error)
+	MarkRefundsSent(ctx context.Context, ids []int64) error
+}
+
+type ShipmentsRepository interface {
+	CreateShipment(ctx context.Context, shipment *Shipment) (int64, error)
+	GetShipment(ctx context.Context, id int64) (*Shipment, error)
+	GetOrderShipments(ctx context.Context, orderID int64) ([]Shipment, error)
+	UpdateShipment(ctx context.Context, shipment *Shipment, statusBefore ShipmentStatus) error
 }
 
 type Deps struct {
 	OrdersRepository
+	WarehousesRepository
+	PaymentsRepository
+	ShipmentsRepository
 	TransactionManager
-	NotificationsSender
+	PaymentProvider
+	ProductService
 }
 
 type Domain interface {
-	CreateOrder(ctx context.Context, user int64, items []OrderItem) (int64, error)
+	CreateOrder(ctx context.Context, order *Order) (int64, error)
+	CreateAndReserveOrder(ctx context.Context, order *Order) (*OrderReservation, error)
 	ListOrder(ctx context.Context, orderID int64) (*Order, error)
 	CancelOrder(ctx context.Context, orderID int64) error
-	Stocks(ctx context.Context, sku uint32) ([]Stock, error)
+	Stocks(ctx context.Context, sku uint32, sellerID int64) ([]Stock, error)
+	BatchStocks(ctx context.Context, skus []uint32) (map[uint32][]Stock, error)
 	OrderPayed(ctx context.Context, orderID int64) error
+	GetOrderHistory(ctx context.Context, orderID int64) ([]StatusChange, error)
+	ListUserOrders(ctx context.Context, filter UserOrdersFilter) ([]*Order, string, error)
+	UpdateOrderItems(ctx context.Context, orderID int64, items []OrderItem) error
+	CreateSeller(ctx context.Context, name string) (int64, error)
+	CreateWarehouse(ctx context.Context, name string, sellerID int64) (int64, error)
+	ReceiveStock(ctx context.Context, warehouseID int64, sku uint32, count uint64) error
+	AdjustStock(ctx context.Context, warehouseID int64, sku uint32, delta int64, reason string) error
+	ListWarehouseStock(ctx context.Context, warehouseID int64) ([]WarehouseStock, error)
+	HoldStock(ctx context.Context, user int64, sku uint32, count uint16, ttl time.Duration) error
+	ReleaseHold(ctx context.Context, user int64, sku uint32) error
+	InitiatePayment(ctx context.Context, orderID int64) (*Payment, error)
+	ConfirmPayment(ctx context.Context, paymentID string, succeeded bool) error
+	RequestReturn(ctx context.Context, orderID int64, items []OrderItem) error
+	CompleteReturn(ctx context.Context, orderID int64, warehouseID int64) error
+	AssembleOrder(ctx context.Context, orderID int64) ([]Shipment, error)
+	ShipShipment(ctx context.Context, shipmentID int64, trackingNumber string) error
+	DeliverShipment(ctx context.Context, shipmentID int64) error
+	ListSellerOrders(ctx context.Context, filter SellerOrdersFilter) ([]SellerOrder, string, error)
 }
 
 type domain struct {
 	Deps
+	config Config
+}
+
+type Config struct {
+	PaymentTimeout      time.Duration
+	BackorderTimeout    time.Duration
+	ReservationRetries  uint8
+	ReservationStrategy ReservationStrategy
 }
 
 type SKUs map[int64]struct{}
 
-func New(repo OrdersRepository, tm TransactionManager, ns NotificationsSender) *domain {
-	return &domain{Deps{repo, tm, ns}}
+func New(repo OrdersRepository, warehouses WarehousesRepository, payments PaymentsRepository, shipments ShipmentsRepository,
+	tm TransactionManager, provider PaymentProvider, products ProductService, config Config) *domain {
+	if config.PaymentTimeout == 0 {
+		config.PaymentTimeout = defaultPaymentTimeout
+	}
+	if config.BackorderTimeout == 0 {
+		config.BackorderTimeout = defaultBackorderTimeout
+	}
+	if config.ReservationRetries == 0 {
+		config.ReservationRetries = defaultReservationRetries
+	}
+	if config.ReservationStrategy == nil {
+		config.ReservationStrategy = greedyStrategy{}
+	}
+	return &domain{Deps{repo, warehouses, payments, shipments, tm, provider, products}, config}
 }
 
 func NewMock(deps ...interface{}) *domain {
-	d := &domain{}
+	d := &domain{config: Config{ReservationStrategy: greedyStrategy{}}}
 
 	for _, v := range deps {
 		switch s := v.(type) {
@@ -69,8 +170,23 @@ func NewMock(deps ...interface{}) *domain {
 			d.TransactionManager = s
 		case OrdersRepository:
 			d.OrdersRepository = s
-		case NotificationsSender:
-			d.NotificationsSender = s
+		case WarehousesRepository:
+			d.WarehousesRepository = s
+		case PaymentsRepository:
+			d.PaymentsRepository = s
+		case ShipmentsRepository:
+			d.ShipmentsRepository = s
+		case PaymentProvider:
+			d.PaymentProvider = s
+		case ProductService:
+			d.ProductService = s
+		case Config:
+			if s.ReservationStrategy == nil {
+				s.ReservationStrategy = d.config.ReservationStrategy
+			}
+			d.config = s
+		case ReservationStrategy:
+			d.config.ReservationStrategy = s
 		}
 	}
 	return d
@@ -79,25 +195,48 @@ func NewMock(deps ...interface{}) *domain {
 type OrderItem struct {
 	Sku   uint32
 	Count uint16
+	//Продавец, чье предложение купил пользователь, 0 - сам маркетплейс
+	SellerID int64
+	//Название и цена за единицу на момент покупки
+	Name  string
+	Price uint32
 }
 
 type Order struct {
-	ID     int64
-	Status string
-	User   int64
-	Items  []OrderItem
+	ID             int64
+	Status         OrderStatus
+	User           int64
+	Items          []OrderItem
+	CreatedAt      time.Time
+	IdempotencyKey string
+	//Склад, с которого товары резервируются в первую очередь, 0 - не задан
+	PreferredWarehouseID int64
+	//Недостающие товары не проваливают заказ, а ждут поступления на склад
+	AllowBackorder bool
+	//Заполняется только для заказов в статусе failed
+	FailureReason string
+	//Заполняется только для заказов в статусах return requested и returned
+	ReturnItems []OrderItem
+	//Заполняется только для заказов в статусе awaiting stock
+	BackorderedItems []OrderItem
+	//Заполняется для собираемых, отправленных и доставленных заказов
+	Shipments []Shipment
+}
+
+func (o *Order) TotalPrice() uint64 {
+	var total uint64
+	for _, item := range o.Items {
+		total += uint64(item.Price) * uint64(item.Count)
+	}
+	return total
+}
+
+type OrderNotification struct {
+	ID    int64
+	Order *Order
 }
 
 type ReservedItem struct {
 	OrderItem
 	WarehouseID int64
 }
-
-const (
-	StatusNew             = "new"
-	StatusAwaitingPayment = "awaiting payment"
-	StatusFailed          = "failed"
-	StatusPayed           = "payed"
-	StatusCancelled       = "cancelled"
-	StatusUndefined       = "undefined"
-)
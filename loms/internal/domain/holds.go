@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func (d *domain) HoldStock(ctx context.Context, user int64, sku uint32, count uint16, ttl time.Duration) error {
+	return d.TransactionManager.RunTransaction(ctx, isoLevelReadCommitted, func(ctxTX context.Context) error {
+		_, err := d.OrdersRepository.ReleaseHolds(ctxTX, user, []uint32{sku})
+		if err != nil {
+			return errors.Wrap(err, "release holds")
+		}
+		//В корзине лежат предложения самого маркетплейса
+		stocks, err := d.OrdersRepository.Stocks(ctxTX, sku, 0)
+		if err != nil {
+			return errors.Wrap(err, "check stocks")
+		}
+		holdFrom, err := takeItem(OrderItem{Sku: sku, Count: count}, stocks)
+		if err != nil {
+			return err
+		}
+		expiresAt := time.Now().Add(ttl)
+		for _, item := range holdFrom {
+			err = d.OrdersRepository.HoldStock(ctxTX, user, item, expiresAt)
+			if err != nil {
+				return errors.Wrap(err, "hold stock")
+			}
+		}
+		return nil
+	})
+}
+
+func (d *domain) ReleaseHold(ctx context.Context, user int64, sku uint32) error {
+	return d.TransactionManager.RunTransaction(ctx, isoLevelSerializable, func(ctxTX context.Context) error {
+		released, err := d.OrdersRepository.ReleaseHolds(ctxTX, user, []uint32{sku})
+		if err != nil {
+			return errors.Wrap(err, "release holds")
+		}
+		return d.allocateReleased(ctxTX, released)
+	})
+}
+
+func (d *domain) ReleaseExpiredHolds(ctx context.Context) error {
+	return d.TransactionManager.RunTransaction(ctx, isoLevelSerializable, func(ctxTX context.Context) error {
+		released, err := d.OrdersRepository.ReleaseExpiredHolds(ctxTX, time.Now())
+		if err != nil {
+			return errors.Wrap(err, "release expired holds")
+		}
+		return d.allocateReleased(ctxTX, released)
+	})
+}
@@ -0,0 +1,240 @@
+package domain
+
+import (
+	"context"
+	transactor "route256/libs/postgres_transactor"
+	txMock "route256/libs/postgres_transactor/mocks"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHoldStock(t *testing.T) {
+	type repositoryMockFunc func(mc *minimock.Controller) OrdersRepository
+
+	type args struct {
+		ctx   context.Context
+		user  int64
+		sku   uint32
+		count uint16
+		ttl   time.Duration
+	}
+
+	var (
+		mc    = minimock.NewController(t)
+		tx    = txMock.NewTxMock(t)
+		ctx   = context.Background()
+		ctxTx = context.WithValue(ctx, transactor.TxKey("tx"), tx)
+
+		releaseErr = errors.New("release error")
+
+		user       = gofakeit.Int64()
+		sku        = gofakeit.Uint32()
+		warehouse1 = gofakeit.Int64()
+		warehouse2 = gofakeit.Int64()
+		stocks     = []Stock{{WarehouseID: warehouse1, Count: 3}, {WarehouseID: warehouse2, Count: 5}}
+		ttl        = time.Minute
+	)
+	t.Cleanup(mc.Finish)
+
+	tmMock := func(mc *minimock.Controller) TransactionManager {
+		mock := NewTransactionManagerMock(t)
+		mock.RunTransactionMock.Set(func(ctx context.Context, isoLevel string, f func(ctxTX context.Context) error) error {
+			return f(ctxTx)
+		})
+		return mock
+	}
+
+	tests := []struct {
+		name           string
+		args           args
+		err            error
+		repositoryMock repositoryMockFunc
+	}{
+		{
+			name: "positive case - hold split between warehouses",
+			args: args{
+				ctx:   ctx,
+				user:  user,
+				sku:   sku,
+				count: 4,
+				ttl:   ttl,
+			},
+			err: nil,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.ReleaseHoldsMock.Expect(ctxTx, user, []uint32{sku}).Return(nil, nil)
+				mock.StocksMock.Expect(ctxTx, sku, int64(0)).Return(stocks, nil)
+				var held []ReservedItem
+				mock.HoldStockMock.Set(func(ctx context.Context, u int64, item ReservedItem, expiresAt time.Time) error {
+					require.Equal(t, user, u)
+					require.WithinDuration(t, time.Now().Add(ttl), expiresAt, time.Second)
+					held = append(held, item)
+					return nil
+				})
+				t.Cleanup(func() {
+					require.Equal(t, []ReservedItem{
+						{WarehouseID: warehouse1, OrderItem: OrderItem{Sku: sku, Count: 3}},
+						{WarehouseID: warehouse2, OrderItem: OrderItem{Sku: sku, Count: 1}},
+					}, held)
+				})
+				return mock
+			},
+		},
+		{
+			name: "negative case - insufficient stock",
+			args: args{
+				ctx:   ctx,
+				user:  user,
+				sku:   sku,
+				count: 9,
+				ttl:   ttl,
+			},
+			err: ErrCantReserveItem,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.ReleaseHoldsMock.Expect(ctxTx, user, []uint32{sku}).Return(nil, nil)
+				mock.StocksMock.Expect(ctxTx, sku, int64(0)).Return(stocks, nil)
+				return mock
+			},
+		},
+		{
+			name: "negative case - release previous hold",
+			args: args{
+				ctx:   ctx,
+				user:  user,
+				sku:   sku,
+				count: 1,
+				ttl:   ttl,
+			},
+			err: releaseErr,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.ReleaseHoldsMock.Expect(ctxTx, user, []uint32{sku}).Return(nil, releaseErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			api := NewMock(tt.repositoryMock(mc), tmMock(mc))
+			err := api.HoldStock(tt.args.ctx, tt.args.user, tt.args.sku, tt.args.count, tt.args.ttl)
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestReleaseHold(t *testing.T) {
+	type repositoryMockFunc func(mc *minimock.Controller) OrdersRepository
+
+	var (
+		mc    = minimock.NewController(t)
+		tx    = txMock.NewTxMock(t)
+		ctx   = context.Background()
+		ctxTx = context.WithValue(ctx, transactor.TxKey("tx"), tx)
+
+		releaseErr = errors.New("release error")
+
+		user        = gofakeit.Int64()
+		sku         = gofakeit.Uint32()
+		warehouseID = gofakeit.Int64()
+		orderID     = gofakeit.Int64()
+		released    = []ReservedItem{{OrderItem: OrderItem{Sku: sku, Count: 2}, WarehouseID: warehouseID}}
+	)
+	t.Cleanup(mc.Finish)
+
+	tmMock := func(mc *minimock.Controller) TransactionManager {
+		mock := NewTransactionManagerMock(t)
+		mock.RunTransactionMock.Set(func(ctx context.Context, isoLevel string, f func(ctxTX context.Context) error) error {
+			require.Equal(t, isoLevelSerializable, isoLevel)
+			return f(ctxTx)
+		})
+		return mock
+	}
+
+	tests := []struct {
+		name           string
+		call           func(d *domain) error
+		err            error
+		repositoryMock repositoryMockFunc
+	}{
+		{
+			name: "positive case - nothing was held",
+			call: func(d *domain) error {
+				return d.ReleaseHold(ctx, user, sku)
+			},
+			err: nil,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.ReleaseHoldsMock.Expect(ctxTx, user, []uint32{sku}).Return(nil, nil)
+				return mock
+			},
+		},
+		{
+			name: "positive case - released hold goes to backorders",
+			call: func(d *domain) error {
+				return d.ReleaseHold(ctx, user, sku)
+			},
+			err: nil,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.ReleaseHoldsMock.Expect(ctxTx, user, []uint32{sku}).Return(released, nil)
+				mock.BatchStocksMock.Expect(ctxTx, []uint32{sku}).Return(map[uint32][]Stock{
+					sku: {{WarehouseID: warehouseID, Count: 2}},
+				}, nil)
+				mock.ListSkuBackordersMock.Expect(ctxTx, sku, 0).Return([]Backorder{{OrderID: orderID, Sku: sku, Count: 3}}, nil)
+				mock.ReserveStockMock.Expect(ctxTx, orderID, released[0]).Return(nil)
+				mock.UpdateBackorderMock.Expect(ctxTx, Backorder{OrderID: orderID, Sku: sku, Count: 1}).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "negative case - release error",
+			call: func(d *domain) error {
+				return d.ReleaseHold(ctx, user, sku)
+			},
+			err: releaseErr,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.ReleaseHoldsMock.Expect(ctxTx, user, []uint32{sku}).Return(nil, releaseErr)
+				return mock
+			},
+		},
+		{
+			name: "positive case - expired holds go to backorders",
+			call: func(d *domain) error {
+				return d.ReleaseExpiredHolds(ctx)
+			},
+			err: nil,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.ReleaseExpiredHoldsMock.Set(func(ctx context.Context, now time.Time) ([]ReservedItem, error) {
+					require.WithinDuration(t, time.Now(), now, time.Minute)
+					return released, nil
+				})
+				mock.BatchStocksMock.Expect(ctxTx, []uint32{sku}).Return(map[uint32][]Stock{
+					sku: {{WarehouseID: warehouseID, Count: 2}},
+				}, nil)
+				mock.ListSkuBackordersMock.Expect(ctxTx, sku, 0).Return(nil, nil)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			api := NewMock(tt.repositoryMock(mc), tmMock(mc))
+			err := tt.call(api)
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
@@ -15,5 +15,39 @@ func (d *domain) ListOrder(ctx context.Context, orderID int64) (*Order, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "get order")
 	}
+	if order.Status == StatusAssembling || order.Status == StatusShipped || order.Status == StatusDelivered {
+		order.Shipments, err = d.ShipmentsRepository.GetOrderShipments(ctx, orderID)
+		if err != nil {
+			return nil, errors.Wrap(err, "get order shipments")
+		}
+		return order, nil
+	}
+	if order.Status == StatusAwaitingStock {
+		order.BackorderedItems, err = d.OrdersRepository.GetBackorders(ctx, orderID)
+		if err != nil {
+			return nil, errors.Wrap(err, "get backorders")
+		}
+		return order, nil
+	}
+	if order.Status == StatusReturnRequested || order.Status == StatusReturned {
+		order.ReturnItems, err = d.OrdersRepository.GetReturnItems(ctx, orderID)
+		if err != nil {
+			return nil, errors.Wrap(err, "get return items")
+		}
+		return order, nil
+	}
+	if order.Status != StatusFailed {
+		return order, nil
+	}
+	history, err := d.OrdersRepository.GetOrderHistory(ctx, orderID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get order history")
+	}
+	for i := len(history) - 1; i >= 0; i-- {
+		if history[i].To == StatusFailed {
+			order.FailureReason = history[i].Reason
+			break
+		}
+	}
 	return order, nil
 }
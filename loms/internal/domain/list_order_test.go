@@ -23,7 +23,8 @@ func TestListOrder(t *testing.T) {
 		mc  = minimock.NewController(t)
 		ctx = context.Background()
 
-		getOrderErr = errors.New("get error")
+		getOrderErr   = errors.New("get error")
+		getHistoryErr = errors.New("get history error")
 
 		orderID = gofakeit.Int64()
 		order   = &Order{
@@ -32,6 +33,20 @@ func TestListOrder(t *testing.T) {
 			User:   gofakeit.Int64(),
 			Items:  nil,
 		}
+		failedOrder = &Order{
+			ID:     orderID,
+			Status: StatusFailed,
+			User:   gofakeit.Int64(),
+			Items:  nil,
+		}
+		returnedOrder = &Order{
+			ID:     orderID,
+			Status: StatusReturnRequested,
+			User:   gofakeit.Int64(),
+		}
+		history = []StatusChange{
+			{OrderID: orderID, From: StatusNew, To: StatusFailed, Reason: ReasonInsufficientStock},
+		}
 	)
 	t.Cleanup(mc.Finish)
 
@@ -61,6 +76,94 @@ func TestListOrder(t *testing.T) {
 				return mock
 			},
 		},
+		{
+			name: "positive case - return items",
+			args: args{
+				ctx:     ctx,
+				orderID: orderID,
+			},
+			want: &Order{
+				ID:          orderID,
+				Status:      StatusReturnRequested,
+				User:        returnedOrder.User,
+				ReturnItems: []OrderItem{{Sku: 1, Count: 1}},
+			},
+			err: nil,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetOrderMock.Expect(ctx, orderID).Return(cloneOrder(returnedOrder), nil)
+				mock.GetReturnItemsMock.Expect(ctx, orderID).Return([]OrderItem{{Sku: 1, Count: 1}}, nil)
+				return mock
+			},
+			tmMock: func(mc *minimock.Controller) TransactionManager {
+				return NewTransactionManagerMock(t)
+			},
+		},
+		{
+			name: "positive case - backordered items",
+			args: args{
+				ctx:     ctx,
+				orderID: orderID,
+			},
+			want: &Order{
+				ID:               orderID,
+				Status:           StatusAwaitingStock,
+				User:             order.User,
+				BackorderedItems: []OrderItem{{Sku: 1, Count: 2}},
+			},
+			err: nil,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetOrderMock.Expect(ctx, orderID).Return(&Order{ID: orderID, Status: StatusAwaitingStock, User: order.User}, nil)
+				mock.GetBackordersMock.Expect(ctx, orderID).Return([]OrderItem{{Sku: 1, Count: 2}}, nil)
+				return mock
+			},
+			tmMock: func(mc *minimock.Controller) TransactionManager {
+				return NewTransactionManagerMock(t)
+			},
+		},
+		{
+			name: "positive case - failure reason",
+			args: args{
+				ctx:     ctx,
+				orderID: orderID,
+			},
+			want: &Order{
+				ID:            orderID,
+				Status:        StatusFailed,
+				User:          failedOrder.User,
+				FailureReason: ReasonInsufficientStock,
+			},
+			err: nil,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetOrderMock.Expect(ctx, orderID).Return(cloneOrder(failedOrder), nil)
+				mock.GetOrderHistoryMock.Expect(ctx, orderID).Return(history, nil)
+				return mock
+			},
+			tmMock: func(mc *minimock.Controller) TransactionManager {
+				mock := NewTransactionManagerMock(t)
+				return mock
+			},
+		},
+		{
+			name: "negative case - get history",
+			args: args{
+				ctx:     ctx,
+				orderID: orderID,
+			},
+			err: getHistoryErr,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetOrderMock.Expect(ctx, orderID).Return(cloneOrder(failedOrder), nil)
+				mock.GetOrderHistoryMock.Expect(ctx, orderID).Return(nil, getHistoryErr)
+				return mock
+			},
+			tmMock: func(mc *minimock.Controller) TransactionManager {
+				mock := NewTransactionManagerMock(t)
+				return mock
+			},
+		},
 		{
 			name: "negative case - get order",
 			args: args{
@@ -0,0 +1,20 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+func (d *domain) GetOrderHistory(ctx context.Context, orderID int64) ([]StatusChange, error) {
+	//Новый заказ еще не имеет истории, поэтому отдельно проверяем, что он существует
+	_, err := d.OrdersRepository.GetOrder(ctx, orderID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get order")
+	}
+	history, err := d.OrdersRepository.GetOrderHistory(ctx, orderID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get order history")
+	}
+	return history, nil
+}
@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetOrderHistory(t *testing.T) {
+	type repositoryMockFunc func(mc *minimock.Controller) OrdersRepository
+
+	type args struct {
+		ctx     context.Context
+		orderID int64
+	}
+
+	var (
+		mc  = minimock.NewController(t)
+		ctx = context.Background()
+
+		getOrderErr   = errors.New("get error")
+		getHistoryErr = errors.New("get history error")
+
+		orderID = gofakeit.Int64()
+		order   = &Order{
+			ID:     orderID,
+			Status: StatusCancelled,
+			User:   gofakeit.Int64(),
+		}
+		history = []StatusChange{
+			{OrderID: orderID, From: StatusNew, To: StatusAwaitingPayment, Reason: ReasonItemsReserved, CreatedAt: time.Now()},
+			{OrderID: orderID, From: StatusAwaitingPayment, To: StatusCancelled, Reason: ReasonPaymentTimeout, CreatedAt: time.Now()},
+		}
+	)
+	t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name           string
+		args           args
+		want           []StatusChange
+		err            error
+		repositoryMock repositoryMockFunc
+	}{
+		{
+			name: "positive case",
+			args: args{
+				ctx:     ctx,
+				orderID: orderID,
+			},
+			want: history,
+			err:  nil,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetOrderMock.Expect(ctx, orderID).Return(order, nil)
+				mock.GetOrderHistoryMock.Expect(ctx, orderID).Return(history, nil)
+				return mock
+			},
+		},
+		{
+			name: "negative case - get order",
+			args: args{
+				ctx:     ctx,
+				orderID: orderID,
+			},
+			err: getOrderErr,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetOrderMock.Expect(ctx, orderID).Return(nil, getOrderErr)
+				return mock
+			},
+		},
+		{
+			name: "negative case - get history",
+			args: args{
+				ctx:     ctx,
+				orderID: orderID,
+			},
+			err: getHistoryErr,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetOrderMock.Expect(ctx, orderID).Return(order, nil)
+				mock.GetOrderHistoryMock.Expect(ctx, orderID).Return(nil, getHistoryErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			api := NewMock(tt.repositoryMock(mc))
+			history, err := api.GetOrderHistory(tt.args.ctx, tt.args.orderID)
+			require.Equal(t, tt.want, history)
+			if tt.err != nil {
+				require.ErrorContains(t, err, tt.err.Error())
+			} else {
+				require.Equal(t, tt.err, err)
+			}
+		})
+	}
+}
@@ -15,6 +15,7 @@ import (
 func TestOrderPayed(t *testing.T) {
 	type repositoryMockFunc func(mc *minimock.Controller) OrdersRepository
 	type tmMockFunc func(mc *minimock.Controller) TransactionManager
+	type warehousesMockFunc func(mc *minimock.Controller) WarehousesRepository
 
 	type args struct {
 		ctx     context.Context
@@ -30,6 +31,7 @@ func TestOrderPayed(t *testing.T) {
 		getOrderErr = errors.New("get error")
 		updateErr   = errors.New("update error")
 		removeErr   = errors.New("remove error")
+		notifyErr   = errors.New("notification error")
 
 		orderID = gofakeit.Int64()
 		order   = &Order{
@@ -53,6 +55,7 @@ func TestOrderPayed(t *testing.T) {
 		err            error
 		repositoryMock repositoryMockFunc
 		tmMock         tmMockFunc
+		warehousesMock warehousesMockFunc
 	}{
 		{
 			name: "positive case",
@@ -63,9 +66,14 @@ func TestOrderPayed(t *testing.T) {
 			err: nil,
 			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
 				mock := NewOrdersRepositoryMock(t)
-				mock.GetOrderMock.Expect(ctxTx, orderID).Return(order, nil)
+				mock.GetOrderMock.Expect(ctxTx, orderID).Return(cloneOrder(order), nil)
 				mock.UpdateOrderStatusMock.Expect(ctxTx, orderID, StatusPayed, order.Status).Return(nil)
+				mock.AddStatusChangeMock.Expect(ctxTx, StatusChange{OrderID: orderID, From: StatusAwaitingPayment, To: StatusPayed, Reason: ReasonPaymentReceived}).Return(nil)
 				mock.RemoveSoldItemsMock.Expect(ctxTx, orderID).Return(nil)
+				mock.CreateOrderNotificationMock.Set(func(ctx context.Context, order *Order) (err error) {
+					return nil
+				})
+				mock.UpdateSellerOrdersStatusMock.Expect(ctxTx, orderID, nil, StatusPayed).Return(nil)
 				return mock
 			},
 			tmMock: func(mc *minimock.Controller) TransactionManager {
@@ -107,7 +115,7 @@ func TestOrderPayed(t *testing.T) {
 			err: updateErr,
 			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
 				mock := NewOrdersRepositoryMock(t)
-				mock.GetOrderMock.Expect(ctxTx, orderID).Return(order, nil)
+				mock.GetOrderMock.Expect(ctxTx, orderID).Return(cloneOrder(order), nil)
 				mock.UpdateOrderStatusMock.Expect(ctxTx, orderID, StatusPayed, order.Status).Return(updateErr)
 				return mock
 			},
@@ -129,8 +137,13 @@ func TestOrderPayed(t *testing.T) {
 			err: removeErr,
 			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
 				mock := NewOrdersRepositoryMock(t)
-				mock.GetOrderMock.Expect(ctxTx, orderID).Return(order, nil)
+				mock.GetOrderMock.Expect(ctxTx, orderID).Return(cloneOrder(order), nil)
 				mock.UpdateOrderStatusMock.Expect(ctxTx, orderID, StatusPayed, order.Status).Return(nil)
+				mock.AddStatusChangeMock.Expect(ctxTx, StatusChange{OrderID: orderID, From: StatusAwaitingPayment, To: StatusPayed, Reason: ReasonPaymentReceived}).Return(nil)
+				mock.CreateOrderNotificationMock.Set(func(ctx context.Context, order *Order) (err error) {
+					return nil
+				})
+				mock.UpdateSellerOrdersStatusMock.Expect(ctxTx, orderID, nil, StatusPayed).Return(nil)
 				mock.RemoveSoldItemsMock.Expect(ctxTx, orderID).Return(removeErr)
 				return mock
 			},
@@ -143,16 +156,42 @@ func TestOrderPayed(t *testing.T) {
 				return mock
 			},
 		},
+		{
+			name: "negative case - create notification",
+			args: args{
+				ctx:     ctx,
+				orderID: orderID,
+			},
+			err: notifyErr,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetOrderMock.Expect(ctxTx, orderID).Return(cloneOrder(order), nil)
+				mock.UpdateOrderStatusMock.Expect(ctxTx, orderID, StatusPayed, order.Status).Return(nil)
+				mock.AddStatusChangeMock.Expect(ctxTx, StatusChange{OrderID: orderID, From: StatusAwaitingPayment, To: StatusPayed, Reason: ReasonPaymentReceived}).Return(nil)
+				mock.CreateOrderNotificationMock.Set(func(ctx context.Context, order *Order) (err error) {
+					return notifyErr
+				})
+				return mock
+			},
+			tmMock: func(mc *minimock.Controller) TransactionManager {
+				mock := NewTransactionManagerMock(t)
+				mock.RunTransactionMock.Set(func(ctx context.Context, isoLevel string, f func(ctxTX context.Context) error) error {
+					err := f(ctxTx)
+					return err
+				})
+				return mock
+			},
+		},
 		{
 			name: "negative case - wrong status",
 			args: args{
 				ctx:     ctx,
 				orderID: orderID,
 			},
-			err: errWrongStatus,
+			err: ErrIllegalTransition,
 			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
 				mock := NewOrdersRepositoryMock(t)
-				mock.GetOrderMock.Expect(ctxTx, orderID).Return(wrongOrder, nil)
+				mock.GetOrderMock.Expect(ctxTx, orderID).Return(cloneOrder(wrongOrder), nil)
 				return mock
 			},
 			tmMock: func(mc *minimock.Controller) TransactionManager {
@@ -170,9 +209,14 @@ func TestOrderPayed(t *testing.T) {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
+			var warehouses WarehousesRepository = NewWarehousesRepositoryMock(t)
+			if tt.warehousesMock != nil {
+				warehouses = tt.warehousesMock(mc)
+			}
 			api := NewMock(
 				tt.repositoryMock(mc),
 				tt.tmMock(mc),
+				warehouses,
 			)
 			err := api.OrderPayed(tt.args.ctx, tt.args.orderID)
 			if tt.err != nil {
@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"context"
+	"route256/libs/logger"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultPaymentTimeout   = 10 * time.Minute
+	defaultBackorderTimeout = 72 * time.Hour
+	expiredOrdersLimit      = 100
+)
+
+func (d *domain) CancelExpiredOrders(ctx context.Context) error {
+	err := d.cancelExpiredOrders(ctx, StatusAwaitingPayment, ReasonPaymentTimeout)
+	if err != nil {
+		return err
+	}
+	return d.cancelExpiredOrders(ctx, StatusAwaitingStock, ReasonBackorderTimeout)
+}
+
+func (d *domain) cancelExpiredOrders(ctx context.Context, status OrderStatus, reason string) error {
+	ids, err := d.OrdersRepository.GetExpiredOrders(ctx, status, time.Now(), expiredOrdersLimit)
+	if err != nil {
+		return errors.Wrap(err, "get expired orders")
+	}
+	for _, id := range ids {
+		err = d.cancelExpiredOrder(ctx, id, status, reason)
+		//Заказ мог быть оплачен или отменен, пока мы до него дошли
+		if err != nil && !errors.Is(err, ErrIllegalTransition) && !errors.Is(err, ErrOrderNotFound) {
+			logger.Error(ctx, "cancel expired order", zap.Int64("order id", id), zap.Error(err))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,226 @@
+package domain
+
+import (
+	"context"
+	"route256/libs/logger"
+	transactor "route256/libs/postgres_transactor"
+	txMock "route256/libs/postgres_transactor/mocks"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCancelExpiredOrders(t *testing.T) {
+	logger.Init(true)
+
+	type repositoryMockFunc func(mc *minimock.Controller) OrdersRepository
+	type tmMockFunc func(mc *minimock.Controller) TransactionManager
+
+	type args struct {
+		ctx context.Context
+	}
+
+	var (
+		mc    = minimock.NewController(t)
+		tx    = txMock.NewTxMock(t)
+		ctx   = context.Background()
+		ctxTx = context.WithValue(ctx, transactor.TxKey("tx"), tx)
+
+		expiredErr = errors.New("expired error")
+		cancelErr  = errors.New("cancel error")
+
+		orderID = gofakeit.Int64()
+		order   = &Order{
+			ID:     orderID,
+			Status: StatusAwaitingPayment,
+			User:   gofakeit.Int64(),
+			Items:  nil,
+		}
+		payedOrder = &Order{
+			ID:     orderID,
+			Status: StatusPayed,
+			User:   gofakeit.Int64(),
+			Items:  nil,
+		}
+		backorderedOrder = &Order{
+			ID:     orderID,
+			Status: StatusAwaitingStock,
+			User:   gofakeit.Int64(),
+			Items:  nil,
+		}
+
+		//Просроченный заказ есть только в переданном статусе
+		expired = func(expiredStatus OrderStatus) func(ctx context.Context, status OrderStatus, now time.Time, limit uint64) ([]int64, error) {
+			return func(ctx context.Context, status OrderStatus, now time.Time, limit uint64) ([]int64, error) {
+				if status != expiredStatus {
+					return nil, nil
+				}
+				return []int64{orderID}, nil
+			}
+		}
+	)
+	t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name           string
+		args           args
+		err            error
+		repositoryMock repositoryMockFunc
+		tmMock         tmMockFunc
+	}{
+		{
+			name: "positive case",
+			args: args{
+				ctx: ctx,
+			},
+			err: nil,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetExpiredOrdersMock.Set(expired(StatusAwaitingPayment))
+				mock.GetOrderMock.Expect(ctxTx, orderID).Return(cloneOrder(order), nil)
+				mock.UpdateOrderStatusMock.Expect(ctxTx, orderID, StatusCancelled, StatusAwaitingPayment).Return(nil)
+				mock.AddStatusChangeMock.Expect(ctxTx, StatusChange{OrderID: orderID, From: StatusAwaitingPayment, To: StatusCancelled, Reason: ReasonPaymentTimeout}).Return(nil)
+				mock.UpdateSellerOrdersStatusMock.Expect(ctxTx, orderID, nil, StatusCancelled).Return(nil)
+				mock.UnReserveItemsMock.Expect(ctxTx, orderID).Return(nil, nil)
+				mock.CreateOrderNotificationMock.Return(nil)
+				return mock
+			},
+			tmMock: func(mc *minimock.Controller) TransactionManager {
+				mock := NewTransactionManagerMock(t)
+				mock.RunTransactionMock.Set(func(ctx context.Context, isoLevel string, f func(ctxTX context.Context) error) error {
+					err := f(ctxTx)
+					return err
+				})
+				return mock
+			},
+		},
+		{
+			name: "positive case - already payed",
+			args: args{
+				ctx: ctx,
+			},
+			err: nil,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetExpiredOrdersMock.Set(expired(StatusAwaitingPayment))
+				mock.GetOrderMock.Expect(ctxTx, orderID).Return(cloneOrder(payedOrder), nil)
+				return mock
+			},
+			tmMock: func(mc *minimock.Controller) TransactionManager {
+				mock := NewTransactionManagerMock(t)
+				mock.RunTransactionMock.Set(func(ctx context.Context, isoLevel string, f func(ctxTX context.Context) error) error {
+					err := f(ctxTx)
+					return err
+				})
+				return mock
+			},
+		},
+		{
+			name: "positive case - backorder timeout",
+			args: args{
+				ctx: ctx,
+			},
+			err: nil,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetExpiredOrdersMock.Set(expired(StatusAwaitingStock))
+				mock.GetOrderMock.Expect(ctxTx, orderID).Return(cloneOrder(backorderedOrder), nil)
+				mock.UpdateOrderStatusMock.Expect(ctxTx, orderID, StatusCancelled, StatusAwaitingStock).Return(nil)
+				mock.AddStatusChangeMock.Expect(ctxTx, StatusChange{OrderID: orderID, From: StatusAwaitingStock, To: StatusCancelled, Reason: ReasonBackorderTimeout}).Return(nil)
+				mock.UpdateSellerOrdersStatusMock.Expect(ctxTx, orderID, nil, StatusCancelled).Return(nil)
+				mock.UnReserveItemsMock.Expect(ctxTx, orderID).Return(nil, nil)
+				mock.DeleteBackordersMock.Expect(ctxTx, orderID).Return(nil)
+				mock.CreateOrderNotificationMock.Return(nil)
+				return mock
+			},
+			tmMock: func(mc *minimock.Controller) TransactionManager {
+				mock := NewTransactionManagerMock(t)
+				mock.RunTransactionMock.Set(func(ctx context.Context, isoLevel string, f func(ctxTX context.Context) error) error {
+					return f(ctxTx)
+				})
+				return mock
+			},
+		},
+		{
+			name: "positive case - backorder filled meanwhile",
+			args: args{
+				ctx: ctx,
+			},
+			err: nil,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetExpiredOrdersMock.Set(expired(StatusAwaitingStock))
+				mock.GetOrderMock.Expect(ctxTx, orderID).Return(cloneOrder(order), nil)
+				return mock
+			},
+			tmMock: func(mc *minimock.Controller) TransactionManager {
+				mock := NewTransactionManagerMock(t)
+				mock.RunTransactionMock.Set(func(ctx context.Context, isoLevel string, f func(ctxTX context.Context) error) error {
+					return f(ctxTx)
+				})
+				return mock
+			},
+		},
+		{
+			name: "positive case - cancel error is logged",
+			args: args{
+				ctx: ctx,
+			},
+			err: nil,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetExpiredOrdersMock.Set(expired(StatusAwaitingPayment))
+				mock.GetOrderMock.Expect(ctxTx, orderID).Return(nil, cancelErr)
+				return mock
+			},
+			tmMock: func(mc *minimock.Controller) TransactionManager {
+				mock := NewTransactionManagerMock(t)
+				mock.RunTransactionMock.Set(func(ctx context.Context, isoLevel string, f func(ctxTX context.Context) error) error {
+					err := f(ctxTx)
+					return err
+				})
+				return mock
+			},
+		},
+		{
+			name: "negative case - get expired orders",
+			args: args{
+				ctx: ctx,
+			},
+			err: expiredErr,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetExpiredOrdersMock.Set(func(ctx context.Context, status OrderStatus, now time.Time, limit uint64) ([]int64, error) {
+					return nil, expiredErr
+				})
+				return mock
+			},
+			tmMock: func(mc *minimock.Controller) TransactionManager {
+				mock := NewTransactionManagerMock(t)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			api := NewMock(
+				tt.repositoryMock(mc),
+				tt.tmMock(mc),
+			)
+			err := api.CancelExpiredOrders(tt.args.ctx)
+			if tt.err != nil {
+				require.ErrorContains(t, err, tt.err.Error())
+			} else {
+				require.Equal(t, tt.err, err)
+			}
+		})
+	}
+
+}
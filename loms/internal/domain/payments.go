@@ -0,0 +1,203 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+type PaymentStatus string
+
+const (
+	PaymentPending   PaymentStatus = "pending"
+	PaymentSucceeded PaymentStatus = "succeeded"
+	PaymentFailed    PaymentStatus = "failed"
+	PaymentRefunded  PaymentStatus = "refunded"
+)
+
+const refundsBatchSize = 100
+
+var (
+	ErrPaymentNotFound = errors.New("payment not found")
+	ErrPaymentExists   = errors.New("order already has an active payment")
+	ErrPaymentFinished = errors.New("payment is already finished")
+)
+
+type Payment struct {
+	//Идентификатор платежа у провайдера
+	ID              string
+	OrderID         int64
+	Amount          uint64
+	Status          PaymentStatus
+	ConfirmationURL string
+	//Сколько уже возвращено покупателю
+	Refunded uint64
+}
+
+type Refund struct {
+	ID        int64
+	PaymentID string
+	Amount    uint64
+}
+
+type PaymentIntent struct {
+	OrderID int64
+	Amount  uint64
+}
+
+type PaymentProvider interface {
+	CreatePayment(ctx context.Context, intent PaymentIntent) (*Payment, error)
+	//Повторяется для того же id возврата, если отметка об отправке не сохранилась
+	Refund(ctx context.Context, refund Refund) error
+}
+
+type Product struct {
+	Name  string
+	Price uint32
+}
+
+type ProductService interface {
+	GetProduct(ctx context.Context, sku uint32) (Product, error)
+}
+
+func (d *domain) InitiatePayment(ctx context.Context, orderID int64) (*Payment, error) {
+	order, err := d.OrdersRepository.GetOrder(ctx, orderID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get order")
+	}
+	err = Transition(order.Status, StatusPayed)
+	if err != nil {
+		return nil, err
+	}
+	payment, err := d.PaymentsRepository.GetActivePayment(ctx, orderID)
+	if err == nil {
+		return payment, nil
+	}
+	if !errors.Is(err, ErrPaymentNotFound) {
+		return nil, errors.Wrap(err, "get active payment")
+	}
+	amount, err := d.itemsAmount(ctx, order.Items)
+	if err != nil {
+		return nil, err
+	}
+	payment, err = d.PaymentProvider.CreatePayment(ctx, PaymentIntent{OrderID: orderID, Amount: amount})
+	if err != nil {
+		return nil, errors.Wrap(err, "create provider payment")
+	}
+	payment.OrderID = orderID
+	payment.Amount = amount
+	payment.Status = PaymentPending
+	err = d.PaymentsRepository.CreatePayment(ctx, payment)
+	//Параллельный запрос успел создать платеж раньше, созданный у провайдера останется неоплаченным
+	if errors.Is(err, ErrPaymentExists) {
+		return d.PaymentsRepository.GetActivePayment(ctx, orderID)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "save payment")
+	}
+	return payment, nil
+}
+
+func (d *domain) itemsAmount(ctx context.Context, items []OrderItem) (uint64, error) {
+	var amount uint64
+	for _, item := range items {
+		price := item.Price
+		if price == 0 {
+			product, err := d.ProductService.GetProduct(ctx, item.Sku)
+			if err != nil {
+				return 0, errors.Wrap(err, "get product")
+			}
+			price = product.Price
+		}
+		amount += uint64(price) * uint64(item.Count)
+	}
+	return amount, nil
+}
+
+func (d *domain) ConfirmPayment(ctx context.Context, paymentID string, succeeded bool) error {
+	status := PaymentFailed
+	if succeeded {
+		status = PaymentSucceeded
+	}
+	err := d.TransactionManager.RunTransaction(ctx, isoLevelSerializable, func(ctxTX context.Context) error {
+		payment, err := d.PaymentsRepository.GetPayment(ctxTX, paymentID)
+		if err != nil {
+			return errors.Wrap(err, "get payment")
+		}
+		//Провайдер повторяет уведомление, пока не получит успешный ответ
+		if payment.Status == status || succeeded && payment.Status == PaymentRefunded {
+			return nil
+		}
+		if payment.Status != PaymentPending {
+			return ErrPaymentFinished
+		}
+		err = d.PaymentsRepository.UpdatePaymentStatus(ctxTX, paymentID, status, PaymentPending)
+		if err != nil {
+			return errors.Wrap(err, "update payment status")
+		}
+		order, err := d.OrdersRepository.GetOrder(ctxTX, payment.OrderID)
+		if err != nil {
+			return errors.Wrap(err, "get order")
+		}
+		switch {
+		case order.Status != StatusAwaitingPayment && !succeeded:
+			return nil
+		case order.Status != StatusAwaitingPayment:
+			return d.refundPayment(ctxTX, payment, payment.Amount)
+		case !succeeded:
+			return d.cancelUnpaidOrder(ctxTX, order, ReasonPaymentFailed)
+		default:
+			return d.payOrder(ctxTX, order)
+		}
+	})
+	if err != nil {
+		return errors.Wrap(err, "confirm payment")
+	}
+	return nil
+}
+
+func (d *domain) refundPayment(ctxTX context.Context, payment *Payment, amount uint64) error {
+	err := d.PaymentsRepository.CreateRefund(ctxTX, payment.ID, amount)
+	if err != nil {
+		return errors.Wrap(err, "create refund")
+	}
+	if payment.Refunded+amount < payment.Amount {
+		return nil
+	}
+	err = d.PaymentsRepository.UpdatePaymentStatus(ctxTX, payment.ID, PaymentRefunded, PaymentSucceeded)
+	if err != nil {
+		return errors.Wrap(err, "update payment status")
+	}
+	return nil
+}
+
+func (d *domain) SendRefunds(ctx context.Context) error {
+	var sendErr error
+	err := d.TransactionManager.RunTransaction(ctx, isoLevelReadCommitted, func(ctxTX context.Context) error {
+		refunds, err := d.PaymentsRepository.GetUnsentRefunds(ctxTX, refundsBatchSize)
+		if err != nil {
+			return errors.Wrap(err, "get unsent refunds")
+		}
+		sent := make([]int64, 0, len(refunds))
+		for _, refund := range refunds {
+			sendErr = d.PaymentProvider.Refund(ctx, refund)
+			if sendErr != nil {
+				sendErr = errors.Wrap(sendErr, "refund payment")
+				break
+			}
+			sent = append(sent, refund.ID)
+		}
+		if len(sent) == 0 {
+			return nil
+		}
+		err = d.PaymentsRepository.MarkRefundsSent(ctxTX, sent)
+		if err != nil {
+			return errors.Wrap(err, "mark refunds sent")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return sendErr
+}
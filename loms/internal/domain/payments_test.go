@@ -0,0 +1,514 @@
+package domain
+
+import (
+	"context"
+	transactor "route256/libs/postgres_transactor"
+	txMock "route256/libs/postgres_transactor/mocks"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitiatePayment(t *testing.T) {
+	type repositoryMockFunc func(mc *minimock.Controller) OrdersRepository
+	type paymentsMockFunc func(mc *minimock.Controller) PaymentsRepository
+	type providerMockFunc func(mc *minimock.Controller) PaymentProvider
+	type productsMockFunc func(mc *minimock.Controller) ProductService
+
+	var (
+		mc  = minimock.NewController(t)
+		ctx = context.Background()
+
+		providerErr = errors.New("provider error")
+
+		orderID = gofakeit.Int64()
+		order   = &Order{
+			ID:     orderID,
+			Status: StatusAwaitingPayment,
+			Items: []OrderItem{
+				{Sku: 1, Count: 2},
+				{Sku: 2, Count: 1},
+			},
+		}
+		paidOrder = &Order{
+			ID:     orderID,
+			Status: StatusPayed,
+		}
+		created = &Payment{
+			ID:              gofakeit.UUID(),
+			ConfirmationURL: gofakeit.URL(),
+		}
+		pending = &Payment{
+			ID:      created.ID,
+			OrderID: orderID,
+			Amount:  250,
+			Status:  PaymentPending,
+		}
+		prices = func(mc *minimock.Controller) ProductService {
+			mock := NewProductServiceMock(t)
+			mock.GetProductMock.When(ctx, 1).Then(Product{Price: 100}, nil)
+			mock.GetProductMock.When(ctx, 2).Then(Product{Price: 50}, nil)
+			return mock
+		}
+	)
+	t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name           string
+		want           *Payment
+		err            error
+		repositoryMock repositoryMockFunc
+		paymentsMock   paymentsMockFunc
+		providerMock   providerMockFunc
+		productsMock   productsMockFunc
+	}{
+		{
+			name: "positive case",
+			want: &Payment{
+				ID:              created.ID,
+				OrderID:         orderID,
+				Amount:          250,
+				Status:          PaymentPending,
+				ConfirmationURL: created.ConfirmationURL,
+			},
+			err: nil,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetOrderMock.Expect(ctx, orderID).Return(cloneOrder(order), nil)
+				return mock
+			},
+			paymentsMock: func(mc *minimock.Controller) PaymentsRepository {
+				mock := NewPaymentsRepositoryMock(t)
+				mock.GetActivePaymentMock.Expect(ctx, orderID).Return(nil, ErrPaymentNotFound)
+				mock.CreatePaymentMock.Return(nil)
+				return mock
+			},
+			providerMock: func(mc *minimock.Controller) PaymentProvider {
+				mock := NewPaymentProviderMock(t)
+				payment := *created
+				mock.CreatePaymentMock.Expect(ctx, PaymentIntent{OrderID: orderID, Amount: 250}).Return(&payment, nil)
+				return mock
+			},
+			productsMock: prices,
+		},
+		{
+			name: "positive case - captured prices",
+			want: &Payment{
+				ID:              created.ID,
+				OrderID:         orderID,
+				Amount:          270,
+				Status:          PaymentPending,
+				ConfirmationURL: created.ConfirmationURL,
+			},
+			err: nil,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetOrderMock.Expect(ctx, orderID).Return(&Order{
+					ID:     orderID,
+					Status: StatusAwaitingPayment,
+					Items: []OrderItem{
+						{Sku: 1, Count: 2, Price: 110},
+						{Sku: 2, Count: 1},
+					},
+				}, nil)
+				return mock
+			},
+			paymentsMock: func(mc *minimock.Controller) PaymentsRepository {
+				mock := NewPaymentsRepositoryMock(t)
+				mock.GetActivePaymentMock.Expect(ctx, orderID).Return(nil, ErrPaymentNotFound)
+				mock.CreatePaymentMock.Return(nil)
+				return mock
+			},
+			providerMock: func(mc *minimock.Controller) PaymentProvider {
+				mock := NewPaymentProviderMock(t)
+				payment := *created
+				mock.CreatePaymentMock.Expect(ctx, PaymentIntent{OrderID: orderID, Amount: 270}).Return(&payment, nil)
+				return mock
+			},
+			productsMock: func(mc *minimock.Controller) ProductService {
+				mock := NewProductServiceMock(t)
+				mock.GetProductMock.Expect(ctx, 2).Return(Product{Price: 50}, nil)
+				return mock
+			},
+		},
+		{
+			name: "positive case - pending payment",
+			want: pending,
+			err:  nil,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetOrderMock.Expect(ctx, orderID).Return(cloneOrder(order), nil)
+				return mock
+			},
+			paymentsMock: func(mc *minimock.Controller) PaymentsRepository {
+				mock := NewPaymentsRepositoryMock(t)
+				mock.GetActivePaymentMock.Expect(ctx, orderID).Return(pending, nil)
+				return mock
+			},
+			providerMock: func(mc *minimock.Controller) PaymentProvider {
+				return NewPaymentProviderMock(t)
+			},
+			productsMock: func(mc *minimock.Controller) ProductService {
+				return NewProductServiceMock(t)
+			},
+		},
+		{
+			name: "positive case - concurrent payment",
+			want: pending,
+			err:  nil,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetOrderMock.Expect(ctx, orderID).Return(cloneOrder(order), nil)
+				return mock
+			},
+			paymentsMock: func(mc *minimock.Controller) PaymentsRepository {
+				mock := NewPaymentsRepositoryMock(t)
+				calls := 0
+				mock.GetActivePaymentMock.Set(func(ctx context.Context, orderID int64) (*Payment, error) {
+					calls++
+					if calls == 1 {
+						return nil, ErrPaymentNotFound
+					}
+					return pending, nil
+				})
+				mock.CreatePaymentMock.Return(ErrPaymentExists)
+				return mock
+			},
+			providerMock: func(mc *minimock.Controller) PaymentProvider {
+				mock := NewPaymentProviderMock(t)
+				payment := *created
+				mock.CreatePaymentMock.Return(&payment, nil)
+				return mock
+			},
+			productsMock: prices,
+		},
+		{
+			name: "negative case - order is paid",
+			want: nil,
+			err:  ErrIllegalTransition,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetOrderMock.Expect(ctx, orderID).Return(cloneOrder(paidOrder), nil)
+				return mock
+			},
+			paymentsMock: func(mc *minimock.Controller) PaymentsRepository {
+				return NewPaymentsRepositoryMock(t)
+			},
+			providerMock: func(mc *minimock.Controller) PaymentProvider {
+				return NewPaymentProviderMock(t)
+			},
+			productsMock: func(mc *minimock.Controller) ProductService {
+				return NewProductServiceMock(t)
+			},
+		},
+		{
+			name: "negative case - provider",
+			want: nil,
+			err:  providerErr,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetOrderMock.Expect(ctx, orderID).Return(cloneOrder(order), nil)
+				return mock
+			},
+			paymentsMock: func(mc *minimock.Controller) PaymentsRepository {
+				mock := NewPaymentsRepositoryMock(t)
+				mock.GetActivePaymentMock.Expect(ctx, orderID).Return(nil, ErrPaymentNotFound)
+				return mock
+			},
+			providerMock: func(mc *minimock.Controller) PaymentProvider {
+				mock := NewPaymentProviderMock(t)
+				mock.CreatePaymentMock.Return(nil, providerErr)
+				return mock
+			},
+			productsMock: prices,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			api := NewMock(
+				tt.repositoryMock(mc),
+				tt.paymentsMock(mc),
+				tt.providerMock(mc),
+				tt.productsMock(mc),
+			)
+			payment, err := api.InitiatePayment(ctx, orderID)
+			require.Equal(t, tt.want, payment)
+			if tt.err != nil {
+				require.ErrorContains(t, err, tt.err.Error())
+			} else {
+				require.Equal(t, tt.err, err)
+			}
+		})
+	}
+}
+
+func TestConfirmPayment(t *testing.T) {
+	type repositoryMockFunc func(mc *minimock.Controller) OrdersRepository
+	type paymentsMockFunc func(mc *minimock.Controller) PaymentsRepository
+	type providerMockFunc func(mc *minimock.Controller) PaymentProvider
+	type warehousesMockFunc func(mc *minimock.Controller) WarehousesRepository
+
+	type args struct {
+		succeeded bool
+	}
+
+	var (
+		mc    = minimock.NewController(t)
+		tx    = txMock.NewTxMock(t)
+		ctx   = context.Background()
+		ctxTx = context.WithValue(ctx, transactor.TxKey("tx"), tx)
+
+		orderID   = gofakeit.Int64()
+		paymentID = gofakeit.UUID()
+		payment   = func(status PaymentStatus) *Payment {
+			return &Payment{ID: paymentID, OrderID: orderID, Amount: 100, Status: status}
+		}
+		order = &Order{
+			ID:     orderID,
+			Status: StatusAwaitingPayment,
+		}
+		cancelledOrder = &Order{
+			ID:     orderID,
+			Status: StatusCancelled,
+		}
+
+		noProvider = func(mc *minimock.Controller) PaymentProvider {
+			return NewPaymentProviderMock(t)
+		}
+	)
+	t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name           string
+		args           args
+		err            error
+		repositoryMock repositoryMockFunc
+		paymentsMock   paymentsMockFunc
+		providerMock   providerMockFunc
+		warehousesMock warehousesMockFunc
+	}{
+		{
+			name: "positive case - succeeded",
+			args: args{succeeded: true},
+			err:  nil,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetOrderMock.Expect(ctxTx, orderID).Return(cloneOrder(order), nil)
+				mock.UpdateOrderStatusMock.Expect(ctxTx, orderID, StatusPayed, StatusAwaitingPayment).Return(nil)
+				mock.AddStatusChangeMock.Expect(ctxTx, StatusChange{OrderID: orderID, From: StatusAwaitingPayment, To: StatusPayed, Reason: ReasonPaymentReceived}).Return(nil)
+				mock.CreateOrderNotificationMock.Return(nil)
+				mock.RemoveSoldItemsMock.Expect(ctxTx, orderID).Return(nil)
+				mock.UpdateSellerOrdersStatusMock.Expect(ctxTx, orderID, nil, StatusPayed).Return(nil)
+				return mock
+			},
+			paymentsMock: func(mc *minimock.Controller) PaymentsRepository {
+				mock := NewPaymentsRepositoryMock(t)
+				mock.GetPaymentMock.Expect(ctxTx, paymentID).Return(payment(PaymentPending), nil)
+				mock.UpdatePaymentStatusMock.Expect(ctxTx, paymentID, PaymentSucceeded, PaymentPending).Return(nil)
+				return mock
+			},
+			providerMock: noProvider,
+		},
+		{
+			name: "positive case - failed cancels order",
+			args: args{succeeded: false},
+			err:  nil,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetOrderMock.Expect(ctxTx, orderID).Return(cloneOrder(order), nil)
+				mock.UpdateOrderStatusMock.Expect(ctxTx, orderID, StatusCancelled, StatusAwaitingPayment).Return(nil)
+				mock.AddStatusChangeMock.Expect(ctxTx, StatusChange{OrderID: orderID, From: StatusAwaitingPayment, To: StatusCancelled, Reason: ReasonPaymentFailed}).Return(nil)
+				mock.CreateOrderNotificationMock.Return(nil)
+				mock.UpdateSellerOrdersStatusMock.Expect(ctxTx, orderID, nil, StatusCancelled).Return(nil)
+				mock.UnReserveItemsMock.Expect(ctxTx, orderID).Return(nil, nil)
+				return mock
+			},
+			paymentsMock: func(mc *minimock.Controller) PaymentsRepository {
+				mock := NewPaymentsRepositoryMock(t)
+				mock.GetPaymentMock.Expect(ctxTx, paymentID).Return(payment(PaymentPending), nil)
+				mock.UpdatePaymentStatusMock.Expect(ctxTx, paymentID, PaymentFailed, PaymentPending).Return(nil)
+				return mock
+			},
+			providerMock: noProvider,
+		},
+		{
+			name: "positive case - repeated notification",
+			args: args{succeeded: true},
+			err:  nil,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				return NewOrdersRepositoryMock(t)
+			},
+			paymentsMock: func(mc *minimock.Controller) PaymentsRepository {
+				mock := NewPaymentsRepositoryMock(t)
+				mock.GetPaymentMock.Expect(ctxTx, paymentID).Return(payment(PaymentSucceeded), nil)
+				return mock
+			},
+			providerMock: noProvider,
+		},
+		{
+			name: "positive case - order cancelled before payment",
+			args: args{succeeded: true},
+			err:  nil,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetOrderMock.Expect(ctxTx, orderID).Return(cloneOrder(cancelledOrder), nil)
+				return mock
+			},
+			paymentsMock: func(mc *minimock.Controller) PaymentsRepository {
+				mock := NewPaymentsRepositoryMock(t)
+				mock.GetPaymentMock.Expect(ctxTx, paymentID).Return(payment(PaymentPending), nil)
+				mock.UpdatePaymentStatusMock.When(ctxTx, paymentID, PaymentSucceeded, PaymentPending).Then(nil)
+				mock.CreateRefundMock.Expect(ctxTx, paymentID, 100).Return(nil)
+				mock.UpdatePaymentStatusMock.When(ctxTx, paymentID, PaymentRefunded, PaymentSucceeded).Then(nil)
+				return mock
+			},
+			providerMock: noProvider,
+		},
+		{
+			name: "negative case - payment finished",
+			args: args{succeeded: true},
+			err:  ErrPaymentFinished,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				return NewOrdersRepositoryMock(t)
+			},
+			paymentsMock: func(mc *minimock.Controller) PaymentsRepository {
+				mock := NewPaymentsRepositoryMock(t)
+				mock.GetPaymentMock.Expect(ctxTx, paymentID).Return(payment(PaymentFailed), nil)
+				return mock
+			},
+			providerMock: noProvider,
+		},
+		{
+			name: "negative case - payment not found",
+			args: args{succeeded: true},
+			err:  ErrPaymentNotFound,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				return NewOrdersRepositoryMock(t)
+			},
+			paymentsMock: func(mc *minimock.Controller) PaymentsRepository {
+				mock := NewPaymentsRepositoryMock(t)
+				mock.GetPaymentMock.Expect(ctxTx, paymentID).Return(nil, ErrPaymentNotFound)
+				return mock
+			},
+			providerMock: noProvider,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tm := NewTransactionManagerMock(t)
+			tm.RunTransactionMock.Set(func(ctx context.Context, isoLevel string, f func(ctxTX context.Context) error) error {
+				return f(ctxTx)
+			})
+			var warehouses WarehousesRepository = NewWarehousesRepositoryMock(t)
+			if tt.warehousesMock != nil {
+				warehouses = tt.warehousesMock(mc)
+			}
+			api := NewMock(
+				tt.repositoryMock(mc),
+				tt.paymentsMock(mc),
+				tt.providerMock(mc),
+				warehouses,
+				tm,
+			)
+			err := api.ConfirmPayment(ctx, paymentID, tt.args.succeeded)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.Equal(t, tt.err, err)
+			}
+		})
+	}
+}
+
+func TestSendRefunds(t *testing.T) {
+	type paymentsMockFunc func(mc *minimock.Controller) PaymentsRepository
+	type providerMockFunc func(mc *minimock.Controller) PaymentProvider
+
+	var (
+		mc    = minimock.NewController(t)
+		tx    = txMock.NewTxMock(t)
+		ctx   = context.Background()
+		ctxTx = context.WithValue(ctx, transactor.TxKey("tx"), tx)
+
+		refundErr = errors.New("refund error")
+
+		first  = Refund{ID: 1, PaymentID: gofakeit.UUID(), Amount: 100}
+		second = Refund{ID: 2, PaymentID: gofakeit.UUID(), Amount: 50}
+	)
+	t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name         string
+		err          error
+		paymentsMock paymentsMockFunc
+		providerMock providerMockFunc
+	}{
+		{
+			name: "positive case",
+			err:  nil,
+			paymentsMock: func(mc *minimock.Controller) PaymentsRepository {
+				mock := NewPaymentsRepositoryMock(t)
+				mock.GetUnsentRefundsMock.Expect(ctxTx, refundsBatchSize).Return([]Refund{first, second}, nil)
+				mock.MarkRefundsSentMock.Expect(ctxTx, []int64{first.ID, second.ID}).Return(nil)
+				return mock
+			},
+			providerMock: func(mc *minimock.Controller) PaymentProvider {
+				mock := NewPaymentProviderMock(t)
+				mock.RefundMock.When(ctx, first).Then(nil)
+				mock.RefundMock.When(ctx, second).Then(nil)
+				return mock
+			},
+		},
+		{
+			name: "positive case - nothing to send",
+			err:  nil,
+			paymentsMock: func(mc *minimock.Controller) PaymentsRepository {
+				mock := NewPaymentsRepositoryMock(t)
+				mock.GetUnsentRefundsMock.Expect(ctxTx, refundsBatchSize).Return(nil, nil)
+				return mock
+			},
+			providerMock: func(mc *minimock.Controller) PaymentProvider {
+				return NewPaymentProviderMock(t)
+			},
+		},
+		{
+			name: "negative case - declined refund stays unsent",
+			err:  refundErr,
+			paymentsMock: func(mc *minimock.Controller) PaymentsRepository {
+				mock := NewPaymentsRepositoryMock(t)
+				mock.GetUnsentRefundsMock.Expect(ctxTx, refundsBatchSize).Return([]Refund{first, second}, nil)
+				mock.MarkRefundsSentMock.Expect(ctxTx, []int64{first.ID}).Return(nil)
+				return mock
+			},
+			providerMock: func(mc *minimock.Controller) PaymentProvider {
+				mock := NewPaymentProviderMock(t)
+				mock.RefundMock.When(ctx, first).Then(nil)
+				mock.RefundMock.When(ctx, second).Then(refundErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tm := NewTransactionManagerMock(t)
+			tm.RunTransactionMock.Set(func(ctx context.Context, isoLevel string, f func(ctxTX context.Context) error) error {
+				return f(ctxTx)
+			})
+			api := NewMock(tt.paymentsMock(mc), tt.providerMock(mc), tm)
+			err := api.SendRefunds(ctx)
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
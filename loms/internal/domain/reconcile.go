@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+type StockDrift struct {
+	WarehouseID int64
+	Sku         uint32
+	//Текущие значения из stocks
+	Count    int64
+	Reserved int64
+	Held     int64
+	//Значения, пересчитанные по журналу stock_movements
+	LedgerCount    int64
+	LedgerReserved int64
+	LedgerHeld     int64
+	//Сумма резервов заказов из reserved_items и холдов корзин из stock_holds
+	ReservedItems int64
+	Holds         int64
+}
+
+func (d *domain) ReconcileStocks(ctx context.Context) ([]StockDrift, error) {
+	drifts, err := d.WarehousesRepository.StockDrifts(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "get stock drifts")
+	}
+	return drifts, nil
+}
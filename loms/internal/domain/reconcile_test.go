@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReconcileStocks(t *testing.T) {
+	type warehousesMockFunc func(mc *minimock.Controller) WarehousesRepository
+
+	var (
+		mc  = minimock.NewController(t)
+		ctx = context.Background()
+
+		repoErr = errors.New("repo error")
+		drifts  = []StockDrift{{
+			WarehouseID:    gofakeit.Int64(),
+			Sku:            gofakeit.Uint32(),
+			Count:          10,
+			Reserved:       3,
+			LedgerCount:    12,
+			LedgerReserved: 3,
+			ReservedItems:  3,
+		}}
+	)
+	t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name           string
+		want           []StockDrift
+		err            error
+		warehousesMock warehousesMockFunc
+	}{
+		{
+			name: "positive case",
+			want: drifts,
+			err:  nil,
+			warehousesMock: func(mc *minimock.Controller) WarehousesRepository {
+				mock := NewWarehousesRepositoryMock(t)
+				mock.StockDriftsMock.Expect(ctx).Return(drifts, nil)
+				return mock
+			},
+		},
+		{
+			name: "negative case - repository error",
+			want: nil,
+			err:  repoErr,
+			warehousesMock: func(mc *minimock.Controller) WarehousesRepository {
+				mock := NewWarehousesRepositoryMock(t)
+				mock.StockDriftsMock.Expect(ctx).Return(nil, repoErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			api := NewMock(tt.warehousesMock(mc))
+			res, err := api.ReconcileStocks(ctx)
+			require.Equal(t, tt.want, res)
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
@@ -0,0 +1,216 @@
+package domain
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	StrategyGreedy             = "greedy"
+	StrategyFewestWarehouses   = "fewest_warehouses"
+	StrategyPreferredWarehouse = "preferred_warehouse"
+)
+
+var ErrUnknownStrategy = errors.New("unknown reservation strategy")
+
+type ReservationStrategy interface {
+	Plan(order *Order, stocks map[uint32][]Stock) ([]ReservedItem, error)
+}
+
+func NewReservationStrategy(name string) (ReservationStrategy, error) {
+	switch name {
+	case "", StrategyGreedy:
+		return greedyStrategy{}, nil
+	case StrategyFewestWarehouses:
+		return fewestWarehousesStrategy{}, nil
+	case StrategyPreferredWarehouse:
+		return preferredWarehouseStrategy{}, nil
+	default:
+		return nil, errors.Wrap(ErrUnknownStrategy, name)
+	}
+}
+
+type greedyStrategy struct{}
+
+func (greedyStrategy) Plan(order *Order, stocks map[uint32][]Stock) ([]ReservedItem, error) {
+	items, err := mergeItems(order.Items)
+	if err != nil {
+		return nil, err
+	}
+	var reserveFrom []ReservedItem
+	for _, item := range items {
+		reserved, err := takeItem(item, sellerStocks(stocks[item.Sku], item.SellerID))
+		if err != nil {
+			return nil, err
+		}
+		reserveFrom = append(reserveFrom, reserved...)
+	}
+	return reserveFrom, nil
+}
+
+type preferredWarehouseStrategy struct{}
+
+func (preferredWarehouseStrategy) Plan(order *Order, stocks map[uint32][]Stock) ([]ReservedItem, error) {
+	if order.PreferredWarehouseID == 0 {
+		return greedyStrategy{}.Plan(order, stocks)
+	}
+	items, err := mergeItems(order.Items)
+	if err != nil {
+		return nil, err
+	}
+	var reserveFrom []ReservedItem
+	for _, item := range items {
+		available := sellerStocks(stocks[item.Sku], item.SellerID)
+		ordered := make([]Stock, 0, len(available))
+		for _, stock := range available {
+			if stock.WarehouseID == order.PreferredWarehouseID {
+				ordered = append([]Stock{stock}, ordered...)
+			} else {
+				ordered = append(ordered, stock)
+			}
+		}
+		reserved, err := takeItem(item, ordered)
+		if err != nil {
+			return nil, err
+		}
+		reserveFrom = append(reserveFrom, reserved...)
+	}
+	return reserveFrom, nil
+}
+
+type fewestWarehousesStrategy struct{}
+
+func (fewestWarehousesStrategy) Plan(order *Order, stocks map[uint32][]Stock) ([]ReservedItem, error) {
+	items, err := mergeItems(order.Items)
+	if err != nil {
+		return nil, err
+	}
+	remaining := make(map[offer]uint64, len(items))
+	available := make(map[int64]map[uint32]uint64)
+	sellers := make(map[int64]int64)
+	for _, item := range items {
+		remaining[itemOffer(item)] += uint64(item.Count)
+		for _, stock := range sellerStocks(stocks[item.Sku], item.SellerID) {
+			if available[stock.WarehouseID] == nil {
+				available[stock.WarehouseID] = make(map[uint32]uint64)
+			}
+			available[stock.WarehouseID][item.Sku] = stock.Count
+			sellers[stock.WarehouseID] = stock.SellerID
+		}
+	}
+	warehouses := make([]int64, 0, len(available))
+	for id := range available {
+		warehouses = append(warehouses, id)
+	}
+	//Сортировка нужна, чтобы при равном покрытии выбор склада был детерминированным
+	sort.Slice(warehouses, func(i, j int) bool { return warehouses[i] < warehouses[j] })
+
+	taken := make(map[offer][]ReservedItem, len(items))
+	for left := sum(remaining); left > 0; left = sum(remaining) {
+		var best int64
+		var bestCover uint64
+		for _, id := range warehouses {
+			var cover uint64
+			for o, count := range remaining {
+				//Склад обслуживает только предложения своего продавца
+				if o.sellerID == sellers[id] {
+					cover += min(count, available[id][o.sku])
+				}
+			}
+			if cover > bestCover {
+				best, bestCover = id, cover
+			}
+		}
+		if bestCover == 0 {
+			return nil, ErrCantReserveItem
+		}
+		for o, count := range remaining {
+			if o.sellerID != sellers[best] {
+				continue
+			}
+			n := min(count, available[best][o.sku])
+			if n == 0 {
+				continue
+			}
+			taken[o] = append(taken[o], ReservedItem{
+				WarehouseID: best,
+				OrderItem:   OrderItem{Sku: o.sku, Count: uint16(n), SellerID: o.sellerID},
+			})
+			remaining[o] -= n
+		}
+		delete(available, best)
+	}
+
+	var reserveFrom []ReservedItem
+	for _, item := range items {
+		reserveFrom = append(reserveFrom, taken[itemOffer(item)]...)
+	}
+	return reserveFrom, nil
+}
+
+func takeItem(item OrderItem, stocks []Stock) ([]ReservedItem, error) {
+	var reserveFrom []ReservedItem
+	var counter uint64 = 0
+	for _, stock := range stocks {
+		counter += stock.Count
+		if counter > uint64(item.Count) {
+			reserveFrom = append(reserveFrom, ReservedItem{
+				WarehouseID: stock.WarehouseID,
+				OrderItem: OrderItem{
+					Sku:      item.Sku,
+					Count:    item.Count - uint16(counter-stock.Count),
+					SellerID: stock.SellerID,
+				}})
+		} else {
+			reserveFrom = append(reserveFrom, ReservedItem{
+				WarehouseID: stock.WarehouseID,
+				OrderItem: OrderItem{
+					Sku:      item.Sku,
+					Count:    uint16(stock.Count),
+					SellerID: stock.SellerID,
+				}})
+		}
+		if counter >= uint64(item.Count) {
+			break
+		}
+	}
+	if counter < uint64(item.Count) {
+		return nil, ErrCantReserveItem
+	}
+	return reserveFrom, nil
+}
+
+type offer struct {
+	sku      uint32
+	sellerID int64
+}
+
+func itemOffer(item OrderItem) offer {
+	return offer{sku: item.Sku, sellerID: item.SellerID}
+}
+
+func sellerStocks(stocks []Stock, sellerID int64) []Stock {
+	result := make([]Stock, 0, len(stocks))
+	for _, stock := range stocks {
+		if stock.SellerID == sellerID {
+			result = append(result, stock)
+		}
+	}
+	return result
+}
+
+func sum(counts map[offer]uint64) uint64 {
+	var total uint64
+	for _, count := range counts {
+		total += count
+	}
+	return total
+}
+
+func min(a, b uint64) uint64 {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,230 @@
+package domain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReservationStrategies(t *testing.T) {
+	type repositoryMockFunc func(mc *minimock.Controller) OrdersRepository
+
+	const (
+		sku1 uint32 = 1
+		sku2 uint32 = 2
+		sku3 uint32 = 3
+
+		warehouse1 int64 = 1
+		warehouse2 int64 = 2
+		warehouse3 int64 = 3
+		warehouse4 int64 = 4
+
+		seller int64 = 7
+	)
+
+	var (
+		mc  = minimock.NewController(t)
+		ctx = context.Background()
+
+		//Стоки отсортированы по убыванию, как их отдает репозиторий
+		stocks = map[uint32][]Stock{
+			sku1: {{WarehouseID: warehouse1, Count: 10}, {WarehouseID: warehouse2, Count: 8}},
+			sku2: {{WarehouseID: warehouse3, Count: 5}, {WarehouseID: warehouse2, Count: 4}},
+			sku3: {{WarehouseID: warehouse4, SellerID: seller, Count: 5}, {WarehouseID: warehouse1, Count: 2}},
+		}
+		sellerItems = []OrderItem{{Sku: sku3, Count: 3, SellerID: seller}, {Sku: sku3, Count: 2}}
+		sellerWant  = []ReservedItem{
+			{WarehouseID: warehouse4, OrderItem: OrderItem{Sku: sku3, Count: 3, SellerID: seller}},
+			{WarehouseID: warehouse1, OrderItem: OrderItem{Sku: sku3, Count: 2}},
+		}
+		items = []OrderItem{{Sku: sku1, Count: 8}, {Sku: sku2, Count: 4}}
+
+		reserved = func(warehouseID int64, sku uint32, count uint16) ReservedItem {
+			return ReservedItem{WarehouseID: warehouseID, OrderItem: OrderItem{Sku: sku, Count: count}}
+		}
+	)
+	t.Cleanup(mc.Finish)
+
+	repositoryMock := func(mc *minimock.Controller) OrdersRepository {
+		mock := NewOrdersRepositoryMock(t)
+		mock.BatchStocksMock.Set(func(ctx context.Context, skus []uint32) (map[uint32][]Stock, error) {
+			res := make(map[uint32][]Stock, len(skus))
+			for _, sku := range skus {
+				res[sku] = stocks[sku]
+			}
+			return res, nil
+		})
+		return mock
+	}
+
+	tests := []struct {
+		name           string
+		strategy       string
+		order          *Order
+		want           []ReservedItem
+		err            error
+		repositoryMock repositoryMockFunc
+	}{
+		{
+			name:           "greedy - biggest stocks first",
+			strategy:       StrategyGreedy,
+			order:          &Order{Items: items},
+			want:           []ReservedItem{reserved(warehouse1, sku1, 8), reserved(warehouse3, sku2, 4)},
+			repositoryMock: repositoryMock,
+		},
+		{
+			name:     "greedy - item split between warehouses",
+			strategy: StrategyGreedy,
+			order:    &Order{Items: []OrderItem{{Sku: sku1, Count: 15}}},
+			want:     []ReservedItem{reserved(warehouse1, sku1, 10), reserved(warehouse2, sku1, 5)},
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.BatchStocksMock.Expect(ctx, []uint32{sku1}).Return(map[uint32][]Stock{sku1: stocks[sku1]}, nil)
+				return mock
+			},
+		},
+		{
+			name:           "greedy - not enough stock",
+			strategy:       StrategyGreedy,
+			order:          &Order{Items: []OrderItem{{Sku: sku1, Count: 19}}},
+			err:            ErrCantReserveItem,
+			repositoryMock: repositoryMock,
+		},
+		{
+			name:     "greedy - lines of the same sku share stocks",
+			strategy: StrategyGreedy,
+			order:    &Order{Items: []OrderItem{{Sku: sku1, Count: 10}, {Sku: sku1, Count: 9}}},
+			err:      ErrCantReserveItem,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.BatchStocksMock.Expect(ctx, []uint32{sku1}).Return(map[uint32][]Stock{sku1: stocks[sku1]}, nil)
+				return mock
+			},
+		},
+		{
+			name:           "greedy - offers reserved in warehouses of their sellers",
+			strategy:       StrategyGreedy,
+			order:          &Order{Items: sellerItems},
+			want:           sellerWant,
+			repositoryMock: repositoryMock,
+		},
+		{
+			name:           "greedy - stock of other sellers is not taken",
+			strategy:       StrategyGreedy,
+			order:          &Order{Items: []OrderItem{{Sku: sku3, Count: 3}}},
+			err:            ErrCantReserveItem,
+			repositoryMock: repositoryMock,
+		},
+		{
+			name:           "fewest warehouses - whole order from one warehouse",
+			strategy:       StrategyFewestWarehouses,
+			order:          &Order{Items: items},
+			want:           []ReservedItem{reserved(warehouse2, sku1, 8), reserved(warehouse2, sku2, 4)},
+			repositoryMock: repositoryMock,
+		},
+		{
+			name:     "fewest warehouses - order split between warehouses",
+			strategy: StrategyFewestWarehouses,
+			order:    &Order{Items: []OrderItem{{Sku: sku1, Count: 12}, {Sku: sku2, Count: 5}}},
+			want: []ReservedItem{
+				reserved(warehouse2, sku1, 8), reserved(warehouse1, sku1, 4),
+				reserved(warehouse2, sku2, 4), reserved(warehouse3, sku2, 1),
+			},
+			repositoryMock: repositoryMock,
+		},
+		{
+			name:           "fewest warehouses - not enough stock",
+			strategy:       StrategyFewestWarehouses,
+			order:          &Order{Items: []OrderItem{{Sku: sku1, Count: 1}, {Sku: sku2, Count: 10}}},
+			err:            ErrCantReserveItem,
+			repositoryMock: repositoryMock,
+		},
+		{
+			name:           "fewest warehouses - offers reserved in warehouses of their sellers",
+			strategy:       StrategyFewestWarehouses,
+			order:          &Order{Items: sellerItems},
+			want:           sellerWant,
+			repositoryMock: repositoryMock,
+		},
+		{
+			name:           "fewest warehouses - lines of the same sku planned together",
+			strategy:       StrategyFewestWarehouses,
+			order:          &Order{Items: []OrderItem{{Sku: sku1, Count: 10}, {Sku: sku1, Count: 8}}},
+			want:           []ReservedItem{reserved(warehouse1, sku1, 10), reserved(warehouse2, sku1, 8)},
+			repositoryMock: repositoryMock,
+		},
+		{
+			name:           "fewest warehouses - merged count overflow",
+			strategy:       StrategyFewestWarehouses,
+			order:          &Order{Items: []OrderItem{{Sku: sku1, Count: 65535}, {Sku: sku1, Count: 1}}},
+			err:            ErrItemCountOverflow,
+			repositoryMock: repositoryMock,
+		},
+		{
+			name:           "preferred warehouse - taken first",
+			strategy:       StrategyPreferredWarehouse,
+			order:          &Order{Items: items, PreferredWarehouseID: warehouse2},
+			want:           []ReservedItem{reserved(warehouse2, sku1, 8), reserved(warehouse2, sku2, 4)},
+			repositoryMock: repositoryMock,
+		},
+		{
+			name:           "preferred warehouse - rest taken greedily",
+			strategy:       StrategyPreferredWarehouse,
+			order:          &Order{Items: []OrderItem{{Sku: sku1, Count: 12}}, PreferredWarehouseID: warehouse2},
+			want:           []ReservedItem{reserved(warehouse2, sku1, 8), reserved(warehouse1, sku1, 4)},
+			repositoryMock: repositoryMock,
+		},
+		{
+			name:           "preferred warehouse - lines of the same sku planned together",
+			strategy:       StrategyPreferredWarehouse,
+			order:          &Order{Items: []OrderItem{{Sku: sku1, Count: 6}, {Sku: sku1, Count: 6}}, PreferredWarehouseID: warehouse2},
+			want:           []ReservedItem{reserved(warehouse2, sku1, 8), reserved(warehouse1, sku1, 4)},
+			repositoryMock: repositoryMock,
+		},
+		{
+			name:           "preferred warehouse - not set",
+			strategy:       StrategyPreferredWarehouse,
+			order:          &Order{Items: items},
+			want:           []ReservedItem{reserved(warehouse1, sku1, 8), reserved(warehouse3, sku2, 4)},
+			repositoryMock: repositoryMock,
+		},
+		{
+			name:           "preferred warehouse - preferred warehouse of another seller",
+			strategy:       StrategyPreferredWarehouse,
+			order:          &Order{Items: sellerItems, PreferredWarehouseID: warehouse1},
+			want:           sellerWant,
+			repositoryMock: repositoryMock,
+		},
+		{
+			name:           "preferred warehouse - not enough stock",
+			strategy:       StrategyPreferredWarehouse,
+			order:          &Order{Items: []OrderItem{{Sku: sku2, Count: 10}}, PreferredWarehouseID: warehouse3},
+			err:            ErrCantReserveItem,
+			repositoryMock: repositoryMock,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			strategy, err := NewReservationStrategy(tt.strategy)
+			require.NoError(t, err)
+			api := NewMock(tt.repositoryMock(mc), strategy)
+			res, err := api.planReservation(ctx, tt.order)
+			require.Equal(t, tt.want, res)
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestNewReservationStrategy(t *testing.T) {
+	strategy, err := NewReservationStrategy("")
+	require.NoError(t, err)
+	require.Equal(t, greedyStrategy{}, strategy)
+
+	_, err = NewReservationStrategy("random")
+	require.ErrorIs(t, err, ErrUnknownStrategy)
+}
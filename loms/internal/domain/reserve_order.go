@@ -0,0 +1,219 @@
+package domain
+
+import (
+	"context"
+	"route256/libs/logger"
+	transactor "route256/libs/postgres_transactor"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultReservationRetries = 3
+	reservationBackoff        = 50 * time.Millisecond
+)
+
+var (
+	ErrCantReserveItem = errors.New("can not reserve item")
+	ErrNoNewOrders     = errors.New("no new orders")
+)
+
+type ItemShortage struct {
+	Sku       uint32
+	SellerID  int64
+	Requested uint64
+	Missing   uint64
+}
+
+func (d *domain) ReserveNextOrder(ctx context.Context) (bool, error) {
+	order, err := d.reserveNextOrder(ctx)
+	for attempt := uint8(1); attempt < d.config.ReservationRetries && transactor.IsSerializationFailure(err); attempt++ {
+		select {
+		case <-ctx.Done():
+			return false, ctx.Err()
+		case <-time.After(reservationBackoff << (attempt - 1)):
+		}
+		order, err = d.reserveNextOrder(ctx)
+	}
+	if errors.Is(err, ErrNoNewOrders) {
+		return false, nil
+	}
+	//Заказ остался в статусе new и будет взят повторно
+	if transactor.IsSerializationFailure(err) {
+		return true, errors.Wrap(err, "reserve order")
+	}
+	if err != nil {
+		if order == nil {
+			return false, errors.Wrap(err, "claim order")
+		}
+		logger.Error(ctx, "error reserve order", zap.Int64("order id", order.ID), zap.Error(err))
+		err = d.setOrderStatus(ctx, order, StatusFailed, ReasonReservationError)
+		if err != nil {
+			return true, errors.Wrap(err, "set order failed")
+		}
+	}
+	return true, nil
+}
+
+func (d *domain) reserveNextOrder(ctx context.Context) (*Order, error) {
+	var order *Order
+	err := d.TransactionManager.RunTransaction(ctx, isoLevelRepeatableRead, func(ctxTX context.Context) error {
+		orderID, err := d.OrdersRepository.ClaimNewOrder(ctxTX)
+		if err != nil {
+			return err
+		}
+		order, err = d.OrdersRepository.GetOrder(ctxTX, orderID)
+		if err != nil {
+			return errors.Wrap(err, "get order")
+		}
+		_, err = d.reserveOrder(ctxTX, order)
+		return err
+	})
+	return order, err
+}
+
+func (d *domain) reserveOrder(ctxTX context.Context, order *Order) ([]ItemShortage, error) {
+	//Товар в холдах корзины пользователя доступен его заказу
+	stocks, err := d.OrdersRepository.UserStocks(ctxTX, order.User, orderSkus(order))
+	if err != nil {
+		return nil, errors.Wrap(err, "check stocks")
+	}
+	reserveFrom, err := d.config.ReservationStrategy.Plan(order, stocks)
+	if errors.Is(err, ErrCantReserveItem) && order.AllowBackorder {
+		return d.backorderOrder(ctxTX, order, stocks)
+	}
+	//Холды не освобождаем: корзина остается за пользователем
+	if errors.Is(err, ErrCantReserveItem) {
+		shortages := itemShortages(order, stocks)
+		err = d.OrdersRepository.CreateShortages(ctxTX, order.ID, shortages)
+		if err != nil {
+			return nil, errors.Wrap(err, "create shortages")
+		}
+		return shortages, d.changeOrderStatus(ctxTX, order, StatusFailed, ReasonInsufficientStock)
+	}
+	if err != nil {
+		return nil, err
+	}
+	err = d.reserveHeldItems(ctxTX, order, reserveFrom)
+	if err != nil {
+		return nil, err
+	}
+	err = d.OrdersRepository.SetPaymentDeadline(ctxTX, order.ID, time.Now().Add(d.config.PaymentTimeout))
+	if err != nil {
+		return nil, errors.Wrap(err, "set payment deadline")
+	}
+	err = d.changeOrderStatus(ctxTX, order, StatusAwaitingPayment, ReasonItemsReserved)
+	if err != nil {
+		return nil, err
+	}
+	return nil, d.createSellerOrders(ctxTX, order)
+}
+
+func (d *domain) reserveHeldItems(ctxTX context.Context, order *Order, reserveFrom []ReservedItem) error {
+	released, err := d.OrdersRepository.ReleaseHolds(ctxTX, order.User, orderSkus(order))
+	if err != nil {
+		return errors.Wrap(err, "release holds")
+	}
+	err = d.reserveItems(ctxTX, order.ID, reserveFrom)
+	if err != nil {
+		return err
+	}
+	//Удержанное сверх заказа достается ждущим товар заказам
+	return d.allocateReleased(ctxTX, released)
+}
+
+func (d *domain) reserveItems(ctxTX context.Context, orderID int64, reserveFrom []ReservedItem) error {
+	for _, v := range reserveFrom {
+		err := d.OrdersRepository.ReserveStock(ctxTX, orderID, v)
+		if err != nil {
+			return errors.Wrap(err, "reserve stock")
+		}
+	}
+	return nil
+}
+
+func itemShortages(order *Order, stocks map[uint32][]Stock) []ItemShortage {
+	offers := make([]offer, 0, len(order.Items))
+	requested := make(map[offer]uint64, len(order.Items))
+	for _, item := range order.Items {
+		o := itemOffer(item)
+		if _, ok := requested[o]; !ok {
+			offers = append(offers, o)
+		}
+		requested[o] += uint64(item.Count)
+	}
+	var shortages []ItemShortage
+	for _, o := range offers {
+		var available uint64
+		for _, stock := range sellerStocks(stocks[o.sku], o.sellerID) {
+			available += stock.Count
+		}
+		if available >= requested[o] {
+			continue
+		}
+		shortages = append(shortages, ItemShortage{
+			Sku:       o.sku,
+			SellerID:  o.sellerID,
+			Requested: requested[o],
+			Missing:   requested[o] - available,
+		})
+	}
+	return shortages
+}
+
+func (d *domain) planReservation(ctx context.Context, order *Order) ([]ReservedItem, error) {
+	stocks, err := d.OrdersRepository.BatchStocks(ctx, orderSkus(order))
+	if err != nil {
+		return nil, errors.Wrap(err, "check stocks")
+	}
+	return d.config.ReservationStrategy.Plan(order, stocks)
+}
+
+func orderSkus(order *Order) []uint32 {
+	skus := make([]uint32, 0, len(order.Items))
+	seen := make(map[uint32]struct{}, len(order.Items))
+	for _, item := range order.Items {
+		if _, ok := seen[item.Sku]; ok {
+			continue
+		}
+		seen[item.Sku] = struct{}{}
+		skus = append(skus, item.Sku)
+	}
+	return skus
+}
+
+func (d *domain) setOrderStatus(ctx context.Context, order *Order, status OrderStatus, reason string) error {
+	return d.TransactionManager.RunTransaction(ctx, isoLevelRepeatableRead, func(ctxTX context.Context) error {
+		return d.changeOrderStatus(ctxTX, order, status, reason)
+	})
+}
+
+func (d *domain) changeOrderStatus(ctxTX context.Context, order *Order, status OrderStatus, reason string) error {
+	err := Transition(order.Status, status)
+	if err != nil {
+		return err
+	}
+	err = d.OrdersRepository.UpdateOrderStatus(ctxTX, order.ID, status, order.Status)
+	if err != nil {
+		return errors.Wrap(err, "set order status")
+	}
+	err = d.OrdersRepository.AddStatusChange(ctxTX, StatusChange{
+		OrderID: order.ID,
+		From:    order.Status,
+		To:      status,
+		Reason:  reason,
+	})
+	if err != nil {
+		return errors.Wrap(err, "add status change")
+	}
+	changed := *order
+	changed.Status = status
+	err = d.OrdersRepository.CreateOrderNotification(ctxTX, &changed)
+	if err != nil {
+		return errors.Wrap(err, "create order notification")
+	}
+	order.Status = status
+	return nil
+}
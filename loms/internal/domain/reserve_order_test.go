@@ -0,0 +1,297 @@
+package domain
+
+import (
+	"context"
+	"route256/libs/logger"
+	transactor "route256/libs/postgres_transactor"
+	txMock "route256/libs/postgres_transactor/mocks"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/jackc/pgconn"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReserveNextOrder(t *testing.T) {
+	logger.Init(true)
+
+	type repositoryMockFunc func(mc *minimock.Controller) OrdersRepository
+	type tmMockFunc func(mc *minimock.Controller) TransactionManager
+
+	type args struct {
+		ctx context.Context
+	}
+
+	var (
+		mc    = minimock.NewController(t)
+		tx    = txMock.NewTxMock(t)
+		ctx   = context.Background()
+		ctxTx = context.WithValue(ctx, transactor.TxKey("tx"), tx)
+
+		claimErr         = errors.New("claim error")
+		reserveErr       = errors.New("reserve error")
+		releaseErr       = errors.New("release error")
+		serializationErr = &pgconn.PgError{Code: "40001"}
+
+		orderID = gofakeit.Int64()
+		count   = gofakeit.Uint16()
+		order   = &Order{
+			ID:     orderID,
+			Status: StatusNew,
+			User:   gofakeit.Int64(),
+			Items: []OrderItem{
+				{
+					Sku:   gofakeit.Uint32(),
+					Count: count,
+				},
+				{
+					Sku:   gofakeit.Uint32(),
+					Count: count,
+				},
+			},
+		}
+		skus = []uint32{order.Items[0].Sku, order.Items[1].Sku}
+
+		sameStocks = func(stocks []Stock) map[uint32][]Stock {
+			return map[uint32][]Stock{skus[0]: stocks, skus[1]: stocks}
+		}
+	)
+	t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		err            error
+		repositoryMock repositoryMockFunc
+		tmMock         tmMockFunc
+	}{
+		{
+			name: "positive case",
+			args: args{
+				ctx: ctx,
+			},
+			want: true,
+			err:  nil,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.ClaimNewOrderMock.Expect(ctxTx).Return(orderID, nil)
+				mock.GetOrderMock.Expect(ctxTx, orderID).Return(cloneOrder(order), nil)
+				mock.UserStocksMock.Expect(ctxTx, order.User, skus).Return(sameStocks([]Stock{
+					{
+						WarehouseID: gofakeit.Int64(),
+						Count:       uint64(count) / 2,
+					},
+					{
+						WarehouseID: gofakeit.Int64(),
+						Count:       uint64(count),
+					},
+				}), nil)
+				mock.ReleaseHoldsMock.Expect(ctxTx, order.User, skus).Return(nil, nil)
+				mock.ReserveStockMock.Return(nil)
+				mock.SetPaymentDeadlineMock.Set(func(ctx context.Context, id int64, deadline time.Time) (err error) {
+					return nil
+				})
+				mock.UpdateOrderStatusMock.Expect(ctxTx, orderID, StatusAwaitingPayment, StatusNew).Return(nil)
+				mock.AddStatusChangeMock.Expect(ctxTx, StatusChange{OrderID: orderID, From: StatusNew, To: StatusAwaitingPayment, Reason: ReasonItemsReserved}).Return(nil)
+				mock.CreateOrderNotificationMock.Return(nil)
+				mock.CreateSellerOrderMock.Expect(ctxTx, SellerOrder{OrderID: orderID, Status: StatusAwaitingPayment, Items: order.Items}).Return(nil)
+				return mock
+			},
+			tmMock: func(mc *minimock.Controller) TransactionManager {
+				mock := NewTransactionManagerMock(t)
+				mock.RunTransactionMock.Set(func(ctx context.Context, isoLevel string, f func(ctxTX context.Context) error) error {
+					err := f(ctxTx)
+					return err
+				})
+				return mock
+			},
+		},
+		{
+			name: "positive case - no new orders",
+			args: args{
+				ctx: ctx,
+			},
+			want: false,
+			err:  nil,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.ClaimNewOrderMock.Expect(ctxTx).Return(0, ErrNoNewOrders)
+				return mock
+			},
+			tmMock: func(mc *minimock.Controller) TransactionManager {
+				mock := NewTransactionManagerMock(t)
+				mock.RunTransactionMock.Set(func(ctx context.Context, isoLevel string, f func(ctxTX context.Context) error) error {
+					err := f(ctxTx)
+					return err
+				})
+				return mock
+			},
+		},
+		{
+			name: "positive case - insufficient stocks",
+			args: args{
+				ctx: ctx,
+			},
+			want: true,
+			err:  nil,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.ClaimNewOrderMock.Expect(ctxTx).Return(orderID, nil)
+				mock.GetOrderMock.Expect(ctxTx, orderID).Return(cloneOrder(order), nil)
+				mock.UserStocksMock.Expect(ctxTx, order.User, skus).Return(sameStocks([]Stock{{
+					WarehouseID: gofakeit.Int64(),
+					Count:       uint64(count) - 1,
+				}}), nil)
+				mock.CreateShortagesMock.Expect(ctxTx, orderID, []ItemShortage{
+					{Sku: skus[0], Requested: uint64(count), Missing: 1},
+					{Sku: skus[1], Requested: uint64(count), Missing: 1},
+				}).Return(nil)
+				mock.UpdateOrderStatusMock.Expect(ctxTx, orderID, StatusFailed, StatusNew).Return(nil)
+				mock.AddStatusChangeMock.Expect(ctxTx, StatusChange{OrderID: orderID, From: StatusNew, To: StatusFailed, Reason: ReasonInsufficientStock}).Return(nil)
+				mock.CreateOrderNotificationMock.Return(nil)
+				return mock
+			},
+			tmMock: func(mc *minimock.Controller) TransactionManager {
+				mock := NewTransactionManagerMock(t)
+				mock.RunTransactionMock.Set(func(ctx context.Context, isoLevel string, f func(ctxTX context.Context) error) error {
+					err := f(ctxTx)
+					return err
+				})
+				return mock
+			},
+		},
+		{
+			name: "negative case - claim order",
+			args: args{
+				ctx: ctx,
+			},
+			want: false,
+			err:  claimErr,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.ClaimNewOrderMock.Expect(ctxTx).Return(0, claimErr)
+				return mock
+			},
+			tmMock: func(mc *minimock.Controller) TransactionManager {
+				mock := NewTransactionManagerMock(t)
+				mock.RunTransactionMock.Set(func(ctx context.Context, isoLevel string, f func(ctxTX context.Context) error) error {
+					err := f(ctxTx)
+					return err
+				})
+				return mock
+			},
+		},
+		{
+			name: "negative case - reserve stock marks order failed",
+			args: args{
+				ctx: ctx,
+			},
+			want: true,
+			err:  nil,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.ClaimNewOrderMock.Expect(ctxTx).Return(orderID, nil)
+				mock.GetOrderMock.Expect(ctxTx, orderID).Return(cloneOrder(order), nil)
+				mock.UserStocksMock.Expect(ctxTx, order.User, skus).Return(sameStocks([]Stock{{
+					WarehouseID: gofakeit.Int64(),
+					Count:       uint64(count),
+				}}), nil)
+				mock.ReleaseHoldsMock.Expect(ctxTx, order.User, skus).Return(nil, nil)
+				mock.ReserveStockMock.Return(reserveErr)
+				mock.UpdateOrderStatusMock.Expect(ctxTx, orderID, StatusFailed, StatusNew).Return(nil)
+				mock.AddStatusChangeMock.Expect(ctxTx, StatusChange{OrderID: orderID, From: StatusNew, To: StatusFailed, Reason: ReasonReservationError}).Return(nil)
+				mock.CreateOrderNotificationMock.Return(nil)
+				return mock
+			},
+			tmMock: func(mc *minimock.Controller) TransactionManager {
+				mock := NewTransactionManagerMock(t)
+				mock.RunTransactionMock.Set(func(ctx context.Context, isoLevel string, f func(ctxTX context.Context) error) error {
+					err := f(ctxTx)
+					return err
+				})
+				return mock
+			},
+		},
+		{
+			name: "negative case - release holds marks order failed",
+			args: args{
+				ctx: ctx,
+			},
+			want: true,
+			err:  nil,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.ClaimNewOrderMock.Expect(ctxTx).Return(orderID, nil)
+				mock.GetOrderMock.Expect(ctxTx, orderID).Return(cloneOrder(order), nil)
+				mock.UserStocksMock.Expect(ctxTx, order.User, skus).Return(sameStocks([]Stock{{
+					WarehouseID: gofakeit.Int64(),
+					Count:       uint64(count),
+				}}), nil)
+				mock.ReleaseHoldsMock.Expect(ctxTx, order.User, skus).Return(nil, releaseErr)
+				mock.UpdateOrderStatusMock.Expect(ctxTx, orderID, StatusFailed, StatusNew).Return(nil)
+				mock.AddStatusChangeMock.Expect(ctxTx, StatusChange{OrderID: orderID, From: StatusNew, To: StatusFailed, Reason: ReasonReservationError}).Return(nil)
+				mock.CreateOrderNotificationMock.Return(nil)
+				return mock
+			},
+			tmMock: func(mc *minimock.Controller) TransactionManager {
+				mock := NewTransactionManagerMock(t)
+				mock.RunTransactionMock.Set(func(ctx context.Context, isoLevel string, f func(ctxTX context.Context) error) error {
+					err := f(ctxTx)
+					return err
+				})
+				return mock
+			},
+		},
+		{
+			name: "negative case - serialization failure",
+			args: args{
+				ctx: ctx,
+			},
+			want: true,
+			err:  serializationErr,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.ClaimNewOrderMock.Expect(ctxTx).Return(orderID, nil)
+				mock.GetOrderMock.Expect(ctxTx, orderID).Return(cloneOrder(order), nil)
+				mock.UserStocksMock.Expect(ctxTx, order.User, skus).Return(nil, serializationErr)
+				return mock
+			},
+			tmMock: func(mc *minimock.Controller) TransactionManager {
+				mock := NewTransactionManagerMock(t)
+				mock.RunTransactionMock.Set(func(ctx context.Context, isoLevel string, f func(ctxTX context.Context) error) error {
+					err := f(ctxTx)
+					return err
+				})
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := tt.repositoryMock(mc)
+			api := NewMock(
+				repo,
+				tt.tmMock(mc),
+				Config{ReservationRetries: defaultReservationRetries},
+			)
+			processed, err := api.ReserveNextOrder(tt.args.ctx)
+			require.Equal(t, tt.want, processed)
+			if tt.err != nil {
+				require.ErrorContains(t, err, tt.err.Error())
+			} else {
+				require.Equal(t, tt.err, err)
+			}
+			if errors.Is(tt.err, serializationErr) {
+				require.Equal(t, uint64(defaultReservationRetries), repo.(*OrdersRepositoryMock).ClaimNewOrderAfterCounter())
+			}
+		})
+	}
+
+}
@@ -0,0 +1,121 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+var ErrInvalidReturn = errors.New("return items must be a part of the order")
+
+func (d *domain) RequestReturn(ctx context.Context, orderID int64, items []OrderItem) error {
+	items, err := mergeItems(items)
+	if err != nil {
+		return err
+	}
+	err = d.TransactionManager.RunTransaction(ctx, isoLevelSerializable, func(ctxTX context.Context) error {
+		order, err := d.OrdersRepository.GetOrder(ctxTX, orderID)
+		if err != nil {
+			return errors.Wrap(err, "get order")
+		}
+		err = checkReturnItems(order, items)
+		if err != nil {
+			return err
+		}
+		err = d.changeOrderStatus(ctxTX, order, StatusReturnRequested, ReasonReturnRequested)
+		if err != nil {
+			return err
+		}
+		err = d.setSellerOrdersStatus(ctxTX, orderID, StatusReturnRequested)
+		if err != nil {
+			return err
+		}
+		err = d.OrdersRepository.CreateReturnItems(ctxTX, orderID, items)
+		if err != nil {
+			return errors.Wrap(err, "create return items")
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "request return")
+	}
+	return nil
+}
+
+func (d *domain) CompleteReturn(ctx context.Context, orderID int64, warehouseID int64) error {
+	err := d.TransactionManager.RunTransaction(ctx, isoLevelSerializable, func(ctxTX context.Context) error {
+		order, err := d.OrdersRepository.GetOrder(ctxTX, orderID)
+		if err != nil {
+			return errors.Wrap(err, "get order")
+		}
+		err = d.changeOrderStatus(ctxTX, order, StatusReturned, ReasonReturnReceived)
+		if err != nil {
+			return err
+		}
+		err = d.setSellerOrdersStatus(ctxTX, orderID, StatusReturned)
+		if err != nil {
+			return err
+		}
+		items, err := d.OrdersRepository.GetReturnItems(ctxTX, orderID)
+		if err != nil {
+			return errors.Wrap(err, "get return items")
+		}
+		for _, item := range items {
+			err = d.WarehousesRepository.ChangeStock(ctxTX, StockMovement{
+				WarehouseID: warehouseID,
+				Sku:         item.Sku,
+				Kind:        MovementReceive,
+				Delta:       int64(item.Count),
+				Reason:      ReasonReturnReceived,
+				OrderID:     orderID,
+			})
+			if err != nil {
+				return errors.Wrap(err, "receive returned items")
+			}
+			err = d.allocateBackorders(ctxTX, warehouseID, item.Sku)
+			if err != nil {
+				return err
+			}
+		}
+		return d.refundReturn(ctxTX, order, items)
+	})
+	if err != nil {
+		return errors.Wrap(err, "complete return")
+	}
+	return nil
+}
+
+func (d *domain) refundReturn(ctxTX context.Context, order *Order, items []OrderItem) error {
+	payment, err := d.PaymentsRepository.GetActivePayment(ctxTX, order.ID)
+	if errors.Is(err, ErrPaymentNotFound) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "get active payment")
+	}
+	amount, err := d.itemsAmount(ctxTX, keepSnapshots(items, order.Items))
+	if err != nil {
+		return err
+	}
+	//Цена товара без зафиксированной цены могла вырасти после оплаты
+	if amount > payment.Amount {
+		amount = payment.Amount
+	}
+	return d.refundPayment(ctxTX, payment, amount)
+}
+
+func checkReturnItems(order *Order, items []OrderItem) error {
+	if len(items) == 0 {
+		return ErrInvalidReturn
+	}
+	ordered := make(map[offer]uint64, len(order.Items))
+	for _, item := range order.Items {
+		ordered[itemOffer(item)] += uint64(item.Count)
+	}
+	for _, item := range items {
+		if item.Count == 0 || uint64(item.Count) > ordered[itemOffer(item)] {
+			return ErrInvalidReturn
+		}
+	}
+	return nil
+}
@@ -0,0 +1,296 @@
+package domain
+
+import (
+	"context"
+	transactor "route256/libs/postgres_transactor"
+	txMock "route256/libs/postgres_transactor/mocks"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestReturn(t *testing.T) {
+	type repositoryMockFunc func(mc *minimock.Controller) OrdersRepository
+
+	var (
+		mc    = minimock.NewController(t)
+		tx    = txMock.NewTxMock(t)
+		ctx   = context.Background()
+		ctxTx = context.WithValue(ctx, transactor.TxKey("tx"), tx)
+
+		orderID = gofakeit.Int64()
+		order   = &Order{
+			ID:     orderID,
+			Status: StatusPayed,
+			Items: []OrderItem{
+				{Sku: 1, Count: 2, Price: 100},
+				{Sku: 2, Count: 1, Price: 50},
+				{Sku: 1, Count: 1, Price: 90, SellerID: 7},
+			},
+		}
+		awaitingOrder = &Order{
+			ID:     orderID,
+			Status: StatusAwaitingPayment,
+			Items:  order.Items,
+		}
+		tmMock = func() TransactionManager {
+			mock := NewTransactionManagerMock(t)
+			mock.RunTransactionMock.Set(func(ctx context.Context, isoLevel string, f func(ctxTX context.Context) error) error {
+				return f(ctxTx)
+			})
+			return mock
+		}
+	)
+	t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name           string
+		items          []OrderItem
+		err            error
+		repositoryMock repositoryMockFunc
+	}{
+		{
+			name:  "positive case",
+			items: []OrderItem{{Sku: 1, Count: 1}, {Sku: 1, Count: 1}},
+			err:   nil,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetOrderMock.Expect(ctxTx, orderID).Return(cloneOrder(order), nil)
+				mock.UpdateOrderStatusMock.Expect(ctxTx, orderID, StatusReturnRequested, StatusPayed).Return(nil)
+				mock.AddStatusChangeMock.Expect(ctxTx, StatusChange{OrderID: orderID, From: StatusPayed, To: StatusReturnRequested, Reason: ReasonReturnRequested}).Return(nil)
+				mock.CreateOrderNotificationMock.Return(nil)
+				mock.UpdateSellerOrdersStatusMock.Expect(ctxTx, orderID, nil, StatusReturnRequested).Return(nil)
+				mock.CreateReturnItemsMock.Expect(ctxTx, orderID, []OrderItem{{Sku: 1, Count: 2}}).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:  "positive case - same sku from two sellers",
+			items: []OrderItem{{Sku: 1, Count: 2}, {Sku: 1, Count: 1, SellerID: 7}},
+			err:   nil,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetOrderMock.Expect(ctxTx, orderID).Return(cloneOrder(order), nil)
+				mock.UpdateOrderStatusMock.Expect(ctxTx, orderID, StatusReturnRequested, StatusPayed).Return(nil)
+				mock.AddStatusChangeMock.Expect(ctxTx, StatusChange{OrderID: orderID, From: StatusPayed, To: StatusReturnRequested, Reason: ReasonReturnRequested}).Return(nil)
+				mock.CreateOrderNotificationMock.Return(nil)
+				mock.UpdateSellerOrdersStatusMock.Expect(ctxTx, orderID, nil, StatusReturnRequested).Return(nil)
+				mock.CreateReturnItemsMock.Expect(ctxTx, orderID, []OrderItem{{Sku: 1, Count: 2}, {Sku: 1, Count: 1, SellerID: 7}}).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:  "negative case - more than ordered from the seller",
+			items: []OrderItem{{Sku: 1, Count: 2, SellerID: 7}},
+			err:   ErrInvalidReturn,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetOrderMock.Expect(ctxTx, orderID).Return(cloneOrder(order), nil)
+				return mock
+			},
+		},
+		{
+			name:  "negative case - more than ordered",
+			items: []OrderItem{{Sku: 2, Count: 2}},
+			err:   ErrInvalidReturn,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetOrderMock.Expect(ctxTx, orderID).Return(cloneOrder(order), nil)
+				return mock
+			},
+		},
+		{
+			name:  "negative case - sku not in order",
+			items: []OrderItem{{Sku: 3, Count: 1}},
+			err:   ErrInvalidReturn,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetOrderMock.Expect(ctxTx, orderID).Return(cloneOrder(order), nil)
+				return mock
+			},
+		},
+		{
+			name:  "negative case - order is not paid",
+			items: []OrderItem{{Sku: 1, Count: 1}},
+			err:   ErrIllegalTransition,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetOrderMock.Expect(ctxTx, orderID).Return(cloneOrder(awaitingOrder), nil)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			api := NewMock(
+				tt.repositoryMock(mc),
+				tmMock(),
+			)
+			err := api.RequestReturn(ctx, orderID, tt.items)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.Equal(t, tt.err, err)
+			}
+		})
+	}
+}
+
+func TestCompleteReturn(t *testing.T) {
+	type warehousesMockFunc func(mc *minimock.Controller) WarehousesRepository
+	type paymentsMockFunc func(mc *minimock.Controller) PaymentsRepository
+	type providerMockFunc func(mc *minimock.Controller) PaymentProvider
+
+	var (
+		mc    = minimock.NewController(t)
+		tx    = txMock.NewTxMock(t)
+		ctx   = context.Background()
+		ctxTx = context.WithValue(ctx, transactor.TxKey("tx"), tx)
+
+		warehouseErr = errors.New("warehouse error")
+
+		orderID     = gofakeit.Int64()
+		warehouseID = gofakeit.Int64()
+		order       = &Order{
+			ID:     orderID,
+			Status: StatusReturnRequested,
+			Items: []OrderItem{
+				{Sku: 1, Count: 2, Price: 100},
+				{Sku: 2, Count: 1, Price: 50},
+			},
+		}
+		returnItems = []OrderItem{{Sku: 1, Count: 1}, {Sku: 2, Count: 1}}
+		payment     = &Payment{ID: gofakeit.UUID(), OrderID: orderID, Amount: 250, Status: PaymentSucceeded}
+		restocked   = func(mock *WarehousesRepositoryMock) *WarehousesRepositoryMock {
+			for _, item := range returnItems {
+				mock.ChangeStockMock.When(ctxTx, StockMovement{
+					WarehouseID: warehouseID,
+					Sku:         item.Sku,
+					Kind:        MovementReceive,
+					Delta:       int64(item.Count),
+					Reason:      ReasonReturnReceived,
+					OrderID:     orderID,
+				}).Then(nil)
+			}
+			return mock
+		}
+	)
+	t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name           string
+		err            error
+		warehousesMock warehousesMockFunc
+		paymentsMock   paymentsMockFunc
+		providerMock   providerMockFunc
+	}{
+		{
+			name: "positive case - partial refund keeps payment succeeded",
+			err:  nil,
+			warehousesMock: func(mc *minimock.Controller) WarehousesRepository {
+				return restocked(NewWarehousesRepositoryMock(t))
+			},
+			paymentsMock: func(mc *minimock.Controller) PaymentsRepository {
+				mock := NewPaymentsRepositoryMock(t)
+				mock.GetActivePaymentMock.Expect(ctxTx, orderID).Return(payment, nil)
+				mock.CreateRefundMock.Expect(ctxTx, payment.ID, 150).Return(nil)
+				return mock
+			},
+			providerMock: func(mc *minimock.Controller) PaymentProvider {
+				return NewPaymentProviderMock(t)
+			},
+		},
+		{
+			name: "positive case - last refund marks payment refunded",
+			err:  nil,
+			warehousesMock: func(mc *minimock.Controller) WarehousesRepository {
+				return restocked(NewWarehousesRepositoryMock(t))
+			},
+			paymentsMock: func(mc *minimock.Controller) PaymentsRepository {
+				mock := NewPaymentsRepositoryMock(t)
+				refunded := *payment
+				refunded.Refunded = 100
+				mock.GetActivePaymentMock.Expect(ctxTx, orderID).Return(&refunded, nil)
+				mock.CreateRefundMock.Expect(ctxTx, payment.ID, 150).Return(nil)
+				mock.UpdatePaymentStatusMock.Expect(ctxTx, payment.ID, PaymentRefunded, PaymentSucceeded).Return(nil)
+				return mock
+			},
+			providerMock: func(mc *minimock.Controller) PaymentProvider {
+				return NewPaymentProviderMock(t)
+			},
+		},
+		{
+			name: "positive case - paid without payment",
+			err:  nil,
+			warehousesMock: func(mc *minimock.Controller) WarehousesRepository {
+				return restocked(NewWarehousesRepositoryMock(t))
+			},
+			paymentsMock: func(mc *minimock.Controller) PaymentsRepository {
+				mock := NewPaymentsRepositoryMock(t)
+				mock.GetActivePaymentMock.Expect(ctxTx, orderID).Return(nil, ErrPaymentNotFound)
+				return mock
+			},
+			providerMock: func(mc *minimock.Controller) PaymentProvider {
+				return NewPaymentProviderMock(t)
+			},
+		},
+		{
+			name: "negative case - warehouse error",
+			err:  warehouseErr,
+			warehousesMock: func(mc *minimock.Controller) WarehousesRepository {
+				mock := NewWarehousesRepositoryMock(t)
+				mock.ChangeStockMock.Return(warehouseErr)
+				return mock
+			},
+			paymentsMock: func(mc *minimock.Controller) PaymentsRepository {
+				return NewPaymentsRepositoryMock(t)
+			},
+			providerMock: func(mc *minimock.Controller) PaymentProvider {
+				return NewPaymentProviderMock(t)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := NewOrdersRepositoryMock(t)
+			repo.GetOrderMock.Expect(ctxTx, orderID).Return(cloneOrder(order), nil)
+			repo.UpdateOrderStatusMock.Expect(ctxTx, orderID, StatusReturned, StatusReturnRequested).Return(nil)
+			repo.AddStatusChangeMock.Expect(ctxTx, StatusChange{OrderID: orderID, From: StatusReturnRequested, To: StatusReturned, Reason: ReasonReturnReceived}).Return(nil)
+			repo.CreateOrderNotificationMock.Return(nil)
+			repo.UpdateSellerOrdersStatusMock.Expect(ctxTx, orderID, nil, StatusReturned).Return(nil)
+			repo.GetReturnItemsMock.Expect(ctxTx, orderID).Return(append([]OrderItem(nil), returnItems...), nil)
+			if tt.err == nil {
+				//Принятый товар распределяется по предзаказам, здесь их нет
+				repo.BatchStocksMock.Set(func(ctx context.Context, skus []uint32) (map[uint32][]Stock, error) {
+					return nil, nil
+				})
+			}
+			tm := NewTransactionManagerMock(t)
+			tm.RunTransactionMock.Set(func(ctx context.Context, isoLevel string, f func(ctxTX context.Context) error) error {
+				return f(ctxTx)
+			})
+			api := NewMock(
+				repo,
+				tt.warehousesMock(mc),
+				tt.paymentsMock(mc),
+				tt.providerMock(mc),
+				tm,
+			)
+			err := api.CompleteReturn(ctx, orderID, warehouseID)
+			if tt.err != nil {
+				require.ErrorContains(t, err, tt.err.Error())
+			} else {
+				require.Equal(t, tt.err, err)
+			}
+		})
+	}
+}
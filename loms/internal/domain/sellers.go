@@ -0,0 +1,123 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrSellerNotFound = errors.New("seller not found")
+	ErrSellerExists   = errors.New("seller already exists")
+)
+
+type SellerOrder struct {
+	ID       int64
+	OrderID  int64
+	SellerID int64
+	Status   OrderStatus
+	Items    []OrderItem
+	//Время создания заказа покупателем
+	CreatedAt time.Time
+}
+
+type SellerOrdersFilter struct {
+	SellerID int64
+	//Пустой статус - заказы в любом статусе
+	Status    OrderStatus
+	PageToken string
+	PageSize  uint64
+}
+
+type SellerOrdersQuery struct {
+	SellerID int64
+	Status   OrderStatus
+	BeforeID int64
+	Limit    uint64
+}
+
+func (d *domain) CreateSeller(ctx context.Context, name string) (int64, error) {
+	id, err := d.WarehousesRepository.CreateSeller(ctx, name)
+	if err != nil {
+		return 0, errors.Wrap(err, "create seller")
+	}
+	return id, nil
+}
+
+func (d *domain) ListSellerOrders(ctx context.Context, filter SellerOrdersFilter) ([]SellerOrder, string, error) {
+	pageSize := filter.PageSize
+	if pageSize == 0 {
+		pageSize = defaultOrdersPageSize
+	}
+	if pageSize > maxOrdersPageSize {
+		pageSize = maxOrdersPageSize
+	}
+	beforeID, err := decodePageToken(filter.PageToken)
+	if err != nil {
+		return nil, "", err
+	}
+	orders, err := d.OrdersRepository.ListSellerOrders(ctx, SellerOrdersQuery{
+		SellerID: filter.SellerID,
+		Status:   filter.Status,
+		BeforeID: beforeID,
+		Limit:    pageSize + 1,
+	})
+	if err != nil {
+		return nil, "", errors.Wrap(err, "list seller orders")
+	}
+	var nextPageToken string
+	if uint64(len(orders)) > pageSize {
+		orders = orders[:pageSize]
+		nextPageToken = encodePageToken(orders[len(orders)-1].ID)
+	}
+	return orders, nextPageToken, nil
+}
+
+func (d *domain) createSellerOrders(ctxTX context.Context, order *Order) error {
+	sellerOrders, err := splitSellerOrders(order)
+	if err != nil {
+		return err
+	}
+	for _, sellerOrder := range sellerOrders {
+		err = d.OrdersRepository.CreateSellerOrder(ctxTX, sellerOrder)
+		if err != nil {
+			return errors.Wrap(err, "create seller order")
+		}
+	}
+	return nil
+}
+
+func (d *domain) setSellerOrdersStatus(ctxTX context.Context, orderID int64, status OrderStatus) error {
+	err := d.OrdersRepository.UpdateSellerOrdersStatus(ctxTX, orderID, nil, status)
+	if err != nil {
+		return errors.Wrap(err, "update seller orders status")
+	}
+	return nil
+}
+
+func splitSellerOrders(order *Order) ([]SellerOrder, error) {
+	var sellerOrders []SellerOrder
+	index := make(map[int64]int)
+	for _, item := range order.Items {
+		i, ok := index[item.SellerID]
+		if !ok {
+			i = len(sellerOrders)
+			index[item.SellerID] = i
+			sellerOrders = append(sellerOrders, SellerOrder{
+				OrderID:  order.ID,
+				SellerID: item.SellerID,
+				Status:   order.Status,
+			})
+		}
+		sellerOrders[i].Items = append(sellerOrders[i].Items, item)
+	}
+	for i := range sellerOrders {
+		items, err := mergeItems(sellerOrders[i].Items)
+		if err != nil {
+			return nil, err
+		}
+		sellerOrders[i].Items = items
+	}
+	return sellerOrders, nil
+}
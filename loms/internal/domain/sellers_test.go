@@ -0,0 +1,119 @@
+package domain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListSellerOrders(t *testing.T) {
+	type repositoryMockFunc func(mc *minimock.Controller) OrdersRepository
+
+	var (
+		mc  = minimock.NewController(t)
+		ctx = context.Background()
+
+		sellerID = gofakeit.Int64()
+		orders   = []SellerOrder{
+			{ID: 5, OrderID: 50, SellerID: sellerID, Status: StatusPayed},
+			{ID: 4, OrderID: 40, SellerID: sellerID, Status: StatusShipped},
+			{ID: 3, OrderID: 30, SellerID: sellerID, Status: StatusDelivered},
+		}
+	)
+	t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name           string
+		filter         SellerOrdersFilter
+		want           []SellerOrder
+		wantToken      string
+		err            error
+		repositoryMock repositoryMockFunc
+	}{
+		{
+			name:      "positive case - first page",
+			filter:    SellerOrdersFilter{SellerID: sellerID, PageSize: 2},
+			want:      orders[:2],
+			wantToken: encodePageToken(4),
+			err:       nil,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.ListSellerOrdersMock.Expect(ctx, SellerOrdersQuery{SellerID: sellerID, Limit: 3}).Return(orders, nil)
+				return mock
+			},
+		},
+		{
+			name:      "positive case - last page",
+			filter:    SellerOrdersFilter{SellerID: sellerID, Status: StatusDelivered, PageToken: encodePageToken(4)},
+			want:      orders[2:],
+			wantToken: "",
+			err:       nil,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.ListSellerOrdersMock.Expect(ctx, SellerOrdersQuery{
+					SellerID: sellerID,
+					Status:   StatusDelivered,
+					BeforeID: 4,
+					Limit:    defaultOrdersPageSize + 1,
+				}).Return(orders[2:], nil)
+				return mock
+			},
+		},
+		{
+			name:   "negative case - invalid page token",
+			filter: SellerOrdersFilter{SellerID: sellerID, PageToken: "???"},
+			want:   nil,
+			err:    ErrInvalidPageToken,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				return NewOrdersRepositoryMock(t)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			api := NewMock(tt.repositoryMock(mc))
+			res, token, err := api.ListSellerOrders(ctx, tt.filter)
+			require.Equal(t, tt.want, res)
+			require.Equal(t, tt.wantToken, token)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSplitSellerOrders(t *testing.T) {
+	order := &Order{
+		ID:     gofakeit.Int64(),
+		Status: StatusAwaitingStock,
+		Items: []OrderItem{
+			{Sku: 1, Count: 1, SellerID: 3, Name: "pen", Price: 20},
+			{Sku: 2, Count: 4},
+			{Sku: 1, Count: 2, SellerID: 3, Name: "pen", Price: 20},
+			{Sku: 1, Count: 5, Name: "pen", Price: 25},
+		},
+	}
+	res, err := splitSellerOrders(order)
+	require.NoError(t, err)
+	require.Equal(t, []SellerOrder{
+		{
+			OrderID:  order.ID,
+			SellerID: 3,
+			Status:   StatusAwaitingStock,
+			Items:    []OrderItem{{Sku: 1, Count: 3, SellerID: 3, Name: "pen", Price: 20}},
+		},
+		{
+			OrderID: order.ID,
+			Status:  StatusAwaitingStock,
+			Items:   []OrderItem{{Sku: 2, Count: 4}, {Sku: 1, Count: 5, Name: "pen", Price: 25}},
+		},
+	}, res)
+}
@@ -0,0 +1,186 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+type ShipmentStatus string
+
+const (
+	ShipmentAssembling ShipmentStatus = "assembling"
+	ShipmentShipped    ShipmentStatus = "shipped"
+	ShipmentDelivered  ShipmentStatus = "delivered"
+)
+
+var (
+	ErrShipmentNotFound    = errors.New("shipment not found")
+	ErrNothingToShip       = errors.New("order has no sold items to ship")
+	ErrEmptyTrackingNumber = errors.New("tracking number must not be empty")
+)
+
+type Shipment struct {
+	ID          int64
+	OrderID     int64
+	WarehouseID int64
+	//Продавец, которому принадлежит склад
+	SellerID int64
+	Status   ShipmentStatus
+	//Заполняется при отправке
+	TrackingNumber string
+	Items          []OrderItem
+}
+
+var shipmentProgress = map[ShipmentStatus]int{
+	ShipmentAssembling: 0,
+	ShipmentShipped:    1,
+	ShipmentDelivered:  2,
+}
+
+var shipmentOrderStatus = map[ShipmentStatus]OrderStatus{
+	ShipmentAssembling: StatusAssembling,
+	ShipmentShipped:    StatusShipped,
+	ShipmentDelivered:  StatusDelivered,
+}
+
+func (d *domain) AssembleOrder(ctx context.Context, orderID int64) ([]Shipment, error) {
+	var shipments []Shipment
+	err := d.TransactionManager.RunTransaction(ctx, isoLevelSerializable, func(ctxTX context.Context) error {
+		order, err := d.OrdersRepository.GetOrder(ctxTX, orderID)
+		if err != nil {
+			return errors.Wrap(err, "get order")
+		}
+		err = Transition(order.Status, StatusAssembling)
+		if err != nil {
+			return err
+		}
+		soldItems, err := d.WarehousesRepository.SoldItems(ctxTX, orderID)
+		if err != nil {
+			return errors.Wrap(err, "get sold items")
+		}
+		if len(soldItems) == 0 {
+			soldItems = unknownWarehouseItems(order.Items)
+		}
+		shipments = splitShipments(orderID, soldItems)
+		if len(shipments) == 0 {
+			return ErrNothingToShip
+		}
+		for i := range shipments {
+			shipments[i].ID, err = d.ShipmentsRepository.CreateShipment(ctxTX, &shipments[i])
+			if err != nil {
+				return errors.Wrap(err, "create shipment")
+			}
+		}
+		order.Shipments = shipments
+		err = d.changeOrderStatus(ctxTX, order, StatusAssembling, ReasonAssemblyStarted)
+		if err != nil {
+			return err
+		}
+		return d.setSellerOrdersStatus(ctxTX, orderID, StatusAssembling)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "assemble order")
+	}
+	return shipments, nil
+}
+
+func (d *domain) ShipShipment(ctx context.Context, shipmentID int64, trackingNumber string) error {
+	if trackingNumber == "" {
+		return ErrEmptyTrackingNumber
+	}
+	return d.advanceShipment(ctx, shipmentID, ShipmentShipped, trackingNumber)
+}
+
+func (d *domain) DeliverShipment(ctx context.Context, shipmentID int64) error {
+	return d.advanceShipment(ctx, shipmentID, ShipmentDelivered, "")
+}
+
+func (d *domain) advanceShipment(ctx context.Context, shipmentID int64, status ShipmentStatus, trackingNumber string) error {
+	err := d.TransactionManager.RunTransaction(ctx, isoLevelSerializable, func(ctxTX context.Context) error {
+		shipment, err := d.ShipmentsRepository.GetShipment(ctxTX, shipmentID)
+		if err != nil {
+			return errors.Wrap(err, "get shipment")
+		}
+		statusBefore := shipment.Status
+		if shipmentProgress[status] != shipmentProgress[statusBefore]+1 {
+			return errors.Wrapf(ErrIllegalTransition, "shipment from %q to %q", statusBefore, status)
+		}
+		shipment.Status = status
+		if trackingNumber != "" {
+			shipment.TrackingNumber = trackingNumber
+		}
+		err = d.ShipmentsRepository.UpdateShipment(ctxTX, shipment, statusBefore)
+		if err != nil {
+			return errors.Wrap(err, "update shipment")
+		}
+		return d.syncOrderWithShipments(ctxTX, shipment)
+	})
+	if err != nil {
+		return errors.Wrap(err, "advance shipment")
+	}
+	return nil
+}
+
+func (d *domain) syncOrderWithShipments(ctxTX context.Context, changed *Shipment) error {
+	order, err := d.OrdersRepository.GetOrder(ctxTX, changed.OrderID)
+	if err != nil {
+		return errors.Wrap(err, "get order")
+	}
+	order.Shipments, err = d.ShipmentsRepository.GetOrderShipments(ctxTX, changed.OrderID)
+	if err != nil {
+		return errors.Wrap(err, "get order shipments")
+	}
+	least, sellerLeast := ShipmentDelivered, ShipmentDelivered
+	for _, shipment := range order.Shipments {
+		if shipmentProgress[shipment.Status] < shipmentProgress[least] {
+			least = shipment.Status
+		}
+		if shipment.SellerID == changed.SellerID && shipmentProgress[shipment.Status] < shipmentProgress[sellerLeast] {
+			sellerLeast = shipment.Status
+		}
+	}
+	err = d.OrdersRepository.UpdateSellerOrdersStatus(ctxTX, order.ID, []int64{changed.SellerID}, shipmentOrderStatus[sellerLeast])
+	if err != nil {
+		return errors.Wrap(err, "update seller order status")
+	}
+	switch {
+	case least == ShipmentShipped && order.Status == StatusAssembling:
+		return d.changeOrderStatus(ctxTX, order, StatusShipped, ReasonShipped)
+	case least == ShipmentDelivered && order.Status == StatusShipped:
+		return d.changeOrderStatus(ctxTX, order, StatusDelivered, ReasonDelivered)
+	}
+	err = d.OrdersRepository.CreateOrderNotification(ctxTX, order)
+	if err != nil {
+		return errors.Wrap(err, "create order notification")
+	}
+	return nil
+}
+
+func unknownWarehouseItems(items []OrderItem) []ReservedItem {
+	result := make([]ReservedItem, 0, len(items))
+	for _, item := range items {
+		result = append(result, ReservedItem{OrderItem: OrderItem{Sku: item.Sku, Count: item.Count}})
+	}
+	return result
+}
+
+func splitShipments(orderID int64, soldItems []ReservedItem) []Shipment {
+	var shipments []Shipment
+	index := make(map[int64]int)
+	for _, item := range soldItems {
+		i, ok := index[item.WarehouseID]
+		if !ok {
+			i = len(shipments)
+			index[item.WarehouseID] = i
+			shipments = append(shipments, Shipment{
+				OrderID:     orderID,
+				WarehouseID: item.WarehouseID,
+				SellerID:    item.SellerID,
+				Status:      ShipmentAssembling,
+			})
+		}
+		shipments[i].Items = append(shipments[i].Items, item.OrderItem)
+	}
+	return shipments
+}
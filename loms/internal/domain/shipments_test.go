@@ -0,0 +1,331 @@
+package domain
+
+import (
+	"context"
+	transactor "route256/libs/postgres_transactor"
+	txMock "route256/libs/postgres_transactor/mocks"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssembleOrder(t *testing.T) {
+	type repositoryMockFunc func(mc *minimock.Controller) OrdersRepository
+	type warehousesMockFunc func(mc *minimock.Controller) WarehousesRepository
+	type shipmentsMockFunc func(mc *minimock.Controller) ShipmentsRepository
+
+	var (
+		mc    = minimock.NewController(t)
+		tx    = txMock.NewTxMock(t)
+		ctx   = context.Background()
+		ctxTx = context.WithValue(ctx, transactor.TxKey("tx"), tx)
+
+		orderID = gofakeit.Int64()
+		order   = &Order{
+			ID:     orderID,
+			Status: StatusPayed,
+		}
+		soldItems = []ReservedItem{
+			{OrderItem: OrderItem{Sku: 1, Count: 2}, WarehouseID: 1},
+			{OrderItem: OrderItem{Sku: 1, Count: 1}, WarehouseID: 2},
+			{OrderItem: OrderItem{Sku: 2, Count: 1}, WarehouseID: 1},
+		}
+		legacyOrder = &Order{
+			ID:     orderID,
+			Status: StatusPayed,
+			Items:  []OrderItem{{Sku: 1, Count: 3, Name: "book", Price: 100}},
+		}
+		legacyShipments = []Shipment{
+			{ID: 9, OrderID: orderID, Status: ShipmentAssembling, Items: []OrderItem{{Sku: 1, Count: 3}}},
+		}
+		shipments = []Shipment{
+			{ID: 10, OrderID: orderID, WarehouseID: 1, Status: ShipmentAssembling, Items: []OrderItem{{Sku: 1, Count: 2}, {Sku: 2, Count: 1}}},
+			{ID: 11, OrderID: orderID, WarehouseID: 2, Status: ShipmentAssembling, Items: []OrderItem{{Sku: 1, Count: 1}}},
+		}
+	)
+	t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name           string
+		want           []Shipment
+		err            error
+		repositoryMock repositoryMockFunc
+		warehousesMock warehousesMockFunc
+		shipmentsMock  shipmentsMockFunc
+	}{
+		{
+			name: "positive case",
+			want: shipments,
+			err:  nil,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetOrderMock.Expect(ctxTx, orderID).Return(cloneOrder(order), nil)
+				mock.UpdateOrderStatusMock.Expect(ctxTx, orderID, StatusAssembling, StatusPayed).Return(nil)
+				mock.AddStatusChangeMock.Expect(ctxTx, StatusChange{OrderID: orderID, From: StatusPayed, To: StatusAssembling, Reason: ReasonAssemblyStarted}).Return(nil)
+				mock.CreateOrderNotificationMock.Expect(ctxTx, &Order{ID: orderID, Status: StatusAssembling, Shipments: shipments}).Return(nil)
+				mock.UpdateSellerOrdersStatusMock.Expect(ctxTx, orderID, nil, StatusAssembling).Return(nil)
+				return mock
+			},
+			warehousesMock: func(mc *minimock.Controller) WarehousesRepository {
+				mock := NewWarehousesRepositoryMock(t)
+				mock.SoldItemsMock.Expect(ctxTx, orderID).Return(soldItems, nil)
+				return mock
+			},
+			shipmentsMock: func(mc *minimock.Controller) ShipmentsRepository {
+				mock := NewShipmentsRepositoryMock(t)
+				mock.CreateShipmentMock.Set(func(ctx context.Context, shipment *Shipment) (int64, error) {
+					return 9 + shipment.WarehouseID, nil
+				})
+				return mock
+			},
+		},
+		{
+			name: "positive case - sold before the ledger",
+			want: legacyShipments,
+			err:  nil,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetOrderMock.Expect(ctxTx, orderID).Return(cloneOrder(legacyOrder), nil)
+				mock.UpdateOrderStatusMock.Expect(ctxTx, orderID, StatusAssembling, StatusPayed).Return(nil)
+				mock.AddStatusChangeMock.Expect(ctxTx, StatusChange{OrderID: orderID, From: StatusPayed, To: StatusAssembling, Reason: ReasonAssemblyStarted}).Return(nil)
+				mock.CreateOrderNotificationMock.Return(nil)
+				mock.UpdateSellerOrdersStatusMock.Expect(ctxTx, orderID, nil, StatusAssembling).Return(nil)
+				return mock
+			},
+			warehousesMock: func(mc *minimock.Controller) WarehousesRepository {
+				mock := NewWarehousesRepositoryMock(t)
+				mock.SoldItemsMock.Expect(ctxTx, orderID).Return(nil, nil)
+				return mock
+			},
+			shipmentsMock: func(mc *minimock.Controller) ShipmentsRepository {
+				mock := NewShipmentsRepositoryMock(t)
+				mock.CreateShipmentMock.Set(func(ctx context.Context, shipment *Shipment) (int64, error) {
+					return 9 + shipment.WarehouseID, nil
+				})
+				return mock
+			},
+		},
+		{
+			name: "negative case - order is not paid",
+			want: nil,
+			err:  ErrIllegalTransition,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetOrderMock.Expect(ctxTx, orderID).Return(&Order{ID: orderID, Status: StatusAwaitingPayment}, nil)
+				return mock
+			},
+			warehousesMock: func(mc *minimock.Controller) WarehousesRepository {
+				return NewWarehousesRepositoryMock(t)
+			},
+			shipmentsMock: func(mc *minimock.Controller) ShipmentsRepository {
+				return NewShipmentsRepositoryMock(t)
+			},
+		},
+		{
+			name: "negative case - nothing to ship",
+			want: nil,
+			err:  ErrNothingToShip,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetOrderMock.Expect(ctxTx, orderID).Return(cloneOrder(order), nil)
+				return mock
+			},
+			warehousesMock: func(mc *minimock.Controller) WarehousesRepository {
+				mock := NewWarehousesRepositoryMock(t)
+				mock.SoldItemsMock.Expect(ctxTx, orderID).Return(nil, nil)
+				return mock
+			},
+			shipmentsMock: func(mc *minimock.Controller) ShipmentsRepository {
+				return NewShipmentsRepositoryMock(t)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tm := NewTransactionManagerMock(t)
+			tm.RunTransactionMock.Set(func(ctx context.Context, isoLevel string, f func(ctxTX context.Context) error) error {
+				return f(ctxTx)
+			})
+			api := NewMock(
+				tt.repositoryMock(mc),
+				tt.warehousesMock(mc),
+				tt.shipmentsMock(mc),
+				tm,
+			)
+			res, err := api.AssembleOrder(ctx, orderID)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
+
+func TestAdvanceShipment(t *testing.T) {
+	type repositoryMockFunc func(mc *minimock.Controller) OrdersRepository
+	type shipmentsMockFunc func(mc *minimock.Controller) ShipmentsRepository
+
+	var (
+		mc    = minimock.NewController(t)
+		tx    = txMock.NewTxMock(t)
+		ctx   = context.Background()
+		ctxTx = context.WithValue(ctx, transactor.TxKey("tx"), tx)
+
+		orderID    = gofakeit.Int64()
+		shipmentID = gofakeit.Int64()
+		tracking   = "TRACK-1"
+
+		assembling = Shipment{ID: shipmentID, OrderID: orderID, WarehouseID: 1, Status: ShipmentAssembling}
+		shipped    = Shipment{ID: shipmentID, OrderID: orderID, WarehouseID: 1, Status: ShipmentShipped, TrackingNumber: tracking}
+		delivered  = Shipment{ID: shipmentID, OrderID: orderID, WarehouseID: 1, Status: ShipmentDelivered, TrackingNumber: tracking}
+		other      = Shipment{ID: shipmentID + 1, OrderID: orderID, WarehouseID: 2, SellerID: 2, Status: ShipmentAssembling}
+	)
+	t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name           string
+		deliver        bool
+		trackingNumber string
+		err            error
+		repositoryMock repositoryMockFunc
+		shipmentsMock  shipmentsMockFunc
+	}{
+		{
+			name:           "positive case - last shipment sent",
+			trackingNumber: tracking,
+			err:            nil,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetOrderMock.Expect(ctxTx, orderID).Return(&Order{ID: orderID, Status: StatusAssembling}, nil)
+				mock.UpdateSellerOrdersStatusMock.Expect(ctxTx, orderID, []int64{0}, StatusShipped).Return(nil)
+				mock.UpdateOrderStatusMock.Expect(ctxTx, orderID, StatusShipped, StatusAssembling).Return(nil)
+				mock.AddStatusChangeMock.Expect(ctxTx, StatusChange{OrderID: orderID, From: StatusAssembling, To: StatusShipped, Reason: ReasonShipped}).Return(nil)
+				mock.CreateOrderNotificationMock.Expect(ctxTx, &Order{ID: orderID, Status: StatusShipped, Shipments: []Shipment{shipped}}).Return(nil)
+				return mock
+			},
+			shipmentsMock: func(mc *minimock.Controller) ShipmentsRepository {
+				mock := NewShipmentsRepositoryMock(t)
+				current := assembling
+				mock.GetShipmentMock.Expect(ctxTx, shipmentID).Return(&current, nil)
+				mock.UpdateShipmentMock.Expect(ctxTx, &shipped, ShipmentAssembling).Return(nil)
+				mock.GetOrderShipmentsMock.Expect(ctxTx, orderID).Return([]Shipment{shipped}, nil)
+				return mock
+			},
+		},
+		{
+			name:           "positive case - shipments of one seller sent",
+			trackingNumber: tracking,
+			err:            nil,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetOrderMock.Expect(ctxTx, orderID).Return(&Order{ID: orderID, Status: StatusAssembling}, nil)
+				mock.UpdateSellerOrdersStatusMock.Expect(ctxTx, orderID, []int64{0}, StatusShipped).Return(nil)
+				mock.CreateOrderNotificationMock.Expect(ctxTx, &Order{ID: orderID, Status: StatusAssembling, Shipments: []Shipment{shipped, other}}).Return(nil)
+				return mock
+			},
+			shipmentsMock: func(mc *minimock.Controller) ShipmentsRepository {
+				mock := NewShipmentsRepositoryMock(t)
+				current := assembling
+				mock.GetShipmentMock.Expect(ctxTx, shipmentID).Return(&current, nil)
+				mock.UpdateShipmentMock.Expect(ctxTx, &shipped, ShipmentAssembling).Return(nil)
+				mock.GetOrderShipmentsMock.Expect(ctxTx, orderID).Return([]Shipment{shipped, other}, nil)
+				return mock
+			},
+		},
+		{
+			name:    "positive case - last shipment delivered",
+			deliver: true,
+			err:     nil,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetOrderMock.Expect(ctxTx, orderID).Return(&Order{ID: orderID, Status: StatusShipped}, nil)
+				mock.UpdateSellerOrdersStatusMock.Expect(ctxTx, orderID, []int64{0}, StatusDelivered).Return(nil)
+				mock.UpdateOrderStatusMock.Expect(ctxTx, orderID, StatusDelivered, StatusShipped).Return(nil)
+				mock.AddStatusChangeMock.Expect(ctxTx, StatusChange{OrderID: orderID, From: StatusShipped, To: StatusDelivered, Reason: ReasonDelivered}).Return(nil)
+				mock.CreateOrderNotificationMock.Return(nil)
+				return mock
+			},
+			shipmentsMock: func(mc *minimock.Controller) ShipmentsRepository {
+				mock := NewShipmentsRepositoryMock(t)
+				current := shipped
+				mock.GetShipmentMock.Expect(ctxTx, shipmentID).Return(&current, nil)
+				mock.UpdateShipmentMock.Expect(ctxTx, &delivered, ShipmentShipped).Return(nil)
+				mock.GetOrderShipmentsMock.Expect(ctxTx, orderID).Return([]Shipment{delivered}, nil)
+				return mock
+			},
+		},
+		{
+			name:    "negative case - deliver not sent shipment",
+			deliver: true,
+			err:     ErrIllegalTransition,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				return NewOrdersRepositoryMock(t)
+			},
+			shipmentsMock: func(mc *minimock.Controller) ShipmentsRepository {
+				mock := NewShipmentsRepositoryMock(t)
+				current := assembling
+				mock.GetShipmentMock.Expect(ctxTx, shipmentID).Return(&current, nil)
+				return mock
+			},
+		},
+		{
+			name:           "negative case - ship twice",
+			trackingNumber: tracking,
+			err:            ErrIllegalTransition,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				return NewOrdersRepositoryMock(t)
+			},
+			shipmentsMock: func(mc *minimock.Controller) ShipmentsRepository {
+				mock := NewShipmentsRepositoryMock(t)
+				current := shipped
+				mock.GetShipmentMock.Expect(ctxTx, shipmentID).Return(&current, nil)
+				return mock
+			},
+		},
+		{
+			name:           "negative case - empty tracking number",
+			trackingNumber: "",
+			err:            ErrEmptyTrackingNumber,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				return NewOrdersRepositoryMock(t)
+			},
+			shipmentsMock: func(mc *minimock.Controller) ShipmentsRepository {
+				return NewShipmentsRepositoryMock(t)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tm := NewTransactionManagerMock(t)
+			tm.RunTransactionMock.Set(func(ctx context.Context, isoLevel string, f func(ctxTX context.Context) error) error {
+				return f(ctxTx)
+			})
+			api := NewMock(
+				tt.repositoryMock(mc),
+				tt.shipmentsMock(mc),
+				tm,
+			)
+			var err error
+			if tt.deliver {
+				err = api.DeliverShipment(ctx, shipmentID)
+			} else {
+				err = api.ShipShipment(ctx, shipmentID, tt.trackingNumber)
+			}
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
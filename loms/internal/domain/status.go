@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type OrderStatus string
+
+const (
+	StatusNew             OrderStatus = "new"
+	StatusAwaitingPayment OrderStatus = "awaiting payment"
+	StatusAwaitingStock   OrderStatus = "awaiting stock"
+	StatusFailed          OrderStatus = "failed"
+	StatusPayed           OrderStatus = "payed"
+	StatusCancelled       OrderStatus = "cancelled"
+	StatusReturnRequested OrderStatus = "return requested"
+	StatusReturned        OrderStatus = "returned"
+	StatusAssembling      OrderStatus = "assembling"
+	StatusShipped         OrderStatus = "shipped"
+	StatusDelivered       OrderStatus = "delivered"
+	StatusUndefined       OrderStatus = "undefined"
+)
+
+// Причины смены статуса, сохраняемые в истории заказа
+const (
+	ReasonItemsReserved     = "items reserved"
+	ReasonInsufficientStock = "insufficient stock"
+	ReasonBackordered       = "items backordered"
+	ReasonBackorderFilled   = "backordered items received"
+	ReasonReservationError  = "reservation error"
+	ReasonPaymentReceived   = "payment received"
+	ReasonCancelledByUser   = "cancelled by user"
+	ReasonPaymentTimeout    = "payment timeout"
+	ReasonBackorderTimeout  = "backorder timeout"
+	ReasonPaymentFailed     = "payment failed"
+	ReasonReturnRequested   = "return requested by user"
+	ReasonReturnReceived    = "returned items received"
+	ReasonAssemblyStarted   = "assembly started"
+	ReasonShipped           = "all shipments sent"
+	ReasonDelivered         = "all shipments delivered"
+)
+
+var ErrIllegalTransition = errors.New("illegal order status transition")
+
+type StatusChange struct {
+	OrderID   int64
+	From      OrderStatus
+	To        OrderStatus
+	Reason    string
+	CreatedAt time.Time
+}
+
+var transitions = map[OrderStatus][]OrderStatus{
+	StatusNew:             {StatusAwaitingPayment, StatusAwaitingStock, StatusFailed},
+	StatusAwaitingStock:   {StatusAwaitingPayment, StatusCancelled},
+	StatusAwaitingPayment: {StatusPayed, StatusCancelled},
+	StatusPayed:           {StatusCancelled, StatusReturnRequested, StatusAssembling},
+	StatusAssembling:      {StatusShipped},
+	StatusShipped:         {StatusDelivered},
+	StatusDelivered:       {StatusReturnRequested},
+	StatusReturnRequested: {StatusReturned},
+}
+
+type TransitionError struct {
+	From OrderStatus
+	To   OrderStatus
+}
+
+func (e *TransitionError) Error() string {
+	return fmt.Sprintf("%s from %q to %q", ErrIllegalTransition, e.From, e.To)
+}
+
+func (e *TransitionError) Is(target error) bool {
+	return target == ErrIllegalTransition
+}
+
+func Transition(from, to OrderStatus) error {
+	for _, next := range transitions[from] {
+		if next == to {
+			return nil
+		}
+	}
+	return &TransitionError{From: from, To: to}
+}
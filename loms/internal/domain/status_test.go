@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransition(t *testing.T) {
+	statuses := []OrderStatus{
+		StatusNew,
+		StatusAwaitingPayment,
+		StatusAwaitingStock,
+		StatusFailed,
+		StatusPayed,
+		StatusCancelled,
+		StatusReturnRequested,
+		StatusReturned,
+		StatusAssembling,
+		StatusShipped,
+		StatusDelivered,
+		StatusUndefined,
+	}
+	allowed := map[OrderStatus]map[OrderStatus]bool{
+		StatusNew: {
+			StatusAwaitingPayment: true,
+			StatusAwaitingStock:   true,
+			StatusFailed:          true,
+		},
+		StatusAwaitingStock: {
+			StatusAwaitingPayment: true,
+			StatusCancelled:       true,
+		},
+		StatusAwaitingPayment: {
+			StatusPayed:     true,
+			StatusCancelled: true,
+		},
+		StatusPayed: {
+			StatusCancelled:       true,
+			StatusReturnRequested: true,
+			StatusAssembling:      true,
+		},
+		StatusAssembling: {
+			StatusShipped: true,
+		},
+		StatusShipped: {
+			StatusDelivered: true,
+		},
+		StatusDelivered: {
+			StatusReturnRequested: true,
+		},
+		StatusReturnRequested: {
+			StatusReturned: true,
+		},
+	}
+
+	type testCase struct {
+		name string
+		from OrderStatus
+		to   OrderStatus
+		ok   bool
+	}
+	var tests []testCase
+	for _, from := range statuses {
+		for _, to := range statuses {
+			tests = append(tests, testCase{
+				name: fmt.Sprintf("%s -> %s", from, to),
+				from: from,
+				to:   to,
+				ok:   allowed[from][to],
+			})
+		}
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := Transition(tt.from, tt.to)
+			if tt.ok {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, ErrIllegalTransition)
+			var transitionErr *TransitionError
+			require.True(t, errors.As(err, &transitionErr))
+			require.Equal(t, tt.from, transitionErr.From)
+			require.Equal(t, tt.to, transitionErr.To)
+		})
+	}
+}
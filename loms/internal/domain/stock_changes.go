@@ -0,0 +1,23 @@
+package domain
+
+type StockChange struct {
+	WarehouseID int64
+	Sku         uint32
+	Delta       int64
+}
+
+type StockChangeNotification struct {
+	ID     int64
+	Change StockChange
+}
+
+func (m StockMovement) AvailableDelta() int64 {
+	switch m.Kind {
+	case MovementReceive, MovementAdjust:
+		return m.Delta
+	case MovementReserve, MovementRelease, MovementHold, MovementReleaseHold:
+		return -m.Delta
+	default:
+		return 0
+	}
+}
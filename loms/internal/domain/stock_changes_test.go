@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAvailableDelta(t *testing.T) {
+	tests := []struct {
+		name     string
+		movement StockMovement
+		want     int64
+	}{
+		{
+			name:     "receive",
+			movement: StockMovement{Kind: MovementReceive, Delta: 10},
+			want:     10,
+		},
+		{
+			name:     "adjust",
+			movement: StockMovement{Kind: MovementAdjust, Delta: -3},
+			want:     -3,
+		},
+		{
+			name:     "reserve",
+			movement: StockMovement{Kind: MovementReserve, Delta: 2},
+			want:     -2,
+		},
+		{
+			name:     "release",
+			movement: StockMovement{Kind: MovementRelease, Delta: -2},
+			want:     2,
+		},
+		{
+			name:     "hold",
+			movement: StockMovement{Kind: MovementHold, Delta: 4},
+			want:     -4,
+		},
+		{
+			name:     "release hold",
+			movement: StockMovement{Kind: MovementReleaseHold, Delta: -4},
+			want:     4,
+		},
+		{
+			name:     "sell",
+			movement: StockMovement{Kind: MovementSell, Delta: -2},
+			want:     0,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.want, tt.movement.AvailableDelta())
+		})
+	}
+}
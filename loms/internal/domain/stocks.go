@@ -8,11 +8,21 @@ import (
 
 type Stock struct {
 	WarehouseID int64
-	Count       uint64
+	//Продавец, которому принадлежит склад, 0 - сам маркетплейс
+	SellerID int64
+	Count    uint64
 }
 
-func (d *domain) Stocks(ctx context.Context, sku uint32) ([]Stock, error) {
-	stocks, err := d.OrdersRepository.Stocks(ctx, sku)
+func (d *domain) Stocks(ctx context.Context, sku uint32, sellerID int64) ([]Stock, error) {
+	stocks, err := d.OrdersRepository.Stocks(ctx, sku, sellerID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get stocks")
+	}
+	return stocks, nil
+}
+
+func (d *domain) BatchStocks(ctx context.Context, skus []uint32) (map[uint32][]Stock, error) {
+	stocks, err := d.OrdersRepository.BatchStocks(ctx, skus)
 	if err != nil {
 		return nil, errors.Wrap(err, "get stocks")
 	}
@@ -15,8 +15,9 @@ func TestStocks(t *testing.T) {
 	type tmMockFunc func(mc *minimock.Controller) TransactionManager
 
 	type args struct {
-		ctx context.Context
-		sku uint32
+		ctx      context.Context
+		sku      uint32
+		sellerID int64
 	}
 
 	var (
@@ -36,6 +37,7 @@ func TestStocks(t *testing.T) {
 			},
 			{
 				WarehouseID: gofakeit.Int64(),
+				SellerID:    7,
 				Count:       gofakeit.Uint64(),
 			},
 		}
@@ -56,11 +58,11 @@ func TestStocks(t *testing.T) {
 				ctx: ctx,
 				sku: sku,
 			},
-			want: stocks,
+			want: stocks[:2],
 			err:  nil,
 			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
 				mock := NewOrdersRepositoryMock(t)
-				mock.StocksMock.Expect(ctx, sku).Return(stocks, nil)
+				mock.StocksMock.Expect(ctx, sku, int64(0)).Return(stocks[:2], nil)
 				return mock
 			},
 			tmMock: func(mc *minimock.Controller) TransactionManager {
@@ -68,6 +70,24 @@ func TestStocks(t *testing.T) {
 				return mock
 			},
 		},
+		{
+			name: "positive case - seller stocks",
+			args: args{
+				ctx:      ctx,
+				sku:      sku,
+				sellerID: 7,
+			},
+			want: stocks[2:],
+			err:  nil,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.StocksMock.Expect(ctx, sku, int64(7)).Return(stocks[2:], nil)
+				return mock
+			},
+			tmMock: func(mc *minimock.Controller) TransactionManager {
+				return NewTransactionManagerMock(t)
+			},
+		},
 		{
 			name: "negative case - stocks error",
 			args: args{
@@ -78,7 +98,7 @@ func TestStocks(t *testing.T) {
 			err:  stocksErr,
 			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
 				mock := NewOrdersRepositoryMock(t)
-				mock.StocksMock.Expect(ctx, sku).Return(nil, stocksErr)
+				mock.StocksMock.Expect(ctx, sku, int64(0)).Return(nil, stocksErr)
 				return mock
 			},
 			tmMock: func(mc *minimock.Controller) TransactionManager {
@@ -96,7 +116,7 @@ func TestStocks(t *testing.T) {
 				tt.repositoryMock(mc),
 				tt.tmMock(mc),
 			)
-			result, err := api.Stocks(tt.args.ctx, tt.args.sku)
+			result, err := api.Stocks(tt.args.ctx, tt.args.sku, tt.args.sellerID)
 			require.Equal(t, tt.want, result)
 			if tt.err != nil {
 				require.ErrorContains(t, err, tt.err.Error())
@@ -107,3 +127,77 @@ func TestStocks(t *testing.T) {
 	}
 
 }
+
+func TestBatchStocks(t *testing.T) {
+	type repositoryMockFunc func(mc *minimock.Controller) OrdersRepository
+
+	type args struct {
+		ctx  context.Context
+		skus []uint32
+	}
+
+	var (
+		mc  = minimock.NewController(t)
+		ctx = context.Background()
+
+		stocksErr = errors.New("stocks error")
+		skus      = []uint32{gofakeit.Uint32(), gofakeit.Uint32()}
+		stocks    = map[uint32][]Stock{
+			skus[0]: {
+				{
+					WarehouseID: gofakeit.Int64(),
+					Count:       gofakeit.Uint64(),
+				},
+			},
+		}
+	)
+	t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name           string
+		args           args
+		want           map[uint32][]Stock
+		err            error
+		repositoryMock repositoryMockFunc
+	}{
+		{
+			name: "positive case",
+			args: args{
+				ctx:  ctx,
+				skus: skus,
+			},
+			want: stocks,
+			err:  nil,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.BatchStocksMock.Expect(ctx, skus).Return(stocks, nil)
+				return mock
+			},
+		},
+		{
+			name: "negative case - stocks error",
+			args: args{
+				ctx:  ctx,
+				skus: skus,
+			},
+			want: nil,
+			err:  stocksErr,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.BatchStocksMock.Expect(ctx, skus).Return(nil, stocksErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			api := NewMock(tt.repositoryMock(mc))
+			result, err := api.BatchStocks(tt.args.ctx, tt.args.skus)
+			require.Equal(t, tt.want, result)
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
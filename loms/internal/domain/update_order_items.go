@@ -0,0 +1,132 @@
+package domain
+
+import (
+	"context"
+	"math"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrOrderNotEditable  = errors.New("only orders awaiting payment can be edited")
+	ErrItemCountOverflow = errors.New("total count of the sku exceeds 65535")
+)
+
+func (d *domain) UpdateOrderItems(ctx context.Context, orderID int64, items []OrderItem) error {
+	items, err := mergeItems(items)
+	if err != nil {
+		return err
+	}
+	err = d.TransactionManager.RunTransaction(ctx, isoLevelSerializable, func(ctxTX context.Context) error {
+		order, err := d.OrdersRepository.GetOrder(ctxTX, orderID)
+		if err != nil {
+			return errors.Wrap(err, "get order")
+		}
+		if order.Status != StatusAwaitingPayment {
+			return ErrOrderNotEditable
+		}
+		released, err := d.OrdersRepository.UnReserveItems(ctxTX, orderID)
+		if err != nil {
+			return errors.Wrap(err, "unreserve items")
+		}
+		err = d.setSnapshots(ctxTX, items, order.Items)
+		if err != nil {
+			return err
+		}
+		order.Items = items
+		reserveFrom, err := d.planReservation(ctxTX, order)
+		if err != nil {
+			return err
+		}
+		for _, v := range reserveFrom {
+			err = d.OrdersRepository.ReserveStock(ctxTX, orderID, v)
+			if err != nil {
+				return errors.Wrap(err, "reserve stock")
+			}
+		}
+		//Освободившийся после уменьшения позиций товар достается ждущим его заказам
+		err = d.allocateReleased(ctxTX, released)
+		if err != nil {
+			return err
+		}
+		err = d.OrdersRepository.UpdateOrderItems(ctxTX, orderID, items)
+		if err != nil {
+			return errors.Wrap(err, "update order items")
+		}
+		//Состав заказов продавцов меняется вместе с заказом
+		err = d.OrdersRepository.DeleteSellerOrders(ctxTX, orderID)
+		if err != nil {
+			return errors.Wrap(err, "delete seller orders")
+		}
+		err = d.createSellerOrders(ctxTX, order)
+		if err != nil {
+			return err
+		}
+		err = d.OrdersRepository.CreateOrderNotification(ctxTX, order)
+		if err != nil {
+			return errors.Wrap(err, "create order notification")
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "update order items")
+	}
+	return nil
+}
+
+func (d *domain) setSnapshots(ctx context.Context, items, previous []OrderItem) error {
+	snapshots := make(map[uint32]Product, len(previous))
+	for _, item := range previous {
+		//У заказов, созданных до снимка цен, цены нет
+		if item.Price != 0 {
+			snapshots[item.Sku] = Product{Name: item.Name, Price: item.Price}
+		}
+	}
+	for i, item := range items {
+		product, ok := snapshots[item.Sku]
+		if !ok {
+			var err error
+			product, err = d.ProductService.GetProduct(ctx, item.Sku)
+			if err != nil {
+				return errors.Wrap(err, "get product")
+			}
+			snapshots[item.Sku] = product
+		}
+		items[i].Name = product.Name
+		items[i].Price = product.Price
+	}
+	return nil
+}
+
+func keepSnapshots(items, previous []OrderItem) []OrderItem {
+	snapshots := make(map[offer]OrderItem, len(previous))
+	for _, item := range previous {
+		snapshots[itemOffer(item)] = item
+	}
+	for i, item := range items {
+		snapshot, ok := snapshots[itemOffer(item)]
+		if item.Price != 0 || !ok {
+			continue
+		}
+		items[i].Name = snapshot.Name
+		items[i].Price = snapshot.Price
+	}
+	return items
+}
+
+func mergeItems(items []OrderItem) ([]OrderItem, error) {
+	merged := make([]OrderItem, 0, len(items))
+	index := make(map[offer]int, len(items))
+	for _, item := range items {
+		if i, ok := index[itemOffer(item)]; ok {
+			if uint32(merged[i].Count)+uint32(item.Count) > math.MaxUint16 {
+				return nil, ErrItemCountOverflow
+			}
+			merged[i].Count += item.Count
+			continue
+		}
+		index[itemOffer(item)] = len(merged)
+		merged = append(merged, item)
+	}
+	return merged, nil
+}
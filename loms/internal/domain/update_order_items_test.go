@@ -0,0 +1,285 @@
+package domain
+
+import (
+	"context"
+	transactor "route256/libs/postgres_transactor"
+	txMock "route256/libs/postgres_transactor/mocks"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateOrderItems(t *testing.T) {
+	type repositoryMockFunc func(mc *minimock.Controller) OrdersRepository
+	type tmMockFunc func(mc *minimock.Controller) TransactionManager
+	type productsMockFunc func(mc *minimock.Controller) ProductService
+
+	type args struct {
+		ctx     context.Context
+		orderID int64
+		items   []OrderItem
+	}
+
+	var (
+		mc    = minimock.NewController(t)
+		tx    = txMock.NewTxMock(t)
+		ctx   = context.Background()
+		ctxTx = context.WithValue(ctx, transactor.TxKey("tx"), tx)
+
+		unreserveErr = errors.New("unreserve error")
+		updateErr    = errors.New("update error")
+
+		orderID     = gofakeit.Int64()
+		warehouseID = gofakeit.Int64()
+		sku         = gofakeit.Uint32()
+		order       = &Order{
+			ID:     orderID,
+			Status: StatusAwaitingPayment,
+			User:   gofakeit.Int64(),
+			Items: []OrderItem{
+				{Sku: sku, Count: 5},
+				{Sku: gofakeit.Uint32(), Count: 1},
+			},
+		}
+		pricedOrder = &Order{
+			ID:     orderID,
+			Status: StatusAwaitingPayment,
+			User:   gofakeit.Int64(),
+			Items: []OrderItem{
+				{Sku: sku, Count: 5, Name: "book", Price: 100},
+			},
+		}
+		payedOrder = &Order{
+			ID:     orderID,
+			Status: StatusPayed,
+			User:   gofakeit.Int64(),
+		}
+		product  = Product{Name: "pen", Price: 20}
+		products = func(mc *minimock.Controller) ProductService {
+			mock := NewProductServiceMock(t)
+			mock.GetProductMock.Expect(ctxTx, sku).Return(product, nil)
+			return mock
+		}
+		noProducts = func(mc *minimock.Controller) ProductService {
+			return NewProductServiceMock(t)
+		}
+		//Вторая позиция удалена, первая уменьшена и передана двумя строками
+		items       = []OrderItem{{Sku: sku, Count: 1}, {Sku: sku, Count: 2}}
+		mergedItems = []OrderItem{{Sku: sku, Count: 3, Name: product.Name, Price: product.Price}}
+		stocks      = []Stock{{WarehouseID: warehouseID, Count: 10}}
+	)
+	t.Cleanup(mc.Finish)
+
+	tmMock := func(mc *minimock.Controller) TransactionManager {
+		mock := NewTransactionManagerMock(t)
+		mock.RunTransactionMock.Set(func(ctx context.Context, isoLevel string, f func(ctxTX context.Context) error) error {
+			return f(ctxTx)
+		})
+		return mock
+	}
+
+	tests := []struct {
+		name           string
+		args           args
+		err            error
+		repositoryMock repositoryMockFunc
+		tmMock         tmMockFunc
+		productsMock   productsMockFunc
+	}{
+		{
+			name: "positive case",
+			args: args{
+				ctx:     ctx,
+				orderID: orderID,
+				items:   items,
+			},
+			err:          nil,
+			productsMock: products,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetOrderMock.Expect(ctxTx, orderID).Return(cloneOrder(order), nil)
+				mock.UnReserveItemsMock.Expect(ctxTx, orderID).Return(nil, nil)
+				mock.BatchStocksMock.Expect(ctxTx, []uint32{sku}).Return(map[uint32][]Stock{sku: stocks}, nil)
+				mock.ReserveStockMock.Expect(ctxTx, orderID, ReservedItem{
+					WarehouseID: warehouseID,
+					OrderItem:   OrderItem{Sku: sku, Count: 3},
+				}).Return(nil)
+				mock.UpdateOrderItemsMock.Expect(ctxTx, orderID, mergedItems).Return(nil)
+				mock.DeleteSellerOrdersMock.Expect(ctxTx, orderID).Return(nil)
+				mock.CreateSellerOrderMock.Expect(ctxTx, SellerOrder{OrderID: orderID, Status: StatusAwaitingPayment, Items: mergedItems}).Return(nil)
+				mock.CreateOrderNotificationMock.Set(func(ctx context.Context, order *Order) (err error) {
+					require.Equal(t, mergedItems, order.Items)
+					return nil
+				})
+				return mock
+			},
+			tmMock: tmMock,
+		},
+		{
+			name: "positive case - freed stock goes to backorders",
+			args: args{
+				ctx:     ctx,
+				orderID: orderID,
+				items:   items,
+			},
+			err:          nil,
+			productsMock: products,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetOrderMock.Expect(ctxTx, orderID).Return(cloneOrder(order), nil)
+				mock.UnReserveItemsMock.Expect(ctxTx, orderID).Return([]ReservedItem{
+					{WarehouseID: warehouseID, OrderItem: OrderItem{Sku: sku, Count: 5}},
+				}, nil)
+				mock.BatchStocksMock.Expect(ctxTx, []uint32{sku}).Return(map[uint32][]Stock{sku: stocks}, nil)
+				mock.ReserveStockMock.When(ctxTx, orderID, ReservedItem{
+					WarehouseID: warehouseID,
+					OrderItem:   OrderItem{Sku: sku, Count: 3},
+				}).Then(nil)
+				mock.ListSkuBackordersMock.Expect(ctxTx, sku, 0).Return([]Backorder{{OrderID: orderID + 1, Sku: sku, Count: 2}}, nil)
+				mock.ReserveStockMock.When(ctxTx, orderID+1, ReservedItem{
+					WarehouseID: warehouseID,
+					OrderItem:   OrderItem{Sku: sku, Count: 2},
+				}).Then(nil)
+				mock.UpdateBackorderMock.Expect(ctxTx, Backorder{OrderID: orderID + 1, Sku: sku}).Return(nil)
+				mock.GetBackordersMock.Expect(ctxTx, orderID+1).Return([]OrderItem{{Sku: sku + 1, Count: 1}}, nil)
+				mock.UpdateOrderItemsMock.Expect(ctxTx, orderID, mergedItems).Return(nil)
+				mock.DeleteSellerOrdersMock.Expect(ctxTx, orderID).Return(nil)
+				mock.CreateSellerOrderMock.Return(nil)
+				mock.CreateOrderNotificationMock.Return(nil)
+				return mock
+			},
+			tmMock: tmMock,
+		},
+		{
+			name: "positive case - keeps captured prices and ignores client ones",
+			args: args{
+				ctx:     ctx,
+				orderID: orderID,
+				items:   []OrderItem{{Sku: sku, Count: 3, Name: "cheap", Price: 1}},
+			},
+			err:          nil,
+			productsMock: noProducts,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetOrderMock.Expect(ctxTx, orderID).Return(cloneOrder(pricedOrder), nil)
+				mock.UnReserveItemsMock.Expect(ctxTx, orderID).Return(nil, nil)
+				mock.BatchStocksMock.Expect(ctxTx, []uint32{sku}).Return(map[uint32][]Stock{sku: stocks}, nil)
+				mock.ReserveStockMock.Return(nil)
+				mock.UpdateOrderItemsMock.Expect(ctxTx, orderID, []OrderItem{{Sku: sku, Count: 3, Name: "book", Price: 100}}).Return(nil)
+				mock.DeleteSellerOrdersMock.Expect(ctxTx, orderID).Return(nil)
+				mock.CreateSellerOrderMock.Return(nil)
+				mock.CreateOrderNotificationMock.Return(nil)
+				return mock
+			},
+			tmMock: tmMock,
+		},
+		{
+			name: "negative case - order is not awaiting payment",
+			args: args{
+				ctx:     ctx,
+				orderID: orderID,
+				items:   items,
+			},
+			err:          ErrOrderNotEditable,
+			productsMock: noProducts,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetOrderMock.Expect(ctxTx, orderID).Return(cloneOrder(payedOrder), nil)
+				return mock
+			},
+			tmMock: tmMock,
+		},
+		{
+			name: "negative case - merged count overflow",
+			args: args{
+				ctx:     ctx,
+				orderID: orderID,
+				items:   []OrderItem{{Sku: sku, Count: 65535}, {Sku: sku, Count: 1}},
+			},
+			err:          ErrItemCountOverflow,
+			productsMock: noProducts,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				return NewOrdersRepositoryMock(t)
+			},
+			tmMock: func(mc *minimock.Controller) TransactionManager {
+				return NewTransactionManagerMock(t)
+			},
+		},
+		{
+			name: "negative case - not enough stock",
+			args: args{
+				ctx:     ctx,
+				orderID: orderID,
+				items:   []OrderItem{{Sku: sku, Count: 11}},
+			},
+			err:          ErrCantReserveItem,
+			productsMock: products,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetOrderMock.Expect(ctxTx, orderID).Return(cloneOrder(order), nil)
+				mock.UnReserveItemsMock.Expect(ctxTx, orderID).Return(nil, nil)
+				mock.BatchStocksMock.Expect(ctxTx, []uint32{sku}).Return(map[uint32][]Stock{sku: stocks}, nil)
+				return mock
+			},
+			tmMock: tmMock,
+		},
+		{
+			name: "negative case - unreserve items",
+			args: args{
+				ctx:     ctx,
+				orderID: orderID,
+				items:   items,
+			},
+			err:          unreserveErr,
+			productsMock: noProducts,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetOrderMock.Expect(ctxTx, orderID).Return(cloneOrder(order), nil)
+				mock.UnReserveItemsMock.Expect(ctxTx, orderID).Return(nil, unreserveErr)
+				return mock
+			},
+			tmMock: tmMock,
+		},
+		{
+			name: "negative case - update items",
+			args: args{
+				ctx:     ctx,
+				orderID: orderID,
+				items:   items,
+			},
+			err:          updateErr,
+			productsMock: products,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.GetOrderMock.Expect(ctxTx, orderID).Return(cloneOrder(order), nil)
+				mock.UnReserveItemsMock.Expect(ctxTx, orderID).Return(nil, nil)
+				mock.BatchStocksMock.Expect(ctxTx, []uint32{sku}).Return(map[uint32][]Stock{sku: stocks}, nil)
+				mock.ReserveStockMock.Return(nil)
+				mock.UpdateOrderItemsMock.Expect(ctxTx, orderID, mergedItems).Return(updateErr)
+				return mock
+			},
+			tmMock: tmMock,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			api := NewMock(
+				tt.repositoryMock(mc),
+				tt.tmMock(mc),
+				tt.productsMock(mc),
+			)
+			err := api.UpdateOrderItems(tt.args.ctx, tt.args.orderID, tt.args.items)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
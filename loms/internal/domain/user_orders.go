@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultOrdersPageSize = 20
+	maxOrdersPageSize     = 100
+)
+
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+type UserOrdersFilter struct {
+	User int64
+	//Пустой статус - заказы в любом статусе
+	Status      OrderStatus
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	PageToken   string
+	PageSize    uint64
+}
+
+type UserOrdersQuery struct {
+	User        int64
+	Status      OrderStatus
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	BeforeID    int64
+	Limit       uint64
+}
+
+func (d *domain) ListUserOrders(ctx context.Context, filter UserOrdersFilter) ([]*Order, string, error) {
+	pageSize := filter.PageSize
+	if pageSize == 0 {
+		pageSize = defaultOrdersPageSize
+	}
+	if pageSize > maxOrdersPageSize {
+		pageSize = maxOrdersPageSize
+	}
+	beforeID, err := decodePageToken(filter.PageToken)
+	if err != nil {
+		return nil, "", err
+	}
+	//Запрашиваем на один заказ больше, чтобы понять, есть ли следующая страница
+	orders, err := d.OrdersRepository.ListUserOrders(ctx, UserOrdersQuery{
+		User:        filter.User,
+		Status:      filter.Status,
+		CreatedFrom: filter.CreatedFrom,
+		CreatedTo:   filter.CreatedTo,
+		BeforeID:    beforeID,
+		Limit:       pageSize + 1,
+	})
+	if err != nil {
+		return nil, "", errors.Wrap(err, "list user orders")
+	}
+	var nextPageToken string
+	if uint64(len(orders)) > pageSize {
+		orders = orders[:pageSize]
+		nextPageToken = encodePageToken(orders[len(orders)-1].ID)
+	}
+	return orders, nextPageToken, nil
+}
+
+func encodePageToken(lastID int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(lastID, 10)))
+}
+
+func decodePageToken(token string) (int64, error) {
+	if token == "" {
+		return 0, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, ErrInvalidPageToken
+	}
+	lastID, err := strconv.ParseInt(string(raw), 10, 64)
+	if err != nil || lastID <= 0 {
+		return 0, ErrInvalidPageToken
+	}
+	return lastID, nil
+}
@@ -0,0 +1,168 @@
+package domain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListUserOrders(t *testing.T) {
+	type repositoryMockFunc func(mc *minimock.Controller) OrdersRepository
+
+	type args struct {
+		ctx    context.Context
+		filter UserOrdersFilter
+	}
+
+	var (
+		mc  = minimock.NewController(t)
+		ctx = context.Background()
+
+		listErr = errors.New("list error")
+
+		user        = gofakeit.Int64()
+		createdFrom = time.Now().Add(-time.Hour)
+		createdTo   = time.Now()
+		orders      = []*Order{
+			{ID: 30, User: user, Status: StatusPayed},
+			{ID: 20, User: user, Status: StatusPayed},
+			{ID: 10, User: user, Status: StatusPayed},
+		}
+	)
+	t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name           string
+		args           args
+		want           []*Order
+		wantToken      string
+		err            error
+		repositoryMock repositoryMockFunc
+	}{
+		{
+			name: "positive case - first page",
+			args: args{
+				ctx: ctx,
+				filter: UserOrdersFilter{
+					User:        user,
+					Status:      StatusPayed,
+					CreatedFrom: createdFrom,
+					CreatedTo:   createdTo,
+					PageSize:    2,
+				},
+			},
+			want:      orders[:2],
+			wantToken: encodePageToken(20),
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.ListUserOrdersMock.Expect(ctx, UserOrdersQuery{
+					User:        user,
+					Status:      StatusPayed,
+					CreatedFrom: createdFrom,
+					CreatedTo:   createdTo,
+					Limit:       3,
+				}).Return(orders, nil)
+				return mock
+			},
+		},
+		{
+			name: "positive case - last page",
+			args: args{
+				ctx: ctx,
+				filter: UserOrdersFilter{
+					User:      user,
+					PageToken: encodePageToken(20),
+					PageSize:  2,
+				},
+			},
+			want:      orders[2:],
+			wantToken: "",
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.ListUserOrdersMock.Expect(ctx, UserOrdersQuery{
+					User:     user,
+					BeforeID: 20,
+					Limit:    3,
+				}).Return(orders[2:], nil)
+				return mock
+			},
+		},
+		{
+			name: "positive case - default page size",
+			args: args{
+				ctx:    ctx,
+				filter: UserOrdersFilter{User: user},
+			},
+			want: nil,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.ListUserOrdersMock.Expect(ctx, UserOrdersQuery{
+					User:  user,
+					Limit: defaultOrdersPageSize + 1,
+				}).Return(nil, nil)
+				return mock
+			},
+		},
+		{
+			name: "positive case - page size is limited",
+			args: args{
+				ctx:    ctx,
+				filter: UserOrdersFilter{User: user, PageSize: 1000},
+			},
+			want: nil,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.ListUserOrdersMock.Expect(ctx, UserOrdersQuery{
+					User:  user,
+					Limit: maxOrdersPageSize + 1,
+				}).Return(nil, nil)
+				return mock
+			},
+		},
+		{
+			name: "negative case - invalid page token",
+			args: args{
+				ctx:    ctx,
+				filter: UserOrdersFilter{User: user, PageToken: "not a token"},
+			},
+			err: ErrInvalidPageToken,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				return NewOrdersRepositoryMock(t)
+			},
+		},
+		{
+			name: "negative case - list orders",
+			args: args{
+				ctx:    ctx,
+				filter: UserOrdersFilter{User: user},
+			},
+			err: listErr,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.ListUserOrdersMock.Return(nil, listErr)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			api := NewMock(tt.repositoryMock(mc))
+			orders, token, err := api.ListUserOrders(tt.args.ctx, tt.args.filter)
+			require.Equal(t, tt.want, orders)
+			require.Equal(t, tt.wantToken, token)
+			if tt.err != nil {
+				require.ErrorContains(t, err, tt.err.Error())
+			} else {
+				require.Equal(t, tt.err, err)
+			}
+		})
+	}
+}
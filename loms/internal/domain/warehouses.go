@@ -0,0 +1,118 @@
+package domain
+
+import (
+	"context"
+	"math"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrWarehouseNotFound     = errors.New("warehouse not found")
+	ErrWarehouseExists       = errors.New("warehouse already exists")
+	ErrStockNotFound         = errors.New("no stock of the sku in the warehouse")
+	ErrStockBelowReserved    = errors.New("stock can not drop below reserved quantity")
+	ErrZeroStockChange       = errors.New("stock change must not be zero")
+	ErrStockChangeOutOfRange = errors.New("stock change is out of range")
+	ErrEmptyReason           = errors.New("reason must not be empty")
+)
+
+type StockMovementKind string
+
+const (
+	MovementReceive     StockMovementKind = "receive"
+	MovementReserve     StockMovementKind = "reserve"
+	MovementRelease     StockMovementKind = "release"
+	MovementSell        StockMovementKind = "sell"
+	MovementAdjust      StockMovementKind = "adjust"
+	MovementHold        StockMovementKind = "hold"
+	MovementReleaseHold StockMovementKind = "release_hold"
+)
+
+type StockMovement struct {
+	WarehouseID int64
+	Sku         uint32
+	Kind        StockMovementKind
+	Delta       int64
+	Reason      string
+	//Заполняется для движений, связанных с заказом
+	OrderID int64
+}
+
+type WarehouseStock struct {
+	Sku      uint32
+	Count    uint64
+	Reserved uint64
+	Held     uint64
+}
+
+func (d *domain) CreateWarehouse(ctx context.Context, name string, sellerID int64) (int64, error) {
+	id, err := d.WarehousesRepository.CreateWarehouse(ctx, name, sellerID)
+	if err != nil {
+		return 0, errors.Wrap(err, "create warehouse")
+	}
+	return id, nil
+}
+
+func (d *domain) ReceiveStock(ctx context.Context, warehouseID int64, sku uint32, count uint64) error {
+	if count == 0 {
+		return ErrZeroStockChange
+	}
+	if count > math.MaxInt32 {
+		return ErrStockChangeOutOfRange
+	}
+	err := d.TransactionManager.RunTransaction(ctx, isoLevelSerializable, func(ctxTX context.Context) error {
+		err := d.WarehousesRepository.ChangeStock(ctxTX, StockMovement{
+			WarehouseID: warehouseID,
+			Sku:         sku,
+			Kind:        MovementReceive,
+			Delta:       int64(count),
+		})
+		if err != nil {
+			return err
+		}
+		return d.allocateBackorders(ctxTX, warehouseID, sku)
+	})
+	if err != nil {
+		return errors.Wrap(err, "receive stock")
+	}
+	return nil
+}
+
+func (d *domain) AdjustStock(ctx context.Context, warehouseID int64, sku uint32, delta int64, reason string) error {
+	if delta == 0 {
+		return ErrZeroStockChange
+	}
+	//Остатки хранятся в integer
+	if delta < math.MinInt32 || delta > math.MaxInt32 {
+		return ErrStockChangeOutOfRange
+	}
+	if reason == "" {
+		return ErrEmptyReason
+	}
+	err := d.TransactionManager.RunTransaction(ctx, isoLevelSerializable, func(ctxTX context.Context) error {
+		err := d.WarehousesRepository.ChangeStock(ctxTX, StockMovement{
+			WarehouseID: warehouseID,
+			Sku:         sku,
+			Kind:        MovementAdjust,
+			Delta:       delta,
+			Reason:      reason,
+		})
+		if err != nil || delta < 0 {
+			return err
+		}
+		return d.allocateBackorders(ctxTX, warehouseID, sku)
+	})
+	if err != nil {
+		return errors.Wrap(err, "adjust stock")
+	}
+	return nil
+}
+
+func (d *domain) ListWarehouseStock(ctx context.Context, warehouseID int64) ([]WarehouseStock, error) {
+	stocks, err := d.WarehousesRepository.ListWarehouseStock(ctx, warehouseID)
+	if err != nil {
+		return nil, errors.Wrap(err, "list warehouse stock")
+	}
+	return stocks, nil
+}
@@ -0,0 +1,417 @@
+package domain
+
+import (
+	"context"
+	"math"
+	transactor "route256/libs/postgres_transactor"
+	txMock "route256/libs/postgres_transactor/mocks"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReceiveStock(t *testing.T) {
+	type warehousesMockFunc func(mc *minimock.Controller) WarehousesRepository
+	type repositoryMockFunc func(mc *minimock.Controller) OrdersRepository
+
+	type args struct {
+		ctx         context.Context
+		warehouseID int64
+		sku         uint32
+		count       uint64
+	}
+
+	var (
+		mc    = minimock.NewController(t)
+		tx    = txMock.NewTxMock(t)
+		ctx   = context.Background()
+		ctxTx = context.WithValue(ctx, transactor.TxKey("tx"), tx)
+
+		warehouseID = gofakeit.Int64()
+		sku         = gofakeit.Uint32()
+		count       = uint64(gofakeit.Uint16()%1000) + 10
+		movement    = StockMovement{
+			WarehouseID: warehouseID,
+			Sku:         sku,
+			Kind:        MovementReceive,
+			Delta:       int64(count),
+		}
+		first  = &Order{ID: 1, Status: StatusAwaitingStock, User: gofakeit.Int64()}
+		second = &Order{ID: 2, Status: StatusAwaitingStock, User: gofakeit.Int64()}
+
+		receive = func(mc *minimock.Controller) WarehousesRepository {
+			mock := NewWarehousesRepositoryMock(t)
+			mock.ChangeStockMock.Expect(ctxTx, movement).Return(nil)
+			return mock
+		}
+	)
+	t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name           string
+		args           args
+		err            error
+		warehousesMock warehousesMockFunc
+		repositoryMock repositoryMockFunc
+	}{
+		{
+			name: "positive case",
+			args: args{
+				ctx:         ctx,
+				warehouseID: warehouseID,
+				sku:         sku,
+				count:       count,
+			},
+			err:            nil,
+			warehousesMock: receive,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.BatchStocksMock.Expect(ctxTx, []uint32{sku}).Return(map[uint32][]Stock{
+					sku: {{WarehouseID: warehouseID, Count: count}},
+				}, nil)
+				mock.ListSkuBackordersMock.Expect(ctxTx, sku, int64(0)).Return(nil, nil)
+				return mock
+			},
+		},
+		{
+			name: "positive case - backorders filled in order",
+			args: args{
+				ctx:         ctx,
+				warehouseID: warehouseID,
+				sku:         sku,
+				count:       count,
+			},
+			err:            nil,
+			warehousesMock: receive,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.BatchStocksMock.Expect(ctxTx, []uint32{sku}).Return(map[uint32][]Stock{
+					sku: {
+						{WarehouseID: gofakeit.Int64(), Count: count},
+						{WarehouseID: warehouseID, Count: count},
+					},
+				}, nil)
+				mock.ListSkuBackordersMock.Expect(ctxTx, sku, int64(0)).Return([]Backorder{
+					{OrderID: first.ID, Sku: sku, Count: 3},
+					{OrderID: second.ID, Sku: sku, Count: uint16(count)},
+				}, nil)
+				mock.ReserveStockMock.When(ctxTx, first.ID, ReservedItem{
+					OrderItem:   OrderItem{Sku: sku, Count: 3},
+					WarehouseID: warehouseID,
+				}).Then(nil)
+				mock.ReserveStockMock.When(ctxTx, second.ID, ReservedItem{
+					OrderItem:   OrderItem{Sku: sku, Count: uint16(count) - 3},
+					WarehouseID: warehouseID,
+				}).Then(nil)
+				mock.UpdateBackorderMock.When(ctxTx, Backorder{OrderID: first.ID, Sku: sku, Count: 0}).Then(nil)
+				mock.UpdateBackorderMock.When(ctxTx, Backorder{OrderID: second.ID, Sku: sku, Count: 3}).Then(nil)
+				mock.GetBackordersMock.Expect(ctxTx, first.ID).Return(nil, nil)
+				mock.GetOrderMock.Expect(ctxTx, first.ID).Return(cloneOrder(first), nil)
+				mock.SetPaymentDeadlineMock.Set(func(ctx context.Context, id int64, deadline time.Time) (err error) {
+					return nil
+				})
+				mock.UpdateOrderStatusMock.Expect(ctxTx, first.ID, StatusAwaitingPayment, StatusAwaitingStock).Return(nil)
+				mock.AddStatusChangeMock.Expect(ctxTx, StatusChange{
+					OrderID: first.ID,
+					From:    StatusAwaitingStock,
+					To:      StatusAwaitingPayment,
+					Reason:  ReasonBackorderFilled,
+				}).Return(nil)
+				mock.CreateOrderNotificationMock.Return(nil)
+				mock.UpdateSellerOrdersStatusMock.Expect(ctxTx, first.ID, nil, StatusAwaitingPayment).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "positive case - order still waits for other skus",
+			args: args{
+				ctx:         ctx,
+				warehouseID: warehouseID,
+				sku:         sku,
+				count:       count,
+			},
+			err:            nil,
+			warehousesMock: receive,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.BatchStocksMock.Expect(ctxTx, []uint32{sku}).Return(map[uint32][]Stock{
+					sku: {{WarehouseID: warehouseID, Count: count}},
+				}, nil)
+				mock.ListSkuBackordersMock.Expect(ctxTx, sku, int64(0)).Return([]Backorder{
+					{OrderID: first.ID, Sku: sku, Count: 3},
+				}, nil)
+				mock.ReserveStockMock.Expect(ctxTx, first.ID, ReservedItem{
+					OrderItem:   OrderItem{Sku: sku, Count: 3},
+					WarehouseID: warehouseID,
+				}).Return(nil)
+				mock.UpdateBackorderMock.Expect(ctxTx, Backorder{OrderID: first.ID, Sku: sku, Count: 0}).Return(nil)
+				mock.GetBackordersMock.Expect(ctxTx, first.ID).Return([]OrderItem{{Sku: gofakeit.Uint32(), Count: 1}}, nil)
+				return mock
+			},
+		},
+		{
+			name: "positive case - seller warehouse fills seller backorders",
+			args: args{
+				ctx:         ctx,
+				warehouseID: warehouseID,
+				sku:         sku,
+				count:       count,
+			},
+			err:            nil,
+			warehousesMock: receive,
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.BatchStocksMock.Expect(ctxTx, []uint32{sku}).Return(map[uint32][]Stock{
+					sku: {{WarehouseID: warehouseID, SellerID: 7, Count: count}},
+				}, nil)
+				mock.ListSkuBackordersMock.Expect(ctxTx, sku, int64(7)).Return([]Backorder{
+					{OrderID: first.ID, Sku: sku, SellerID: 7, Count: 3},
+				}, nil)
+				mock.ReserveStockMock.Expect(ctxTx, first.ID, ReservedItem{
+					OrderItem:   OrderItem{Sku: sku, Count: 3, SellerID: 7},
+					WarehouseID: warehouseID,
+				}).Return(nil)
+				mock.UpdateBackorderMock.Expect(ctxTx, Backorder{OrderID: first.ID, Sku: sku, SellerID: 7, Count: 0}).Return(nil)
+				mock.GetBackordersMock.Expect(ctxTx, first.ID).Return([]OrderItem{{Sku: gofakeit.Uint32(), Count: 1}}, nil)
+				return mock
+			},
+		},
+		{
+			name: "negative case - zero count",
+			args: args{
+				ctx:         ctx,
+				warehouseID: warehouseID,
+				sku:         sku,
+			},
+			err: ErrZeroStockChange,
+		},
+		{
+			name: "negative case - unknown warehouse",
+			args: args{
+				ctx:         ctx,
+				warehouseID: warehouseID,
+				sku:         sku,
+				count:       count,
+			},
+			err: ErrWarehouseNotFound,
+			warehousesMock: func(mc *minimock.Controller) WarehousesRepository {
+				mock := NewWarehousesRepositoryMock(t)
+				mock.ChangeStockMock.Return(ErrWarehouseNotFound)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var warehouses WarehousesRepository = NewWarehousesRepositoryMock(t)
+			if tt.warehousesMock != nil {
+				warehouses = tt.warehousesMock(mc)
+			}
+			var repository OrdersRepository = NewOrdersRepositoryMock(t)
+			if tt.repositoryMock != nil {
+				repository = tt.repositoryMock(mc)
+			}
+			tm := NewTransactionManagerMock(t)
+			if tt.args.count > 0 {
+				tm.RunTransactionMock.Set(func(ctx context.Context, isoLevel string, f func(ctxTX context.Context) error) error {
+					return f(ctxTx)
+				})
+			}
+			api := NewMock(warehouses, repository, tm)
+			err := api.ReceiveStock(tt.args.ctx, tt.args.warehouseID, tt.args.sku, tt.args.count)
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestAdjustStock(t *testing.T) {
+	type warehousesMockFunc func(mc *minimock.Controller) WarehousesRepository
+	type repositoryMockFunc func(mc *minimock.Controller) OrdersRepository
+
+	type args struct {
+		ctx         context.Context
+		warehouseID int64
+		sku         uint32
+		delta       int64
+		reason      string
+	}
+
+	var (
+		mc    = minimock.NewController(t)
+		tx    = txMock.NewTxMock(t)
+		ctx   = context.Background()
+		ctxTx = context.WithValue(ctx, transactor.TxKey("tx"), tx)
+
+		warehouseID = gofakeit.Int64()
+		sku         = gofakeit.Uint32()
+		reason      = "inventory"
+	)
+	t.Cleanup(mc.Finish)
+
+	tests := []struct {
+		name           string
+		args           args
+		err            error
+		warehousesMock warehousesMockFunc
+		repositoryMock repositoryMockFunc
+	}{
+		{
+			name: "positive case",
+			args: args{
+				ctx:         ctx,
+				warehouseID: warehouseID,
+				sku:         sku,
+				delta:       -3,
+				reason:      reason,
+			},
+			err: nil,
+			warehousesMock: func(mc *minimock.Controller) WarehousesRepository {
+				mock := NewWarehousesRepositoryMock(t)
+				mock.ChangeStockMock.Expect(ctxTx, StockMovement{
+					WarehouseID: warehouseID,
+					Sku:         sku,
+					Kind:        MovementAdjust,
+					Delta:       -3,
+					Reason:      reason,
+				}).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "positive case - found items go to backorders",
+			args: args{
+				ctx:         ctx,
+				warehouseID: warehouseID,
+				sku:         sku,
+				delta:       3,
+				reason:      reason,
+			},
+			err: nil,
+			warehousesMock: func(mc *minimock.Controller) WarehousesRepository {
+				mock := NewWarehousesRepositoryMock(t)
+				mock.ChangeStockMock.Expect(ctxTx, StockMovement{
+					WarehouseID: warehouseID,
+					Sku:         sku,
+					Kind:        MovementAdjust,
+					Delta:       3,
+					Reason:      reason,
+				}).Return(nil)
+				return mock
+			},
+			repositoryMock: func(mc *minimock.Controller) OrdersRepository {
+				mock := NewOrdersRepositoryMock(t)
+				mock.BatchStocksMock.Expect(ctxTx, []uint32{sku}).Return(map[uint32][]Stock{
+					sku: {{WarehouseID: warehouseID, Count: 3}},
+				}, nil)
+				mock.ListSkuBackordersMock.Expect(ctxTx, sku, 0).Return([]Backorder{{OrderID: 1, Sku: sku, Count: 5}}, nil)
+				mock.ReserveStockMock.Expect(ctxTx, 1, ReservedItem{
+					OrderItem:   OrderItem{Sku: sku, Count: 3},
+					WarehouseID: warehouseID,
+				}).Return(nil)
+				mock.UpdateBackorderMock.Expect(ctxTx, Backorder{OrderID: 1, Sku: sku, Count: 2}).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "negative case - below reserved",
+			args: args{
+				ctx:         ctx,
+				warehouseID: warehouseID,
+				sku:         sku,
+				delta:       -100,
+				reason:      reason,
+			},
+			err: ErrStockBelowReserved,
+			warehousesMock: func(mc *minimock.Controller) WarehousesRepository {
+				mock := NewWarehousesRepositoryMock(t)
+				mock.ChangeStockMock.Return(ErrStockBelowReserved)
+				return mock
+			},
+		},
+		{
+			name: "negative case - no stock row",
+			args: args{
+				ctx:         ctx,
+				warehouseID: warehouseID,
+				sku:         sku,
+				delta:       -1,
+				reason:      reason,
+			},
+			err: ErrStockNotFound,
+			warehousesMock: func(mc *minimock.Controller) WarehousesRepository {
+				mock := NewWarehousesRepositoryMock(t)
+				mock.ChangeStockMock.Return(ErrStockNotFound)
+				return mock
+			},
+		},
+		{
+			name: "negative case - delta out of range",
+			args: args{
+				ctx:         ctx,
+				warehouseID: warehouseID,
+				sku:         sku,
+				delta:       math.MaxInt32 + 1,
+				reason:      reason,
+			},
+			err: ErrStockChangeOutOfRange,
+			warehousesMock: func(mc *minimock.Controller) WarehousesRepository {
+				return NewWarehousesRepositoryMock(t)
+			},
+		},
+		{
+			name: "negative case - zero delta",
+			args: args{
+				ctx:         ctx,
+				warehouseID: warehouseID,
+				sku:         sku,
+				reason:      reason,
+			},
+			err: ErrZeroStockChange,
+			warehousesMock: func(mc *minimock.Controller) WarehousesRepository {
+				return NewWarehousesRepositoryMock(t)
+			},
+		},
+		{
+			name: "negative case - empty reason",
+			args: args{
+				ctx:         ctx,
+				warehouseID: warehouseID,
+				sku:         sku,
+				delta:       1,
+			},
+			err: ErrEmptyReason,
+			warehousesMock: func(mc *minimock.Controller) WarehousesRepository {
+				return NewWarehousesRepositoryMock(t)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var repository OrdersRepository = NewOrdersRepositoryMock(t)
+			if tt.repositoryMock != nil {
+				repository = tt.repositoryMock(mc)
+			}
+			tm := NewTransactionManagerMock(t)
+			//Некорректные запросы отклоняются до транзакции
+			switch tt.err {
+			case ErrZeroStockChange, ErrStockChangeOutOfRange, ErrEmptyReason:
+			default:
+				tm.RunTransactionMock.Set(func(ctx context.Context, isoLevel string, f func(ctxTX context.Context) error) error {
+					return f(ctxTx)
+				})
+			}
+			api := NewMock(tt.warehousesMock(mc), repository, tm)
+			err := api.AdjustStock(tt.args.ctx, tt.args.warehouseID, tt.args.sku, tt.args.delta, tt.args.reason)
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
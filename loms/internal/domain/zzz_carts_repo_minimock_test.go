@@ -8,6 +8,7 @@ import (
 	"context"
 	"sync"
 	mm_atomic "sync/atomic"
+	"time"
 	mm_time "time"
 
 	"github.com/gojuno/minimock/v3"
@@ -17,18 +18,150 @@ import (
 type OrdersRepositoryMock struct {
 	t minimock.Tester
 
+	funcAddStatusChange          func(ctx context.Context, change StatusChange) (err error)
+	inspectFuncAddStatusChange   func(ctx context.Context, change StatusChange)
+	afterAddStatusChangeCounter  uint64
+	beforeAddStatusChangeCounter uint64
+	AddStatusChangeMock          mOrdersRepositoryMockAddStatusChange
+
+	funcBatchStocks          func(ctx context.Context, skus []uint32) (m1 map[uint32][]Stock, err error)
+	inspectFuncBatchStocks   func(ctx context.Context, skus []uint32)
+	afterBatchStocksCounter  uint64
+	beforeBatchStocksCounter uint64
+	BatchStocksMock          mOrdersRepositoryMockBatchStocks
+
+	funcClaimNewOrder          func(ctx context.Context) (i1 int64, err error)
+	inspectFuncClaimNewOrder   func(ctx context.Context)
+	afterClaimNewOrderCounter  uint64
+	beforeClaimNewOrderCounter uint64
+	ClaimNewOrderMock          mOrdersRepositoryMockClaimNewOrder
+
+	funcCreateBackorders          func(ctx context.Context, orderID int64, items []OrderItem) (err error)
+	inspectFuncCreateBackorders   func(ctx context.Context, orderID int64, items []OrderItem)
+	afterCreateBackordersCounter  uint64
+	beforeCreateBackordersCounter uint64
+	CreateBackordersMock          mOrdersRepositoryMockCreateBackorders
+
 	funcCreateOrder          func(ctx context.Context, order *Order) (i1 int64, err error)
 	inspectFuncCreateOrder   func(ctx context.Context, order *Order)
 	afterCreateOrderCounter  uint64
 	beforeCreateOrderCounter uint64
 	CreateOrderMock          mOrdersRepositoryMockCreateOrder
 
+	funcCreateOrderNotification          func(ctx context.Context, order *Order) (err error)
+	inspectFuncCreateOrderNotification   func(ctx context.Context, order *Order)
+	afterCreateOrderNotificationCounter  uint64
+	beforeCreateOrderNotificationCounter uint64
+	CreateOrderNotificationMock          mOrdersRepositoryMockCreateOrderNotification
+
+	funcCreateReturnItems          func(ctx context.Context, orderID int64, items []OrderItem) (err error)
+	inspectFuncCreateReturnItems   func(ctx context.Context, orderID int64, items []OrderItem)
+	afterCreateReturnItemsCounter  uint64
+	beforeCreateReturnItemsCounter uint64
+	CreateReturnItemsMock          mOrdersRepositoryMockCreateReturnItems
+
+	funcCreateSellerOrder          func(ctx context.Context, order SellerOrder) (err error)
+	inspectFuncCreateSellerOrder   func(ctx context.Context, order SellerOrder)
+	afterCreateSellerOrderCounter  uint64
+	beforeCreateSellerOrderCounter uint64
+	CreateSellerOrderMock          mOrdersRepositoryMockCreateSellerOrder
+
+	funcCreateShortages          func(ctx context.Context, orderID int64, shortages []ItemShortage) (err error)
+	inspectFuncCreateShortages   func(ctx context.Context, orderID int64, shortages []ItemShortage)
+	afterCreateShortagesCounter  uint64
+	beforeCreateShortagesCounter uint64
+	CreateShortagesMock          mOrdersRepositoryMockCreateShortages
+
+	funcDeleteBackorders          func(ctx context.Context, orderID int64) (err error)
+	inspectFuncDeleteBackorders   func(ctx context.Context, orderID int64)
+	afterDeleteBackordersCounter  uint64
+	beforeDeleteBackordersCounter uint64
+	DeleteBackordersMock          mOrdersRepositoryMockDeleteBackorders
+
+	funcDeleteSellerOrders          func(ctx context.Context, orderID int64) (err error)
+	inspectFuncDeleteSellerOrders   func(ctx context.Context, orderID int64)
+	afterDeleteSellerOrdersCounter  uint64
+	beforeDeleteSellerOrdersCounter uint64
+	DeleteSellerOrdersMock          mOrdersRepositoryMockDeleteSellerOrders
+
+	funcGetBackorders          func(ctx context.Context, orderID int64) (oa1 []OrderItem, err error)
+	inspectFuncGetBackorders   func(ctx context.Context, orderID int64)
+	afterGetBackordersCounter  uint64
+	beforeGetBackordersCounter uint64
+	GetBackordersMock          mOrdersRepositoryMockGetBackorders
+
+	funcGetExpiredOrders          func(ctx context.Context, status OrderStatus, now time.Time, limit uint64) (ia1 []int64, err error)
+	inspectFuncGetExpiredOrders   func(ctx context.Context, status OrderStatus, now time.Time, limit uint64)
+	afterGetExpiredOrdersCounter  uint64
+	beforeGetExpiredOrdersCounter uint64
+	GetExpiredOrdersMock          mOrdersRepositoryMockGetExpiredOrders
+
 	funcGetOrder          func(ctx context.Context, id int64) (op1 *Order, err error)
 	inspectFuncGetOrder   func(ctx context.Context, id int64)
 	afterGetOrderCounter  uint64
 	beforeGetOrderCounter uint64
 	GetOrderMock          mOrdersRepositoryMockGetOrder
 
+	funcGetOrderHistory          func(ctx context.Context, orderID int64) (sa1 []StatusChange, err error)
+	inspectFuncGetOrderHistory   func(ctx context.Context, orderID int64)
+	afterGetOrderHistoryCounter  uint64
+	beforeGetOrderHistoryCounter uint64
+	GetOrderHistoryMock          mOrdersRepositoryMockGetOrderHistory
+
+	funcGetOrderIDByIdempotencyKey          func(ctx context.Context, user int64, key string) (i1 int64, err error)
+	inspectFuncGetOrderIDByIdempotencyKey   func(ctx context.Context, user int64, key string)
+	afterGetOrderIDByIdempotencyKeyCounter  uint64
+	beforeGetOrderIDByIdempotencyKeyCounter uint64
+	GetOrderIDByIdempotencyKeyMock          mOrdersRepositoryMockGetOrderIDByIdempotencyKey
+
+	funcGetReturnItems          func(ctx context.Context, orderID int64) (oa1 []OrderItem, err error)
+	inspectFuncGetReturnItems   func(ctx context.Context, orderID int64)
+	afterGetReturnItemsCounter  uint64
+	beforeGetReturnItemsCounter uint64
+	GetReturnItemsMock          mOrdersRepositoryMockGetReturnItems
+
+	funcGetShortages          func(ctx context.Context, orderID int64) (ia1 []ItemShortage, err error)
+	inspectFuncGetShortages   func(ctx context.Context, orderID int64)
+	afterGetShortagesCounter  uint64
+	beforeGetShortagesCounter uint64
+	GetShortagesMock          mOrdersRepositoryMockGetShortages
+
+	funcHoldStock          func(ctx context.Context, user int64, item ReservedItem, expiresAt time.Time) (err error)
+	inspectFuncHoldStock   func(ctx context.Context, user int64, item ReservedItem, expiresAt time.Time)
+	afterHoldStockCounter  uint64
+	beforeHoldStockCounter uint64
+	HoldStockMock          mOrdersRepositoryMockHoldStock
+
+	funcListSellerOrders          func(ctx context.Context, query SellerOrdersQuery) (sa1 []SellerOrder, err error)
+	inspectFuncListSellerOrders   func(ctx context.Context, query SellerOrdersQuery)
+	afterListSellerOrdersCounter  uint64
+	beforeListSellerOrdersCounter uint64
+	ListSellerOrdersMock          mOrdersRepositoryMockListSellerOrders
+
+	funcListSkuBackorders          func(ctx context.Context, sku uint32, sellerID int64) (ba1 []Backorder, err error)
+	inspectFuncListSkuBackorders   func(ctx context.Context, sku uint32, sellerID int64)
+	afterListSkuBackordersCounter  uint64
+	beforeListSkuBackordersCounter uint64
+	ListSkuBackordersMock          mOrdersRepositoryMockListSkuBackorders
+
+	funcListUserOrders          func(ctx context.Context, query UserOrdersQuery) (opa1 []*Order, err error)
+	inspectFuncListUserOrders   func(ctx context.Context, query UserOrdersQuery)
+	afterListUserOrdersCounter  uint64
+	beforeListUserOrdersCounter uint64
+	ListUserOrdersMock          mOrdersRepositoryMockListUserOrders
+
+	funcReleaseExpiredHolds          func(ctx context.Context, now time.Time) (ra1 []ReservedItem, err error)
+	inspectFuncReleaseExpiredHolds   func(ctx context.Context, now time.Time)
+	afterReleaseExpiredHoldsCounter  uint64
+	beforeReleaseExpiredHoldsCounter uint64
+	ReleaseExpiredHoldsMock          mOrdersRepositoryMockReleaseExpiredHolds
+
+	funcReleaseHolds          func(ctx context.Context, user int64, skus []uint32) (ra1 []ReservedItem, err error)
+	inspectFuncReleaseHolds   func(ctx context.Context, user int64, skus []uint32)
+	afterReleaseHoldsCounter  uint64
+	beforeReleaseHoldsCounter uint64
+	ReleaseHoldsMock          mOrdersRepositoryMockReleaseHolds
+
 	funcRemoveSoldItems          func(ctx context.Context, orderID int64) (err error)
 	inspectFuncRemoveSoldItems   func(ctx context.Context, orderID int64)
 	afterRemoveSoldItemsCounter  uint64
@@ -41,23 +174,59 @@ type OrdersRepositoryMock struct {
 	beforeReserveStockCounter uint64
 	ReserveStockMock          mOrdersRepositoryMockReserveStock
 
-	funcStocks          func(ctx context.Context, sku uint32) (sa1 []Stock, err error)
-	inspectFuncStocks   func(ctx context.Context, sku uint32)
+	funcSetBackorderDeadline          func(ctx context.Context, id int64, deadline time.Time) (err error)
+	inspectFuncSetBackorderDeadline   func(ctx context.Context, id int64, deadline time.Time)
+	afterSetBackorderDeadlineCounter  uint64
+	beforeSetBackorderDeadlineCounter uint64
+	SetBackorderDeadlineMock          mOrdersRepositoryMockSetBackorderDeadline
+
+	funcSetPaymentDeadline          func(ctx context.Context, id int64, deadline time.Time) (err error)
+	inspectFuncSetPaymentDeadline   func(ctx context.Context, id int64, deadline time.Time)
+	afterSetPaymentDeadlineCounter  uint64
+	beforeSetPaymentDeadlineCounter uint64
+	SetPaymentDeadlineMock          mOrdersRepositoryMockSetPaymentDeadline
+
+	funcStocks          func(ctx context.Context, sku uint32, sellerID int64) (sa1 []Stock, err error)
+	inspectFuncStocks   func(ctx context.Context, sku uint32, sellerID int64)
 	afterStocksCounter  uint64
 	beforeStocksCounter uint64
 	StocksMock          mOrdersRepositoryMockStocks
 
-	funcUnReserveItems          func(ctx context.Context, orderID int64) (err error)
+	funcUnReserveItems          func(ctx context.Context, orderID int64) (ra1 []ReservedItem, err error)
 	inspectFuncUnReserveItems   func(ctx context.Context, orderID int64)
 	afterUnReserveItemsCounter  uint64
 	beforeUnReserveItemsCounter uint64
 	UnReserveItemsMock          mOrdersRepositoryMockUnReserveItems
 
-	funcUpdateOrderStatus          func(ctx context.Context, id int64, status string, statusBefore string) (err error)
-	inspectFuncUpdateOrderStatus   func(ctx context.Context, id int64, status string, statusBefore string)
+	funcUpdateBackorder          func(ctx context.Context, backorder Backorder) (err error)
+	inspectFuncUpdateBackorder   func(ctx context.Context, backorder Backorder)
+	afterUpdateBackorderCounter  uint64
+	beforeUpdateBackorderCounter uint64
+	UpdateBackorderMock          mOrdersRepositoryMockUpdateBackorder
+
+	funcUpdateOrderItems          func(ctx context.Context, orderID int64, items []OrderItem) (err error)
+	inspectFuncUpdateOrderItems   func(ctx context.Context, orderID int64, items []OrderItem)
+	afterUpdateOrderItemsCounter  uint64
+	beforeUpdateOrderItemsCounter uint64
+	UpdateOrderItemsMock          mOrdersRepositoryMockUpdateOrderItems
+
+	funcUpdateOrderStatus          func(ctx context.Context, id int64, status OrderStatus, statusBefore OrderStatus) (err error)
+	inspectFuncUpdateOrderStatus   func(ctx context.Context, id int64, status OrderStatus, statusBefore OrderStatus)
 	afterUpdateOrderStatusCounter  uint64
 	beforeUpdateOrderStatusCounter uint64
 	UpdateOrderStatusMock          mOrdersRepositoryMockUpdateOrderStatus
+
+	funcUpdateSellerOrdersStatus          func(ctx context.Context, orderID int64, sellerIDs []int64, status OrderStatus) (err error)
+	inspectFuncUpdateSellerOrdersStatus   func(ctx context.Context, orderID int64, sellerIDs []int64, status OrderStatus)
+	afterUpdateSellerOrdersStatusCounter  uint64
+	beforeUpdateSellerOrdersStatusCounter uint64
+	UpdateSellerOrdersStatusMock          mOrdersRepositoryMockUpdateSellerOrdersStatus
+
+	funcUserStocks          func(ctx context.Context, user int64, skus []uint32) (m1 map[uint32][]Stock, err error)
+	inspectFuncUserStocks   func(ctx context.Context, user int64, skus []uint32)
+	afterUserStocksCounter  uint64
+	beforeUserStocksCounter uint64
+	UserStocksMock          mOrdersRepositoryMockUserStocks
 }
 
 // NewOrdersRepositoryMock returns a mock for OrdersRepository
@@ -67,1564 +236,7783 @@ func NewOrdersRepositoryMock(t minimock.Tester) *OrdersRepositoryMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.AddStatusChangeMock = mOrdersRepositoryMockAddStatusChange{mock: m}
+	m.AddStatusChangeMock.callArgs = []*OrdersRepositoryMockAddStatusChangeParams{}
+
+	m.BatchStocksMock = mOrdersRepositoryMockBatchStocks{mock: m}
+	m.BatchStocksMock.callArgs = []*OrdersRepositoryMockBatchStocksParams{}
+
+	m.ClaimNewOrderMock = mOrdersRepositoryMockClaimNewOrder{mock: m}
+	m.ClaimNewOrderMock.callArgs = []*OrdersRepositoryMockClaimNewOrderParams{}
+
+	m.CreateBackordersMock = mOrdersRepositoryMockCreateBackorders{mock: m}
+	m.CreateBackordersMock.callArgs = []*OrdersRepositoryMockCreateBackordersParams{}
+
 	m.CreateOrderMock = mOrdersRepositoryMockCreateOrder{mock: m}
 	m.CreateOrderMock.callArgs = []*OrdersRepositoryMockCreateOrderParams{}
 
+	m.CreateOrderNotificationMock = mOrdersRepositoryMockCreateOrderNotification{mock: m}
+	m.CreateOrderNotificationMock.callArgs = []*OrdersRepositoryMockCreateOrderNotificationParams{}
+
+	m.CreateReturnItemsMock = mOrdersRepositoryMockCreateReturnItems{mock: m}
+	m.CreateReturnItemsMock.callArgs = []*OrdersRepositoryMockCreateReturnItemsParams{}
+
+	m.CreateSellerOrderMock = mOrdersRepositoryMockCreateSellerOrder{mock: m}
+	m.CreateSellerOrderMock.callArgs = []*OrdersRepositoryMockCreateSellerOrderParams{}
+
+	m.CreateShortagesMock = mOrdersRepositoryMockCreateShortages{mock: m}
+	m.CreateShortagesMock.callArgs = []*OrdersRepositoryMockCreateShortagesParams{}
+
+	m.DeleteBackordersMock = mOrdersRepositoryMockDeleteBackorders{mock: m}
+	m.DeleteBackordersMock.callArgs = []*OrdersRepositoryMockDeleteBackordersParams{}
+
+	m.DeleteSellerOrdersMock = mOrdersRepositoryMockDeleteSellerOrders{mock: m}
+	m.DeleteSellerOrdersMock.callArgs = []*OrdersRepositoryMockDeleteSellerOrdersParams{}
+
+	m.GetBackordersMock = mOrdersRepositoryMockGetBackorders{mock: m}
+	m.GetBackordersMock.callArgs = []*OrdersRepositoryMockGetBackordersParams{}
+
+	m.GetExpiredOrdersMock = mOrdersRepositoryMockGetExpiredOrders{mock: m}
+	m.GetExpiredOrdersMock.callArgs = []*OrdersRepositoryMockGetExpiredOrdersParams{}
+
 	m.GetOrderMock = mOrdersRepositoryMockGetOrder{mock: m}
 	m.GetOrderMock.callArgs = []*OrdersRepositoryMockGetOrderParams{}
 
+	m.GetOrderHistoryMock = mOrdersRepositoryMockGetOrderHistory{mock: m}
+	m.GetOrderHistoryMock.callArgs = []*OrdersRepositoryMockGetOrderHistoryParams{}
+
+	m.GetOrderIDByIdempotencyKeyMock = mOrdersRepositoryMockGetOrderIDByIdempotencyKey{mock: m}
+	m.GetOrderIDByIdempotencyKeyMock.callArgs = []*OrdersRepositoryMockGetOrderIDByIdempotencyKeyParams{}
+
+	m.GetReturnItemsMock = mOrdersRepositoryMockGetReturnItems{mock: m}
+	m.GetReturnItemsMock.callArgs = []*OrdersRepositoryMockGetReturnItemsParams{}
+
+	m.GetShortagesMock = mOrdersRepositoryMockGetShortages{mock: m}
+	m.GetShortagesMock.callArgs = []*OrdersRepositoryMockGetShortagesParams{}
+
+	m.HoldStockMock = mOrdersRepositoryMockHoldStock{mock: m}
+	m.HoldStockMock.callArgs = []*OrdersRepositoryMockHoldStockParams{}
+
+	m.ListSellerOrdersMock = mOrdersRepositoryMockListSellerOrders{mock: m}
+	m.ListSellerOrdersMock.callArgs = []*OrdersRepositoryMockListSellerOrdersParams{}
+
+	m.ListSkuBackordersMock = mOrdersRepositoryMockListSkuBackorders{mock: m}
+	m.ListSkuBackordersMock.callArgs = []*OrdersRepositoryMockListSkuBackordersParams{}
+
+	m.ListUserOrdersMock = mOrdersRepositoryMockListUserOrders{mock: m}
+	m.ListUserOrdersMock.callArgs = []*OrdersRepositoryMockListUserOrdersParams{}
+
+	m.ReleaseExpiredHoldsMock = mOrdersRepositoryMockReleaseExpiredHolds{mock: m}
+	m.ReleaseExpiredHoldsMock.callArgs = []*OrdersRepositoryMockReleaseExpiredHoldsParams{}
+
+	m.ReleaseHoldsMock = mOrdersRepositoryMockReleaseHolds{mock: m}
+	m.ReleaseHoldsMock.callArgs = []*OrdersRepositoryMockReleaseHoldsParams{}
+
 	m.RemoveSoldItemsMock = mOrdersRepositoryMockRemoveSoldItems{mock: m}
 	m.RemoveSoldItemsMock.callArgs = []*OrdersRepositoryMockRemoveSoldItemsParams{}
 
 	m.ReserveStockMock = mOrdersRepositoryMockReserveStock{mock: m}
 	m.ReserveStockMock.callArgs = []*OrdersRepositoryMockReserveStockParams{}
 
+	m.SetBackorderDeadlineMock = mOrdersRepositoryMockSetBackorderDeadline{mock: m}
+	m.SetBackorderDeadlineMock.callArgs = []*OrdersRepositoryMockSetBackorderDeadlineParams{}
+
+	m.SetPaymentDeadlineMock = mOrdersRepositoryMockSetPaymentDeadline{mock: m}
+	m.SetPaymentDeadlineMock.callArgs = []*OrdersRepositoryMockSetPaymentDeadlineParams{}
+
 	m.StocksMock = mOrdersRepositoryMockStocks{mock: m}
 	m.StocksMock.callArgs = []*OrdersRepositoryMockStocksParams{}
 
 	m.UnReserveItemsMock = mOrdersRepositoryMockUnReserveItems{mock: m}
 	m.UnReserveItemsMock.callArgs = []*OrdersRepositoryMockUnReserveItemsParams{}
 
+	m.UpdateBackorderMock = mOrdersRepositoryMockUpdateBackorder{mock: m}
+	m.UpdateBackorderMock.callArgs = []*OrdersRepositoryMockUpdateBackorderParams{}
+
+	m.UpdateOrderItemsMock = mOrdersRepositoryMockUpdateOrderItems{mock: m}
+	m.UpdateOrderItemsMock.callArgs = []*OrdersRepositoryMockUpdateOrderItemsParams{}
+
 	m.UpdateOrderStatusMock = mOrdersRepositoryMockUpdateOrderStatus{mock: m}
 	m.UpdateOrderStatusMock.callArgs = []*OrdersRepositoryMockUpdateOrderStatusParams{}
 
+	m.UpdateSellerOrdersStatusMock = mOrdersRepositoryMockUpdateSellerOrdersStatus{mock: m}
+	m.UpdateSellerOrdersStatusMock.callArgs = []*OrdersRepositoryMockUpdateSellerOrdersStatusParams{}
+
+	m.UserStocksMock = mOrdersRepositoryMockUserStocks{mock: m}
+	m.UserStocksMock.callArgs = []*OrdersRepositoryMockUserStocksParams{}
+
 	return m
 }
 
-type mOrdersRepositoryMockCreateOrder struct {
+type mOrdersRepositoryMockAddStatusChange struct {
 	mock               *OrdersRepositoryMock
-	defaultExpectation *OrdersRepositoryMockCreateOrderExpectation
-	expectations       []*OrdersRepositoryMockCreateOrderExpectation
+	defaultExpectation *OrdersRepositoryMockAddStatusChangeExpectation
+	expectations       []*OrdersRepositoryMockAddStatusChangeExpectation
 
-	callArgs []*OrdersRepositoryMockCreateOrderParams
+	callArgs []*OrdersRepositoryMockAddStatusChangeParams
 	mutex    sync.RWMutex
 }
 
-// OrdersRepositoryMockCreateOrderExpectation specifies expectation struct of the OrdersRepository.CreateOrder
-type OrdersRepositoryMockCreateOrderExpectation struct {
+// OrdersRepositoryMockAddStatusChangeExpectation specifies expectation struct of the OrdersRepository.AddStatusChange
+type OrdersRepositoryMockAddStatusChangeExpectation struct {
 	mock    *OrdersRepositoryMock
-	params  *OrdersRepositoryMockCreateOrderParams
-	results *OrdersRepositoryMockCreateOrderResults
+	params  *OrdersRepositoryMockAddStatusChangeParams
+	results *OrdersRepositoryMockAddStatusChangeResults
 	Counter uint64
 }
 
-// OrdersRepositoryMockCreateOrderParams contains parameters of the OrdersRepository.CreateOrder
-type OrdersRepositoryMockCreateOrderParams struct {
-	ctx   context.Context
-	order *Order
+// OrdersRepositoryMockAddStatusChangeParams contains parameters of the OrdersRepository.AddStatusChange
+type OrdersRepositoryMockAddStatusChangeParams struct {
+	ctx    context.Context
+	change StatusChange
 }
 
-// OrdersRepositoryMockCreateOrderResults contains results of the OrdersRepository.CreateOrder
-type OrdersRepositoryMockCreateOrderResults struct {
-	i1  int64
+// OrdersRepositoryMockAddStatusChangeResults contains results of the OrdersRepository.AddStatusChange
+type OrdersRepositoryMockAddStatusChangeResults struct {
 	err error
 }
 
-// Expect sets up expected params for OrdersRepository.CreateOrder
-func (mmCreateOrder *mOrdersRepositoryMockCreateOrder) Expect(ctx context.Context, order *Order) *mOrdersRepositoryMockCreateOrder {
-	if mmCreateOrder.mock.funcCreateOrder != nil {
-		mmCreateOrder.mock.t.Fatalf("OrdersRepositoryMock.CreateOrder mock is already set by Set")
+// Expect sets up expected params for OrdersRepository.AddStatusChange
+func (mmAddStatusChange *mOrdersRepositoryMockAddStatusChange) Expect(ctx context.Context, change StatusChange) *mOrdersRepositoryMockAddStatusChange {
+	if mmAddStatusChange.mock.funcAddStatusChange != nil {
+		mmAddStatusChange.mock.t.Fatalf("OrdersRepositoryMock.AddStatusChange mock is already set by Set")
 	}
 
-	if mmCreateOrder.defaultExpectation == nil {
-		mmCreateOrder.defaultExpectation = &OrdersRepositoryMockCreateOrderExpectation{}
+	if mmAddStatusChange.defaultExpectation == nil {
+		mmAddStatusChange.defaultExpectation = &OrdersRepositoryMockAddStatusChangeExpectation{}
 	}
 
-	mmCreateOrder.defaultExpectation.params = &OrdersRepositoryMockCreateOrderParams{ctx, order}
-	for _, e := range mmCreateOrder.expectations {
-		if minimock.Equal(e.params, mmCreateOrder.defaultExpectation.params) {
-			mmCreateOrder.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateOrder.defaultExpectation.params)
+	mmAddStatusChange.defaultExpectation.params = &OrdersRepositoryMockAddStatusChangeParams{ctx, change}
+	for _, e := range mmAddStatusChange.expectations {
+		if minimock.Equal(e.params, mmAddStatusChange.defaultExpectation.params) {
+			mmAddStatusChange.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddStatusChange.defaultExpectation.params)
 		}
 	}
 
-	return mmCreateOrder
+	return mmAddStatusChange
 }
 
-// Inspect accepts an inspector function that has same arguments as the OrdersRepository.CreateOrder
-func (mmCreateOrder *mOrdersRepositoryMockCreateOrder) Inspect(f func(ctx context.Context, order *Order)) *mOrdersRepositoryMockCreateOrder {
-	if mmCreateOrder.mock.inspectFuncCreateOrder != nil {
-		mmCreateOrder.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.CreateOrder")
+// Inspect accepts an inspector function that has same arguments as the OrdersRepository.AddStatusChange
+func (mmAddStatusChange *mOrdersRepositoryMockAddStatusChange) Inspect(f func(ctx context.Context, change StatusChange)) *mOrdersRepositoryMockAddStatusChange {
+	if mmAddStatusChange.mock.inspectFuncAddStatusChange != nil {
+		mmAddStatusChange.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.AddStatusChange")
 	}
 
-	mmCreateOrder.mock.inspectFuncCreateOrder = f
+	mmAddStatusChange.mock.inspectFuncAddStatusChange = f
 
-	return mmCreateOrder
+	return mmAddStatusChange
 }
 
-// Return sets up results that will be returned by OrdersRepository.CreateOrder
-func (mmCreateOrder *mOrdersRepositoryMockCreateOrder) Return(i1 int64, err error) *OrdersRepositoryMock {
-	if mmCreateOrder.mock.funcCreateOrder != nil {
-		mmCreateOrder.mock.t.Fatalf("OrdersRepositoryMock.CreateOrder mock is already set by Set")
+// Return sets up results that will be returned by OrdersRepository.AddStatusChange
+func (mmAddStatusChange *mOrdersRepositoryMockAddStatusChange) Return(err error) *OrdersRepositoryMock {
+	if mmAddStatusChange.mock.funcAddStatusChange != nil {
+		mmAddStatusChange.mock.t.Fatalf("OrdersRepositoryMock.AddStatusChange mock is already set by Set")
 	}
 
-	if mmCreateOrder.defaultExpectation == nil {
-		mmCreateOrder.defaultExpectation = &OrdersRepositoryMockCreateOrderExpectation{mock: mmCreateOrder.mock}
+	if mmAddStatusChange.defaultExpectation == nil {
+		mmAddStatusChange.defaultExpectation = &OrdersRepositoryMockAddStatusChangeExpectation{mock: mmAddStatusChange.mock}
 	}
-	mmCreateOrder.defaultExpectation.results = &OrdersRepositoryMockCreateOrderResults{i1, err}
-	return mmCreateOrder.mock
+	mmAddStatusChange.defaultExpectation.results = &OrdersRepositoryMockAddStatusChangeResults{err}
+	return mmAddStatusChange.mock
 }
 
-// Set uses given function f to mock the OrdersRepository.CreateOrder method
-func (mmCreateOrder *mOrdersRepositoryMockCreateOrder) Set(f func(ctx context.Context, order *Order) (i1 int64, err error)) *OrdersRepositoryMock {
-	if mmCreateOrder.defaultExpectation != nil {
-		mmCreateOrder.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.CreateOrder method")
+// Set uses given function f to mock the OrdersRepository.AddStatusChange method
+func (mmAddStatusChange *mOrdersRepositoryMockAddStatusChange) Set(f func(ctx context.Context, change StatusChange) (err error)) *OrdersRepositoryMock {
+	if mmAddStatusChange.defaultExpectation != nil {
+		mmAddStatusChange.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.AddStatusChange method")
 	}
 
-	if len(mmCreateOrder.expectations) > 0 {
-		mmCreateOrder.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.CreateOrder method")
+	if len(mmAddStatusChange.expectations) > 0 {
+		mmAddStatusChange.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.AddStatusChange method")
 	}
 
-	mmCreateOrder.mock.funcCreateOrder = f
-	return mmCreateOrder.mock
+	mmAddStatusChange.mock.funcAddStatusChange = f
+	return mmAddStatusChange.mock
 }
 
-// When sets expectation for the OrdersRepository.CreateOrder which will trigger the result defined by the following
+// When sets expectation for the OrdersRepository.AddStatusChange which will trigger the result defined by the following
 // Then helper
-func (mmCreateOrder *mOrdersRepositoryMockCreateOrder) When(ctx context.Context, order *Order) *OrdersRepositoryMockCreateOrderExpectation {
-	if mmCreateOrder.mock.funcCreateOrder != nil {
-		mmCreateOrder.mock.t.Fatalf("OrdersRepositoryMock.CreateOrder mock is already set by Set")
+func (mmAddStatusChange *mOrdersRepositoryMockAddStatusChange) When(ctx context.Context, change StatusChange) *OrdersRepositoryMockAddStatusChangeExpectation {
+	if mmAddStatusChange.mock.funcAddStatusChange != nil {
+		mmAddStatusChange.mock.t.Fatalf("OrdersRepositoryMock.AddStatusChange mock is already set by Set")
 	}
 
-	expectation := &OrdersRepositoryMockCreateOrderExpectation{
-		mock:   mmCreateOrder.mock,
-		params: &OrdersRepositoryMockCreateOrderParams{ctx, order},
+	expectation := &OrdersRepositoryMockAddStatusChangeExpectation{
+		mock:   mmAddStatusChange.mock,
+		params: &OrdersRepositoryMockAddStatusChangeParams{ctx, change},
 	}
-	mmCreateOrder.expectations = append(mmCreateOrder.expectations, expectation)
+	mmAddStatusChange.expectations = append(mmAddStatusChange.expectations, expectation)
 	return expectation
 }
 
-// Then sets up OrdersRepository.CreateOrder return parameters for the expectation previously defined by the When method
-func (e *OrdersRepositoryMockCreateOrderExpectation) Then(i1 int64, err error) *OrdersRepositoryMock {
-	e.results = &OrdersRepositoryMockCreateOrderResults{i1, err}
+// Then sets up OrdersRepository.AddStatusChange return parameters for the expectation previously defined by the When method
+func (e *OrdersRepositoryMockAddStatusChangeExpectation) Then(err error) *OrdersRepositoryMock {
+	e.results = &OrdersRepositoryMockAddStatusChangeResults{err}
 	return e.mock
 }
 
-// CreateOrder implements OrdersRepository
-func (mmCreateOrder *OrdersRepositoryMock) CreateOrder(ctx context.Context, order *Order) (i1 int64, err error) {
-	mm_atomic.AddUint64(&mmCreateOrder.beforeCreateOrderCounter, 1)
-	defer mm_atomic.AddUint64(&mmCreateOrder.afterCreateOrderCounter, 1)
+// AddStatusChange implements OrdersRepository
+func (mmAddStatusChange *OrdersRepositoryMock) AddStatusChange(ctx context.Context, change StatusChange) (err error) {
+	mm_atomic.AddUint64(&mmAddStatusChange.beforeAddStatusChangeCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddStatusChange.afterAddStatusChangeCounter, 1)
 
-	if mmCreateOrder.inspectFuncCreateOrder != nil {
-		mmCreateOrder.inspectFuncCreateOrder(ctx, order)
+	if mmAddStatusChange.inspectFuncAddStatusChange != nil {
+		mmAddStatusChange.inspectFuncAddStatusChange(ctx, change)
 	}
 
-	mm_params := &OrdersRepositoryMockCreateOrderParams{ctx, order}
+	mm_params := &OrdersRepositoryMockAddStatusChangeParams{ctx, change}
 
 	// Record call args
-	mmCreateOrder.CreateOrderMock.mutex.Lock()
-	mmCreateOrder.CreateOrderMock.callArgs = append(mmCreateOrder.CreateOrderMock.callArgs, mm_params)
-	mmCreateOrder.CreateOrderMock.mutex.Unlock()
+	mmAddStatusChange.AddStatusChangeMock.mutex.Lock()
+	mmAddStatusChange.AddStatusChangeMock.callArgs = append(mmAddStatusChange.AddStatusChangeMock.callArgs, mm_params)
+	mmAddStatusChange.AddStatusChangeMock.mutex.Unlock()
 
-	for _, e := range mmCreateOrder.CreateOrderMock.expectations {
+	for _, e := range mmAddStatusChange.AddStatusChangeMock.expectations {
 		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.i1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmCreateOrder.CreateOrderMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmCreateOrder.CreateOrderMock.defaultExpectation.Counter, 1)
-		mm_want := mmCreateOrder.CreateOrderMock.defaultExpectation.params
-		mm_got := OrdersRepositoryMockCreateOrderParams{ctx, order}
+	if mmAddStatusChange.AddStatusChangeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddStatusChange.AddStatusChangeMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddStatusChange.AddStatusChangeMock.defaultExpectation.params
+		mm_got := OrdersRepositoryMockAddStatusChangeParams{ctx, change}
 		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmCreateOrder.t.Errorf("OrdersRepositoryMock.CreateOrder got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmAddStatusChange.t.Errorf("OrdersRepositoryMock.AddStatusChange got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmCreateOrder.CreateOrderMock.defaultExpectation.results
+		mm_results := mmAddStatusChange.AddStatusChangeMock.defaultExpectation.results
 		if mm_results == nil {
-			mmCreateOrder.t.Fatal("No results are set for the OrdersRepositoryMock.CreateOrder")
+			mmAddStatusChange.t.Fatal("No results are set for the OrdersRepositoryMock.AddStatusChange")
 		}
-		return (*mm_results).i1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmCreateOrder.funcCreateOrder != nil {
-		return mmCreateOrder.funcCreateOrder(ctx, order)
+	if mmAddStatusChange.funcAddStatusChange != nil {
+		return mmAddStatusChange.funcAddStatusChange(ctx, change)
 	}
-	mmCreateOrder.t.Fatalf("Unexpected call to OrdersRepositoryMock.CreateOrder. %v %v", ctx, order)
+	mmAddStatusChange.t.Fatalf("Unexpected call to OrdersRepositoryMock.AddStatusChange. %v %v", ctx, change)
 	return
 }
 
-// CreateOrderAfterCounter returns a count of finished OrdersRepositoryMock.CreateOrder invocations
-func (mmCreateOrder *OrdersRepositoryMock) CreateOrderAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreateOrder.afterCreateOrderCounter)
+// AddStatusChangeAfterCounter returns a count of finished OrdersRepositoryMock.AddStatusChange invocations
+func (mmAddStatusChange *OrdersRepositoryMock) AddStatusChangeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddStatusChange.afterAddStatusChangeCounter)
 }
 
-// CreateOrderBeforeCounter returns a count of OrdersRepositoryMock.CreateOrder invocations
-func (mmCreateOrder *OrdersRepositoryMock) CreateOrderBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreateOrder.beforeCreateOrderCounter)
+// AddStatusChangeBeforeCounter returns a count of OrdersRepositoryMock.AddStatusChange invocations
+func (mmAddStatusChange *OrdersRepositoryMock) AddStatusChangeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddStatusChange.beforeAddStatusChangeCounter)
 }
 
-// Calls returns a list of arguments used in each call to OrdersRepositoryMock.CreateOrder.
+// Calls returns a list of arguments used in each call to OrdersRepositoryMock.AddStatusChange.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmCreateOrder *mOrdersRepositoryMockCreateOrder) Calls() []*OrdersRepositoryMockCreateOrderParams {
-	mmCreateOrder.mutex.RLock()
+func (mmAddStatusChange *mOrdersRepositoryMockAddStatusChange) Calls() []*OrdersRepositoryMockAddStatusChangeParams {
+	mmAddStatusChange.mutex.RLock()
 
-	argCopy := make([]*OrdersRepositoryMockCreateOrderParams, len(mmCreateOrder.callArgs))
-	copy(argCopy, mmCreateOrder.callArgs)
+	argCopy := make([]*OrdersRepositoryMockAddStatusChangeParams, len(mmAddStatusChange.callArgs))
+	copy(argCopy, mmAddStatusChange.callArgs)
 
-	mmCreateOrder.mutex.RUnlock()
+	mmAddStatusChange.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockCreateOrderDone returns true if the count of the CreateOrder invocations corresponds
+// MinimockAddStatusChangeDone returns true if the count of the AddStatusChange invocations corresponds
 // the number of defined expectations
-func (m *OrdersRepositoryMock) MinimockCreateOrderDone() bool {
-	for _, e := range m.CreateOrderMock.expectations {
+func (m *OrdersRepositoryMock) MinimockAddStatusChangeDone() bool {
+	for _, e := range m.AddStatusChangeMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.CreateOrderMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateOrderCounter) < 1 {
+	if m.AddStatusChangeMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterAddStatusChangeCounter) < 1 {
 		return false
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcCreateOrder != nil && mm_atomic.LoadUint64(&m.afterCreateOrderCounter) < 1 {
+	if m.funcAddStatusChange != nil && mm_atomic.LoadUint64(&m.afterAddStatusChangeCounter) < 1 {
 		return false
 	}
 	return true
 }
 
-// MinimockCreateOrderInspect logs each unmet expectation
-func (m *OrdersRepositoryMock) MinimockCreateOrderInspect() {
-	for _, e := range m.CreateOrderMock.expectations {
+// MinimockAddStatusChangeInspect logs each unmet expectation
+func (m *OrdersRepositoryMock) MinimockAddStatusChangeInspect() {
+	for _, e := range m.AddStatusChangeMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to OrdersRepositoryMock.CreateOrder with params: %#v", *e.params)
+			m.t.Errorf("Expected call to OrdersRepositoryMock.AddStatusChange with params: %#v", *e.params)
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.CreateOrderMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateOrderCounter) < 1 {
-		if m.CreateOrderMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to OrdersRepositoryMock.CreateOrder")
+	if m.AddStatusChangeMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterAddStatusChangeCounter) < 1 {
+		if m.AddStatusChangeMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OrdersRepositoryMock.AddStatusChange")
 		} else {
-			m.t.Errorf("Expected call to OrdersRepositoryMock.CreateOrder with params: %#v", *m.CreateOrderMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to OrdersRepositoryMock.AddStatusChange with params: %#v", *m.AddStatusChangeMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcCreateOrder != nil && mm_atomic.LoadUint64(&m.afterCreateOrderCounter) < 1 {
-		m.t.Error("Expected call to OrdersRepositoryMock.CreateOrder")
+	if m.funcAddStatusChange != nil && mm_atomic.LoadUint64(&m.afterAddStatusChangeCounter) < 1 {
+		m.t.Error("Expected call to OrdersRepositoryMock.AddStatusChange")
 	}
 }
 
-type mOrdersRepositoryMockGetOrder struct {
+type mOrdersRepositoryMockBatchStocks struct {
 	mock               *OrdersRepositoryMock
-	defaultExpectation *OrdersRepositoryMockGetOrderExpectation
-	expectations       []*OrdersRepositoryMockGetOrderExpectation
+	defaultExpectation *OrdersRepositoryMockBatchStocksExpectation
+	expectations       []*OrdersRepositoryMockBatchStocksExpectation
 
-	callArgs []*OrdersRepositoryMockGetOrderParams
+	callArgs []*OrdersRepositoryMockBatchStocksParams
 	mutex    sync.RWMutex
 }
 
-// OrdersRepositoryMockGetOrderExpectation specifies expectation struct of the OrdersRepository.GetOrder
-type OrdersRepositoryMockGetOrderExpectation struct {
+// OrdersRepositoryMockBatchStocksExpectation specifies expectation struct of the OrdersRepository.BatchStocks
+type OrdersRepositoryMockBatchStocksExpectation struct {
 	mock    *OrdersRepositoryMock
-	params  *OrdersRepositoryMockGetOrderParams
-	results *OrdersRepositoryMockGetOrderResults
+	params  *OrdersRepositoryMockBatchStocksParams
+	results *OrdersRepositoryMockBatchStocksResults
 	Counter uint64
 }
 
-// OrdersRepositoryMockGetOrderParams contains parameters of the OrdersRepository.GetOrder
-type OrdersRepositoryMockGetOrderParams struct {
-	ctx context.Context
-	id  int64
+// OrdersRepositoryMockBatchStocksParams contains parameters of the OrdersRepository.BatchStocks
+type OrdersRepositoryMockBatchStocksParams struct {
+	ctx  context.Context
+	skus []uint32
 }
 
-// OrdersRepositoryMockGetOrderResults contains results of the OrdersRepository.GetOrder
-type OrdersRepositoryMockGetOrderResults struct {
-	op1 *Order
+// OrdersRepositoryMockBatchStocksResults contains results of the OrdersRepository.BatchStocks
+type OrdersRepositoryMockBatchStocksResults struct {
+	m1  map[uint32][]Stock
 	err error
 }
 
-// Expect sets up expected params for OrdersRepository.GetOrder
-func (mmGetOrder *mOrdersRepositoryMockGetOrder) Expect(ctx context.Context, id int64) *mOrdersRepositoryMockGetOrder {
-	if mmGetOrder.mock.funcGetOrder != nil {
-		mmGetOrder.mock.t.Fatalf("OrdersRepositoryMock.GetOrder mock is already set by Set")
+// Expect sets up expected params for OrdersRepository.BatchStocks
+func (mmBatchStocks *mOrdersRepositoryMockBatchStocks) Expect(ctx context.Context, skus []uint32) *mOrdersRepositoryMockBatchStocks {
+	if mmBatchStocks.mock.funcBatchStocks != nil {
+		mmBatchStocks.mock.t.Fatalf("OrdersRepositoryMock.BatchStocks mock is already set by Set")
 	}
 
-	if mmGetOrder.defaultExpectation == nil {
-		mmGetOrder.defaultExpectation = &OrdersRepositoryMockGetOrderExpectation{}
+	if mmBatchStocks.defaultExpectation == nil {
+		mmBatchStocks.defaultExpectation = &OrdersRepositoryMockBatchStocksExpectation{}
 	}
 
-	mmGetOrder.defaultExpectation.params = &OrdersRepositoryMockGetOrderParams{ctx, id}
-	for _, e := range mmGetOrder.expectations {
-		if minimock.Equal(e.params, mmGetOrder.defaultExpectation.params) {
-			mmGetOrder.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetOrder.defaultExpectation.params)
+	mmBatchStocks.defaultExpectation.params = &OrdersRepositoryMockBatchStocksParams{ctx, skus}
+	for _, e := range mmBatchStocks.expectations {
+		if minimock.Equal(e.params, mmBatchStocks.defaultExpectation.params) {
+			mmBatchStocks.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmBatchStocks.defaultExpectation.params)
 		}
 	}
 
-	return mmGetOrder
+	return mmBatchStocks
 }
 
-// Inspect accepts an inspector function that has same arguments as the OrdersRepository.GetOrder
-func (mmGetOrder *mOrdersRepositoryMockGetOrder) Inspect(f func(ctx context.Context, id int64)) *mOrdersRepositoryMockGetOrder {
-	if mmGetOrder.mock.inspectFuncGetOrder != nil {
-		mmGetOrder.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.GetOrder")
+// Inspect accepts an inspector function that has same arguments as the OrdersRepository.BatchStocks
+func (mmBatchStocks *mOrdersRepositoryMockBatchStocks) Inspect(f func(ctx context.Context, skus []uint32)) *mOrdersRepositoryMockBatchStocks {
+	if mmBatchStocks.mock.inspectFuncBatchStocks != nil {
+		mmBatchStocks.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.BatchStocks")
 	}
 
-	mmGetOrder.mock.inspectFuncGetOrder = f
+	mmBatchStocks.mock.inspectFuncBatchStocks = f
 
-	return mmGetOrder
+	return mmBatchStocks
 }
 
-// Return sets up results that will be returned by OrdersRepository.GetOrder
-func (mmGetOrder *mOrdersRepositoryMockGetOrder) Return(op1 *Order, err error) *OrdersRepositoryMock {
-	if mmGetOrder.mock.funcGetOrder != nil {
-		mmGetOrder.mock.t.Fatalf("OrdersRepositoryMock.GetOrder mock is already set by Set")
+// Return sets up results that will be returned by OrdersRepository.BatchStocks
+func (mmBatchStocks *mOrdersRepositoryMockBatchStocks) Return(m1 map[uint32][]Stock, err error) *OrdersRepositoryMock {
+	if mmBatchStocks.mock.funcBatchStocks != nil {
+		mmBatchStocks.mock.t.Fatalf("OrdersRepositoryMock.BatchStocks mock is already set by Set")
 	}
 
-	if mmGetOrder.defaultExpectation == nil {
-		mmGetOrder.defaultExpectation = &OrdersRepositoryMockGetOrderExpectation{mock: mmGetOrder.mock}
+	if mmBatchStocks.defaultExpectation == nil {
+		mmBatchStocks.defaultExpectation = &OrdersRepositoryMockBatchStocksExpectation{mock: mmBatchStocks.mock}
 	}
-	mmGetOrder.defaultExpectation.results = &OrdersRepositoryMockGetOrderResults{op1, err}
-	return mmGetOrder.mock
+	mmBatchStocks.defaultExpectation.results = &OrdersRepositoryMockBatchStocksResults{m1, err}
+	return mmBatchStocks.mock
 }
 
-// Set uses given function f to mock the OrdersRepository.GetOrder method
-func (mmGetOrder *mOrdersRepositoryMockGetOrder) Set(f func(ctx context.Context, id int64) (op1 *Order, err error)) *OrdersRepositoryMock {
-	if mmGetOrder.defaultExpectation != nil {
-		mmGetOrder.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.GetOrder method")
+// Set uses given function f to mock the OrdersRepository.BatchStocks method
+func (mmBatchStocks *mOrdersRepositoryMockBatchStocks) Set(f func(ctx context.Context, skus []uint32) (m1 map[uint32][]Stock, err error)) *OrdersRepositoryMock {
+	if mmBatchStocks.defaultExpectation != nil {
+		mmBatchStocks.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.BatchStocks method")
 	}
 
-	if len(mmGetOrder.expectations) > 0 {
-		mmGetOrder.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.GetOrder method")
+	if len(mmBatchStocks.expectations) > 0 {
+		mmBatchStocks.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.BatchStocks method")
 	}
 
-	mmGetOrder.mock.funcGetOrder = f
-	return mmGetOrder.mock
+	mmBatchStocks.mock.funcBatchStocks = f
+	return mmBatchStocks.mock
 }
 
-// When sets expectation for the OrdersRepository.GetOrder which will trigger the result defined by the following
+// When sets expectation for the OrdersRepository.BatchStocks which will trigger the result defined by the following
 // Then helper
-func (mmGetOrder *mOrdersRepositoryMockGetOrder) When(ctx context.Context, id int64) *OrdersRepositoryMockGetOrderExpectation {
-	if mmGetOrder.mock.funcGetOrder != nil {
-		mmGetOrder.mock.t.Fatalf("OrdersRepositoryMock.GetOrder mock is already set by Set")
+func (mmBatchStocks *mOrdersRepositoryMockBatchStocks) When(ctx context.Context, skus []uint32) *OrdersRepositoryMockBatchStocksExpectation {
+	if mmBatchStocks.mock.funcBatchStocks != nil {
+		mmBatchStocks.mock.t.Fatalf("OrdersRepositoryMock.BatchStocks mock is already set by Set")
 	}
 
-	expectation := &OrdersRepositoryMockGetOrderExpectation{
-		mock:   mmGetOrder.mock,
-		params: &OrdersRepositoryMockGetOrderParams{ctx, id},
+	expectation := &OrdersRepositoryMockBatchStocksExpectation{
+		mock:   mmBatchStocks.mock,
+		params: &OrdersRepositoryMockBatchStocksParams{ctx, skus},
 	}
-	mmGetOrder.expectations = append(mmGetOrder.expectations, expectation)
+	mmBatchStocks.expectations = append(mmBatchStocks.expectations, expectation)
 	return expectation
 }
 
-// Then sets up OrdersRepository.GetOrder return parameters for the expectation previously defined by the When method
-func (e *OrdersRepositoryMockGetOrderExpectation) Then(op1 *Order, err error) *OrdersRepositoryMock {
-	e.results = &OrdersRepositoryMockGetOrderResults{op1, err}
+// Then sets up OrdersRepository.BatchStocks return parameters for the expectation previously defined by the When method
+func (e *OrdersRepositoryMockBatchStocksExpectation) Then(m1 map[uint32][]Stock, err error) *OrdersRepositoryMock {
+	e.results = &OrdersRepositoryMockBatchStocksResults{m1, err}
 	return e.mock
 }
 
-// GetOrder implements OrdersRepository
-func (mmGetOrder *OrdersRepositoryMock) GetOrder(ctx context.Context, id int64) (op1 *Order, err error) {
-	mm_atomic.AddUint64(&mmGetOrder.beforeGetOrderCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetOrder.afterGetOrderCounter, 1)
+// BatchStocks implements OrdersRepository
+func (mmBatchStocks *OrdersRepositoryMock) BatchStocks(ctx context.Context, skus []uint32) (m1 map[uint32][]Stock, err error) {
+	mm_atomic.AddUint64(&mmBatchStocks.beforeBatchStocksCounter, 1)
+	defer mm_atomic.AddUint64(&mmBatchStocks.afterBatchStocksCounter, 1)
 
-	if mmGetOrder.inspectFuncGetOrder != nil {
-		mmGetOrder.inspectFuncGetOrder(ctx, id)
+	if mmBatchStocks.inspectFuncBatchStocks != nil {
+		mmBatchStocks.inspectFuncBatchStocks(ctx, skus)
 	}
 
-	mm_params := &OrdersRepositoryMockGetOrderParams{ctx, id}
+	mm_params := &OrdersRepositoryMockBatchStocksParams{ctx, skus}
 
 	// Record call args
-	mmGetOrder.GetOrderMock.mutex.Lock()
-	mmGetOrder.GetOrderMock.callArgs = append(mmGetOrder.GetOrderMock.callArgs, mm_params)
-	mmGetOrder.GetOrderMock.mutex.Unlock()
+	mmBatchStocks.BatchStocksMock.mutex.Lock()
+	mmBatchStocks.BatchStocksMock.callArgs = append(mmBatchStocks.BatchStocksMock.callArgs, mm_params)
+	mmBatchStocks.BatchStocksMock.mutex.Unlock()
 
-	for _, e := range mmGetOrder.GetOrderMock.expectations {
+	for _, e := range mmBatchStocks.BatchStocksMock.expectations {
 		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.op1, e.results.err
+			return e.results.m1, e.results.err
 		}
 	}
 
-	if mmGetOrder.GetOrderMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetOrder.GetOrderMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetOrder.GetOrderMock.defaultExpectation.params
-		mm_got := OrdersRepositoryMockGetOrderParams{ctx, id}
+	if mmBatchStocks.BatchStocksMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmBatchStocks.BatchStocksMock.defaultExpectation.Counter, 1)
+		mm_want := mmBatchStocks.BatchStocksMock.defaultExpectation.params
+		mm_got := OrdersRepositoryMockBatchStocksParams{ctx, skus}
 		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetOrder.t.Errorf("OrdersRepositoryMock.GetOrder got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmBatchStocks.t.Errorf("OrdersRepositoryMock.BatchStocks got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetOrder.GetOrderMock.defaultExpectation.results
+		mm_results := mmBatchStocks.BatchStocksMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetOrder.t.Fatal("No results are set for the OrdersRepositoryMock.GetOrder")
+			mmBatchStocks.t.Fatal("No results are set for the OrdersRepositoryMock.BatchStocks")
 		}
-		return (*mm_results).op1, (*mm_results).err
+		return (*mm_results).m1, (*mm_results).err
 	}
-	if mmGetOrder.funcGetOrder != nil {
-		return mmGetOrder.funcGetOrder(ctx, id)
+	if mmBatchStocks.funcBatchStocks != nil {
+		return mmBatchStocks.funcBatchStocks(ctx, skus)
 	}
-	mmGetOrder.t.Fatalf("Unexpected call to OrdersRepositoryMock.GetOrder. %v %v", ctx, id)
+	mmBatchStocks.t.Fatalf("Unexpected call to OrdersRepositoryMock.BatchStocks. %v %v", ctx, skus)
 	return
 }
 
-// GetOrderAfterCounter returns a count of finished OrdersRepositoryMock.GetOrder invocations
-func (mmGetOrder *OrdersRepositoryMock) GetOrderAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetOrder.afterGetOrderCounter)
+// BatchStocksAfterCounter returns a count of finished OrdersRepositoryMock.BatchStocks invocations
+func (mmBatchStocks *OrdersRepositoryMock) BatchStocksAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBatchStocks.afterBatchStocksCounter)
 }
 
-// GetOrderBeforeCounter returns a count of OrdersRepositoryMock.GetOrder invocations
-func (mmGetOrder *OrdersRepositoryMock) GetOrderBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetOrder.beforeGetOrderCounter)
+// BatchStocksBeforeCounter returns a count of OrdersRepositoryMock.BatchStocks invocations
+func (mmBatchStocks *OrdersRepositoryMock) BatchStocksBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBatchStocks.beforeBatchStocksCounter)
 }
 
-// Calls returns a list of arguments used in each call to OrdersRepositoryMock.GetOrder.
+// Calls returns a list of arguments used in each call to OrdersRepositoryMock.BatchStocks.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetOrder *mOrdersRepositoryMockGetOrder) Calls() []*OrdersRepositoryMockGetOrderParams {
-	mmGetOrder.mutex.RLock()
+func (mmBatchStocks *mOrdersRepositoryMockBatchStocks) Calls() []*OrdersRepositoryMockBatchStocksParams {
+	mmBatchStocks.mutex.RLock()
 
-	argCopy := make([]*OrdersRepositoryMockGetOrderParams, len(mmGetOrder.callArgs))
-	copy(argCopy, mmGetOrder.callArgs)
+	argCopy := make([]*OrdersRepositoryMockBatchStocksParams, len(mmBatchStocks.callArgs))
+	copy(argCopy, mmBatchStocks.callArgs)
 
-	mmGetOrder.mutex.RUnlock()
+	mmBatchStocks.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetOrderDone returns true if the count of the GetOrder invocations corresponds
+// MinimockBatchStocksDone returns true if the count of the BatchStocks invocations corresponds
 // the number of defined expectations
-func (m *OrdersRepositoryMock) MinimockGetOrderDone() bool {
-	for _, e := range m.GetOrderMock.expectations {
+func (m *OrdersRepositoryMock) MinimockBatchStocksDone() bool {
+	for _, e := range m.BatchStocksMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetOrderMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetOrderCounter) < 1 {
+	if m.BatchStocksMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterBatchStocksCounter) < 1 {
 		return false
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetOrder != nil && mm_atomic.LoadUint64(&m.afterGetOrderCounter) < 1 {
+	if m.funcBatchStocks != nil && mm_atomic.LoadUint64(&m.afterBatchStocksCounter) < 1 {
 		return false
 	}
 	return true
 }
 
-// MinimockGetOrderInspect logs each unmet expectation
-func (m *OrdersRepositoryMock) MinimockGetOrderInspect() {
-	for _, e := range m.GetOrderMock.expectations {
+// MinimockBatchStocksInspect logs each unmet expectation
+func (m *OrdersRepositoryMock) MinimockBatchStocksInspect() {
+	for _, e := range m.BatchStocksMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to OrdersRepositoryMock.GetOrder with params: %#v", *e.params)
+			m.t.Errorf("Expected call to OrdersRepositoryMock.BatchStocks with params: %#v", *e.params)
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetOrderMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetOrderCounter) < 1 {
-		if m.GetOrderMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to OrdersRepositoryMock.GetOrder")
+	if m.BatchStocksMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterBatchStocksCounter) < 1 {
+		if m.BatchStocksMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OrdersRepositoryMock.BatchStocks")
 		} else {
-			m.t.Errorf("Expected call to OrdersRepositoryMock.GetOrder with params: %#v", *m.GetOrderMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to OrdersRepositoryMock.BatchStocks with params: %#v", *m.BatchStocksMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetOrder != nil && mm_atomic.LoadUint64(&m.afterGetOrderCounter) < 1 {
-		m.t.Error("Expected call to OrdersRepositoryMock.GetOrder")
+	if m.funcBatchStocks != nil && mm_atomic.LoadUint64(&m.afterBatchStocksCounter) < 1 {
+		m.t.Error("Expected call to OrdersRepositoryMock.BatchStocks")
 	}
 }
 
-type mOrdersRepositoryMockRemoveSoldItems struct {
+type mOrdersRepositoryMockClaimNewOrder struct {
 	mock               *OrdersRepositoryMock
-	defaultExpectation *OrdersRepositoryMockRemoveSoldItemsExpectation
-	expectations       []*OrdersRepositoryMockRemoveSoldItemsExpectation
+	defaultExpectation *OrdersRepositoryMockClaimNewOrderExpectation
+	expectations       []*OrdersRepositoryMockClaimNewOrderExpectation
 
-	callArgs []*OrdersRepositoryMockRemoveSoldItemsParams
+	callArgs []*OrdersRepositoryMockClaimNewOrderParams
 	mutex    sync.RWMutex
 }
 
-// OrdersRepositoryMockRemoveSoldItemsExpectation specifies expectation struct of the OrdersRepository.RemoveSoldItems
-type OrdersRepositoryMockRemoveSoldItemsExpectation struct {
+// OrdersRepositoryMockClaimNewOrderExpectation specifies expectation struct of the OrdersRepository.ClaimNewOrder
+type OrdersRepositoryMockClaimNewOrderExpectation struct {
 	mock    *OrdersRepositoryMock
-	params  *OrdersRepositoryMockRemoveSoldItemsParams
-	results *OrdersRepositoryMockRemoveSoldItemsResults
+	params  *OrdersRepositoryMockClaimNewOrderParams
+	results *OrdersRepositoryMockClaimNewOrderResults
 	Counter uint64
 }
 
-// OrdersRepositoryMockRemoveSoldItemsParams contains parameters of the OrdersRepository.RemoveSoldItems
-type OrdersRepositoryMockRemoveSoldItemsParams struct {
-	ctx     context.Context
-	orderID int64
+// OrdersRepositoryMockClaimNewOrderParams contains parameters of the OrdersRepository.ClaimNewOrder
+type OrdersRepositoryMockClaimNewOrderParams struct {
+	ctx context.Context
 }
 
-// OrdersRepositoryMockRemoveSoldItemsResults contains results of the OrdersRepository.RemoveSoldItems
-type OrdersRepositoryMockRemoveSoldItemsResults struct {
+// OrdersRepositoryMockClaimNewOrderResults contains results of the OrdersRepository.ClaimNewOrder
+type OrdersRepositoryMockClaimNewOrderResults struct {
+	i1  int64
 	err error
 }
 
-// Expect sets up expected params for OrdersRepository.RemoveSoldItems
-func (mmRemoveSoldItems *mOrdersRepositoryMockRemoveSoldItems) Expect(ctx context.Context, orderID int64) *mOrdersRepositoryMockRemoveSoldItems {
-	if mmRemoveSoldItems.mock.funcRemoveSoldItems != nil {
-		mmRemoveSoldItems.mock.t.Fatalf("OrdersRepositoryMock.RemoveSoldItems mock is already set by Set")
+// Expect sets up expected params for OrdersRepository.ClaimNewOrder
+func (mmClaimNewOrder *mOrdersRepositoryMockClaimNewOrder) Expect(ctx context.Context) *mOrdersRepositoryMockClaimNewOrder {
+	if mmClaimNewOrder.mock.funcClaimNewOrder != nil {
+		mmClaimNewOrder.mock.t.Fatalf("OrdersRepositoryMock.ClaimNewOrder mock is already set by Set")
 	}
 
-	if mmRemoveSoldItems.defaultExpectation == nil {
-		mmRemoveSoldItems.defaultExpectation = &OrdersRepositoryMockRemoveSoldItemsExpectation{}
+	if mmClaimNewOrder.defaultExpectation == nil {
+		mmClaimNewOrder.defaultExpectation = &OrdersRepositoryMockClaimNewOrderExpectation{}
 	}
 
-	mmRemoveSoldItems.defaultExpectation.params = &OrdersRepositoryMockRemoveSoldItemsParams{ctx, orderID}
-	for _, e := range mmRemoveSoldItems.expectations {
-		if minimock.Equal(e.params, mmRemoveSoldItems.defaultExpectation.params) {
-			mmRemoveSoldItems.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemoveSoldItems.defaultExpectation.params)
+	mmClaimNewOrder.defaultExpectation.params = &OrdersRepositoryMockClaimNewOrderParams{ctx}
+	for _, e := range mmClaimNewOrder.expectations {
+		if minimock.Equal(e.params, mmClaimNewOrder.defaultExpectation.params) {
+			mmClaimNewOrder.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmClaimNewOrder.defaultExpectation.params)
 		}
 	}
 
-	return mmRemoveSoldItems
+	return mmClaimNewOrder
 }
 
-// Inspect accepts an inspector function that has same arguments as the OrdersRepository.RemoveSoldItems
-func (mmRemoveSoldItems *mOrdersRepositoryMockRemoveSoldItems) Inspect(f func(ctx context.Context, orderID int64)) *mOrdersRepositoryMockRemoveSoldItems {
-	if mmRemoveSoldItems.mock.inspectFuncRemoveSoldItems != nil {
-		mmRemoveSoldItems.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.RemoveSoldItems")
+// Inspect accepts an inspector function that has same arguments as the OrdersRepository.ClaimNewOrder
+func (mmClaimNewOrder *mOrdersRepositoryMockClaimNewOrder) Inspect(f func(ctx context.Context)) *mOrdersRepositoryMockClaimNewOrder {
+	if mmClaimNewOrder.mock.inspectFuncClaimNewOrder != nil {
+		mmClaimNewOrder.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.ClaimNewOrder")
 	}
 
-	mmRemoveSoldItems.mock.inspectFuncRemoveSoldItems = f
+	mmClaimNewOrder.mock.inspectFuncClaimNewOrder = f
 
-	return mmRemoveSoldItems
+	return mmClaimNewOrder
 }
 
-// Return sets up results that will be returned by OrdersRepository.RemoveSoldItems
-func (mmRemoveSoldItems *mOrdersRepositoryMockRemoveSoldItems) Return(err error) *OrdersRepositoryMock {
-	if mmRemoveSoldItems.mock.funcRemoveSoldItems != nil {
-		mmRemoveSoldItems.mock.t.Fatalf("OrdersRepositoryMock.RemoveSoldItems mock is already set by Set")
+// Return sets up results that will be returned by OrdersRepository.ClaimNewOrder
+func (mmClaimNewOrder *mOrdersRepositoryMockClaimNewOrder) Return(i1 int64, err error) *OrdersRepositoryMock {
+	if mmClaimNewOrder.mock.funcClaimNewOrder != nil {
+		mmClaimNewOrder.mock.t.Fatalf("OrdersRepositoryMock.ClaimNewOrder mock is already set by Set")
 	}
 
-	if mmRemoveSoldItems.defaultExpectation == nil {
-		mmRemoveSoldItems.defaultExpectation = &OrdersRepositoryMockRemoveSoldItemsExpectation{mock: mmRemoveSoldItems.mock}
+	if mmClaimNewOrder.defaultExpectation == nil {
+		mmClaimNewOrder.defaultExpectation = &OrdersRepositoryMockClaimNewOrderExpectation{mock: mmClaimNewOrder.mock}
 	}
-	mmRemoveSoldItems.defaultExpectation.results = &OrdersRepositoryMockRemoveSoldItemsResults{err}
-	return mmRemoveSoldItems.mock
+	mmClaimNewOrder.defaultExpectation.results = &OrdersRepositoryMockClaimNewOrderResults{i1, err}
+	return mmClaimNewOrder.mock
 }
 
-// Set uses given function f to mock the OrdersRepository.RemoveSoldItems method
-func (mmRemoveSoldItems *mOrdersRepositoryMockRemoveSoldItems) Set(f func(ctx context.Context, orderID int64) (err error)) *OrdersRepositoryMock {
-	if mmRemoveSoldItems.defaultExpectation != nil {
-		mmRemoveSoldItems.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.RemoveSoldItems method")
+// Set uses given function f to mock the OrdersRepository.ClaimNewOrder method
+func (mmClaimNewOrder *mOrdersRepositoryMockClaimNewOrder) Set(f func(ctx context.Context) (i1 int64, err error)) *OrdersRepositoryMock {
+	if mmClaimNewOrder.defaultExpectation != nil {
+		mmClaimNewOrder.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.ClaimNewOrder method")
 	}
 
-	if len(mmRemoveSoldItems.expectations) > 0 {
-		mmRemoveSoldItems.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.RemoveSoldItems method")
+	if len(mmClaimNewOrder.expectations) > 0 {
+		mmClaimNewOrder.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.ClaimNewOrder method")
 	}
 
-	mmRemoveSoldItems.mock.funcRemoveSoldItems = f
-	return mmRemoveSoldItems.mock
+	mmClaimNewOrder.mock.funcClaimNewOrder = f
+	return mmClaimNewOrder.mock
 }
 
-// When sets expectation for the OrdersRepository.RemoveSoldItems which will trigger the result defined by the following
+// When sets expectation for the OrdersRepository.ClaimNewOrder which will trigger the result defined by the following
 // Then helper
-func (mmRemoveSoldItems *mOrdersRepositoryMockRemoveSoldItems) When(ctx context.Context, orderID int64) *OrdersRepositoryMockRemoveSoldItemsExpectation {
-	if mmRemoveSoldItems.mock.funcRemoveSoldItems != nil {
-		mmRemoveSoldItems.mock.t.Fatalf("OrdersRepositoryMock.RemoveSoldItems mock is already set by Set")
+func (mmClaimNewOrder *mOrdersRepositoryMockClaimNewOrder) When(ctx context.Context) *OrdersRepositoryMockClaimNewOrderExpectation {
+	if mmClaimNewOrder.mock.funcClaimNewOrder != nil {
+		mmClaimNewOrder.mock.t.Fatalf("OrdersRepositoryMock.ClaimNewOrder mock is already set by Set")
 	}
 
-	expectation := &OrdersRepositoryMockRemoveSoldItemsExpectation{
-		mock:   mmRemoveSoldItems.mock,
-		params: &OrdersRepositoryMockRemoveSoldItemsParams{ctx, orderID},
+	expectation := &OrdersRepositoryMockClaimNewOrderExpectation{
+		mock:   mmClaimNewOrder.mock,
+		params: &OrdersRepositoryMockClaimNewOrderParams{ctx},
 	}
-	mmRemoveSoldItems.expectations = append(mmRemoveSoldItems.expectations, expectation)
+	mmClaimNewOrder.expectations = append(mmClaimNewOrder.expectations, expectation)
 	return expectation
 }
 
-// Then sets up OrdersRepository.RemoveSoldItems return parameters for the expectation previously defined by the When method
-func (e *OrdersRepositoryMockRemoveSoldItemsExpectation) Then(err error) *OrdersRepositoryMock {
-	e.results = &OrdersRepositoryMockRemoveSoldItemsResults{err}
+// Then sets up OrdersRepository.ClaimNewOrder return parameters for the expectation previously defined by the When method
+func (e *OrdersRepositoryMockClaimNewOrderExpectation) Then(i1 int64, err error) *OrdersRepositoryMock {
+	e.results = &OrdersRepositoryMockClaimNewOrderResults{i1, err}
 	return e.mock
 }
 
-// RemoveSoldItems implements OrdersRepository
-func (mmRemoveSoldItems *OrdersRepositoryMock) RemoveSoldItems(ctx context.Context, orderID int64) (err error) {
-	mm_atomic.AddUint64(&mmRemoveSoldItems.beforeRemoveSoldItemsCounter, 1)
-	defer mm_atomic.AddUint64(&mmRemoveSoldItems.afterRemoveSoldItemsCounter, 1)
+// ClaimNewOrder implements OrdersRepository
+func (mmClaimNewOrder *OrdersRepositoryMock) ClaimNewOrder(ctx context.Context) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmClaimNewOrder.beforeClaimNewOrderCounter, 1)
+	defer mm_atomic.AddUint64(&mmClaimNewOrder.afterClaimNewOrderCounter, 1)
 
-	if mmRemoveSoldItems.inspectFuncRemoveSoldItems != nil {
-		mmRemoveSoldItems.inspectFuncRemoveSoldItems(ctx, orderID)
+	if mmClaimNewOrder.inspectFuncClaimNewOrder != nil {
+		mmClaimNewOrder.inspectFuncClaimNewOrder(ctx)
 	}
 
-	mm_params := &OrdersRepositoryMockRemoveSoldItemsParams{ctx, orderID}
+	mm_params := &OrdersRepositoryMockClaimNewOrderParams{ctx}
 
 	// Record call args
-	mmRemoveSoldItems.RemoveSoldItemsMock.mutex.Lock()
-	mmRemoveSoldItems.RemoveSoldItemsMock.callArgs = append(mmRemoveSoldItems.RemoveSoldItemsMock.callArgs, mm_params)
-	mmRemoveSoldItems.RemoveSoldItemsMock.mutex.Unlock()
+	mmClaimNewOrder.ClaimNewOrderMock.mutex.Lock()
+	mmClaimNewOrder.ClaimNewOrderMock.callArgs = append(mmClaimNewOrder.ClaimNewOrderMock.callArgs, mm_params)
+	mmClaimNewOrder.ClaimNewOrderMock.mutex.Unlock()
 
-	for _, e := range mmRemoveSoldItems.RemoveSoldItemsMock.expectations {
+	for _, e := range mmClaimNewOrder.ClaimNewOrderMock.expectations {
 		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.i1, e.results.err
 		}
 	}
 
-	if mmRemoveSoldItems.RemoveSoldItemsMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmRemoveSoldItems.RemoveSoldItemsMock.defaultExpectation.Counter, 1)
-		mm_want := mmRemoveSoldItems.RemoveSoldItemsMock.defaultExpectation.params
-		mm_got := OrdersRepositoryMockRemoveSoldItemsParams{ctx, orderID}
+	if mmClaimNewOrder.ClaimNewOrderMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmClaimNewOrder.ClaimNewOrderMock.defaultExpectation.Counter, 1)
+		mm_want := mmClaimNewOrder.ClaimNewOrderMock.defaultExpectation.params
+		mm_got := OrdersRepositoryMockClaimNewOrderParams{ctx}
 		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmRemoveSoldItems.t.Errorf("OrdersRepositoryMock.RemoveSoldItems got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmClaimNewOrder.t.Errorf("OrdersRepositoryMock.ClaimNewOrder got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmRemoveSoldItems.RemoveSoldItemsMock.defaultExpectation.results
+		mm_results := mmClaimNewOrder.ClaimNewOrderMock.defaultExpectation.results
 		if mm_results == nil {
-			mmRemoveSoldItems.t.Fatal("No results are set for the OrdersRepositoryMock.RemoveSoldItems")
+			mmClaimNewOrder.t.Fatal("No results are set for the OrdersRepositoryMock.ClaimNewOrder")
 		}
-		return (*mm_results).err
+		return (*mm_results).i1, (*mm_results).err
 	}
-	if mmRemoveSoldItems.funcRemoveSoldItems != nil {
-		return mmRemoveSoldItems.funcRemoveSoldItems(ctx, orderID)
+	if mmClaimNewOrder.funcClaimNewOrder != nil {
+		return mmClaimNewOrder.funcClaimNewOrder(ctx)
 	}
-	mmRemoveSoldItems.t.Fatalf("Unexpected call to OrdersRepositoryMock.RemoveSoldItems. %v %v", ctx, orderID)
+	mmClaimNewOrder.t.Fatalf("Unexpected call to OrdersRepositoryMock.ClaimNewOrder. %v", ctx)
 	return
 }
 
-// RemoveSoldItemsAfterCounter returns a count of finished OrdersRepositoryMock.RemoveSoldItems invocations
-func (mmRemoveSoldItems *OrdersRepositoryMock) RemoveSoldItemsAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmRemoveSoldItems.afterRemoveSoldItemsCounter)
+// ClaimNewOrderAfterCounter returns a count of finished OrdersRepositoryMock.ClaimNewOrder invocations
+func (mmClaimNewOrder *OrdersRepositoryMock) ClaimNewOrderAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClaimNewOrder.afterClaimNewOrderCounter)
 }
 
-// RemoveSoldItemsBeforeCounter returns a count of OrdersRepositoryMock.RemoveSoldItems invocations
-func (mmRemoveSoldItems *OrdersRepositoryMock) RemoveSoldItemsBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmRemoveSoldItems.beforeRemoveSoldItemsCounter)
+// ClaimNewOrderBeforeCounter returns a count of OrdersRepositoryMock.ClaimNewOrder invocations
+func (mmClaimNewOrder *OrdersRepositoryMock) ClaimNewOrderBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClaimNewOrder.beforeClaimNewOrderCounter)
 }
 
-// Calls returns a list of arguments used in each call to OrdersRepositoryMock.RemoveSoldItems.
+// Calls returns a list of arguments used in each call to OrdersRepositoryMock.ClaimNewOrder.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmRemoveSoldItems *mOrdersRepositoryMockRemoveSoldItems) Calls() []*OrdersRepositoryMockRemoveSoldItemsParams {
-	mmRemoveSoldItems.mutex.RLock()
+func (mmClaimNewOrder *mOrdersRepositoryMockClaimNewOrder) Calls() []*OrdersRepositoryMockClaimNewOrderParams {
+	mmClaimNewOrder.mutex.RLock()
 
-	argCopy := make([]*OrdersRepositoryMockRemoveSoldItemsParams, len(mmRemoveSoldItems.callArgs))
-	copy(argCopy, mmRemoveSoldItems.callArgs)
+	argCopy := make([]*OrdersRepositoryMockClaimNewOrderParams, len(mmClaimNewOrder.callArgs))
+	copy(argCopy, mmClaimNewOrder.callArgs)
 
-	mmRemoveSoldItems.mutex.RUnlock()
+	mmClaimNewOrder.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockRemoveSoldItemsDone returns true if the count of the RemoveSoldItems invocations corresponds
+// MinimockClaimNewOrderDone returns true if the count of the ClaimNewOrder invocations corresponds
 // the number of defined expectations
-func (m *OrdersRepositoryMock) MinimockRemoveSoldItemsDone() bool {
-	for _, e := range m.RemoveSoldItemsMock.expectations {
+func (m *OrdersRepositoryMock) MinimockClaimNewOrderDone() bool {
+	for _, e := range m.ClaimNewOrderMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.RemoveSoldItemsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRemoveSoldItemsCounter) < 1 {
+	if m.ClaimNewOrderMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterClaimNewOrderCounter) < 1 {
 		return false
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcRemoveSoldItems != nil && mm_atomic.LoadUint64(&m.afterRemoveSoldItemsCounter) < 1 {
+	if m.funcClaimNewOrder != nil && mm_atomic.LoadUint64(&m.afterClaimNewOrderCounter) < 1 {
 		return false
 	}
 	return true
 }
 
-// MinimockRemoveSoldItemsInspect logs each unmet expectation
-func (m *OrdersRepositoryMock) MinimockRemoveSoldItemsInspect() {
-	for _, e := range m.RemoveSoldItemsMock.expectations {
+// MinimockClaimNewOrderInspect logs each unmet expectation
+func (m *OrdersRepositoryMock) MinimockClaimNewOrderInspect() {
+	for _, e := range m.ClaimNewOrderMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to OrdersRepositoryMock.RemoveSoldItems with params: %#v", *e.params)
+			m.t.Errorf("Expected call to OrdersRepositoryMock.ClaimNewOrder with params: %#v", *e.params)
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.RemoveSoldItemsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRemoveSoldItemsCounter) < 1 {
-		if m.RemoveSoldItemsMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to OrdersRepositoryMock.RemoveSoldItems")
+	if m.ClaimNewOrderMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterClaimNewOrderCounter) < 1 {
+		if m.ClaimNewOrderMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OrdersRepositoryMock.ClaimNewOrder")
 		} else {
-			m.t.Errorf("Expected call to OrdersRepositoryMock.RemoveSoldItems with params: %#v", *m.RemoveSoldItemsMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to OrdersRepositoryMock.ClaimNewOrder with params: %#v", *m.ClaimNewOrderMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcRemoveSoldItems != nil && mm_atomic.LoadUint64(&m.afterRemoveSoldItemsCounter) < 1 {
-		m.t.Error("Expected call to OrdersRepositoryMock.RemoveSoldItems")
+	if m.funcClaimNewOrder != nil && mm_atomic.LoadUint64(&m.afterClaimNewOrderCounter) < 1 {
+		m.t.Error("Expected call to OrdersRepositoryMock.ClaimNewOrder")
 	}
 }
 
-type mOrdersRepositoryMockReserveStock struct {
+type mOrdersRepositoryMockCreateBackorders struct {
 	mock               *OrdersRepositoryMock
-	defaultExpectation *OrdersRepositoryMockReserveStockExpectation
-	expectations       []*OrdersRepositoryMockReserveStockExpectation
+	defaultExpectation *OrdersRepositoryMockCreateBackordersExpectation
+	expectations       []*OrdersRepositoryMockCreateBackordersExpectation
 
-	callArgs []*OrdersRepositoryMockReserveStockParams
+	callArgs []*OrdersRepositoryMockCreateBackordersParams
 	mutex    sync.RWMutex
 }
 
-// OrdersRepositoryMockReserveStockExpectation specifies expectation struct of the OrdersRepository.ReserveStock
-type OrdersRepositoryMockReserveStockExpectation struct {
+// OrdersRepositoryMockCreateBackordersExpectation specifies expectation struct of the OrdersRepository.CreateBackorders
+type OrdersRepositoryMockCreateBackordersExpectation struct {
 	mock    *OrdersRepositoryMock
-	params  *OrdersRepositoryMockReserveStockParams
-	results *OrdersRepositoryMockReserveStockResults
+	params  *OrdersRepositoryMockCreateBackordersParams
+	results *OrdersRepositoryMockCreateBackordersResults
 	Counter uint64
 }
 
-// OrdersRepositoryMockReserveStockParams contains parameters of the OrdersRepository.ReserveStock
-type OrdersRepositoryMockReserveStockParams struct {
+// OrdersRepositoryMockCreateBackordersParams contains parameters of the OrdersRepository.CreateBackorders
+type OrdersRepositoryMockCreateBackordersParams struct {
 	ctx     context.Context
 	orderID int64
-	item    ReservedItem
+	items   []OrderItem
 }
 
-// OrdersRepositoryMockReserveStockResults contains results of the OrdersRepository.ReserveStock
-type OrdersRepositoryMockReserveStockResults struct {
+// OrdersRepositoryMockCreateBackordersResults contains results of the OrdersRepository.CreateBackorders
+type OrdersRepositoryMockCreateBackordersResults struct {
 	err error
 }
 
-// Expect sets up expected params for OrdersRepository.ReserveStock
-func (mmReserveStock *mOrdersRepositoryMockReserveStock) Expect(ctx context.Context, orderID int64, item ReservedItem) *mOrdersRepositoryMockReserveStock {
-	if mmReserveStock.mock.funcReserveStock != nil {
-		mmReserveStock.mock.t.Fatalf("OrdersRepositoryMock.ReserveStock mock is already set by Set")
+// Expect sets up expected params for OrdersRepository.CreateBackorders
+func (mmCreateBackorders *mOrdersRepositoryMockCreateBackorders) Expect(ctx context.Context, orderID int64, items []OrderItem) *mOrdersRepositoryMockCreateBackorders {
+	if mmCreateBackorders.mock.funcCreateBackorders != nil {
+		mmCreateBackorders.mock.t.Fatalf("OrdersRepositoryMock.CreateBackorders mock is already set by Set")
 	}
 
-	if mmReserveStock.defaultExpectation == nil {
-		mmReserveStock.defaultExpectation = &OrdersRepositoryMockReserveStockExpectation{}
+	if mmCreateBackorders.defaultExpectation == nil {
+		mmCreateBackorders.defaultExpectation = &OrdersRepositoryMockCreateBackordersExpectation{}
 	}
 
-	mmReserveStock.defaultExpectation.params = &OrdersRepositoryMockReserveStockParams{ctx, orderID, item}
-	for _, e := range mmReserveStock.expectations {
-		if minimock.Equal(e.params, mmReserveStock.defaultExpectation.params) {
-			mmReserveStock.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReserveStock.defaultExpectation.params)
+	mmCreateBackorders.defaultExpectation.params = &OrdersRepositoryMockCreateBackordersParams{ctx, orderID, items}
+	for _, e := range mmCreateBackorders.expectations {
+		if minimock.Equal(e.params, mmCreateBackorders.defaultExpectation.params) {
+			mmCreateBackorders.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateBackorders.defaultExpectation.params)
 		}
 	}
 
-	return mmReserveStock
+	return mmCreateBackorders
 }
 
-// Inspect accepts an inspector function that has same arguments as the OrdersRepository.ReserveStock
-func (mmReserveStock *mOrdersRepositoryMockReserveStock) Inspect(f func(ctx context.Context, orderID int64, item ReservedItem)) *mOrdersRepositoryMockReserveStock {
-	if mmReserveStock.mock.inspectFuncReserveStock != nil {
-		mmReserveStock.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.ReserveStock")
+// Inspect accepts an inspector function that has same arguments as the OrdersRepository.CreateBackorders
+func (mmCreateBackorders *mOrdersRepositoryMockCreateBackorders) Inspect(f func(ctx context.Context, orderID int64, items []OrderItem)) *mOrdersRepositoryMockCreateBackorders {
+	if mmCreateBackorders.mock.inspectFuncCreateBackorders != nil {
+		mmCreateBackorders.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.CreateBackorders")
 	}
 
-	mmReserveStock.mock.inspectFuncReserveStock = f
+	mmCreateBackorders.mock.inspectFuncCreateBackorders = f
 
-	return mmReserveStock
+	return mmCreateBackorders
 }
 
-// Return sets up results that will be returned by OrdersRepository.ReserveStock
-func (mmReserveStock *mOrdersRepositoryMockReserveStock) Return(err error) *OrdersRepositoryMock {
-	if mmReserveStock.mock.funcReserveStock != nil {
-		mmReserveStock.mock.t.Fatalf("OrdersRepositoryMock.ReserveStock mock is already set by Set")
+// Return sets up results that will be returned by OrdersRepository.CreateBackorders
+func (mmCreateBackorders *mOrdersRepositoryMockCreateBackorders) Return(err error) *OrdersRepositoryMock {
+	if mmCreateBackorders.mock.funcCreateBackorders != nil {
+		mmCreateBackorders.mock.t.Fatalf("OrdersRepositoryMock.CreateBackorders mock is already set by Set")
 	}
 
-	if mmReserveStock.defaultExpectation == nil {
-		mmReserveStock.defaultExpectation = &OrdersRepositoryMockReserveStockExpectation{mock: mmReserveStock.mock}
+	if mmCreateBackorders.defaultExpectation == nil {
+		mmCreateBackorders.defaultExpectation = &OrdersRepositoryMockCreateBackordersExpectation{mock: mmCreateBackorders.mock}
 	}
-	mmReserveStock.defaultExpectation.results = &OrdersRepositoryMockReserveStockResults{err}
-	return mmReserveStock.mock
+	mmCreateBackorders.defaultExpectation.results = &OrdersRepositoryMockCreateBackordersResults{err}
+	return mmCreateBackorders.mock
 }
 
-// Set uses given function f to mock the OrdersRepository.ReserveStock method
-func (mmReserveStock *mOrdersRepositoryMockReserveStock) Set(f func(ctx context.Context, orderID int64, item ReservedItem) (err error)) *OrdersRepositoryMock {
-	if mmReserveStock.defaultExpectation != nil {
-		mmReserveStock.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.ReserveStock method")
+// Set uses given function f to mock the OrdersRepository.CreateBackorders method
+func (mmCreateBackorders *mOrdersRepositoryMockCreateBackorders) Set(f func(ctx context.Context, orderID int64, items []OrderItem) (err error)) *OrdersRepositoryMock {
+	if mmCreateBackorders.defaultExpectation != nil {
+		mmCreateBackorders.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.CreateBackorders method")
 	}
 
-	if len(mmReserveStock.expectations) > 0 {
-		mmReserveStock.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.ReserveStock method")
+	if len(mmCreateBackorders.expectations) > 0 {
+		mmCreateBackorders.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.CreateBackorders method")
 	}
 
-	mmReserveStock.mock.funcReserveStock = f
-	return mmReserveStock.mock
+	mmCreateBackorders.mock.funcCreateBackorders = f
+	return mmCreateBackorders.mock
 }
 
-// When sets expectation for the OrdersRepository.ReserveStock which will trigger the result defined by the following
+// When sets expectation for the OrdersRepository.CreateBackorders which will trigger the result defined by the following
 // Then helper
-func (mmReserveStock *mOrdersRepositoryMockReserveStock) When(ctx context.Context, orderID int64, item ReservedItem) *OrdersRepositoryMockReserveStockExpectation {
-	if mmReserveStock.mock.funcReserveStock != nil {
-		mmReserveStock.mock.t.Fatalf("OrdersRepositoryMock.ReserveStock mock is already set by Set")
+func (mmCreateBackorders *mOrdersRepositoryMockCreateBackorders) When(ctx context.Context, orderID int64, items []OrderItem) *OrdersRepositoryMockCreateBackordersExpectation {
+	if mmCreateBackorders.mock.funcCreateBackorders != nil {
+		mmCreateBackorders.mock.t.Fatalf("OrdersRepositoryMock.CreateBackorders mock is already set by Set")
 	}
 
-	expectation := &OrdersRepositoryMockReserveStockExpectation{
-		mock:   mmReserveStock.mock,
-		params: &OrdersRepositoryMockReserveStockParams{ctx, orderID, item},
+	expectation := &OrdersRepositoryMockCreateBackordersExpectation{
+		mock:   mmCreateBackorders.mock,
+		params: &OrdersRepositoryMockCreateBackordersParams{ctx, orderID, items},
 	}
-	mmReserveStock.expectations = append(mmReserveStock.expectations, expectation)
+	mmCreateBackorders.expectations = append(mmCreateBackorders.expectations, expectation)
 	return expectation
 }
 
-// Then sets up OrdersRepository.ReserveStock return parameters for the expectation previously defined by the When method
-func (e *OrdersRepositoryMockReserveStockExpectation) Then(err error) *OrdersRepositoryMock {
-	e.results = &OrdersRepositoryMockReserveStockResults{err}
+// Then sets up OrdersRepository.CreateBackorders return parameters for the expectation previously defined by the When method
+func (e *OrdersRepositoryMockCreateBackordersExpectation) Then(err error) *OrdersRepositoryMock {
+	e.results = &OrdersRepositoryMockCreateBackordersResults{err}
 	return e.mock
 }
 
-// ReserveStock implements OrdersRepository
-func (mmReserveStock *OrdersRepositoryMock) ReserveStock(ctx context.Context, orderID int64, item ReservedItem) (err error) {
-	mm_atomic.AddUint64(&mmReserveStock.beforeReserveStockCounter, 1)
-	defer mm_atomic.AddUint64(&mmReserveStock.afterReserveStockCounter, 1)
+// CreateBackorders implements OrdersRepository
+func (mmCreateBackorders *OrdersRepositoryMock) CreateBackorders(ctx context.Context, orderID int64, items []OrderItem) (err error) {
+	mm_atomic.AddUint64(&mmCreateBackorders.beforeCreateBackordersCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateBackorders.afterCreateBackordersCounter, 1)
 
-	if mmReserveStock.inspectFuncReserveStock != nil {
-		mmReserveStock.inspectFuncReserveStock(ctx, orderID, item)
+	if mmCreateBackorders.inspectFuncCreateBackorders != nil {
+		mmCreateBackorders.inspectFuncCreateBackorders(ctx, orderID, items)
 	}
 
-	mm_params := &OrdersRepositoryMockReserveStockParams{ctx, orderID, item}
+	mm_params := &OrdersRepositoryMockCreateBackordersParams{ctx, orderID, items}
 
 	// Record call args
-	mmReserveStock.ReserveStockMock.mutex.Lock()
-	mmReserveStock.ReserveStockMock.callArgs = append(mmReserveStock.ReserveStockMock.callArgs, mm_params)
-	mmReserveStock.ReserveStockMock.mutex.Unlock()
+	mmCreateBackorders.CreateBackordersMock.mutex.Lock()
+	mmCreateBackorders.CreateBackordersMock.callArgs = append(mmCreateBackorders.CreateBackordersMock.callArgs, mm_params)
+	mmCreateBackorders.CreateBackordersMock.mutex.Unlock()
 
-	for _, e := range mmReserveStock.ReserveStockMock.expectations {
+	for _, e := range mmCreateBackorders.CreateBackordersMock.expectations {
 		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmReserveStock.ReserveStockMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmReserveStock.ReserveStockMock.defaultExpectation.Counter, 1)
-		mm_want := mmReserveStock.ReserveStockMock.defaultExpectation.params
-		mm_got := OrdersRepositoryMockReserveStockParams{ctx, orderID, item}
+	if mmCreateBackorders.CreateBackordersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateBackorders.CreateBackordersMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateBackorders.CreateBackordersMock.defaultExpectation.params
+		mm_got := OrdersRepositoryMockCreateBackordersParams{ctx, orderID, items}
 		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmReserveStock.t.Errorf("OrdersRepositoryMock.ReserveStock got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmCreateBackorders.t.Errorf("OrdersRepositoryMock.CreateBackorders got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmReserveStock.ReserveStockMock.defaultExpectation.results
+		mm_results := mmCreateBackorders.CreateBackordersMock.defaultExpectation.results
 		if mm_results == nil {
-			mmReserveStock.t.Fatal("No results are set for the OrdersRepositoryMock.ReserveStock")
+			mmCreateBackorders.t.Fatal("No results are set for the OrdersRepositoryMock.CreateBackorders")
 		}
 		return (*mm_results).err
 	}
-	if mmReserveStock.funcReserveStock != nil {
-		return mmReserveStock.funcReserveStock(ctx, orderID, item)
+	if mmCreateBackorders.funcCreateBackorders != nil {
+		return mmCreateBackorders.funcCreateBackorders(ctx, orderID, items)
 	}
-	mmReserveStock.t.Fatalf("Unexpected call to OrdersRepositoryMock.ReserveStock. %v %v %v", ctx, orderID, item)
+	mmCreateBackorders.t.Fatalf("Unexpected call to OrdersRepositoryMock.CreateBackorders. %v %v %v", ctx, orderID, items)
 	return
 }
 
-// ReserveStockAfterCounter returns a count of finished OrdersRepositoryMock.ReserveStock invocations
-func (mmReserveStock *OrdersRepositoryMock) ReserveStockAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmReserveStock.afterReserveStockCounter)
+// CreateBackordersAfterCounter returns a count of finished OrdersRepositoryMock.CreateBackorders invocations
+func (mmCreateBackorders *OrdersRepositoryMock) CreateBackordersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateBackorders.afterCreateBackordersCounter)
 }
 
-// ReserveStockBeforeCounter returns a count of OrdersRepositoryMock.ReserveStock invocations
-func (mmReserveStock *OrdersRepositoryMock) ReserveStockBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmReserveStock.beforeReserveStockCounter)
+// CreateBackordersBeforeCounter returns a count of OrdersRepositoryMock.CreateBackorders invocations
+func (mmCreateBackorders *OrdersRepositoryMock) CreateBackordersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateBackorders.beforeCreateBackordersCounter)
 }
 
-// Calls returns a list of arguments used in each call to OrdersRepositoryMock.ReserveStock.
+// Calls returns a list of arguments used in each call to OrdersRepositoryMock.CreateBackorders.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmReserveStock *mOrdersRepositoryMockReserveStock) Calls() []*OrdersRepositoryMockReserveStockParams {
-	mmReserveStock.mutex.RLock()
+func (mmCreateBackorders *mOrdersRepositoryMockCreateBackorders) Calls() []*OrdersRepositoryMockCreateBackordersParams {
+	mmCreateBackorders.mutex.RLock()
 
-	argCopy := make([]*OrdersRepositoryMockReserveStockParams, len(mmReserveStock.callArgs))
-	copy(argCopy, mmReserveStock.callArgs)
+	argCopy := make([]*OrdersRepositoryMockCreateBackordersParams, len(mmCreateBackorders.callArgs))
+	copy(argCopy, mmCreateBackorders.callArgs)
 
-	mmReserveStock.mutex.RUnlock()
+	mmCreateBackorders.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockReserveStockDone returns true if the count of the ReserveStock invocations corresponds
+// MinimockCreateBackordersDone returns true if the count of the CreateBackorders invocations corresponds
 // the number of defined expectations
-func (m *OrdersRepositoryMock) MinimockReserveStockDone() bool {
-	for _, e := range m.ReserveStockMock.expectations {
+func (m *OrdersRepositoryMock) MinimockCreateBackordersDone() bool {
+	for _, e := range m.CreateBackordersMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.ReserveStockMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterReserveStockCounter) < 1 {
+	if m.CreateBackordersMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateBackordersCounter) < 1 {
 		return false
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcReserveStock != nil && mm_atomic.LoadUint64(&m.afterReserveStockCounter) < 1 {
+	if m.funcCreateBackorders != nil && mm_atomic.LoadUint64(&m.afterCreateBackordersCounter) < 1 {
 		return false
 	}
 	return true
 }
 
-// MinimockReserveStockInspect logs each unmet expectation
-func (m *OrdersRepositoryMock) MinimockReserveStockInspect() {
-	for _, e := range m.ReserveStockMock.expectations {
+// MinimockCreateBackordersInspect logs each unmet expectation
+func (m *OrdersRepositoryMock) MinimockCreateBackordersInspect() {
+	for _, e := range m.CreateBackordersMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to OrdersRepositoryMock.ReserveStock with params: %#v", *e.params)
+			m.t.Errorf("Expected call to OrdersRepositoryMock.CreateBackorders with params: %#v", *e.params)
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.ReserveStockMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterReserveStockCounter) < 1 {
-		if m.ReserveStockMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to OrdersRepositoryMock.ReserveStock")
+	if m.CreateBackordersMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateBackordersCounter) < 1 {
+		if m.CreateBackordersMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OrdersRepositoryMock.CreateBackorders")
 		} else {
-			m.t.Errorf("Expected call to OrdersRepositoryMock.ReserveStock with params: %#v", *m.ReserveStockMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to OrdersRepositoryMock.CreateBackorders with params: %#v", *m.CreateBackordersMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcReserveStock != nil && mm_atomic.LoadUint64(&m.afterReserveStockCounter) < 1 {
-		m.t.Error("Expected call to OrdersRepositoryMock.ReserveStock")
+	if m.funcCreateBackorders != nil && mm_atomic.LoadUint64(&m.afterCreateBackordersCounter) < 1 {
+		m.t.Error("Expected call to OrdersRepositoryMock.CreateBackorders")
 	}
 }
 
-type mOrdersRepositoryMockStocks struct {
+type mOrdersRepositoryMockCreateOrder struct {
 	mock               *OrdersRepositoryMock
-	defaultExpectation *OrdersRepositoryMockStocksExpectation
-	expectations       []*OrdersRepositoryMockStocksExpectation
+	defaultExpectation *OrdersRepositoryMockCreateOrderExpectation
+	expectations       []*OrdersRepositoryMockCreateOrderExpectation
 
-	callArgs []*OrdersRepositoryMockStocksParams
+	callArgs []*OrdersRepositoryMockCreateOrderParams
 	mutex    sync.RWMutex
 }
 
-// OrdersRepositoryMockStocksExpectation specifies expectation struct of the OrdersRepository.Stocks
-type OrdersRepositoryMockStocksExpectation struct {
+// OrdersRepositoryMockCreateOrderExpectation specifies expectation struct of the OrdersRepository.CreateOrder
+type OrdersRepositoryMockCreateOrderExpectation struct {
 	mock    *OrdersRepositoryMock
-	params  *OrdersRepositoryMockStocksParams
-	results *OrdersRepositoryMockStocksResults
+	params  *OrdersRepositoryMockCreateOrderParams
+	results *OrdersRepositoryMockCreateOrderResults
 	Counter uint64
 }
 
-// OrdersRepositoryMockStocksParams contains parameters of the OrdersRepository.Stocks
-type OrdersRepositoryMockStocksParams struct {
-	ctx context.Context
-	sku uint32
+// OrdersRepositoryMockCreateOrderParams contains parameters of the OrdersRepository.CreateOrder
+type OrdersRepositoryMockCreateOrderParams struct {
+	ctx   context.Context
+	order *Order
 }
 
-// OrdersRepositoryMockStocksResults contains results of the OrdersRepository.Stocks
-type OrdersRepositoryMockStocksResults struct {
-	sa1 []Stock
+// OrdersRepositoryMockCreateOrderResults contains results of the OrdersRepository.CreateOrder
+type OrdersRepositoryMockCreateOrderResults struct {
+	i1  int64
 	err error
 }
 
-// Expect sets up expected params for OrdersRepository.Stocks
-func (mmStocks *mOrdersRepositoryMockStocks) Expect(ctx context.Context, sku uint32) *mOrdersRepositoryMockStocks {
-	if mmStocks.mock.funcStocks != nil {
-		mmStocks.mock.t.Fatalf("OrdersRepositoryMock.Stocks mock is already set by Set")
+// Expect sets up expected params for OrdersRepository.CreateOrder
+func (mmCreateOrder *mOrdersRepositoryMockCreateOrder) Expect(ctx context.Context, order *Order) *mOrdersRepositoryMockCreateOrder {
+	if mmCreateOrder.mock.funcCreateOrder != nil {
+		mmCreateOrder.mock.t.Fatalf("OrdersRepositoryMock.CreateOrder mock is already set by Set")
 	}
 
-	if mmStocks.defaultExpectation == nil {
-		mmStocks.defaultExpectation = &OrdersRepositoryMockStocksExpectation{}
-	}
+	if mmCreateOrder.defaultExpectation == nil {
+		mmCreateOrder.defaultExpectation = &OrdersRepositoryMockCreateOrderExpectation{}
+	}
+
+	mmCreateOrder.defaultExpectation.params = &OrdersRepositoryMockCreateOrderParams{ctx, order}
+	for _, e := range mmCreateOrder.expectations {
+		if minimock.Equal(e.params, mmCreateOrder.defaultExpectation.params) {
+			mmCreateOrder.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateOrder.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateOrder
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrdersRepository.CreateOrder
+func (mmCreateOrder *mOrdersRepositoryMockCreateOrder) Inspect(f func(ctx context.Context, order *Order)) *mOrdersRepositoryMockCreateOrder {
+	if mmCreateOrder.mock.inspectFuncCreateOrder != nil {
+		mmCreateOrder.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.CreateOrder")
+	}
+
+	mmCreateOrder.mock.inspectFuncCreateOrder = f
+
+	return mmCreateOrder
+}
+
+// Return sets up results that will be returned by OrdersRepository.CreateOrder
+func (mmCreateOrder *mOrdersRepositoryMockCreateOrder) Return(i1 int64, err error) *OrdersRepositoryMock {
+	if mmCreateOrder.mock.funcCreateOrder != nil {
+		mmCreateOrder.mock.t.Fatalf("OrdersRepositoryMock.CreateOrder mock is already set by Set")
+	}
+
+	if mmCreateOrder.defaultExpectation == nil {
+		mmCreateOrder.defaultExpectation = &OrdersRepositoryMockCreateOrderExpectation{mock: mmCreateOrder.mock}
+	}
+	mmCreateOrder.defaultExpectation.results = &OrdersRepositoryMockCreateOrderResults{i1, err}
+	return mmCreateOrder.mock
+}
+
+// Set uses given function f to mock the OrdersRepository.CreateOrder method
+func (mmCreateOrder *mOrdersRepositoryMockCreateOrder) Set(f func(ctx context.Context, order *Order) (i1 int64, err error)) *OrdersRepositoryMock {
+	if mmCreateOrder.defaultExpectation != nil {
+		mmCreateOrder.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.CreateOrder method")
+	}
+
+	if len(mmCreateOrder.expectations) > 0 {
+		mmCreateOrder.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.CreateOrder method")
+	}
+
+	mmCreateOrder.mock.funcCreateOrder = f
+	return mmCreateOrder.mock
+}
+
+// When sets expectation for the OrdersRepository.CreateOrder which will trigger the result defined by the following
+// Then helper
+func (mmCreateOrder *mOrdersRepositoryMockCreateOrder) When(ctx context.Context, order *Order) *OrdersRepositoryMockCreateOrderExpectation {
+	if mmCreateOrder.mock.funcCreateOrder != nil {
+		mmCreateOrder.mock.t.Fatalf("OrdersRepositoryMock.CreateOrder mock is already set by Set")
+	}
+
+	expectation := &OrdersRepositoryMockCreateOrderExpectation{
+		mock:   mmCreateOrder.mock,
+		params: &OrdersRepositoryMockCreateOrderParams{ctx, order},
+	}
+	mmCreateOrder.expectations = append(mmCreateOrder.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrdersRepository.CreateOrder return parameters for the expectation previously defined by the When method
+func (e *OrdersRepositoryMockCreateOrderExpectation) Then(i1 int64, err error) *OrdersRepositoryMock {
+	e.results = &OrdersRepositoryMockCreateOrderResults{i1, err}
+	return e.mock
+}
+
+// CreateOrder implements OrdersRepository
+func (mmCreateOrder *OrdersRepositoryMock) CreateOrder(ctx context.Context, order *Order) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreateOrder.beforeCreateOrderCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateOrder.afterCreateOrderCounter, 1)
+
+	if mmCreateOrder.inspectFuncCreateOrder != nil {
+		mmCreateOrder.inspectFuncCreateOrder(ctx, order)
+	}
+
+	mm_params := &OrdersRepositoryMockCreateOrderParams{ctx, order}
+
+	// Record call args
+	mmCreateOrder.CreateOrderMock.mutex.Lock()
+	mmCreateOrder.CreateOrderMock.callArgs = append(mmCreateOrder.CreateOrderMock.callArgs, mm_params)
+	mmCreateOrder.CreateOrderMock.mutex.Unlock()
+
+	for _, e := range mmCreateOrder.CreateOrderMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreateOrder.CreateOrderMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateOrder.CreateOrderMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateOrder.CreateOrderMock.defaultExpectation.params
+		mm_got := OrdersRepositoryMockCreateOrderParams{ctx, order}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateOrder.t.Errorf("OrdersRepositoryMock.CreateOrder got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateOrder.CreateOrderMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateOrder.t.Fatal("No results are set for the OrdersRepositoryMock.CreateOrder")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreateOrder.funcCreateOrder != nil {
+		return mmCreateOrder.funcCreateOrder(ctx, order)
+	}
+	mmCreateOrder.t.Fatalf("Unexpected call to OrdersRepositoryMock.CreateOrder. %v %v", ctx, order)
+	return
+}
+
+// CreateOrderAfterCounter returns a count of finished OrdersRepositoryMock.CreateOrder invocations
+func (mmCreateOrder *OrdersRepositoryMock) CreateOrderAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateOrder.afterCreateOrderCounter)
+}
+
+// CreateOrderBeforeCounter returns a count of OrdersRepositoryMock.CreateOrder invocations
+func (mmCreateOrder *OrdersRepositoryMock) CreateOrderBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateOrder.beforeCreateOrderCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrdersRepositoryMock.CreateOrder.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateOrder *mOrdersRepositoryMockCreateOrder) Calls() []*OrdersRepositoryMockCreateOrderParams {
+	mmCreateOrder.mutex.RLock()
+
+	argCopy := make([]*OrdersRepositoryMockCreateOrderParams, len(mmCreateOrder.callArgs))
+	copy(argCopy, mmCreateOrder.callArgs)
+
+	mmCreateOrder.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateOrderDone returns true if the count of the CreateOrder invocations corresponds
+// the number of defined expectations
+func (m *OrdersRepositoryMock) MinimockCreateOrderDone() bool {
+	for _, e := range m.CreateOrderMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateOrderMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateOrderCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateOrder != nil && mm_atomic.LoadUint64(&m.afterCreateOrderCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockCreateOrderInspect logs each unmet expectation
+func (m *OrdersRepositoryMock) MinimockCreateOrderInspect() {
+	for _, e := range m.CreateOrderMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.CreateOrder with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateOrderMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateOrderCounter) < 1 {
+		if m.CreateOrderMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OrdersRepositoryMock.CreateOrder")
+		} else {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.CreateOrder with params: %#v", *m.CreateOrderMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateOrder != nil && mm_atomic.LoadUint64(&m.afterCreateOrderCounter) < 1 {
+		m.t.Error("Expected call to OrdersRepositoryMock.CreateOrder")
+	}
+}
+
+type mOrdersRepositoryMockCreateOrderNotification struct {
+	mock               *OrdersRepositoryMock
+	defaultExpectation *OrdersRepositoryMockCreateOrderNotificationExpectation
+	expectations       []*OrdersRepositoryMockCreateOrderNotificationExpectation
+
+	callArgs []*OrdersRepositoryMockCreateOrderNotificationParams
+	mutex    sync.RWMutex
+}
+
+// OrdersRepositoryMockCreateOrderNotificationExpectation specifies expectation struct of the OrdersRepository.CreateOrderNotification
+type OrdersRepositoryMockCreateOrderNotificationExpectation struct {
+	mock    *OrdersRepositoryMock
+	params  *OrdersRepositoryMockCreateOrderNotificationParams
+	results *OrdersRepositoryMockCreateOrderNotificationResults
+	Counter uint64
+}
+
+// OrdersRepositoryMockCreateOrderNotificationParams contains parameters of the OrdersRepository.CreateOrderNotification
+type OrdersRepositoryMockCreateOrderNotificationParams struct {
+	ctx   context.Context
+	order *Order
+}
+
+// OrdersRepositoryMockCreateOrderNotificationResults contains results of the OrdersRepository.CreateOrderNotification
+type OrdersRepositoryMockCreateOrderNotificationResults struct {
+	err error
+}
+
+// Expect sets up expected params for OrdersRepository.CreateOrderNotification
+func (mmCreateOrderNotification *mOrdersRepositoryMockCreateOrderNotification) Expect(ctx context.Context, order *Order) *mOrdersRepositoryMockCreateOrderNotification {
+	if mmCreateOrderNotification.mock.funcCreateOrderNotification != nil {
+		mmCreateOrderNotification.mock.t.Fatalf("OrdersRepositoryMock.CreateOrderNotification mock is already set by Set")
+	}
+
+	if mmCreateOrderNotification.defaultExpectation == nil {
+		mmCreateOrderNotification.defaultExpectation = &OrdersRepositoryMockCreateOrderNotificationExpectation{}
+	}
+
+	mmCreateOrderNotification.defaultExpectation.params = &OrdersRepositoryMockCreateOrderNotificationParams{ctx, order}
+	for _, e := range mmCreateOrderNotification.expectations {
+		if minimock.Equal(e.params, mmCreateOrderNotification.defaultExpectation.params) {
+			mmCreateOrderNotification.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateOrderNotification.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateOrderNotification
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrdersRepository.CreateOrderNotification
+func (mmCreateOrderNotification *mOrdersRepositoryMockCreateOrderNotification) Inspect(f func(ctx context.Context, order *Order)) *mOrdersRepositoryMockCreateOrderNotification {
+	if mmCreateOrderNotification.mock.inspectFuncCreateOrderNotification != nil {
+		mmCreateOrderNotification.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.CreateOrderNotification")
+	}
+
+	mmCreateOrderNotification.mock.inspectFuncCreateOrderNotification = f
+
+	return mmCreateOrderNotification
+}
+
+// Return sets up results that will be returned by OrdersRepository.CreateOrderNotification
+func (mmCreateOrderNotification *mOrdersRepositoryMockCreateOrderNotification) Return(err error) *OrdersRepositoryMock {
+	if mmCreateOrderNotification.mock.funcCreateOrderNotification != nil {
+		mmCreateOrderNotification.mock.t.Fatalf("OrdersRepositoryMock.CreateOrderNotification mock is already set by Set")
+	}
+
+	if mmCreateOrderNotification.defaultExpectation == nil {
+		mmCreateOrderNotification.defaultExpectation = &OrdersRepositoryMockCreateOrderNotificationExpectation{mock: mmCreateOrderNotification.mock}
+	}
+	mmCreateOrderNotification.defaultExpectation.results = &OrdersRepositoryMockCreateOrderNotificationResults{err}
+	return mmCreateOrderNotification.mock
+}
+
+// Set uses given function f to mock the OrdersRepository.CreateOrderNotification method
+func (mmCreateOrderNotification *mOrdersRepositoryMockCreateOrderNotification) Set(f func(ctx context.Context, order *Order) (err error)) *OrdersRepositoryMock {
+	if mmCreateOrderNotification.defaultExpectation != nil {
+		mmCreateOrderNotification.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.CreateOrderNotification method")
+	}
+
+	if len(mmCreateOrderNotification.expectations) > 0 {
+		mmCreateOrderNotification.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.CreateOrderNotification method")
+	}
+
+	mmCreateOrderNotification.mock.funcCreateOrderNotification = f
+	return mmCreateOrderNotification.mock
+}
+
+// When sets expectation for the OrdersRepository.CreateOrderNotification which will trigger the result defined by the following
+// Then helper
+func (mmCreateOrderNotification *mOrdersRepositoryMockCreateOrderNotification) When(ctx context.Context, order *Order) *OrdersRepositoryMockCreateOrderNotificationExpectation {
+	if mmCreateOrderNotification.mock.funcCreateOrderNotification != nil {
+		mmCreateOrderNotification.mock.t.Fatalf("OrdersRepositoryMock.CreateOrderNotification mock is already set by Set")
+	}
+
+	expectation := &OrdersRepositoryMockCreateOrderNotificationExpectation{
+		mock:   mmCreateOrderNotification.mock,
+		params: &OrdersRepositoryMockCreateOrderNotificationParams{ctx, order},
+	}
+	mmCreateOrderNotification.expectations = append(mmCreateOrderNotification.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrdersRepository.CreateOrderNotification return parameters for the expectation previously defined by the When method
+func (e *OrdersRepositoryMockCreateOrderNotificationExpectation) Then(err error) *OrdersRepositoryMock {
+	e.results = &OrdersRepositoryMockCreateOrderNotificationResults{err}
+	return e.mock
+}
+
+// CreateOrderNotification implements OrdersRepository
+func (mmCreateOrderNotification *OrdersRepositoryMock) CreateOrderNotification(ctx context.Context, order *Order) (err error) {
+	mm_atomic.AddUint64(&mmCreateOrderNotification.beforeCreateOrderNotificationCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateOrderNotification.afterCreateOrderNotificationCounter, 1)
+
+	if mmCreateOrderNotification.inspectFuncCreateOrderNotification != nil {
+		mmCreateOrderNotification.inspectFuncCreateOrderNotification(ctx, order)
+	}
+
+	mm_params := &OrdersRepositoryMockCreateOrderNotificationParams{ctx, order}
+
+	// Record call args
+	mmCreateOrderNotification.CreateOrderNotificationMock.mutex.Lock()
+	mmCreateOrderNotification.CreateOrderNotificationMock.callArgs = append(mmCreateOrderNotification.CreateOrderNotificationMock.callArgs, mm_params)
+	mmCreateOrderNotification.CreateOrderNotificationMock.mutex.Unlock()
+
+	for _, e := range mmCreateOrderNotification.CreateOrderNotificationMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateOrderNotification.CreateOrderNotificationMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateOrderNotification.CreateOrderNotificationMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateOrderNotification.CreateOrderNotificationMock.defaultExpectation.params
+		mm_got := OrdersRepositoryMockCreateOrderNotificationParams{ctx, order}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateOrderNotification.t.Errorf("OrdersRepositoryMock.CreateOrderNotification got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateOrderNotification.CreateOrderNotificationMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateOrderNotification.t.Fatal("No results are set for the OrdersRepositoryMock.CreateOrderNotification")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateOrderNotification.funcCreateOrderNotification != nil {
+		return mmCreateOrderNotification.funcCreateOrderNotification(ctx, order)
+	}
+	mmCreateOrderNotification.t.Fatalf("Unexpected call to OrdersRepositoryMock.CreateOrderNotification. %v %v", ctx, order)
+	return
+}
+
+// CreateOrderNotificationAfterCounter returns a count of finished OrdersRepositoryMock.CreateOrderNotification invocations
+func (mmCreateOrderNotification *OrdersRepositoryMock) CreateOrderNotificationAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateOrderNotification.afterCreateOrderNotificationCounter)
+}
+
+// CreateOrderNotificationBeforeCounter returns a count of OrdersRepositoryMock.CreateOrderNotification invocations
+func (mmCreateOrderNotification *OrdersRepositoryMock) CreateOrderNotificationBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateOrderNotification.beforeCreateOrderNotificationCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrdersRepositoryMock.CreateOrderNotification.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateOrderNotification *mOrdersRepositoryMockCreateOrderNotification) Calls() []*OrdersRepositoryMockCreateOrderNotificationParams {
+	mmCreateOrderNotification.mutex.RLock()
+
+	argCopy := make([]*OrdersRepositoryMockCreateOrderNotificationParams, len(mmCreateOrderNotification.callArgs))
+	copy(argCopy, mmCreateOrderNotification.callArgs)
+
+	mmCreateOrderNotification.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateOrderNotificationDone returns true if the count of the CreateOrderNotification invocations corresponds
+// the number of defined expectations
+func (m *OrdersRepositoryMock) MinimockCreateOrderNotificationDone() bool {
+	for _, e := range m.CreateOrderNotificationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateOrderNotificationMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateOrderNotificationCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateOrderNotification != nil && mm_atomic.LoadUint64(&m.afterCreateOrderNotificationCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockCreateOrderNotificationInspect logs each unmet expectation
+func (m *OrdersRepositoryMock) MinimockCreateOrderNotificationInspect() {
+	for _, e := range m.CreateOrderNotificationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.CreateOrderNotification with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateOrderNotificationMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateOrderNotificationCounter) < 1 {
+		if m.CreateOrderNotificationMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OrdersRepositoryMock.CreateOrderNotification")
+		} else {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.CreateOrderNotification with params: %#v", *m.CreateOrderNotificationMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateOrderNotification != nil && mm_atomic.LoadUint64(&m.afterCreateOrderNotificationCounter) < 1 {
+		m.t.Error("Expected call to OrdersRepositoryMock.CreateOrderNotification")
+	}
+}
+
+type mOrdersRepositoryMockCreateReturnItems struct {
+	mock               *OrdersRepositoryMock
+	defaultExpectation *OrdersRepositoryMockCreateReturnItemsExpectation
+	expectations       []*OrdersRepositoryMockCreateReturnItemsExpectation
+
+	callArgs []*OrdersRepositoryMockCreateReturnItemsParams
+	mutex    sync.RWMutex
+}
+
+// OrdersRepositoryMockCreateReturnItemsExpectation specifies expectation struct of the OrdersRepository.CreateReturnItems
+type OrdersRepositoryMockCreateReturnItemsExpectation struct {
+	mock    *OrdersRepositoryMock
+	params  *OrdersRepositoryMockCreateReturnItemsParams
+	results *OrdersRepositoryMockCreateReturnItemsResults
+	Counter uint64
+}
+
+// OrdersRepositoryMockCreateReturnItemsParams contains parameters of the OrdersRepository.CreateReturnItems
+type OrdersRepositoryMockCreateReturnItemsParams struct {
+	ctx     context.Context
+	orderID int64
+	items   []OrderItem
+}
+
+// OrdersRepositoryMockCreateReturnItemsResults contains results of the OrdersRepository.CreateReturnItems
+type OrdersRepositoryMockCreateReturnItemsResults struct {
+	err error
+}
+
+// Expect sets up expected params for OrdersRepository.CreateReturnItems
+func (mmCreateReturnItems *mOrdersRepositoryMockCreateReturnItems) Expect(ctx context.Context, orderID int64, items []OrderItem) *mOrdersRepositoryMockCreateReturnItems {
+	if mmCreateReturnItems.mock.funcCreateReturnItems != nil {
+		mmCreateReturnItems.mock.t.Fatalf("OrdersRepositoryMock.CreateReturnItems mock is already set by Set")
+	}
+
+	if mmCreateReturnItems.defaultExpectation == nil {
+		mmCreateReturnItems.defaultExpectation = &OrdersRepositoryMockCreateReturnItemsExpectation{}
+	}
+
+	mmCreateReturnItems.defaultExpectation.params = &OrdersRepositoryMockCreateReturnItemsParams{ctx, orderID, items}
+	for _, e := range mmCreateReturnItems.expectations {
+		if minimock.Equal(e.params, mmCreateReturnItems.defaultExpectation.params) {
+			mmCreateReturnItems.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateReturnItems.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateReturnItems
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrdersRepository.CreateReturnItems
+func (mmCreateReturnItems *mOrdersRepositoryMockCreateReturnItems) Inspect(f func(ctx context.Context, orderID int64, items []OrderItem)) *mOrdersRepositoryMockCreateReturnItems {
+	if mmCreateReturnItems.mock.inspectFuncCreateReturnItems != nil {
+		mmCreateReturnItems.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.CreateReturnItems")
+	}
+
+	mmCreateReturnItems.mock.inspectFuncCreateReturnItems = f
+
+	return mmCreateReturnItems
+}
+
+// Return sets up results that will be returned by OrdersRepository.CreateReturnItems
+func (mmCreateReturnItems *mOrdersRepositoryMockCreateReturnItems) Return(err error) *OrdersRepositoryMock {
+	if mmCreateReturnItems.mock.funcCreateReturnItems != nil {
+		mmCreateReturnItems.mock.t.Fatalf("OrdersRepositoryMock.CreateReturnItems mock is already set by Set")
+	}
+
+	if mmCreateReturnItems.defaultExpectation == nil {
+		mmCreateReturnItems.defaultExpectation = &OrdersRepositoryMockCreateReturnItemsExpectation{mock: mmCreateReturnItems.mock}
+	}
+	mmCreateReturnItems.defaultExpectation.results = &OrdersRepositoryMockCreateReturnItemsResults{err}
+	return mmCreateReturnItems.mock
+}
+
+// Set uses given function f to mock the OrdersRepository.CreateReturnItems method
+func (mmCreateReturnItems *mOrdersRepositoryMockCreateReturnItems) Set(f func(ctx context.Context, orderID int64, items []OrderItem) (err error)) *OrdersRepositoryMock {
+	if mmCreateReturnItems.defaultExpectation != nil {
+		mmCreateReturnItems.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.CreateReturnItems method")
+	}
+
+	if len(mmCreateReturnItems.expectations) > 0 {
+		mmCreateReturnItems.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.CreateReturnItems method")
+	}
+
+	mmCreateReturnItems.mock.funcCreateReturnItems = f
+	return mmCreateReturnItems.mock
+}
+
+// When sets expectation for the OrdersRepository.CreateReturnItems which will trigger the result defined by the following
+// Then helper
+func (mmCreateReturnItems *mOrdersRepositoryMockCreateReturnItems) When(ctx context.Context, orderID int64, items []OrderItem) *OrdersRepositoryMockCreateReturnItemsExpectation {
+	if mmCreateReturnItems.mock.funcCreateReturnItems != nil {
+		mmCreateReturnItems.mock.t.Fatalf("OrdersRepositoryMock.CreateReturnItems mock is already set by Set")
+	}
+
+	expectation := &OrdersRepositoryMockCreateReturnItemsExpectation{
+		mock:   mmCreateReturnItems.mock,
+		params: &OrdersRepositoryMockCreateReturnItemsParams{ctx, orderID, items},
+	}
+	mmCreateReturnItems.expectations = append(mmCreateReturnItems.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrdersRepository.CreateReturnItems return parameters for the expectation previously defined by the When method
+func (e *OrdersRepositoryMockCreateReturnItemsExpectation) Then(err error) *OrdersRepositoryMock {
+	e.results = &OrdersRepositoryMockCreateReturnItemsResults{err}
+	return e.mock
+}
+
+// CreateReturnItems implements OrdersRepository
+func (mmCreateReturnItems *OrdersRepositoryMock) CreateReturnItems(ctx context.Context, orderID int64, items []OrderItem) (err error) {
+	mm_atomic.AddUint64(&mmCreateReturnItems.beforeCreateReturnItemsCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateReturnItems.afterCreateReturnItemsCounter, 1)
+
+	if mmCreateReturnItems.inspectFuncCreateReturnItems != nil {
+		mmCreateReturnItems.inspectFuncCreateReturnItems(ctx, orderID, items)
+	}
+
+	mm_params := &OrdersRepositoryMockCreateReturnItemsParams{ctx, orderID, items}
+
+	// Record call args
+	mmCreateReturnItems.CreateReturnItemsMock.mutex.Lock()
+	mmCreateReturnItems.CreateReturnItemsMock.callArgs = append(mmCreateReturnItems.CreateReturnItemsMock.callArgs, mm_params)
+	mmCreateReturnItems.CreateReturnItemsMock.mutex.Unlock()
+
+	for _, e := range mmCreateReturnItems.CreateReturnItemsMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateReturnItems.CreateReturnItemsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateReturnItems.CreateReturnItemsMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateReturnItems.CreateReturnItemsMock.defaultExpectation.params
+		mm_got := OrdersRepositoryMockCreateReturnItemsParams{ctx, orderID, items}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateReturnItems.t.Errorf("OrdersRepositoryMock.CreateReturnItems got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateReturnItems.CreateReturnItemsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateReturnItems.t.Fatal("No results are set for the OrdersRepositoryMock.CreateReturnItems")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateReturnItems.funcCreateReturnItems != nil {
+		return mmCreateReturnItems.funcCreateReturnItems(ctx, orderID, items)
+	}
+	mmCreateReturnItems.t.Fatalf("Unexpected call to OrdersRepositoryMock.CreateReturnItems. %v %v %v", ctx, orderID, items)
+	return
+}
+
+// CreateReturnItemsAfterCounter returns a count of finished OrdersRepositoryMock.CreateReturnItems invocations
+func (mmCreateReturnItems *OrdersRepositoryMock) CreateReturnItemsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateReturnItems.afterCreateReturnItemsCounter)
+}
+
+// CreateReturnItemsBeforeCounter returns a count of OrdersRepositoryMock.CreateReturnItems invocations
+func (mmCreateReturnItems *OrdersRepositoryMock) CreateReturnItemsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateReturnItems.beforeCreateReturnItemsCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrdersRepositoryMock.CreateReturnItems.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateReturnItems *mOrdersRepositoryMockCreateReturnItems) Calls() []*OrdersRepositoryMockCreateReturnItemsParams {
+	mmCreateReturnItems.mutex.RLock()
+
+	argCopy := make([]*OrdersRepositoryMockCreateReturnItemsParams, len(mmCreateReturnItems.callArgs))
+	copy(argCopy, mmCreateReturnItems.callArgs)
+
+	mmCreateReturnItems.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateReturnItemsDone returns true if the count of the CreateReturnItems invocations corresponds
+// the number of defined expectations
+func (m *OrdersRepositoryMock) MinimockCreateReturnItemsDone() bool {
+	for _, e := range m.CreateReturnItemsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateReturnItemsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateReturnItemsCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateReturnItems != nil && mm_atomic.LoadUint64(&m.afterCreateReturnItemsCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockCreateReturnItemsInspect logs each unmet expectation
+func (m *OrdersRepositoryMock) MinimockCreateReturnItemsInspect() {
+	for _, e := range m.CreateReturnItemsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.CreateReturnItems with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateReturnItemsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateReturnItemsCounter) < 1 {
+		if m.CreateReturnItemsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OrdersRepositoryMock.CreateReturnItems")
+		} else {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.CreateReturnItems with params: %#v", *m.CreateReturnItemsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateReturnItems != nil && mm_atomic.LoadUint64(&m.afterCreateReturnItemsCounter) < 1 {
+		m.t.Error("Expected call to OrdersRepositoryMock.CreateReturnItems")
+	}
+}
+
+type mOrdersRepositoryMockCreateSellerOrder struct {
+	mock               *OrdersRepositoryMock
+	defaultExpectation *OrdersRepositoryMockCreateSellerOrderExpectation
+	expectations       []*OrdersRepositoryMockCreateSellerOrderExpectation
+
+	callArgs []*OrdersRepositoryMockCreateSellerOrderParams
+	mutex    sync.RWMutex
+}
+
+// OrdersRepositoryMockCreateSellerOrderExpectation specifies expectation struct of the OrdersRepository.CreateSellerOrder
+type OrdersRepositoryMockCreateSellerOrderExpectation struct {
+	mock    *OrdersRepositoryMock
+	params  *OrdersRepositoryMockCreateSellerOrderParams
+	results *OrdersRepositoryMockCreateSellerOrderResults
+	Counter uint64
+}
+
+// OrdersRepositoryMockCreateSellerOrderParams contains parameters of the OrdersRepository.CreateSellerOrder
+type OrdersRepositoryMockCreateSellerOrderParams struct {
+	ctx   context.Context
+	order SellerOrder
+}
+
+// OrdersRepositoryMockCreateSellerOrderResults contains results of the OrdersRepository.CreateSellerOrder
+type OrdersRepositoryMockCreateSellerOrderResults struct {
+	err error
+}
+
+// Expect sets up expected params for OrdersRepository.CreateSellerOrder
+func (mmCreateSellerOrder *mOrdersRepositoryMockCreateSellerOrder) Expect(ctx context.Context, order SellerOrder) *mOrdersRepositoryMockCreateSellerOrder {
+	if mmCreateSellerOrder.mock.funcCreateSellerOrder != nil {
+		mmCreateSellerOrder.mock.t.Fatalf("OrdersRepositoryMock.CreateSellerOrder mock is already set by Set")
+	}
+
+	if mmCreateSellerOrder.defaultExpectation == nil {
+		mmCreateSellerOrder.defaultExpectation = &OrdersRepositoryMockCreateSellerOrderExpectation{}
+	}
+
+	mmCreateSellerOrder.defaultExpectation.params = &OrdersRepositoryMockCreateSellerOrderParams{ctx, order}
+	for _, e := range mmCreateSellerOrder.expectations {
+		if minimock.Equal(e.params, mmCreateSellerOrder.defaultExpectation.params) {
+			mmCreateSellerOrder.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateSellerOrder.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateSellerOrder
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrdersRepository.CreateSellerOrder
+func (mmCreateSellerOrder *mOrdersRepositoryMockCreateSellerOrder) Inspect(f func(ctx context.Context, order SellerOrder)) *mOrdersRepositoryMockCreateSellerOrder {
+	if mmCreateSellerOrder.mock.inspectFuncCreateSellerOrder != nil {
+		mmCreateSellerOrder.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.CreateSellerOrder")
+	}
+
+	mmCreateSellerOrder.mock.inspectFuncCreateSellerOrder = f
+
+	return mmCreateSellerOrder
+}
+
+// Return sets up results that will be returned by OrdersRepository.CreateSellerOrder
+func (mmCreateSellerOrder *mOrdersRepositoryMockCreateSellerOrder) Return(err error) *OrdersRepositoryMock {
+	if mmCreateSellerOrder.mock.funcCreateSellerOrder != nil {
+		mmCreateSellerOrder.mock.t.Fatalf("OrdersRepositoryMock.CreateSellerOrder mock is already set by Set")
+	}
+
+	if mmCreateSellerOrder.defaultExpectation == nil {
+		mmCreateSellerOrder.defaultExpectation = &OrdersRepositoryMockCreateSellerOrderExpectation{mock: mmCreateSellerOrder.mock}
+	}
+	mmCreateSellerOrder.defaultExpectation.results = &OrdersRepositoryMockCreateSellerOrderResults{err}
+	return mmCreateSellerOrder.mock
+}
+
+// Set uses given function f to mock the OrdersRepository.CreateSellerOrder method
+func (mmCreateSellerOrder *mOrdersRepositoryMockCreateSellerOrder) Set(f func(ctx context.Context, order SellerOrder) (err error)) *OrdersRepositoryMock {
+	if mmCreateSellerOrder.defaultExpectation != nil {
+		mmCreateSellerOrder.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.CreateSellerOrder method")
+	}
+
+	if len(mmCreateSellerOrder.expectations) > 0 {
+		mmCreateSellerOrder.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.CreateSellerOrder method")
+	}
+
+	mmCreateSellerOrder.mock.funcCreateSellerOrder = f
+	return mmCreateSellerOrder.mock
+}
+
+// When sets expectation for the OrdersRepository.CreateSellerOrder which will trigger the result defined by the following
+// Then helper
+func (mmCreateSellerOrder *mOrdersRepositoryMockCreateSellerOrder) When(ctx context.Context, order SellerOrder) *OrdersRepositoryMockCreateSellerOrderExpectation {
+	if mmCreateSellerOrder.mock.funcCreateSellerOrder != nil {
+		mmCreateSellerOrder.mock.t.Fatalf("OrdersRepositoryMock.CreateSellerOrder mock is already set by Set")
+	}
+
+	expectation := &OrdersRepositoryMockCreateSellerOrderExpectation{
+		mock:   mmCreateSellerOrder.mock,
+		params: &OrdersRepositoryMockCreateSellerOrderParams{ctx, order},
+	}
+	mmCreateSellerOrder.expectations = append(mmCreateSellerOrder.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrdersRepository.CreateSellerOrder return parameters for the expectation previously defined by the When method
+func (e *OrdersRepositoryMockCreateSellerOrderExpectation) Then(err error) *OrdersRepositoryMock {
+	e.results = &OrdersRepositoryMockCreateSellerOrderResults{err}
+	return e.mock
+}
+
+// CreateSellerOrder implements OrdersRepository
+func (mmCreateSellerOrder *OrdersRepositoryMock) CreateSellerOrder(ctx context.Context, order SellerOrder) (err error) {
+	mm_atomic.AddUint64(&mmCreateSellerOrder.beforeCreateSellerOrderCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateSellerOrder.afterCreateSellerOrderCounter, 1)
+
+	if mmCreateSellerOrder.inspectFuncCreateSellerOrder != nil {
+		mmCreateSellerOrder.inspectFuncCreateSellerOrder(ctx, order)
+	}
+
+	mm_params := &OrdersRepositoryMockCreateSellerOrderParams{ctx, order}
+
+	// Record call args
+	mmCreateSellerOrder.CreateSellerOrderMock.mutex.Lock()
+	mmCreateSellerOrder.CreateSellerOrderMock.callArgs = append(mmCreateSellerOrder.CreateSellerOrderMock.callArgs, mm_params)
+	mmCreateSellerOrder.CreateSellerOrderMock.mutex.Unlock()
+
+	for _, e := range mmCreateSellerOrder.CreateSellerOrderMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateSellerOrder.CreateSellerOrderMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateSellerOrder.CreateSellerOrderMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateSellerOrder.CreateSellerOrderMock.defaultExpectation.params
+		mm_got := OrdersRepositoryMockCreateSellerOrderParams{ctx, order}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateSellerOrder.t.Errorf("OrdersRepositoryMock.CreateSellerOrder got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateSellerOrder.CreateSellerOrderMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateSellerOrder.t.Fatal("No results are set for the OrdersRepositoryMock.CreateSellerOrder")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateSellerOrder.funcCreateSellerOrder != nil {
+		return mmCreateSellerOrder.funcCreateSellerOrder(ctx, order)
+	}
+	mmCreateSellerOrder.t.Fatalf("Unexpected call to OrdersRepositoryMock.CreateSellerOrder. %v %v", ctx, order)
+	return
+}
+
+// CreateSellerOrderAfterCounter returns a count of finished OrdersRepositoryMock.CreateSellerOrder invocations
+func (mmCreateSellerOrder *OrdersRepositoryMock) CreateSellerOrderAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateSellerOrder.afterCreateSellerOrderCounter)
+}
+
+// CreateSellerOrderBeforeCounter returns a count of OrdersRepositoryMock.CreateSellerOrder invocations
+func (mmCreateSellerOrder *OrdersRepositoryMock) CreateSellerOrderBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateSellerOrder.beforeCreateSellerOrderCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrdersRepositoryMock.CreateSellerOrder.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateSellerOrder *mOrdersRepositoryMockCreateSellerOrder) Calls() []*OrdersRepositoryMockCreateSellerOrderParams {
+	mmCreateSellerOrder.mutex.RLock()
+
+	argCopy := make([]*OrdersRepositoryMockCreateSellerOrderParams, len(mmCreateSellerOrder.callArgs))
+	copy(argCopy, mmCreateSellerOrder.callArgs)
+
+	mmCreateSellerOrder.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateSellerOrderDone returns true if the count of the CreateSellerOrder invocations corresponds
+// the number of defined expectations
+func (m *OrdersRepositoryMock) MinimockCreateSellerOrderDone() bool {
+	for _, e := range m.CreateSellerOrderMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateSellerOrderMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateSellerOrderCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateSellerOrder != nil && mm_atomic.LoadUint64(&m.afterCreateSellerOrderCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockCreateSellerOrderInspect logs each unmet expectation
+func (m *OrdersRepositoryMock) MinimockCreateSellerOrderInspect() {
+	for _, e := range m.CreateSellerOrderMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.CreateSellerOrder with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateSellerOrderMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateSellerOrderCounter) < 1 {
+		if m.CreateSellerOrderMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OrdersRepositoryMock.CreateSellerOrder")
+		} else {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.CreateSellerOrder with params: %#v", *m.CreateSellerOrderMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateSellerOrder != nil && mm_atomic.LoadUint64(&m.afterCreateSellerOrderCounter) < 1 {
+		m.t.Error("Expected call to OrdersRepositoryMock.CreateSellerOrder")
+	}
+}
+
+type mOrdersRepositoryMockCreateShortages struct {
+	mock               *OrdersRepositoryMock
+	defaultExpectation *OrdersRepositoryMockCreateShortagesExpectation
+	expectations       []*OrdersRepositoryMockCreateShortagesExpectation
+
+	callArgs []*OrdersRepositoryMockCreateShortagesParams
+	mutex    sync.RWMutex
+}
+
+// OrdersRepositoryMockCreateShortagesExpectation specifies expectation struct of the OrdersRepository.CreateShortages
+type OrdersRepositoryMockCreateShortagesExpectation struct {
+	mock    *OrdersRepositoryMock
+	params  *OrdersRepositoryMockCreateShortagesParams
+	results *OrdersRepositoryMockCreateShortagesResults
+	Counter uint64
+}
+
+// OrdersRepositoryMockCreateShortagesParams contains parameters of the OrdersRepository.CreateShortages
+type OrdersRepositoryMockCreateShortagesParams struct {
+	ctx       context.Context
+	orderID   int64
+	shortages []ItemShortage
+}
+
+// OrdersRepositoryMockCreateShortagesResults contains results of the OrdersRepository.CreateShortages
+type OrdersRepositoryMockCreateShortagesResults struct {
+	err error
+}
+
+// Expect sets up expected params for OrdersRepository.CreateShortages
+func (mmCreateShortages *mOrdersRepositoryMockCreateShortages) Expect(ctx context.Context, orderID int64, shortages []ItemShortage) *mOrdersRepositoryMockCreateShortages {
+	if mmCreateShortages.mock.funcCreateShortages != nil {
+		mmCreateShortages.mock.t.Fatalf("OrdersRepositoryMock.CreateShortages mock is already set by Set")
+	}
+
+	if mmCreateShortages.defaultExpectation == nil {
+		mmCreateShortages.defaultExpectation = &OrdersRepositoryMockCreateShortagesExpectation{}
+	}
+
+	mmCreateShortages.defaultExpectation.params = &OrdersRepositoryMockCreateShortagesParams{ctx, orderID, shortages}
+	for _, e := range mmCreateShortages.expectations {
+		if minimock.Equal(e.params, mmCreateShortages.defaultExpectation.params) {
+			mmCreateShortages.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateShortages.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateShortages
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrdersRepository.CreateShortages
+func (mmCreateShortages *mOrdersRepositoryMockCreateShortages) Inspect(f func(ctx context.Context, orderID int64, shortages []ItemShortage)) *mOrdersRepositoryMockCreateShortages {
+	if mmCreateShortages.mock.inspectFuncCreateShortages != nil {
+		mmCreateShortages.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.CreateShortages")
+	}
+
+	mmCreateShortages.mock.inspectFuncCreateShortages = f
+
+	return mmCreateShortages
+}
+
+// Return sets up results that will be returned by OrdersRepository.CreateShortages
+func (mmCreateShortages *mOrdersRepositoryMockCreateShortages) Return(err error) *OrdersRepositoryMock {
+	if mmCreateShortages.mock.funcCreateShortages != nil {
+		mmCreateShortages.mock.t.Fatalf("OrdersRepositoryMock.CreateShortages mock is already set by Set")
+	}
+
+	if mmCreateShortages.defaultExpectation == nil {
+		mmCreateShortages.defaultExpectation = &OrdersRepositoryMockCreateShortagesExpectation{mock: mmCreateShortages.mock}
+	}
+	mmCreateShortages.defaultExpectation.results = &OrdersRepositoryMockCreateShortagesResults{err}
+	return mmCreateShortages.mock
+}
+
+// Set uses given function f to mock the OrdersRepository.CreateShortages method
+func (mmCreateShortages *mOrdersRepositoryMockCreateShortages) Set(f func(ctx context.Context, orderID int64, shortages []ItemShortage) (err error)) *OrdersRepositoryMock {
+	if mmCreateShortages.defaultExpectation != nil {
+		mmCreateShortages.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.CreateShortages method")
+	}
+
+	if len(mmCreateShortages.expectations) > 0 {
+		mmCreateShortages.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.CreateShortages method")
+	}
+
+	mmCreateShortages.mock.funcCreateShortages = f
+	return mmCreateShortages.mock
+}
+
+// When sets expectation for the OrdersRepository.CreateShortages which will trigger the result defined by the following
+// Then helper
+func (mmCreateShortages *mOrdersRepositoryMockCreateShortages) When(ctx context.Context, orderID int64, shortages []ItemShortage) *OrdersRepositoryMockCreateShortagesExpectation {
+	if mmCreateShortages.mock.funcCreateShortages != nil {
+		mmCreateShortages.mock.t.Fatalf("OrdersRepositoryMock.CreateShortages mock is already set by Set")
+	}
+
+	expectation := &OrdersRepositoryMockCreateShortagesExpectation{
+		mock:   mmCreateShortages.mock,
+		params: &OrdersRepositoryMockCreateShortagesParams{ctx, orderID, shortages},
+	}
+	mmCreateShortages.expectations = append(mmCreateShortages.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrdersRepository.CreateShortages return parameters for the expectation previously defined by the When method
+func (e *OrdersRepositoryMockCreateShortagesExpectation) Then(err error) *OrdersRepositoryMock {
+	e.results = &OrdersRepositoryMockCreateShortagesResults{err}
+	return e.mock
+}
+
+// CreateShortages implements OrdersRepository
+func (mmCreateShortages *OrdersRepositoryMock) CreateShortages(ctx context.Context, orderID int64, shortages []ItemShortage) (err error) {
+	mm_atomic.AddUint64(&mmCreateShortages.beforeCreateShortagesCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateShortages.afterCreateShortagesCounter, 1)
+
+	if mmCreateShortages.inspectFuncCreateShortages != nil {
+		mmCreateShortages.inspectFuncCreateShortages(ctx, orderID, shortages)
+	}
+
+	mm_params := &OrdersRepositoryMockCreateShortagesParams{ctx, orderID, shortages}
+
+	// Record call args
+	mmCreateShortages.CreateShortagesMock.mutex.Lock()
+	mmCreateShortages.CreateShortagesMock.callArgs = append(mmCreateShortages.CreateShortagesMock.callArgs, mm_params)
+	mmCreateShortages.CreateShortagesMock.mutex.Unlock()
+
+	for _, e := range mmCreateShortages.CreateShortagesMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateShortages.CreateShortagesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateShortages.CreateShortagesMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateShortages.CreateShortagesMock.defaultExpectation.params
+		mm_got := OrdersRepositoryMockCreateShortagesParams{ctx, orderID, shortages}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateShortages.t.Errorf("OrdersRepositoryMock.CreateShortages got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateShortages.CreateShortagesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateShortages.t.Fatal("No results are set for the OrdersRepositoryMock.CreateShortages")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateShortages.funcCreateShortages != nil {
+		return mmCreateShortages.funcCreateShortages(ctx, orderID, shortages)
+	}
+	mmCreateShortages.t.Fatalf("Unexpected call to OrdersRepositoryMock.CreateShortages. %v %v %v", ctx, orderID, shortages)
+	return
+}
+
+// CreateShortagesAfterCounter returns a count of finished OrdersRepositoryMock.CreateShortages invocations
+func (mmCreateShortages *OrdersRepositoryMock) CreateShortagesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateShortages.afterCreateShortagesCounter)
+}
+
+// CreateShortagesBeforeCounter returns a count of OrdersRepositoryMock.CreateShortages invocations
+func (mmCreateShortages *OrdersRepositoryMock) CreateShortagesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateShortages.beforeCreateShortagesCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrdersRepositoryMock.CreateShortages.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateShortages *mOrdersRepositoryMockCreateShortages) Calls() []*OrdersRepositoryMockCreateShortagesParams {
+	mmCreateShortages.mutex.RLock()
+
+	argCopy := make([]*OrdersRepositoryMockCreateShortagesParams, len(mmCreateShortages.callArgs))
+	copy(argCopy, mmCreateShortages.callArgs)
+
+	mmCreateShortages.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateShortagesDone returns true if the count of the CreateShortages invocations corresponds
+// the number of defined expectations
+func (m *OrdersRepositoryMock) MinimockCreateShortagesDone() bool {
+	for _, e := range m.CreateShortagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateShortagesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateShortagesCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateShortages != nil && mm_atomic.LoadUint64(&m.afterCreateShortagesCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockCreateShortagesInspect logs each unmet expectation
+func (m *OrdersRepositoryMock) MinimockCreateShortagesInspect() {
+	for _, e := range m.CreateShortagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.CreateShortages with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateShortagesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateShortagesCounter) < 1 {
+		if m.CreateShortagesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OrdersRepositoryMock.CreateShortages")
+		} else {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.CreateShortages with params: %#v", *m.CreateShortagesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateShortages != nil && mm_atomic.LoadUint64(&m.afterCreateShortagesCounter) < 1 {
+		m.t.Error("Expected call to OrdersRepositoryMock.CreateShortages")
+	}
+}
+
+type mOrdersRepositoryMockDeleteBackorders struct {
+	mock               *OrdersRepositoryMock
+	defaultExpectation *OrdersRepositoryMockDeleteBackordersExpectation
+	expectations       []*OrdersRepositoryMockDeleteBackordersExpectation
+
+	callArgs []*OrdersRepositoryMockDeleteBackordersParams
+	mutex    sync.RWMutex
+}
+
+// OrdersRepositoryMockDeleteBackordersExpectation specifies expectation struct of the OrdersRepository.DeleteBackorders
+type OrdersRepositoryMockDeleteBackordersExpectation struct {
+	mock    *OrdersRepositoryMock
+	params  *OrdersRepositoryMockDeleteBackordersParams
+	results *OrdersRepositoryMockDeleteBackordersResults
+	Counter uint64
+}
+
+// OrdersRepositoryMockDeleteBackordersParams contains parameters of the OrdersRepository.DeleteBackorders
+type OrdersRepositoryMockDeleteBackordersParams struct {
+	ctx     context.Context
+	orderID int64
+}
+
+// OrdersRepositoryMockDeleteBackordersResults contains results of the OrdersRepository.DeleteBackorders
+type OrdersRepositoryMockDeleteBackordersResults struct {
+	err error
+}
+
+// Expect sets up expected params for OrdersRepository.DeleteBackorders
+func (mmDeleteBackorders *mOrdersRepositoryMockDeleteBackorders) Expect(ctx context.Context, orderID int64) *mOrdersRepositoryMockDeleteBackorders {
+	if mmDeleteBackorders.mock.funcDeleteBackorders != nil {
+		mmDeleteBackorders.mock.t.Fatalf("OrdersRepositoryMock.DeleteBackorders mock is already set by Set")
+	}
+
+	if mmDeleteBackorders.defaultExpectation == nil {
+		mmDeleteBackorders.defaultExpectation = &OrdersRepositoryMockDeleteBackordersExpectation{}
+	}
+
+	mmDeleteBackorders.defaultExpectation.params = &OrdersRepositoryMockDeleteBackordersParams{ctx, orderID}
+	for _, e := range mmDeleteBackorders.expectations {
+		if minimock.Equal(e.params, mmDeleteBackorders.defaultExpectation.params) {
+			mmDeleteBackorders.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteBackorders.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteBackorders
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrdersRepository.DeleteBackorders
+func (mmDeleteBackorders *mOrdersRepositoryMockDeleteBackorders) Inspect(f func(ctx context.Context, orderID int64)) *mOrdersRepositoryMockDeleteBackorders {
+	if mmDeleteBackorders.mock.inspectFuncDeleteBackorders != nil {
+		mmDeleteBackorders.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.DeleteBackorders")
+	}
+
+	mmDeleteBackorders.mock.inspectFuncDeleteBackorders = f
+
+	return mmDeleteBackorders
+}
+
+// Return sets up results that will be returned by OrdersRepository.DeleteBackorders
+func (mmDeleteBackorders *mOrdersRepositoryMockDeleteBackorders) Return(err error) *OrdersRepositoryMock {
+	if mmDeleteBackorders.mock.funcDeleteBackorders != nil {
+		mmDeleteBackorders.mock.t.Fatalf("OrdersRepositoryMock.DeleteBackorders mock is already set by Set")
+	}
+
+	if mmDeleteBackorders.defaultExpectation == nil {
+		mmDeleteBackorders.defaultExpectation = &OrdersRepositoryMockDeleteBackordersExpectation{mock: mmDeleteBackorders.mock}
+	}
+	mmDeleteBackorders.defaultExpectation.results = &OrdersRepositoryMockDeleteBackordersResults{err}
+	return mmDeleteBackorders.mock
+}
+
+// Set uses given function f to mock the OrdersRepository.DeleteBackorders method
+func (mmDeleteBackorders *mOrdersRepositoryMockDeleteBackorders) Set(f func(ctx context.Context, orderID int64) (err error)) *OrdersRepositoryMock {
+	if mmDeleteBackorders.defaultExpectation != nil {
+		mmDeleteBackorders.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.DeleteBackorders method")
+	}
+
+	if len(mmDeleteBackorders.expectations) > 0 {
+		mmDeleteBackorders.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.DeleteBackorders method")
+	}
+
+	mmDeleteBackorders.mock.funcDeleteBackorders = f
+	return mmDeleteBackorders.mock
+}
+
+// When sets expectation for the OrdersRepository.DeleteBackorders which will trigger the result defined by the following
+// Then helper
+func (mmDeleteBackorders *mOrdersRepositoryMockDeleteBackorders) When(ctx context.Context, orderID int64) *OrdersRepositoryMockDeleteBackordersExpectation {
+	if mmDeleteBackorders.mock.funcDeleteBackorders != nil {
+		mmDeleteBackorders.mock.t.Fatalf("OrdersRepositoryMock.DeleteBackorders mock is already set by Set")
+	}
+
+	expectation := &OrdersRepositoryMockDeleteBackordersExpectation{
+		mock:   mmDeleteBackorders.mock,
+		params: &OrdersRepositoryMockDeleteBackordersParams{ctx, orderID},
+	}
+	mmDeleteBackorders.expectations = append(mmDeleteBackorders.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrdersRepository.DeleteBackorders return parameters for the expectation previously defined by the When method
+func (e *OrdersRepositoryMockDeleteBackordersExpectation) Then(err error) *OrdersRepositoryMock {
+	e.results = &OrdersRepositoryMockDeleteBackordersResults{err}
+	return e.mock
+}
+
+// DeleteBackorders implements OrdersRepository
+func (mmDeleteBackorders *OrdersRepositoryMock) DeleteBackorders(ctx context.Context, orderID int64) (err error) {
+	mm_atomic.AddUint64(&mmDeleteBackorders.beforeDeleteBackordersCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteBackorders.afterDeleteBackordersCounter, 1)
+
+	if mmDeleteBackorders.inspectFuncDeleteBackorders != nil {
+		mmDeleteBackorders.inspectFuncDeleteBackorders(ctx, orderID)
+	}
+
+	mm_params := &OrdersRepositoryMockDeleteBackordersParams{ctx, orderID}
+
+	// Record call args
+	mmDeleteBackorders.DeleteBackordersMock.mutex.Lock()
+	mmDeleteBackorders.DeleteBackordersMock.callArgs = append(mmDeleteBackorders.DeleteBackordersMock.callArgs, mm_params)
+	mmDeleteBackorders.DeleteBackordersMock.mutex.Unlock()
+
+	for _, e := range mmDeleteBackorders.DeleteBackordersMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteBackorders.DeleteBackordersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteBackorders.DeleteBackordersMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteBackorders.DeleteBackordersMock.defaultExpectation.params
+		mm_got := OrdersRepositoryMockDeleteBackordersParams{ctx, orderID}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteBackorders.t.Errorf("OrdersRepositoryMock.DeleteBackorders got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteBackorders.DeleteBackordersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteBackorders.t.Fatal("No results are set for the OrdersRepositoryMock.DeleteBackorders")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteBackorders.funcDeleteBackorders != nil {
+		return mmDeleteBackorders.funcDeleteBackorders(ctx, orderID)
+	}
+	mmDeleteBackorders.t.Fatalf("Unexpected call to OrdersRepositoryMock.DeleteBackorders. %v %v", ctx, orderID)
+	return
+}
+
+// DeleteBackordersAfterCounter returns a count of finished OrdersRepositoryMock.DeleteBackorders invocations
+func (mmDeleteBackorders *OrdersRepositoryMock) DeleteBackordersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteBackorders.afterDeleteBackordersCounter)
+}
+
+// DeleteBackordersBeforeCounter returns a count of OrdersRepositoryMock.DeleteBackorders invocations
+func (mmDeleteBackorders *OrdersRepositoryMock) DeleteBackordersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteBackorders.beforeDeleteBackordersCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrdersRepositoryMock.DeleteBackorders.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteBackorders *mOrdersRepositoryMockDeleteBackorders) Calls() []*OrdersRepositoryMockDeleteBackordersParams {
+	mmDeleteBackorders.mutex.RLock()
+
+	argCopy := make([]*OrdersRepositoryMockDeleteBackordersParams, len(mmDeleteBackorders.callArgs))
+	copy(argCopy, mmDeleteBackorders.callArgs)
+
+	mmDeleteBackorders.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteBackordersDone returns true if the count of the DeleteBackorders invocations corresponds
+// the number of defined expectations
+func (m *OrdersRepositoryMock) MinimockDeleteBackordersDone() bool {
+	for _, e := range m.DeleteBackordersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteBackordersMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeleteBackordersCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteBackorders != nil && mm_atomic.LoadUint64(&m.afterDeleteBackordersCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockDeleteBackordersInspect logs each unmet expectation
+func (m *OrdersRepositoryMock) MinimockDeleteBackordersInspect() {
+	for _, e := range m.DeleteBackordersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.DeleteBackorders with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteBackordersMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeleteBackordersCounter) < 1 {
+		if m.DeleteBackordersMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OrdersRepositoryMock.DeleteBackorders")
+		} else {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.DeleteBackorders with params: %#v", *m.DeleteBackordersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteBackorders != nil && mm_atomic.LoadUint64(&m.afterDeleteBackordersCounter) < 1 {
+		m.t.Error("Expected call to OrdersRepositoryMock.DeleteBackorders")
+	}
+}
+
+type mOrdersRepositoryMockDeleteSellerOrders struct {
+	mock               *OrdersRepositoryMock
+	defaultExpectation *OrdersRepositoryMockDeleteSellerOrdersExpectation
+	expectations       []*OrdersRepositoryMockDeleteSellerOrdersExpectation
+
+	callArgs []*OrdersRepositoryMockDeleteSellerOrdersParams
+	mutex    sync.RWMutex
+}
+
+// OrdersRepositoryMockDeleteSellerOrdersExpectation specifies expectation struct of the OrdersRepository.DeleteSellerOrders
+type OrdersRepositoryMockDeleteSellerOrdersExpectation struct {
+	mock    *OrdersRepositoryMock
+	params  *OrdersRepositoryMockDeleteSellerOrdersParams
+	results *OrdersRepositoryMockDeleteSellerOrdersResults
+	Counter uint64
+}
+
+// OrdersRepositoryMockDeleteSellerOrdersParams contains parameters of the OrdersRepository.DeleteSellerOrders
+type OrdersRepositoryMockDeleteSellerOrdersParams struct {
+	ctx     context.Context
+	orderID int64
+}
+
+// OrdersRepositoryMockDeleteSellerOrdersResults contains results of the OrdersRepository.DeleteSellerOrders
+type OrdersRepositoryMockDeleteSellerOrdersResults struct {
+	err error
+}
+
+// Expect sets up expected params for OrdersRepository.DeleteSellerOrders
+func (mmDeleteSellerOrders *mOrdersRepositoryMockDeleteSellerOrders) Expect(ctx context.Context, orderID int64) *mOrdersRepositoryMockDeleteSellerOrders {
+	if mmDeleteSellerOrders.mock.funcDeleteSellerOrders != nil {
+		mmDeleteSellerOrders.mock.t.Fatalf("OrdersRepositoryMock.DeleteSellerOrders mock is already set by Set")
+	}
+
+	if mmDeleteSellerOrders.defaultExpectation == nil {
+		mmDeleteSellerOrders.defaultExpectation = &OrdersRepositoryMockDeleteSellerOrdersExpectation{}
+	}
+
+	mmDeleteSellerOrders.defaultExpectation.params = &OrdersRepositoryMockDeleteSellerOrdersParams{ctx, orderID}
+	for _, e := range mmDeleteSellerOrders.expectations {
+		if minimock.Equal(e.params, mmDeleteSellerOrders.defaultExpectation.params) {
+			mmDeleteSellerOrders.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteSellerOrders.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteSellerOrders
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrdersRepository.DeleteSellerOrders
+func (mmDeleteSellerOrders *mOrdersRepositoryMockDeleteSellerOrders) Inspect(f func(ctx context.Context, orderID int64)) *mOrdersRepositoryMockDeleteSellerOrders {
+	if mmDeleteSellerOrders.mock.inspectFuncDeleteSellerOrders != nil {
+		mmDeleteSellerOrders.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.DeleteSellerOrders")
+	}
+
+	mmDeleteSellerOrders.mock.inspectFuncDeleteSellerOrders = f
+
+	return mmDeleteSellerOrders
+}
+
+// Return sets up results that will be returned by OrdersRepository.DeleteSellerOrders
+func (mmDeleteSellerOrders *mOrdersRepositoryMockDeleteSellerOrders) Return(err error) *OrdersRepositoryMock {
+	if mmDeleteSellerOrders.mock.funcDeleteSellerOrders != nil {
+		mmDeleteSellerOrders.mock.t.Fatalf("OrdersRepositoryMock.DeleteSellerOrders mock is already set by Set")
+	}
+
+	if mmDeleteSellerOrders.defaultExpectation == nil {
+		mmDeleteSellerOrders.defaultExpectation = &OrdersRepositoryMockDeleteSellerOrdersExpectation{mock: mmDeleteSellerOrders.mock}
+	}
+	mmDeleteSellerOrders.defaultExpectation.results = &OrdersRepositoryMockDeleteSellerOrdersResults{err}
+	return mmDeleteSellerOrders.mock
+}
+
+// Set uses given function f to mock the OrdersRepository.DeleteSellerOrders method
+func (mmDeleteSellerOrders *mOrdersRepositoryMockDeleteSellerOrders) Set(f func(ctx context.Context, orderID int64) (err error)) *OrdersRepositoryMock {
+	if mmDeleteSellerOrders.defaultExpectation != nil {
+		mmDeleteSellerOrders.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.DeleteSellerOrders method")
+	}
+
+	if len(mmDeleteSellerOrders.expectations) > 0 {
+		mmDeleteSellerOrders.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.DeleteSellerOrders method")
+	}
+
+	mmDeleteSellerOrders.mock.funcDeleteSellerOrders = f
+	return mmDeleteSellerOrders.mock
+}
+
+// When sets expectation for the OrdersRepository.DeleteSellerOrders which will trigger the result defined by the following
+// Then helper
+func (mmDeleteSellerOrders *mOrdersRepositoryMockDeleteSellerOrders) When(ctx context.Context, orderID int64) *OrdersRepositoryMockDeleteSellerOrdersExpectation {
+	if mmDeleteSellerOrders.mock.funcDeleteSellerOrders != nil {
+		mmDeleteSellerOrders.mock.t.Fatalf("OrdersRepositoryMock.DeleteSellerOrders mock is already set by Set")
+	}
+
+	expectation := &OrdersRepositoryMockDeleteSellerOrdersExpectation{
+		mock:   mmDeleteSellerOrders.mock,
+		params: &OrdersRepositoryMockDeleteSellerOrdersParams{ctx, orderID},
+	}
+	mmDeleteSellerOrders.expectations = append(mmDeleteSellerOrders.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrdersRepository.DeleteSellerOrders return parameters for the expectation previously defined by the When method
+func (e *OrdersRepositoryMockDeleteSellerOrdersExpectation) Then(err error) *OrdersRepositoryMock {
+	e.results = &OrdersRepositoryMockDeleteSellerOrdersResults{err}
+	return e.mock
+}
+
+// DeleteSellerOrders implements OrdersRepository
+func (mmDeleteSellerOrders *OrdersRepositoryMock) DeleteSellerOrders(ctx context.Context, orderID int64) (err error) {
+	mm_atomic.AddUint64(&mmDeleteSellerOrders.beforeDeleteSellerOrdersCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteSellerOrders.afterDeleteSellerOrdersCounter, 1)
+
+	if mmDeleteSellerOrders.inspectFuncDeleteSellerOrders != nil {
+		mmDeleteSellerOrders.inspectFuncDeleteSellerOrders(ctx, orderID)
+	}
+
+	mm_params := &OrdersRepositoryMockDeleteSellerOrdersParams{ctx, orderID}
+
+	// Record call args
+	mmDeleteSellerOrders.DeleteSellerOrdersMock.mutex.Lock()
+	mmDeleteSellerOrders.DeleteSellerOrdersMock.callArgs = append(mmDeleteSellerOrders.DeleteSellerOrdersMock.callArgs, mm_params)
+	mmDeleteSellerOrders.DeleteSellerOrdersMock.mutex.Unlock()
+
+	for _, e := range mmDeleteSellerOrders.DeleteSellerOrdersMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteSellerOrders.DeleteSellerOrdersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteSellerOrders.DeleteSellerOrdersMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteSellerOrders.DeleteSellerOrdersMock.defaultExpectation.params
+		mm_got := OrdersRepositoryMockDeleteSellerOrdersParams{ctx, orderID}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteSellerOrders.t.Errorf("OrdersRepositoryMock.DeleteSellerOrders got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteSellerOrders.DeleteSellerOrdersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteSellerOrders.t.Fatal("No results are set for the OrdersRepositoryMock.DeleteSellerOrders")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteSellerOrders.funcDeleteSellerOrders != nil {
+		return mmDeleteSellerOrders.funcDeleteSellerOrders(ctx, orderID)
+	}
+	mmDeleteSellerOrders.t.Fatalf("Unexpected call to OrdersRepositoryMock.DeleteSellerOrders. %v %v", ctx, orderID)
+	return
+}
+
+// DeleteSellerOrdersAfterCounter returns a count of finished OrdersRepositoryMock.DeleteSellerOrders invocations
+func (mmDeleteSellerOrders *OrdersRepositoryMock) DeleteSellerOrdersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteSellerOrders.afterDeleteSellerOrdersCounter)
+}
+
+// DeleteSellerOrdersBeforeCounter returns a count of OrdersRepositoryMock.DeleteSellerOrders invocations
+func (mmDeleteSellerOrders *OrdersRepositoryMock) DeleteSellerOrdersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteSellerOrders.beforeDeleteSellerOrdersCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrdersRepositoryMock.DeleteSellerOrders.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteSellerOrders *mOrdersRepositoryMockDeleteSellerOrders) Calls() []*OrdersRepositoryMockDeleteSellerOrdersParams {
+	mmDeleteSellerOrders.mutex.RLock()
+
+	argCopy := make([]*OrdersRepositoryMockDeleteSellerOrdersParams, len(mmDeleteSellerOrders.callArgs))
+	copy(argCopy, mmDeleteSellerOrders.callArgs)
+
+	mmDeleteSellerOrders.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteSellerOrdersDone returns true if the count of the DeleteSellerOrders invocations corresponds
+// the number of defined expectations
+func (m *OrdersRepositoryMock) MinimockDeleteSellerOrdersDone() bool {
+	for _, e := range m.DeleteSellerOrdersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteSellerOrdersMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeleteSellerOrdersCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteSellerOrders != nil && mm_atomic.LoadUint64(&m.afterDeleteSellerOrdersCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockDeleteSellerOrdersInspect logs each unmet expectation
+func (m *OrdersRepositoryMock) MinimockDeleteSellerOrdersInspect() {
+	for _, e := range m.DeleteSellerOrdersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.DeleteSellerOrders with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteSellerOrdersMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDeleteSellerOrdersCounter) < 1 {
+		if m.DeleteSellerOrdersMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OrdersRepositoryMock.DeleteSellerOrders")
+		} else {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.DeleteSellerOrders with params: %#v", *m.DeleteSellerOrdersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteSellerOrders != nil && mm_atomic.LoadUint64(&m.afterDeleteSellerOrdersCounter) < 1 {
+		m.t.Error("Expected call to OrdersRepositoryMock.DeleteSellerOrders")
+	}
+}
+
+type mOrdersRepositoryMockGetBackorders struct {
+	mock               *OrdersRepositoryMock
+	defaultExpectation *OrdersRepositoryMockGetBackordersExpectation
+	expectations       []*OrdersRepositoryMockGetBackordersExpectation
+
+	callArgs []*OrdersRepositoryMockGetBackordersParams
+	mutex    sync.RWMutex
+}
+
+// OrdersRepositoryMockGetBackordersExpectation specifies expectation struct of the OrdersRepository.GetBackorders
+type OrdersRepositoryMockGetBackordersExpectation struct {
+	mock    *OrdersRepositoryMock
+	params  *OrdersRepositoryMockGetBackordersParams
+	results *OrdersRepositoryMockGetBackordersResults
+	Counter uint64
+}
+
+// OrdersRepositoryMockGetBackordersParams contains parameters of the OrdersRepository.GetBackorders
+type OrdersRepositoryMockGetBackordersParams struct {
+	ctx     context.Context
+	orderID int64
+}
+
+// OrdersRepositoryMockGetBackordersResults contains results of the OrdersRepository.GetBackorders
+type OrdersRepositoryMockGetBackordersResults struct {
+	oa1 []OrderItem
+	err error
+}
+
+// Expect sets up expected params for OrdersRepository.GetBackorders
+func (mmGetBackorders *mOrdersRepositoryMockGetBackorders) Expect(ctx context.Context, orderID int64) *mOrdersRepositoryMockGetBackorders {
+	if mmGetBackorders.mock.funcGetBackorders != nil {
+		mmGetBackorders.mock.t.Fatalf("OrdersRepositoryMock.GetBackorders mock is already set by Set")
+	}
+
+	if mmGetBackorders.defaultExpectation == nil {
+		mmGetBackorders.defaultExpectation = &OrdersRepositoryMockGetBackordersExpectation{}
+	}
+
+	mmGetBackorders.defaultExpectation.params = &OrdersRepositoryMockGetBackordersParams{ctx, orderID}
+	for _, e := range mmGetBackorders.expectations {
+		if minimock.Equal(e.params, mmGetBackorders.defaultExpectation.params) {
+			mmGetBackorders.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetBackorders.defaultExpectation.params)
+		}
+	}
+
+	return mmGetBackorders
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrdersRepository.GetBackorders
+func (mmGetBackorders *mOrdersRepositoryMockGetBackorders) Inspect(f func(ctx context.Context, orderID int64)) *mOrdersRepositoryMockGetBackorders {
+	if mmGetBackorders.mock.inspectFuncGetBackorders != nil {
+		mmGetBackorders.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.GetBackorders")
+	}
+
+	mmGetBackorders.mock.inspectFuncGetBackorders = f
+
+	return mmGetBackorders
+}
+
+// Return sets up results that will be returned by OrdersRepository.GetBackorders
+func (mmGetBackorders *mOrdersRepositoryMockGetBackorders) Return(oa1 []OrderItem, err error) *OrdersRepositoryMock {
+	if mmGetBackorders.mock.funcGetBackorders != nil {
+		mmGetBackorders.mock.t.Fatalf("OrdersRepositoryMock.GetBackorders mock is already set by Set")
+	}
+
+	if mmGetBackorders.defaultExpectation == nil {
+		mmGetBackorders.defaultExpectation = &OrdersRepositoryMockGetBackordersExpectation{mock: mmGetBackorders.mock}
+	}
+	mmGetBackorders.defaultExpectation.results = &OrdersRepositoryMockGetBackordersResults{oa1, err}
+	return mmGetBackorders.mock
+}
+
+// Set uses given function f to mock the OrdersRepository.GetBackorders method
+func (mmGetBackorders *mOrdersRepositoryMockGetBackorders) Set(f func(ctx context.Context, orderID int64) (oa1 []OrderItem, err error)) *OrdersRepositoryMock {
+	if mmGetBackorders.defaultExpectation != nil {
+		mmGetBackorders.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.GetBackorders method")
+	}
+
+	if len(mmGetBackorders.expectations) > 0 {
+		mmGetBackorders.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.GetBackorders method")
+	}
+
+	mmGetBackorders.mock.funcGetBackorders = f
+	return mmGetBackorders.mock
+}
+
+// When sets expectation for the OrdersRepository.GetBackorders which will trigger the result defined by the following
+// Then helper
+func (mmGetBackorders *mOrdersRepositoryMockGetBackorders) When(ctx context.Context, orderID int64) *OrdersRepositoryMockGetBackordersExpectation {
+	if mmGetBackorders.mock.funcGetBackorders != nil {
+		mmGetBackorders.mock.t.Fatalf("OrdersRepositoryMock.GetBackorders mock is already set by Set")
+	}
+
+	expectation := &OrdersRepositoryMockGetBackordersExpectation{
+		mock:   mmGetBackorders.mock,
+		params: &OrdersRepositoryMockGetBackordersParams{ctx, orderID},
+	}
+	mmGetBackorders.expectations = append(mmGetBackorders.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrdersRepository.GetBackorders return parameters for the expectation previously defined by the When method
+func (e *OrdersRepositoryMockGetBackordersExpectation) Then(oa1 []OrderItem, err error) *OrdersRepositoryMock {
+	e.results = &OrdersRepositoryMockGetBackordersResults{oa1, err}
+	return e.mock
+}
+
+// GetBackorders implements OrdersRepository
+func (mmGetBackorders *OrdersRepositoryMock) GetBackorders(ctx context.Context, orderID int64) (oa1 []OrderItem, err error) {
+	mm_atomic.AddUint64(&mmGetBackorders.beforeGetBackordersCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetBackorders.afterGetBackordersCounter, 1)
+
+	if mmGetBackorders.inspectFuncGetBackorders != nil {
+		mmGetBackorders.inspectFuncGetBackorders(ctx, orderID)
+	}
+
+	mm_params := &OrdersRepositoryMockGetBackordersParams{ctx, orderID}
+
+	// Record call args
+	mmGetBackorders.GetBackordersMock.mutex.Lock()
+	mmGetBackorders.GetBackordersMock.callArgs = append(mmGetBackorders.GetBackordersMock.callArgs, mm_params)
+	mmGetBackorders.GetBackordersMock.mutex.Unlock()
+
+	for _, e := range mmGetBackorders.GetBackordersMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.oa1, e.results.err
+		}
+	}
+
+	if mmGetBackorders.GetBackordersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetBackorders.GetBackordersMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetBackorders.GetBackordersMock.defaultExpectation.params
+		mm_got := OrdersRepositoryMockGetBackordersParams{ctx, orderID}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetBackorders.t.Errorf("OrdersRepositoryMock.GetBackorders got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetBackorders.GetBackordersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetBackorders.t.Fatal("No results are set for the OrdersRepositoryMock.GetBackorders")
+		}
+		return (*mm_results).oa1, (*mm_results).err
+	}
+	if mmGetBackorders.funcGetBackorders != nil {
+		return mmGetBackorders.funcGetBackorders(ctx, orderID)
+	}
+	mmGetBackorders.t.Fatalf("Unexpected call to OrdersRepositoryMock.GetBackorders. %v %v", ctx, orderID)
+	return
+}
+
+// GetBackordersAfterCounter returns a count of finished OrdersRepositoryMock.GetBackorders invocations
+func (mmGetBackorders *OrdersRepositoryMock) GetBackordersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetBackorders.afterGetBackordersCounter)
+}
+
+// GetBackordersBeforeCounter returns a count of OrdersRepositoryMock.GetBackorders invocations
+func (mmGetBackorders *OrdersRepositoryMock) GetBackordersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetBackorders.beforeGetBackordersCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrdersRepositoryMock.GetBackorders.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetBackorders *mOrdersRepositoryMockGetBackorders) Calls() []*OrdersRepositoryMockGetBackordersParams {
+	mmGetBackorders.mutex.RLock()
+
+	argCopy := make([]*OrdersRepositoryMockGetBackordersParams, len(mmGetBackorders.callArgs))
+	copy(argCopy, mmGetBackorders.callArgs)
+
+	mmGetBackorders.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetBackordersDone returns true if the count of the GetBackorders invocations corresponds
+// the number of defined expectations
+func (m *OrdersRepositoryMock) MinimockGetBackordersDone() bool {
+	for _, e := range m.GetBackordersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetBackordersMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetBackordersCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetBackorders != nil && mm_atomic.LoadUint64(&m.afterGetBackordersCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetBackordersInspect logs each unmet expectation
+func (m *OrdersRepositoryMock) MinimockGetBackordersInspect() {
+	for _, e := range m.GetBackordersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.GetBackorders with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetBackordersMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetBackordersCounter) < 1 {
+		if m.GetBackordersMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OrdersRepositoryMock.GetBackorders")
+		} else {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.GetBackorders with params: %#v", *m.GetBackordersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetBackorders != nil && mm_atomic.LoadUint64(&m.afterGetBackordersCounter) < 1 {
+		m.t.Error("Expected call to OrdersRepositoryMock.GetBackorders")
+	}
+}
+
+type mOrdersRepositoryMockGetExpiredOrders struct {
+	mock               *OrdersRepositoryMock
+	defaultExpectation *OrdersRepositoryMockGetExpiredOrdersExpectation
+	expectations       []*OrdersRepositoryMockGetExpiredOrdersExpectation
+
+	callArgs []*OrdersRepositoryMockGetExpiredOrdersParams
+	mutex    sync.RWMutex
+}
+
+// OrdersRepositoryMockGetExpiredOrdersExpectation specifies expectation struct of the OrdersRepository.GetExpiredOrders
+type OrdersRepositoryMockGetExpiredOrdersExpectation struct {
+	mock    *OrdersRepositoryMock
+	params  *OrdersRepositoryMockGetExpiredOrdersParams
+	results *OrdersRepositoryMockGetExpiredOrdersResults
+	Counter uint64
+}
+
+// OrdersRepositoryMockGetExpiredOrdersParams contains parameters of the OrdersRepository.GetExpiredOrders
+type OrdersRepositoryMockGetExpiredOrdersParams struct {
+	ctx    context.Context
+	status OrderStatus
+	now    time.Time
+	limit  uint64
+}
+
+// OrdersRepositoryMockGetExpiredOrdersResults contains results of the OrdersRepository.GetExpiredOrders
+type OrdersRepositoryMockGetExpiredOrdersResults struct {
+	ia1 []int64
+	err error
+}
+
+// Expect sets up expected params for OrdersRepository.GetExpiredOrders
+func (mmGetExpiredOrders *mOrdersRepositoryMockGetExpiredOrders) Expect(ctx context.Context, status OrderStatus, now time.Time, limit uint64) *mOrdersRepositoryMockGetExpiredOrders {
+	if mmGetExpiredOrders.mock.funcGetExpiredOrders != nil {
+		mmGetExpiredOrders.mock.t.Fatalf("OrdersRepositoryMock.GetExpiredOrders mock is already set by Set")
+	}
+
+	if mmGetExpiredOrders.defaultExpectation == nil {
+		mmGetExpiredOrders.defaultExpectation = &OrdersRepositoryMockGetExpiredOrdersExpectation{}
+	}
+
+	mmGetExpiredOrders.defaultExpectation.params = &OrdersRepositoryMockGetExpiredOrdersParams{ctx, status, now, limit}
+	for _, e := range mmGetExpiredOrders.expectations {
+		if minimock.Equal(e.params, mmGetExpiredOrders.defaultExpectation.params) {
+			mmGetExpiredOrders.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetExpiredOrders.defaultExpectation.params)
+		}
+	}
+
+	return mmGetExpiredOrders
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrdersRepository.GetExpiredOrders
+func (mmGetExpiredOrders *mOrdersRepositoryMockGetExpiredOrders) Inspect(f func(ctx context.Context, status OrderStatus, now time.Time, limit uint64)) *mOrdersRepositoryMockGetExpiredOrders {
+	if mmGetExpiredOrders.mock.inspectFuncGetExpiredOrders != nil {
+		mmGetExpiredOrders.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.GetExpiredOrders")
+	}
+
+	mmGetExpiredOrders.mock.inspectFuncGetExpiredOrders = f
+
+	return mmGetExpiredOrders
+}
+
+// Return sets up results that will be returned by OrdersRepository.GetExpiredOrders
+func (mmGetExpiredOrders *mOrdersRepositoryMockGetExpiredOrders) Return(ia1 []int64, err error) *OrdersRepositoryMock {
+	if mmGetExpiredOrders.mock.funcGetExpiredOrders != nil {
+		mmGetExpiredOrders.mock.t.Fatalf("OrdersRepositoryMock.GetExpiredOrders mock is already set by Set")
+	}
+
+	if mmGetExpiredOrders.defaultExpectation == nil {
+		mmGetExpiredOrders.defaultExpectation = &OrdersRepositoryMockGetExpiredOrdersExpectation{mock: mmGetExpiredOrders.mock}
+	}
+	mmGetExpiredOrders.defaultExpectation.results = &OrdersRepositoryMockGetExpiredOrdersResults{ia1, err}
+	return mmGetExpiredOrders.mock
+}
+
+// Set uses given function f to mock the OrdersRepository.GetExpiredOrders method
+func (mmGetExpiredOrders *mOrdersRepositoryMockGetExpiredOrders) Set(f func(ctx context.Context, status OrderStatus, now time.Time, limit uint64) (ia1 []int64, err error)) *OrdersRepositoryMock {
+	if mmGetExpiredOrders.defaultExpectation != nil {
+		mmGetExpiredOrders.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.GetExpiredOrders method")
+	}
+
+	if len(mmGetExpiredOrders.expectations) > 0 {
+		mmGetExpiredOrders.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.GetExpiredOrders method")
+	}
+
+	mmGetExpiredOrders.mock.funcGetExpiredOrders = f
+	return mmGetExpiredOrders.mock
+}
+
+// When sets expectation for the OrdersRepository.GetExpiredOrders which will trigger the result defined by the following
+// Then helper
+func (mmGetExpiredOrders *mOrdersRepositoryMockGetExpiredOrders) When(ctx context.Context, status OrderStatus, now time.Time, limit uint64) *OrdersRepositoryMockGetExpiredOrdersExpectation {
+	if mmGetExpiredOrders.mock.funcGetExpiredOrders != nil {
+		mmGetExpiredOrders.mock.t.Fatalf("OrdersRepositoryMock.GetExpiredOrders mock is already set by Set")
+	}
+
+	expectation := &OrdersRepositoryMockGetExpiredOrdersExpectation{
+		mock:   mmGetExpiredOrders.mock,
+		params: &OrdersRepositoryMockGetExpiredOrdersParams{ctx, status, now, limit},
+	}
+	mmGetExpiredOrders.expectations = append(mmGetExpiredOrders.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrdersRepository.GetExpiredOrders return parameters for the expectation previously defined by the When method
+func (e *OrdersRepositoryMockGetExpiredOrdersExpectation) Then(ia1 []int64, err error) *OrdersRepositoryMock {
+	e.results = &OrdersRepositoryMockGetExpiredOrdersResults{ia1, err}
+	return e.mock
+}
+
+// GetExpiredOrders implements OrdersRepository
+func (mmGetExpiredOrders *OrdersRepositoryMock) GetExpiredOrders(ctx context.Context, status OrderStatus, now time.Time, limit uint64) (ia1 []int64, err error) {
+	mm_atomic.AddUint64(&mmGetExpiredOrders.beforeGetExpiredOrdersCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetExpiredOrders.afterGetExpiredOrdersCounter, 1)
+
+	if mmGetExpiredOrders.inspectFuncGetExpiredOrders != nil {
+		mmGetExpiredOrders.inspectFuncGetExpiredOrders(ctx, status, now, limit)
+	}
+
+	mm_params := &OrdersRepositoryMockGetExpiredOrdersParams{ctx, status, now, limit}
+
+	// Record call args
+	mmGetExpiredOrders.GetExpiredOrdersMock.mutex.Lock()
+	mmGetExpiredOrders.GetExpiredOrdersMock.callArgs = append(mmGetExpiredOrders.GetExpiredOrdersMock.callArgs, mm_params)
+	mmGetExpiredOrders.GetExpiredOrdersMock.mutex.Unlock()
+
+	for _, e := range mmGetExpiredOrders.GetExpiredOrdersMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ia1, e.results.err
+		}
+	}
+
+	if mmGetExpiredOrders.GetExpiredOrdersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetExpiredOrders.GetExpiredOrdersMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetExpiredOrders.GetExpiredOrdersMock.defaultExpectation.params
+		mm_got := OrdersRepositoryMockGetExpiredOrdersParams{ctx, status, now, limit}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetExpiredOrders.t.Errorf("OrdersRepositoryMock.GetExpiredOrders got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetExpiredOrders.GetExpiredOrdersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetExpiredOrders.t.Fatal("No results are set for the OrdersRepositoryMock.GetExpiredOrders")
+		}
+		return (*mm_results).ia1, (*mm_results).err
+	}
+	if mmGetExpiredOrders.funcGetExpiredOrders != nil {
+		return mmGetExpiredOrders.funcGetExpiredOrders(ctx, status, now, limit)
+	}
+	mmGetExpiredOrders.t.Fatalf("Unexpected call to OrdersRepositoryMock.GetExpiredOrders. %v %v %v %v", ctx, status, now, limit)
+	return
+}
+
+// GetExpiredOrdersAfterCounter returns a count of finished OrdersRepositoryMock.GetExpiredOrders invocations
+func (mmGetExpiredOrders *OrdersRepositoryMock) GetExpiredOrdersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetExpiredOrders.afterGetExpiredOrdersCounter)
+}
+
+// GetExpiredOrdersBeforeCounter returns a count of OrdersRepositoryMock.GetExpiredOrders invocations
+func (mmGetExpiredOrders *OrdersRepositoryMock) GetExpiredOrdersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetExpiredOrders.beforeGetExpiredOrdersCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrdersRepositoryMock.GetExpiredOrders.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetExpiredOrders *mOrdersRepositoryMockGetExpiredOrders) Calls() []*OrdersRepositoryMockGetExpiredOrdersParams {
+	mmGetExpiredOrders.mutex.RLock()
+
+	argCopy := make([]*OrdersRepositoryMockGetExpiredOrdersParams, len(mmGetExpiredOrders.callArgs))
+	copy(argCopy, mmGetExpiredOrders.callArgs)
+
+	mmGetExpiredOrders.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetExpiredOrdersDone returns true if the count of the GetExpiredOrders invocations corresponds
+// the number of defined expectations
+func (m *OrdersRepositoryMock) MinimockGetExpiredOrdersDone() bool {
+	for _, e := range m.GetExpiredOrdersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetExpiredOrdersMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetExpiredOrdersCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetExpiredOrders != nil && mm_atomic.LoadUint64(&m.afterGetExpiredOrdersCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetExpiredOrdersInspect logs each unmet expectation
+func (m *OrdersRepositoryMock) MinimockGetExpiredOrdersInspect() {
+	for _, e := range m.GetExpiredOrdersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.GetExpiredOrders with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetExpiredOrdersMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetExpiredOrdersCounter) < 1 {
+		if m.GetExpiredOrdersMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OrdersRepositoryMock.GetExpiredOrders")
+		} else {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.GetExpiredOrders with params: %#v", *m.GetExpiredOrdersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetExpiredOrders != nil && mm_atomic.LoadUint64(&m.afterGetExpiredOrdersCounter) < 1 {
+		m.t.Error("Expected call to OrdersRepositoryMock.GetExpiredOrders")
+	}
+}
+
+type mOrdersRepositoryMockGetOrder struct {
+	mock               *OrdersRepositoryMock
+	defaultExpectation *OrdersRepositoryMockGetOrderExpectation
+	expectations       []*OrdersRepositoryMockGetOrderExpectation
+
+	callArgs []*OrdersRepositoryMockGetOrderParams
+	mutex    sync.RWMutex
+}
+
+// OrdersRepositoryMockGetOrderExpectation specifies expectation struct of the OrdersRepository.GetOrder
+type OrdersRepositoryMockGetOrderExpectation struct {
+	mock    *OrdersRepositoryMock
+	params  *OrdersRepositoryMockGetOrderParams
+	results *OrdersRepositoryMockGetOrderResults
+	Counter uint64
+}
+
+// OrdersRepositoryMockGetOrderParams contains parameters of the OrdersRepository.GetOrder
+type OrdersRepositoryMockGetOrderParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// OrdersRepositoryMockGetOrderResults contains results of the OrdersRepository.GetOrder
+type OrdersRepositoryMockGetOrderResults struct {
+	op1 *Order
+	err error
+}
+
+// Expect sets up expected params for OrdersRepository.GetOrder
+func (mmGetOrder *mOrdersRepositoryMockGetOrder) Expect(ctx context.Context, id int64) *mOrdersRepositoryMockGetOrder {
+	if mmGetOrder.mock.funcGetOrder != nil {
+		mmGetOrder.mock.t.Fatalf("OrdersRepositoryMock.GetOrder mock is already set by Set")
+	}
+
+	if mmGetOrder.defaultExpectation == nil {
+		mmGetOrder.defaultExpectation = &OrdersRepositoryMockGetOrderExpectation{}
+	}
+
+	mmGetOrder.defaultExpectation.params = &OrdersRepositoryMockGetOrderParams{ctx, id}
+	for _, e := range mmGetOrder.expectations {
+		if minimock.Equal(e.params, mmGetOrder.defaultExpectation.params) {
+			mmGetOrder.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetOrder.defaultExpectation.params)
+		}
+	}
+
+	return mmGetOrder
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrdersRepository.GetOrder
+func (mmGetOrder *mOrdersRepositoryMockGetOrder) Inspect(f func(ctx context.Context, id int64)) *mOrdersRepositoryMockGetOrder {
+	if mmGetOrder.mock.inspectFuncGetOrder != nil {
+		mmGetOrder.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.GetOrder")
+	}
+
+	mmGetOrder.mock.inspectFuncGetOrder = f
+
+	return mmGetOrder
+}
+
+// Return sets up results that will be returned by OrdersRepository.GetOrder
+func (mmGetOrder *mOrdersRepositoryMockGetOrder) Return(op1 *Order, err error) *OrdersRepositoryMock {
+	if mmGetOrder.mock.funcGetOrder != nil {
+		mmGetOrder.mock.t.Fatalf("OrdersRepositoryMock.GetOrder mock is already set by Set")
+	}
+
+	if mmGetOrder.defaultExpectation == nil {
+		mmGetOrder.defaultExpectation = &OrdersRepositoryMockGetOrderExpectation{mock: mmGetOrder.mock}
+	}
+	mmGetOrder.defaultExpectation.results = &OrdersRepositoryMockGetOrderResults{op1, err}
+	return mmGetOrder.mock
+}
+
+// Set uses given function f to mock the OrdersRepository.GetOrder method
+func (mmGetOrder *mOrdersRepositoryMockGetOrder) Set(f func(ctx context.Context, id int64) (op1 *Order, err error)) *OrdersRepositoryMock {
+	if mmGetOrder.defaultExpectation != nil {
+		mmGetOrder.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.GetOrder method")
+	}
+
+	if len(mmGetOrder.expectations) > 0 {
+		mmGetOrder.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.GetOrder method")
+	}
+
+	mmGetOrder.mock.funcGetOrder = f
+	return mmGetOrder.mock
+}
+
+// When sets expectation for the OrdersRepository.GetOrder which will trigger the result defined by the following
+// Then helper
+func (mmGetOrder *mOrdersRepositoryMockGetOrder) When(ctx context.Context, id int64) *OrdersRepositoryMockGetOrderExpectation {
+	if mmGetOrder.mock.funcGetOrder != nil {
+		mmGetOrder.mock.t.Fatalf("OrdersRepositoryMock.GetOrder mock is already set by Set")
+	}
+
+	expectation := &OrdersRepositoryMockGetOrderExpectation{
+		mock:   mmGetOrder.mock,
+		params: &OrdersRepositoryMockGetOrderParams{ctx, id},
+	}
+	mmGetOrder.expectations = append(mmGetOrder.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrdersRepository.GetOrder return parameters for the expectation previously defined by the When method
+func (e *OrdersRepositoryMockGetOrderExpectation) Then(op1 *Order, err error) *OrdersRepositoryMock {
+	e.results = &OrdersRepositoryMockGetOrderResults{op1, err}
+	return e.mock
+}
+
+// GetOrder implements OrdersRepository
+func (mmGetOrder *OrdersRepositoryMock) GetOrder(ctx context.Context, id int64) (op1 *Order, err error) {
+	mm_atomic.AddUint64(&mmGetOrder.beforeGetOrderCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetOrder.afterGetOrderCounter, 1)
+
+	if mmGetOrder.inspectFuncGetOrder != nil {
+		mmGetOrder.inspectFuncGetOrder(ctx, id)
+	}
+
+	mm_params := &OrdersRepositoryMockGetOrderParams{ctx, id}
+
+	// Record call args
+	mmGetOrder.GetOrderMock.mutex.Lock()
+	mmGetOrder.GetOrderMock.callArgs = append(mmGetOrder.GetOrderMock.callArgs, mm_params)
+	mmGetOrder.GetOrderMock.mutex.Unlock()
+
+	for _, e := range mmGetOrder.GetOrderMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.op1, e.results.err
+		}
+	}
+
+	if mmGetOrder.GetOrderMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetOrder.GetOrderMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetOrder.GetOrderMock.defaultExpectation.params
+		mm_got := OrdersRepositoryMockGetOrderParams{ctx, id}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetOrder.t.Errorf("OrdersRepositoryMock.GetOrder got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetOrder.GetOrderMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetOrder.t.Fatal("No results are set for the OrdersRepositoryMock.GetOrder")
+		}
+		return (*mm_results).op1, (*mm_results).err
+	}
+	if mmGetOrder.funcGetOrder != nil {
+		return mmGetOrder.funcGetOrder(ctx, id)
+	}
+	mmGetOrder.t.Fatalf("Unexpected call to OrdersRepositoryMock.GetOrder. %v %v", ctx, id)
+	return
+}
+
+// GetOrderAfterCounter returns a count of finished OrdersRepositoryMock.GetOrder invocations
+func (mmGetOrder *OrdersRepositoryMock) GetOrderAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOrder.afterGetOrderCounter)
+}
+
+// GetOrderBeforeCounter returns a count of OrdersRepositoryMock.GetOrder invocations
+func (mmGetOrder *OrdersRepositoryMock) GetOrderBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOrder.beforeGetOrderCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrdersRepositoryMock.GetOrder.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetOrder *mOrdersRepositoryMockGetOrder) Calls() []*OrdersRepositoryMockGetOrderParams {
+	mmGetOrder.mutex.RLock()
+
+	argCopy := make([]*OrdersRepositoryMockGetOrderParams, len(mmGetOrder.callArgs))
+	copy(argCopy, mmGetOrder.callArgs)
+
+	mmGetOrder.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetOrderDone returns true if the count of the GetOrder invocations corresponds
+// the number of defined expectations
+func (m *OrdersRepositoryMock) MinimockGetOrderDone() bool {
+	for _, e := range m.GetOrderMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetOrderMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetOrderCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetOrder != nil && mm_atomic.LoadUint64(&m.afterGetOrderCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetOrderInspect logs each unmet expectation
+func (m *OrdersRepositoryMock) MinimockGetOrderInspect() {
+	for _, e := range m.GetOrderMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.GetOrder with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetOrderMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetOrderCounter) < 1 {
+		if m.GetOrderMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OrdersRepositoryMock.GetOrder")
+		} else {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.GetOrder with params: %#v", *m.GetOrderMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetOrder != nil && mm_atomic.LoadUint64(&m.afterGetOrderCounter) < 1 {
+		m.t.Error("Expected call to OrdersRepositoryMock.GetOrder")
+	}
+}
+
+type mOrdersRepositoryMockGetOrderHistory struct {
+	mock               *OrdersRepositoryMock
+	defaultExpectation *OrdersRepositoryMockGetOrderHistoryExpectation
+	expectations       []*OrdersRepositoryMockGetOrderHistoryExpectation
+
+	callArgs []*OrdersRepositoryMockGetOrderHistoryParams
+	mutex    sync.RWMutex
+}
+
+// OrdersRepositoryMockGetOrderHistoryExpectation specifies expectation struct of the OrdersRepository.GetOrderHistory
+type OrdersRepositoryMockGetOrderHistoryExpectation struct {
+	mock    *OrdersRepositoryMock
+	params  *OrdersRepositoryMockGetOrderHistoryParams
+	results *OrdersRepositoryMockGetOrderHistoryResults
+	Counter uint64
+}
+
+// OrdersRepositoryMockGetOrderHistoryParams contains parameters of the OrdersRepository.GetOrderHistory
+type OrdersRepositoryMockGetOrderHistoryParams struct {
+	ctx     context.Context
+	orderID int64
+}
+
+// OrdersRepositoryMockGetOrderHistoryResults contains results of the OrdersRepository.GetOrderHistory
+type OrdersRepositoryMockGetOrderHistoryResults struct {
+	sa1 []StatusChange
+	err error
+}
+
+// Expect sets up expected params for OrdersRepository.GetOrderHistory
+func (mmGetOrderHistory *mOrdersRepositoryMockGetOrderHistory) Expect(ctx context.Context, orderID int64) *mOrdersRepositoryMockGetOrderHistory {
+	if mmGetOrderHistory.mock.funcGetOrderHistory != nil {
+		mmGetOrderHistory.mock.t.Fatalf("OrdersRepositoryMock.GetOrderHistory mock is already set by Set")
+	}
+
+	if mmGetOrderHistory.defaultExpectation == nil {
+		mmGetOrderHistory.defaultExpectation = &OrdersRepositoryMockGetOrderHistoryExpectation{}
+	}
+
+	mmGetOrderHistory.defaultExpectation.params = &OrdersRepositoryMockGetOrderHistoryParams{ctx, orderID}
+	for _, e := range mmGetOrderHistory.expectations {
+		if minimock.Equal(e.params, mmGetOrderHistory.defaultExpectation.params) {
+			mmGetOrderHistory.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetOrderHistory.defaultExpectation.params)
+		}
+	}
+
+	return mmGetOrderHistory
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrdersRepository.GetOrderHistory
+func (mmGetOrderHistory *mOrdersRepositoryMockGetOrderHistory) Inspect(f func(ctx context.Context, orderID int64)) *mOrdersRepositoryMockGetOrderHistory {
+	if mmGetOrderHistory.mock.inspectFuncGetOrderHistory != nil {
+		mmGetOrderHistory.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.GetOrderHistory")
+	}
+
+	mmGetOrderHistory.mock.inspectFuncGetOrderHistory = f
+
+	return mmGetOrderHistory
+}
+
+// Return sets up results that will be returned by OrdersRepository.GetOrderHistory
+func (mmGetOrderHistory *mOrdersRepositoryMockGetOrderHistory) Return(sa1 []StatusChange, err error) *OrdersRepositoryMock {
+	if mmGetOrderHistory.mock.funcGetOrderHistory != nil {
+		mmGetOrderHistory.mock.t.Fatalf("OrdersRepositoryMock.GetOrderHistory mock is already set by Set")
+	}
+
+	if mmGetOrderHistory.defaultExpectation == nil {
+		mmGetOrderHistory.defaultExpectation = &OrdersRepositoryMockGetOrderHistoryExpectation{mock: mmGetOrderHistory.mock}
+	}
+	mmGetOrderHistory.defaultExpectation.results = &OrdersRepositoryMockGetOrderHistoryResults{sa1, err}
+	return mmGetOrderHistory.mock
+}
+
+// Set uses given function f to mock the OrdersRepository.GetOrderHistory method
+func (mmGetOrderHistory *mOrdersRepositoryMockGetOrderHistory) Set(f func(ctx context.Context, orderID int64) (sa1 []StatusChange, err error)) *OrdersRepositoryMock {
+	if mmGetOrderHistory.defaultExpectation != nil {
+		mmGetOrderHistory.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.GetOrderHistory method")
+	}
+
+	if len(mmGetOrderHistory.expectations) > 0 {
+		mmGetOrderHistory.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.GetOrderHistory method")
+	}
+
+	mmGetOrderHistory.mock.funcGetOrderHistory = f
+	return mmGetOrderHistory.mock
+}
+
+// When sets expectation for the OrdersRepository.GetOrderHistory which will trigger the result defined by the following
+// Then helper
+func (mmGetOrderHistory *mOrdersRepositoryMockGetOrderHistory) When(ctx context.Context, orderID int64) *OrdersRepositoryMockGetOrderHistoryExpectation {
+	if mmGetOrderHistory.mock.funcGetOrderHistory != nil {
+		mmGetOrderHistory.mock.t.Fatalf("OrdersRepositoryMock.GetOrderHistory mock is already set by Set")
+	}
+
+	expectation := &OrdersRepositoryMockGetOrderHistoryExpectation{
+		mock:   mmGetOrderHistory.mock,
+		params: &OrdersRepositoryMockGetOrderHistoryParams{ctx, orderID},
+	}
+	mmGetOrderHistory.expectations = append(mmGetOrderHistory.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrdersRepository.GetOrderHistory return parameters for the expectation previously defined by the When method
+func (e *OrdersRepositoryMockGetOrderHistoryExpectation) Then(sa1 []StatusChange, err error) *OrdersRepositoryMock {
+	e.results = &OrdersRepositoryMockGetOrderHistoryResults{sa1, err}
+	return e.mock
+}
+
+// GetOrderHistory implements OrdersRepository
+func (mmGetOrderHistory *OrdersRepositoryMock) GetOrderHistory(ctx context.Context, orderID int64) (sa1 []StatusChange, err error) {
+	mm_atomic.AddUint64(&mmGetOrderHistory.beforeGetOrderHistoryCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetOrderHistory.afterGetOrderHistoryCounter, 1)
+
+	if mmGetOrderHistory.inspectFuncGetOrderHistory != nil {
+		mmGetOrderHistory.inspectFuncGetOrderHistory(ctx, orderID)
+	}
+
+	mm_params := &OrdersRepositoryMockGetOrderHistoryParams{ctx, orderID}
+
+	// Record call args
+	mmGetOrderHistory.GetOrderHistoryMock.mutex.Lock()
+	mmGetOrderHistory.GetOrderHistoryMock.callArgs = append(mmGetOrderHistory.GetOrderHistoryMock.callArgs, mm_params)
+	mmGetOrderHistory.GetOrderHistoryMock.mutex.Unlock()
+
+	for _, e := range mmGetOrderHistory.GetOrderHistoryMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmGetOrderHistory.GetOrderHistoryMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetOrderHistory.GetOrderHistoryMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetOrderHistory.GetOrderHistoryMock.defaultExpectation.params
+		mm_got := OrdersRepositoryMockGetOrderHistoryParams{ctx, orderID}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetOrderHistory.t.Errorf("OrdersRepositoryMock.GetOrderHistory got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetOrderHistory.GetOrderHistoryMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetOrderHistory.t.Fatal("No results are set for the OrdersRepositoryMock.GetOrderHistory")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmGetOrderHistory.funcGetOrderHistory != nil {
+		return mmGetOrderHistory.funcGetOrderHistory(ctx, orderID)
+	}
+	mmGetOrderHistory.t.Fatalf("Unexpected call to OrdersRepositoryMock.GetOrderHistory. %v %v", ctx, orderID)
+	return
+}
+
+// GetOrderHistoryAfterCounter returns a count of finished OrdersRepositoryMock.GetOrderHistory invocations
+func (mmGetOrderHistory *OrdersRepositoryMock) GetOrderHistoryAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOrderHistory.afterGetOrderHistoryCounter)
+}
+
+// GetOrderHistoryBeforeCounter returns a count of OrdersRepositoryMock.GetOrderHistory invocations
+func (mmGetOrderHistory *OrdersRepositoryMock) GetOrderHistoryBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOrderHistory.beforeGetOrderHistoryCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrdersRepositoryMock.GetOrderHistory.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetOrderHistory *mOrdersRepositoryMockGetOrderHistory) Calls() []*OrdersRepositoryMockGetOrderHistoryParams {
+	mmGetOrderHistory.mutex.RLock()
+
+	argCopy := make([]*OrdersRepositoryMockGetOrderHistoryParams, len(mmGetOrderHistory.callArgs))
+	copy(argCopy, mmGetOrderHistory.callArgs)
+
+	mmGetOrderHistory.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetOrderHistoryDone returns true if the count of the GetOrderHistory invocations corresponds
+// the number of defined expectations
+func (m *OrdersRepositoryMock) MinimockGetOrderHistoryDone() bool {
+	for _, e := range m.GetOrderHistoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetOrderHistoryMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetOrderHistoryCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetOrderHistory != nil && mm_atomic.LoadUint64(&m.afterGetOrderHistoryCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetOrderHistoryInspect logs each unmet expectation
+func (m *OrdersRepositoryMock) MinimockGetOrderHistoryInspect() {
+	for _, e := range m.GetOrderHistoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.GetOrderHistory with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetOrderHistoryMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetOrderHistoryCounter) < 1 {
+		if m.GetOrderHistoryMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OrdersRepositoryMock.GetOrderHistory")
+		} else {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.GetOrderHistory with params: %#v", *m.GetOrderHistoryMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetOrderHistory != nil && mm_atomic.LoadUint64(&m.afterGetOrderHistoryCounter) < 1 {
+		m.t.Error("Expected call to OrdersRepositoryMock.GetOrderHistory")
+	}
+}
+
+type mOrdersRepositoryMockGetOrderIDByIdempotencyKey struct {
+	mock               *OrdersRepositoryMock
+	defaultExpectation *OrdersRepositoryMockGetOrderIDByIdempotencyKeyExpectation
+	expectations       []*OrdersRepositoryMockGetOrderIDByIdempotencyKeyExpectation
+
+	callArgs []*OrdersRepositoryMockGetOrderIDByIdempotencyKeyParams
+	mutex    sync.RWMutex
+}
+
+// OrdersRepositoryMockGetOrderIDByIdempotencyKeyExpectation specifies expectation struct of the OrdersRepository.GetOrderIDByIdempotencyKey
+type OrdersRepositoryMockGetOrderIDByIdempotencyKeyExpectation struct {
+	mock    *OrdersRepositoryMock
+	params  *OrdersRepositoryMockGetOrderIDByIdempotencyKeyParams
+	results *OrdersRepositoryMockGetOrderIDByIdempotencyKeyResults
+	Counter uint64
+}
+
+// OrdersRepositoryMockGetOrderIDByIdempotencyKeyParams contains parameters of the OrdersRepository.GetOrderIDByIdempotencyKey
+type OrdersRepositoryMockGetOrderIDByIdempotencyKeyParams struct {
+	ctx  context.Context
+	user int64
+	key  string
+}
+
+// OrdersRepositoryMockGetOrderIDByIdempotencyKeyResults contains results of the OrdersRepository.GetOrderIDByIdempotencyKey
+type OrdersRepositoryMockGetOrderIDByIdempotencyKeyResults struct {
+	i1  int64
+	err error
+}
+
+// Expect sets up expected params for OrdersRepository.GetOrderIDByIdempotencyKey
+func (mmGetOrderIDByIdempotencyKey *mOrdersRepositoryMockGetOrderIDByIdempotencyKey) Expect(ctx context.Context, user int64, key string) *mOrdersRepositoryMockGetOrderIDByIdempotencyKey {
+	if mmGetOrderIDByIdempotencyKey.mock.funcGetOrderIDByIdempotencyKey != nil {
+		mmGetOrderIDByIdempotencyKey.mock.t.Fatalf("OrdersRepositoryMock.GetOrderIDByIdempotencyKey mock is already set by Set")
+	}
+
+	if mmGetOrderIDByIdempotencyKey.defaultExpectation == nil {
+		mmGetOrderIDByIdempotencyKey.defaultExpectation = &OrdersRepositoryMockGetOrderIDByIdempotencyKeyExpectation{}
+	}
+
+	mmGetOrderIDByIdempotencyKey.defaultExpectation.params = &OrdersRepositoryMockGetOrderIDByIdempotencyKeyParams{ctx, user, key}
+	for _, e := range mmGetOrderIDByIdempotencyKey.expectations {
+		if minimock.Equal(e.params, mmGetOrderIDByIdempotencyKey.defaultExpectation.params) {
+			mmGetOrderIDByIdempotencyKey.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetOrderIDByIdempotencyKey.defaultExpectation.params)
+		}
+	}
+
+	return mmGetOrderIDByIdempotencyKey
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrdersRepository.GetOrderIDByIdempotencyKey
+func (mmGetOrderIDByIdempotencyKey *mOrdersRepositoryMockGetOrderIDByIdempotencyKey) Inspect(f func(ctx context.Context, user int64, key string)) *mOrdersRepositoryMockGetOrderIDByIdempotencyKey {
+	if mmGetOrderIDByIdempotencyKey.mock.inspectFuncGetOrderIDByIdempotencyKey != nil {
+		mmGetOrderIDByIdempotencyKey.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.GetOrderIDByIdempotencyKey")
+	}
+
+	mmGetOrderIDByIdempotencyKey.mock.inspectFuncGetOrderIDByIdempotencyKey = f
+
+	return mmGetOrderIDByIdempotencyKey
+}
+
+// Return sets up results that will be returned by OrdersRepository.GetOrderIDByIdempotencyKey
+func (mmGetOrderIDByIdempotencyKey *mOrdersRepositoryMockGetOrderIDByIdempotencyKey) Return(i1 int64, err error) *OrdersRepositoryMock {
+	if mmGetOrderIDByIdempotencyKey.mock.funcGetOrderIDByIdempotencyKey != nil {
+		mmGetOrderIDByIdempotencyKey.mock.t.Fatalf("OrdersRepositoryMock.GetOrderIDByIdempotencyKey mock is already set by Set")
+	}
+
+	if mmGetOrderIDByIdempotencyKey.defaultExpectation == nil {
+		mmGetOrderIDByIdempotencyKey.defaultExpectation = &OrdersRepositoryMockGetOrderIDByIdempotencyKeyExpectation{mock: mmGetOrderIDByIdempotencyKey.mock}
+	}
+	mmGetOrderIDByIdempotencyKey.defaultExpectation.results = &OrdersRepositoryMockGetOrderIDByIdempotencyKeyResults{i1, err}
+	return mmGetOrderIDByIdempotencyKey.mock
+}
+
+// Set uses given function f to mock the OrdersRepository.GetOrderIDByIdempotencyKey method
+func (mmGetOrderIDByIdempotencyKey *mOrdersRepositoryMockGetOrderIDByIdempotencyKey) Set(f func(ctx context.Context, user int64, key string) (i1 int64, err error)) *OrdersRepositoryMock {
+	if mmGetOrderIDByIdempotencyKey.defaultExpectation != nil {
+		mmGetOrderIDByIdempotencyKey.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.GetOrderIDByIdempotencyKey method")
+	}
+
+	if len(mmGetOrderIDByIdempotencyKey.expectations) > 0 {
+		mmGetOrderIDByIdempotencyKey.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.GetOrderIDByIdempotencyKey method")
+	}
+
+	mmGetOrderIDByIdempotencyKey.mock.funcGetOrderIDByIdempotencyKey = f
+	return mmGetOrderIDByIdempotencyKey.mock
+}
+
+// When sets expectation for the OrdersRepository.GetOrderIDByIdempotencyKey which will trigger the result defined by the following
+// Then helper
+func (mmGetOrderIDByIdempotencyKey *mOrdersRepositoryMockGetOrderIDByIdempotencyKey) When(ctx context.Context, user int64, key string) *OrdersRepositoryMockGetOrderIDByIdempotencyKeyExpectation {
+	if mmGetOrderIDByIdempotencyKey.mock.funcGetOrderIDByIdempotencyKey != nil {
+		mmGetOrderIDByIdempotencyKey.mock.t.Fatalf("OrdersRepositoryMock.GetOrderIDByIdempotencyKey mock is already set by Set")
+	}
+
+	expectation := &OrdersRepositoryMockGetOrderIDByIdempotencyKeyExpectation{
+		mock:   mmGetOrderIDByIdempotencyKey.mock,
+		params: &OrdersRepositoryMockGetOrderIDByIdempotencyKeyParams{ctx, user, key},
+	}
+	mmGetOrderIDByIdempotencyKey.expectations = append(mmGetOrderIDByIdempotencyKey.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrdersRepository.GetOrderIDByIdempotencyKey return parameters for the expectation previously defined by the When method
+func (e *OrdersRepositoryMockGetOrderIDByIdempotencyKeyExpectation) Then(i1 int64, err error) *OrdersRepositoryMock {
+	e.results = &OrdersRepositoryMockGetOrderIDByIdempotencyKeyResults{i1, err}
+	return e.mock
+}
+
+// GetOrderIDByIdempotencyKey implements OrdersRepository
+func (mmGetOrderIDByIdempotencyKey *OrdersRepositoryMock) GetOrderIDByIdempotencyKey(ctx context.Context, user int64, key string) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmGetOrderIDByIdempotencyKey.beforeGetOrderIDByIdempotencyKeyCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetOrderIDByIdempotencyKey.afterGetOrderIDByIdempotencyKeyCounter, 1)
+
+	if mmGetOrderIDByIdempotencyKey.inspectFuncGetOrderIDByIdempotencyKey != nil {
+		mmGetOrderIDByIdempotencyKey.inspectFuncGetOrderIDByIdempotencyKey(ctx, user, key)
+	}
+
+	mm_params := &OrdersRepositoryMockGetOrderIDByIdempotencyKeyParams{ctx, user, key}
+
+	// Record call args
+	mmGetOrderIDByIdempotencyKey.GetOrderIDByIdempotencyKeyMock.mutex.Lock()
+	mmGetOrderIDByIdempotencyKey.GetOrderIDByIdempotencyKeyMock.callArgs = append(mmGetOrderIDByIdempotencyKey.GetOrderIDByIdempotencyKeyMock.callArgs, mm_params)
+	mmGetOrderIDByIdempotencyKey.GetOrderIDByIdempotencyKeyMock.mutex.Unlock()
+
+	for _, e := range mmGetOrderIDByIdempotencyKey.GetOrderIDByIdempotencyKeyMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmGetOrderIDByIdempotencyKey.GetOrderIDByIdempotencyKeyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetOrderIDByIdempotencyKey.GetOrderIDByIdempotencyKeyMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetOrderIDByIdempotencyKey.GetOrderIDByIdempotencyKeyMock.defaultExpectation.params
+		mm_got := OrdersRepositoryMockGetOrderIDByIdempotencyKeyParams{ctx, user, key}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetOrderIDByIdempotencyKey.t.Errorf("OrdersRepositoryMock.GetOrderIDByIdempotencyKey got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetOrderIDByIdempotencyKey.GetOrderIDByIdempotencyKeyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetOrderIDByIdempotencyKey.t.Fatal("No results are set for the OrdersRepositoryMock.GetOrderIDByIdempotencyKey")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmGetOrderIDByIdempotencyKey.funcGetOrderIDByIdempotencyKey != nil {
+		return mmGetOrderIDByIdempotencyKey.funcGetOrderIDByIdempotencyKey(ctx, user, key)
+	}
+	mmGetOrderIDByIdempotencyKey.t.Fatalf("Unexpected call to OrdersRepositoryMock.GetOrderIDByIdempotencyKey. %v %v %v", ctx, user, key)
+	return
+}
+
+// GetOrderIDByIdempotencyKeyAfterCounter returns a count of finished OrdersRepositoryMock.GetOrderIDByIdempotencyKey invocations
+func (mmGetOrderIDByIdempotencyKey *OrdersRepositoryMock) GetOrderIDByIdempotencyKeyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOrderIDByIdempotencyKey.afterGetOrderIDByIdempotencyKeyCounter)
+}
+
+// GetOrderIDByIdempotencyKeyBeforeCounter returns a count of OrdersRepositoryMock.GetOrderIDByIdempotencyKey invocations
+func (mmGetOrderIDByIdempotencyKey *OrdersRepositoryMock) GetOrderIDByIdempotencyKeyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOrderIDByIdempotencyKey.beforeGetOrderIDByIdempotencyKeyCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrdersRepositoryMock.GetOrderIDByIdempotencyKey.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetOrderIDByIdempotencyKey *mOrdersRepositoryMockGetOrderIDByIdempotencyKey) Calls() []*OrdersRepositoryMockGetOrderIDByIdempotencyKeyParams {
+	mmGetOrderIDByIdempotencyKey.mutex.RLock()
+
+	argCopy := make([]*OrdersRepositoryMockGetOrderIDByIdempotencyKeyParams, len(mmGetOrderIDByIdempotencyKey.callArgs))
+	copy(argCopy, mmGetOrderIDByIdempotencyKey.callArgs)
+
+	mmGetOrderIDByIdempotencyKey.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetOrderIDByIdempotencyKeyDone returns true if the count of the GetOrderIDByIdempotencyKey invocations corresponds
+// the number of defined expectations
+func (m *OrdersRepositoryMock) MinimockGetOrderIDByIdempotencyKeyDone() bool {
+	for _, e := range m.GetOrderIDByIdempotencyKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetOrderIDByIdempotencyKeyMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetOrderIDByIdempotencyKeyCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetOrderIDByIdempotencyKey != nil && mm_atomic.LoadUint64(&m.afterGetOrderIDByIdempotencyKeyCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetOrderIDByIdempotencyKeyInspect logs each unmet expectation
+func (m *OrdersRepositoryMock) MinimockGetOrderIDByIdempotencyKeyInspect() {
+	for _, e := range m.GetOrderIDByIdempotencyKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.GetOrderIDByIdempotencyKey with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetOrderIDByIdempotencyKeyMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetOrderIDByIdempotencyKeyCounter) < 1 {
+		if m.GetOrderIDByIdempotencyKeyMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OrdersRepositoryMock.GetOrderIDByIdempotencyKey")
+		} else {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.GetOrderIDByIdempotencyKey with params: %#v", *m.GetOrderIDByIdempotencyKeyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetOrderIDByIdempotencyKey != nil && mm_atomic.LoadUint64(&m.afterGetOrderIDByIdempotencyKeyCounter) < 1 {
+		m.t.Error("Expected call to OrdersRepositoryMock.GetOrderIDByIdempotencyKey")
+	}
+}
+
+type mOrdersRepositoryMockGetReturnItems struct {
+	mock               *OrdersRepositoryMock
+	defaultExpectation *OrdersRepositoryMockGetReturnItemsExpectation
+	expectations       []*OrdersRepositoryMockGetReturnItemsExpectation
+
+	callArgs []*OrdersRepositoryMockGetReturnItemsParams
+	mutex    sync.RWMutex
+}
+
+// OrdersRepositoryMockGetReturnItemsExpectation specifies expectation struct of the OrdersRepository.GetReturnItems
+type OrdersRepositoryMockGetReturnItemsExpectation struct {
+	mock    *OrdersRepositoryMock
+	params  *OrdersRepositoryMockGetReturnItemsParams
+	results *OrdersRepositoryMockGetReturnItemsResults
+	Counter uint64
+}
+
+// OrdersRepositoryMockGetReturnItemsParams contains parameters of the OrdersRepository.GetReturnItems
+type OrdersRepositoryMockGetReturnItemsParams struct {
+	ctx     context.Context
+	orderID int64
+}
+
+// OrdersRepositoryMockGetReturnItemsResults contains results of the OrdersRepository.GetReturnItems
+type OrdersRepositoryMockGetReturnItemsResults struct {
+	oa1 []OrderItem
+	err error
+}
+
+// Expect sets up expected params for OrdersRepository.GetReturnItems
+func (mmGetReturnItems *mOrdersRepositoryMockGetReturnItems) Expect(ctx context.Context, orderID int64) *mOrdersRepositoryMockGetReturnItems {
+	if mmGetReturnItems.mock.funcGetReturnItems != nil {
+		mmGetReturnItems.mock.t.Fatalf("OrdersRepositoryMock.GetReturnItems mock is already set by Set")
+	}
+
+	if mmGetReturnItems.defaultExpectation == nil {
+		mmGetReturnItems.defaultExpectation = &OrdersRepositoryMockGetReturnItemsExpectation{}
+	}
+
+	mmGetReturnItems.defaultExpectation.params = &OrdersRepositoryMockGetReturnItemsParams{ctx, orderID}
+	for _, e := range mmGetReturnItems.expectations {
+		if minimock.Equal(e.params, mmGetReturnItems.defaultExpectation.params) {
+			mmGetReturnItems.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetReturnItems.defaultExpectation.params)
+		}
+	}
+
+	return mmGetReturnItems
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrdersRepository.GetReturnItems
+func (mmGetReturnItems *mOrdersRepositoryMockGetReturnItems) Inspect(f func(ctx context.Context, orderID int64)) *mOrdersRepositoryMockGetReturnItems {
+	if mmGetReturnItems.mock.inspectFuncGetReturnItems != nil {
+		mmGetReturnItems.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.GetReturnItems")
+	}
+
+	mmGetReturnItems.mock.inspectFuncGetReturnItems = f
+
+	return mmGetReturnItems
+}
+
+// Return sets up results that will be returned by OrdersRepository.GetReturnItems
+func (mmGetReturnItems *mOrdersRepositoryMockGetReturnItems) Return(oa1 []OrderItem, err error) *OrdersRepositoryMock {
+	if mmGetReturnItems.mock.funcGetReturnItems != nil {
+		mmGetReturnItems.mock.t.Fatalf("OrdersRepositoryMock.GetReturnItems mock is already set by Set")
+	}
+
+	if mmGetReturnItems.defaultExpectation == nil {
+		mmGetReturnItems.defaultExpectation = &OrdersRepositoryMockGetReturnItemsExpectation{mock: mmGetReturnItems.mock}
+	}
+	mmGetReturnItems.defaultExpectation.results = &OrdersRepositoryMockGetReturnItemsResults{oa1, err}
+	return mmGetReturnItems.mock
+}
+
+// Set uses given function f to mock the OrdersRepository.GetReturnItems method
+func (mmGetReturnItems *mOrdersRepositoryMockGetReturnItems) Set(f func(ctx context.Context, orderID int64) (oa1 []OrderItem, err error)) *OrdersRepositoryMock {
+	if mmGetReturnItems.defaultExpectation != nil {
+		mmGetReturnItems.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.GetReturnItems method")
+	}
+
+	if len(mmGetReturnItems.expectations) > 0 {
+		mmGetReturnItems.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.GetReturnItems method")
+	}
+
+	mmGetReturnItems.mock.funcGetReturnItems = f
+	return mmGetReturnItems.mock
+}
+
+// When sets expectation for the OrdersRepository.GetReturnItems which will trigger the result defined by the following
+// Then helper
+func (mmGetReturnItems *mOrdersRepositoryMockGetReturnItems) When(ctx context.Context, orderID int64) *OrdersRepositoryMockGetReturnItemsExpectation {
+	if mmGetReturnItems.mock.funcGetReturnItems != nil {
+		mmGetReturnItems.mock.t.Fatalf("OrdersRepositoryMock.GetReturnItems mock is already set by Set")
+	}
+
+	expectation := &OrdersRepositoryMockGetReturnItemsExpectation{
+		mock:   mmGetReturnItems.mock,
+		params: &OrdersRepositoryMockGetReturnItemsParams{ctx, orderID},
+	}
+	mmGetReturnItems.expectations = append(mmGetReturnItems.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrdersRepository.GetReturnItems return parameters for the expectation previously defined by the When method
+func (e *OrdersRepositoryMockGetReturnItemsExpectation) Then(oa1 []OrderItem, err error) *OrdersRepositoryMock {
+	e.results = &OrdersRepositoryMockGetReturnItemsResults{oa1, err}
+	return e.mock
+}
+
+// GetReturnItems implements OrdersRepository
+func (mmGetReturnItems *OrdersRepositoryMock) GetReturnItems(ctx context.Context, orderID int64) (oa1 []OrderItem, err error) {
+	mm_atomic.AddUint64(&mmGetReturnItems.beforeGetReturnItemsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetReturnItems.afterGetReturnItemsCounter, 1)
+
+	if mmGetReturnItems.inspectFuncGetReturnItems != nil {
+		mmGetReturnItems.inspectFuncGetReturnItems(ctx, orderID)
+	}
+
+	mm_params := &OrdersRepositoryMockGetReturnItemsParams{ctx, orderID}
+
+	// Record call args
+	mmGetReturnItems.GetReturnItemsMock.mutex.Lock()
+	mmGetReturnItems.GetReturnItemsMock.callArgs = append(mmGetReturnItems.GetReturnItemsMock.callArgs, mm_params)
+	mmGetReturnItems.GetReturnItemsMock.mutex.Unlock()
+
+	for _, e := range mmGetReturnItems.GetReturnItemsMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.oa1, e.results.err
+		}
+	}
+
+	if mmGetReturnItems.GetReturnItemsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetReturnItems.GetReturnItemsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetReturnItems.GetReturnItemsMock.defaultExpectation.params
+		mm_got := OrdersRepositoryMockGetReturnItemsParams{ctx, orderID}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetReturnItems.t.Errorf("OrdersRepositoryMock.GetReturnItems got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetReturnItems.GetReturnItemsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetReturnItems.t.Fatal("No results are set for the OrdersRepositoryMock.GetReturnItems")
+		}
+		return (*mm_results).oa1, (*mm_results).err
+	}
+	if mmGetReturnItems.funcGetReturnItems != nil {
+		return mmGetReturnItems.funcGetReturnItems(ctx, orderID)
+	}
+	mmGetReturnItems.t.Fatalf("Unexpected call to OrdersRepositoryMock.GetReturnItems. %v %v", ctx, orderID)
+	return
+}
+
+// GetReturnItemsAfterCounter returns a count of finished OrdersRepositoryMock.GetReturnItems invocations
+func (mmGetReturnItems *OrdersRepositoryMock) GetReturnItemsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetReturnItems.afterGetReturnItemsCounter)
+}
+
+// GetReturnItemsBeforeCounter returns a count of OrdersRepositoryMock.GetReturnItems invocations
+func (mmGetReturnItems *OrdersRepositoryMock) GetReturnItemsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetReturnItems.beforeGetReturnItemsCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrdersRepositoryMock.GetReturnItems.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetReturnItems *mOrdersRepositoryMockGetReturnItems) Calls() []*OrdersRepositoryMockGetReturnItemsParams {
+	mmGetReturnItems.mutex.RLock()
+
+	argCopy := make([]*OrdersRepositoryMockGetReturnItemsParams, len(mmGetReturnItems.callArgs))
+	copy(argCopy, mmGetReturnItems.callArgs)
+
+	mmGetReturnItems.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetReturnItemsDone returns true if the count of the GetReturnItems invocations corresponds
+// the number of defined expectations
+func (m *OrdersRepositoryMock) MinimockGetReturnItemsDone() bool {
+	for _, e := range m.GetReturnItemsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetReturnItemsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetReturnItemsCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetReturnItems != nil && mm_atomic.LoadUint64(&m.afterGetReturnItemsCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetReturnItemsInspect logs each unmet expectation
+func (m *OrdersRepositoryMock) MinimockGetReturnItemsInspect() {
+	for _, e := range m.GetReturnItemsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.GetReturnItems with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetReturnItemsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetReturnItemsCounter) < 1 {
+		if m.GetReturnItemsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OrdersRepositoryMock.GetReturnItems")
+		} else {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.GetReturnItems with params: %#v", *m.GetReturnItemsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetReturnItems != nil && mm_atomic.LoadUint64(&m.afterGetReturnItemsCounter) < 1 {
+		m.t.Error("Expected call to OrdersRepositoryMock.GetReturnItems")
+	}
+}
+
+type mOrdersRepositoryMockGetShortages struct {
+	mock               *OrdersRepositoryMock
+	defaultExpectation *OrdersRepositoryMockGetShortagesExpectation
+	expectations       []*OrdersRepositoryMockGetShortagesExpectation
+
+	callArgs []*OrdersRepositoryMockGetShortagesParams
+	mutex    sync.RWMutex
+}
+
+// OrdersRepositoryMockGetShortagesExpectation specifies expectation struct of the OrdersRepository.GetShortages
+type OrdersRepositoryMockGetShortagesExpectation struct {
+	mock    *OrdersRepositoryMock
+	params  *OrdersRepositoryMockGetShortagesParams
+	results *OrdersRepositoryMockGetShortagesResults
+	Counter uint64
+}
+
+// OrdersRepositoryMockGetShortagesParams contains parameters of the OrdersRepository.GetShortages
+type OrdersRepositoryMockGetShortagesParams struct {
+	ctx     context.Context
+	orderID int64
+}
+
+// OrdersRepositoryMockGetShortagesResults contains results of the OrdersRepository.GetShortages
+type OrdersRepositoryMockGetShortagesResults struct {
+	ia1 []ItemShortage
+	err error
+}
+
+// Expect sets up expected params for OrdersRepository.GetShortages
+func (mmGetShortages *mOrdersRepositoryMockGetShortages) Expect(ctx context.Context, orderID int64) *mOrdersRepositoryMockGetShortages {
+	if mmGetShortages.mock.funcGetShortages != nil {
+		mmGetShortages.mock.t.Fatalf("OrdersRepositoryMock.GetShortages mock is already set by Set")
+	}
+
+	if mmGetShortages.defaultExpectation == nil {
+		mmGetShortages.defaultExpectation = &OrdersRepositoryMockGetShortagesExpectation{}
+	}
+
+	mmGetShortages.defaultExpectation.params = &OrdersRepositoryMockGetShortagesParams{ctx, orderID}
+	for _, e := range mmGetShortages.expectations {
+		if minimock.Equal(e.params, mmGetShortages.defaultExpectation.params) {
+			mmGetShortages.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetShortages.defaultExpectation.params)
+		}
+	}
+
+	return mmGetShortages
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrdersRepository.GetShortages
+func (mmGetShortages *mOrdersRepositoryMockGetShortages) Inspect(f func(ctx context.Context, orderID int64)) *mOrdersRepositoryMockGetShortages {
+	if mmGetShortages.mock.inspectFuncGetShortages != nil {
+		mmGetShortages.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.GetShortages")
+	}
+
+	mmGetShortages.mock.inspectFuncGetShortages = f
+
+	return mmGetShortages
+}
+
+// Return sets up results that will be returned by OrdersRepository.GetShortages
+func (mmGetShortages *mOrdersRepositoryMockGetShortages) Return(ia1 []ItemShortage, err error) *OrdersRepositoryMock {
+	if mmGetShortages.mock.funcGetShortages != nil {
+		mmGetShortages.mock.t.Fatalf("OrdersRepositoryMock.GetShortages mock is already set by Set")
+	}
+
+	if mmGetShortages.defaultExpectation == nil {
+		mmGetShortages.defaultExpectation = &OrdersRepositoryMockGetShortagesExpectation{mock: mmGetShortages.mock}
+	}
+	mmGetShortages.defaultExpectation.results = &OrdersRepositoryMockGetShortagesResults{ia1, err}
+	return mmGetShortages.mock
+}
+
+// Set uses given function f to mock the OrdersRepository.GetShortages method
+func (mmGetShortages *mOrdersRepositoryMockGetShortages) Set(f func(ctx context.Context, orderID int64) (ia1 []ItemShortage, err error)) *OrdersRepositoryMock {
+	if mmGetShortages.defaultExpectation != nil {
+		mmGetShortages.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.GetShortages method")
+	}
+
+	if len(mmGetShortages.expectations) > 0 {
+		mmGetShortages.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.GetShortages method")
+	}
+
+	mmGetShortages.mock.funcGetShortages = f
+	return mmGetShortages.mock
+}
+
+// When sets expectation for the OrdersRepository.GetShortages which will trigger the result defined by the following
+// Then helper
+func (mmGetShortages *mOrdersRepositoryMockGetShortages) When(ctx context.Context, orderID int64) *OrdersRepositoryMockGetShortagesExpectation {
+	if mmGetShortages.mock.funcGetShortages != nil {
+		mmGetShortages.mock.t.Fatalf("OrdersRepositoryMock.GetShortages mock is already set by Set")
+	}
+
+	expectation := &OrdersRepositoryMockGetShortagesExpectation{
+		mock:   mmGetShortages.mock,
+		params: &OrdersRepositoryMockGetShortagesParams{ctx, orderID},
+	}
+	mmGetShortages.expectations = append(mmGetShortages.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrdersRepository.GetShortages return parameters for the expectation previously defined by the When method
+func (e *OrdersRepositoryMockGetShortagesExpectation) Then(ia1 []ItemShortage, err error) *OrdersRepositoryMock {
+	e.results = &OrdersRepositoryMockGetShortagesResults{ia1, err}
+	return e.mock
+}
+
+// GetShortages implements OrdersRepository
+func (mmGetShortages *OrdersRepositoryMock) GetShortages(ctx context.Context, orderID int64) (ia1 []ItemShortage, err error) {
+	mm_atomic.AddUint64(&mmGetShortages.beforeGetShortagesCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetShortages.afterGetShortagesCounter, 1)
+
+	if mmGetShortages.inspectFuncGetShortages != nil {
+		mmGetShortages.inspectFuncGetShortages(ctx, orderID)
+	}
+
+	mm_params := &OrdersRepositoryMockGetShortagesParams{ctx, orderID}
+
+	// Record call args
+	mmGetShortages.GetShortagesMock.mutex.Lock()
+	mmGetShortages.GetShortagesMock.callArgs = append(mmGetShortages.GetShortagesMock.callArgs, mm_params)
+	mmGetShortages.GetShortagesMock.mutex.Unlock()
+
+	for _, e := range mmGetShortages.GetShortagesMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ia1, e.results.err
+		}
+	}
+
+	if mmGetShortages.GetShortagesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetShortages.GetShortagesMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetShortages.GetShortagesMock.defaultExpectation.params
+		mm_got := OrdersRepositoryMockGetShortagesParams{ctx, orderID}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetShortages.t.Errorf("OrdersRepositoryMock.GetShortages got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetShortages.GetShortagesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetShortages.t.Fatal("No results are set for the OrdersRepositoryMock.GetShortages")
+		}
+		return (*mm_results).ia1, (*mm_results).err
+	}
+	if mmGetShortages.funcGetShortages != nil {
+		return mmGetShortages.funcGetShortages(ctx, orderID)
+	}
+	mmGetShortages.t.Fatalf("Unexpected call to OrdersRepositoryMock.GetShortages. %v %v", ctx, orderID)
+	return
+}
+
+// GetShortagesAfterCounter returns a count of finished OrdersRepositoryMock.GetShortages invocations
+func (mmGetShortages *OrdersRepositoryMock) GetShortagesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetShortages.afterGetShortagesCounter)
+}
+
+// GetShortagesBeforeCounter returns a count of OrdersRepositoryMock.GetShortages invocations
+func (mmGetShortages *OrdersRepositoryMock) GetShortagesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetShortages.beforeGetShortagesCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrdersRepositoryMock.GetShortages.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetShortages *mOrdersRepositoryMockGetShortages) Calls() []*OrdersRepositoryMockGetShortagesParams {
+	mmGetShortages.mutex.RLock()
+
+	argCopy := make([]*OrdersRepositoryMockGetShortagesParams, len(mmGetShortages.callArgs))
+	copy(argCopy, mmGetShortages.callArgs)
+
+	mmGetShortages.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetShortagesDone returns true if the count of the GetShortages invocations corresponds
+// the number of defined expectations
+func (m *OrdersRepositoryMock) MinimockGetShortagesDone() bool {
+	for _, e := range m.GetShortagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetShortagesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetShortagesCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetShortages != nil && mm_atomic.LoadUint64(&m.afterGetShortagesCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetShortagesInspect logs each unmet expectation
+func (m *OrdersRepositoryMock) MinimockGetShortagesInspect() {
+	for _, e := range m.GetShortagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.GetShortages with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetShortagesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetShortagesCounter) < 1 {
+		if m.GetShortagesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OrdersRepositoryMock.GetShortages")
+		} else {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.GetShortages with params: %#v", *m.GetShortagesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetShortages != nil && mm_atomic.LoadUint64(&m.afterGetShortagesCounter) < 1 {
+		m.t.Error("Expected call to OrdersRepositoryMock.GetShortages")
+	}
+}
+
+type mOrdersRepositoryMockHoldStock struct {
+	mock               *OrdersRepositoryMock
+	defaultExpectation *OrdersRepositoryMockHoldStockExpectation
+	expectations       []*OrdersRepositoryMockHoldStockExpectation
+
+	callArgs []*OrdersRepositoryMockHoldStockParams
+	mutex    sync.RWMutex
+}
+
+// OrdersRepositoryMockHoldStockExpectation specifies expectation struct of the OrdersRepository.HoldStock
+type OrdersRepositoryMockHoldStockExpectation struct {
+	mock    *OrdersRepositoryMock
+	params  *OrdersRepositoryMockHoldStockParams
+	results *OrdersRepositoryMockHoldStockResults
+	Counter uint64
+}
+
+// OrdersRepositoryMockHoldStockParams contains parameters of the OrdersRepository.HoldStock
+type OrdersRepositoryMockHoldStockParams struct {
+	ctx       context.Context
+	user      int64
+	item      ReservedItem
+	expiresAt time.Time
+}
+
+// OrdersRepositoryMockHoldStockResults contains results of the OrdersRepository.HoldStock
+type OrdersRepositoryMockHoldStockResults struct {
+	err error
+}
+
+// Expect sets up expected params for OrdersRepository.HoldStock
+func (mmHoldStock *mOrdersRepositoryMockHoldStock) Expect(ctx context.Context, user int64, item ReservedItem, expiresAt time.Time) *mOrdersRepositoryMockHoldStock {
+	if mmHoldStock.mock.funcHoldStock != nil {
+		mmHoldStock.mock.t.Fatalf("OrdersRepositoryMock.HoldStock mock is already set by Set")
+	}
+
+	if mmHoldStock.defaultExpectation == nil {
+		mmHoldStock.defaultExpectation = &OrdersRepositoryMockHoldStockExpectation{}
+	}
+
+	mmHoldStock.defaultExpectation.params = &OrdersRepositoryMockHoldStockParams{ctx, user, item, expiresAt}
+	for _, e := range mmHoldStock.expectations {
+		if minimock.Equal(e.params, mmHoldStock.defaultExpectation.params) {
+			mmHoldStock.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmHoldStock.defaultExpectation.params)
+		}
+	}
+
+	return mmHoldStock
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrdersRepository.HoldStock
+func (mmHoldStock *mOrdersRepositoryMockHoldStock) Inspect(f func(ctx context.Context, user int64, item ReservedItem, expiresAt time.Time)) *mOrdersRepositoryMockHoldStock {
+	if mmHoldStock.mock.inspectFuncHoldStock != nil {
+		mmHoldStock.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.HoldStock")
+	}
+
+	mmHoldStock.mock.inspectFuncHoldStock = f
+
+	return mmHoldStock
+}
+
+// Return sets up results that will be returned by OrdersRepository.HoldStock
+func (mmHoldStock *mOrdersRepositoryMockHoldStock) Return(err error) *OrdersRepositoryMock {
+	if mmHoldStock.mock.funcHoldStock != nil {
+		mmHoldStock.mock.t.Fatalf("OrdersRepositoryMock.HoldStock mock is already set by Set")
+	}
+
+	if mmHoldStock.defaultExpectation == nil {
+		mmHoldStock.defaultExpectation = &OrdersRepositoryMockHoldStockExpectation{mock: mmHoldStock.mock}
+	}
+	mmHoldStock.defaultExpectation.results = &OrdersRepositoryMockHoldStockResults{err}
+	return mmHoldStock.mock
+}
+
+// Set uses given function f to mock the OrdersRepository.HoldStock method
+func (mmHoldStock *mOrdersRepositoryMockHoldStock) Set(f func(ctx context.Context, user int64, item ReservedItem, expiresAt time.Time) (err error)) *OrdersRepositoryMock {
+	if mmHoldStock.defaultExpectation != nil {
+		mmHoldStock.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.HoldStock method")
+	}
+
+	if len(mmHoldStock.expectations) > 0 {
+		mmHoldStock.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.HoldStock method")
+	}
+
+	mmHoldStock.mock.funcHoldStock = f
+	return mmHoldStock.mock
+}
+
+// When sets expectation for the OrdersRepository.HoldStock which will trigger the result defined by the following
+// Then helper
+func (mmHoldStock *mOrdersRepositoryMockHoldStock) When(ctx context.Context, user int64, item ReservedItem, expiresAt time.Time) *OrdersRepositoryMockHoldStockExpectation {
+	if mmHoldStock.mock.funcHoldStock != nil {
+		mmHoldStock.mock.t.Fatalf("OrdersRepositoryMock.HoldStock mock is already set by Set")
+	}
+
+	expectation := &OrdersRepositoryMockHoldStockExpectation{
+		mock:   mmHoldStock.mock,
+		params: &OrdersRepositoryMockHoldStockParams{ctx, user, item, expiresAt},
+	}
+	mmHoldStock.expectations = append(mmHoldStock.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrdersRepository.HoldStock return parameters for the expectation previously defined by the When method
+func (e *OrdersRepositoryMockHoldStockExpectation) Then(err error) *OrdersRepositoryMock {
+	e.results = &OrdersRepositoryMockHoldStockResults{err}
+	return e.mock
+}
+
+// HoldStock implements OrdersRepository
+func (mmHoldStock *OrdersRepositoryMock) HoldStock(ctx context.Context, user int64, item ReservedItem, expiresAt time.Time) (err error) {
+	mm_atomic.AddUint64(&mmHoldStock.beforeHoldStockCounter, 1)
+	defer mm_atomic.AddUint64(&mmHoldStock.afterHoldStockCounter, 1)
+
+	if mmHoldStock.inspectFuncHoldStock != nil {
+		mmHoldStock.inspectFuncHoldStock(ctx, user, item, expiresAt)
+	}
+
+	mm_params := &OrdersRepositoryMockHoldStockParams{ctx, user, item, expiresAt}
+
+	// Record call args
+	mmHoldStock.HoldStockMock.mutex.Lock()
+	mmHoldStock.HoldStockMock.callArgs = append(mmHoldStock.HoldStockMock.callArgs, mm_params)
+	mmHoldStock.HoldStockMock.mutex.Unlock()
+
+	for _, e := range mmHoldStock.HoldStockMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmHoldStock.HoldStockMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmHoldStock.HoldStockMock.defaultExpectation.Counter, 1)
+		mm_want := mmHoldStock.HoldStockMock.defaultExpectation.params
+		mm_got := OrdersRepositoryMockHoldStockParams{ctx, user, item, expiresAt}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmHoldStock.t.Errorf("OrdersRepositoryMock.HoldStock got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmHoldStock.HoldStockMock.defaultExpectation.results
+		if mm_results == nil {
+			mmHoldStock.t.Fatal("No results are set for the OrdersRepositoryMock.HoldStock")
+		}
+		return (*mm_results).err
+	}
+	if mmHoldStock.funcHoldStock != nil {
+		return mmHoldStock.funcHoldStock(ctx, user, item, expiresAt)
+	}
+	mmHoldStock.t.Fatalf("Unexpected call to OrdersRepositoryMock.HoldStock. %v %v %v %v", ctx, user, item, expiresAt)
+	return
+}
+
+// HoldStockAfterCounter returns a count of finished OrdersRepositoryMock.HoldStock invocations
+func (mmHoldStock *OrdersRepositoryMock) HoldStockAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHoldStock.afterHoldStockCounter)
+}
+
+// HoldStockBeforeCounter returns a count of OrdersRepositoryMock.HoldStock invocations
+func (mmHoldStock *OrdersRepositoryMock) HoldStockBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHoldStock.beforeHoldStockCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrdersRepositoryMock.HoldStock.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmHoldStock *mOrdersRepositoryMockHoldStock) Calls() []*OrdersRepositoryMockHoldStockParams {
+	mmHoldStock.mutex.RLock()
+
+	argCopy := make([]*OrdersRepositoryMockHoldStockParams, len(mmHoldStock.callArgs))
+	copy(argCopy, mmHoldStock.callArgs)
+
+	mmHoldStock.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockHoldStockDone returns true if the count of the HoldStock invocations corresponds
+// the number of defined expectations
+func (m *OrdersRepositoryMock) MinimockHoldStockDone() bool {
+	for _, e := range m.HoldStockMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.HoldStockMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterHoldStockCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcHoldStock != nil && mm_atomic.LoadUint64(&m.afterHoldStockCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockHoldStockInspect logs each unmet expectation
+func (m *OrdersRepositoryMock) MinimockHoldStockInspect() {
+	for _, e := range m.HoldStockMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.HoldStock with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.HoldStockMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterHoldStockCounter) < 1 {
+		if m.HoldStockMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OrdersRepositoryMock.HoldStock")
+		} else {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.HoldStock with params: %#v", *m.HoldStockMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcHoldStock != nil && mm_atomic.LoadUint64(&m.afterHoldStockCounter) < 1 {
+		m.t.Error("Expected call to OrdersRepositoryMock.HoldStock")
+	}
+}
+
+type mOrdersRepositoryMockListSellerOrders struct {
+	mock               *OrdersRepositoryMock
+	defaultExpectation *OrdersRepositoryMockListSellerOrdersExpectation
+	expectations       []*OrdersRepositoryMockListSellerOrdersExpectation
+
+	callArgs []*OrdersRepositoryMockListSellerOrdersParams
+	mutex    sync.RWMutex
+}
+
+// OrdersRepositoryMockListSellerOrdersExpectation specifies expectation struct of the OrdersRepository.ListSellerOrders
+type OrdersRepositoryMockListSellerOrdersExpectation struct {
+	mock    *OrdersRepositoryMock
+	params  *OrdersRepositoryMockListSellerOrdersParams
+	results *OrdersRepositoryMockListSellerOrdersResults
+	Counter uint64
+}
+
+// OrdersRepositoryMockListSellerOrdersParams contains parameters of the OrdersRepository.ListSellerOrders
+type OrdersRepositoryMockListSellerOrdersParams struct {
+	ctx   context.Context
+	query SellerOrdersQuery
+}
+
+// OrdersRepositoryMockListSellerOrdersResults contains results of the OrdersRepository.ListSellerOrders
+type OrdersRepositoryMockListSellerOrdersResults struct {
+	sa1 []SellerOrder
+	err error
+}
+
+// Expect sets up expected params for OrdersRepository.ListSellerOrders
+func (mmListSellerOrders *mOrdersRepositoryMockListSellerOrders) Expect(ctx context.Context, query SellerOrdersQuery) *mOrdersRepositoryMockListSellerOrders {
+	if mmListSellerOrders.mock.funcListSellerOrders != nil {
+		mmListSellerOrders.mock.t.Fatalf("OrdersRepositoryMock.ListSellerOrders mock is already set by Set")
+	}
+
+	if mmListSellerOrders.defaultExpectation == nil {
+		mmListSellerOrders.defaultExpectation = &OrdersRepositoryMockListSellerOrdersExpectation{}
+	}
+
+	mmListSellerOrders.defaultExpectation.params = &OrdersRepositoryMockListSellerOrdersParams{ctx, query}
+	for _, e := range mmListSellerOrders.expectations {
+		if minimock.Equal(e.params, mmListSellerOrders.defaultExpectation.params) {
+			mmListSellerOrders.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListSellerOrders.defaultExpectation.params)
+		}
+	}
+
+	return mmListSellerOrders
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrdersRepository.ListSellerOrders
+func (mmListSellerOrders *mOrdersRepositoryMockListSellerOrders) Inspect(f func(ctx context.Context, query SellerOrdersQuery)) *mOrdersRepositoryMockListSellerOrders {
+	if mmListSellerOrders.mock.inspectFuncListSellerOrders != nil {
+		mmListSellerOrders.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.ListSellerOrders")
+	}
+
+	mmListSellerOrders.mock.inspectFuncListSellerOrders = f
+
+	return mmListSellerOrders
+}
+
+// Return sets up results that will be returned by OrdersRepository.ListSellerOrders
+func (mmListSellerOrders *mOrdersRepositoryMockListSellerOrders) Return(sa1 []SellerOrder, err error) *OrdersRepositoryMock {
+	if mmListSellerOrders.mock.funcListSellerOrders != nil {
+		mmListSellerOrders.mock.t.Fatalf("OrdersRepositoryMock.ListSellerOrders mock is already set by Set")
+	}
+
+	if mmListSellerOrders.defaultExpectation == nil {
+		mmListSellerOrders.defaultExpectation = &OrdersRepositoryMockListSellerOrdersExpectation{mock: mmListSellerOrders.mock}
+	}
+	mmListSellerOrders.defaultExpectation.results = &OrdersRepositoryMockListSellerOrdersResults{sa1, err}
+	return mmListSellerOrders.mock
+}
+
+// Set uses given function f to mock the OrdersRepository.ListSellerOrders method
+func (mmListSellerOrders *mOrdersRepositoryMockListSellerOrders) Set(f func(ctx context.Context, query SellerOrdersQuery) (sa1 []SellerOrder, err error)) *OrdersRepositoryMock {
+	if mmListSellerOrders.defaultExpectation != nil {
+		mmListSellerOrders.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.ListSellerOrders method")
+	}
+
+	if len(mmListSellerOrders.expectations) > 0 {
+		mmListSellerOrders.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.ListSellerOrders method")
+	}
+
+	mmListSellerOrders.mock.funcListSellerOrders = f
+	return mmListSellerOrders.mock
+}
+
+// When sets expectation for the OrdersRepository.ListSellerOrders which will trigger the result defined by the following
+// Then helper
+func (mmListSellerOrders *mOrdersRepositoryMockListSellerOrders) When(ctx context.Context, query SellerOrdersQuery) *OrdersRepositoryMockListSellerOrdersExpectation {
+	if mmListSellerOrders.mock.funcListSellerOrders != nil {
+		mmListSellerOrders.mock.t.Fatalf("OrdersRepositoryMock.ListSellerOrders mock is already set by Set")
+	}
+
+	expectation := &OrdersRepositoryMockListSellerOrdersExpectation{
+		mock:   mmListSellerOrders.mock,
+		params: &OrdersRepositoryMockListSellerOrdersParams{ctx, query},
+	}
+	mmListSellerOrders.expectations = append(mmListSellerOrders.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrdersRepository.ListSellerOrders return parameters for the expectation previously defined by the When method
+func (e *OrdersRepositoryMockListSellerOrdersExpectation) Then(sa1 []SellerOrder, err error) *OrdersRepositoryMock {
+	e.results = &OrdersRepositoryMockListSellerOrdersResults{sa1, err}
+	return e.mock
+}
+
+// ListSellerOrders implements OrdersRepository
+func (mmListSellerOrders *OrdersRepositoryMock) ListSellerOrders(ctx context.Context, query SellerOrdersQuery) (sa1 []SellerOrder, err error) {
+	mm_atomic.AddUint64(&mmListSellerOrders.beforeListSellerOrdersCounter, 1)
+	defer mm_atomic.AddUint64(&mmListSellerOrders.afterListSellerOrdersCounter, 1)
+
+	if mmListSellerOrders.inspectFuncListSellerOrders != nil {
+		mmListSellerOrders.inspectFuncListSellerOrders(ctx, query)
+	}
+
+	mm_params := &OrdersRepositoryMockListSellerOrdersParams{ctx, query}
+
+	// Record call args
+	mmListSellerOrders.ListSellerOrdersMock.mutex.Lock()
+	mmListSellerOrders.ListSellerOrdersMock.callArgs = append(mmListSellerOrders.ListSellerOrdersMock.callArgs, mm_params)
+	mmListSellerOrders.ListSellerOrdersMock.mutex.Unlock()
+
+	for _, e := range mmListSellerOrders.ListSellerOrdersMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmListSellerOrders.ListSellerOrdersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListSellerOrders.ListSellerOrdersMock.defaultExpectation.Counter, 1)
+		mm_want := mmListSellerOrders.ListSellerOrdersMock.defaultExpectation.params
+		mm_got := OrdersRepositoryMockListSellerOrdersParams{ctx, query}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListSellerOrders.t.Errorf("OrdersRepositoryMock.ListSellerOrders got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListSellerOrders.ListSellerOrdersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListSellerOrders.t.Fatal("No results are set for the OrdersRepositoryMock.ListSellerOrders")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmListSellerOrders.funcListSellerOrders != nil {
+		return mmListSellerOrders.funcListSellerOrders(ctx, query)
+	}
+	mmListSellerOrders.t.Fatalf("Unexpected call to OrdersRepositoryMock.ListSellerOrders. %v %v", ctx, query)
+	return
+}
+
+// ListSellerOrdersAfterCounter returns a count of finished OrdersRepositoryMock.ListSellerOrders invocations
+func (mmListSellerOrders *OrdersRepositoryMock) ListSellerOrdersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListSellerOrders.afterListSellerOrdersCounter)
+}
+
+// ListSellerOrdersBeforeCounter returns a count of OrdersRepositoryMock.ListSellerOrders invocations
+func (mmListSellerOrders *OrdersRepositoryMock) ListSellerOrdersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListSellerOrders.beforeListSellerOrdersCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrdersRepositoryMock.ListSellerOrders.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListSellerOrders *mOrdersRepositoryMockListSellerOrders) Calls() []*OrdersRepositoryMockListSellerOrdersParams {
+	mmListSellerOrders.mutex.RLock()
+
+	argCopy := make([]*OrdersRepositoryMockListSellerOrdersParams, len(mmListSellerOrders.callArgs))
+	copy(argCopy, mmListSellerOrders.callArgs)
+
+	mmListSellerOrders.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListSellerOrdersDone returns true if the count of the ListSellerOrders invocations corresponds
+// the number of defined expectations
+func (m *OrdersRepositoryMock) MinimockListSellerOrdersDone() bool {
+	for _, e := range m.ListSellerOrdersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListSellerOrdersMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterListSellerOrdersCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListSellerOrders != nil && mm_atomic.LoadUint64(&m.afterListSellerOrdersCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockListSellerOrdersInspect logs each unmet expectation
+func (m *OrdersRepositoryMock) MinimockListSellerOrdersInspect() {
+	for _, e := range m.ListSellerOrdersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.ListSellerOrders with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListSellerOrdersMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterListSellerOrdersCounter) < 1 {
+		if m.ListSellerOrdersMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OrdersRepositoryMock.ListSellerOrders")
+		} else {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.ListSellerOrders with params: %#v", *m.ListSellerOrdersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListSellerOrders != nil && mm_atomic.LoadUint64(&m.afterListSellerOrdersCounter) < 1 {
+		m.t.Error("Expected call to OrdersRepositoryMock.ListSellerOrders")
+	}
+}
+
+type mOrdersRepositoryMockListSkuBackorders struct {
+	mock               *OrdersRepositoryMock
+	defaultExpectation *OrdersRepositoryMockListSkuBackordersExpectation
+	expectations       []*OrdersRepositoryMockListSkuBackordersExpectation
+
+	callArgs []*OrdersRepositoryMockListSkuBackordersParams
+	mutex    sync.RWMutex
+}
+
+// OrdersRepositoryMockListSkuBackordersExpectation specifies expectation struct of the OrdersRepository.ListSkuBackorders
+type OrdersRepositoryMockListSkuBackordersExpectation struct {
+	mock    *OrdersRepositoryMock
+	params  *OrdersRepositoryMockListSkuBackordersParams
+	results *OrdersRepositoryMockListSkuBackordersResults
+	Counter uint64
+}
+
+// OrdersRepositoryMockListSkuBackordersParams contains parameters of the OrdersRepository.ListSkuBackorders
+type OrdersRepositoryMockListSkuBackordersParams struct {
+	ctx      context.Context
+	sku      uint32
+	sellerID int64
+}
+
+// OrdersRepositoryMockListSkuBackordersResults contains results of the OrdersRepository.ListSkuBackorders
+type OrdersRepositoryMockListSkuBackordersResults struct {
+	ba1 []Backorder
+	err error
+}
+
+// Expect sets up expected params for OrdersRepository.ListSkuBackorders
+func (mmListSkuBackorders *mOrdersRepositoryMockListSkuBackorders) Expect(ctx context.Context, sku uint32, sellerID int64) *mOrdersRepositoryMockListSkuBackorders {
+	if mmListSkuBackorders.mock.funcListSkuBackorders != nil {
+		mmListSkuBackorders.mock.t.Fatalf("OrdersRepositoryMock.ListSkuBackorders mock is already set by Set")
+	}
+
+	if mmListSkuBackorders.defaultExpectation == nil {
+		mmListSkuBackorders.defaultExpectation = &OrdersRepositoryMockListSkuBackordersExpectation{}
+	}
+
+	mmListSkuBackorders.defaultExpectation.params = &OrdersRepositoryMockListSkuBackordersParams{ctx, sku, sellerID}
+	for _, e := range mmListSkuBackorders.expectations {
+		if minimock.Equal(e.params, mmListSkuBackorders.defaultExpectation.params) {
+			mmListSkuBackorders.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListSkuBackorders.defaultExpectation.params)
+		}
+	}
+
+	return mmListSkuBackorders
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrdersRepository.ListSkuBackorders
+func (mmListSkuBackorders *mOrdersRepositoryMockListSkuBackorders) Inspect(f func(ctx context.Context, sku uint32, sellerID int64)) *mOrdersRepositoryMockListSkuBackorders {
+	if mmListSkuBackorders.mock.inspectFuncListSkuBackorders != nil {
+		mmListSkuBackorders.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.ListSkuBackorders")
+	}
+
+	mmListSkuBackorders.mock.inspectFuncListSkuBackorders = f
+
+	return mmListSkuBackorders
+}
+
+// Return sets up results that will be returned by OrdersRepository.ListSkuBackorders
+func (mmListSkuBackorders *mOrdersRepositoryMockListSkuBackorders) Return(ba1 []Backorder, err error) *OrdersRepositoryMock {
+	if mmListSkuBackorders.mock.funcListSkuBackorders != nil {
+		mmListSkuBackorders.mock.t.Fatalf("OrdersRepositoryMock.ListSkuBackorders mock is already set by Set")
+	}
+
+	if mmListSkuBackorders.defaultExpectation == nil {
+		mmListSkuBackorders.defaultExpectation = &OrdersRepositoryMockListSkuBackordersExpectation{mock: mmListSkuBackorders.mock}
+	}
+	mmListSkuBackorders.defaultExpectation.results = &OrdersRepositoryMockListSkuBackordersResults{ba1, err}
+	return mmListSkuBackorders.mock
+}
+
+// Set uses given function f to mock the OrdersRepository.ListSkuBackorders method
+func (mmListSkuBackorders *mOrdersRepositoryMockListSkuBackorders) Set(f func(ctx context.Context, sku uint32, sellerID int64) (ba1 []Backorder, err error)) *OrdersRepositoryMock {
+	if mmListSkuBackorders.defaultExpectation != nil {
+		mmListSkuBackorders.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.ListSkuBackorders method")
+	}
+
+	if len(mmListSkuBackorders.expectations) > 0 {
+		mmListSkuBackorders.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.ListSkuBackorders method")
+	}
+
+	mmListSkuBackorders.mock.funcListSkuBackorders = f
+	return mmListSkuBackorders.mock
+}
+
+// When sets expectation for the OrdersRepository.ListSkuBackorders which will trigger the result defined by the following
+// Then helper
+func (mmListSkuBackorders *mOrdersRepositoryMockListSkuBackorders) When(ctx context.Context, sku uint32, sellerID int64) *OrdersRepositoryMockListSkuBackordersExpectation {
+	if mmListSkuBackorders.mock.funcListSkuBackorders != nil {
+		mmListSkuBackorders.mock.t.Fatalf("OrdersRepositoryMock.ListSkuBackorders mock is already set by Set")
+	}
+
+	expectation := &OrdersRepositoryMockListSkuBackordersExpectation{
+		mock:   mmListSkuBackorders.mock,
+		params: &OrdersRepositoryMockListSkuBackordersParams{ctx, sku, sellerID},
+	}
+	mmListSkuBackorders.expectations = append(mmListSkuBackorders.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrdersRepository.ListSkuBackorders return parameters for the expectation previously defined by the When method
+func (e *OrdersRepositoryMockListSkuBackordersExpectation) Then(ba1 []Backorder, err error) *OrdersRepositoryMock {
+	e.results = &OrdersRepositoryMockListSkuBackordersResults{ba1, err}
+	return e.mock
+}
+
+// ListSkuBackorders implements OrdersRepository
+func (mmListSkuBackorders *OrdersRepositoryMock) ListSkuBackorders(ctx context.Context, sku uint32, sellerID int64) (ba1 []Backorder, err error) {
+	mm_atomic.AddUint64(&mmListSkuBackorders.beforeListSkuBackordersCounter, 1)
+	defer mm_atomic.AddUint64(&mmListSkuBackorders.afterListSkuBackordersCounter, 1)
+
+	if mmListSkuBackorders.inspectFuncListSkuBackorders != nil {
+		mmListSkuBackorders.inspectFuncListSkuBackorders(ctx, sku, sellerID)
+	}
+
+	mm_params := &OrdersRepositoryMockListSkuBackordersParams{ctx, sku, sellerID}
+
+	// Record call args
+	mmListSkuBackorders.ListSkuBackordersMock.mutex.Lock()
+	mmListSkuBackorders.ListSkuBackordersMock.callArgs = append(mmListSkuBackorders.ListSkuBackordersMock.callArgs, mm_params)
+	mmListSkuBackorders.ListSkuBackordersMock.mutex.Unlock()
+
+	for _, e := range mmListSkuBackorders.ListSkuBackordersMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ba1, e.results.err
+		}
+	}
+
+	if mmListSkuBackorders.ListSkuBackordersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListSkuBackorders.ListSkuBackordersMock.defaultExpectation.Counter, 1)
+		mm_want := mmListSkuBackorders.ListSkuBackordersMock.defaultExpectation.params
+		mm_got := OrdersRepositoryMockListSkuBackordersParams{ctx, sku, sellerID}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListSkuBackorders.t.Errorf("OrdersRepositoryMock.ListSkuBackorders got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListSkuBackorders.ListSkuBackordersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListSkuBackorders.t.Fatal("No results are set for the OrdersRepositoryMock.ListSkuBackorders")
+		}
+		return (*mm_results).ba1, (*mm_results).err
+	}
+	if mmListSkuBackorders.funcListSkuBackorders != nil {
+		return mmListSkuBackorders.funcListSkuBackorders(ctx, sku, sellerID)
+	}
+	mmListSkuBackorders.t.Fatalf("Unexpected call to OrdersRepositoryMock.ListSkuBackorders. %v %v %v", ctx, sku, sellerID)
+	return
+}
+
+// ListSkuBackordersAfterCounter returns a count of finished OrdersRepositoryMock.ListSkuBackorders invocations
+func (mmListSkuBackorders *OrdersRepositoryMock) ListSkuBackordersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListSkuBackorders.afterListSkuBackordersCounter)
+}
+
+// ListSkuBackordersBeforeCounter returns a count of OrdersRepositoryMock.ListSkuBackorders invocations
+func (mmListSkuBackorders *OrdersRepositoryMock) ListSkuBackordersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListSkuBackorders.beforeListSkuBackordersCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrdersRepositoryMock.ListSkuBackorders.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListSkuBackorders *mOrdersRepositoryMockListSkuBackorders) Calls() []*OrdersRepositoryMockListSkuBackordersParams {
+	mmListSkuBackorders.mutex.RLock()
+
+	argCopy := make([]*OrdersRepositoryMockListSkuBackordersParams, len(mmListSkuBackorders.callArgs))
+	copy(argCopy, mmListSkuBackorders.callArgs)
+
+	mmListSkuBackorders.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListSkuBackordersDone returns true if the count of the ListSkuBackorders invocations corresponds
+// the number of defined expectations
+func (m *OrdersRepositoryMock) MinimockListSkuBackordersDone() bool {
+	for _, e := range m.ListSkuBackordersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListSkuBackordersMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterListSkuBackordersCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListSkuBackorders != nil && mm_atomic.LoadUint64(&m.afterListSkuBackordersCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockListSkuBackordersInspect logs each unmet expectation
+func (m *OrdersRepositoryMock) MinimockListSkuBackordersInspect() {
+	for _, e := range m.ListSkuBackordersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.ListSkuBackorders with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListSkuBackordersMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterListSkuBackordersCounter) < 1 {
+		if m.ListSkuBackordersMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OrdersRepositoryMock.ListSkuBackorders")
+		} else {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.ListSkuBackorders with params: %#v", *m.ListSkuBackordersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListSkuBackorders != nil && mm_atomic.LoadUint64(&m.afterListSkuBackordersCounter) < 1 {
+		m.t.Error("Expected call to OrdersRepositoryMock.ListSkuBackorders")
+	}
+}
+
+type mOrdersRepositoryMockListUserOrders struct {
+	mock               *OrdersRepositoryMock
+	defaultExpectation *OrdersRepositoryMockListUserOrdersExpectation
+	expectations       []*OrdersRepositoryMockListUserOrdersExpectation
+
+	callArgs []*OrdersRepositoryMockListUserOrdersParams
+	mutex    sync.RWMutex
+}
+
+// OrdersRepositoryMockListUserOrdersExpectation specifies expectation struct of the OrdersRepository.ListUserOrders
+type OrdersRepositoryMockListUserOrdersExpectation struct {
+	mock    *OrdersRepositoryMock
+	params  *OrdersRepositoryMockListUserOrdersParams
+	results *OrdersRepositoryMockListUserOrdersResults
+	Counter uint64
+}
+
+// OrdersRepositoryMockListUserOrdersParams contains parameters of the OrdersRepository.ListUserOrders
+type OrdersRepositoryMockListUserOrdersParams struct {
+	ctx   context.Context
+	query UserOrdersQuery
+}
+
+// OrdersRepositoryMockListUserOrdersResults contains results of the OrdersRepository.ListUserOrders
+type OrdersRepositoryMockListUserOrdersResults struct {
+	opa1 []*Order
+	err  error
+}
+
+// Expect sets up expected params for OrdersRepository.ListUserOrders
+func (mmListUserOrders *mOrdersRepositoryMockListUserOrders) Expect(ctx context.Context, query UserOrdersQuery) *mOrdersRepositoryMockListUserOrders {
+	if mmListUserOrders.mock.funcListUserOrders != nil {
+		mmListUserOrders.mock.t.Fatalf("OrdersRepositoryMock.ListUserOrders mock is already set by Set")
+	}
+
+	if mmListUserOrders.defaultExpectation == nil {
+		mmListUserOrders.defaultExpectation = &OrdersRepositoryMockListUserOrdersExpectation{}
+	}
+
+	mmListUserOrders.defaultExpectation.params = &OrdersRepositoryMockListUserOrdersParams{ctx, query}
+	for _, e := range mmListUserOrders.expectations {
+		if minimock.Equal(e.params, mmListUserOrders.defaultExpectation.params) {
+			mmListUserOrders.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListUserOrders.defaultExpectation.params)
+		}
+	}
+
+	return mmListUserOrders
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrdersRepository.ListUserOrders
+func (mmListUserOrders *mOrdersRepositoryMockListUserOrders) Inspect(f func(ctx context.Context, query UserOrdersQuery)) *mOrdersRepositoryMockListUserOrders {
+	if mmListUserOrders.mock.inspectFuncListUserOrders != nil {
+		mmListUserOrders.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.ListUserOrders")
+	}
+
+	mmListUserOrders.mock.inspectFuncListUserOrders = f
+
+	return mmListUserOrders
+}
+
+// Return sets up results that will be returned by OrdersRepository.ListUserOrders
+func (mmListUserOrders *mOrdersRepositoryMockListUserOrders) Return(opa1 []*Order, err error) *OrdersRepositoryMock {
+	if mmListUserOrders.mock.funcListUserOrders != nil {
+		mmListUserOrders.mock.t.Fatalf("OrdersRepositoryMock.ListUserOrders mock is already set by Set")
+	}
+
+	if mmListUserOrders.defaultExpectation == nil {
+		mmListUserOrders.defaultExpectation = &OrdersRepositoryMockListUserOrdersExpectation{mock: mmListUserOrders.mock}
+	}
+	mmListUserOrders.defaultExpectation.results = &OrdersRepositoryMockListUserOrdersResults{opa1, err}
+	return mmListUserOrders.mock
+}
+
+// Set uses given function f to mock the OrdersRepository.ListUserOrders method
+func (mmListUserOrders *mOrdersRepositoryMockListUserOrders) Set(f func(ctx context.Context, query UserOrdersQuery) (opa1 []*Order, err error)) *OrdersRepositoryMock {
+	if mmListUserOrders.defaultExpectation != nil {
+		mmListUserOrders.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.ListUserOrders method")
+	}
+
+	if len(mmListUserOrders.expectations) > 0 {
+		mmListUserOrders.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.ListUserOrders method")
+	}
+
+	mmListUserOrders.mock.funcListUserOrders = f
+	return mmListUserOrders.mock
+}
+
+// When sets expectation for the OrdersRepository.ListUserOrders which will trigger the result defined by the following
+// Then helper
+func (mmListUserOrders *mOrdersRepositoryMockListUserOrders) When(ctx context.Context, query UserOrdersQuery) *OrdersRepositoryMockListUserOrdersExpectation {
+	if mmListUserOrders.mock.funcListUserOrders != nil {
+		mmListUserOrders.mock.t.Fatalf("OrdersRepositoryMock.ListUserOrders mock is already set by Set")
+	}
+
+	expectation := &OrdersRepositoryMockListUserOrdersExpectation{
+		mock:   mmListUserOrders.mock,
+		params: &OrdersRepositoryMockListUserOrdersParams{ctx, query},
+	}
+	mmListUserOrders.expectations = append(mmListUserOrders.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrdersRepository.ListUserOrders return parameters for the expectation previously defined by the When method
+func (e *OrdersRepositoryMockListUserOrdersExpectation) Then(opa1 []*Order, err error) *OrdersRepositoryMock {
+	e.results = &OrdersRepositoryMockListUserOrdersResults{opa1, err}
+	return e.mock
+}
+
+// ListUserOrders implements OrdersRepository
+func (mmListUserOrders *OrdersRepositoryMock) ListUserOrders(ctx context.Context, query UserOrdersQuery) (opa1 []*Order, err error) {
+	mm_atomic.AddUint64(&mmListUserOrders.beforeListUserOrdersCounter, 1)
+	defer mm_atomic.AddUint64(&mmListUserOrders.afterListUserOrdersCounter, 1)
+
+	if mmListUserOrders.inspectFuncListUserOrders != nil {
+		mmListUserOrders.inspectFuncListUserOrders(ctx, query)
+	}
+
+	mm_params := &OrdersRepositoryMockListUserOrdersParams{ctx, query}
+
+	// Record call args
+	mmListUserOrders.ListUserOrdersMock.mutex.Lock()
+	mmListUserOrders.ListUserOrdersMock.callArgs = append(mmListUserOrders.ListUserOrdersMock.callArgs, mm_params)
+	mmListUserOrders.ListUserOrdersMock.mutex.Unlock()
+
+	for _, e := range mmListUserOrders.ListUserOrdersMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.opa1, e.results.err
+		}
+	}
+
+	if mmListUserOrders.ListUserOrdersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListUserOrders.ListUserOrdersMock.defaultExpectation.Counter, 1)
+		mm_want := mmListUserOrders.ListUserOrdersMock.defaultExpectation.params
+		mm_got := OrdersRepositoryMockListUserOrdersParams{ctx, query}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListUserOrders.t.Errorf("OrdersRepositoryMock.ListUserOrders got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListUserOrders.ListUserOrdersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListUserOrders.t.Fatal("No results are set for the OrdersRepositoryMock.ListUserOrders")
+		}
+		return (*mm_results).opa1, (*mm_results).err
+	}
+	if mmListUserOrders.funcListUserOrders != nil {
+		return mmListUserOrders.funcListUserOrders(ctx, query)
+	}
+	mmListUserOrders.t.Fatalf("Unexpected call to OrdersRepositoryMock.ListUserOrders. %v %v", ctx, query)
+	return
+}
+
+// ListUserOrdersAfterCounter returns a count of finished OrdersRepositoryMock.ListUserOrders invocations
+func (mmListUserOrders *OrdersRepositoryMock) ListUserOrdersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListUserOrders.afterListUserOrdersCounter)
+}
+
+// ListUserOrdersBeforeCounter returns a count of OrdersRepositoryMock.ListUserOrders invocations
+func (mmListUserOrders *OrdersRepositoryMock) ListUserOrdersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListUserOrders.beforeListUserOrdersCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrdersRepositoryMock.ListUserOrders.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListUserOrders *mOrdersRepositoryMockListUserOrders) Calls() []*OrdersRepositoryMockListUserOrdersParams {
+	mmListUserOrders.mutex.RLock()
+
+	argCopy := make([]*OrdersRepositoryMockListUserOrdersParams, len(mmListUserOrders.callArgs))
+	copy(argCopy, mmListUserOrders.callArgs)
+
+	mmListUserOrders.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListUserOrdersDone returns true if the count of the ListUserOrders invocations corresponds
+// the number of defined expectations
+func (m *OrdersRepositoryMock) MinimockListUserOrdersDone() bool {
+	for _, e := range m.ListUserOrdersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListUserOrdersMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterListUserOrdersCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListUserOrders != nil && mm_atomic.LoadUint64(&m.afterListUserOrdersCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockListUserOrdersInspect logs each unmet expectation
+func (m *OrdersRepositoryMock) MinimockListUserOrdersInspect() {
+	for _, e := range m.ListUserOrdersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.ListUserOrders with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListUserOrdersMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterListUserOrdersCounter) < 1 {
+		if m.ListUserOrdersMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OrdersRepositoryMock.ListUserOrders")
+		} else {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.ListUserOrders with params: %#v", *m.ListUserOrdersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListUserOrders != nil && mm_atomic.LoadUint64(&m.afterListUserOrdersCounter) < 1 {
+		m.t.Error("Expected call to OrdersRepositoryMock.ListUserOrders")
+	}
+}
+
+type mOrdersRepositoryMockReleaseExpiredHolds struct {
+	mock               *OrdersRepositoryMock
+	defaultExpectation *OrdersRepositoryMockReleaseExpiredHoldsExpectation
+	expectations       []*OrdersRepositoryMockReleaseExpiredHoldsExpectation
+
+	callArgs []*OrdersRepositoryMockReleaseExpiredHoldsParams
+	mutex    sync.RWMutex
+}
+
+// OrdersRepositoryMockReleaseExpiredHoldsExpectation specifies expectation struct of the OrdersRepository.ReleaseExpiredHolds
+type OrdersRepositoryMockReleaseExpiredHoldsExpectation struct {
+	mock    *OrdersRepositoryMock
+	params  *OrdersRepositoryMockReleaseExpiredHoldsParams
+	results *OrdersRepositoryMockReleaseExpiredHoldsResults
+	Counter uint64
+}
+
+// OrdersRepositoryMockReleaseExpiredHoldsParams contains parameters of the OrdersRepository.ReleaseExpiredHolds
+type OrdersRepositoryMockReleaseExpiredHoldsParams struct {
+	ctx context.Context
+	now time.Time
+}
+
+// OrdersRepositoryMockReleaseExpiredHoldsResults contains results of the OrdersRepository.ReleaseExpiredHolds
+type OrdersRepositoryMockReleaseExpiredHoldsResults struct {
+	ra1 []ReservedItem
+	err error
+}
+
+// Expect sets up expected params for OrdersRepository.ReleaseExpiredHolds
+func (mmReleaseExpiredHolds *mOrdersRepositoryMockReleaseExpiredHolds) Expect(ctx context.Context, now time.Time) *mOrdersRepositoryMockReleaseExpiredHolds {
+	if mmReleaseExpiredHolds.mock.funcReleaseExpiredHolds != nil {
+		mmReleaseExpiredHolds.mock.t.Fatalf("OrdersRepositoryMock.ReleaseExpiredHolds mock is already set by Set")
+	}
+
+	if mmReleaseExpiredHolds.defaultExpectation == nil {
+		mmReleaseExpiredHolds.defaultExpectation = &OrdersRepositoryMockReleaseExpiredHoldsExpectation{}
+	}
+
+	mmReleaseExpiredHolds.defaultExpectation.params = &OrdersRepositoryMockReleaseExpiredHoldsParams{ctx, now}
+	for _, e := range mmReleaseExpiredHolds.expectations {
+		if minimock.Equal(e.params, mmReleaseExpiredHolds.defaultExpectation.params) {
+			mmReleaseExpiredHolds.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReleaseExpiredHolds.defaultExpectation.params)
+		}
+	}
+
+	return mmReleaseExpiredHolds
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrdersRepository.ReleaseExpiredHolds
+func (mmReleaseExpiredHolds *mOrdersRepositoryMockReleaseExpiredHolds) Inspect(f func(ctx context.Context, now time.Time)) *mOrdersRepositoryMockReleaseExpiredHolds {
+	if mmReleaseExpiredHolds.mock.inspectFuncReleaseExpiredHolds != nil {
+		mmReleaseExpiredHolds.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.ReleaseExpiredHolds")
+	}
+
+	mmReleaseExpiredHolds.mock.inspectFuncReleaseExpiredHolds = f
+
+	return mmReleaseExpiredHolds
+}
+
+// Return sets up results that will be returned by OrdersRepository.ReleaseExpiredHolds
+func (mmReleaseExpiredHolds *mOrdersRepositoryMockReleaseExpiredHolds) Return(ra1 []ReservedItem, err error) *OrdersRepositoryMock {
+	if mmReleaseExpiredHolds.mock.funcReleaseExpiredHolds != nil {
+		mmReleaseExpiredHolds.mock.t.Fatalf("OrdersRepositoryMock.ReleaseExpiredHolds mock is already set by Set")
+	}
+
+	if mmReleaseExpiredHolds.defaultExpectation == nil {
+		mmReleaseExpiredHolds.defaultExpectation = &OrdersRepositoryMockReleaseExpiredHoldsExpectation{mock: mmReleaseExpiredHolds.mock}
+	}
+	mmReleaseExpiredHolds.defaultExpectation.results = &OrdersRepositoryMockReleaseExpiredHoldsResults{ra1, err}
+	return mmReleaseExpiredHolds.mock
+}
+
+// Set uses given function f to mock the OrdersRepository.ReleaseExpiredHolds method
+func (mmReleaseExpiredHolds *mOrdersRepositoryMockReleaseExpiredHolds) Set(f func(ctx context.Context, now time.Time) (ra1 []ReservedItem, err error)) *OrdersRepositoryMock {
+	if mmReleaseExpiredHolds.defaultExpectation != nil {
+		mmReleaseExpiredHolds.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.ReleaseExpiredHolds method")
+	}
+
+	if len(mmReleaseExpiredHolds.expectations) > 0 {
+		mmReleaseExpiredHolds.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.ReleaseExpiredHolds method")
+	}
+
+	mmReleaseExpiredHolds.mock.funcReleaseExpiredHolds = f
+	return mmReleaseExpiredHolds.mock
+}
+
+// When sets expectation for the OrdersRepository.ReleaseExpiredHolds which will trigger the result defined by the following
+// Then helper
+func (mmReleaseExpiredHolds *mOrdersRepositoryMockReleaseExpiredHolds) When(ctx context.Context, now time.Time) *OrdersRepositoryMockReleaseExpiredHoldsExpectation {
+	if mmReleaseExpiredHolds.mock.funcReleaseExpiredHolds != nil {
+		mmReleaseExpiredHolds.mock.t.Fatalf("OrdersRepositoryMock.ReleaseExpiredHolds mock is already set by Set")
+	}
+
+	expectation := &OrdersRepositoryMockReleaseExpiredHoldsExpectation{
+		mock:   mmReleaseExpiredHolds.mock,
+		params: &OrdersRepositoryMockReleaseExpiredHoldsParams{ctx, now},
+	}
+	mmReleaseExpiredHolds.expectations = append(mmReleaseExpiredHolds.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrdersRepository.ReleaseExpiredHolds return parameters for the expectation previously defined by the When method
+func (e *OrdersRepositoryMockReleaseExpiredHoldsExpectation) Then(ra1 []ReservedItem, err error) *OrdersRepositoryMock {
+	e.results = &OrdersRepositoryMockReleaseExpiredHoldsResults{ra1, err}
+	return e.mock
+}
+
+// ReleaseExpiredHolds implements OrdersRepository
+func (mmReleaseExpiredHolds *OrdersRepositoryMock) ReleaseExpiredHolds(ctx context.Context, now time.Time) (ra1 []ReservedItem, err error) {
+	mm_atomic.AddUint64(&mmReleaseExpiredHolds.beforeReleaseExpiredHoldsCounter, 1)
+	defer mm_atomic.AddUint64(&mmReleaseExpiredHolds.afterReleaseExpiredHoldsCounter, 1)
+
+	if mmReleaseExpiredHolds.inspectFuncReleaseExpiredHolds != nil {
+		mmReleaseExpiredHolds.inspectFuncReleaseExpiredHolds(ctx, now)
+	}
+
+	mm_params := &OrdersRepositoryMockReleaseExpiredHoldsParams{ctx, now}
+
+	// Record call args
+	mmReleaseExpiredHolds.ReleaseExpiredHoldsMock.mutex.Lock()
+	mmReleaseExpiredHolds.ReleaseExpiredHoldsMock.callArgs = append(mmReleaseExpiredHolds.ReleaseExpiredHoldsMock.callArgs, mm_params)
+	mmReleaseExpiredHolds.ReleaseExpiredHoldsMock.mutex.Unlock()
+
+	for _, e := range mmReleaseExpiredHolds.ReleaseExpiredHoldsMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ra1, e.results.err
+		}
+	}
+
+	if mmReleaseExpiredHolds.ReleaseExpiredHoldsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReleaseExpiredHolds.ReleaseExpiredHoldsMock.defaultExpectation.Counter, 1)
+		mm_want := mmReleaseExpiredHolds.ReleaseExpiredHoldsMock.defaultExpectation.params
+		mm_got := OrdersRepositoryMockReleaseExpiredHoldsParams{ctx, now}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReleaseExpiredHolds.t.Errorf("OrdersRepositoryMock.ReleaseExpiredHolds got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReleaseExpiredHolds.ReleaseExpiredHoldsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReleaseExpiredHolds.t.Fatal("No results are set for the OrdersRepositoryMock.ReleaseExpiredHolds")
+		}
+		return (*mm_results).ra1, (*mm_results).err
+	}
+	if mmReleaseExpiredHolds.funcReleaseExpiredHolds != nil {
+		return mmReleaseExpiredHolds.funcReleaseExpiredHolds(ctx, now)
+	}
+	mmReleaseExpiredHolds.t.Fatalf("Unexpected call to OrdersRepositoryMock.ReleaseExpiredHolds. %v %v", ctx, now)
+	return
+}
+
+// ReleaseExpiredHoldsAfterCounter returns a count of finished OrdersRepositoryMock.ReleaseExpiredHolds invocations
+func (mmReleaseExpiredHolds *OrdersRepositoryMock) ReleaseExpiredHoldsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReleaseExpiredHolds.afterReleaseExpiredHoldsCounter)
+}
+
+// ReleaseExpiredHoldsBeforeCounter returns a count of OrdersRepositoryMock.ReleaseExpiredHolds invocations
+func (mmReleaseExpiredHolds *OrdersRepositoryMock) ReleaseExpiredHoldsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReleaseExpiredHolds.beforeReleaseExpiredHoldsCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrdersRepositoryMock.ReleaseExpiredHolds.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReleaseExpiredHolds *mOrdersRepositoryMockReleaseExpiredHolds) Calls() []*OrdersRepositoryMockReleaseExpiredHoldsParams {
+	mmReleaseExpiredHolds.mutex.RLock()
+
+	argCopy := make([]*OrdersRepositoryMockReleaseExpiredHoldsParams, len(mmReleaseExpiredHolds.callArgs))
+	copy(argCopy, mmReleaseExpiredHolds.callArgs)
+
+	mmReleaseExpiredHolds.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReleaseExpiredHoldsDone returns true if the count of the ReleaseExpiredHolds invocations corresponds
+// the number of defined expectations
+func (m *OrdersRepositoryMock) MinimockReleaseExpiredHoldsDone() bool {
+	for _, e := range m.ReleaseExpiredHoldsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReleaseExpiredHoldsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterReleaseExpiredHoldsCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReleaseExpiredHolds != nil && mm_atomic.LoadUint64(&m.afterReleaseExpiredHoldsCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockReleaseExpiredHoldsInspect logs each unmet expectation
+func (m *OrdersRepositoryMock) MinimockReleaseExpiredHoldsInspect() {
+	for _, e := range m.ReleaseExpiredHoldsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.ReleaseExpiredHolds with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReleaseExpiredHoldsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterReleaseExpiredHoldsCounter) < 1 {
+		if m.ReleaseExpiredHoldsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OrdersRepositoryMock.ReleaseExpiredHolds")
+		} else {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.ReleaseExpiredHolds with params: %#v", *m.ReleaseExpiredHoldsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReleaseExpiredHolds != nil && mm_atomic.LoadUint64(&m.afterReleaseExpiredHoldsCounter) < 1 {
+		m.t.Error("Expected call to OrdersRepositoryMock.ReleaseExpiredHolds")
+	}
+}
+
+type mOrdersRepositoryMockReleaseHolds struct {
+	mock               *OrdersRepositoryMock
+	defaultExpectation *OrdersRepositoryMockReleaseHoldsExpectation
+	expectations       []*OrdersRepositoryMockReleaseHoldsExpectation
+
+	callArgs []*OrdersRepositoryMockReleaseHoldsParams
+	mutex    sync.RWMutex
+}
+
+// OrdersRepositoryMockReleaseHoldsExpectation specifies expectation struct of the OrdersRepository.ReleaseHolds
+type OrdersRepositoryMockReleaseHoldsExpectation struct {
+	mock    *OrdersRepositoryMock
+	params  *OrdersRepositoryMockReleaseHoldsParams
+	results *OrdersRepositoryMockReleaseHoldsResults
+	Counter uint64
+}
+
+// OrdersRepositoryMockReleaseHoldsParams contains parameters of the OrdersRepository.ReleaseHolds
+type OrdersRepositoryMockReleaseHoldsParams struct {
+	ctx  context.Context
+	user int64
+	skus []uint32
+}
+
+// OrdersRepositoryMockReleaseHoldsResults contains results of the OrdersRepository.ReleaseHolds
+type OrdersRepositoryMockReleaseHoldsResults struct {
+	ra1 []ReservedItem
+	err error
+}
+
+// Expect sets up expected params for OrdersRepository.ReleaseHolds
+func (mmReleaseHolds *mOrdersRepositoryMockReleaseHolds) Expect(ctx context.Context, user int64, skus []uint32) *mOrdersRepositoryMockReleaseHolds {
+	if mmReleaseHolds.mock.funcReleaseHolds != nil {
+		mmReleaseHolds.mock.t.Fatalf("OrdersRepositoryMock.ReleaseHolds mock is already set by Set")
+	}
+
+	if mmReleaseHolds.defaultExpectation == nil {
+		mmReleaseHolds.defaultExpectation = &OrdersRepositoryMockReleaseHoldsExpectation{}
+	}
+
+	mmReleaseHolds.defaultExpectation.params = &OrdersRepositoryMockReleaseHoldsParams{ctx, user, skus}
+	for _, e := range mmReleaseHolds.expectations {
+		if minimock.Equal(e.params, mmReleaseHolds.defaultExpectation.params) {
+			mmReleaseHolds.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReleaseHolds.defaultExpectation.params)
+		}
+	}
+
+	return mmReleaseHolds
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrdersRepository.ReleaseHolds
+func (mmReleaseHolds *mOrdersRepositoryMockReleaseHolds) Inspect(f func(ctx context.Context, user int64, skus []uint32)) *mOrdersRepositoryMockReleaseHolds {
+	if mmReleaseHolds.mock.inspectFuncReleaseHolds != nil {
+		mmReleaseHolds.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.ReleaseHolds")
+	}
+
+	mmReleaseHolds.mock.inspectFuncReleaseHolds = f
+
+	return mmReleaseHolds
+}
+
+// Return sets up results that will be returned by OrdersRepository.ReleaseHolds
+func (mmReleaseHolds *mOrdersRepositoryMockReleaseHolds) Return(ra1 []ReservedItem, err error) *OrdersRepositoryMock {
+	if mmReleaseHolds.mock.funcReleaseHolds != nil {
+		mmReleaseHolds.mock.t.Fatalf("OrdersRepositoryMock.ReleaseHolds mock is already set by Set")
+	}
+
+	if mmReleaseHolds.defaultExpectation == nil {
+		mmReleaseHolds.defaultExpectation = &OrdersRepositoryMockReleaseHoldsExpectation{mock: mmReleaseHolds.mock}
+	}
+	mmReleaseHolds.defaultExpectation.results = &OrdersRepositoryMockReleaseHoldsResults{ra1, err}
+	return mmReleaseHolds.mock
+}
+
+// Set uses given function f to mock the OrdersRepository.ReleaseHolds method
+func (mmReleaseHolds *mOrdersRepositoryMockReleaseHolds) Set(f func(ctx context.Context, user int64, skus []uint32) (ra1 []ReservedItem, err error)) *OrdersRepositoryMock {
+	if mmReleaseHolds.defaultExpectation != nil {
+		mmReleaseHolds.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.ReleaseHolds method")
+	}
+
+	if len(mmReleaseHolds.expectations) > 0 {
+		mmReleaseHolds.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.ReleaseHolds method")
+	}
+
+	mmReleaseHolds.mock.funcReleaseHolds = f
+	return mmReleaseHolds.mock
+}
+
+// When sets expectation for the OrdersRepository.ReleaseHolds which will trigger the result defined by the following
+// Then helper
+func (mmReleaseHolds *mOrdersRepositoryMockReleaseHolds) When(ctx context.Context, user int64, skus []uint32) *OrdersRepositoryMockReleaseHoldsExpectation {
+	if mmReleaseHolds.mock.funcReleaseHolds != nil {
+		mmReleaseHolds.mock.t.Fatalf("OrdersRepositoryMock.ReleaseHolds mock is already set by Set")
+	}
+
+	expectation := &OrdersRepositoryMockReleaseHoldsExpectation{
+		mock:   mmReleaseHolds.mock,
+		params: &OrdersRepositoryMockReleaseHoldsParams{ctx, user, skus},
+	}
+	mmReleaseHolds.expectations = append(mmReleaseHolds.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrdersRepository.ReleaseHolds return parameters for the expectation previously defined by the When method
+func (e *OrdersRepositoryMockReleaseHoldsExpectation) Then(ra1 []ReservedItem, err error) *OrdersRepositoryMock {
+	e.results = &OrdersRepositoryMockReleaseHoldsResults{ra1, err}
+	return e.mock
+}
+
+// ReleaseHolds implements OrdersRepository
+func (mmReleaseHolds *OrdersRepositoryMock) ReleaseHolds(ctx context.Context, user int64, skus []uint32) (ra1 []ReservedItem, err error) {
+	mm_atomic.AddUint64(&mmReleaseHolds.beforeReleaseHoldsCounter, 1)
+	defer mm_atomic.AddUint64(&mmReleaseHolds.afterReleaseHoldsCounter, 1)
+
+	if mmReleaseHolds.inspectFuncReleaseHolds != nil {
+		mmReleaseHolds.inspectFuncReleaseHolds(ctx, user, skus)
+	}
+
+	mm_params := &OrdersRepositoryMockReleaseHoldsParams{ctx, user, skus}
+
+	// Record call args
+	mmReleaseHolds.ReleaseHoldsMock.mutex.Lock()
+	mmReleaseHolds.ReleaseHoldsMock.callArgs = append(mmReleaseHolds.ReleaseHoldsMock.callArgs, mm_params)
+	mmReleaseHolds.ReleaseHoldsMock.mutex.Unlock()
+
+	for _, e := range mmReleaseHolds.ReleaseHoldsMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ra1, e.results.err
+		}
+	}
+
+	if mmReleaseHolds.ReleaseHoldsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReleaseHolds.ReleaseHoldsMock.defaultExpectation.Counter, 1)
+		mm_want := mmReleaseHolds.ReleaseHoldsMock.defaultExpectation.params
+		mm_got := OrdersRepositoryMockReleaseHoldsParams{ctx, user, skus}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReleaseHolds.t.Errorf("OrdersRepositoryMock.ReleaseHolds got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReleaseHolds.ReleaseHoldsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReleaseHolds.t.Fatal("No results are set for the OrdersRepositoryMock.ReleaseHolds")
+		}
+		return (*mm_results).ra1, (*mm_results).err
+	}
+	if mmReleaseHolds.funcReleaseHolds != nil {
+		return mmReleaseHolds.funcReleaseHolds(ctx, user, skus)
+	}
+	mmReleaseHolds.t.Fatalf("Unexpected call to OrdersRepositoryMock.ReleaseHolds. %v %v %v", ctx, user, skus)
+	return
+}
+
+// ReleaseHoldsAfterCounter returns a count of finished OrdersRepositoryMock.ReleaseHolds invocations
+func (mmReleaseHolds *OrdersRepositoryMock) ReleaseHoldsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReleaseHolds.afterReleaseHoldsCounter)
+}
+
+// ReleaseHoldsBeforeCounter returns a count of OrdersRepositoryMock.ReleaseHolds invocations
+func (mmReleaseHolds *OrdersRepositoryMock) ReleaseHoldsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReleaseHolds.beforeReleaseHoldsCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrdersRepositoryMock.ReleaseHolds.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReleaseHolds *mOrdersRepositoryMockReleaseHolds) Calls() []*OrdersRepositoryMockReleaseHoldsParams {
+	mmReleaseHolds.mutex.RLock()
+
+	argCopy := make([]*OrdersRepositoryMockReleaseHoldsParams, len(mmReleaseHolds.callArgs))
+	copy(argCopy, mmReleaseHolds.callArgs)
+
+	mmReleaseHolds.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReleaseHoldsDone returns true if the count of the ReleaseHolds invocations corresponds
+// the number of defined expectations
+func (m *OrdersRepositoryMock) MinimockReleaseHoldsDone() bool {
+	for _, e := range m.ReleaseHoldsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReleaseHoldsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterReleaseHoldsCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReleaseHolds != nil && mm_atomic.LoadUint64(&m.afterReleaseHoldsCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockReleaseHoldsInspect logs each unmet expectation
+func (m *OrdersRepositoryMock) MinimockReleaseHoldsInspect() {
+	for _, e := range m.ReleaseHoldsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.ReleaseHolds with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReleaseHoldsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterReleaseHoldsCounter) < 1 {
+		if m.ReleaseHoldsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OrdersRepositoryMock.ReleaseHolds")
+		} else {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.ReleaseHolds with params: %#v", *m.ReleaseHoldsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReleaseHolds != nil && mm_atomic.LoadUint64(&m.afterReleaseHoldsCounter) < 1 {
+		m.t.Error("Expected call to OrdersRepositoryMock.ReleaseHolds")
+	}
+}
+
+type mOrdersRepositoryMockRemoveSoldItems struct {
+	mock               *OrdersRepositoryMock
+	defaultExpectation *OrdersRepositoryMockRemoveSoldItemsExpectation
+	expectations       []*OrdersRepositoryMockRemoveSoldItemsExpectation
+
+	callArgs []*OrdersRepositoryMockRemoveSoldItemsParams
+	mutex    sync.RWMutex
+}
+
+// OrdersRepositoryMockRemoveSoldItemsExpectation specifies expectation struct of the OrdersRepository.RemoveSoldItems
+type OrdersRepositoryMockRemoveSoldItemsExpectation struct {
+	mock    *OrdersRepositoryMock
+	params  *OrdersRepositoryMockRemoveSoldItemsParams
+	results *OrdersRepositoryMockRemoveSoldItemsResults
+	Counter uint64
+}
+
+// OrdersRepositoryMockRemoveSoldItemsParams contains parameters of the OrdersRepository.RemoveSoldItems
+type OrdersRepositoryMockRemoveSoldItemsParams struct {
+	ctx     context.Context
+	orderID int64
+}
+
+// OrdersRepositoryMockRemoveSoldItemsResults contains results of the OrdersRepository.RemoveSoldItems
+type OrdersRepositoryMockRemoveSoldItemsResults struct {
+	err error
+}
+
+// Expect sets up expected params for OrdersRepository.RemoveSoldItems
+func (mmRemoveSoldItems *mOrdersRepositoryMockRemoveSoldItems) Expect(ctx context.Context, orderID int64) *mOrdersRepositoryMockRemoveSoldItems {
+	if mmRemoveSoldItems.mock.funcRemoveSoldItems != nil {
+		mmRemoveSoldItems.mock.t.Fatalf("OrdersRepositoryMock.RemoveSoldItems mock is already set by Set")
+	}
+
+	if mmRemoveSoldItems.defaultExpectation == nil {
+		mmRemoveSoldItems.defaultExpectation = &OrdersRepositoryMockRemoveSoldItemsExpectation{}
+	}
+
+	mmRemoveSoldItems.defaultExpectation.params = &OrdersRepositoryMockRemoveSoldItemsParams{ctx, orderID}
+	for _, e := range mmRemoveSoldItems.expectations {
+		if minimock.Equal(e.params, mmRemoveSoldItems.defaultExpectation.params) {
+			mmRemoveSoldItems.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemoveSoldItems.defaultExpectation.params)
+		}
+	}
+
+	return mmRemoveSoldItems
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrdersRepository.RemoveSoldItems
+func (mmRemoveSoldItems *mOrdersRepositoryMockRemoveSoldItems) Inspect(f func(ctx context.Context, orderID int64)) *mOrdersRepositoryMockRemoveSoldItems {
+	if mmRemoveSoldItems.mock.inspectFuncRemoveSoldItems != nil {
+		mmRemoveSoldItems.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.RemoveSoldItems")
+	}
+
+	mmRemoveSoldItems.mock.inspectFuncRemoveSoldItems = f
+
+	return mmRemoveSoldItems
+}
+
+// Return sets up results that will be returned by OrdersRepository.RemoveSoldItems
+func (mmRemoveSoldItems *mOrdersRepositoryMockRemoveSoldItems) Return(err error) *OrdersRepositoryMock {
+	if mmRemoveSoldItems.mock.funcRemoveSoldItems != nil {
+		mmRemoveSoldItems.mock.t.Fatalf("OrdersRepositoryMock.RemoveSoldItems mock is already set by Set")
+	}
+
+	if mmRemoveSoldItems.defaultExpectation == nil {
+		mmRemoveSoldItems.defaultExpectation = &OrdersRepositoryMockRemoveSoldItemsExpectation{mock: mmRemoveSoldItems.mock}
+	}
+	mmRemoveSoldItems.defaultExpectation.results = &OrdersRepositoryMockRemoveSoldItemsResults{err}
+	return mmRemoveSoldItems.mock
+}
+
+// Set uses given function f to mock the OrdersRepository.RemoveSoldItems method
+func (mmRemoveSoldItems *mOrdersRepositoryMockRemoveSoldItems) Set(f func(ctx context.Context, orderID int64) (err error)) *OrdersRepositoryMock {
+	if mmRemoveSoldItems.defaultExpectation != nil {
+		mmRemoveSoldItems.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.RemoveSoldItems method")
+	}
+
+	if len(mmRemoveSoldItems.expectations) > 0 {
+		mmRemoveSoldItems.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.RemoveSoldItems method")
+	}
+
+	mmRemoveSoldItems.mock.funcRemoveSoldItems = f
+	return mmRemoveSoldItems.mock
+}
+
+// When sets expectation for the OrdersRepository.RemoveSoldItems which will trigger the result defined by the following
+// Then helper
+func (mmRemoveSoldItems *mOrdersRepositoryMockRemoveSoldItems) When(ctx context.Context, orderID int64) *OrdersRepositoryMockRemoveSoldItemsExpectation {
+	if mmRemoveSoldItems.mock.funcRemoveSoldItems != nil {
+		mmRemoveSoldItems.mock.t.Fatalf("OrdersRepositoryMock.RemoveSoldItems mock is already set by Set")
+	}
+
+	expectation := &OrdersRepositoryMockRemoveSoldItemsExpectation{
+		mock:   mmRemoveSoldItems.mock,
+		params: &OrdersRepositoryMockRemoveSoldItemsParams{ctx, orderID},
+	}
+	mmRemoveSoldItems.expectations = append(mmRemoveSoldItems.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrdersRepository.RemoveSoldItems return parameters for the expectation previously defined by the When method
+func (e *OrdersRepositoryMockRemoveSoldItemsExpectation) Then(err error) *OrdersRepositoryMock {
+	e.results = &OrdersRepositoryMockRemoveSoldItemsResults{err}
+	return e.mock
+}
+
+// RemoveSoldItems implements OrdersRepository
+func (mmRemoveSoldItems *OrdersRepositoryMock) RemoveSoldItems(ctx context.Context, orderID int64) (err error) {
+	mm_atomic.AddUint64(&mmRemoveSoldItems.beforeRemoveSoldItemsCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemoveSoldItems.afterRemoveSoldItemsCounter, 1)
+
+	if mmRemoveSoldItems.inspectFuncRemoveSoldItems != nil {
+		mmRemoveSoldItems.inspectFuncRemoveSoldItems(ctx, orderID)
+	}
+
+	mm_params := &OrdersRepositoryMockRemoveSoldItemsParams{ctx, orderID}
+
+	// Record call args
+	mmRemoveSoldItems.RemoveSoldItemsMock.mutex.Lock()
+	mmRemoveSoldItems.RemoveSoldItemsMock.callArgs = append(mmRemoveSoldItems.RemoveSoldItemsMock.callArgs, mm_params)
+	mmRemoveSoldItems.RemoveSoldItemsMock.mutex.Unlock()
+
+	for _, e := range mmRemoveSoldItems.RemoveSoldItemsMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRemoveSoldItems.RemoveSoldItemsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemoveSoldItems.RemoveSoldItemsMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemoveSoldItems.RemoveSoldItemsMock.defaultExpectation.params
+		mm_got := OrdersRepositoryMockRemoveSoldItemsParams{ctx, orderID}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemoveSoldItems.t.Errorf("OrdersRepositoryMock.RemoveSoldItems got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemoveSoldItems.RemoveSoldItemsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemoveSoldItems.t.Fatal("No results are set for the OrdersRepositoryMock.RemoveSoldItems")
+		}
+		return (*mm_results).err
+	}
+	if mmRemoveSoldItems.funcRemoveSoldItems != nil {
+		return mmRemoveSoldItems.funcRemoveSoldItems(ctx, orderID)
+	}
+	mmRemoveSoldItems.t.Fatalf("Unexpected call to OrdersRepositoryMock.RemoveSoldItems. %v %v", ctx, orderID)
+	return
+}
+
+// RemoveSoldItemsAfterCounter returns a count of finished OrdersRepositoryMock.RemoveSoldItems invocations
+func (mmRemoveSoldItems *OrdersRepositoryMock) RemoveSoldItemsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveSoldItems.afterRemoveSoldItemsCounter)
+}
+
+// RemoveSoldItemsBeforeCounter returns a count of OrdersRepositoryMock.RemoveSoldItems invocations
+func (mmRemoveSoldItems *OrdersRepositoryMock) RemoveSoldItemsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveSoldItems.beforeRemoveSoldItemsCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrdersRepositoryMock.RemoveSoldItems.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemoveSoldItems *mOrdersRepositoryMockRemoveSoldItems) Calls() []*OrdersRepositoryMockRemoveSoldItemsParams {
+	mmRemoveSoldItems.mutex.RLock()
+
+	argCopy := make([]*OrdersRepositoryMockRemoveSoldItemsParams, len(mmRemoveSoldItems.callArgs))
+	copy(argCopy, mmRemoveSoldItems.callArgs)
+
+	mmRemoveSoldItems.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemoveSoldItemsDone returns true if the count of the RemoveSoldItems invocations corresponds
+// the number of defined expectations
+func (m *OrdersRepositoryMock) MinimockRemoveSoldItemsDone() bool {
+	for _, e := range m.RemoveSoldItemsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveSoldItemsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRemoveSoldItemsCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveSoldItems != nil && mm_atomic.LoadUint64(&m.afterRemoveSoldItemsCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockRemoveSoldItemsInspect logs each unmet expectation
+func (m *OrdersRepositoryMock) MinimockRemoveSoldItemsInspect() {
+	for _, e := range m.RemoveSoldItemsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.RemoveSoldItems with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveSoldItemsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRemoveSoldItemsCounter) < 1 {
+		if m.RemoveSoldItemsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OrdersRepositoryMock.RemoveSoldItems")
+		} else {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.RemoveSoldItems with params: %#v", *m.RemoveSoldItemsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveSoldItems != nil && mm_atomic.LoadUint64(&m.afterRemoveSoldItemsCounter) < 1 {
+		m.t.Error("Expected call to OrdersRepositoryMock.RemoveSoldItems")
+	}
+}
+
+type mOrdersRepositoryMockReserveStock struct {
+	mock               *OrdersRepositoryMock
+	defaultExpectation *OrdersRepositoryMockReserveStockExpectation
+	expectations       []*OrdersRepositoryMockReserveStockExpectation
+
+	callArgs []*OrdersRepositoryMockReserveStockParams
+	mutex    sync.RWMutex
+}
+
+// OrdersRepositoryMockReserveStockExpectation specifies expectation struct of the OrdersRepository.ReserveStock
+type OrdersRepositoryMockReserveStockExpectation struct {
+	mock    *OrdersRepositoryMock
+	params  *OrdersRepositoryMockReserveStockParams
+	results *OrdersRepositoryMockReserveStockResults
+	Counter uint64
+}
+
+// OrdersRepositoryMockReserveStockParams contains parameters of the OrdersRepository.ReserveStock
+type OrdersRepositoryMockReserveStockParams struct {
+	ctx     context.Context
+	orderID int64
+	item    ReservedItem
+}
+
+// OrdersRepositoryMockReserveStockResults contains results of the OrdersRepository.ReserveStock
+type OrdersRepositoryMockReserveStockResults struct {
+	err error
+}
+
+// Expect sets up expected params for OrdersRepository.ReserveStock
+func (mmReserveStock *mOrdersRepositoryMockReserveStock) Expect(ctx context.Context, orderID int64, item ReservedItem) *mOrdersRepositoryMockReserveStock {
+	if mmReserveStock.mock.funcReserveStock != nil {
+		mmReserveStock.mock.t.Fatalf("OrdersRepositoryMock.ReserveStock mock is already set by Set")
+	}
+
+	if mmReserveStock.defaultExpectation == nil {
+		mmReserveStock.defaultExpectation = &OrdersRepositoryMockReserveStockExpectation{}
+	}
+
+	mmReserveStock.defaultExpectation.params = &OrdersRepositoryMockReserveStockParams{ctx, orderID, item}
+	for _, e := range mmReserveStock.expectations {
+		if minimock.Equal(e.params, mmReserveStock.defaultExpectation.params) {
+			mmReserveStock.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReserveStock.defaultExpectation.params)
+		}
+	}
+
+	return mmReserveStock
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrdersRepository.ReserveStock
+func (mmReserveStock *mOrdersRepositoryMockReserveStock) Inspect(f func(ctx context.Context, orderID int64, item ReservedItem)) *mOrdersRepositoryMockReserveStock {
+	if mmReserveStock.mock.inspectFuncReserveStock != nil {
+		mmReserveStock.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.ReserveStock")
+	}
+
+	mmReserveStock.mock.inspectFuncReserveStock = f
+
+	return mmReserveStock
+}
+
+// Return sets up results that will be returned by OrdersRepository.ReserveStock
+func (mmReserveStock *mOrdersRepositoryMockReserveStock) Return(err error) *OrdersRepositoryMock {
+	if mmReserveStock.mock.funcReserveStock != nil {
+		mmReserveStock.mock.t.Fatalf("OrdersRepositoryMock.ReserveStock mock is already set by Set")
+	}
+
+	if mmReserveStock.defaultExpectation == nil {
+		mmReserveStock.defaultExpectation = &OrdersRepositoryMockReserveStockExpectation{mock: mmReserveStock.mock}
+	}
+	mmReserveStock.defaultExpectation.results = &OrdersRepositoryMockReserveStockResults{err}
+	return mmReserveStock.mock
+}
+
+// Set uses given function f to mock the OrdersRepository.ReserveStock method
+func (mmReserveStock *mOrdersRepositoryMockReserveStock) Set(f func(ctx context.Context, orderID int64, item ReservedItem) (err error)) *OrdersRepositoryMock {
+	if mmReserveStock.defaultExpectation != nil {
+		mmReserveStock.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.ReserveStock method")
+	}
+
+	if len(mmReserveStock.expectations) > 0 {
+		mmReserveStock.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.ReserveStock method")
+	}
+
+	mmReserveStock.mock.funcReserveStock = f
+	return mmReserveStock.mock
+}
+
+// When sets expectation for the OrdersRepository.ReserveStock which will trigger the result defined by the following
+// Then helper
+func (mmReserveStock *mOrdersRepositoryMockReserveStock) When(ctx context.Context, orderID int64, item ReservedItem) *OrdersRepositoryMockReserveStockExpectation {
+	if mmReserveStock.mock.funcReserveStock != nil {
+		mmReserveStock.mock.t.Fatalf("OrdersRepositoryMock.ReserveStock mock is already set by Set")
+	}
+
+	expectation := &OrdersRepositoryMockReserveStockExpectation{
+		mock:   mmReserveStock.mock,
+		params: &OrdersRepositoryMockReserveStockParams{ctx, orderID, item},
+	}
+	mmReserveStock.expectations = append(mmReserveStock.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrdersRepository.ReserveStock return parameters for the expectation previously defined by the When method
+func (e *OrdersRepositoryMockReserveStockExpectation) Then(err error) *OrdersRepositoryMock {
+	e.results = &OrdersRepositoryMockReserveStockResults{err}
+	return e.mock
+}
+
+// ReserveStock implements OrdersRepository
+func (mmReserveStock *OrdersRepositoryMock) ReserveStock(ctx context.Context, orderID int64, item ReservedItem) (err error) {
+	mm_atomic.AddUint64(&mmReserveStock.beforeReserveStockCounter, 1)
+	defer mm_atomic.AddUint64(&mmReserveStock.afterReserveStockCounter, 1)
+
+	if mmReserveStock.inspectFuncReserveStock != nil {
+		mmReserveStock.inspectFuncReserveStock(ctx, orderID, item)
+	}
+
+	mm_params := &OrdersRepositoryMockReserveStockParams{ctx, orderID, item}
+
+	// Record call args
+	mmReserveStock.ReserveStockMock.mutex.Lock()
+	mmReserveStock.ReserveStockMock.callArgs = append(mmReserveStock.ReserveStockMock.callArgs, mm_params)
+	mmReserveStock.ReserveStockMock.mutex.Unlock()
+
+	for _, e := range mmReserveStock.ReserveStockMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmReserveStock.ReserveStockMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReserveStock.ReserveStockMock.defaultExpectation.Counter, 1)
+		mm_want := mmReserveStock.ReserveStockMock.defaultExpectation.params
+		mm_got := OrdersRepositoryMockReserveStockParams{ctx, orderID, item}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReserveStock.t.Errorf("OrdersRepositoryMock.ReserveStock got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReserveStock.ReserveStockMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReserveStock.t.Fatal("No results are set for the OrdersRepositoryMock.ReserveStock")
+		}
+		return (*mm_results).err
+	}
+	if mmReserveStock.funcReserveStock != nil {
+		return mmReserveStock.funcReserveStock(ctx, orderID, item)
+	}
+	mmReserveStock.t.Fatalf("Unexpected call to OrdersRepositoryMock.ReserveStock. %v %v %v", ctx, orderID, item)
+	return
+}
+
+// ReserveStockAfterCounter returns a count of finished OrdersRepositoryMock.ReserveStock invocations
+func (mmReserveStock *OrdersRepositoryMock) ReserveStockAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReserveStock.afterReserveStockCounter)
+}
+
+// ReserveStockBeforeCounter returns a count of OrdersRepositoryMock.ReserveStock invocations
+func (mmReserveStock *OrdersRepositoryMock) ReserveStockBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReserveStock.beforeReserveStockCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrdersRepositoryMock.ReserveStock.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReserveStock *mOrdersRepositoryMockReserveStock) Calls() []*OrdersRepositoryMockReserveStockParams {
+	mmReserveStock.mutex.RLock()
+
+	argCopy := make([]*OrdersRepositoryMockReserveStockParams, len(mmReserveStock.callArgs))
+	copy(argCopy, mmReserveStock.callArgs)
+
+	mmReserveStock.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReserveStockDone returns true if the count of the ReserveStock invocations corresponds
+// the number of defined expectations
+func (m *OrdersRepositoryMock) MinimockReserveStockDone() bool {
+	for _, e := range m.ReserveStockMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReserveStockMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterReserveStockCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReserveStock != nil && mm_atomic.LoadUint64(&m.afterReserveStockCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockReserveStockInspect logs each unmet expectation
+func (m *OrdersRepositoryMock) MinimockReserveStockInspect() {
+	for _, e := range m.ReserveStockMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.ReserveStock with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReserveStockMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterReserveStockCounter) < 1 {
+		if m.ReserveStockMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OrdersRepositoryMock.ReserveStock")
+		} else {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.ReserveStock with params: %#v", *m.ReserveStockMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReserveStock != nil && mm_atomic.LoadUint64(&m.afterReserveStockCounter) < 1 {
+		m.t.Error("Expected call to OrdersRepositoryMock.ReserveStock")
+	}
+}
+
+type mOrdersRepositoryMockSetBackorderDeadline struct {
+	mock               *OrdersRepositoryMock
+	defaultExpectation *OrdersRepositoryMockSetBackorderDeadlineExpectation
+	expectations       []*OrdersRepositoryMockSetBackorderDeadlineExpectation
+
+	callArgs []*OrdersRepositoryMockSetBackorderDeadlineParams
+	mutex    sync.RWMutex
+}
+
+// OrdersRepositoryMockSetBackorderDeadlineExpectation specifies expectation struct of the OrdersRepository.SetBackorderDeadline
+type OrdersRepositoryMockSetBackorderDeadlineExpectation struct {
+	mock    *OrdersRepositoryMock
+	params  *OrdersRepositoryMockSetBackorderDeadlineParams
+	results *OrdersRepositoryMockSetBackorderDeadlineResults
+	Counter uint64
+}
+
+// OrdersRepositoryMockSetBackorderDeadlineParams contains parameters of the OrdersRepository.SetBackorderDeadline
+type OrdersRepositoryMockSetBackorderDeadlineParams struct {
+	ctx      context.Context
+	id       int64
+	deadline time.Time
+}
+
+// OrdersRepositoryMockSetBackorderDeadlineResults contains results of the OrdersRepository.SetBackorderDeadline
+type OrdersRepositoryMockSetBackorderDeadlineResults struct {
+	err error
+}
+
+// Expect sets up expected params for OrdersRepository.SetBackorderDeadline
+func (mmSetBackorderDeadline *mOrdersRepositoryMockSetBackorderDeadline) Expect(ctx context.Context, id int64, deadline time.Time) *mOrdersRepositoryMockSetBackorderDeadline {
+	if mmSetBackorderDeadline.mock.funcSetBackorderDeadline != nil {
+		mmSetBackorderDeadline.mock.t.Fatalf("OrdersRepositoryMock.SetBackorderDeadline mock is already set by Set")
+	}
+
+	if mmSetBackorderDeadline.defaultExpectation == nil {
+		mmSetBackorderDeadline.defaultExpectation = &OrdersRepositoryMockSetBackorderDeadlineExpectation{}
+	}
+
+	mmSetBackorderDeadline.defaultExpectation.params = &OrdersRepositoryMockSetBackorderDeadlineParams{ctx, id, deadline}
+	for _, e := range mmSetBackorderDeadline.expectations {
+		if minimock.Equal(e.params, mmSetBackorderDeadline.defaultExpectation.params) {
+			mmSetBackorderDeadline.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetBackorderDeadline.defaultExpectation.params)
+		}
+	}
+
+	return mmSetBackorderDeadline
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrdersRepository.SetBackorderDeadline
+func (mmSetBackorderDeadline *mOrdersRepositoryMockSetBackorderDeadline) Inspect(f func(ctx context.Context, id int64, deadline time.Time)) *mOrdersRepositoryMockSetBackorderDeadline {
+	if mmSetBackorderDeadline.mock.inspectFuncSetBackorderDeadline != nil {
+		mmSetBackorderDeadline.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.SetBackorderDeadline")
+	}
+
+	mmSetBackorderDeadline.mock.inspectFuncSetBackorderDeadline = f
+
+	return mmSetBackorderDeadline
+}
+
+// Return sets up results that will be returned by OrdersRepository.SetBackorderDeadline
+func (mmSetBackorderDeadline *mOrdersRepositoryMockSetBackorderDeadline) Return(err error) *OrdersRepositoryMock {
+	if mmSetBackorderDeadline.mock.funcSetBackorderDeadline != nil {
+		mmSetBackorderDeadline.mock.t.Fatalf("OrdersRepositoryMock.SetBackorderDeadline mock is already set by Set")
+	}
+
+	if mmSetBackorderDeadline.defaultExpectation == nil {
+		mmSetBackorderDeadline.defaultExpectation = &OrdersRepositoryMockSetBackorderDeadlineExpectation{mock: mmSetBackorderDeadline.mock}
+	}
+	mmSetBackorderDeadline.defaultExpectation.results = &OrdersRepositoryMockSetBackorderDeadlineResults{err}
+	return mmSetBackorderDeadline.mock
+}
+
+// Set uses given function f to mock the OrdersRepository.SetBackorderDeadline method
+func (mmSetBackorderDeadline *mOrdersRepositoryMockSetBackorderDeadline) Set(f func(ctx context.Context, id int64, deadline time.Time) (err error)) *OrdersRepositoryMock {
+	if mmSetBackorderDeadline.defaultExpectation != nil {
+		mmSetBackorderDeadline.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.SetBackorderDeadline method")
+	}
+
+	if len(mmSetBackorderDeadline.expectations) > 0 {
+		mmSetBackorderDeadline.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.SetBackorderDeadline method")
+	}
+
+	mmSetBackorderDeadline.mock.funcSetBackorderDeadline = f
+	return mmSetBackorderDeadline.mock
+}
+
+// When sets expectation for the OrdersRepository.SetBackorderDeadline which will trigger the result defined by the following
+// Then helper
+func (mmSetBackorderDeadline *mOrdersRepositoryMockSetBackorderDeadline) When(ctx context.Context, id int64, deadline time.Time) *OrdersRepositoryMockSetBackorderDeadlineExpectation {
+	if mmSetBackorderDeadline.mock.funcSetBackorderDeadline != nil {
+		mmSetBackorderDeadline.mock.t.Fatalf("OrdersRepositoryMock.SetBackorderDeadline mock is already set by Set")
+	}
+
+	expectation := &OrdersRepositoryMockSetBackorderDeadlineExpectation{
+		mock:   mmSetBackorderDeadline.mock,
+		params: &OrdersRepositoryMockSetBackorderDeadlineParams{ctx, id, deadline},
+	}
+	mmSetBackorderDeadline.expectations = append(mmSetBackorderDeadline.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrdersRepository.SetBackorderDeadline return parameters for the expectation previously defined by the When method
+func (e *OrdersRepositoryMockSetBackorderDeadlineExpectation) Then(err error) *OrdersRepositoryMock {
+	e.results = &OrdersRepositoryMockSetBackorderDeadlineResults{err}
+	return e.mock
+}
+
+// SetBackorderDeadline implements OrdersRepository
+func (mmSetBackorderDeadline *OrdersRepositoryMock) SetBackorderDeadline(ctx context.Context, id int64, deadline time.Time) (err error) {
+	mm_atomic.AddUint64(&mmSetBackorderDeadline.beforeSetBackorderDeadlineCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetBackorderDeadline.afterSetBackorderDeadlineCounter, 1)
+
+	if mmSetBackorderDeadline.inspectFuncSetBackorderDeadline != nil {
+		mmSetBackorderDeadline.inspectFuncSetBackorderDeadline(ctx, id, deadline)
+	}
+
+	mm_params := &OrdersRepositoryMockSetBackorderDeadlineParams{ctx, id, deadline}
+
+	// Record call args
+	mmSetBackorderDeadline.SetBackorderDeadlineMock.mutex.Lock()
+	mmSetBackorderDeadline.SetBackorderDeadlineMock.callArgs = append(mmSetBackorderDeadline.SetBackorderDeadlineMock.callArgs, mm_params)
+	mmSetBackorderDeadline.SetBackorderDeadlineMock.mutex.Unlock()
+
+	for _, e := range mmSetBackorderDeadline.SetBackorderDeadlineMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSetBackorderDeadline.SetBackorderDeadlineMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetBackorderDeadline.SetBackorderDeadlineMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetBackorderDeadline.SetBackorderDeadlineMock.defaultExpectation.params
+		mm_got := OrdersRepositoryMockSetBackorderDeadlineParams{ctx, id, deadline}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetBackorderDeadline.t.Errorf("OrdersRepositoryMock.SetBackorderDeadline got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetBackorderDeadline.SetBackorderDeadlineMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetBackorderDeadline.t.Fatal("No results are set for the OrdersRepositoryMock.SetBackorderDeadline")
+		}
+		return (*mm_results).err
+	}
+	if mmSetBackorderDeadline.funcSetBackorderDeadline != nil {
+		return mmSetBackorderDeadline.funcSetBackorderDeadline(ctx, id, deadline)
+	}
+	mmSetBackorderDeadline.t.Fatalf("Unexpected call to OrdersRepositoryMock.SetBackorderDeadline. %v %v %v", ctx, id, deadline)
+	return
+}
+
+// SetBackorderDeadlineAfterCounter returns a count of finished OrdersRepositoryMock.SetBackorderDeadline invocations
+func (mmSetBackorderDeadline *OrdersRepositoryMock) SetBackorderDeadlineAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetBackorderDeadline.afterSetBackorderDeadlineCounter)
+}
+
+// SetBackorderDeadlineBeforeCounter returns a count of OrdersRepositoryMock.SetBackorderDeadline invocations
+func (mmSetBackorderDeadline *OrdersRepositoryMock) SetBackorderDeadlineBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetBackorderDeadline.beforeSetBackorderDeadlineCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrdersRepositoryMock.SetBackorderDeadline.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetBackorderDeadline *mOrdersRepositoryMockSetBackorderDeadline) Calls() []*OrdersRepositoryMockSetBackorderDeadlineParams {
+	mmSetBackorderDeadline.mutex.RLock()
+
+	argCopy := make([]*OrdersRepositoryMockSetBackorderDeadlineParams, len(mmSetBackorderDeadline.callArgs))
+	copy(argCopy, mmSetBackorderDeadline.callArgs)
+
+	mmSetBackorderDeadline.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetBackorderDeadlineDone returns true if the count of the SetBackorderDeadline invocations corresponds
+// the number of defined expectations
+func (m *OrdersRepositoryMock) MinimockSetBackorderDeadlineDone() bool {
+	for _, e := range m.SetBackorderDeadlineMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetBackorderDeadlineMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSetBackorderDeadlineCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetBackorderDeadline != nil && mm_atomic.LoadUint64(&m.afterSetBackorderDeadlineCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockSetBackorderDeadlineInspect logs each unmet expectation
+func (m *OrdersRepositoryMock) MinimockSetBackorderDeadlineInspect() {
+	for _, e := range m.SetBackorderDeadlineMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.SetBackorderDeadline with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetBackorderDeadlineMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSetBackorderDeadlineCounter) < 1 {
+		if m.SetBackorderDeadlineMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OrdersRepositoryMock.SetBackorderDeadline")
+		} else {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.SetBackorderDeadline with params: %#v", *m.SetBackorderDeadlineMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetBackorderDeadline != nil && mm_atomic.LoadUint64(&m.afterSetBackorderDeadlineCounter) < 1 {
+		m.t.Error("Expected call to OrdersRepositoryMock.SetBackorderDeadline")
+	}
+}
+
+type mOrdersRepositoryMockSetPaymentDeadline struct {
+	mock               *OrdersRepositoryMock
+	defaultExpectation *OrdersRepositoryMockSetPaymentDeadlineExpectation
+	expectations       []*OrdersRepositoryMockSetPaymentDeadlineExpectation
+
+	callArgs []*OrdersRepositoryMockSetPaymentDeadlineParams
+	mutex    sync.RWMutex
+}
+
+// OrdersRepositoryMockSetPaymentDeadlineExpectation specifies expectation struct of the OrdersRepository.SetPaymentDeadline
+type OrdersRepositoryMockSetPaymentDeadlineExpectation struct {
+	mock    *OrdersRepositoryMock
+	params  *OrdersRepositoryMockSetPaymentDeadlineParams
+	results *OrdersRepositoryMockSetPaymentDeadlineResults
+	Counter uint64
+}
+
+// OrdersRepositoryMockSetPaymentDeadlineParams contains parameters of the OrdersRepository.SetPaymentDeadline
+type OrdersRepositoryMockSetPaymentDeadlineParams struct {
+	ctx      context.Context
+	id       int64
+	deadline time.Time
+}
+
+// OrdersRepositoryMockSetPaymentDeadlineResults contains results of the OrdersRepository.SetPaymentDeadline
+type OrdersRepositoryMockSetPaymentDeadlineResults struct {
+	err error
+}
+
+// Expect sets up expected params for OrdersRepository.SetPaymentDeadline
+func (mmSetPaymentDeadline *mOrdersRepositoryMockSetPaymentDeadline) Expect(ctx context.Context, id int64, deadline time.Time) *mOrdersRepositoryMockSetPaymentDeadline {
+	if mmSetPaymentDeadline.mock.funcSetPaymentDeadline != nil {
+		mmSetPaymentDeadline.mock.t.Fatalf("OrdersRepositoryMock.SetPaymentDeadline mock is already set by Set")
+	}
+
+	if mmSetPaymentDeadline.defaultExpectation == nil {
+		mmSetPaymentDeadline.defaultExpectation = &OrdersRepositoryMockSetPaymentDeadlineExpectation{}
+	}
+
+	mmSetPaymentDeadline.defaultExpectation.params = &OrdersRepositoryMockSetPaymentDeadlineParams{ctx, id, deadline}
+	for _, e := range mmSetPaymentDeadline.expectations {
+		if minimock.Equal(e.params, mmSetPaymentDeadline.defaultExpectation.params) {
+			mmSetPaymentDeadline.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetPaymentDeadline.defaultExpectation.params)
+		}
+	}
+
+	return mmSetPaymentDeadline
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrdersRepository.SetPaymentDeadline
+func (mmSetPaymentDeadline *mOrdersRepositoryMockSetPaymentDeadline) Inspect(f func(ctx context.Context, id int64, deadline time.Time)) *mOrdersRepositoryMockSetPaymentDeadline {
+	if mmSetPaymentDeadline.mock.inspectFuncSetPaymentDeadline != nil {
+		mmSetPaymentDeadline.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.SetPaymentDeadline")
+	}
+
+	mmSetPaymentDeadline.mock.inspectFuncSetPaymentDeadline = f
+
+	return mmSetPaymentDeadline
+}
+
+// Return sets up results that will be returned by OrdersRepository.SetPaymentDeadline
+func (mmSetPaymentDeadline *mOrdersRepositoryMockSetPaymentDeadline) Return(err error) *OrdersRepositoryMock {
+	if mmSetPaymentDeadline.mock.funcSetPaymentDeadline != nil {
+		mmSetPaymentDeadline.mock.t.Fatalf("OrdersRepositoryMock.SetPaymentDeadline mock is already set by Set")
+	}
+
+	if mmSetPaymentDeadline.defaultExpectation == nil {
+		mmSetPaymentDeadline.defaultExpectation = &OrdersRepositoryMockSetPaymentDeadlineExpectation{mock: mmSetPaymentDeadline.mock}
+	}
+	mmSetPaymentDeadline.defaultExpectation.results = &OrdersRepositoryMockSetPaymentDeadlineResults{err}
+	return mmSetPaymentDeadline.mock
+}
+
+// Set uses given function f to mock the OrdersRepository.SetPaymentDeadline method
+func (mmSetPaymentDeadline *mOrdersRepositoryMockSetPaymentDeadline) Set(f func(ctx context.Context, id int64, deadline time.Time) (err error)) *OrdersRepositoryMock {
+	if mmSetPaymentDeadline.defaultExpectation != nil {
+		mmSetPaymentDeadline.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.SetPaymentDeadline method")
+	}
+
+	if len(mmSetPaymentDeadline.expectations) > 0 {
+		mmSetPaymentDeadline.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.SetPaymentDeadline method")
+	}
+
+	mmSetPaymentDeadline.mock.funcSetPaymentDeadline = f
+	return mmSetPaymentDeadline.mock
+}
+
+// When sets expectation for the OrdersRepository.SetPaymentDeadline which will trigger the result defined by the following
+// Then helper
+func (mmSetPaymentDeadline *mOrdersRepositoryMockSetPaymentDeadline) When(ctx context.Context, id int64, deadline time.Time) *OrdersRepositoryMockSetPaymentDeadlineExpectation {
+	if mmSetPaymentDeadline.mock.funcSetPaymentDeadline != nil {
+		mmSetPaymentDeadline.mock.t.Fatalf("OrdersRepositoryMock.SetPaymentDeadline mock is already set by Set")
+	}
+
+	expectation := &OrdersRepositoryMockSetPaymentDeadlineExpectation{
+		mock:   mmSetPaymentDeadline.mock,
+		params: &OrdersRepositoryMockSetPaymentDeadlineParams{ctx, id, deadline},
+	}
+	mmSetPaymentDeadline.expectations = append(mmSetPaymentDeadline.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrdersRepository.SetPaymentDeadline return parameters for the expectation previously defined by the When method
+func (e *OrdersRepositoryMockSetPaymentDeadlineExpectation) Then(err error) *OrdersRepositoryMock {
+	e.results = &OrdersRepositoryMockSetPaymentDeadlineResults{err}
+	return e.mock
+}
+
+// SetPaymentDeadline implements OrdersRepository
+func (mmSetPaymentDeadline *OrdersRepositoryMock) SetPaymentDeadline(ctx context.Context, id int64, deadline time.Time) (err error) {
+	mm_atomic.AddUint64(&mmSetPaymentDeadline.beforeSetPaymentDeadlineCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetPaymentDeadline.afterSetPaymentDeadlineCounter, 1)
+
+	if mmSetPaymentDeadline.inspectFuncSetPaymentDeadline != nil {
+		mmSetPaymentDeadline.inspectFuncSetPaymentDeadline(ctx, id, deadline)
+	}
+
+	mm_params := &OrdersRepositoryMockSetPaymentDeadlineParams{ctx, id, deadline}
+
+	// Record call args
+	mmSetPaymentDeadline.SetPaymentDeadlineMock.mutex.Lock()
+	mmSetPaymentDeadline.SetPaymentDeadlineMock.callArgs = append(mmSetPaymentDeadline.SetPaymentDeadlineMock.callArgs, mm_params)
+	mmSetPaymentDeadline.SetPaymentDeadlineMock.mutex.Unlock()
+
+	for _, e := range mmSetPaymentDeadline.SetPaymentDeadlineMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSetPaymentDeadline.SetPaymentDeadlineMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetPaymentDeadline.SetPaymentDeadlineMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetPaymentDeadline.SetPaymentDeadlineMock.defaultExpectation.params
+		mm_got := OrdersRepositoryMockSetPaymentDeadlineParams{ctx, id, deadline}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetPaymentDeadline.t.Errorf("OrdersRepositoryMock.SetPaymentDeadline got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetPaymentDeadline.SetPaymentDeadlineMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetPaymentDeadline.t.Fatal("No results are set for the OrdersRepositoryMock.SetPaymentDeadline")
+		}
+		return (*mm_results).err
+	}
+	if mmSetPaymentDeadline.funcSetPaymentDeadline != nil {
+		return mmSetPaymentDeadline.funcSetPaymentDeadline(ctx, id, deadline)
+	}
+	mmSetPaymentDeadline.t.Fatalf("Unexpected call to OrdersRepositoryMock.SetPaymentDeadline. %v %v %v", ctx, id, deadline)
+	return
+}
+
+// SetPaymentDeadlineAfterCounter returns a count of finished OrdersRepositoryMock.SetPaymentDeadline invocations
+func (mmSetPaymentDeadline *OrdersRepositoryMock) SetPaymentDeadlineAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetPaymentDeadline.afterSetPaymentDeadlineCounter)
+}
+
+// SetPaymentDeadlineBeforeCounter returns a count of OrdersRepositoryMock.SetPaymentDeadline invocations
+func (mmSetPaymentDeadline *OrdersRepositoryMock) SetPaymentDeadlineBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetPaymentDeadline.beforeSetPaymentDeadlineCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrdersRepositoryMock.SetPaymentDeadline.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetPaymentDeadline *mOrdersRepositoryMockSetPaymentDeadline) Calls() []*OrdersRepositoryMockSetPaymentDeadlineParams {
+	mmSetPaymentDeadline.mutex.RLock()
+
+	argCopy := make([]*OrdersRepositoryMockSetPaymentDeadlineParams, len(mmSetPaymentDeadline.callArgs))
+	copy(argCopy, mmSetPaymentDeadline.callArgs)
+
+	mmSetPaymentDeadline.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetPaymentDeadlineDone returns true if the count of the SetPaymentDeadline invocations corresponds
+// the number of defined expectations
+func (m *OrdersRepositoryMock) MinimockSetPaymentDeadlineDone() bool {
+	for _, e := range m.SetPaymentDeadlineMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetPaymentDeadlineMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSetPaymentDeadlineCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetPaymentDeadline != nil && mm_atomic.LoadUint64(&m.afterSetPaymentDeadlineCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockSetPaymentDeadlineInspect logs each unmet expectation
+func (m *OrdersRepositoryMock) MinimockSetPaymentDeadlineInspect() {
+	for _, e := range m.SetPaymentDeadlineMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.SetPaymentDeadline with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetPaymentDeadlineMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSetPaymentDeadlineCounter) < 1 {
+		if m.SetPaymentDeadlineMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OrdersRepositoryMock.SetPaymentDeadline")
+		} else {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.SetPaymentDeadline with params: %#v", *m.SetPaymentDeadlineMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetPaymentDeadline != nil && mm_atomic.LoadUint64(&m.afterSetPaymentDeadlineCounter) < 1 {
+		m.t.Error("Expected call to OrdersRepositoryMock.SetPaymentDeadline")
+	}
+}
+
+type mOrdersRepositoryMockStocks struct {
+	mock               *OrdersRepositoryMock
+	defaultExpectation *OrdersRepositoryMockStocksExpectation
+	expectations       []*OrdersRepositoryMockStocksExpectation
+
+	callArgs []*OrdersRepositoryMockStocksParams
+	mutex    sync.RWMutex
+}
+
+// OrdersRepositoryMockStocksExpectation specifies expectation struct of the OrdersRepository.Stocks
+type OrdersRepositoryMockStocksExpectation struct {
+	mock    *OrdersRepositoryMock
+	params  *OrdersRepositoryMockStocksParams
+	results *OrdersRepositoryMockStocksResults
+	Counter uint64
+}
+
+// OrdersRepositoryMockStocksParams contains parameters of the OrdersRepository.Stocks
+type OrdersRepositoryMockStocksParams struct {
+	ctx      context.Context
+	sku      uint32
+	sellerID int64
+}
+
+// OrdersRepositoryMockStocksResults contains results of the OrdersRepository.Stocks
+type OrdersRepositoryMockStocksResults struct {
+	sa1 []Stock
+	err error
+}
+
+// Expect sets up expected params for OrdersRepository.Stocks
+func (mmStocks *mOrdersRepositoryMockStocks) Expect(ctx context.Context, sku uint32, sellerID int64) *mOrdersRepositoryMockStocks {
+	if mmStocks.mock.funcStocks != nil {
+		mmStocks.mock.t.Fatalf("OrdersRepositoryMock.Stocks mock is already set by Set")
+	}
+
+	if mmStocks.defaultExpectation == nil {
+		mmStocks.defaultExpectation = &OrdersRepositoryMockStocksExpectation{}
+	}
+
+	mmStocks.defaultExpectation.params = &OrdersRepositoryMockStocksParams{ctx, sku, sellerID}
+	for _, e := range mmStocks.expectations {
+		if minimock.Equal(e.params, mmStocks.defaultExpectation.params) {
+			mmStocks.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmStocks.defaultExpectation.params)
+		}
+	}
+
+	return mmStocks
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrdersRepository.Stocks
+func (mmStocks *mOrdersRepositoryMockStocks) Inspect(f func(ctx context.Context, sku uint32, sellerID int64)) *mOrdersRepositoryMockStocks {
+	if mmStocks.mock.inspectFuncStocks != nil {
+		mmStocks.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.Stocks")
+	}
+
+	mmStocks.mock.inspectFuncStocks = f
+
+	return mmStocks
+}
+
+// Return sets up results that will be returned by OrdersRepository.Stocks
+func (mmStocks *mOrdersRepositoryMockStocks) Return(sa1 []Stock, err error) *OrdersRepositoryMock {
+	if mmStocks.mock.funcStocks != nil {
+		mmStocks.mock.t.Fatalf("OrdersRepositoryMock.Stocks mock is already set by Set")
+	}
+
+	if mmStocks.defaultExpectation == nil {
+		mmStocks.defaultExpectation = &OrdersRepositoryMockStocksExpectation{mock: mmStocks.mock}
+	}
+	mmStocks.defaultExpectation.results = &OrdersRepositoryMockStocksResults{sa1, err}
+	return mmStocks.mock
+}
+
+// Set uses given function f to mock the OrdersRepository.Stocks method
+func (mmStocks *mOrdersRepositoryMockStocks) Set(f func(ctx context.Context, sku uint32, sellerID int64) (sa1 []Stock, err error)) *OrdersRepositoryMock {
+	if mmStocks.defaultExpectation != nil {
+		mmStocks.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.Stocks method")
+	}
+
+	if len(mmStocks.expectations) > 0 {
+		mmStocks.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.Stocks method")
+	}
+
+	mmStocks.mock.funcStocks = f
+	return mmStocks.mock
+}
+
+// When sets expectation for the OrdersRepository.Stocks which will trigger the result defined by the following
+// Then helper
+func (mmStocks *mOrdersRepositoryMockStocks) When(ctx context.Context, sku uint32, sellerID int64) *OrdersRepositoryMockStocksExpectation {
+	if mmStocks.mock.funcStocks != nil {
+		mmStocks.mock.t.Fatalf("OrdersRepositoryMock.Stocks mock is already set by Set")
+	}
+
+	expectation := &OrdersRepositoryMockStocksExpectation{
+		mock:   mmStocks.mock,
+		params: &OrdersRepositoryMockStocksParams{ctx, sku, sellerID},
+	}
+	mmStocks.expectations = append(mmStocks.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrdersRepository.Stocks return parameters for the expectation previously defined by the When method
+func (e *OrdersRepositoryMockStocksExpectation) Then(sa1 []Stock, err error) *OrdersRepositoryMock {
+	e.results = &OrdersRepositoryMockStocksResults{sa1, err}
+	return e.mock
+}
+
+// Stocks implements OrdersRepository
+func (mmStocks *OrdersRepositoryMock) Stocks(ctx context.Context, sku uint32, sellerID int64) (sa1 []Stock, err error) {
+	mm_atomic.AddUint64(&mmStocks.beforeStocksCounter, 1)
+	defer mm_atomic.AddUint64(&mmStocks.afterStocksCounter, 1)
+
+	if mmStocks.inspectFuncStocks != nil {
+		mmStocks.inspectFuncStocks(ctx, sku, sellerID)
+	}
+
+	mm_params := &OrdersRepositoryMockStocksParams{ctx, sku, sellerID}
+
+	// Record call args
+	mmStocks.StocksMock.mutex.Lock()
+	mmStocks.StocksMock.callArgs = append(mmStocks.StocksMock.callArgs, mm_params)
+	mmStocks.StocksMock.mutex.Unlock()
+
+	for _, e := range mmStocks.StocksMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmStocks.StocksMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmStocks.StocksMock.defaultExpectation.Counter, 1)
+		mm_want := mmStocks.StocksMock.defaultExpectation.params
+		mm_got := OrdersRepositoryMockStocksParams{ctx, sku, sellerID}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmStocks.t.Errorf("OrdersRepositoryMock.Stocks got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmStocks.StocksMock.defaultExpectation.results
+		if mm_results == nil {
+			mmStocks.t.Fatal("No results are set for the OrdersRepositoryMock.Stocks")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmStocks.funcStocks != nil {
+		return mmStocks.funcStocks(ctx, sku, sellerID)
+	}
+	mmStocks.t.Fatalf("Unexpected call to OrdersRepositoryMock.Stocks. %v %v %v", ctx, sku, sellerID)
+	return
+}
+
+// StocksAfterCounter returns a count of finished OrdersRepositoryMock.Stocks invocations
+func (mmStocks *OrdersRepositoryMock) StocksAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStocks.afterStocksCounter)
+}
+
+// StocksBeforeCounter returns a count of OrdersRepositoryMock.Stocks invocations
+func (mmStocks *OrdersRepositoryMock) StocksBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStocks.beforeStocksCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrdersRepositoryMock.Stocks.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmStocks *mOrdersRepositoryMockStocks) Calls() []*OrdersRepositoryMockStocksParams {
+	mmStocks.mutex.RLock()
+
+	argCopy := make([]*OrdersRepositoryMockStocksParams, len(mmStocks.callArgs))
+	copy(argCopy, mmStocks.callArgs)
+
+	mmStocks.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockStocksDone returns true if the count of the Stocks invocations corresponds
+// the number of defined expectations
+func (m *OrdersRepositoryMock) MinimockStocksDone() bool {
+	for _, e := range m.StocksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.StocksMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterStocksCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcStocks != nil && mm_atomic.LoadUint64(&m.afterStocksCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockStocksInspect logs each unmet expectation
+func (m *OrdersRepositoryMock) MinimockStocksInspect() {
+	for _, e := range m.StocksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.Stocks with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.StocksMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterStocksCounter) < 1 {
+		if m.StocksMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OrdersRepositoryMock.Stocks")
+		} else {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.Stocks with params: %#v", *m.StocksMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcStocks != nil && mm_atomic.LoadUint64(&m.afterStocksCounter) < 1 {
+		m.t.Error("Expected call to OrdersRepositoryMock.Stocks")
+	}
+}
+
+type mOrdersRepositoryMockUnReserveItems struct {
+	mock               *OrdersRepositoryMock
+	defaultExpectation *OrdersRepositoryMockUnReserveItemsExpectation
+	expectations       []*OrdersRepositoryMockUnReserveItemsExpectation
+
+	callArgs []*OrdersRepositoryMockUnReserveItemsParams
+	mutex    sync.RWMutex
+}
+
+// OrdersRepositoryMockUnReserveItemsExpectation specifies expectation struct of the OrdersRepository.UnReserveItems
+type OrdersRepositoryMockUnReserveItemsExpectation struct {
+	mock    *OrdersRepositoryMock
+	params  *OrdersRepositoryMockUnReserveItemsParams
+	results *OrdersRepositoryMockUnReserveItemsResults
+	Counter uint64
+}
+
+// OrdersRepositoryMockUnReserveItemsParams contains parameters of the OrdersRepository.UnReserveItems
+type OrdersRepositoryMockUnReserveItemsParams struct {
+	ctx     context.Context
+	orderID int64
+}
+
+// OrdersRepositoryMockUnReserveItemsResults contains results of the OrdersRepository.UnReserveItems
+type OrdersRepositoryMockUnReserveItemsResults struct {
+	ra1 []ReservedItem
+	err error
+}
+
+// Expect sets up expected params for OrdersRepository.UnReserveItems
+func (mmUnReserveItems *mOrdersRepositoryMockUnReserveItems) Expect(ctx context.Context, orderID int64) *mOrdersRepositoryMockUnReserveItems {
+	if mmUnReserveItems.mock.funcUnReserveItems != nil {
+		mmUnReserveItems.mock.t.Fatalf("OrdersRepositoryMock.UnReserveItems mock is already set by Set")
+	}
+
+	if mmUnReserveItems.defaultExpectation == nil {
+		mmUnReserveItems.defaultExpectation = &OrdersRepositoryMockUnReserveItemsExpectation{}
+	}
+
+	mmUnReserveItems.defaultExpectation.params = &OrdersRepositoryMockUnReserveItemsParams{ctx, orderID}
+	for _, e := range mmUnReserveItems.expectations {
+		if minimock.Equal(e.params, mmUnReserveItems.defaultExpectation.params) {
+			mmUnReserveItems.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUnReserveItems.defaultExpectation.params)
+		}
+	}
+
+	return mmUnReserveItems
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrdersRepository.UnReserveItems
+func (mmUnReserveItems *mOrdersRepositoryMockUnReserveItems) Inspect(f func(ctx context.Context, orderID int64)) *mOrdersRepositoryMockUnReserveItems {
+	if mmUnReserveItems.mock.inspectFuncUnReserveItems != nil {
+		mmUnReserveItems.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.UnReserveItems")
+	}
+
+	mmUnReserveItems.mock.inspectFuncUnReserveItems = f
+
+	return mmUnReserveItems
+}
+
+// Return sets up results that will be returned by OrdersRepository.UnReserveItems
+func (mmUnReserveItems *mOrdersRepositoryMockUnReserveItems) Return(ra1 []ReservedItem, err error) *OrdersRepositoryMock {
+	if mmUnReserveItems.mock.funcUnReserveItems != nil {
+		mmUnReserveItems.mock.t.Fatalf("OrdersRepositoryMock.UnReserveItems mock is already set by Set")
+	}
+
+	if mmUnReserveItems.defaultExpectation == nil {
+		mmUnReserveItems.defaultExpectation = &OrdersRepositoryMockUnReserveItemsExpectation{mock: mmUnReserveItems.mock}
+	}
+	mmUnReserveItems.defaultExpectation.results = &OrdersRepositoryMockUnReserveItemsResults{ra1, err}
+	return mmUnReserveItems.mock
+}
+
+// Set uses given function f to mock the OrdersRepository.UnReserveItems method
+func (mmUnReserveItems *mOrdersRepositoryMockUnReserveItems) Set(f func(ctx context.Context, orderID int64) (ra1 []ReservedItem, err error)) *OrdersRepositoryMock {
+	if mmUnReserveItems.defaultExpectation != nil {
+		mmUnReserveItems.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.UnReserveItems method")
+	}
+
+	if len(mmUnReserveItems.expectations) > 0 {
+		mmUnReserveItems.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.UnReserveItems method")
+	}
+
+	mmUnReserveItems.mock.funcUnReserveItems = f
+	return mmUnReserveItems.mock
+}
+
+// When sets expectation for the OrdersRepository.UnReserveItems which will trigger the result defined by the following
+// Then helper
+func (mmUnReserveItems *mOrdersRepositoryMockUnReserveItems) When(ctx context.Context, orderID int64) *OrdersRepositoryMockUnReserveItemsExpectation {
+	if mmUnReserveItems.mock.funcUnReserveItems != nil {
+		mmUnReserveItems.mock.t.Fatalf("OrdersRepositoryMock.UnReserveItems mock is already set by Set")
+	}
+
+	expectation := &OrdersRepositoryMockUnReserveItemsExpectation{
+		mock:   mmUnReserveItems.mock,
+		params: &OrdersRepositoryMockUnReserveItemsParams{ctx, orderID},
+	}
+	mmUnReserveItems.expectations = append(mmUnReserveItems.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrdersRepository.UnReserveItems return parameters for the expectation previously defined by the When method
+func (e *OrdersRepositoryMockUnReserveItemsExpectation) Then(ra1 []ReservedItem, err error) *OrdersRepositoryMock {
+	e.results = &OrdersRepositoryMockUnReserveItemsResults{ra1, err}
+	return e.mock
+}
+
+// UnReserveItems implements OrdersRepository
+func (mmUnReserveItems *OrdersRepositoryMock) UnReserveItems(ctx context.Context, orderID int64) (ra1 []ReservedItem, err error) {
+	mm_atomic.AddUint64(&mmUnReserveItems.beforeUnReserveItemsCounter, 1)
+	defer mm_atomic.AddUint64(&mmUnReserveItems.afterUnReserveItemsCounter, 1)
+
+	if mmUnReserveItems.inspectFuncUnReserveItems != nil {
+		mmUnReserveItems.inspectFuncUnReserveItems(ctx, orderID)
+	}
+
+	mm_params := &OrdersRepositoryMockUnReserveItemsParams{ctx, orderID}
+
+	// Record call args
+	mmUnReserveItems.UnReserveItemsMock.mutex.Lock()
+	mmUnReserveItems.UnReserveItemsMock.callArgs = append(mmUnReserveItems.UnReserveItemsMock.callArgs, mm_params)
+	mmUnReserveItems.UnReserveItemsMock.mutex.Unlock()
+
+	for _, e := range mmUnReserveItems.UnReserveItemsMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ra1, e.results.err
+		}
+	}
+
+	if mmUnReserveItems.UnReserveItemsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUnReserveItems.UnReserveItemsMock.defaultExpectation.Counter, 1)
+		mm_want := mmUnReserveItems.UnReserveItemsMock.defaultExpectation.params
+		mm_got := OrdersRepositoryMockUnReserveItemsParams{ctx, orderID}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUnReserveItems.t.Errorf("OrdersRepositoryMock.UnReserveItems got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUnReserveItems.UnReserveItemsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUnReserveItems.t.Fatal("No results are set for the OrdersRepositoryMock.UnReserveItems")
+		}
+		return (*mm_results).ra1, (*mm_results).err
+	}
+	if mmUnReserveItems.funcUnReserveItems != nil {
+		return mmUnReserveItems.funcUnReserveItems(ctx, orderID)
+	}
+	mmUnReserveItems.t.Fatalf("Unexpected call to OrdersRepositoryMock.UnReserveItems. %v %v", ctx, orderID)
+	return
+}
+
+// UnReserveItemsAfterCounter returns a count of finished OrdersRepositoryMock.UnReserveItems invocations
+func (mmUnReserveItems *OrdersRepositoryMock) UnReserveItemsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUnReserveItems.afterUnReserveItemsCounter)
+}
+
+// UnReserveItemsBeforeCounter returns a count of OrdersRepositoryMock.UnReserveItems invocations
+func (mmUnReserveItems *OrdersRepositoryMock) UnReserveItemsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUnReserveItems.beforeUnReserveItemsCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrdersRepositoryMock.UnReserveItems.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUnReserveItems *mOrdersRepositoryMockUnReserveItems) Calls() []*OrdersRepositoryMockUnReserveItemsParams {
+	mmUnReserveItems.mutex.RLock()
+
+	argCopy := make([]*OrdersRepositoryMockUnReserveItemsParams, len(mmUnReserveItems.callArgs))
+	copy(argCopy, mmUnReserveItems.callArgs)
+
+	mmUnReserveItems.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUnReserveItemsDone returns true if the count of the UnReserveItems invocations corresponds
+// the number of defined expectations
+func (m *OrdersRepositoryMock) MinimockUnReserveItemsDone() bool {
+	for _, e := range m.UnReserveItemsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UnReserveItemsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUnReserveItemsCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUnReserveItems != nil && mm_atomic.LoadUint64(&m.afterUnReserveItemsCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockUnReserveItemsInspect logs each unmet expectation
+func (m *OrdersRepositoryMock) MinimockUnReserveItemsInspect() {
+	for _, e := range m.UnReserveItemsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.UnReserveItems with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UnReserveItemsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUnReserveItemsCounter) < 1 {
+		if m.UnReserveItemsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OrdersRepositoryMock.UnReserveItems")
+		} else {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.UnReserveItems with params: %#v", *m.UnReserveItemsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUnReserveItems != nil && mm_atomic.LoadUint64(&m.afterUnReserveItemsCounter) < 1 {
+		m.t.Error("Expected call to OrdersRepositoryMock.UnReserveItems")
+	}
+}
+
+type mOrdersRepositoryMockUpdateBackorder struct {
+	mock               *OrdersRepositoryMock
+	defaultExpectation *OrdersRepositoryMockUpdateBackorderExpectation
+	expectations       []*OrdersRepositoryMockUpdateBackorderExpectation
+
+	callArgs []*OrdersRepositoryMockUpdateBackorderParams
+	mutex    sync.RWMutex
+}
+
+// OrdersRepositoryMockUpdateBackorderExpectation specifies expectation struct of the OrdersRepository.UpdateBackorder
+type OrdersRepositoryMockUpdateBackorderExpectation struct {
+	mock    *OrdersRepositoryMock
+	params  *OrdersRepositoryMockUpdateBackorderParams
+	results *OrdersRepositoryMockUpdateBackorderResults
+	Counter uint64
+}
+
+// OrdersRepositoryMockUpdateBackorderParams contains parameters of the OrdersRepository.UpdateBackorder
+type OrdersRepositoryMockUpdateBackorderParams struct {
+	ctx       context.Context
+	backorder Backorder
+}
+
+// OrdersRepositoryMockUpdateBackorderResults contains results of the OrdersRepository.UpdateBackorder
+type OrdersRepositoryMockUpdateBackorderResults struct {
+	err error
+}
+
+// Expect sets up expected params for OrdersRepository.UpdateBackorder
+func (mmUpdateBackorder *mOrdersRepositoryMockUpdateBackorder) Expect(ctx context.Context, backorder Backorder) *mOrdersRepositoryMockUpdateBackorder {
+	if mmUpdateBackorder.mock.funcUpdateBackorder != nil {
+		mmUpdateBackorder.mock.t.Fatalf("OrdersRepositoryMock.UpdateBackorder mock is already set by Set")
+	}
+
+	if mmUpdateBackorder.defaultExpectation == nil {
+		mmUpdateBackorder.defaultExpectation = &OrdersRepositoryMockUpdateBackorderExpectation{}
+	}
+
+	mmUpdateBackorder.defaultExpectation.params = &OrdersRepositoryMockUpdateBackorderParams{ctx, backorder}
+	for _, e := range mmUpdateBackorder.expectations {
+		if minimock.Equal(e.params, mmUpdateBackorder.defaultExpectation.params) {
+			mmUpdateBackorder.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateBackorder.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateBackorder
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrdersRepository.UpdateBackorder
+func (mmUpdateBackorder *mOrdersRepositoryMockUpdateBackorder) Inspect(f func(ctx context.Context, backorder Backorder)) *mOrdersRepositoryMockUpdateBackorder {
+	if mmUpdateBackorder.mock.inspectFuncUpdateBackorder != nil {
+		mmUpdateBackorder.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.UpdateBackorder")
+	}
+
+	mmUpdateBackorder.mock.inspectFuncUpdateBackorder = f
+
+	return mmUpdateBackorder
+}
+
+// Return sets up results that will be returned by OrdersRepository.UpdateBackorder
+func (mmUpdateBackorder *mOrdersRepositoryMockUpdateBackorder) Return(err error) *OrdersRepositoryMock {
+	if mmUpdateBackorder.mock.funcUpdateBackorder != nil {
+		mmUpdateBackorder.mock.t.Fatalf("OrdersRepositoryMock.UpdateBackorder mock is already set by Set")
+	}
+
+	if mmUpdateBackorder.defaultExpectation == nil {
+		mmUpdateBackorder.defaultExpectation = &OrdersRepositoryMockUpdateBackorderExpectation{mock: mmUpdateBackorder.mock}
+	}
+	mmUpdateBackorder.defaultExpectation.results = &OrdersRepositoryMockUpdateBackorderResults{err}
+	return mmUpdateBackorder.mock
+}
+
+// Set uses given function f to mock the OrdersRepository.UpdateBackorder method
+func (mmUpdateBackorder *mOrdersRepositoryMockUpdateBackorder) Set(f func(ctx context.Context, backorder Backorder) (err error)) *OrdersRepositoryMock {
+	if mmUpdateBackorder.defaultExpectation != nil {
+		mmUpdateBackorder.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.UpdateBackorder method")
+	}
+
+	if len(mmUpdateBackorder.expectations) > 0 {
+		mmUpdateBackorder.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.UpdateBackorder method")
+	}
+
+	mmUpdateBackorder.mock.funcUpdateBackorder = f
+	return mmUpdateBackorder.mock
+}
+
+// When sets expectation for the OrdersRepository.UpdateBackorder which will trigger the result defined by the following
+// Then helper
+func (mmUpdateBackorder *mOrdersRepositoryMockUpdateBackorder) When(ctx context.Context, backorder Backorder) *OrdersRepositoryMockUpdateBackorderExpectation {
+	if mmUpdateBackorder.mock.funcUpdateBackorder != nil {
+		mmUpdateBackorder.mock.t.Fatalf("OrdersRepositoryMock.UpdateBackorder mock is already set by Set")
+	}
+
+	expectation := &OrdersRepositoryMockUpdateBackorderExpectation{
+		mock:   mmUpdateBackorder.mock,
+		params: &OrdersRepositoryMockUpdateBackorderParams{ctx, backorder},
+	}
+	mmUpdateBackorder.expectations = append(mmUpdateBackorder.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrdersRepository.UpdateBackorder return parameters for the expectation previously defined by the When method
+func (e *OrdersRepositoryMockUpdateBackorderExpectation) Then(err error) *OrdersRepositoryMock {
+	e.results = &OrdersRepositoryMockUpdateBackorderResults{err}
+	return e.mock
+}
+
+// UpdateBackorder implements OrdersRepository
+func (mmUpdateBackorder *OrdersRepositoryMock) UpdateBackorder(ctx context.Context, backorder Backorder) (err error) {
+	mm_atomic.AddUint64(&mmUpdateBackorder.beforeUpdateBackorderCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateBackorder.afterUpdateBackorderCounter, 1)
+
+	if mmUpdateBackorder.inspectFuncUpdateBackorder != nil {
+		mmUpdateBackorder.inspectFuncUpdateBackorder(ctx, backorder)
+	}
+
+	mm_params := &OrdersRepositoryMockUpdateBackorderParams{ctx, backorder}
+
+	// Record call args
+	mmUpdateBackorder.UpdateBackorderMock.mutex.Lock()
+	mmUpdateBackorder.UpdateBackorderMock.callArgs = append(mmUpdateBackorder.UpdateBackorderMock.callArgs, mm_params)
+	mmUpdateBackorder.UpdateBackorderMock.mutex.Unlock()
+
+	for _, e := range mmUpdateBackorder.UpdateBackorderMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdateBackorder.UpdateBackorderMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateBackorder.UpdateBackorderMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateBackorder.UpdateBackorderMock.defaultExpectation.params
+		mm_got := OrdersRepositoryMockUpdateBackorderParams{ctx, backorder}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateBackorder.t.Errorf("OrdersRepositoryMock.UpdateBackorder got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateBackorder.UpdateBackorderMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateBackorder.t.Fatal("No results are set for the OrdersRepositoryMock.UpdateBackorder")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdateBackorder.funcUpdateBackorder != nil {
+		return mmUpdateBackorder.funcUpdateBackorder(ctx, backorder)
+	}
+	mmUpdateBackorder.t.Fatalf("Unexpected call to OrdersRepositoryMock.UpdateBackorder. %v %v", ctx, backorder)
+	return
+}
+
+// UpdateBackorderAfterCounter returns a count of finished OrdersRepositoryMock.UpdateBackorder invocations
+func (mmUpdateBackorder *OrdersRepositoryMock) UpdateBackorderAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateBackorder.afterUpdateBackorderCounter)
+}
+
+// UpdateBackorderBeforeCounter returns a count of OrdersRepositoryMock.UpdateBackorder invocations
+func (mmUpdateBackorder *OrdersRepositoryMock) UpdateBackorderBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateBackorder.beforeUpdateBackorderCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrdersRepositoryMock.UpdateBackorder.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateBackorder *mOrdersRepositoryMockUpdateBackorder) Calls() []*OrdersRepositoryMockUpdateBackorderParams {
+	mmUpdateBackorder.mutex.RLock()
+
+	argCopy := make([]*OrdersRepositoryMockUpdateBackorderParams, len(mmUpdateBackorder.callArgs))
+	copy(argCopy, mmUpdateBackorder.callArgs)
+
+	mmUpdateBackorder.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateBackorderDone returns true if the count of the UpdateBackorder invocations corresponds
+// the number of defined expectations
+func (m *OrdersRepositoryMock) MinimockUpdateBackorderDone() bool {
+	for _, e := range m.UpdateBackorderMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateBackorderMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdateBackorderCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateBackorder != nil && mm_atomic.LoadUint64(&m.afterUpdateBackorderCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockUpdateBackorderInspect logs each unmet expectation
+func (m *OrdersRepositoryMock) MinimockUpdateBackorderInspect() {
+	for _, e := range m.UpdateBackorderMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.UpdateBackorder with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateBackorderMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdateBackorderCounter) < 1 {
+		if m.UpdateBackorderMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OrdersRepositoryMock.UpdateBackorder")
+		} else {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.UpdateBackorder with params: %#v", *m.UpdateBackorderMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateBackorder != nil && mm_atomic.LoadUint64(&m.afterUpdateBackorderCounter) < 1 {
+		m.t.Error("Expected call to OrdersRepositoryMock.UpdateBackorder")
+	}
+}
+
+type mOrdersRepositoryMockUpdateOrderItems struct {
+	mock               *OrdersRepositoryMock
+	defaultExpectation *OrdersRepositoryMockUpdateOrderItemsExpectation
+	expectations       []*OrdersRepositoryMockUpdateOrderItemsExpectation
+
+	callArgs []*OrdersRepositoryMockUpdateOrderItemsParams
+	mutex    sync.RWMutex
+}
+
+// OrdersRepositoryMockUpdateOrderItemsExpectation specifies expectation struct of the OrdersRepository.UpdateOrderItems
+type OrdersRepositoryMockUpdateOrderItemsExpectation struct {
+	mock    *OrdersRepositoryMock
+	params  *OrdersRepositoryMockUpdateOrderItemsParams
+	results *OrdersRepositoryMockUpdateOrderItemsResults
+	Counter uint64
+}
+
+// OrdersRepositoryMockUpdateOrderItemsParams contains parameters of the OrdersRepository.UpdateOrderItems
+type OrdersRepositoryMockUpdateOrderItemsParams struct {
+	ctx     context.Context
+	orderID int64
+	items   []OrderItem
+}
+
+// OrdersRepositoryMockUpdateOrderItemsResults contains results of the OrdersRepository.UpdateOrderItems
+type OrdersRepositoryMockUpdateOrderItemsResults struct {
+	err error
+}
+
+// Expect sets up expected params for OrdersRepository.UpdateOrderItems
+func (mmUpdateOrderItems *mOrdersRepositoryMockUpdateOrderItems) Expect(ctx context.Context, orderID int64, items []OrderItem) *mOrdersRepositoryMockUpdateOrderItems {
+	if mmUpdateOrderItems.mock.funcUpdateOrderItems != nil {
+		mmUpdateOrderItems.mock.t.Fatalf("OrdersRepositoryMock.UpdateOrderItems mock is already set by Set")
+	}
+
+	if mmUpdateOrderItems.defaultExpectation == nil {
+		mmUpdateOrderItems.defaultExpectation = &OrdersRepositoryMockUpdateOrderItemsExpectation{}
+	}
+
+	mmUpdateOrderItems.defaultExpectation.params = &OrdersRepositoryMockUpdateOrderItemsParams{ctx, orderID, items}
+	for _, e := range mmUpdateOrderItems.expectations {
+		if minimock.Equal(e.params, mmUpdateOrderItems.defaultExpectation.params) {
+			mmUpdateOrderItems.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateOrderItems.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateOrderItems
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrdersRepository.UpdateOrderItems
+func (mmUpdateOrderItems *mOrdersRepositoryMockUpdateOrderItems) Inspect(f func(ctx context.Context, orderID int64, items []OrderItem)) *mOrdersRepositoryMockUpdateOrderItems {
+	if mmUpdateOrderItems.mock.inspectFuncUpdateOrderItems != nil {
+		mmUpdateOrderItems.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.UpdateOrderItems")
+	}
+
+	mmUpdateOrderItems.mock.inspectFuncUpdateOrderItems = f
+
+	return mmUpdateOrderItems
+}
 
-	mmStocks.defaultExpectation.params = &OrdersRepositoryMockStocksParams{ctx, sku}
-	for _, e := range mmStocks.expectations {
-		if minimock.Equal(e.params, mmStocks.defaultExpectation.params) {
-			mmStocks.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmStocks.defaultExpectation.params)
+// Return sets up results that will be returned by OrdersRepository.UpdateOrderItems
+func (mmUpdateOrderItems *mOrdersRepositoryMockUpdateOrderItems) Return(err error) *OrdersRepositoryMock {
+	if mmUpdateOrderItems.mock.funcUpdateOrderItems != nil {
+		mmUpdateOrderItems.mock.t.Fatalf("OrdersRepositoryMock.UpdateOrderItems mock is already set by Set")
+	}
+
+	if mmUpdateOrderItems.defaultExpectation == nil {
+		mmUpdateOrderItems.defaultExpectation = &OrdersRepositoryMockUpdateOrderItemsExpectation{mock: mmUpdateOrderItems.mock}
+	}
+	mmUpdateOrderItems.defaultExpectation.results = &OrdersRepositoryMockUpdateOrderItemsResults{err}
+	return mmUpdateOrderItems.mock
+}
+
+// Set uses given function f to mock the OrdersRepository.UpdateOrderItems method
+func (mmUpdateOrderItems *mOrdersRepositoryMockUpdateOrderItems) Set(f func(ctx context.Context, orderID int64, items []OrderItem) (err error)) *OrdersRepositoryMock {
+	if mmUpdateOrderItems.defaultExpectation != nil {
+		mmUpdateOrderItems.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.UpdateOrderItems method")
+	}
+
+	if len(mmUpdateOrderItems.expectations) > 0 {
+		mmUpdateOrderItems.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.UpdateOrderItems method")
+	}
+
+	mmUpdateOrderItems.mock.funcUpdateOrderItems = f
+	return mmUpdateOrderItems.mock
+}
+
+// When sets expectation for the OrdersRepository.UpdateOrderItems which will trigger the result defined by the following
+// Then helper
+func (mmUpdateOrderItems *mOrdersRepositoryMockUpdateOrderItems) When(ctx context.Context, orderID int64, items []OrderItem) *OrdersRepositoryMockUpdateOrderItemsExpectation {
+	if mmUpdateOrderItems.mock.funcUpdateOrderItems != nil {
+		mmUpdateOrderItems.mock.t.Fatalf("OrdersRepositoryMock.UpdateOrderItems mock is already set by Set")
+	}
+
+	expectation := &OrdersRepositoryMockUpdateOrderItemsExpectation{
+		mock:   mmUpdateOrderItems.mock,
+		params: &OrdersRepositoryMockUpdateOrderItemsParams{ctx, orderID, items},
+	}
+	mmUpdateOrderItems.expectations = append(mmUpdateOrderItems.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrdersRepository.UpdateOrderItems return parameters for the expectation previously defined by the When method
+func (e *OrdersRepositoryMockUpdateOrderItemsExpectation) Then(err error) *OrdersRepositoryMock {
+	e.results = &OrdersRepositoryMockUpdateOrderItemsResults{err}
+	return e.mock
+}
+
+// UpdateOrderItems implements OrdersRepository
+func (mmUpdateOrderItems *OrdersRepositoryMock) UpdateOrderItems(ctx context.Context, orderID int64, items []OrderItem) (err error) {
+	mm_atomic.AddUint64(&mmUpdateOrderItems.beforeUpdateOrderItemsCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateOrderItems.afterUpdateOrderItemsCounter, 1)
+
+	if mmUpdateOrderItems.inspectFuncUpdateOrderItems != nil {
+		mmUpdateOrderItems.inspectFuncUpdateOrderItems(ctx, orderID, items)
+	}
+
+	mm_params := &OrdersRepositoryMockUpdateOrderItemsParams{ctx, orderID, items}
+
+	// Record call args
+	mmUpdateOrderItems.UpdateOrderItemsMock.mutex.Lock()
+	mmUpdateOrderItems.UpdateOrderItemsMock.callArgs = append(mmUpdateOrderItems.UpdateOrderItemsMock.callArgs, mm_params)
+	mmUpdateOrderItems.UpdateOrderItemsMock.mutex.Unlock()
+
+	for _, e := range mmUpdateOrderItems.UpdateOrderItemsMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
 		}
 	}
 
-	return mmStocks
+	if mmUpdateOrderItems.UpdateOrderItemsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateOrderItems.UpdateOrderItemsMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateOrderItems.UpdateOrderItemsMock.defaultExpectation.params
+		mm_got := OrdersRepositoryMockUpdateOrderItemsParams{ctx, orderID, items}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateOrderItems.t.Errorf("OrdersRepositoryMock.UpdateOrderItems got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateOrderItems.UpdateOrderItemsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateOrderItems.t.Fatal("No results are set for the OrdersRepositoryMock.UpdateOrderItems")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdateOrderItems.funcUpdateOrderItems != nil {
+		return mmUpdateOrderItems.funcUpdateOrderItems(ctx, orderID, items)
+	}
+	mmUpdateOrderItems.t.Fatalf("Unexpected call to OrdersRepositoryMock.UpdateOrderItems. %v %v %v", ctx, orderID, items)
+	return
 }
 
-// Inspect accepts an inspector function that has same arguments as the OrdersRepository.Stocks
-func (mmStocks *mOrdersRepositoryMockStocks) Inspect(f func(ctx context.Context, sku uint32)) *mOrdersRepositoryMockStocks {
-	if mmStocks.mock.inspectFuncStocks != nil {
-		mmStocks.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.Stocks")
+// UpdateOrderItemsAfterCounter returns a count of finished OrdersRepositoryMock.UpdateOrderItems invocations
+func (mmUpdateOrderItems *OrdersRepositoryMock) UpdateOrderItemsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateOrderItems.afterUpdateOrderItemsCounter)
+}
+
+// UpdateOrderItemsBeforeCounter returns a count of OrdersRepositoryMock.UpdateOrderItems invocations
+func (mmUpdateOrderItems *OrdersRepositoryMock) UpdateOrderItemsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateOrderItems.beforeUpdateOrderItemsCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrdersRepositoryMock.UpdateOrderItems.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateOrderItems *mOrdersRepositoryMockUpdateOrderItems) Calls() []*OrdersRepositoryMockUpdateOrderItemsParams {
+	mmUpdateOrderItems.mutex.RLock()
+
+	argCopy := make([]*OrdersRepositoryMockUpdateOrderItemsParams, len(mmUpdateOrderItems.callArgs))
+	copy(argCopy, mmUpdateOrderItems.callArgs)
+
+	mmUpdateOrderItems.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateOrderItemsDone returns true if the count of the UpdateOrderItems invocations corresponds
+// the number of defined expectations
+func (m *OrdersRepositoryMock) MinimockUpdateOrderItemsDone() bool {
+	for _, e := range m.UpdateOrderItemsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
 	}
 
-	mmStocks.mock.inspectFuncStocks = f
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateOrderItemsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdateOrderItemsCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateOrderItems != nil && mm_atomic.LoadUint64(&m.afterUpdateOrderItemsCounter) < 1 {
+		return false
+	}
+	return true
+}
 
-	return mmStocks
+// MinimockUpdateOrderItemsInspect logs each unmet expectation
+func (m *OrdersRepositoryMock) MinimockUpdateOrderItemsInspect() {
+	for _, e := range m.UpdateOrderItemsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.UpdateOrderItems with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateOrderItemsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdateOrderItemsCounter) < 1 {
+		if m.UpdateOrderItemsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OrdersRepositoryMock.UpdateOrderItems")
+		} else {
+			m.t.Errorf("Expected call to OrdersRepositoryMock.UpdateOrderItems with params: %#v", *m.UpdateOrderItemsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateOrderItems != nil && mm_atomic.LoadUint64(&m.afterUpdateOrderItemsCounter) < 1 {
+		m.t.Error("Expected call to OrdersRepositoryMock.UpdateOrderItems")
+	}
 }
 
-// Return sets up results that will be returned by OrdersRepository.Stocks
-func (mmStocks *mOrdersRepositoryMockStocks) Return(sa1 []Stock, err error) *OrdersRepositoryMock {
-	if mmStocks.mock.funcStocks != nil {
-		mmStocks.mock.t.Fatalf("OrdersRepositoryMock.Stocks mock is already set by Set")
+type mOrdersRepositoryMockUpdateOrderStatus struct {
+	mock               *OrdersRepositoryMock
+	defaultExpectation *OrdersRepositoryMockUpdateOrderStatusExpectation
+	expectations       []*OrdersRepositoryMockUpdateOrderStatusExpectation
+
+	callArgs []*OrdersRepositoryMockUpdateOrderStatusParams
+	mutex    sync.RWMutex
+}
+
+// OrdersRepositoryMockUpdateOrderStatusExpectation specifies expectation struct of the OrdersRepository.UpdateOrderStatus
+type OrdersRepositoryMockUpdateOrderStatusExpectation struct {
+	mock    *OrdersRepositoryMock
+	params  *OrdersRepositoryMockUpdateOrderStatusParams
+	results *OrdersRepositoryMockUpdateOrderStatusResults
+	Counter uint64
+}
+
+// OrdersRepositoryMockUpdateOrderStatusParams contains parameters of the OrdersRepository.UpdateOrderStatus
+type OrdersRepositoryMockUpdateOrderStatusParams struct {
+	ctx          context.Context
+	id           int64
+	status       OrderStatus
+	statusBefore OrderStatus
+}
+
+// OrdersRepositoryMockUpdateOrderStatusResults contains results of the OrdersRepository.UpdateOrderStatus
+type OrdersRepositoryMockUpdateOrderStatusResults struct {
+	err error
+}
+
+// Expect sets up expected params for OrdersRepository.UpdateOrderStatus
+func (mmUpdateOrderStatus *mOrdersRepositoryMockUpdateOrderStatus) Expect(ctx context.Context, id int64, status OrderStatus, statusBefore OrderStatus) *mOrdersRepositoryMockUpdateOrderStatus {
+	if mmUpdateOrderStatus.mock.funcUpdateOrderStatus != nil {
+		mmUpdateOrderStatus.mock.t.Fatalf("OrdersRepositoryMock.UpdateOrderStatus mock is already set by Set")
 	}
 
-	if mmStocks.defaultExpectation == nil {
-		mmStocks.defaultExpectation = &OrdersRepositoryMockStocksExpectation{mock: mmStocks.mock}
+	if mmUpdateOrderStatus.defaultExpectation == nil {
+		mmUpdateOrderStatus.defaultExpectation = &OrdersRepositoryMockUpdateOrderStatusExpectation{}
 	}
-	mmStocks.defaultExpectation.results = &OrdersRepositoryMockStocksResults{sa1, err}
-	return mmStocks.mock
+
+	mmUpdateOrderStatus.defaultExpectation.params = &OrdersRepositoryMockUpdateOrderStatusParams{ctx, id, status, statusBefore}
+	for _, e := range mmUpdateOrderStatus.expectations {
+		if minimock.Equal(e.params, mmUpdateOrderStatus.defaultExpectation.params) {
+			mmUpdateOrderStatus.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateOrderStatus.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateOrderStatus
 }
 
-// Set uses given function f to mock the OrdersRepository.Stocks method
-func (mmStocks *mOrdersRepositoryMockStocks) Set(f func(ctx context.Context, sku uint32) (sa1 []Stock, err error)) *OrdersRepositoryMock {
-	if mmStocks.defaultExpectation != nil {
-		mmStocks.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.Stocks method")
+// Inspect accepts an inspector function that has same arguments as the OrdersRepository.UpdateOrderStatus
+func (mmUpdateOrderStatus *mOrdersRepositoryMockUpdateOrderStatus) Inspect(f func(ctx context.Context, id int64, status OrderStatus, statusBefore OrderStatus)) *mOrdersRepositoryMockUpdateOrderStatus {
+	if mmUpdateOrderStatus.mock.inspectFuncUpdateOrderStatus != nil {
+		mmUpdateOrderStatus.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.UpdateOrderStatus")
 	}
 
-	if len(mmStocks.expectations) > 0 {
-		mmStocks.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.Stocks method")
+	mmUpdateOrderStatus.mock.inspectFuncUpdateOrderStatus = f
+
+	return mmUpdateOrderStatus
+}
+
+// Return sets up results that will be returned by OrdersRepository.UpdateOrderStatus
+func (mmUpdateOrderStatus *mOrdersRepositoryMockUpdateOrderStatus) Return(err error) *OrdersRepositoryMock {
+	if mmUpdateOrderStatus.mock.funcUpdateOrderStatus != nil {
+		mmUpdateOrderStatus.mock.t.Fatalf("OrdersRepositoryMock.UpdateOrderStatus mock is already set by Set")
 	}
 
-	mmStocks.mock.funcStocks = f
-	return mmStocks.mock
+	if mmUpdateOrderStatus.defaultExpectation == nil {
+		mmUpdateOrderStatus.defaultExpectation = &OrdersRepositoryMockUpdateOrderStatusExpectation{mock: mmUpdateOrderStatus.mock}
+	}
+	mmUpdateOrderStatus.defaultExpectation.results = &OrdersRepositoryMockUpdateOrderStatusResults{err}
+	return mmUpdateOrderStatus.mock
 }
 
-// When sets expectation for the OrdersRepository.Stocks which will trigger the result defined by the following
+// Set uses given function f to mock the OrdersRepository.UpdateOrderStatus method
+func (mmUpdateOrderStatus *mOrdersRepositoryMockUpdateOrderStatus) Set(f func(ctx context.Context, id int64, status OrderStatus, statusBefore OrderStatus) (err error)) *OrdersRepositoryMock {
+	if mmUpdateOrderStatus.defaultExpectation != nil {
+		mmUpdateOrderStatus.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.UpdateOrderStatus method")
+	}
+
+	if len(mmUpdateOrderStatus.expectations) > 0 {
+		mmUpdateOrderStatus.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.UpdateOrderStatus method")
+	}
+
+	mmUpdateOrderStatus.mock.funcUpdateOrderStatus = f
+	return mmUpdateOrderStatus.mock
+}
+
+// When sets expectation for the OrdersRepository.UpdateOrderStatus which will trigger the result defined by the following
 // Then helper
-func (mmStocks *mOrdersRepositoryMockStocks) When(ctx context.Context, sku uint32) *OrdersRepositoryMockStocksExpectation {
-	if mmStocks.mock.funcStocks != nil {
-		mmStocks.mock.t.Fatalf("OrdersRepositoryMock.Stocks mock is already set by Set")
+func (mmUpdateOrderStatus *mOrdersRepositoryMockUpdateOrderStatus) When(ctx context.Context, id int64, status OrderStatus, statusBefore OrderStatus) *OrdersRepositoryMockUpdateOrderStatusExpectation {
+	if mmUpdateOrderStatus.mock.funcUpdateOrderStatus != nil {
+		mmUpdateOrderStatus.mock.t.Fatalf("OrdersRepositoryMock.UpdateOrderStatus mock is already set by Set")
 	}
 
-	expectation := &OrdersRepositoryMockStocksExpectation{
-		mock:   mmStocks.mock,
-		params: &OrdersRepositoryMockStocksParams{ctx, sku},
+	expectation := &OrdersRepositoryMockUpdateOrderStatusExpectation{
+		mock:   mmUpdateOrderStatus.mock,
+		params: &OrdersRepositoryMockUpdateOrderStatusParams{ctx, id, status, statusBefore},
 	}
-	mmStocks.expectations = append(mmStocks.expectations, expectation)
+	mmUpdateOrderStatus.expectations = append(mmUpdateOrderStatus.expectations, expectation)
 	return expectation
 }
 
-// Then sets up OrdersRepository.Stocks return parameters for the expectation previously defined by the When method
-func (e *OrdersRepositoryMockStocksExpectation) Then(sa1 []Stock, err error) *OrdersRepositoryMock {
-	e.results = &OrdersRepositoryMockStocksResults{sa1, err}
+// Then sets up OrdersRepository.UpdateOrderStatus return parameters for the expectation previously defined by the When method
+func (e *OrdersRepositoryMockUpdateOrderStatusExpectation) Then(err error) *OrdersRepositoryMock {
+	e.results = &OrdersRepositoryMockUpdateOrderStatusResults{err}
 	return e.mock
 }
 
-// Stocks implements OrdersRepository
-func (mmStocks *OrdersRepositoryMock) Stocks(ctx context.Context, sku uint32) (sa1 []Stock, err error) {
-	mm_atomic.AddUint64(&mmStocks.beforeStocksCounter, 1)
-	defer mm_atomic.AddUint64(&mmStocks.afterStocksCounter, 1)
+// UpdateOrderStatus implements OrdersRepository
+func (mmUpdateOrderStatus *OrdersRepositoryMock) UpdateOrderStatus(ctx context.Context, id int64, status OrderStatus, statusBefore OrderStatus) (err error) {
+	mm_atomic.AddUint64(&mmUpdateOrderStatus.beforeUpdateOrderStatusCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateOrderStatus.afterUpdateOrderStatusCounter, 1)
 
-	if mmStocks.inspectFuncStocks != nil {
-		mmStocks.inspectFuncStocks(ctx, sku)
+	if mmUpdateOrderStatus.inspectFuncUpdateOrderStatus != nil {
+		mmUpdateOrderStatus.inspectFuncUpdateOrderStatus(ctx, id, status, statusBefore)
 	}
 
-	mm_params := &OrdersRepositoryMockStocksParams{ctx, sku}
+	mm_params := &OrdersRepositoryMockUpdateOrderStatusParams{ctx, id, status, statusBefore}
 
 	// Record call args
-	mmStocks.StocksMock.mutex.Lock()
-	mmStocks.StocksMock.callArgs = append(mmStocks.StocksMock.callArgs, mm_params)
-	mmStocks.StocksMock.mutex.Unlock()
+	mmUpdateOrderStatus.UpdateOrderStatusMock.mutex.Lock()
+	mmUpdateOrderStatus.UpdateOrderStatusMock.callArgs = append(mmUpdateOrderStatus.UpdateOrderStatusMock.callArgs, mm_params)
+	mmUpdateOrderStatus.UpdateOrderStatusMock.mutex.Unlock()
 
-	for _, e := range mmStocks.StocksMock.expectations {
+	for _, e := range mmUpdateOrderStatus.UpdateOrderStatusMock.expectations {
 		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.sa1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmStocks.StocksMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmStocks.StocksMock.defaultExpectation.Counter, 1)
-		mm_want := mmStocks.StocksMock.defaultExpectation.params
-		mm_got := OrdersRepositoryMockStocksParams{ctx, sku}
+	if mmUpdateOrderStatus.UpdateOrderStatusMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateOrderStatus.UpdateOrderStatusMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateOrderStatus.UpdateOrderStatusMock.defaultExpectation.params
+		mm_got := OrdersRepositoryMockUpdateOrderStatusParams{ctx, id, status, statusBefore}
 		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmStocks.t.Errorf("OrdersRepositoryMock.Stocks got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUpdateOrderStatus.t.Errorf("OrdersRepositoryMock.UpdateOrderStatus got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmStocks.StocksMock.defaultExpectation.results
+		mm_results := mmUpdateOrderStatus.UpdateOrderStatusMock.defaultExpectation.results
 		if mm_results == nil {
-			mmStocks.t.Fatal("No results are set for the OrdersRepositoryMock.Stocks")
+			mmUpdateOrderStatus.t.Fatal("No results are set for the OrdersRepositoryMock.UpdateOrderStatus")
 		}
-		return (*mm_results).sa1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmStocks.funcStocks != nil {
-		return mmStocks.funcStocks(ctx, sku)
+	if mmUpdateOrderStatus.funcUpdateOrderStatus != nil {
+		return mmUpdateOrderStatus.funcUpdateOrderStatus(ctx, id, status, statusBefore)
 	}
-	mmStocks.t.Fatalf("Unexpected call to OrdersRepositoryMock.Stocks. %v %v", ctx, sku)
+	mmUpdateOrderStatus.t.Fatalf("Unexpected call to OrdersRepositoryMock.UpdateOrderStatus. %v %v %v %v", ctx, id, status, statusBefore)
 	return
 }
 
-// StocksAfterCounter returns a count of finished OrdersRepositoryMock.Stocks invocations
-func (mmStocks *OrdersRepositoryMock) StocksAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmStocks.afterStocksCounter)
+// UpdateOrderStatusAfterCounter returns a count of finished OrdersRepositoryMock.UpdateOrderStatus invocations
+func (mmUpdateOrderStatus *OrdersRepositoryMock) UpdateOrderStatusAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateOrderStatus.afterUpdateOrderStatusCounter)
 }
 
-// StocksBeforeCounter returns a count of OrdersRepositoryMock.Stocks invocations
-func (mmStocks *OrdersRepositoryMock) StocksBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmStocks.beforeStocksCounter)
+// UpdateOrderStatusBeforeCounter returns a count of OrdersRepositoryMock.UpdateOrderStatus invocations
+func (mmUpdateOrderStatus *OrdersRepositoryMock) UpdateOrderStatusBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateOrderStatus.beforeUpdateOrderStatusCounter)
 }
 
-// Calls returns a list of arguments used in each call to OrdersRepositoryMock.Stocks.
+// Calls returns a list of arguments used in each call to OrdersRepositoryMock.UpdateOrderStatus.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmStocks *mOrdersRepositoryMockStocks) Calls() []*OrdersRepositoryMockStocksParams {
-	mmStocks.mutex.RLock()
+func (mmUpdateOrderStatus *mOrdersRepositoryMockUpdateOrderStatus) Calls() []*OrdersRepositoryMockUpdateOrderStatusParams {
+	mmUpdateOrderStatus.mutex.RLock()
 
-	argCopy := make([]*OrdersRepositoryMockStocksParams, len(mmStocks.callArgs))
-	copy(argCopy, mmStocks.callArgs)
+	argCopy := make([]*OrdersRepositoryMockUpdateOrderStatusParams, len(mmUpdateOrderStatus.callArgs))
+	copy(argCopy, mmUpdateOrderStatus.callArgs)
 
-	mmStocks.mutex.RUnlock()
+	mmUpdateOrderStatus.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockStocksDone returns true if the count of the Stocks invocations corresponds
+// MinimockUpdateOrderStatusDone returns true if the count of the UpdateOrderStatus invocations corresponds
 // the number of defined expectations
-func (m *OrdersRepositoryMock) MinimockStocksDone() bool {
-	for _, e := range m.StocksMock.expectations {
+func (m *OrdersRepositoryMock) MinimockUpdateOrderStatusDone() bool {
+	for _, e := range m.UpdateOrderStatusMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.StocksMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterStocksCounter) < 1 {
+	if m.UpdateOrderStatusMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdateOrderStatusCounter) < 1 {
 		return false
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcStocks != nil && mm_atomic.LoadUint64(&m.afterStocksCounter) < 1 {
+	if m.funcUpdateOrderStatus != nil && mm_atomic.LoadUint64(&m.afterUpdateOrderStatusCounter) < 1 {
 		return false
 	}
 	return true
 }
 
-// MinimockStocksInspect logs each unmet expectation
-func (m *OrdersRepositoryMock) MinimockStocksInspect() {
-	for _, e := range m.StocksMock.expectations {
+// MinimockUpdateOrderStatusInspect logs each unmet expectation
+func (m *OrdersRepositoryMock) MinimockUpdateOrderStatusInspect() {
+	for _, e := range m.UpdateOrderStatusMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to OrdersRepositoryMock.Stocks with params: %#v", *e.params)
+			m.t.Errorf("Expected call to OrdersRepositoryMock.UpdateOrderStatus with params: %#v", *e.params)
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.StocksMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterStocksCounter) < 1 {
-		if m.StocksMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to OrdersRepositoryMock.Stocks")
+	if m.UpdateOrderStatusMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdateOrderStatusCounter) < 1 {
+		if m.UpdateOrderStatusMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OrdersRepositoryMock.UpdateOrderStatus")
 		} else {
-			m.t.Errorf("Expected call to OrdersRepositoryMock.Stocks with params: %#v", *m.StocksMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to OrdersRepositoryMock.UpdateOrderStatus with params: %#v", *m.UpdateOrderStatusMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcStocks != nil && mm_atomic.LoadUint64(&m.afterStocksCounter) < 1 {
-		m.t.Error("Expected call to OrdersRepositoryMock.Stocks")
+	if m.funcUpdateOrderStatus != nil && mm_atomic.LoadUint64(&m.afterUpdateOrderStatusCounter) < 1 {
+		m.t.Error("Expected call to OrdersRepositoryMock.UpdateOrderStatus")
 	}
 }
 
-type mOrdersRepositoryMockUnReserveItems struct {
+type mOrdersRepositoryMockUpdateSellerOrdersStatus struct {
 	mock               *OrdersRepositoryMock
-	defaultExpectation *OrdersRepositoryMockUnReserveItemsExpectation
-	expectations       []*OrdersRepositoryMockUnReserveItemsExpectation
+	defaultExpectation *OrdersRepositoryMockUpdateSellerOrdersStatusExpectation
+	expectations       []*OrdersRepositoryMockUpdateSellerOrdersStatusExpectation
 
-	callArgs []*OrdersRepositoryMockUnReserveItemsParams
+	callArgs []*OrdersRepositoryMockUpdateSellerOrdersStatusParams
 	mutex    sync.RWMutex
 }
 
-// OrdersRepositoryMockUnReserveItemsExpectation specifies expectation struct of the OrdersRepository.UnReserveItems
-type OrdersRepositoryMockUnReserveItemsExpectation struct {
+// OrdersRepositoryMockUpdateSellerOrdersStatusExpectation specifies expectation struct of the OrdersRepository.UpdateSellerOrdersStatus
+type OrdersRepositoryMockUpdateSellerOrdersStatusExpectation struct {
 	mock    *OrdersRepositoryMock
-	params  *OrdersRepositoryMockUnReserveItemsParams
-	results *OrdersRepositoryMockUnReserveItemsResults
+	params  *OrdersRepositoryMockUpdateSellerOrdersStatusParams
+	results *OrdersRepositoryMockUpdateSellerOrdersStatusResults
 	Counter uint64
 }
 
-// OrdersRepositoryMockUnReserveItemsParams contains parameters of the OrdersRepository.UnReserveItems
-type OrdersRepositoryMockUnReserveItemsParams struct {
-	ctx     context.Context
-	orderID int64
+// OrdersRepositoryMockUpdateSellerOrdersStatusParams contains parameters of the OrdersRepository.UpdateSellerOrdersStatus
+type OrdersRepositoryMockUpdateSellerOrdersStatusParams struct {
+	ctx       context.Context
+	orderID   int64
+	sellerIDs []int64
+	status    OrderStatus
 }
 
-// OrdersRepositoryMockUnReserveItemsResults contains results of the OrdersRepository.UnReserveItems
-type OrdersRepositoryMockUnReserveItemsResults struct {
+// OrdersRepositoryMockUpdateSellerOrdersStatusResults contains results of the OrdersRepository.UpdateSellerOrdersStatus
+type OrdersRepositoryMockUpdateSellerOrdersStatusResults struct {
 	err error
 }
 
-// Expect sets up expected params for OrdersRepository.UnReserveItems
-func (mmUnReserveItems *mOrdersRepositoryMockUnReserveItems) Expect(ctx context.Context, orderID int64) *mOrdersRepositoryMockUnReserveItems {
-	if mmUnReserveItems.mock.funcUnReserveItems != nil {
-		mmUnReserveItems.mock.t.Fatalf("OrdersRepositoryMock.UnReserveItems mock is already set by Set")
+// Expect sets up expected params for OrdersRepository.UpdateSellerOrdersStatus
+func (mmUpdateSellerOrdersStatus *mOrdersRepositoryMockUpdateSellerOrdersStatus) Expect(ctx context.Context, orderID int64, sellerIDs []int64, status OrderStatus) *mOrdersRepositoryMockUpdateSellerOrdersStatus {
+	if mmUpdateSellerOrdersStatus.mock.funcUpdateSellerOrdersStatus != nil {
+		mmUpdateSellerOrdersStatus.mock.t.Fatalf("OrdersRepositoryMock.UpdateSellerOrdersStatus mock is already set by Set")
 	}
 
-	if mmUnReserveItems.defaultExpectation == nil {
-		mmUnReserveItems.defaultExpectation = &OrdersRepositoryMockUnReserveItemsExpectation{}
+	if mmUpdateSellerOrdersStatus.defaultExpectation == nil {
+		mmUpdateSellerOrdersStatus.defaultExpectation = &OrdersRepositoryMockUpdateSellerOrdersStatusExpectation{}
 	}
 
-	mmUnReserveItems.defaultExpectation.params = &OrdersRepositoryMockUnReserveItemsParams{ctx, orderID}
-	for _, e := range mmUnReserveItems.expectations {
-		if minimock.Equal(e.params, mmUnReserveItems.defaultExpectation.params) {
-			mmUnReserveItems.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUnReserveItems.defaultExpectation.params)
+	mmUpdateSellerOrdersStatus.defaultExpectation.params = &OrdersRepositoryMockUpdateSellerOrdersStatusParams{ctx, orderID, sellerIDs, status}
+	for _, e := range mmUpdateSellerOrdersStatus.expectations {
+		if minimock.Equal(e.params, mmUpdateSellerOrdersStatus.defaultExpectation.params) {
+			mmUpdateSellerOrdersStatus.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateSellerOrdersStatus.defaultExpectation.params)
 		}
 	}
 
-	return mmUnReserveItems
+	return mmUpdateSellerOrdersStatus
 }
 
-// Inspect accepts an inspector function that has same arguments as the OrdersRepository.UnReserveItems
-func (mmUnReserveItems *mOrdersRepositoryMockUnReserveItems) Inspect(f func(ctx context.Context, orderID int64)) *mOrdersRepositoryMockUnReserveItems {
-	if mmUnReserveItems.mock.inspectFuncUnReserveItems != nil {
-		mmUnReserveItems.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.UnReserveItems")
+// Inspect accepts an inspector function that has same arguments as the OrdersRepository.UpdateSellerOrdersStatus
+func (mmUpdateSellerOrdersStatus *mOrdersRepositoryMockUpdateSellerOrdersStatus) Inspect(f func(ctx context.Context, orderID int64, sellerIDs []int64, status OrderStatus)) *mOrdersRepositoryMockUpdateSellerOrdersStatus {
+	if mmUpdateSellerOrdersStatus.mock.inspectFuncUpdateSellerOrdersStatus != nil {
+		mmUpdateSellerOrdersStatus.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.UpdateSellerOrdersStatus")
 	}
 
-	mmUnReserveItems.mock.inspectFuncUnReserveItems = f
+	mmUpdateSellerOrdersStatus.mock.inspectFuncUpdateSellerOrdersStatus = f
 
-	return mmUnReserveItems
+	return mmUpdateSellerOrdersStatus
 }
 
-// Return sets up results that will be returned by OrdersRepository.UnReserveItems
-func (mmUnReserveItems *mOrdersRepositoryMockUnReserveItems) Return(err error) *OrdersRepositoryMock {
-	if mmUnReserveItems.mock.funcUnReserveItems != nil {
-		mmUnReserveItems.mock.t.Fatalf("OrdersRepositoryMock.UnReserveItems mock is already set by Set")
+// Return sets up results that will be returned by OrdersRepository.UpdateSellerOrdersStatus
+func (mmUpdateSellerOrdersStatus *mOrdersRepositoryMockUpdateSellerOrdersStatus) Return(err error) *OrdersRepositoryMock {
+	if mmUpdateSellerOrdersStatus.mock.funcUpdateSellerOrdersStatus != nil {
+		mmUpdateSellerOrdersStatus.mock.t.Fatalf("OrdersRepositoryMock.UpdateSellerOrdersStatus mock is already set by Set")
 	}
 
-	if mmUnReserveItems.defaultExpectation == nil {
-		mmUnReserveItems.defaultExpectation = &OrdersRepositoryMockUnReserveItemsExpectation{mock: mmUnReserveItems.mock}
+	if mmUpdateSellerOrdersStatus.defaultExpectation == nil {
+		mmUpdateSellerOrdersStatus.defaultExpectation = &OrdersRepositoryMockUpdateSellerOrdersStatusExpectation{mock: mmUpdateSellerOrdersStatus.mock}
 	}
-	mmUnReserveItems.defaultExpectation.results = &OrdersRepositoryMockUnReserveItemsResults{err}
-	return mmUnReserveItems.mock
+	mmUpdateSellerOrdersStatus.defaultExpectation.results = &OrdersRepositoryMockUpdateSellerOrdersStatusResults{err}
+	return mmUpdateSellerOrdersStatus.mock
 }
 
-// Set uses given function f to mock the OrdersRepository.UnReserveItems method
-func (mmUnReserveItems *mOrdersRepositoryMockUnReserveItems) Set(f func(ctx context.Context, orderID int64) (err error)) *OrdersRepositoryMock {
-	if mmUnReserveItems.defaultExpectation != nil {
-		mmUnReserveItems.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.UnReserveItems method")
+// Set uses given function f to mock the OrdersRepository.UpdateSellerOrdersStatus method
+func (mmUpdateSellerOrdersStatus *mOrdersRepositoryMockUpdateSellerOrdersStatus) Set(f func(ctx context.Context, orderID int64, sellerIDs []int64, status OrderStatus) (err error)) *OrdersRepositoryMock {
+	if mmUpdateSellerOrdersStatus.defaultExpectation != nil {
+		mmUpdateSellerOrdersStatus.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.UpdateSellerOrdersStatus method")
 	}
 
-	if len(mmUnReserveItems.expectations) > 0 {
-		mmUnReserveItems.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.UnReserveItems method")
+	if len(mmUpdateSellerOrdersStatus.expectations) > 0 {
+		mmUpdateSellerOrdersStatus.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.UpdateSellerOrdersStatus method")
 	}
 
-	mmUnReserveItems.mock.funcUnReserveItems = f
-	return mmUnReserveItems.mock
+	mmUpdateSellerOrdersStatus.mock.funcUpdateSellerOrdersStatus = f
+	return mmUpdateSellerOrdersStatus.mock
 }
 
-// When sets expectation for the OrdersRepository.UnReserveItems which will trigger the result defined by the following
+// When sets expectation for the OrdersRepository.UpdateSellerOrdersStatus which will trigger the result defined by the following
 // Then helper
-func (mmUnReserveItems *mOrdersRepositoryMockUnReserveItems) When(ctx context.Context, orderID int64) *OrdersRepositoryMockUnReserveItemsExpectation {
-	if mmUnReserveItems.mock.funcUnReserveItems != nil {
-		mmUnReserveItems.mock.t.Fatalf("OrdersRepositoryMock.UnReserveItems mock is already set by Set")
+func (mmUpdateSellerOrdersStatus *mOrdersRepositoryMockUpdateSellerOrdersStatus) When(ctx context.Context, orderID int64, sellerIDs []int64, status OrderStatus) *OrdersRepositoryMockUpdateSellerOrdersStatusExpectation {
+	if mmUpdateSellerOrdersStatus.mock.funcUpdateSellerOrdersStatus != nil {
+		mmUpdateSellerOrdersStatus.mock.t.Fatalf("OrdersRepositoryMock.UpdateSellerOrdersStatus mock is already set by Set")
 	}
 
-	expectation := &OrdersRepositoryMockUnReserveItemsExpectation{
-		mock:   mmUnReserveItems.mock,
-		params: &OrdersRepositoryMockUnReserveItemsParams{ctx, orderID},
+	expectation := &OrdersRepositoryMockUpdateSellerOrdersStatusExpectation{
+		mock:   mmUpdateSellerOrdersStatus.mock,
+		params: &OrdersRepositoryMockUpdateSellerOrdersStatusParams{ctx, orderID, sellerIDs, status},
 	}
-	mmUnReserveItems.expectations = append(mmUnReserveItems.expectations, expectation)
+	mmUpdateSellerOrdersStatus.expectations = append(mmUpdateSellerOrdersStatus.expectations, expectation)
 	return expectation
 }
 
-// Then sets up OrdersRepository.UnReserveItems return parameters for the expectation previously defined by the When method
-func (e *OrdersRepositoryMockUnReserveItemsExpectation) Then(err error) *OrdersRepositoryMock {
-	e.results = &OrdersRepositoryMockUnReserveItemsResults{err}
+// Then sets up OrdersRepository.UpdateSellerOrdersStatus return parameters for the expectation previously defined by the When method
+func (e *OrdersRepositoryMockUpdateSellerOrdersStatusExpectation) Then(err error) *OrdersRepositoryMock {
+	e.results = &OrdersRepositoryMockUpdateSellerOrdersStatusResults{err}
 	return e.mock
 }
 
-// UnReserveItems implements OrdersRepository
-func (mmUnReserveItems *OrdersRepositoryMock) UnReserveItems(ctx context.Context, orderID int64) (err error) {
-	mm_atomic.AddUint64(&mmUnReserveItems.beforeUnReserveItemsCounter, 1)
-	defer mm_atomic.AddUint64(&mmUnReserveItems.afterUnReserveItemsCounter, 1)
+// UpdateSellerOrdersStatus implements OrdersRepository
+func (mmUpdateSellerOrdersStatus *OrdersRepositoryMock) UpdateSellerOrdersStatus(ctx context.Context, orderID int64, sellerIDs []int64, status OrderStatus) (err error) {
+	mm_atomic.AddUint64(&mmUpdateSellerOrdersStatus.beforeUpdateSellerOrdersStatusCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateSellerOrdersStatus.afterUpdateSellerOrdersStatusCounter, 1)
 
-	if mmUnReserveItems.inspectFuncUnReserveItems != nil {
-		mmUnReserveItems.inspectFuncUnReserveItems(ctx, orderID)
+	if mmUpdateSellerOrdersStatus.inspectFuncUpdateSellerOrdersStatus != nil {
+		mmUpdateSellerOrdersStatus.inspectFuncUpdateSellerOrdersStatus(ctx, orderID, sellerIDs, status)
 	}
 
-	mm_params := &OrdersRepositoryMockUnReserveItemsParams{ctx, orderID}
+	mm_params := &OrdersRepositoryMockUpdateSellerOrdersStatusParams{ctx, orderID, sellerIDs, status}
 
 	// Record call args
-	mmUnReserveItems.UnReserveItemsMock.mutex.Lock()
-	mmUnReserveItems.UnReserveItemsMock.callArgs = append(mmUnReserveItems.UnReserveItemsMock.callArgs, mm_params)
-	mmUnReserveItems.UnReserveItemsMock.mutex.Unlock()
+	mmUpdateSellerOrdersStatus.UpdateSellerOrdersStatusMock.mutex.Lock()
+	mmUpdateSellerOrdersStatus.UpdateSellerOrdersStatusMock.callArgs = append(mmUpdateSellerOrdersStatus.UpdateSellerOrdersStatusMock.callArgs, mm_params)
+	mmUpdateSellerOrdersStatus.UpdateSellerOrdersStatusMock.mutex.Unlock()
 
-	for _, e := range mmUnReserveItems.UnReserveItemsMock.expectations {
+	for _, e := range mmUpdateSellerOrdersStatus.UpdateSellerOrdersStatusMock.expectations {
 		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmUnReserveItems.UnReserveItemsMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmUnReserveItems.UnReserveItemsMock.defaultExpectation.Counter, 1)
-		mm_want := mmUnReserveItems.UnReserveItemsMock.defaultExpectation.params
-		mm_got := OrdersRepositoryMockUnReserveItemsParams{ctx, orderID}
+	if mmUpdateSellerOrdersStatus.UpdateSellerOrdersStatusMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateSellerOrdersStatus.UpdateSellerOrdersStatusMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateSellerOrdersStatus.UpdateSellerOrdersStatusMock.defaultExpectation.params
+		mm_got := OrdersRepositoryMockUpdateSellerOrdersStatusParams{ctx, orderID, sellerIDs, status}
 		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmUnReserveItems.t.Errorf("OrdersRepositoryMock.UnReserveItems got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUpdateSellerOrdersStatus.t.Errorf("OrdersRepositoryMock.UpdateSellerOrdersStatus got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmUnReserveItems.UnReserveItemsMock.defaultExpectation.results
+		mm_results := mmUpdateSellerOrdersStatus.UpdateSellerOrdersStatusMock.defaultExpectation.results
 		if mm_results == nil {
-			mmUnReserveItems.t.Fatal("No results are set for the OrdersRepositoryMock.UnReserveItems")
+			mmUpdateSellerOrdersStatus.t.Fatal("No results are set for the OrdersRepositoryMock.UpdateSellerOrdersStatus")
 		}
 		return (*mm_results).err
 	}
-	if mmUnReserveItems.funcUnReserveItems != nil {
-		return mmUnReserveItems.funcUnReserveItems(ctx, orderID)
+	if mmUpdateSellerOrdersStatus.funcUpdateSellerOrdersStatus != nil {
+		return mmUpdateSellerOrdersStatus.funcUpdateSellerOrdersStatus(ctx, orderID, sellerIDs, status)
 	}
-	mmUnReserveItems.t.Fatalf("Unexpected call to OrdersRepositoryMock.UnReserveItems. %v %v", ctx, orderID)
+	mmUpdateSellerOrdersStatus.t.Fatalf("Unexpected call to OrdersRepositoryMock.UpdateSellerOrdersStatus. %v %v %v %v", ctx, orderID, sellerIDs, status)
 	return
 }
 
-// UnReserveItemsAfterCounter returns a count of finished OrdersRepositoryMock.UnReserveItems invocations
-func (mmUnReserveItems *OrdersRepositoryMock) UnReserveItemsAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmUnReserveItems.afterUnReserveItemsCounter)
+// UpdateSellerOrdersStatusAfterCounter returns a count of finished OrdersRepositoryMock.UpdateSellerOrdersStatus invocations
+func (mmUpdateSellerOrdersStatus *OrdersRepositoryMock) UpdateSellerOrdersStatusAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateSellerOrdersStatus.afterUpdateSellerOrdersStatusCounter)
 }
 
-// UnReserveItemsBeforeCounter returns a count of OrdersRepositoryMock.UnReserveItems invocations
-func (mmUnReserveItems *OrdersRepositoryMock) UnReserveItemsBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmUnReserveItems.beforeUnReserveItemsCounter)
+// UpdateSellerOrdersStatusBeforeCounter returns a count of OrdersRepositoryMock.UpdateSellerOrdersStatus invocations
+func (mmUpdateSellerOrdersStatus *OrdersRepositoryMock) UpdateSellerOrdersStatusBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateSellerOrdersStatus.beforeUpdateSellerOrdersStatusCounter)
 }
 
-// Calls returns a list of arguments used in each call to OrdersRepositoryMock.UnReserveItems.
+// Calls returns a list of arguments used in each call to OrdersRepositoryMock.UpdateSellerOrdersStatus.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmUnReserveItems *mOrdersRepositoryMockUnReserveItems) Calls() []*OrdersRepositoryMockUnReserveItemsParams {
-	mmUnReserveItems.mutex.RLock()
+func (mmUpdateSellerOrdersStatus *mOrdersRepositoryMockUpdateSellerOrdersStatus) Calls() []*OrdersRepositoryMockUpdateSellerOrdersStatusParams {
+	mmUpdateSellerOrdersStatus.mutex.RLock()
 
-	argCopy := make([]*OrdersRepositoryMockUnReserveItemsParams, len(mmUnReserveItems.callArgs))
-	copy(argCopy, mmUnReserveItems.callArgs)
+	argCopy := make([]*OrdersRepositoryMockUpdateSellerOrdersStatusParams, len(mmUpdateSellerOrdersStatus.callArgs))
+	copy(argCopy, mmUpdateSellerOrdersStatus.callArgs)
 
-	mmUnReserveItems.mutex.RUnlock()
+	mmUpdateSellerOrdersStatus.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockUnReserveItemsDone returns true if the count of the UnReserveItems invocations corresponds
+// MinimockUpdateSellerOrdersStatusDone returns true if the count of the UpdateSellerOrdersStatus invocations corresponds
 // the number of defined expectations
-func (m *OrdersRepositoryMock) MinimockUnReserveItemsDone() bool {
-	for _, e := range m.UnReserveItemsMock.expectations {
+func (m *OrdersRepositoryMock) MinimockUpdateSellerOrdersStatusDone() bool {
+	for _, e := range m.UpdateSellerOrdersStatusMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.UnReserveItemsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUnReserveItemsCounter) < 1 {
+	if m.UpdateSellerOrdersStatusMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdateSellerOrdersStatusCounter) < 1 {
 		return false
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcUnReserveItems != nil && mm_atomic.LoadUint64(&m.afterUnReserveItemsCounter) < 1 {
+	if m.funcUpdateSellerOrdersStatus != nil && mm_atomic.LoadUint64(&m.afterUpdateSellerOrdersStatusCounter) < 1 {
 		return false
 	}
 	return true
 }
 
-// MinimockUnReserveItemsInspect logs each unmet expectation
-func (m *OrdersRepositoryMock) MinimockUnReserveItemsInspect() {
-	for _, e := range m.UnReserveItemsMock.expectations {
+// MinimockUpdateSellerOrdersStatusInspect logs each unmet expectation
+func (m *OrdersRepositoryMock) MinimockUpdateSellerOrdersStatusInspect() {
+	for _, e := range m.UpdateSellerOrdersStatusMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to OrdersRepositoryMock.UnReserveItems with params: %#v", *e.params)
+			m.t.Errorf("Expected call to OrdersRepositoryMock.UpdateSellerOrdersStatus with params: %#v", *e.params)
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.UnReserveItemsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUnReserveItemsCounter) < 1 {
-		if m.UnReserveItemsMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to OrdersRepositoryMock.UnReserveItems")
+	if m.UpdateSellerOrdersStatusMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdateSellerOrdersStatusCounter) < 1 {
+		if m.UpdateSellerOrdersStatusMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OrdersRepositoryMock.UpdateSellerOrdersStatus")
 		} else {
-			m.t.Errorf("Expected call to OrdersRepositoryMock.UnReserveItems with params: %#v", *m.UnReserveItemsMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to OrdersRepositoryMock.UpdateSellerOrdersStatus with params: %#v", *m.UpdateSellerOrdersStatusMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcUnReserveItems != nil && mm_atomic.LoadUint64(&m.afterUnReserveItemsCounter) < 1 {
-		m.t.Error("Expected call to OrdersRepositoryMock.UnReserveItems")
+	if m.funcUpdateSellerOrdersStatus != nil && mm_atomic.LoadUint64(&m.afterUpdateSellerOrdersStatusCounter) < 1 {
+		m.t.Error("Expected call to OrdersRepositoryMock.UpdateSellerOrdersStatus")
 	}
 }
 
-type mOrdersRepositoryMockUpdateOrderStatus struct {
+type mOrdersRepositoryMockUserStocks struct {
 	mock               *OrdersRepositoryMock
-	defaultExpectation *OrdersRepositoryMockUpdateOrderStatusExpectation
-	expectations       []*OrdersRepositoryMockUpdateOrderStatusExpectation
+	defaultExpectation *OrdersRepositoryMockUserStocksExpectation
+	expectations       []*OrdersRepositoryMockUserStocksExpectation
 
-	callArgs []*OrdersRepositoryMockUpdateOrderStatusParams
+	callArgs []*OrdersRepositoryMockUserStocksParams
 	mutex    sync.RWMutex
 }
 
-// OrdersRepositoryMockUpdateOrderStatusExpectation specifies expectation struct of the OrdersRepository.UpdateOrderStatus
-type OrdersRepositoryMockUpdateOrderStatusExpectation struct {
+// OrdersRepositoryMockUserStocksExpectation specifies expectation struct of the OrdersRepository.UserStocks
+type OrdersRepositoryMockUserStocksExpectation struct {
 	mock    *OrdersRepositoryMock
-	params  *OrdersRepositoryMockUpdateOrderStatusParams
-	results *OrdersRepositoryMockUpdateOrderStatusResults
+	params  *OrdersRepositoryMockUserStocksParams
+	results *OrdersRepositoryMockUserStocksResults
 	Counter uint64
 }
 
-// OrdersRepositoryMockUpdateOrderStatusParams contains parameters of the OrdersRepository.UpdateOrderStatus
-type OrdersRepositoryMockUpdateOrderStatusParams struct {
-	ctx          context.Context
-	id           int64
-	status       string
-	statusBefore string
+// OrdersRepositoryMockUserStocksParams contains parameters of the OrdersRepository.UserStocks
+type OrdersRepositoryMockUserStocksParams struct {
+	ctx  context.Context
+	user int64
+	skus []uint32
 }
 
-// OrdersRepositoryMockUpdateOrderStatusResults contains results of the OrdersRepository.UpdateOrderStatus
-type OrdersRepositoryMockUpdateOrderStatusResults struct {
+// OrdersRepositoryMockUserStocksResults contains results of the OrdersRepository.UserStocks
+type OrdersRepositoryMockUserStocksResults struct {
+	m1  map[uint32][]Stock
 	err error
 }
 
-// Expect sets up expected params for OrdersRepository.UpdateOrderStatus
-func (mmUpdateOrderStatus *mOrdersRepositoryMockUpdateOrderStatus) Expect(ctx context.Context, id int64, status string, statusBefore string) *mOrdersRepositoryMockUpdateOrderStatus {
-	if mmUpdateOrderStatus.mock.funcUpdateOrderStatus != nil {
-		mmUpdateOrderStatus.mock.t.Fatalf("OrdersRepositoryMock.UpdateOrderStatus mock is already set by Set")
+// Expect sets up expected params for OrdersRepository.UserStocks
+func (mmUserStocks *mOrdersRepositoryMockUserStocks) Expect(ctx context.Context, user int64, skus []uint32) *mOrdersRepositoryMockUserStocks {
+	if mmUserStocks.mock.funcUserStocks != nil {
+		mmUserStocks.mock.t.Fatalf("OrdersRepositoryMock.UserStocks mock is already set by Set")
 	}
 
-	if mmUpdateOrderStatus.defaultExpectation == nil {
-		mmUpdateOrderStatus.defaultExpectation = &OrdersRepositoryMockUpdateOrderStatusExpectation{}
+	if mmUserStocks.defaultExpectation == nil {
+		mmUserStocks.defaultExpectation = &OrdersRepositoryMockUserStocksExpectation{}
 	}
 
-	mmUpdateOrderStatus.defaultExpectation.params = &OrdersRepositoryMockUpdateOrderStatusParams{ctx, id, status, statusBefore}
-	for _, e := range mmUpdateOrderStatus.expectations {
-		if minimock.Equal(e.params, mmUpdateOrderStatus.defaultExpectation.params) {
-			mmUpdateOrderStatus.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateOrderStatus.defaultExpectation.params)
+	mmUserStocks.defaultExpectation.params = &OrdersRepositoryMockUserStocksParams{ctx, user, skus}
+	for _, e := range mmUserStocks.expectations {
+		if minimock.Equal(e.params, mmUserStocks.defaultExpectation.params) {
+			mmUserStocks.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUserStocks.defaultExpectation.params)
 		}
 	}
 
-	return mmUpdateOrderStatus
+	return mmUserStocks
 }
 
-// Inspect accepts an inspector function that has same arguments as the OrdersRepository.UpdateOrderStatus
-func (mmUpdateOrderStatus *mOrdersRepositoryMockUpdateOrderStatus) Inspect(f func(ctx context.Context, id int64, status string, statusBefore string)) *mOrdersRepositoryMockUpdateOrderStatus {
-	if mmUpdateOrderStatus.mock.inspectFuncUpdateOrderStatus != nil {
-		mmUpdateOrderStatus.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.UpdateOrderStatus")
+// Inspect accepts an inspector function that has same arguments as the OrdersRepository.UserStocks
+func (mmUserStocks *mOrdersRepositoryMockUserStocks) Inspect(f func(ctx context.Context, user int64, skus []uint32)) *mOrdersRepositoryMockUserStocks {
+	if mmUserStocks.mock.inspectFuncUserStocks != nil {
+		mmUserStocks.mock.t.Fatalf("Inspect function is already set for OrdersRepositoryMock.UserStocks")
 	}
 
-	mmUpdateOrderStatus.mock.inspectFuncUpdateOrderStatus = f
+	mmUserStocks.mock.inspectFuncUserStocks = f
 
-	return mmUpdateOrderStatus
+	return mmUserStocks
 }
 
-// Return sets up results that will be returned by OrdersRepository.UpdateOrderStatus
-func (mmUpdateOrderStatus *mOrdersRepositoryMockUpdateOrderStatus) Return(err error) *OrdersRepositoryMock {
-	if mmUpdateOrderStatus.mock.funcUpdateOrderStatus != nil {
-		mmUpdateOrderStatus.mock.t.Fatalf("OrdersRepositoryMock.UpdateOrderStatus mock is already set by Set")
+// Return sets up results that will be returned by OrdersRepository.UserStocks
+func (mmUserStocks *mOrdersRepositoryMockUserStocks) Return(m1 map[uint32][]Stock, err error) *OrdersRepositoryMock {
+	if mmUserStocks.mock.funcUserStocks != nil {
+		mmUserStocks.mock.t.Fatalf("OrdersRepositoryMock.UserStocks mock is already set by Set")
 	}
 
-	if mmUpdateOrderStatus.defaultExpectation == nil {
-		mmUpdateOrderStatus.defaultExpectation = &OrdersRepositoryMockUpdateOrderStatusExpectation{mock: mmUpdateOrderStatus.mock}
+	if mmUserStocks.defaultExpectation == nil {
+		mmUserStocks.defaultExpectation = &OrdersRepositoryMockUserStocksExpectation{mock: mmUserStocks.mock}
 	}
-	mmUpdateOrderStatus.defaultExpectation.results = &OrdersRepositoryMockUpdateOrderStatusResults{err}
-	return mmUpdateOrderStatus.mock
+	mmUserStocks.defaultExpectation.results = &OrdersRepositoryMockUserStocksResults{m1, err}
+	return mmUserStocks.mock
 }
 
-// Set uses given function f to mock the OrdersRepository.UpdateOrderStatus method
-func (mmUpdateOrderStatus *mOrdersRepositoryMockUpdateOrderStatus) Set(f func(ctx context.Context, id int64, status string, statusBefore string) (err error)) *OrdersRepositoryMock {
-	if mmUpdateOrderStatus.defaultExpectation != nil {
-		mmUpdateOrderStatus.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.UpdateOrderStatus method")
+// Set uses given function f to mock the OrdersRepository.UserStocks method
+func (mmUserStocks *mOrdersRepositoryMockUserStocks) Set(f func(ctx context.Context, user int64, skus []uint32) (m1 map[uint32][]Stock, err error)) *OrdersRepositoryMock {
+	if mmUserStocks.defaultExpectation != nil {
+		mmUserStocks.mock.t.Fatalf("Default expectation is already set for the OrdersRepository.UserStocks method")
 	}
 
-	if len(mmUpdateOrderStatus.expectations) > 0 {
-		mmUpdateOrderStatus.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.UpdateOrderStatus method")
+	if len(mmUserStocks.expectations) > 0 {
+		mmUserStocks.mock.t.Fatalf("Some expectations are already set for the OrdersRepository.UserStocks method")
 	}
 
-	mmUpdateOrderStatus.mock.funcUpdateOrderStatus = f
-	return mmUpdateOrderStatus.mock
+	mmUserStocks.mock.funcUserStocks = f
+	return mmUserStocks.mock
 }
 
-// When sets expectation for the OrdersRepository.UpdateOrderStatus which will trigger the result defined by the following
+// When sets expectation for the OrdersRepository.UserStocks which will trigger the result defined by the following
 // Then helper
-func (mmUpdateOrderStatus *mOrdersRepositoryMockUpdateOrderStatus) When(ctx context.Context, id int64, status string, statusBefore string) *OrdersRepositoryMockUpdateOrderStatusExpectation {
-	if mmUpdateOrderStatus.mock.funcUpdateOrderStatus != nil {
-		mmUpdateOrderStatus.mock.t.Fatalf("OrdersRepositoryMock.UpdateOrderStatus mock is already set by Set")
+func (mmUserStocks *mOrdersRepositoryMockUserStocks) When(ctx context.Context, user int64, skus []uint32) *OrdersRepositoryMockUserStocksExpectation {
+	if mmUserStocks.mock.funcUserStocks != nil {
+		mmUserStocks.mock.t.Fatalf("OrdersRepositoryMock.UserStocks mock is already set by Set")
 	}
 
-	expectation := &OrdersRepositoryMockUpdateOrderStatusExpectation{
-		mock:   mmUpdateOrderStatus.mock,
-		params: &OrdersRepositoryMockUpdateOrderStatusParams{ctx, id, status, statusBefore},
+	expectation := &OrdersRepositoryMockUserStocksExpectation{
+		mock:   mmUserStocks.mock,
+		params: &OrdersRepositoryMockUserStocksParams{ctx, user, skus},
 	}
-	mmUpdateOrderStatus.expectations = append(mmUpdateOrderStatus.expectations, expectation)
+	mmUserStocks.expectations = append(mmUserStocks.expectations, expectation)
 	return expectation
 }
 
-// Then sets up OrdersRepository.UpdateOrderStatus return parameters for the expectation previously defined by the When method
-func (e *OrdersRepositoryMockUpdateOrderStatusExpectation) Then(err error) *OrdersRepositoryMock {
-	e.results = &OrdersRepositoryMockUpdateOrderStatusResults{err}
+// Then sets up OrdersRepository.UserStocks return parameters for the expectation previously defined by the When method
+func (e *OrdersRepositoryMockUserStocksExpectation) Then(m1 map[uint32][]Stock, err error) *OrdersRepositoryMock {
+	e.results = &OrdersRepositoryMockUserStocksResults{m1, err}
 	return e.mock
 }
 
-// UpdateOrderStatus implements OrdersRepository
-func (mmUpdateOrderStatus *OrdersRepositoryMock) UpdateOrderStatus(ctx context.Context, id int64, status string, statusBefore string) (err error) {
-	mm_atomic.AddUint64(&mmUpdateOrderStatus.beforeUpdateOrderStatusCounter, 1)
-	defer mm_atomic.AddUint64(&mmUpdateOrderStatus.afterUpdateOrderStatusCounter, 1)
+// UserStocks implements OrdersRepository
+func (mmUserStocks *OrdersRepositoryMock) UserStocks(ctx context.Context, user int64, skus []uint32) (m1 map[uint32][]Stock, err error) {
+	mm_atomic.AddUint64(&mmUserStocks.beforeUserStocksCounter, 1)
+	defer mm_atomic.AddUint64(&mmUserStocks.afterUserStocksCounter, 1)
 
-	if mmUpdateOrderStatus.inspectFuncUpdateOrderStatus != nil {
-		mmUpdateOrderStatus.inspectFuncUpdateOrderStatus(ctx, id, status, statusBefore)
+	if mmUserStocks.inspectFuncUserStocks != nil {
+		mmUserStocks.inspectFuncUserStocks(ctx, user, skus)
 	}
 
-	mm_params := &OrdersRepositoryMockUpdateOrderStatusParams{ctx, id, status, statusBefore}
+	mm_params := &OrdersRepositoryMockUserStocksParams{ctx, user, skus}
 
 	// Record call args
-	mmUpdateOrderStatus.UpdateOrderStatusMock.mutex.Lock()
-	mmUpdateOrderStatus.UpdateOrderStatusMock.callArgs = append(mmUpdateOrderStatus.UpdateOrderStatusMock.callArgs, mm_params)
-	mmUpdateOrderStatus.UpdateOrderStatusMock.mutex.Unlock()
+	mmUserStocks.UserStocksMock.mutex.Lock()
+	mmUserStocks.UserStocksMock.callArgs = append(mmUserStocks.UserStocksMock.callArgs, mm_params)
+	mmUserStocks.UserStocksMock.mutex.Unlock()
 
-	for _, e := range mmUpdateOrderStatus.UpdateOrderStatusMock.expectations {
+	for _, e := range mmUserStocks.UserStocksMock.expectations {
 		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.m1, e.results.err
 		}
 	}
 
-	if mmUpdateOrderStatus.UpdateOrderStatusMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmUpdateOrderStatus.UpdateOrderStatusMock.defaultExpectation.Counter, 1)
-		mm_want := mmUpdateOrderStatus.UpdateOrderStatusMock.defaultExpectation.params
-		mm_got := OrdersRepositoryMockUpdateOrderStatusParams{ctx, id, status, statusBefore}
+	if mmUserStocks.UserStocksMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUserStocks.UserStocksMock.defaultExpectation.Counter, 1)
+		mm_want := mmUserStocks.UserStocksMock.defaultExpectation.params
+		mm_got := OrdersRepositoryMockUserStocksParams{ctx, user, skus}
 		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmUpdateOrderStatus.t.Errorf("OrdersRepositoryMock.UpdateOrderStatus got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUserStocks.t.Errorf("OrdersRepositoryMock.UserStocks got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmUpdateOrderStatus.UpdateOrderStatusMock.defaultExpectation.results
+		mm_results := mmUserStocks.UserStocksMock.defaultExpectation.results
 		if mm_results == nil {
-			mmUpdateOrderStatus.t.Fatal("No results are set for the OrdersRepositoryMock.UpdateOrderStatus")
+			mmUserStocks.t.Fatal("No results are set for the OrdersRepositoryMock.UserStocks")
 		}
-		return (*mm_results).err
+		return (*mm_results).m1, (*mm_results).err
 	}
-	if mmUpdateOrderStatus.funcUpdateOrderStatus != nil {
-		return mmUpdateOrderStatus.funcUpdateOrderStatus(ctx, id, status, statusBefore)
+	if mmUserStocks.funcUserStocks != nil {
+		return mmUserStocks.funcUserStocks(ctx, user, skus)
 	}
-	mmUpdateOrderStatus.t.Fatalf("Unexpected call to OrdersRepositoryMock.UpdateOrderStatus. %v %v %v %v", ctx, id, status, statusBefore)
+	mmUserStocks.t.Fatalf("Unexpected call to OrdersRepositoryMock.UserStocks. %v %v %v", ctx, user, skus)
 	return
 }
 
-// UpdateOrderStatusAfterCounter returns a count of finished OrdersRepositoryMock.UpdateOrderStatus invocations
-func (mmUpdateOrderStatus *OrdersRepositoryMock) UpdateOrderStatusAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmUpdateOrderStatus.afterUpdateOrderStatusCounter)
+// UserStocksAfterCounter returns a count of finished OrdersRepositoryMock.UserStocks invocations
+func (mmUserStocks *OrdersRepositoryMock) UserStocksAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUserStocks.afterUserStocksCounter)
 }
 
-// UpdateOrderStatusBeforeCounter returns a count of OrdersRepositoryMock.UpdateOrderStatus invocations
-func (mmUpdateOrderStatus *OrdersRepositoryMock) UpdateOrderStatusBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmUpdateOrderStatus.beforeUpdateOrderStatusCounter)
+// UserStocksBeforeCounter returns a count of OrdersRepositoryMock.UserStocks invocations
+func (mmUserStocks *OrdersRepositoryMock) UserStocksBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUserStocks.beforeUserStocksCounter)
 }
 
-// Calls returns a list of arguments used in each call to OrdersRepositoryMock.UpdateOrderStatus.
+// Calls returns a list of arguments used in each call to OrdersRepositoryMock.UserStocks.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmUpdateOrderStatus *mOrdersRepositoryMockUpdateOrderStatus) Calls() []*OrdersRepositoryMockUpdateOrderStatusParams {
-	mmUpdateOrderStatus.mutex.RLock()
+func (mmUserStocks *mOrdersRepositoryMockUserStocks) Calls() []*OrdersRepositoryMockUserStocksParams {
+	mmUserStocks.mutex.RLock()
 
-	argCopy := make([]*OrdersRepositoryMockUpdateOrderStatusParams, len(mmUpdateOrderStatus.callArgs))
-	copy(argCopy, mmUpdateOrderStatus.callArgs)
+	argCopy := make([]*OrdersRepositoryMockUserStocksParams, len(mmUserStocks.callArgs))
+	copy(argCopy, mmUserStocks.callArgs)
 
-	mmUpdateOrderStatus.mutex.RUnlock()
+	mmUserStocks.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockUpdateOrderStatusDone returns true if the count of the UpdateOrderStatus invocations corresponds
+// MinimockUserStocksDone returns true if the count of the UserStocks invocations corresponds
 // the number of defined expectations
-func (m *OrdersRepositoryMock) MinimockUpdateOrderStatusDone() bool {
-	for _, e := range m.UpdateOrderStatusMock.expectations {
+func (m *OrdersRepositoryMock) MinimockUserStocksDone() bool {
+	for _, e := range m.UserStocksMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.UpdateOrderStatusMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdateOrderStatusCounter) < 1 {
+	if m.UserStocksMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUserStocksCounter) < 1 {
 		return false
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcUpdateOrderStatus != nil && mm_atomic.LoadUint64(&m.afterUpdateOrderStatusCounter) < 1 {
+	if m.funcUserStocks != nil && mm_atomic.LoadUint64(&m.afterUserStocksCounter) < 1 {
 		return false
 	}
 	return true
 }
 
-// MinimockUpdateOrderStatusInspect logs each unmet expectation
-func (m *OrdersRepositoryMock) MinimockUpdateOrderStatusInspect() {
-	for _, e := range m.UpdateOrderStatusMock.expectations {
+// MinimockUserStocksInspect logs each unmet expectation
+func (m *OrdersRepositoryMock) MinimockUserStocksInspect() {
+	for _, e := range m.UserStocksMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to OrdersRepositoryMock.UpdateOrderStatus with params: %#v", *e.params)
+			m.t.Errorf("Expected call to OrdersRepositoryMock.UserStocks with params: %#v", *e.params)
 		}
 	}
 
 	// if default expectation was set then invocations count should be greater than zero
-	if m.UpdateOrderStatusMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdateOrderStatusCounter) < 1 {
-		if m.UpdateOrderStatusMock.defaultExpectation.params == nil {
-			m.t.Error("Expected call to OrdersRepositoryMock.UpdateOrderStatus")
+	if m.UserStocksMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUserStocksCounter) < 1 {
+		if m.UserStocksMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OrdersRepositoryMock.UserStocks")
 		} else {
-			m.t.Errorf("Expected call to OrdersRepositoryMock.UpdateOrderStatus with params: %#v", *m.UpdateOrderStatusMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to OrdersRepositoryMock.UserStocks with params: %#v", *m.UserStocksMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcUpdateOrderStatus != nil && mm_atomic.LoadUint64(&m.afterUpdateOrderStatusCounter) < 1 {
-		m.t.Error("Expected call to OrdersRepositoryMock.UpdateOrderStatus")
+	if m.funcUserStocks != nil && mm_atomic.LoadUint64(&m.afterUserStocksCounter) < 1 {
+		m.t.Error("Expected call to OrdersRepositoryMock.UserStocks")
 	}
 }
 
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *OrdersRepositoryMock) MinimockFinish() {
 	if !m.minimockDone() {
+		m.MinimockAddStatusChangeInspect()
+
+		m.MinimockBatchStocksInspect()
+
+		m.MinimockClaimNewOrderInspect()
+
+		m.MinimockCreateBackordersInspect()
+
 		m.MinimockCreateOrderInspect()
 
+		m.MinimockCreateOrderNotificationInspect()
+
+		m.MinimockCreateReturnItemsInspect()
+
+		m.MinimockCreateSellerOrderInspect()
+
+		m.MinimockCreateShortagesInspect()
+
+		m.MinimockDeleteBackordersInspect()
+
+		m.MinimockDeleteSellerOrdersInspect()
+
+		m.MinimockGetBackordersInspect()
+
+		m.MinimockGetExpiredOrdersInspect()
+
 		m.MinimockGetOrderInspect()
 
+		m.MinimockGetOrderHistoryInspect()
+
+		m.MinimockGetOrderIDByIdempotencyKeyInspect()
+
+		m.MinimockGetReturnItemsInspect()
+
+		m.MinimockGetShortagesInspect()
+
+		m.MinimockHoldStockInspect()
+
+		m.MinimockListSellerOrdersInspect()
+
+		m.MinimockListSkuBackordersInspect()
+
+		m.MinimockListUserOrdersInspect()
+
+		m.MinimockReleaseExpiredHoldsInspect()
+
+		m.MinimockReleaseHoldsInspect()
+
 		m.MinimockRemoveSoldItemsInspect()
 
 		m.MinimockReserveStockInspect()
 
+		m.MinimockSetBackorderDeadlineInspect()
+
+		m.MinimockSetPaymentDeadlineInspect()
+
 		m.MinimockStocksInspect()
 
 		m.MinimockUnReserveItemsInspect()
 
+		m.MinimockUpdateBackorderInspect()
+
+		m.MinimockUpdateOrderItemsInspect()
+
 		m.MinimockUpdateOrderStatusInspect()
+
+		m.MinimockUpdateSellerOrdersStatusInspect()
+
+		m.MinimockUserStocksInspect()
 		m.t.FailNow()
 	}
 }
@@ -1648,11 +8036,39 @@ func (m *OrdersRepositoryMock) MinimockWait(timeout mm_time.Duration) {
 func (m *OrdersRepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockAddStatusChangeDone() &&
+		m.MinimockBatchStocksDone() &&
+		m.MinimockClaimNewOrderDone() &&
+		m.MinimockCreateBackordersDone() &&
 		m.MinimockCreateOrderDone() &&
+		m.MinimockCreateOrderNotificationDone() &&
+		m.MinimockCreateReturnItemsDone() &&
+		m.MinimockCreateSellerOrderDone() &&
+		m.MinimockCreateShortagesDone() &&
+		m.MinimockDeleteBackordersDone() &&
+		m.MinimockDeleteSellerOrdersDone() &&
+		m.MinimockGetBackordersDone() &&
+		m.MinimockGetExpiredOrdersDone() &&
 		m.MinimockGetOrderDone() &&
+		m.MinimockGetOrderHistoryDone() &&
+		m.MinimockGetOrderIDByIdempotencyKeyDone() &&
+		m.MinimockGetReturnItemsDone() &&
+		m.MinimockGetShortagesDone() &&
+		m.MinimockHoldStockDone() &&
+		m.MinimockListSellerOrdersDone() &&
+		m.MinimockListSkuBackordersDone() &&
+		m.MinimockListUserOrdersDone() &&
+		m.MinimockReleaseExpiredHoldsDone() &&
+		m.MinimockReleaseHoldsDone() &&
 		m.MinimockRemoveSoldItemsDone() &&
 		m.MinimockReserveStockDone() &&
+		m.MinimockSetBackorderDeadlineDone() &&
+		m.MinimockSetPaymentDeadlineDone() &&
 		m.MinimockStocksDone() &&
 		m.MinimockUnReserveItemsDone() &&
-		m.MinimockUpdateOrderStatusDone()
+		m.MinimockUpdateBackorderDone() &&
+		m.MinimockUpdateOrderItemsDone() &&
+		m.MinimockUpdateOrderStatusDone() &&
+		m.MinimockUpdateSellerOrdersStatusDone() &&
+		m.MinimockUserStocksDone()
 }
@@ -0,0 +1,513 @@
+package domain
+
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
+
+//go:generate minimock -i route256/loms/internal/domain.PaymentProvider -o ./zzz_payment_provider_minimock_test.go -n PaymentProviderMock
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// PaymentProviderMock implements PaymentProvider
+type PaymentProviderMock struct {
+	t minimock.Tester
+
+	funcCreatePayment          func(ctx context.Context, intent PaymentIntent) (pp1 *Payment, err error)
+	inspectFuncCreatePayment   func(ctx context.Context, intent PaymentIntent)
+	afterCreatePaymentCounter  uint64
+	beforeCreatePaymentCounter uint64
+	CreatePaymentMock          mPaymentProviderMockCreatePayment
+
+	funcRefund          func(ctx context.Context, refund Refund) (err error)
+	inspectFuncRefund   func(ctx context.Context, refund Refund)
+	afterRefundCounter  uint64
+	beforeRefundCounter uint64
+	RefundMock          mPaymentProviderMockRefund
+}
+
+// NewPaymentProviderMock returns a mock for PaymentProvider
+func NewPaymentProviderMock(t minimock.Tester) *PaymentProviderMock {
+	m := &PaymentProviderMock{t: t}
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreatePaymentMock = mPaymentProviderMockCreatePayment{mock: m}
+	m.CreatePaymentMock.callArgs = []*PaymentProviderMockCreatePaymentParams{}
+
+	m.RefundMock = mPaymentProviderMockRefund{mock: m}
+	m.RefundMock.callArgs = []*PaymentProviderMockRefundParams{}
+
+	return m
+}
+
+type mPaymentProviderMockCreatePayment struct {
+	mock               *PaymentProviderMock
+	defaultExpectation *PaymentProviderMockCreatePaymentExpectation
+	expectations       []*PaymentProviderMockCreatePaymentExpectation
+
+	callArgs []*PaymentProviderMockCreatePaymentParams
+	mutex    sync.RWMutex
+}
+
+// PaymentProviderMockCreatePaymentExpectation specifies expectation struct of the PaymentProvider.CreatePayment
+type PaymentProviderMockCreatePaymentExpectation struct {
+	mock    *PaymentProviderMock
+	params  *PaymentProviderMockCreatePaymentParams
+	results *PaymentProviderMockCreatePaymentResults
+	Counter uint64
+}
+
+// PaymentProviderMockCreatePaymentParams contains parameters of the PaymentProvider.CreatePayment
+type PaymentProviderMockCreatePaymentParams struct {
+	ctx    context.Context
+	intent PaymentIntent
+}
+
+// PaymentProviderMockCreatePaymentResults contains results of the PaymentProvider.CreatePayment
+type PaymentProviderMockCreatePaymentResults struct {
+	pp1 *Payment
+	err error
+}
+
+// Expect sets up expected params for PaymentProvider.CreatePayment
+func (mmCreatePayment *mPaymentProviderMockCreatePayment) Expect(ctx context.Context, intent PaymentIntent) *mPaymentProviderMockCreatePayment {
+	if mmCreatePayment.mock.funcCreatePayment != nil {
+		mmCreatePayment.mock.t.Fatalf("PaymentProviderMock.CreatePayment mock is already set by Set")
+	}
+
+	if mmCreatePayment.defaultExpectation == nil {
+		mmCreatePayment.defaultExpectation = &PaymentProviderMockCreatePaymentExpectation{}
+	}
+
+	mmCreatePayment.defaultExpectation.params = &PaymentProviderMockCreatePaymentParams{ctx, intent}
+	for _, e := range mmCreatePayment.expectations {
+		if minimock.Equal(e.params, mmCreatePayment.defaultExpectation.params) {
+			mmCreatePayment.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreatePayment.defaultExpectation.params)
+		}
+	}
+
+	return mmCreatePayment
+}
+
+// Inspect accepts an inspector function that has same arguments as the PaymentProvider.CreatePayment
+func (mmCreatePayment *mPaymentProviderMockCreatePayment) Inspect(f func(ctx context.Context, intent PaymentIntent)) *mPaymentProviderMockCreatePayment {
+	if mmCreatePayment.mock.inspectFuncCreatePayment != nil {
+		mmCreatePayment.mock.t.Fatalf("Inspect function is already set for PaymentProviderMock.CreatePayment")
+	}
+
+	mmCreatePayment.mock.inspectFuncCreatePayment = f
+
+	return mmCreatePayment
+}
+
+// Return sets up results that will be returned by PaymentProvider.CreatePayment
+func (mmCreatePayment *mPaymentProviderMockCreatePayment) Return(pp1 *Payment, err error) *PaymentProviderMock {
+	if mmCreatePayment.mock.funcCreatePayment != nil {
+		mmCreatePayment.mock.t.Fatalf("PaymentProviderMock.CreatePayment mock is already set by Set")
+	}
+
+	if mmCreatePayment.defaultExpectation == nil {
+		mmCreatePayment.defaultExpectation = &PaymentProviderMockCreatePaymentExpectation{mock: mmCreatePayment.mock}
+	}
+	mmCreatePayment.defaultExpectation.results = &PaymentProviderMockCreatePaymentResults{pp1, err}
+	return mmCreatePayment.mock
+}
+
+// Set uses given function f to mock the PaymentProvider.CreatePayment method
+func (mmCreatePayment *mPaymentProviderMockCreatePayment) Set(f func(ctx context.Context, intent PaymentIntent) (pp1 *Payment, err error)) *PaymentProviderMock {
+	if mmCreatePayment.defaultExpectation != nil {
+		mmCreatePayment.mock.t.Fatalf("Default expectation is already set for the PaymentProvider.CreatePayment method")
+	}
+
+	if len(mmCreatePayment.expectations) > 0 {
+		mmCreatePayment.mock.t.Fatalf("Some expectations are already set for the PaymentProvider.CreatePayment method")
+	}
+
+	mmCreatePayment.mock.funcCreatePayment = f
+	return mmCreatePayment.mock
+}
+
+// When sets expectation for the PaymentProvider.CreatePayment which will trigger the result defined by the following
+// Then helper
+func (mmCreatePayment *mPaymentProviderMockCreatePayment) When(ctx context.Context, intent PaymentIntent) *PaymentProviderMockCreatePaymentExpectation {
+	if mmCreatePayment.mock.funcCreatePayment != nil {
+		mmCreatePayment.mock.t.Fatalf("PaymentProviderMock.CreatePayment mock is already set by Set")
+	}
+
+	expectation := &PaymentProviderMockCreatePaymentExpectation{
+		mock:   mmCreatePayment.mock,
+		params: &PaymentProviderMockCreatePaymentParams{ctx, intent},
+	}
+	mmCreatePayment.expectations = append(mmCreatePayment.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PaymentProvider.CreatePayment return parameters for the expectation previously defined by the When method
+func (e *PaymentProviderMockCreatePaymentExpectation) Then(pp1 *Payment, err error) *PaymentProviderMock {
+	e.results = &PaymentProviderMockCreatePaymentResults{pp1, err}
+	return e.mock
+}
+
+// CreatePayment implements PaymentProvider
+func (mmCreatePayment *PaymentProviderMock) CreatePayment(ctx context.Context, intent PaymentIntent) (pp1 *Payment, err error) {
+	mm_atomic.AddUint64(&mmCreatePayment.beforeCreatePaymentCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreatePayment.afterCreatePaymentCounter, 1)
+
+	if mmCreatePayment.inspectFuncCreatePayment != nil {
+		mmCreatePayment.inspectFuncCreatePayment(ctx, intent)
+	}
+
+	mm_params := &PaymentProviderMockCreatePaymentParams{ctx, intent}
+
+	// Record call args
+	mmCreatePayment.CreatePaymentMock.mutex.Lock()
+	mmCreatePayment.CreatePaymentMock.callArgs = append(mmCreatePayment.CreatePaymentMock.callArgs, mm_params)
+	mmCreatePayment.CreatePaymentMock.mutex.Unlock()
+
+	for _, e := range mmCreatePayment.CreatePaymentMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pp1, e.results.err
+		}
+	}
+
+	if mmCreatePayment.CreatePaymentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreatePayment.CreatePaymentMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreatePayment.CreatePaymentMock.defaultExpectation.params
+		mm_got := PaymentProviderMockCreatePaymentParams{ctx, intent}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreatePayment.t.Errorf("PaymentProviderMock.CreatePayment got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreatePayment.CreatePaymentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreatePayment.t.Fatal("No results are set for the PaymentProviderMock.CreatePayment")
+		}
+		return (*mm_results).pp1, (*mm_results).err
+	}
+	if mmCreatePayment.funcCreatePayment != nil {
+		return mmCreatePayment.funcCreatePayment(ctx, intent)
+	}
+	mmCreatePayment.t.Fatalf("Unexpected call to PaymentProviderMock.CreatePayment. %v %v", ctx, intent)
+	return
+}
+
+// CreatePaymentAfterCounter returns a count of finished PaymentProviderMock.CreatePayment invocations
+func (mmCreatePayment *PaymentProviderMock) CreatePaymentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePayment.afterCreatePaymentCounter)
+}
+
+// CreatePaymentBeforeCounter returns a count of PaymentProviderMock.CreatePayment invocations
+func (mmCreatePayment *PaymentProviderMock) CreatePaymentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePayment.beforeCreatePaymentCounter)
+}
+
+// Calls returns a list of arguments used in each call to PaymentProviderMock.CreatePayment.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreatePayment *mPaymentProviderMockCreatePayment) Calls() []*PaymentProviderMockCreatePaymentParams {
+	mmCreatePayment.mutex.RLock()
+
+	argCopy := make([]*PaymentProviderMockCreatePaymentParams, len(mmCreatePayment.callArgs))
+	copy(argCopy, mmCreatePayment.callArgs)
+
+	mmCreatePayment.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreatePaymentDone returns true if the count of the CreatePayment invocations corresponds
+// the number of defined expectations
+func (m *PaymentProviderMock) MinimockCreatePaymentDone() bool {
+	for _, e := range m.CreatePaymentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreatePaymentMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreatePaymentCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreatePayment != nil && mm_atomic.LoadUint64(&m.afterCreatePaymentCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockCreatePaymentInspect logs each unmet expectation
+func (m *PaymentProviderMock) MinimockCreatePaymentInspect() {
+	for _, e := range m.CreatePaymentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PaymentProviderMock.CreatePayment with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreatePaymentMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreatePaymentCounter) < 1 {
+		if m.CreatePaymentMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PaymentProviderMock.CreatePayment")
+		} else {
+			m.t.Errorf("Expected call to PaymentProviderMock.CreatePayment with params: %#v", *m.CreatePaymentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreatePayment != nil && mm_atomic.LoadUint64(&m.afterCreatePaymentCounter) < 1 {
+		m.t.Error("Expected call to PaymentProviderMock.CreatePayment")
+	}
+}
+
+type mPaymentProviderMockRefund struct {
+	mock               *PaymentProviderMock
+	defaultExpectation *PaymentProviderMockRefundExpectation
+	expectations       []*PaymentProviderMockRefundExpectation
+
+	callArgs []*PaymentProviderMockRefundParams
+	mutex    sync.RWMutex
+}
+
+// PaymentProviderMockRefundExpectation specifies expectation struct of the PaymentProvider.Refund
+type PaymentProviderMockRefundExpectation struct {
+	mock    *PaymentProviderMock
+	params  *PaymentProviderMockRefundParams
+	results *PaymentProviderMockRefundResults
+	Counter uint64
+}
+
+// PaymentProviderMockRefundParams contains parameters of the PaymentProvider.Refund
+type PaymentProviderMockRefundParams struct {
+	ctx    context.Context
+	refund Refund
+}
+
+// PaymentProviderMockRefundResults contains results of the PaymentProvider.Refund
+type PaymentProviderMockRefundResults struct {
+	err error
+}
+
+// Expect sets up expected params for PaymentProvider.Refund
+func (mmRefund *mPaymentProviderMockRefund) Expect(ctx context.Context, refund Refund) *mPaymentProviderMockRefund {
+	if mmRefund.mock.funcRefund != nil {
+		mmRefund.mock.t.Fatalf("PaymentProviderMock.Refund mock is already set by Set")
+	}
+
+	if mmRefund.defaultExpectation == nil {
+		mmRefund.defaultExpectation = &PaymentProviderMockRefundExpectation{}
+	}
+
+	mmRefund.defaultExpectation.params = &PaymentProviderMockRefundParams{ctx, refund}
+	for _, e := range mmRefund.expectations {
+		if minimock.Equal(e.params, mmRefund.defaultExpectation.params) {
+			mmRefund.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRefund.defaultExpectation.params)
+		}
+	}
+
+	return mmRefund
+}
+
+// Inspect accepts an inspector function that has same arguments as the PaymentProvider.Refund
+func (mmRefund *mPaymentProviderMockRefund) Inspect(f func(ctx context.Context, refund Refund)) *mPaymentProviderMockRefund {
+	if mmRefund.mock.inspectFuncRefund != nil {
+		mmRefund.mock.t.Fatalf("Inspect function is already set for PaymentProviderMock.Refund")
+	}
+
+	mmRefund.mock.inspectFuncRefund = f
+
+	return mmRefund
+}
+
+// Return sets up results that will be returned by PaymentProvider.Refund
+func (mmRefund *mPaymentProviderMockRefund) Return(err error) *PaymentProviderMock {
+	if mmRefund.mock.funcRefund != nil {
+		mmRefund.mock.t.Fatalf("PaymentProviderMock.Refund mock is already set by Set")
+	}
+
+	if mmRefund.defaultExpectation == nil {
+		mmRefund.defaultExpectation = &PaymentProviderMockRefundExpectation{mock: mmRefund.mock}
+	}
+	mmRefund.defaultExpectation.results = &PaymentProviderMockRefundResults{err}
+	return mmRefund.mock
+}
+
+// Set uses given function f to mock the PaymentProvider.Refund method
+func (mmRefund *mPaymentProviderMockRefund) Set(f func(ctx context.Context, refund Refund) (err error)) *PaymentProviderMock {
+	if mmRefund.defaultExpectation != nil {
+		mmRefund.mock.t.Fatalf("Default expectation is already set for the PaymentProvider.Refund method")
+	}
+
+	if len(mmRefund.expectations) > 0 {
+		mmRefund.mock.t.Fatalf("Some expectations are already set for the PaymentProvider.Refund method")
+	}
+
+	mmRefund.mock.funcRefund = f
+	return mmRefund.mock
+}
+
+// When sets expectation for the PaymentProvider.Refund which will trigger the result defined by the following
+// Then helper
+func (mmRefund *mPaymentProviderMockRefund) When(ctx context.Context, refund Refund) *PaymentProviderMockRefundExpectation {
+	if mmRefund.mock.funcRefund != nil {
+		mmRefund.mock.t.Fatalf("PaymentProviderMock.Refund mock is already set by Set")
+	}
+
+	expectation := &PaymentProviderMockRefundExpectation{
+		mock:   mmRefund.mock,
+		params: &PaymentProviderMockRefundParams{ctx, refund},
+	}
+	mmRefund.expectations = append(mmRefund.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PaymentProvider.Refund return parameters for the expectation previously defined by the When method
+func (e *PaymentProviderMockRefundExpectation) Then(err error) *PaymentProviderMock {
+	e.results = &PaymentProviderMockRefundResults{err}
+	return e.mock
+}
+
+// Refund implements PaymentProvider
+func (mmRefund *PaymentProviderMock) Refund(ctx context.Context, refund Refund) (err error) {
+	mm_atomic.AddUint64(&mmRefund.beforeRefundCounter, 1)
+	defer mm_atomic.AddUint64(&mmRefund.afterRefundCounter, 1)
+
+	if mmRefund.inspectFuncRefund != nil {
+		mmRefund.inspectFuncRefund(ctx, refund)
+	}
+
+	mm_params := &PaymentProviderMockRefundParams{ctx, refund}
+
+	// Record call args
+	mmRefund.RefundMock.mutex.Lock()
+	mmRefund.RefundMock.callArgs = append(mmRefund.RefundMock.callArgs, mm_params)
+	mmRefund.RefundMock.mutex.Unlock()
+
+	for _, e := range mmRefund.RefundMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRefund.RefundMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRefund.RefundMock.defaultExpectation.Counter, 1)
+		mm_want := mmRefund.RefundMock.defaultExpectation.params
+		mm_got := PaymentProviderMockRefundParams{ctx, refund}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRefund.t.Errorf("PaymentProviderMock.Refund got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRefund.RefundMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRefund.t.Fatal("No results are set for the PaymentProviderMock.Refund")
+		}
+		return (*mm_results).err
+	}
+	if mmRefund.funcRefund != nil {
+		return mmRefund.funcRefund(ctx, refund)
+	}
+	mmRefund.t.Fatalf("Unexpected call to PaymentProviderMock.Refund. %v %v", ctx, refund)
+	return
+}
+
+// RefundAfterCounter returns a count of finished PaymentProviderMock.Refund invocations
+func (mmRefund *PaymentProviderMock) RefundAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRefund.afterRefundCounter)
+}
+
+// RefundBeforeCounter returns a count of PaymentProviderMock.Refund invocations
+func (mmRefund *PaymentProviderMock) RefundBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRefund.beforeRefundCounter)
+}
+
+// Calls returns a list of arguments used in each call to PaymentProviderMock.Refund.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRefund *mPaymentProviderMockRefund) Calls() []*PaymentProviderMockRefundParams {
+	mmRefund.mutex.RLock()
+
+	argCopy := make([]*PaymentProviderMockRefundParams, len(mmRefund.callArgs))
+	copy(argCopy, mmRefund.callArgs)
+
+	mmRefund.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRefundDone returns true if the count of the Refund invocations corresponds
+// the number of defined expectations
+func (m *PaymentProviderMock) MinimockRefundDone() bool {
+	for _, e := range m.RefundMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RefundMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRefundCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRefund != nil && mm_atomic.LoadUint64(&m.afterRefundCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockRefundInspect logs each unmet expectation
+func (m *PaymentProviderMock) MinimockRefundInspect() {
+	for _, e := range m.RefundMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PaymentProviderMock.Refund with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RefundMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterRefundCounter) < 1 {
+		if m.RefundMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PaymentProviderMock.Refund")
+		} else {
+			m.t.Errorf("Expected call to PaymentProviderMock.Refund with params: %#v", *m.RefundMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRefund != nil && mm_atomic.LoadUint64(&m.afterRefundCounter) < 1 {
+		m.t.Error("Expected call to PaymentProviderMock.Refund")
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *PaymentProviderMock) MinimockFinish() {
+	if !m.minimockDone() {
+		m.MinimockCreatePaymentInspect()
+
+		m.MinimockRefundInspect()
+		m.t.FailNow()
+	}
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *PaymentProviderMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *PaymentProviderMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreatePaymentDone() &&
+		m.MinimockRefundDone()
+}
@@ -0,0 +1,1658 @@
+package domain
+
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
+
+//go:generate minimock -i route256/loms/internal/domain.PaymentsRepository -o ./zzz_payments_repo_minimock_test.go -n PaymentsRepositoryMock
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// PaymentsRepositoryMock implements PaymentsRepository
+type PaymentsRepositoryMock struct {
+	t minimock.Tester
+
+	funcCreatePayment          func(ctx context.Context, payment *Payment) (err error)
+	inspectFuncCreatePayment   func(ctx context.Context, payment *Payment)
+	afterCreatePaymentCounter  uint64
+	beforeCreatePaymentCounter uint64
+	CreatePaymentMock          mPaymentsRepositoryMockCreatePayment
+
+	funcCreateRefund          func(ctx context.Context, paymentID string, amount uint64) (err error)
+	inspectFuncCreateRefund   func(ctx context.Context, paymentID string, amount uint64)
+	afterCreateRefundCounter  uint64
+	beforeCreateRefundCounter uint64
+	CreateRefundMock          mPaymentsRepositoryMockCreateRefund
+
+	funcGetActivePayment          func(ctx context.Context, orderID int64) (pp1 *Payment, err error)
+	inspectFuncGetActivePayment   func(ctx context.Context, orderID int64)
+	afterGetActivePaymentCounter  uint64
+	beforeGetActivePaymentCounter uint64
+	GetActivePaymentMock          mPaymentsRepositoryMockGetActivePayment
+
+	funcGetPayment          func(ctx context.Context, id string) (pp1 *Payment, err error)
+	inspectFuncGetPayment   func(ctx context.Context, id string)
+	afterGetPaymentCounter  uint64
+	beforeGetPaymentCounter uint64
+	GetPaymentMock          mPaymentsRepositoryMockGetPayment
+
+	funcGetUnsentRefunds          func(ctx context.Context, limit uint64) (ra1 []Refund, err error)
+	inspectFuncGetUnsentRefunds   func(ctx context.Context, limit uint64)
+	afterGetUnsentRefundsCounter  uint64
+	beforeGetUnsentRefundsCounter uint64
+	GetUnsentRefundsMock          mPaymentsRepositoryMockGetUnsentRefunds
+
+	funcMarkRefundsSent          func(ctx context.Context, ids []int64) (err error)
+	inspectFuncMarkRefundsSent   func(ctx context.Context, ids []int64)
+	afterMarkRefundsSentCounter  uint64
+	beforeMarkRefundsSentCounter uint64
+	MarkRefundsSentMock          mPaymentsRepositoryMockMarkRefundsSent
+
+	funcUpdatePaymentStatus          func(ctx context.Context, id string, status PaymentStatus, statusBefore PaymentStatus) (err error)
+	inspectFuncUpdatePaymentStatus   func(ctx context.Context, id string, status PaymentStatus, statusBefore PaymentStatus)
+	afterUpdatePaymentStatusCounter  uint64
+	beforeUpdatePaymentStatusCounter uint64
+	UpdatePaymentStatusMock          mPaymentsRepositoryMockUpdatePaymentStatus
+}
+
+// NewPaymentsRepositoryMock returns a mock for PaymentsRepository
+func NewPaymentsRepositoryMock(t minimock.Tester) *PaymentsRepositoryMock {
+	m := &PaymentsRepositoryMock{t: t}
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreatePaymentMock = mPaymentsRepositoryMockCreatePayment{mock: m}
+	m.CreatePaymentMock.callArgs = []*PaymentsRepositoryMockCreatePaymentParams{}
+
+	m.CreateRefundMock = mPaymentsRepositoryMockCreateRefund{mock: m}
+	m.CreateRefundMock.callArgs = []*PaymentsRepositoryMockCreateRefundParams{}
+
+	m.GetActivePaymentMock = mPaymentsRepositoryMockGetActivePayment{mock: m}
+	m.GetActivePaymentMock.callArgs = []*PaymentsRepositoryMockGetActivePaymentParams{}
+
+	m.GetPaymentMock = mPaymentsRepositoryMockGetPayment{mock: m}
+	m.GetPaymentMock.callArgs = []*PaymentsRepositoryMockGetPaymentParams{}
+
+	m.GetUnsentRefundsMock = mPaymentsRepositoryMockGetUnsentRefunds{mock: m}
+	m.GetUnsentRefundsMock.callArgs = []*PaymentsRepositoryMockGetUnsentRefundsParams{}
+
+	m.MarkRefundsSentMock = mPaymentsRepositoryMockMarkRefundsSent{mock: m}
+	m.MarkRefundsSentMock.callArgs = []*PaymentsRepositoryMockMarkRefundsSentParams{}
+
+	m.UpdatePaymentStatusMock = mPaymentsRepositoryMockUpdatePaymentStatus{mock: m}
+	m.UpdatePaymentStatusMock.callArgs = []*PaymentsRepositoryMockUpdatePaymentStatusParams{}
+
+	return m
+}
+
+type mPaymentsRepositoryMockCreatePayment struct {
+	mock               *PaymentsRepositoryMock
+	defaultExpectation *PaymentsRepositoryMockCreatePaymentExpectation
+	expectations       []*PaymentsRepositoryMockCreatePaymentExpectation
+
+	callArgs []*PaymentsRepositoryMockCreatePaymentParams
+	mutex    sync.RWMutex
+}
+
+// PaymentsRepositoryMockCreatePaymentExpectation specifies expectation struct of the PaymentsRepository.CreatePayment
+type PaymentsRepositoryMockCreatePaymentExpectation struct {
+	mock    *PaymentsRepositoryMock
+	params  *PaymentsRepositoryMockCreatePaymentParams
+	results *PaymentsRepositoryMockCreatePaymentResults
+	Counter uint64
+}
+
+// PaymentsRepositoryMockCreatePaymentParams contains parameters of the PaymentsRepository.CreatePayment
+type PaymentsRepositoryMockCreatePaymentParams struct {
+	ctx     context.Context
+	payment *Payment
+}
+
+// PaymentsRepositoryMockCreatePaymentResults contains results of the PaymentsRepository.CreatePayment
+type PaymentsRepositoryMockCreatePaymentResults struct {
+	err error
+}
+
+// Expect sets up expected params for PaymentsRepository.CreatePayment
+func (mmCreatePayment *mPaymentsRepositoryMockCreatePayment) Expect(ctx context.Context, payment *Payment) *mPaymentsRepositoryMockCreatePayment {
+	if mmCreatePayment.mock.funcCreatePayment != nil {
+		mmCreatePayment.mock.t.Fatalf("PaymentsRepositoryMock.CreatePayment mock is already set by Set")
+	}
+
+	if mmCreatePayment.defaultExpectation == nil {
+		mmCreatePayment.defaultExpectation = &PaymentsRepositoryMockCreatePaymentExpectation{}
+	}
+
+	mmCreatePayment.defaultExpectation.params = &PaymentsRepositoryMockCreatePaymentParams{ctx, payment}
+	for _, e := range mmCreatePayment.expectations {
+		if minimock.Equal(e.params, mmCreatePayment.defaultExpectation.params) {
+			mmCreatePayment.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreatePayment.defaultExpectation.params)
+		}
+	}
+
+	return mmCreatePayment
+}
+
+// Inspect accepts an inspector function that has same arguments as the PaymentsRepository.CreatePayment
+func (mmCreatePayment *mPaymentsRepositoryMockCreatePayment) Inspect(f func(ctx context.Context, payment *Payment)) *mPaymentsRepositoryMockCreatePayment {
+	if mmCreatePayment.mock.inspectFuncCreatePayment != nil {
+		mmCreatePayment.mock.t.Fatalf("Inspect function is already set for PaymentsRepositoryMock.CreatePayment")
+	}
+
+	mmCreatePayment.mock.inspectFuncCreatePayment = f
+
+	return mmCreatePayment
+}
+
+// Return sets up results that will be returned by PaymentsRepository.CreatePayment
+func (mmCreatePayment *mPaymentsRepositoryMockCreatePayment) Return(err error) *PaymentsRepositoryMock {
+	if mmCreatePayment.mock.funcCreatePayment != nil {
+		mmCreatePayment.mock.t.Fatalf("PaymentsRepositoryMock.CreatePayment mock is already set by Set")
+	}
+
+	if mmCreatePayment.defaultExpectation == nil {
+		mmCreatePayment.defaultExpectation = &PaymentsRepositoryMockCreatePaymentExpectation{mock: mmCreatePayment.mock}
+	}
+	mmCreatePayment.defaultExpectation.results = &PaymentsRepositoryMockCreatePaymentResults{err}
+	return mmCreatePayment.mock
+}
+
+// Set uses given function f to mock the PaymentsRepository.CreatePayment method
+func (mmCreatePayment *mPaymentsRepositoryMockCreatePayment) Set(f func(ctx context.Context, payment *Payment) (err error)) *PaymentsRepositoryMock {
+	if mmCreatePayment.defaultExpectation != nil {
+		mmCreatePayment.mock.t.Fatalf("Default expectation is already set for the PaymentsRepository.CreatePayment method")
+	}
+
+	if len(mmCreatePayment.expectations) > 0 {
+		mmCreatePayment.mock.t.Fatalf("Some expectations are already set for the PaymentsRepository.CreatePayment method")
+	}
+
+	mmCreatePayment.mock.funcCreatePayment = f
+	return mmCreatePayment.mock
+}
+
+// When sets expectation for the PaymentsRepository.CreatePayment which will trigger the result defined by the following
+// Then helper
+func (mmCreatePayment *mPaymentsRepositoryMockCreatePayment) When(ctx context.Context, payment *Payment) *PaymentsRepositoryMockCreatePaymentExpectation {
+	if mmCreatePayment.mock.funcCreatePayment != nil {
+		mmCreatePayment.mock.t.Fatalf("PaymentsRepositoryMock.CreatePayment mock is already set by Set")
+	}
+
+	expectation := &PaymentsRepositoryMockCreatePaymentExpectation{
+		mock:   mmCreatePayment.mock,
+		params: &PaymentsRepositoryMockCreatePaymentParams{ctx, payment},
+	}
+	mmCreatePayment.expectations = append(mmCreatePayment.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PaymentsRepository.CreatePayment return parameters for the expectation previously defined by the When method
+func (e *PaymentsRepositoryMockCreatePaymentExpectation) Then(err error) *PaymentsRepositoryMock {
+	e.results = &PaymentsRepositoryMockCreatePaymentResults{err}
+	return e.mock
+}
+
+// CreatePayment implements PaymentsRepository
+func (mmCreatePayment *PaymentsRepositoryMock) CreatePayment(ctx context.Context, payment *Payment) (err error) {
+	mm_atomic.AddUint64(&mmCreatePayment.beforeCreatePaymentCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreatePayment.afterCreatePaymentCounter, 1)
+
+	if mmCreatePayment.inspectFuncCreatePayment != nil {
+		mmCreatePayment.inspectFuncCreatePayment(ctx, payment)
+	}
+
+	mm_params := &PaymentsRepositoryMockCreatePaymentParams{ctx, payment}
+
+	// Record call args
+	mmCreatePayment.CreatePaymentMock.mutex.Lock()
+	mmCreatePayment.CreatePaymentMock.callArgs = append(mmCreatePayment.CreatePaymentMock.callArgs, mm_params)
+	mmCreatePayment.CreatePaymentMock.mutex.Unlock()
+
+	for _, e := range mmCreatePayment.CreatePaymentMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreatePayment.CreatePaymentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreatePayment.CreatePaymentMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreatePayment.CreatePaymentMock.defaultExpectation.params
+		mm_got := PaymentsRepositoryMockCreatePaymentParams{ctx, payment}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreatePayment.t.Errorf("PaymentsRepositoryMock.CreatePayment got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreatePayment.CreatePaymentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreatePayment.t.Fatal("No results are set for the PaymentsRepositoryMock.CreatePayment")
+		}
+		return (*mm_results).err
+	}
+	if mmCreatePayment.funcCreatePayment != nil {
+		return mmCreatePayment.funcCreatePayment(ctx, payment)
+	}
+	mmCreatePayment.t.Fatalf("Unexpected call to PaymentsRepositoryMock.CreatePayment. %v %v", ctx, payment)
+	return
+}
+
+// CreatePaymentAfterCounter returns a count of finished PaymentsRepositoryMock.CreatePayment invocations
+func (mmCreatePayment *PaymentsRepositoryMock) CreatePaymentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePayment.afterCreatePaymentCounter)
+}
+
+// CreatePaymentBeforeCounter returns a count of PaymentsRepositoryMock.CreatePayment invocations
+func (mmCreatePayment *PaymentsRepositoryMock) CreatePaymentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePayment.beforeCreatePaymentCounter)
+}
+
+// Calls returns a list of arguments used in each call to PaymentsRepositoryMock.CreatePayment.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreatePayment *mPaymentsRepositoryMockCreatePayment) Calls() []*PaymentsRepositoryMockCreatePaymentParams {
+	mmCreatePayment.mutex.RLock()
+
+	argCopy := make([]*PaymentsRepositoryMockCreatePaymentParams, len(mmCreatePayment.callArgs))
+	copy(argCopy, mmCreatePayment.callArgs)
+
+	mmCreatePayment.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreatePaymentDone returns true if the count of the CreatePayment invocations corresponds
+// the number of defined expectations
+func (m *PaymentsRepositoryMock) MinimockCreatePaymentDone() bool {
+	for _, e := range m.CreatePaymentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreatePaymentMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreatePaymentCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreatePayment != nil && mm_atomic.LoadUint64(&m.afterCreatePaymentCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockCreatePaymentInspect logs each unmet expectation
+func (m *PaymentsRepositoryMock) MinimockCreatePaymentInspect() {
+	for _, e := range m.CreatePaymentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PaymentsRepositoryMock.CreatePayment with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreatePaymentMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreatePaymentCounter) < 1 {
+		if m.CreatePaymentMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PaymentsRepositoryMock.CreatePayment")
+		} else {
+			m.t.Errorf("Expected call to PaymentsRepositoryMock.CreatePayment with params: %#v", *m.CreatePaymentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreatePayment != nil && mm_atomic.LoadUint64(&m.afterCreatePaymentCounter) < 1 {
+		m.t.Error("Expected call to PaymentsRepositoryMock.CreatePayment")
+	}
+}
+
+type mPaymentsRepositoryMockCreateRefund struct {
+	mock               *PaymentsRepositoryMock
+	defaultExpectation *PaymentsRepositoryMockCreateRefundExpectation
+	expectations       []*PaymentsRepositoryMockCreateRefundExpectation
+
+	callArgs []*PaymentsRepositoryMockCreateRefundParams
+	mutex    sync.RWMutex
+}
+
+// PaymentsRepositoryMockCreateRefundExpectation specifies expectation struct of the PaymentsRepository.CreateRefund
+type PaymentsRepositoryMockCreateRefundExpectation struct {
+	mock    *PaymentsRepositoryMock
+	params  *PaymentsRepositoryMockCreateRefundParams
+	results *PaymentsRepositoryMockCreateRefundResults
+	Counter uint64
+}
+
+// PaymentsRepositoryMockCreateRefundParams contains parameters of the PaymentsRepository.CreateRefund
+type PaymentsRepositoryMockCreateRefundParams struct {
+	ctx       context.Context
+	paymentID string
+	amount    uint64
+}
+
+// PaymentsRepositoryMockCreateRefundResults contains results of the PaymentsRepository.CreateRefund
+type PaymentsRepositoryMockCreateRefundResults struct {
+	err error
+}
+
+// Expect sets up expected params for PaymentsRepository.CreateRefund
+func (mmCreateRefund *mPaymentsRepositoryMockCreateRefund) Expect(ctx context.Context, paymentID string, amount uint64) *mPaymentsRepositoryMockCreateRefund {
+	if mmCreateRefund.mock.funcCreateRefund != nil {
+		mmCreateRefund.mock.t.Fatalf("PaymentsRepositoryMock.CreateRefund mock is already set by Set")
+	}
+
+	if mmCreateRefund.defaultExpectation == nil {
+		mmCreateRefund.defaultExpectation = &PaymentsRepositoryMockCreateRefundExpectation{}
+	}
+
+	mmCreateRefund.defaultExpectation.params = &PaymentsRepositoryMockCreateRefundParams{ctx, paymentID, amount}
+	for _, e := range mmCreateRefund.expectations {
+		if minimock.Equal(e.params, mmCreateRefund.defaultExpectation.params) {
+			mmCreateRefund.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateRefund.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateRefund
+}
+
+// Inspect accepts an inspector function that has same arguments as the PaymentsRepository.CreateRefund
+func (mmCreateRefund *mPaymentsRepositoryMockCreateRefund) Inspect(f func(ctx context.Context, paymentID string, amount uint64)) *mPaymentsRepositoryMockCreateRefund {
+	if mmCreateRefund.mock.inspectFuncCreateRefund != nil {
+		mmCreateRefund.mock.t.Fatalf("Inspect function is already set for PaymentsRepositoryMock.CreateRefund")
+	}
+
+	mmCreateRefund.mock.inspectFuncCreateRefund = f
+
+	return mmCreateRefund
+}
+
+// Return sets up results that will be returned by PaymentsRepository.CreateRefund
+func (mmCreateRefund *mPaymentsRepositoryMockCreateRefund) Return(err error) *PaymentsRepositoryMock {
+	if mmCreateRefund.mock.funcCreateRefund != nil {
+		mmCreateRefund.mock.t.Fatalf("PaymentsRepositoryMock.CreateRefund mock is already set by Set")
+	}
+
+	if mmCreateRefund.defaultExpectation == nil {
+		mmCreateRefund.defaultExpectation = &PaymentsRepositoryMockCreateRefundExpectation{mock: mmCreateRefund.mock}
+	}
+	mmCreateRefund.defaultExpectation.results = &PaymentsRepositoryMockCreateRefundResults{err}
+	return mmCreateRefund.mock
+}
+
+// Set uses given function f to mock the PaymentsRepository.CreateRefund method
+func (mmCreateRefund *mPaymentsRepositoryMockCreateRefund) Set(f func(ctx context.Context, paymentID string, amount uint64) (err error)) *PaymentsRepositoryMock {
+	if mmCreateRefund.defaultExpectation != nil {
+		mmCreateRefund.mock.t.Fatalf("Default expectation is already set for the PaymentsRepository.CreateRefund method")
+	}
+
+	if len(mmCreateRefund.expectations) > 0 {
+		mmCreateRefund.mock.t.Fatalf("Some expectations are already set for the PaymentsRepository.CreateRefund method")
+	}
+
+	mmCreateRefund.mock.funcCreateRefund = f
+	return mmCreateRefund.mock
+}
+
+// When sets expectation for the PaymentsRepository.CreateRefund which will trigger the result defined by the following
+// Then helper
+func (mmCreateRefund *mPaymentsRepositoryMockCreateRefund) When(ctx context.Context, paymentID string, amount uint64) *PaymentsRepositoryMockCreateRefundExpectation {
+	if mmCreateRefund.mock.funcCreateRefund != nil {
+		mmCreateRefund.mock.t.Fatalf("PaymentsRepositoryMock.CreateRefund mock is already set by Set")
+	}
+
+	expectation := &PaymentsRepositoryMockCreateRefundExpectation{
+		mock:   mmCreateRefund.mock,
+		params: &PaymentsRepositoryMockCreateRefundParams{ctx, paymentID, amount},
+	}
+	mmCreateRefund.expectations = append(mmCreateRefund.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PaymentsRepository.CreateRefund return parameters for the expectation previously defined by the When method
+func (e *PaymentsRepositoryMockCreateRefundExpectation) Then(err error) *PaymentsRepositoryMock {
+	e.results = &PaymentsRepositoryMockCreateRefundResults{err}
+	return e.mock
+}
+
+// CreateRefund implements PaymentsRepository
+func (mmCreateRefund *PaymentsRepositoryMock) CreateRefund(ctx context.Context, paymentID string, amount uint64) (err error) {
+	mm_atomic.AddUint64(&mmCreateRefund.beforeCreateRefundCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateRefund.afterCreateRefundCounter, 1)
+
+	if mmCreateRefund.inspectFuncCreateRefund != nil {
+		mmCreateRefund.inspectFuncCreateRefund(ctx, paymentID, amount)
+	}
+
+	mm_params := &PaymentsRepositoryMockCreateRefundParams{ctx, paymentID, amount}
+
+	// Record call args
+	mmCreateRefund.CreateRefundMock.mutex.Lock()
+	mmCreateRefund.CreateRefundMock.callArgs = append(mmCreateRefund.CreateRefundMock.callArgs, mm_params)
+	mmCreateRefund.CreateRefundMock.mutex.Unlock()
+
+	for _, e := range mmCreateRefund.CreateRefundMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateRefund.CreateRefundMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateRefund.CreateRefundMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateRefund.CreateRefundMock.defaultExpectation.params
+		mm_got := PaymentsRepositoryMockCreateRefundParams{ctx, paymentID, amount}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateRefund.t.Errorf("PaymentsRepositoryMock.CreateRefund got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateRefund.CreateRefundMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateRefund.t.Fatal("No results are set for the PaymentsRepositoryMock.CreateRefund")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateRefund.funcCreateRefund != nil {
+		return mmCreateRefund.funcCreateRefund(ctx, paymentID, amount)
+	}
+	mmCreateRefund.t.Fatalf("Unexpected call to PaymentsRepositoryMock.CreateRefund. %v %v %v", ctx, paymentID, amount)
+	return
+}
+
+// CreateRefundAfterCounter returns a count of finished PaymentsRepositoryMock.CreateRefund invocations
+func (mmCreateRefund *PaymentsRepositoryMock) CreateRefundAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateRefund.afterCreateRefundCounter)
+}
+
+// CreateRefundBeforeCounter returns a count of PaymentsRepositoryMock.CreateRefund invocations
+func (mmCreateRefund *PaymentsRepositoryMock) CreateRefundBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateRefund.beforeCreateRefundCounter)
+}
+
+// Calls returns a list of arguments used in each call to PaymentsRepositoryMock.CreateRefund.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateRefund *mPaymentsRepositoryMockCreateRefund) Calls() []*PaymentsRepositoryMockCreateRefundParams {
+	mmCreateRefund.mutex.RLock()
+
+	argCopy := make([]*PaymentsRepositoryMockCreateRefundParams, len(mmCreateRefund.callArgs))
+	copy(argCopy, mmCreateRefund.callArgs)
+
+	mmCreateRefund.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateRefundDone returns true if the count of the CreateRefund invocations corresponds
+// the number of defined expectations
+func (m *PaymentsRepositoryMock) MinimockCreateRefundDone() bool {
+	for _, e := range m.CreateRefundMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateRefundMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateRefundCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateRefund != nil && mm_atomic.LoadUint64(&m.afterCreateRefundCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockCreateRefundInspect logs each unmet expectation
+func (m *PaymentsRepositoryMock) MinimockCreateRefundInspect() {
+	for _, e := range m.CreateRefundMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PaymentsRepositoryMock.CreateRefund with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateRefundMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateRefundCounter) < 1 {
+		if m.CreateRefundMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PaymentsRepositoryMock.CreateRefund")
+		} else {
+			m.t.Errorf("Expected call to PaymentsRepositoryMock.CreateRefund with params: %#v", *m.CreateRefundMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateRefund != nil && mm_atomic.LoadUint64(&m.afterCreateRefundCounter) < 1 {
+		m.t.Error("Expected call to PaymentsRepositoryMock.CreateRefund")
+	}
+}
+
+type mPaymentsRepositoryMockGetActivePayment struct {
+	mock               *PaymentsRepositoryMock
+	defaultExpectation *PaymentsRepositoryMockGetActivePaymentExpectation
+	expectations       []*PaymentsRepositoryMockGetActivePaymentExpectation
+
+	callArgs []*PaymentsRepositoryMockGetActivePaymentParams
+	mutex    sync.RWMutex
+}
+
+// PaymentsRepositoryMockGetActivePaymentExpectation specifies expectation struct of the PaymentsRepository.GetActivePayment
+type PaymentsRepositoryMockGetActivePaymentExpectation struct {
+	mock    *PaymentsRepositoryMock
+	params  *PaymentsRepositoryMockGetActivePaymentParams
+	results *PaymentsRepositoryMockGetActivePaymentResults
+	Counter uint64
+}
+
+// PaymentsRepositoryMockGetActivePaymentParams contains parameters of the PaymentsRepository.GetActivePayment
+type PaymentsRepositoryMockGetActivePaymentParams struct {
+	ctx     context.Context
+	orderID int64
+}
+
+// PaymentsRepositoryMockGetActivePaymentResults contains results of the PaymentsRepository.GetActivePayment
+type PaymentsRepositoryMockGetActivePaymentResults struct {
+	pp1 *Payment
+	err error
+}
+
+// Expect sets up expected params for PaymentsRepository.GetActivePayment
+func (mmGetActivePayment *mPaymentsRepositoryMockGetActivePayment) Expect(ctx context.Context, orderID int64) *mPaymentsRepositoryMockGetActivePayment {
+	if mmGetActivePayment.mock.funcGetActivePayment != nil {
+		mmGetActivePayment.mock.t.Fatalf("PaymentsRepositoryMock.GetActivePayment mock is already set by Set")
+	}
+
+	if mmGetActivePayment.defaultExpectation == nil {
+		mmGetActivePayment.defaultExpectation = &PaymentsRepositoryMockGetActivePaymentExpectation{}
+	}
+
+	mmGetActivePayment.defaultExpectation.params = &PaymentsRepositoryMockGetActivePaymentParams{ctx, orderID}
+	for _, e := range mmGetActivePayment.expectations {
+		if minimock.Equal(e.params, mmGetActivePayment.defaultExpectation.params) {
+			mmGetActivePayment.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetActivePayment.defaultExpectation.params)
+		}
+	}
+
+	return mmGetActivePayment
+}
+
+// Inspect accepts an inspector function that has same arguments as the PaymentsRepository.GetActivePayment
+func (mmGetActivePayment *mPaymentsRepositoryMockGetActivePayment) Inspect(f func(ctx context.Context, orderID int64)) *mPaymentsRepositoryMockGetActivePayment {
+	if mmGetActivePayment.mock.inspectFuncGetActivePayment != nil {
+		mmGetActivePayment.mock.t.Fatalf("Inspect function is already set for PaymentsRepositoryMock.GetActivePayment")
+	}
+
+	mmGetActivePayment.mock.inspectFuncGetActivePayment = f
+
+	return mmGetActivePayment
+}
+
+// Return sets up results that will be returned by PaymentsRepository.GetActivePayment
+func (mmGetActivePayment *mPaymentsRepositoryMockGetActivePayment) Return(pp1 *Payment, err error) *PaymentsRepositoryMock {
+	if mmGetActivePayment.mock.funcGetActivePayment != nil {
+		mmGetActivePayment.mock.t.Fatalf("PaymentsRepositoryMock.GetActivePayment mock is already set by Set")
+	}
+
+	if mmGetActivePayment.defaultExpectation == nil {
+		mmGetActivePayment.defaultExpectation = &PaymentsRepositoryMockGetActivePaymentExpectation{mock: mmGetActivePayment.mock}
+	}
+	mmGetActivePayment.defaultExpectation.results = &PaymentsRepositoryMockGetActivePaymentResults{pp1, err}
+	return mmGetActivePayment.mock
+}
+
+// Set uses given function f to mock the PaymentsRepository.GetActivePayment method
+func (mmGetActivePayment *mPaymentsRepositoryMockGetActivePayment) Set(f func(ctx context.Context, orderID int64) (pp1 *Payment, err error)) *PaymentsRepositoryMock {
+	if mmGetActivePayment.defaultExpectation != nil {
+		mmGetActivePayment.mock.t.Fatalf("Default expectation is already set for the PaymentsRepository.GetActivePayment method")
+	}
+
+	if len(mmGetActivePayment.expectations) > 0 {
+		mmGetActivePayment.mock.t.Fatalf("Some expectations are already set for the PaymentsRepository.GetActivePayment method")
+	}
+
+	mmGetActivePayment.mock.funcGetActivePayment = f
+	return mmGetActivePayment.mock
+}
+
+// When sets expectation for the PaymentsRepository.GetActivePayment which will trigger the result defined by the following
+// Then helper
+func (mmGetActivePayment *mPaymentsRepositoryMockGetActivePayment) When(ctx context.Context, orderID int64) *PaymentsRepositoryMockGetActivePaymentExpectation {
+	if mmGetActivePayment.mock.funcGetActivePayment != nil {
+		mmGetActivePayment.mock.t.Fatalf("PaymentsRepositoryMock.GetActivePayment mock is already set by Set")
+	}
+
+	expectation := &PaymentsRepositoryMockGetActivePaymentExpectation{
+		mock:   mmGetActivePayment.mock,
+		params: &PaymentsRepositoryMockGetActivePaymentParams{ctx, orderID},
+	}
+	mmGetActivePayment.expectations = append(mmGetActivePayment.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PaymentsRepository.GetActivePayment return parameters for the expectation previously defined by the When method
+func (e *PaymentsRepositoryMockGetActivePaymentExpectation) Then(pp1 *Payment, err error) *PaymentsRepositoryMock {
+	e.results = &PaymentsRepositoryMockGetActivePaymentResults{pp1, err}
+	return e.mock
+}
+
+// GetActivePayment implements PaymentsRepository
+func (mmGetActivePayment *PaymentsRepositoryMock) GetActivePayment(ctx context.Context, orderID int64) (pp1 *Payment, err error) {
+	mm_atomic.AddUint64(&mmGetActivePayment.beforeGetActivePaymentCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetActivePayment.afterGetActivePaymentCounter, 1)
+
+	if mmGetActivePayment.inspectFuncGetActivePayment != nil {
+		mmGetActivePayment.inspectFuncGetActivePayment(ctx, orderID)
+	}
+
+	mm_params := &PaymentsRepositoryMockGetActivePaymentParams{ctx, orderID}
+
+	// Record call args
+	mmGetActivePayment.GetActivePaymentMock.mutex.Lock()
+	mmGetActivePayment.GetActivePaymentMock.callArgs = append(mmGetActivePayment.GetActivePaymentMock.callArgs, mm_params)
+	mmGetActivePayment.GetActivePaymentMock.mutex.Unlock()
+
+	for _, e := range mmGetActivePayment.GetActivePaymentMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pp1, e.results.err
+		}
+	}
+
+	if mmGetActivePayment.GetActivePaymentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetActivePayment.GetActivePaymentMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetActivePayment.GetActivePaymentMock.defaultExpectation.params
+		mm_got := PaymentsRepositoryMockGetActivePaymentParams{ctx, orderID}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetActivePayment.t.Errorf("PaymentsRepositoryMock.GetActivePayment got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetActivePayment.GetActivePaymentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetActivePayment.t.Fatal("No results are set for the PaymentsRepositoryMock.GetActivePayment")
+		}
+		return (*mm_results).pp1, (*mm_results).err
+	}
+	if mmGetActivePayment.funcGetActivePayment != nil {
+		return mmGetActivePayment.funcGetActivePayment(ctx, orderID)
+	}
+	mmGetActivePayment.t.Fatalf("Unexpected call to PaymentsRepositoryMock.GetActivePayment. %v %v", ctx, orderID)
+	return
+}
+
+// GetActivePaymentAfterCounter returns a count of finished PaymentsRepositoryMock.GetActivePayment invocations
+func (mmGetActivePayment *PaymentsRepositoryMock) GetActivePaymentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetActivePayment.afterGetActivePaymentCounter)
+}
+
+// GetActivePaymentBeforeCounter returns a count of PaymentsRepositoryMock.GetActivePayment invocations
+func (mmGetActivePayment *PaymentsRepositoryMock) GetActivePaymentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetActivePayment.beforeGetActivePaymentCounter)
+}
+
+// Calls returns a list of arguments used in each call to PaymentsRepositoryMock.GetActivePayment.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetActivePayment *mPaymentsRepositoryMockGetActivePayment) Calls() []*PaymentsRepositoryMockGetActivePaymentParams {
+	mmGetActivePayment.mutex.RLock()
+
+	argCopy := make([]*PaymentsRepositoryMockGetActivePaymentParams, len(mmGetActivePayment.callArgs))
+	copy(argCopy, mmGetActivePayment.callArgs)
+
+	mmGetActivePayment.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetActivePaymentDone returns true if the count of the GetActivePayment invocations corresponds
+// the number of defined expectations
+func (m *PaymentsRepositoryMock) MinimockGetActivePaymentDone() bool {
+	for _, e := range m.GetActivePaymentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetActivePaymentMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetActivePaymentCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetActivePayment != nil && mm_atomic.LoadUint64(&m.afterGetActivePaymentCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetActivePaymentInspect logs each unmet expectation
+func (m *PaymentsRepositoryMock) MinimockGetActivePaymentInspect() {
+	for _, e := range m.GetActivePaymentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PaymentsRepositoryMock.GetActivePayment with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetActivePaymentMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetActivePaymentCounter) < 1 {
+		if m.GetActivePaymentMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PaymentsRepositoryMock.GetActivePayment")
+		} else {
+			m.t.Errorf("Expected call to PaymentsRepositoryMock.GetActivePayment with params: %#v", *m.GetActivePaymentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetActivePayment != nil && mm_atomic.LoadUint64(&m.afterGetActivePaymentCounter) < 1 {
+		m.t.Error("Expected call to PaymentsRepositoryMock.GetActivePayment")
+	}
+}
+
+type mPaymentsRepositoryMockGetPayment struct {
+	mock               *PaymentsRepositoryMock
+	defaultExpectation *PaymentsRepositoryMockGetPaymentExpectation
+	expectations       []*PaymentsRepositoryMockGetPaymentExpectation
+
+	callArgs []*PaymentsRepositoryMockGetPaymentParams
+	mutex    sync.RWMutex
+}
+
+// PaymentsRepositoryMockGetPaymentExpectation specifies expectation struct of the PaymentsRepository.GetPayment
+type PaymentsRepositoryMockGetPaymentExpectation struct {
+	mock    *PaymentsRepositoryMock
+	params  *PaymentsRepositoryMockGetPaymentParams
+	results *PaymentsRepositoryMockGetPaymentResults
+	Counter uint64
+}
+
+// PaymentsRepositoryMockGetPaymentParams contains parameters of the PaymentsRepository.GetPayment
+type PaymentsRepositoryMockGetPaymentParams struct {
+	ctx context.Context
+	id  string
+}
+
+// PaymentsRepositoryMockGetPaymentResults contains results of the PaymentsRepository.GetPayment
+type PaymentsRepositoryMockGetPaymentResults struct {
+	pp1 *Payment
+	err error
+}
+
+// Expect sets up expected params for PaymentsRepository.GetPayment
+func (mmGetPayment *mPaymentsRepositoryMockGetPayment) Expect(ctx context.Context, id string) *mPaymentsRepositoryMockGetPayment {
+	if mmGetPayment.mock.funcGetPayment != nil {
+		mmGetPayment.mock.t.Fatalf("PaymentsRepositoryMock.GetPayment mock is already set by Set")
+	}
+
+	if mmGetPayment.defaultExpectation == nil {
+		mmGetPayment.defaultExpectation = &PaymentsRepositoryMockGetPaymentExpectation{}
+	}
+
+	mmGetPayment.defaultExpectation.params = &PaymentsRepositoryMockGetPaymentParams{ctx, id}
+	for _, e := range mmGetPayment.expectations {
+		if minimock.Equal(e.params, mmGetPayment.defaultExpectation.params) {
+			mmGetPayment.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPayment.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPayment
+}
+
+// Inspect accepts an inspector function that has same arguments as the PaymentsRepository.GetPayment
+func (mmGetPayment *mPaymentsRepositoryMockGetPayment) Inspect(f func(ctx context.Context, id string)) *mPaymentsRepositoryMockGetPayment {
+	if mmGetPayment.mock.inspectFuncGetPayment != nil {
+		mmGetPayment.mock.t.Fatalf("Inspect function is already set for PaymentsRepositoryMock.GetPayment")
+	}
+
+	mmGetPayment.mock.inspectFuncGetPayment = f
+
+	return mmGetPayment
+}
+
+// Return sets up results that will be returned by PaymentsRepository.GetPayment
+func (mmGetPayment *mPaymentsRepositoryMockGetPayment) Return(pp1 *Payment, err error) *PaymentsRepositoryMock {
+	if mmGetPayment.mock.funcGetPayment != nil {
+		mmGetPayment.mock.t.Fatalf("PaymentsRepositoryMock.GetPayment mock is already set by Set")
+	}
+
+	if mmGetPayment.defaultExpectation == nil {
+		mmGetPayment.defaultExpectation = &PaymentsRepositoryMockGetPaymentExpectation{mock: mmGetPayment.mock}
+	}
+	mmGetPayment.defaultExpectation.results = &PaymentsRepositoryMockGetPaymentResults{pp1, err}
+	return mmGetPayment.mock
+}
+
+// Set uses given function f to mock the PaymentsRepository.GetPayment method
+func (mmGetPayment *mPaymentsRepositoryMockGetPayment) Set(f func(ctx context.Context, id string) (pp1 *Payment, err error)) *PaymentsRepositoryMock {
+	if mmGetPayment.defaultExpectation != nil {
+		mmGetPayment.mock.t.Fatalf("Default expectation is already set for the PaymentsRepository.GetPayment method")
+	}
+
+	if len(mmGetPayment.expectations) > 0 {
+		mmGetPayment.mock.t.Fatalf("Some expectations are already set for the PaymentsRepository.GetPayment method")
+	}
+
+	mmGetPayment.mock.funcGetPayment = f
+	return mmGetPayment.mock
+}
+
+// When sets expectation for the PaymentsRepository.GetPayment which will trigger the result defined by the following
+// Then helper
+func (mmGetPayment *mPaymentsRepositoryMockGetPayment) When(ctx context.Context, id string) *PaymentsRepositoryMockGetPaymentExpectation {
+	if mmGetPayment.mock.funcGetPayment != nil {
+		mmGetPayment.mock.t.Fatalf("PaymentsRepositoryMock.GetPayment mock is already set by Set")
+	}
+
+	expectation := &PaymentsRepositoryMockGetPaymentExpectation{
+		mock:   mmGetPayment.mock,
+		params: &PaymentsRepositoryMockGetPaymentParams{ctx, id},
+	}
+	mmGetPayment.expectations = append(mmGetPayment.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PaymentsRepository.GetPayment return parameters for the expectation previously defined by the When method
+func (e *PaymentsRepositoryMockGetPaymentExpectation) Then(pp1 *Payment, err error) *PaymentsRepositoryMock {
+	e.results = &PaymentsRepositoryMockGetPaymentResults{pp1, err}
+	return e.mock
+}
+
+// GetPayment implements PaymentsRepository
+func (mmGetPayment *PaymentsRepositoryMock) GetPayment(ctx context.Context, id string) (pp1 *Payment, err error) {
+	mm_atomic.AddUint64(&mmGetPayment.beforeGetPaymentCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPayment.afterGetPaymentCounter, 1)
+
+	if mmGetPayment.inspectFuncGetPayment != nil {
+		mmGetPayment.inspectFuncGetPayment(ctx, id)
+	}
+
+	mm_params := &PaymentsRepositoryMockGetPaymentParams{ctx, id}
+
+	// Record call args
+	mmGetPayment.GetPaymentMock.mutex.Lock()
+	mmGetPayment.GetPaymentMock.callArgs = append(mmGetPayment.GetPaymentMock.callArgs, mm_params)
+	mmGetPayment.GetPaymentMock.mutex.Unlock()
+
+	for _, e := range mmGetPayment.GetPaymentMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pp1, e.results.err
+		}
+	}
+
+	if mmGetPayment.GetPaymentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPayment.GetPaymentMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPayment.GetPaymentMock.defaultExpectation.params
+		mm_got := PaymentsRepositoryMockGetPaymentParams{ctx, id}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPayment.t.Errorf("PaymentsRepositoryMock.GetPayment got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPayment.GetPaymentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPayment.t.Fatal("No results are set for the PaymentsRepositoryMock.GetPayment")
+		}
+		return (*mm_results).pp1, (*mm_results).err
+	}
+	if mmGetPayment.funcGetPayment != nil {
+		return mmGetPayment.funcGetPayment(ctx, id)
+	}
+	mmGetPayment.t.Fatalf("Unexpected call to PaymentsRepositoryMock.GetPayment. %v %v", ctx, id)
+	return
+}
+
+// GetPaymentAfterCounter returns a count of finished PaymentsRepositoryMock.GetPayment invocations
+func (mmGetPayment *PaymentsRepositoryMock) GetPaymentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPayment.afterGetPaymentCounter)
+}
+
+// GetPaymentBeforeCounter returns a count of PaymentsRepositoryMock.GetPayment invocations
+func (mmGetPayment *PaymentsRepositoryMock) GetPaymentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPayment.beforeGetPaymentCounter)
+}
+
+// Calls returns a list of arguments used in each call to PaymentsRepositoryMock.GetPayment.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPayment *mPaymentsRepositoryMockGetPayment) Calls() []*PaymentsRepositoryMockGetPaymentParams {
+	mmGetPayment.mutex.RLock()
+
+	argCopy := make([]*PaymentsRepositoryMockGetPaymentParams, len(mmGetPayment.callArgs))
+	copy(argCopy, mmGetPayment.callArgs)
+
+	mmGetPayment.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPaymentDone returns true if the count of the GetPayment invocations corresponds
+// the number of defined expectations
+func (m *PaymentsRepositoryMock) MinimockGetPaymentDone() bool {
+	for _, e := range m.GetPaymentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPaymentMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetPaymentCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPayment != nil && mm_atomic.LoadUint64(&m.afterGetPaymentCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetPaymentInspect logs each unmet expectation
+func (m *PaymentsRepositoryMock) MinimockGetPaymentInspect() {
+	for _, e := range m.GetPaymentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PaymentsRepositoryMock.GetPayment with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPaymentMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetPaymentCounter) < 1 {
+		if m.GetPaymentMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PaymentsRepositoryMock.GetPayment")
+		} else {
+			m.t.Errorf("Expected call to PaymentsRepositoryMock.GetPayment with params: %#v", *m.GetPaymentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPayment != nil && mm_atomic.LoadUint64(&m.afterGetPaymentCounter) < 1 {
+		m.t.Error("Expected call to PaymentsRepositoryMock.GetPayment")
+	}
+}
+
+type mPaymentsRepositoryMockGetUnsentRefunds struct {
+	mock               *PaymentsRepositoryMock
+	defaultExpectation *PaymentsRepositoryMockGetUnsentRefundsExpectation
+	expectations       []*PaymentsRepositoryMockGetUnsentRefundsExpectation
+
+	callArgs []*PaymentsRepositoryMockGetUnsentRefundsParams
+	mutex    sync.RWMutex
+}
+
+// PaymentsRepositoryMockGetUnsentRefundsExpectation specifies expectation struct of the PaymentsRepository.GetUnsentRefunds
+type PaymentsRepositoryMockGetUnsentRefundsExpectation struct {
+	mock    *PaymentsRepositoryMock
+	params  *PaymentsRepositoryMockGetUnsentRefundsParams
+	results *PaymentsRepositoryMockGetUnsentRefundsResults
+	Counter uint64
+}
+
+// PaymentsRepositoryMockGetUnsentRefundsParams contains parameters of the PaymentsRepository.GetUnsentRefunds
+type PaymentsRepositoryMockGetUnsentRefundsParams struct {
+	ctx   context.Context
+	limit uint64
+}
+
+// PaymentsRepositoryMockGetUnsentRefundsResults contains results of the PaymentsRepository.GetUnsentRefunds
+type PaymentsRepositoryMockGetUnsentRefundsResults struct {
+	ra1 []Refund
+	err error
+}
+
+// Expect sets up expected params for PaymentsRepository.GetUnsentRefunds
+func (mmGetUnsentRefunds *mPaymentsRepositoryMockGetUnsentRefunds) Expect(ctx context.Context, limit uint64) *mPaymentsRepositoryMockGetUnsentRefunds {
+	if mmGetUnsentRefunds.mock.funcGetUnsentRefunds != nil {
+		mmGetUnsentRefunds.mock.t.Fatalf("PaymentsRepositoryMock.GetUnsentRefunds mock is already set by Set")
+	}
+
+	if mmGetUnsentRefunds.defaultExpectation == nil {
+		mmGetUnsentRefunds.defaultExpectation = &PaymentsRepositoryMockGetUnsentRefundsExpectation{}
+	}
+
+	mmGetUnsentRefunds.defaultExpectation.params = &PaymentsRepositoryMockGetUnsentRefundsParams{ctx, limit}
+	for _, e := range mmGetUnsentRefunds.expectations {
+		if minimock.Equal(e.params, mmGetUnsentRefunds.defaultExpectation.params) {
+			mmGetUnsentRefunds.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUnsentRefunds.defaultExpectation.params)
+		}
+	}
+
+	return mmGetUnsentRefunds
+}
+
+// Inspect accepts an inspector function that has same arguments as the PaymentsRepository.GetUnsentRefunds
+func (mmGetUnsentRefunds *mPaymentsRepositoryMockGetUnsentRefunds) Inspect(f func(ctx context.Context, limit uint64)) *mPaymentsRepositoryMockGetUnsentRefunds {
+	if mmGetUnsentRefunds.mock.inspectFuncGetUnsentRefunds != nil {
+		mmGetUnsentRefunds.mock.t.Fatalf("Inspect function is already set for PaymentsRepositoryMock.GetUnsentRefunds")
+	}
+
+	mmGetUnsentRefunds.mock.inspectFuncGetUnsentRefunds = f
+
+	return mmGetUnsentRefunds
+}
+
+// Return sets up results that will be returned by PaymentsRepository.GetUnsentRefunds
+func (mmGetUnsentRefunds *mPaymentsRepositoryMockGetUnsentRefunds) Return(ra1 []Refund, err error) *PaymentsRepositoryMock {
+	if mmGetUnsentRefunds.mock.funcGetUnsentRefunds != nil {
+		mmGetUnsentRefunds.mock.t.Fatalf("PaymentsRepositoryMock.GetUnsentRefunds mock is already set by Set")
+	}
+
+	if mmGetUnsentRefunds.defaultExpectation == nil {
+		mmGetUnsentRefunds.defaultExpectation = &PaymentsRepositoryMockGetUnsentRefundsExpectation{mock: mmGetUnsentRefunds.mock}
+	}
+	mmGetUnsentRefunds.defaultExpectation.results = &PaymentsRepositoryMockGetUnsentRefundsResults{ra1, err}
+	return mmGetUnsentRefunds.mock
+}
+
+// Set uses given function f to mock the PaymentsRepository.GetUnsentRefunds method
+func (mmGetUnsentRefunds *mPaymentsRepositoryMockGetUnsentRefunds) Set(f func(ctx context.Context, limit uint64) (ra1 []Refund, err error)) *PaymentsRepositoryMock {
+	if mmGetUnsentRefunds.defaultExpectation != nil {
+		mmGetUnsentRefunds.mock.t.Fatalf("Default expectation is already set for the PaymentsRepository.GetUnsentRefunds method")
+	}
+
+	if len(mmGetUnsentRefunds.expectations) > 0 {
+		mmGetUnsentRefunds.mock.t.Fatalf("Some expectations are already set for the PaymentsRepository.GetUnsentRefunds method")
+	}
+
+	mmGetUnsentRefunds.mock.funcGetUnsentRefunds = f
+	return mmGetUnsentRefunds.mock
+}
+
+// When sets expectation for the PaymentsRepository.GetUnsentRefunds which will trigger the result defined by the following
+// Then helper
+func (mmGetUnsentRefunds *mPaymentsRepositoryMockGetUnsentRefunds) When(ctx context.Context, limit uint64) *PaymentsRepositoryMockGetUnsentRefundsExpectation {
+	if mmGetUnsentRefunds.mock.funcGetUnsentRefunds != nil {
+		mmGetUnsentRefunds.mock.t.Fatalf("PaymentsRepositoryMock.GetUnsentRefunds mock is already set by Set")
+	}
+
+	expectation := &PaymentsRepositoryMockGetUnsentRefundsExpectation{
+		mock:   mmGetUnsentRefunds.mock,
+		params: &PaymentsRepositoryMockGetUnsentRefundsParams{ctx, limit},
+	}
+	mmGetUnsentRefunds.expectations = append(mmGetUnsentRefunds.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PaymentsRepository.GetUnsentRefunds return parameters for the expectation previously defined by the When method
+func (e *PaymentsRepositoryMockGetUnsentRefundsExpectation) Then(ra1 []Refund, err error) *PaymentsRepositoryMock {
+	e.results = &PaymentsRepositoryMockGetUnsentRefundsResults{ra1, err}
+	return e.mock
+}
+
+// GetUnsentRefunds implements PaymentsRepository
+func (mmGetUnsentRefunds *PaymentsRepositoryMock) GetUnsentRefunds(ctx context.Context, limit uint64) (ra1 []Refund, err error) {
+	mm_atomic.AddUint64(&mmGetUnsentRefunds.beforeGetUnsentRefundsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetUnsentRefunds.afterGetUnsentRefundsCounter, 1)
+
+	if mmGetUnsentRefunds.inspectFuncGetUnsentRefunds != nil {
+		mmGetUnsentRefunds.inspectFuncGetUnsentRefunds(ctx, limit)
+	}
+
+	mm_params := &PaymentsRepositoryMockGetUnsentRefundsParams{ctx, limit}
+
+	// Record call args
+	mmGetUnsentRefunds.GetUnsentRefundsMock.mutex.Lock()
+	mmGetUnsentRefunds.GetUnsentRefundsMock.callArgs = append(mmGetUnsentRefunds.GetUnsentRefundsMock.callArgs, mm_params)
+	mmGetUnsentRefunds.GetUnsentRefundsMock.mutex.Unlock()
+
+	for _, e := range mmGetUnsentRefunds.GetUnsentRefundsMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ra1, e.results.err
+		}
+	}
+
+	if mmGetUnsentRefunds.GetUnsentRefundsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetUnsentRefunds.GetUnsentRefundsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetUnsentRefunds.GetUnsentRefundsMock.defaultExpectation.params
+		mm_got := PaymentsRepositoryMockGetUnsentRefundsParams{ctx, limit}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetUnsentRefunds.t.Errorf("PaymentsRepositoryMock.GetUnsentRefunds got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetUnsentRefunds.GetUnsentRefundsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetUnsentRefunds.t.Fatal("No results are set for the PaymentsRepositoryMock.GetUnsentRefunds")
+		}
+		return (*mm_results).ra1, (*mm_results).err
+	}
+	if mmGetUnsentRefunds.funcGetUnsentRefunds != nil {
+		return mmGetUnsentRefunds.funcGetUnsentRefunds(ctx, limit)
+	}
+	mmGetUnsentRefunds.t.Fatalf("Unexpected call to PaymentsRepositoryMock.GetUnsentRefunds. %v %v", ctx, limit)
+	return
+}
+
+// GetUnsentRefundsAfterCounter returns a count of finished PaymentsRepositoryMock.GetUnsentRefunds invocations
+func (mmGetUnsentRefunds *PaymentsRepositoryMock) GetUnsentRefundsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUnsentRefunds.afterGetUnsentRefundsCounter)
+}
+
+// GetUnsentRefundsBeforeCounter returns a count of PaymentsRepositoryMock.GetUnsentRefunds invocations
+func (mmGetUnsentRefunds *PaymentsRepositoryMock) GetUnsentRefundsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUnsentRefunds.beforeGetUnsentRefundsCounter)
+}
+
+// Calls returns a list of arguments used in each call to PaymentsRepositoryMock.GetUnsentRefunds.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetUnsentRefunds *mPaymentsRepositoryMockGetUnsentRefunds) Calls() []*PaymentsRepositoryMockGetUnsentRefundsParams {
+	mmGetUnsentRefunds.mutex.RLock()
+
+	argCopy := make([]*PaymentsRepositoryMockGetUnsentRefundsParams, len(mmGetUnsentRefunds.callArgs))
+	copy(argCopy, mmGetUnsentRefunds.callArgs)
+
+	mmGetUnsentRefunds.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetUnsentRefundsDone returns true if the count of the GetUnsentRefunds invocations corresponds
+// the number of defined expectations
+func (m *PaymentsRepositoryMock) MinimockGetUnsentRefundsDone() bool {
+	for _, e := range m.GetUnsentRefundsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetUnsentRefundsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetUnsentRefundsCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetUnsentRefunds != nil && mm_atomic.LoadUint64(&m.afterGetUnsentRefundsCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetUnsentRefundsInspect logs each unmet expectation
+func (m *PaymentsRepositoryMock) MinimockGetUnsentRefundsInspect() {
+	for _, e := range m.GetUnsentRefundsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PaymentsRepositoryMock.GetUnsentRefunds with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetUnsentRefundsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetUnsentRefundsCounter) < 1 {
+		if m.GetUnsentRefundsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PaymentsRepositoryMock.GetUnsentRefunds")
+		} else {
+			m.t.Errorf("Expected call to PaymentsRepositoryMock.GetUnsentRefunds with params: %#v", *m.GetUnsentRefundsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetUnsentRefunds != nil && mm_atomic.LoadUint64(&m.afterGetUnsentRefundsCounter) < 1 {
+		m.t.Error("Expected call to PaymentsRepositoryMock.GetUnsentRefunds")
+	}
+}
+
+type mPaymentsRepositoryMockMarkRefundsSent struct {
+	mock               *PaymentsRepositoryMock
+	defaultExpectation *PaymentsRepositoryMockMarkRefundsSentExpectation
+	expectations       []*PaymentsRepositoryMockMarkRefundsSentExpectation
+
+	callArgs []*PaymentsRepositoryMockMarkRefundsSentParams
+	mutex    sync.RWMutex
+}
+
+// PaymentsRepositoryMockMarkRefundsSentExpectation specifies expectation struct of the PaymentsRepository.MarkRefundsSent
+type PaymentsRepositoryMockMarkRefundsSentExpectation struct {
+	mock    *PaymentsRepositoryMock
+	params  *PaymentsRepositoryMockMarkRefundsSentParams
+	results *PaymentsRepositoryMockMarkRefundsSentResults
+	Counter uint64
+}
+
+// PaymentsRepositoryMockMarkRefundsSentParams contains parameters of the PaymentsRepository.MarkRefundsSent
+type PaymentsRepositoryMockMarkRefundsSentParams struct {
+	ctx context.Context
+	ids []int64
+}
+
+// PaymentsRepositoryMockMarkRefundsSentResults contains results of the PaymentsRepository.MarkRefundsSent
+type PaymentsRepositoryMockMarkRefundsSentResults struct {
+	err error
+}
+
+// Expect sets up expected params for PaymentsRepository.MarkRefundsSent
+func (mmMarkRefundsSent *mPaymentsRepositoryMockMarkRefundsSent) Expect(ctx context.Context, ids []int64) *mPaymentsRepositoryMockMarkRefundsSent {
+	if mmMarkRefundsSent.mock.funcMarkRefundsSent != nil {
+		mmMarkRefundsSent.mock.t.Fatalf("PaymentsRepositoryMock.MarkRefundsSent mock is already set by Set")
+	}
+
+	if mmMarkRefundsSent.defaultExpectation == nil {
+		mmMarkRefundsSent.defaultExpectation = &PaymentsRepositoryMockMarkRefundsSentExpectation{}
+	}
+
+	mmMarkRefundsSent.defaultExpectation.params = &PaymentsRepositoryMockMarkRefundsSentParams{ctx, ids}
+	for _, e := range mmMarkRefundsSent.expectations {
+		if minimock.Equal(e.params, mmMarkRefundsSent.defaultExpectation.params) {
+			mmMarkRefundsSent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMarkRefundsSent.defaultExpectation.params)
+		}
+	}
+
+	return mmMarkRefundsSent
+}
+
+// Inspect accepts an inspector function that has same arguments as the PaymentsRepository.MarkRefundsSent
+func (mmMarkRefundsSent *mPaymentsRepositoryMockMarkRefundsSent) Inspect(f func(ctx context.Context, ids []int64)) *mPaymentsRepositoryMockMarkRefundsSent {
+	if mmMarkRefundsSent.mock.inspectFuncMarkRefundsSent != nil {
+		mmMarkRefundsSent.mock.t.Fatalf("Inspect function is already set for PaymentsRepositoryMock.MarkRefundsSent")
+	}
+
+	mmMarkRefundsSent.mock.inspectFuncMarkRefundsSent = f
+
+	return mmMarkRefundsSent
+}
+
+// Return sets up results that will be returned by PaymentsRepository.MarkRefundsSent
+func (mmMarkRefundsSent *mPaymentsRepositoryMockMarkRefundsSent) Return(err error) *PaymentsRepositoryMock {
+	if mmMarkRefundsSent.mock.funcMarkRefundsSent != nil {
+		mmMarkRefundsSent.mock.t.Fatalf("PaymentsRepositoryMock.MarkRefundsSent mock is already set by Set")
+	}
+
+	if mmMarkRefundsSent.defaultExpectation == nil {
+		mmMarkRefundsSent.defaultExpectation = &PaymentsRepositoryMockMarkRefundsSentExpectation{mock: mmMarkRefundsSent.mock}
+	}
+	mmMarkRefundsSent.defaultExpectation.results = &PaymentsRepositoryMockMarkRefundsSentResults{err}
+	return mmMarkRefundsSent.mock
+}
+
+// Set uses given function f to mock the PaymentsRepository.MarkRefundsSent method
+func (mmMarkRefundsSent *mPaymentsRepositoryMockMarkRefundsSent) Set(f func(ctx context.Context, ids []int64) (err error)) *PaymentsRepositoryMock {
+	if mmMarkRefundsSent.defaultExpectation != nil {
+		mmMarkRefundsSent.mock.t.Fatalf("Default expectation is already set for the PaymentsRepository.MarkRefundsSent method")
+	}
+
+	if len(mmMarkRefundsSent.expectations) > 0 {
+		mmMarkRefundsSent.mock.t.Fatalf("Some expectations are already set for the PaymentsRepository.MarkRefundsSent method")
+	}
+
+	mmMarkRefundsSent.mock.funcMarkRefundsSent = f
+	return mmMarkRefundsSent.mock
+}
+
+// When sets expectation for the PaymentsRepository.MarkRefundsSent which will trigger the result defined by the following
+// Then helper
+func (mmMarkRefundsSent *mPaymentsRepositoryMockMarkRefundsSent) When(ctx context.Context, ids []int64) *PaymentsRepositoryMockMarkRefundsSentExpectation {
+	if mmMarkRefundsSent.mock.funcMarkRefundsSent != nil {
+		mmMarkRefundsSent.mock.t.Fatalf("PaymentsRepositoryMock.MarkRefundsSent mock is already set by Set")
+	}
+
+	expectation := &PaymentsRepositoryMockMarkRefundsSentExpectation{
+		mock:   mmMarkRefundsSent.mock,
+		params: &PaymentsRepositoryMockMarkRefundsSentParams{ctx, ids},
+	}
+	mmMarkRefundsSent.expectations = append(mmMarkRefundsSent.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PaymentsRepository.MarkRefundsSent return parameters for the expectation previously defined by the When method
+func (e *PaymentsRepositoryMockMarkRefundsSentExpectation) Then(err error) *PaymentsRepositoryMock {
+	e.results = &PaymentsRepositoryMockMarkRefundsSentResults{err}
+	return e.mock
+}
+
+// MarkRefundsSent implements PaymentsRepository
+func (mmMarkRefundsSent *PaymentsRepositoryMock) MarkRefundsSent(ctx context.Context, ids []int64) (err error) {
+	mm_atomic.AddUint64(&mmMarkRefundsSent.beforeMarkRefundsSentCounter, 1)
+	defer mm_atomic.AddUint64(&mmMarkRefundsSent.afterMarkRefundsSentCounter, 1)
+
+	if mmMarkRefundsSent.inspectFuncMarkRefundsSent != nil {
+		mmMarkRefundsSent.inspectFuncMarkRefundsSent(ctx, ids)
+	}
+
+	mm_params := &PaymentsRepositoryMockMarkRefundsSentParams{ctx, ids}
+
+	// Record call args
+	mmMarkRefundsSent.MarkRefundsSentMock.mutex.Lock()
+	mmMarkRefundsSent.MarkRefundsSentMock.callArgs = append(mmMarkRefundsSent.MarkRefundsSentMock.callArgs, mm_params)
+	mmMarkRefundsSent.MarkRefundsSentMock.mutex.Unlock()
+
+	for _, e := range mmMarkRefundsSent.MarkRefundsSentMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmMarkRefundsSent.MarkRefundsSentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMarkRefundsSent.MarkRefundsSentMock.defaultExpectation.Counter, 1)
+		mm_want := mmMarkRefundsSent.MarkRefundsSentMock.defaultExpectation.params
+		mm_got := PaymentsRepositoryMockMarkRefundsSentParams{ctx, ids}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMarkRefundsSent.t.Errorf("PaymentsRepositoryMock.MarkRefundsSent got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMarkRefundsSent.MarkRefundsSentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMarkRefundsSent.t.Fatal("No results are set for the PaymentsRepositoryMock.MarkRefundsSent")
+		}
+		return (*mm_results).err
+	}
+	if mmMarkRefundsSent.funcMarkRefundsSent != nil {
+		return mmMarkRefundsSent.funcMarkRefundsSent(ctx, ids)
+	}
+	mmMarkRefundsSent.t.Fatalf("Unexpected call to PaymentsRepositoryMock.MarkRefundsSent. %v %v", ctx, ids)
+	return
+}
+
+// MarkRefundsSentAfterCounter returns a count of finished PaymentsRepositoryMock.MarkRefundsSent invocations
+func (mmMarkRefundsSent *PaymentsRepositoryMock) MarkRefundsSentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkRefundsSent.afterMarkRefundsSentCounter)
+}
+
+// MarkRefundsSentBeforeCounter returns a count of PaymentsRepositoryMock.MarkRefundsSent invocations
+func (mmMarkRefundsSent *PaymentsRepositoryMock) MarkRefundsSentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkRefundsSent.beforeMarkRefundsSentCounter)
+}
+
+// Calls returns a list of arguments used in each call to PaymentsRepositoryMock.MarkRefundsSent.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMarkRefundsSent *mPaymentsRepositoryMockMarkRefundsSent) Calls() []*PaymentsRepositoryMockMarkRefundsSentParams {
+	mmMarkRefundsSent.mutex.RLock()
+
+	argCopy := make([]*PaymentsRepositoryMockMarkRefundsSentParams, len(mmMarkRefundsSent.callArgs))
+	copy(argCopy, mmMarkRefundsSent.callArgs)
+
+	mmMarkRefundsSent.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMarkRefundsSentDone returns true if the count of the MarkRefundsSent invocations corresponds
+// the number of defined expectations
+func (m *PaymentsRepositoryMock) MinimockMarkRefundsSentDone() bool {
+	for _, e := range m.MarkRefundsSentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MarkRefundsSentMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterMarkRefundsSentCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMarkRefundsSent != nil && mm_atomic.LoadUint64(&m.afterMarkRefundsSentCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockMarkRefundsSentInspect logs each unmet expectation
+func (m *PaymentsRepositoryMock) MinimockMarkRefundsSentInspect() {
+	for _, e := range m.MarkRefundsSentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PaymentsRepositoryMock.MarkRefundsSent with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MarkRefundsSentMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterMarkRefundsSentCounter) < 1 {
+		if m.MarkRefundsSentMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PaymentsRepositoryMock.MarkRefundsSent")
+		} else {
+			m.t.Errorf("Expected call to PaymentsRepositoryMock.MarkRefundsSent with params: %#v", *m.MarkRefundsSentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMarkRefundsSent != nil && mm_atomic.LoadUint64(&m.afterMarkRefundsSentCounter) < 1 {
+		m.t.Error("Expected call to PaymentsRepositoryMock.MarkRefundsSent")
+	}
+}
+
+type mPaymentsRepositoryMockUpdatePaymentStatus struct {
+	mock               *PaymentsRepositoryMock
+	defaultExpectation *PaymentsRepositoryMockUpdatePaymentStatusExpectation
+	expectations       []*PaymentsRepositoryMockUpdatePaymentStatusExpectation
+
+	callArgs []*PaymentsRepositoryMockUpdatePaymentStatusParams
+	mutex    sync.RWMutex
+}
+
+// PaymentsRepositoryMockUpdatePaymentStatusExpectation specifies expectation struct of the PaymentsRepository.UpdatePaymentStatus
+type PaymentsRepositoryMockUpdatePaymentStatusExpectation struct {
+	mock    *PaymentsRepositoryMock
+	params  *PaymentsRepositoryMockUpdatePaymentStatusParams
+	results *PaymentsRepositoryMockUpdatePaymentStatusResults
+	Counter uint64
+}
+
+// PaymentsRepositoryMockUpdatePaymentStatusParams contains parameters of the PaymentsRepository.UpdatePaymentStatus
+type PaymentsRepositoryMockUpdatePaymentStatusParams struct {
+	ctx          context.Context
+	id           string
+	status       PaymentStatus
+	statusBefore PaymentStatus
+}
+
+// PaymentsRepositoryMockUpdatePaymentStatusResults contains results of the PaymentsRepository.UpdatePaymentStatus
+type PaymentsRepositoryMockUpdatePaymentStatusResults struct {
+	err error
+}
+
+// Expect sets up expected params for PaymentsRepository.UpdatePaymentStatus
+func (mmUpdatePaymentStatus *mPaymentsRepositoryMockUpdatePaymentStatus) Expect(ctx context.Context, id string, status PaymentStatus, statusBefore PaymentStatus) *mPaymentsRepositoryMockUpdatePaymentStatus {
+	if mmUpdatePaymentStatus.mock.funcUpdatePaymentStatus != nil {
+		mmUpdatePaymentStatus.mock.t.Fatalf("PaymentsRepositoryMock.UpdatePaymentStatus mock is already set by Set")
+	}
+
+	if mmUpdatePaymentStatus.defaultExpectation == nil {
+		mmUpdatePaymentStatus.defaultExpectation = &PaymentsRepositoryMockUpdatePaymentStatusExpectation{}
+	}
+
+	mmUpdatePaymentStatus.defaultExpectation.params = &PaymentsRepositoryMockUpdatePaymentStatusParams{ctx, id, status, statusBefore}
+	for _, e := range mmUpdatePaymentStatus.expectations {
+		if minimock.Equal(e.params, mmUpdatePaymentStatus.defaultExpectation.params) {
+			mmUpdatePaymentStatus.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdatePaymentStatus.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdatePaymentStatus
+}
+
+// Inspect accepts an inspector function that has same arguments as the PaymentsRepository.UpdatePaymentStatus
+func (mmUpdatePaymentStatus *mPaymentsRepositoryMockUpdatePaymentStatus) Inspect(f func(ctx context.Context, id string, status PaymentStatus, statusBefore PaymentStatus)) *mPaymentsRepositoryMockUpdatePaymentStatus {
+	if mmUpdatePaymentStatus.mock.inspectFuncUpdatePaymentStatus != nil {
+		mmUpdatePaymentStatus.mock.t.Fatalf("Inspect function is already set for PaymentsRepositoryMock.UpdatePaymentStatus")
+	}
+
+	mmUpdatePaymentStatus.mock.inspectFuncUpdatePaymentStatus = f
+
+	return mmUpdatePaymentStatus
+}
+
+// Return sets up results that will be returned by PaymentsRepository.UpdatePaymentStatus
+func (mmUpdatePaymentStatus *mPaymentsRepositoryMockUpdatePaymentStatus) Return(err error) *PaymentsRepositoryMock {
+	if mmUpdatePaymentStatus.mock.funcUpdatePaymentStatus != nil {
+		mmUpdatePaymentStatus.mock.t.Fatalf("PaymentsRepositoryMock.UpdatePaymentStatus mock is already set by Set")
+	}
+
+	if mmUpdatePaymentStatus.defaultExpectation == nil {
+		mmUpdatePaymentStatus.defaultExpectation = &PaymentsRepositoryMockUpdatePaymentStatusExpectation{mock: mmUpdatePaymentStatus.mock}
+	}
+	mmUpdatePaymentStatus.defaultExpectation.results = &PaymentsRepositoryMockUpdatePaymentStatusResults{err}
+	return mmUpdatePaymentStatus.mock
+}
+
+// Set uses given function f to mock the PaymentsRepository.UpdatePaymentStatus method
+func (mmUpdatePaymentStatus *mPaymentsRepositoryMockUpdatePaymentStatus) Set(f func(ctx context.Context, id string, status PaymentStatus, statusBefore PaymentStatus) (err error)) *PaymentsRepositoryMock {
+	if mmUpdatePaymentStatus.defaultExpectation != nil {
+		mmUpdatePaymentStatus.mock.t.Fatalf("Default expectation is already set for the PaymentsRepository.UpdatePaymentStatus method")
+	}
+
+	if len(mmUpdatePaymentStatus.expectations) > 0 {
+		mmUpdatePaymentStatus.mock.t.Fatalf("Some expectations are already set for the PaymentsRepository.UpdatePaymentStatus method")
+	}
+
+	mmUpdatePaymentStatus.mock.funcUpdatePaymentStatus = f
+	return mmUpdatePaymentStatus.mock
+}
+
+// When sets expectation for the PaymentsRepository.UpdatePaymentStatus which will trigger the result defined by the following
+// Then helper
+func (mmUpdatePaymentStatus *mPaymentsRepositoryMockUpdatePaymentStatus) When(ctx context.Context, id string, status PaymentStatus, statusBefore PaymentStatus) *PaymentsRepositoryMockUpdatePaymentStatusExpectation {
+	if mmUpdatePaymentStatus.mock.funcUpdatePaymentStatus != nil {
+		mmUpdatePaymentStatus.mock.t.Fatalf("PaymentsRepositoryMock.UpdatePaymentStatus mock is already set by Set")
+	}
+
+	expectation := &PaymentsRepositoryMockUpdatePaymentStatusExpectation{
+		mock:   mmUpdatePaymentStatus.mock,
+		params: &PaymentsRepositoryMockUpdatePaymentStatusParams{ctx, id, status, statusBefore},
+	}
+	mmUpdatePaymentStatus.expectations = append(mmUpdatePaymentStatus.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PaymentsRepository.UpdatePaymentStatus return parameters for the expectation previously defined by the When method
+func (e *PaymentsRepositoryMockUpdatePaymentStatusExpectation) Then(err error) *PaymentsRepositoryMock {
+	e.results = &PaymentsRepositoryMockUpdatePaymentStatusResults{err}
+	return e.mock
+}
+
+// UpdatePaymentStatus implements PaymentsRepository
+func (mmUpdatePaymentStatus *PaymentsRepositoryMock) UpdatePaymentStatus(ctx context.Context, id string, status PaymentStatus, statusBefore PaymentStatus) (err error) {
+	mm_atomic.AddUint64(&mmUpdatePaymentStatus.beforeUpdatePaymentStatusCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdatePaymentStatus.afterUpdatePaymentStatusCounter, 1)
+
+	if mmUpdatePaymentStatus.inspectFuncUpdatePaymentStatus != nil {
+		mmUpdatePaymentStatus.inspectFuncUpdatePaymentStatus(ctx, id, status, statusBefore)
+	}
+
+	mm_params := &PaymentsRepositoryMockUpdatePaymentStatusParams{ctx, id, status, statusBefore}
+
+	// Record call args
+	mmUpdatePaymentStatus.UpdatePaymentStatusMock.mutex.Lock()
+	mmUpdatePaymentStatus.UpdatePaymentStatusMock.callArgs = append(mmUpdatePaymentStatus.UpdatePaymentStatusMock.callArgs, mm_params)
+	mmUpdatePaymentStatus.UpdatePaymentStatusMock.mutex.Unlock()
+
+	for _, e := range mmUpdatePaymentStatus.UpdatePaymentStatusMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdatePaymentStatus.UpdatePaymentStatusMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdatePaymentStatus.UpdatePaymentStatusMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdatePaymentStatus.UpdatePaymentStatusMock.defaultExpectation.params
+		mm_got := PaymentsRepositoryMockUpdatePaymentStatusParams{ctx, id, status, statusBefore}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdatePaymentStatus.t.Errorf("PaymentsRepositoryMock.UpdatePaymentStatus got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdatePaymentStatus.UpdatePaymentStatusMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdatePaymentStatus.t.Fatal("No results are set for the PaymentsRepositoryMock.UpdatePaymentStatus")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdatePaymentStatus.funcUpdatePaymentStatus != nil {
+		return mmUpdatePaymentStatus.funcUpdatePaymentStatus(ctx, id, status, statusBefore)
+	}
+	mmUpdatePaymentStatus.t.Fatalf("Unexpected call to PaymentsRepositoryMock.UpdatePaymentStatus. %v %v %v %v", ctx, id, status, statusBefore)
+	return
+}
+
+// UpdatePaymentStatusAfterCounter returns a count of finished PaymentsRepositoryMock.UpdatePaymentStatus invocations
+func (mmUpdatePaymentStatus *PaymentsRepositoryMock) UpdatePaymentStatusAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdatePaymentStatus.afterUpdatePaymentStatusCounter)
+}
+
+// UpdatePaymentStatusBeforeCounter returns a count of PaymentsRepositoryMock.UpdatePaymentStatus invocations
+func (mmUpdatePaymentStatus *PaymentsRepositoryMock) UpdatePaymentStatusBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdatePaymentStatus.beforeUpdatePaymentStatusCounter)
+}
+
+// Calls returns a list of arguments used in each call to PaymentsRepositoryMock.UpdatePaymentStatus.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdatePaymentStatus *mPaymentsRepositoryMockUpdatePaymentStatus) Calls() []*PaymentsRepositoryMockUpdatePaymentStatusParams {
+	mmUpdatePaymentStatus.mutex.RLock()
+
+	argCopy := make([]*PaymentsRepositoryMockUpdatePaymentStatusParams, len(mmUpdatePaymentStatus.callArgs))
+	copy(argCopy, mmUpdatePaymentStatus.callArgs)
+
+	mmUpdatePaymentStatus.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdatePaymentStatusDone returns true if the count of the UpdatePaymentStatus invocations corresponds
+// the number of defined expectations
+func (m *PaymentsRepositoryMock) MinimockUpdatePaymentStatusDone() bool {
+	for _, e := range m.UpdatePaymentStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdatePaymentStatusMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdatePaymentStatusCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdatePaymentStatus != nil && mm_atomic.LoadUint64(&m.afterUpdatePaymentStatusCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockUpdatePaymentStatusInspect logs each unmet expectation
+func (m *PaymentsRepositoryMock) MinimockUpdatePaymentStatusInspect() {
+	for _, e := range m.UpdatePaymentStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PaymentsRepositoryMock.UpdatePaymentStatus with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdatePaymentStatusMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdatePaymentStatusCounter) < 1 {
+		if m.UpdatePaymentStatusMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PaymentsRepositoryMock.UpdatePaymentStatus")
+		} else {
+			m.t.Errorf("Expected call to PaymentsRepositoryMock.UpdatePaymentStatus with params: %#v", *m.UpdatePaymentStatusMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdatePaymentStatus != nil && mm_atomic.LoadUint64(&m.afterUpdatePaymentStatusCounter) < 1 {
+		m.t.Error("Expected call to PaymentsRepositoryMock.UpdatePaymentStatus")
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *PaymentsRepositoryMock) MinimockFinish() {
+	if !m.minimockDone() {
+		m.MinimockCreatePaymentInspect()
+
+		m.MinimockCreateRefundInspect()
+
+		m.MinimockGetActivePaymentInspect()
+
+		m.MinimockGetPaymentInspect()
+
+		m.MinimockGetUnsentRefundsInspect()
+
+		m.MinimockMarkRefundsSentInspect()
+
+		m.MinimockUpdatePaymentStatusInspect()
+		m.t.FailNow()
+	}
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *PaymentsRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *PaymentsRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreatePaymentDone() &&
+		m.MinimockCreateRefundDone() &&
+		m.MinimockGetActivePaymentDone() &&
+		m.MinimockGetPaymentDone() &&
+		m.MinimockGetUnsentRefundsDone() &&
+		m.MinimockMarkRefundsSentDone() &&
+		m.MinimockUpdatePaymentStatusDone()
+}
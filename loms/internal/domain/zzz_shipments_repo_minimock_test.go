@@ -0,0 +1,972 @@
+package domain
+
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
+
+//go:generate minimock -i route256/loms/internal/domain.ShipmentsRepository -o ./zzz_shipments_repo_minimock_test.go -n ShipmentsRepositoryMock
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// ShipmentsRepositoryMock implements ShipmentsRepository
+type ShipmentsRepositoryMock struct {
+	t minimock.Tester
+
+	funcCreateShipment          func(ctx context.Context, shipment *Shipment) (i1 int64, err error)
+	inspectFuncCreateShipment   func(ctx context.Context, shipment *Shipment)
+	afterCreateShipmentCounter  uint64
+	beforeCreateShipmentCounter uint64
+	CreateShipmentMock          mShipmentsRepositoryMockCreateShipment
+
+	funcGetOrderShipments          func(ctx context.Context, orderID int64) (sa1 []Shipment, err error)
+	inspectFuncGetOrderShipments   func(ctx context.Context, orderID int64)
+	afterGetOrderShipmentsCounter  uint64
+	beforeGetOrderShipmentsCounter uint64
+	GetOrderShipmentsMock          mShipmentsRepositoryMockGetOrderShipments
+
+	funcGetShipment          func(ctx context.Context, id int64) (sp1 *Shipment, err error)
+	inspectFuncGetShipment   func(ctx context.Context, id int64)
+	afterGetShipmentCounter  uint64
+	beforeGetShipmentCounter uint64
+	GetShipmentMock          mShipmentsRepositoryMockGetShipment
+
+	funcUpdateShipment          func(ctx context.Context, shipment *Shipment, statusBefore ShipmentStatus) (err error)
+	inspectFuncUpdateShipment   func(ctx context.Context, shipment *Shipment, statusBefore ShipmentStatus)
+	afterUpdateShipmentCounter  uint64
+	beforeUpdateShipmentCounter uint64
+	UpdateShipmentMock          mShipmentsRepositoryMockUpdateShipment
+}
+
+// NewShipmentsRepositoryMock returns a mock for ShipmentsRepository
+func NewShipmentsRepositoryMock(t minimock.Tester) *ShipmentsRepositoryMock {
+	m := &ShipmentsRepositoryMock{t: t}
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateShipmentMock = mShipmentsRepositoryMockCreateShipment{mock: m}
+	m.CreateShipmentMock.callArgs = []*ShipmentsRepositoryMockCreateShipmentParams{}
+
+	m.GetOrderShipmentsMock = mShipmentsRepositoryMockGetOrderShipments{mock: m}
+	m.GetOrderShipmentsMock.callArgs = []*ShipmentsRepositoryMockGetOrderShipmentsParams{}
+
+	m.GetShipmentMock = mShipmentsRepositoryMockGetShipment{mock: m}
+	m.GetShipmentMock.callArgs = []*ShipmentsRepositoryMockGetShipmentParams{}
+
+	m.UpdateShipmentMock = mShipmentsRepositoryMockUpdateShipment{mock: m}
+	m.UpdateShipmentMock.callArgs = []*ShipmentsRepositoryMockUpdateShipmentParams{}
+
+	return m
+}
+
+type mShipmentsRepositoryMockCreateShipment struct {
+	mock               *ShipmentsRepositoryMock
+	defaultExpectation *ShipmentsRepositoryMockCreateShipmentExpectation
+	expectations       []*ShipmentsRepositoryMockCreateShipmentExpectation
+
+	callArgs []*ShipmentsRepositoryMockCreateShipmentParams
+	mutex    sync.RWMutex
+}
+
+// ShipmentsRepositoryMockCreateShipmentExpectation specifies expectation struct of the ShipmentsRepository.CreateShipment
+type ShipmentsRepositoryMockCreateShipmentExpectation struct {
+	mock    *ShipmentsRepositoryMock
+	params  *ShipmentsRepositoryMockCreateShipmentParams
+	results *ShipmentsRepositoryMockCreateShipmentResults
+	Counter uint64
+}
+
+// ShipmentsRepositoryMockCreateShipmentParams contains parameters of the ShipmentsRepository.CreateShipment
+type ShipmentsRepositoryMockCreateShipmentParams struct {
+	ctx      context.Context
+	shipment *Shipment
+}
+
+// ShipmentsRepositoryMockCreateShipmentResults contains results of the ShipmentsRepository.CreateShipment
+type ShipmentsRepositoryMockCreateShipmentResults struct {
+	i1  int64
+	err error
+}
+
+// Expect sets up expected params for ShipmentsRepository.CreateShipment
+func (mmCreateShipment *mShipmentsRepositoryMockCreateShipment) Expect(ctx context.Context, shipment *Shipment) *mShipmentsRepositoryMockCreateShipment {
+	if mmCreateShipment.mock.funcCreateShipment != nil {
+		mmCreateShipment.mock.t.Fatalf("ShipmentsRepositoryMock.CreateShipment mock is already set by Set")
+	}
+
+	if mmCreateShipment.defaultExpectation == nil {
+		mmCreateShipment.defaultExpectation = &ShipmentsRepositoryMockCreateShipmentExpectation{}
+	}
+
+	mmCreateShipment.defaultExpectation.params = &ShipmentsRepositoryMockCreateShipmentParams{ctx, shipment}
+	for _, e := range mmCreateShipment.expectations {
+		if minimock.Equal(e.params, mmCreateShipment.defaultExpectation.params) {
+			mmCreateShipment.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateShipment.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateShipment
+}
+
+// Inspect accepts an inspector function that has same arguments as the ShipmentsRepository.CreateShipment
+func (mmCreateShipment *mShipmentsRepositoryMockCreateShipment) Inspect(f func(ctx context.Context, shipment *Shipment)) *mShipmentsRepositoryMockCreateShipment {
+	if mmCreateShipment.mock.inspectFuncCreateShipment != nil {
+		mmCreateShipment.mock.t.Fatalf("Inspect function is already set for ShipmentsRepositoryMock.CreateShipment")
+	}
+
+	mmCreateShipment.mock.inspectFuncCreateShipment = f
+
+	return mmCreateShipment
+}
+
+// Return sets up results that will be returned by ShipmentsRepository.CreateShipment
+func (mmCreateShipment *mShipmentsRepositoryMockCreateShipment) Return(i1 int64, err error) *ShipmentsRepositoryMock {
+	if mmCreateShipment.mock.funcCreateShipment != nil {
+		mmCreateShipment.mock.t.Fatalf("ShipmentsRepositoryMock.CreateShipment mock is already set by Set")
+	}
+
+	if mmCreateShipment.defaultExpectation == nil {
+		mmCreateShipment.defaultExpectation = &ShipmentsRepositoryMockCreateShipmentExpectation{mock: mmCreateShipment.mock}
+	}
+	mmCreateShipment.defaultExpectation.results = &ShipmentsRepositoryMockCreateShipmentResults{i1, err}
+	return mmCreateShipment.mock
+}
+
+// Set uses given function f to mock the ShipmentsRepository.CreateShipment method
+func (mmCreateShipment *mShipmentsRepositoryMockCreateShipment) Set(f func(ctx context.Context, shipment *Shipment) (i1 int64, err error)) *ShipmentsRepositoryMock {
+	if mmCreateShipment.defaultExpectation != nil {
+		mmCreateShipment.mock.t.Fatalf("Default expectation is already set for the ShipmentsRepository.CreateShipment method")
+	}
+
+	if len(mmCreateShipment.expectations) > 0 {
+		mmCreateShipment.mock.t.Fatalf("Some expectations are already set for the ShipmentsRepository.CreateShipment method")
+	}
+
+	mmCreateShipment.mock.funcCreateShipment = f
+	return mmCreateShipment.mock
+}
+
+// When sets expectation for the ShipmentsRepository.CreateShipment which will trigger the result defined by the following
+// Then helper
+func (mmCreateShipment *mShipmentsRepositoryMockCreateShipment) When(ctx context.Context, shipment *Shipment) *ShipmentsRepositoryMockCreateShipmentExpectation {
+	if mmCreateShipment.mock.funcCreateShipment != nil {
+		mmCreateShipment.mock.t.Fatalf("ShipmentsRepositoryMock.CreateShipment mock is already set by Set")
+	}
+
+	expectation := &ShipmentsRepositoryMockCreateShipmentExpectation{
+		mock:   mmCreateShipment.mock,
+		params: &ShipmentsRepositoryMockCreateShipmentParams{ctx, shipment},
+	}
+	mmCreateShipment.expectations = append(mmCreateShipment.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ShipmentsRepository.CreateShipment return parameters for the expectation previously defined by the When method
+func (e *ShipmentsRepositoryMockCreateShipmentExpectation) Then(i1 int64, err error) *ShipmentsRepositoryMock {
+	e.results = &ShipmentsRepositoryMockCreateShipmentResults{i1, err}
+	return e.mock
+}
+
+// CreateShipment implements ShipmentsRepository
+func (mmCreateShipment *ShipmentsRepositoryMock) CreateShipment(ctx context.Context, shipment *Shipment) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreateShipment.beforeCreateShipmentCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateShipment.afterCreateShipmentCounter, 1)
+
+	if mmCreateShipment.inspectFuncCreateShipment != nil {
+		mmCreateShipment.inspectFuncCreateShipment(ctx, shipment)
+	}
+
+	mm_params := &ShipmentsRepositoryMockCreateShipmentParams{ctx, shipment}
+
+	// Record call args
+	mmCreateShipment.CreateShipmentMock.mutex.Lock()
+	mmCreateShipment.CreateShipmentMock.callArgs = append(mmCreateShipment.CreateShipmentMock.callArgs, mm_params)
+	mmCreateShipment.CreateShipmentMock.mutex.Unlock()
+
+	for _, e := range mmCreateShipment.CreateShipmentMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreateShipment.CreateShipmentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateShipment.CreateShipmentMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateShipment.CreateShipmentMock.defaultExpectation.params
+		mm_got := ShipmentsRepositoryMockCreateShipmentParams{ctx, shipment}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateShipment.t.Errorf("ShipmentsRepositoryMock.CreateShipment got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateShipment.CreateShipmentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateShipment.t.Fatal("No results are set for the ShipmentsRepositoryMock.CreateShipment")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreateShipment.funcCreateShipment != nil {
+		return mmCreateShipment.funcCreateShipment(ctx, shipment)
+	}
+	mmCreateShipment.t.Fatalf("Unexpected call to ShipmentsRepositoryMock.CreateShipment. %v %v", ctx, shipment)
+	return
+}
+
+// CreateShipmentAfterCounter returns a count of finished ShipmentsRepositoryMock.CreateShipment invocations
+func (mmCreateShipment *ShipmentsRepositoryMock) CreateShipmentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateShipment.afterCreateShipmentCounter)
+}
+
+// CreateShipmentBeforeCounter returns a count of ShipmentsRepositoryMock.CreateShipment invocations
+func (mmCreateShipment *ShipmentsRepositoryMock) CreateShipmentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateShipment.beforeCreateShipmentCounter)
+}
+
+// Calls returns a list of arguments used in each call to ShipmentsRepositoryMock.CreateShipment.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateShipment *mShipmentsRepositoryMockCreateShipment) Calls() []*ShipmentsRepositoryMockCreateShipmentParams {
+	mmCreateShipment.mutex.RLock()
+
+	argCopy := make([]*ShipmentsRepositoryMockCreateShipmentParams, len(mmCreateShipment.callArgs))
+	copy(argCopy, mmCreateShipment.callArgs)
+
+	mmCreateShipment.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateShipmentDone returns true if the count of the CreateShipment invocations corresponds
+// the number of defined expectations
+func (m *ShipmentsRepositoryMock) MinimockCreateShipmentDone() bool {
+	for _, e := range m.CreateShipmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateShipmentMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateShipmentCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateShipment != nil && mm_atomic.LoadUint64(&m.afterCreateShipmentCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockCreateShipmentInspect logs each unmet expectation
+func (m *ShipmentsRepositoryMock) MinimockCreateShipmentInspect() {
+	for _, e := range m.CreateShipmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ShipmentsRepositoryMock.CreateShipment with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateShipmentMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateShipmentCounter) < 1 {
+		if m.CreateShipmentMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ShipmentsRepositoryMock.CreateShipment")
+		} else {
+			m.t.Errorf("Expected call to ShipmentsRepositoryMock.CreateShipment with params: %#v", *m.CreateShipmentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateShipment != nil && mm_atomic.LoadUint64(&m.afterCreateShipmentCounter) < 1 {
+		m.t.Error("Expected call to ShipmentsRepositoryMock.CreateShipment")
+	}
+}
+
+type mShipmentsRepositoryMockGetOrderShipments struct {
+	mock               *ShipmentsRepositoryMock
+	defaultExpectation *ShipmentsRepositoryMockGetOrderShipmentsExpectation
+	expectations       []*ShipmentsRepositoryMockGetOrderShipmentsExpectation
+
+	callArgs []*ShipmentsRepositoryMockGetOrderShipmentsParams
+	mutex    sync.RWMutex
+}
+
+// ShipmentsRepositoryMockGetOrderShipmentsExpectation specifies expectation struct of the ShipmentsRepository.GetOrderShipments
+type ShipmentsRepositoryMockGetOrderShipmentsExpectation struct {
+	mock    *ShipmentsRepositoryMock
+	params  *ShipmentsRepositoryMockGetOrderShipmentsParams
+	results *ShipmentsRepositoryMockGetOrderShipmentsResults
+	Counter uint64
+}
+
+// ShipmentsRepositoryMockGetOrderShipmentsParams contains parameters of the ShipmentsRepository.GetOrderShipments
+type ShipmentsRepositoryMockGetOrderShipmentsParams struct {
+	ctx     context.Context
+	orderID int64
+}
+
+// ShipmentsRepositoryMockGetOrderShipmentsResults contains results of the ShipmentsRepository.GetOrderShipments
+type ShipmentsRepositoryMockGetOrderShipmentsResults struct {
+	sa1 []Shipment
+	err error
+}
+
+// Expect sets up expected params for ShipmentsRepository.GetOrderShipments
+func (mmGetOrderShipments *mShipmentsRepositoryMockGetOrderShipments) Expect(ctx context.Context, orderID int64) *mShipmentsRepositoryMockGetOrderShipments {
+	if mmGetOrderShipments.mock.funcGetOrderShipments != nil {
+		mmGetOrderShipments.mock.t.Fatalf("ShipmentsRepositoryMock.GetOrderShipments mock is already set by Set")
+	}
+
+	if mmGetOrderShipments.defaultExpectation == nil {
+		mmGetOrderShipments.defaultExpectation = &ShipmentsRepositoryMockGetOrderShipmentsExpectation{}
+	}
+
+	mmGetOrderShipments.defaultExpectation.params = &ShipmentsRepositoryMockGetOrderShipmentsParams{ctx, orderID}
+	for _, e := range mmGetOrderShipments.expectations {
+		if minimock.Equal(e.params, mmGetOrderShipments.defaultExpectation.params) {
+			mmGetOrderShipments.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetOrderShipments.defaultExpectation.params)
+		}
+	}
+
+	return mmGetOrderShipments
+}
+
+// Inspect accepts an inspector function that has same arguments as the ShipmentsRepository.GetOrderShipments
+func (mmGetOrderShipments *mShipmentsRepositoryMockGetOrderShipments) Inspect(f func(ctx context.Context, orderID int64)) *mShipmentsRepositoryMockGetOrderShipments {
+	if mmGetOrderShipments.mock.inspectFuncGetOrderShipments != nil {
+		mmGetOrderShipments.mock.t.Fatalf("Inspect function is already set for ShipmentsRepositoryMock.GetOrderShipments")
+	}
+
+	mmGetOrderShipments.mock.inspectFuncGetOrderShipments = f
+
+	return mmGetOrderShipments
+}
+
+// Return sets up results that will be returned by ShipmentsRepository.GetOrderShipments
+func (mmGetOrderShipments *mShipmentsRepositoryMockGetOrderShipments) Return(sa1 []Shipment, err error) *ShipmentsRepositoryMock {
+	if mmGetOrderShipments.mock.funcGetOrderShipments != nil {
+		mmGetOrderShipments.mock.t.Fatalf("ShipmentsRepositoryMock.GetOrderShipments mock is already set by Set")
+	}
+
+	if mmGetOrderShipments.defaultExpectation == nil {
+		mmGetOrderShipments.defaultExpectation = &ShipmentsRepositoryMockGetOrderShipmentsExpectation{mock: mmGetOrderShipments.mock}
+	}
+	mmGetOrderShipments.defaultExpectation.results = &ShipmentsRepositoryMockGetOrderShipmentsResults{sa1, err}
+	return mmGetOrderShipments.mock
+}
+
+// Set uses given function f to mock the ShipmentsRepository.GetOrderShipments method
+func (mmGetOrderShipments *mShipmentsRepositoryMockGetOrderShipments) Set(f func(ctx context.Context, orderID int64) (sa1 []Shipment, err error)) *ShipmentsRepositoryMock {
+	if mmGetOrderShipments.defaultExpectation != nil {
+		mmGetOrderShipments.mock.t.Fatalf("Default expectation is already set for the ShipmentsRepository.GetOrderShipments method")
+	}
+
+	if len(mmGetOrderShipments.expectations) > 0 {
+		mmGetOrderShipments.mock.t.Fatalf("Some expectations are already set for the ShipmentsRepository.GetOrderShipments method")
+	}
+
+	mmGetOrderShipments.mock.funcGetOrderShipments = f
+	return mmGetOrderShipments.mock
+}
+
+// When sets expectation for the ShipmentsRepository.GetOrderShipments which will trigger the result defined by the following
+// Then helper
+func (mmGetOrderShipments *mShipmentsRepositoryMockGetOrderShipments) When(ctx context.Context, orderID int64) *ShipmentsRepositoryMockGetOrderShipmentsExpectation {
+	if mmGetOrderShipments.mock.funcGetOrderShipments != nil {
+		mmGetOrderShipments.mock.t.Fatalf("ShipmentsRepositoryMock.GetOrderShipments mock is already set by Set")
+	}
+
+	expectation := &ShipmentsRepositoryMockGetOrderShipmentsExpectation{
+		mock:   mmGetOrderShipments.mock,
+		params: &ShipmentsRepositoryMockGetOrderShipmentsParams{ctx, orderID},
+	}
+	mmGetOrderShipments.expectations = append(mmGetOrderShipments.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ShipmentsRepository.GetOrderShipments return parameters for the expectation previously defined by the When method
+func (e *ShipmentsRepositoryMockGetOrderShipmentsExpectation) Then(sa1 []Shipment, err error) *ShipmentsRepositoryMock {
+	e.results = &ShipmentsRepositoryMockGetOrderShipmentsResults{sa1, err}
+	return e.mock
+}
+
+// GetOrderShipments implements ShipmentsRepository
+func (mmGetOrderShipments *ShipmentsRepositoryMock) GetOrderShipments(ctx context.Context, orderID int64) (sa1 []Shipment, err error) {
+	mm_atomic.AddUint64(&mmGetOrderShipments.beforeGetOrderShipmentsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetOrderShipments.afterGetOrderShipmentsCounter, 1)
+
+	if mmGetOrderShipments.inspectFuncGetOrderShipments != nil {
+		mmGetOrderShipments.inspectFuncGetOrderShipments(ctx, orderID)
+	}
+
+	mm_params := &ShipmentsRepositoryMockGetOrderShipmentsParams{ctx, orderID}
+
+	// Record call args
+	mmGetOrderShipments.GetOrderShipmentsMock.mutex.Lock()
+	mmGetOrderShipments.GetOrderShipmentsMock.callArgs = append(mmGetOrderShipments.GetOrderShipmentsMock.callArgs, mm_params)
+	mmGetOrderShipments.GetOrderShipmentsMock.mutex.Unlock()
+
+	for _, e := range mmGetOrderShipments.GetOrderShipmentsMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmGetOrderShipments.GetOrderShipmentsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetOrderShipments.GetOrderShipmentsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetOrderShipments.GetOrderShipmentsMock.defaultExpectation.params
+		mm_got := ShipmentsRepositoryMockGetOrderShipmentsParams{ctx, orderID}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetOrderShipments.t.Errorf("ShipmentsRepositoryMock.GetOrderShipments got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetOrderShipments.GetOrderShipmentsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetOrderShipments.t.Fatal("No results are set for the ShipmentsRepositoryMock.GetOrderShipments")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmGetOrderShipments.funcGetOrderShipments != nil {
+		return mmGetOrderShipments.funcGetOrderShipments(ctx, orderID)
+	}
+	mmGetOrderShipments.t.Fatalf("Unexpected call to ShipmentsRepositoryMock.GetOrderShipments. %v %v", ctx, orderID)
+	return
+}
+
+// GetOrderShipmentsAfterCounter returns a count of finished ShipmentsRepositoryMock.GetOrderShipments invocations
+func (mmGetOrderShipments *ShipmentsRepositoryMock) GetOrderShipmentsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOrderShipments.afterGetOrderShipmentsCounter)
+}
+
+// GetOrderShipmentsBeforeCounter returns a count of ShipmentsRepositoryMock.GetOrderShipments invocations
+func (mmGetOrderShipments *ShipmentsRepositoryMock) GetOrderShipmentsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOrderShipments.beforeGetOrderShipmentsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ShipmentsRepositoryMock.GetOrderShipments.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetOrderShipments *mShipmentsRepositoryMockGetOrderShipments) Calls() []*ShipmentsRepositoryMockGetOrderShipmentsParams {
+	mmGetOrderShipments.mutex.RLock()
+
+	argCopy := make([]*ShipmentsRepositoryMockGetOrderShipmentsParams, len(mmGetOrderShipments.callArgs))
+	copy(argCopy, mmGetOrderShipments.callArgs)
+
+	mmGetOrderShipments.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetOrderShipmentsDone returns true if the count of the GetOrderShipments invocations corresponds
+// the number of defined expectations
+func (m *ShipmentsRepositoryMock) MinimockGetOrderShipmentsDone() bool {
+	for _, e := range m.GetOrderShipmentsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetOrderShipmentsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetOrderShipmentsCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetOrderShipments != nil && mm_atomic.LoadUint64(&m.afterGetOrderShipmentsCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetOrderShipmentsInspect logs each unmet expectation
+func (m *ShipmentsRepositoryMock) MinimockGetOrderShipmentsInspect() {
+	for _, e := range m.GetOrderShipmentsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ShipmentsRepositoryMock.GetOrderShipments with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetOrderShipmentsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetOrderShipmentsCounter) < 1 {
+		if m.GetOrderShipmentsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ShipmentsRepositoryMock.GetOrderShipments")
+		} else {
+			m.t.Errorf("Expected call to ShipmentsRepositoryMock.GetOrderShipments with params: %#v", *m.GetOrderShipmentsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetOrderShipments != nil && mm_atomic.LoadUint64(&m.afterGetOrderShipmentsCounter) < 1 {
+		m.t.Error("Expected call to ShipmentsRepositoryMock.GetOrderShipments")
+	}
+}
+
+type mShipmentsRepositoryMockGetShipment struct {
+	mock               *ShipmentsRepositoryMock
+	defaultExpectation *ShipmentsRepositoryMockGetShipmentExpectation
+	expectations       []*ShipmentsRepositoryMockGetShipmentExpectation
+
+	callArgs []*ShipmentsRepositoryMockGetShipmentParams
+	mutex    sync.RWMutex
+}
+
+// ShipmentsRepositoryMockGetShipmentExpectation specifies expectation struct of the ShipmentsRepository.GetShipment
+type ShipmentsRepositoryMockGetShipmentExpectation struct {
+	mock    *ShipmentsRepositoryMock
+	params  *ShipmentsRepositoryMockGetShipmentParams
+	results *ShipmentsRepositoryMockGetShipmentResults
+	Counter uint64
+}
+
+// ShipmentsRepositoryMockGetShipmentParams contains parameters of the ShipmentsRepository.GetShipment
+type ShipmentsRepositoryMockGetShipmentParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// ShipmentsRepositoryMockGetShipmentResults contains results of the ShipmentsRepository.GetShipment
+type ShipmentsRepositoryMockGetShipmentResults struct {
+	sp1 *Shipment
+	err error
+}
+
+// Expect sets up expected params for ShipmentsRepository.GetShipment
+func (mmGetShipment *mShipmentsRepositoryMockGetShipment) Expect(ctx context.Context, id int64) *mShipmentsRepositoryMockGetShipment {
+	if mmGetShipment.mock.funcGetShipment != nil {
+		mmGetShipment.mock.t.Fatalf("ShipmentsRepositoryMock.GetShipment mock is already set by Set")
+	}
+
+	if mmGetShipment.defaultExpectation == nil {
+		mmGetShipment.defaultExpectation = &ShipmentsRepositoryMockGetShipmentExpectation{}
+	}
+
+	mmGetShipment.defaultExpectation.params = &ShipmentsRepositoryMockGetShipmentParams{ctx, id}
+	for _, e := range mmGetShipment.expectations {
+		if minimock.Equal(e.params, mmGetShipment.defaultExpectation.params) {
+			mmGetShipment.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetShipment.defaultExpectation.params)
+		}
+	}
+
+	return mmGetShipment
+}
+
+// Inspect accepts an inspector function that has same arguments as the ShipmentsRepository.GetShipment
+func (mmGetShipment *mShipmentsRepositoryMockGetShipment) Inspect(f func(ctx context.Context, id int64)) *mShipmentsRepositoryMockGetShipment {
+	if mmGetShipment.mock.inspectFuncGetShipment != nil {
+		mmGetShipment.mock.t.Fatalf("Inspect function is already set for ShipmentsRepositoryMock.GetShipment")
+	}
+
+	mmGetShipment.mock.inspectFuncGetShipment = f
+
+	return mmGetShipment
+}
+
+// Return sets up results that will be returned by ShipmentsRepository.GetShipment
+func (mmGetShipment *mShipmentsRepositoryMockGetShipment) Return(sp1 *Shipment, err error) *ShipmentsRepositoryMock {
+	if mmGetShipment.mock.funcGetShipment != nil {
+		mmGetShipment.mock.t.Fatalf("ShipmentsRepositoryMock.GetShipment mock is already set by Set")
+	}
+
+	if mmGetShipment.defaultExpectation == nil {
+		mmGetShipment.defaultExpectation = &ShipmentsRepositoryMockGetShipmentExpectation{mock: mmGetShipment.mock}
+	}
+	mmGetShipment.defaultExpectation.results = &ShipmentsRepositoryMockGetShipmentResults{sp1, err}
+	return mmGetShipment.mock
+}
+
+// Set uses given function f to mock the ShipmentsRepository.GetShipment method
+func (mmGetShipment *mShipmentsRepositoryMockGetShipment) Set(f func(ctx context.Context, id int64) (sp1 *Shipment, err error)) *ShipmentsRepositoryMock {
+	if mmGetShipment.defaultExpectation != nil {
+		mmGetShipment.mock.t.Fatalf("Default expectation is already set for the ShipmentsRepository.GetShipment method")
+	}
+
+	if len(mmGetShipment.expectations) > 0 {
+		mmGetShipment.mock.t.Fatalf("Some expectations are already set for the ShipmentsRepository.GetShipment method")
+	}
+
+	mmGetShipment.mock.funcGetShipment = f
+	return mmGetShipment.mock
+}
+
+// When sets expectation for the ShipmentsRepository.GetShipment which will trigger the result defined by the following
+// Then helper
+func (mmGetShipment *mShipmentsRepositoryMockGetShipment) When(ctx context.Context, id int64) *ShipmentsRepositoryMockGetShipmentExpectation {
+	if mmGetShipment.mock.funcGetShipment != nil {
+		mmGetShipment.mock.t.Fatalf("ShipmentsRepositoryMock.GetShipment mock is already set by Set")
+	}
+
+	expectation := &ShipmentsRepositoryMockGetShipmentExpectation{
+		mock:   mmGetShipment.mock,
+		params: &ShipmentsRepositoryMockGetShipmentParams{ctx, id},
+	}
+	mmGetShipment.expectations = append(mmGetShipment.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ShipmentsRepository.GetShipment return parameters for the expectation previously defined by the When method
+func (e *ShipmentsRepositoryMockGetShipmentExpectation) Then(sp1 *Shipment, err error) *ShipmentsRepositoryMock {
+	e.results = &ShipmentsRepositoryMockGetShipmentResults{sp1, err}
+	return e.mock
+}
+
+// GetShipment implements ShipmentsRepository
+func (mmGetShipment *ShipmentsRepositoryMock) GetShipment(ctx context.Context, id int64) (sp1 *Shipment, err error) {
+	mm_atomic.AddUint64(&mmGetShipment.beforeGetShipmentCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetShipment.afterGetShipmentCounter, 1)
+
+	if mmGetShipment.inspectFuncGetShipment != nil {
+		mmGetShipment.inspectFuncGetShipment(ctx, id)
+	}
+
+	mm_params := &ShipmentsRepositoryMockGetShipmentParams{ctx, id}
+
+	// Record call args
+	mmGetShipment.GetShipmentMock.mutex.Lock()
+	mmGetShipment.GetShipmentMock.callArgs = append(mmGetShipment.GetShipmentMock.callArgs, mm_params)
+	mmGetShipment.GetShipmentMock.mutex.Unlock()
+
+	for _, e := range mmGetShipment.GetShipmentMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sp1, e.results.err
+		}
+	}
+
+	if mmGetShipment.GetShipmentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetShipment.GetShipmentMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetShipment.GetShipmentMock.defaultExpectation.params
+		mm_got := ShipmentsRepositoryMockGetShipmentParams{ctx, id}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetShipment.t.Errorf("ShipmentsRepositoryMock.GetShipment got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetShipment.GetShipmentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetShipment.t.Fatal("No results are set for the ShipmentsRepositoryMock.GetShipment")
+		}
+		return (*mm_results).sp1, (*mm_results).err
+	}
+	if mmGetShipment.funcGetShipment != nil {
+		return mmGetShipment.funcGetShipment(ctx, id)
+	}
+	mmGetShipment.t.Fatalf("Unexpected call to ShipmentsRepositoryMock.GetShipment. %v %v", ctx, id)
+	return
+}
+
+// GetShipmentAfterCounter returns a count of finished ShipmentsRepositoryMock.GetShipment invocations
+func (mmGetShipment *ShipmentsRepositoryMock) GetShipmentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetShipment.afterGetShipmentCounter)
+}
+
+// GetShipmentBeforeCounter returns a count of ShipmentsRepositoryMock.GetShipment invocations
+func (mmGetShipment *ShipmentsRepositoryMock) GetShipmentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetShipment.beforeGetShipmentCounter)
+}
+
+// Calls returns a list of arguments used in each call to ShipmentsRepositoryMock.GetShipment.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetShipment *mShipmentsRepositoryMockGetShipment) Calls() []*ShipmentsRepositoryMockGetShipmentParams {
+	mmGetShipment.mutex.RLock()
+
+	argCopy := make([]*ShipmentsRepositoryMockGetShipmentParams, len(mmGetShipment.callArgs))
+	copy(argCopy, mmGetShipment.callArgs)
+
+	mmGetShipment.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetShipmentDone returns true if the count of the GetShipment invocations corresponds
+// the number of defined expectations
+func (m *ShipmentsRepositoryMock) MinimockGetShipmentDone() bool {
+	for _, e := range m.GetShipmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetShipmentMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetShipmentCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetShipment != nil && mm_atomic.LoadUint64(&m.afterGetShipmentCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetShipmentInspect logs each unmet expectation
+func (m *ShipmentsRepositoryMock) MinimockGetShipmentInspect() {
+	for _, e := range m.GetShipmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ShipmentsRepositoryMock.GetShipment with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetShipmentMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetShipmentCounter) < 1 {
+		if m.GetShipmentMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ShipmentsRepositoryMock.GetShipment")
+		} else {
+			m.t.Errorf("Expected call to ShipmentsRepositoryMock.GetShipment with params: %#v", *m.GetShipmentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetShipment != nil && mm_atomic.LoadUint64(&m.afterGetShipmentCounter) < 1 {
+		m.t.Error("Expected call to ShipmentsRepositoryMock.GetShipment")
+	}
+}
+
+type mShipmentsRepositoryMockUpdateShipment struct {
+	mock               *ShipmentsRepositoryMock
+	defaultExpectation *ShipmentsRepositoryMockUpdateShipmentExpectation
+	expectations       []*ShipmentsRepositoryMockUpdateShipmentExpectation
+
+	callArgs []*ShipmentsRepositoryMockUpdateShipmentParams
+	mutex    sync.RWMutex
+}
+
+// ShipmentsRepositoryMockUpdateShipmentExpectation specifies expectation struct of the ShipmentsRepository.UpdateShipment
+type ShipmentsRepositoryMockUpdateShipmentExpectation struct {
+	mock    *ShipmentsRepositoryMock
+	params  *ShipmentsRepositoryMockUpdateShipmentParams
+	results *ShipmentsRepositoryMockUpdateShipmentResults
+	Counter uint64
+}
+
+// ShipmentsRepositoryMockUpdateShipmentParams contains parameters of the ShipmentsRepository.UpdateShipment
+type ShipmentsRepositoryMockUpdateShipmentParams struct {
+	ctx          context.Context
+	shipment     *Shipment
+	statusBefore ShipmentStatus
+}
+
+// ShipmentsRepositoryMockUpdateShipmentResults contains results of the ShipmentsRepository.UpdateShipment
+type ShipmentsRepositoryMockUpdateShipmentResults struct {
+	err error
+}
+
+// Expect sets up expected params for ShipmentsRepository.UpdateShipment
+func (mmUpdateShipment *mShipmentsRepositoryMockUpdateShipment) Expect(ctx context.Context, shipment *Shipment, statusBefore ShipmentStatus) *mShipmentsRepositoryMockUpdateShipment {
+	if mmUpdateShipment.mock.funcUpdateShipment != nil {
+		mmUpdateShipment.mock.t.Fatalf("ShipmentsRepositoryMock.UpdateShipment mock is already set by Set")
+	}
+
+	if mmUpdateShipment.defaultExpectation == nil {
+		mmUpdateShipment.defaultExpectation = &ShipmentsRepositoryMockUpdateShipmentExpectation{}
+	}
+
+	mmUpdateShipment.defaultExpectation.params = &ShipmentsRepositoryMockUpdateShipmentParams{ctx, shipment, statusBefore}
+	for _, e := range mmUpdateShipment.expectations {
+		if minimock.Equal(e.params, mmUpdateShipment.defaultExpectation.params) {
+			mmUpdateShipment.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateShipment.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateShipment
+}
+
+// Inspect accepts an inspector function that has same arguments as the ShipmentsRepository.UpdateShipment
+func (mmUpdateShipment *mShipmentsRepositoryMockUpdateShipment) Inspect(f func(ctx context.Context, shipment *Shipment, statusBefore ShipmentStatus)) *mShipmentsRepositoryMockUpdateShipment {
+	if mmUpdateShipment.mock.inspectFuncUpdateShipment != nil {
+		mmUpdateShipment.mock.t.Fatalf("Inspect function is already set for ShipmentsRepositoryMock.UpdateShipment")
+	}
+
+	mmUpdateShipment.mock.inspectFuncUpdateShipment = f
+
+	return mmUpdateShipment
+}
+
+// Return sets up results that will be returned by ShipmentsRepository.UpdateShipment
+func (mmUpdateShipment *mShipmentsRepositoryMockUpdateShipment) Return(err error) *ShipmentsRepositoryMock {
+	if mmUpdateShipment.mock.funcUpdateShipment != nil {
+		mmUpdateShipment.mock.t.Fatalf("ShipmentsRepositoryMock.UpdateShipment mock is already set by Set")
+	}
+
+	if mmUpdateShipment.defaultExpectation == nil {
+		mmUpdateShipment.defaultExpectation = &ShipmentsRepositoryMockUpdateShipmentExpectation{mock: mmUpdateShipment.mock}
+	}
+	mmUpdateShipment.defaultExpectation.results = &ShipmentsRepositoryMockUpdateShipmentResults{err}
+	return mmUpdateShipment.mock
+}
+
+// Set uses given function f to mock the ShipmentsRepository.UpdateShipment method
+func (mmUpdateShipment *mShipmentsRepositoryMockUpdateShipment) Set(f func(ctx context.Context, shipment *Shipment, statusBefore ShipmentStatus) (err error)) *ShipmentsRepositoryMock {
+	if mmUpdateShipment.defaultExpectation != nil {
+		mmUpdateShipment.mock.t.Fatalf("Default expectation is already set for the ShipmentsRepository.UpdateShipment method")
+	}
+
+	if len(mmUpdateShipment.expectations) > 0 {
+		mmUpdateShipment.mock.t.Fatalf("Some expectations are already set for the ShipmentsRepository.UpdateShipment method")
+	}
+
+	mmUpdateShipment.mock.funcUpdateShipment = f
+	return mmUpdateShipment.mock
+}
+
+// When sets expectation for the ShipmentsRepository.UpdateShipment which will trigger the result defined by the following
+// Then helper
+func (mmUpdateShipment *mShipmentsRepositoryMockUpdateShipment) When(ctx context.Context, shipment *Shipment, statusBefore ShipmentStatus) *ShipmentsRepositoryMockUpdateShipmentExpectation {
+	if mmUpdateShipment.mock.funcUpdateShipment != nil {
+		mmUpdateShipment.mock.t.Fatalf("ShipmentsRepositoryMock.UpdateShipment mock is already set by Set")
+	}
+
+	expectation := &ShipmentsRepositoryMockUpdateShipmentExpectation{
+		mock:   mmUpdateShipment.mock,
+		params: &ShipmentsRepositoryMockUpdateShipmentParams{ctx, shipment, statusBefore},
+	}
+	mmUpdateShipment.expectations = append(mmUpdateShipment.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ShipmentsRepository.UpdateShipment return parameters for the expectation previously defined by the When method
+func (e *ShipmentsRepositoryMockUpdateShipmentExpectation) Then(err error) *ShipmentsRepositoryMock {
+	e.results = &ShipmentsRepositoryMockUpdateShipmentResults{err}
+	return e.mock
+}
+
+// UpdateShipment implements ShipmentsRepository
+func (mmUpdateShipment *ShipmentsRepositoryMock) UpdateShipment(ctx context.Context, shipment *Shipment, statusBefore ShipmentStatus) (err error) {
+	mm_atomic.AddUint64(&mmUpdateShipment.beforeUpdateShipmentCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateShipment.afterUpdateShipmentCounter, 1)
+
+	if mmUpdateShipment.inspectFuncUpdateShipment != nil {
+		mmUpdateShipment.inspectFuncUpdateShipment(ctx, shipment, statusBefore)
+	}
+
+	mm_params := &ShipmentsRepositoryMockUpdateShipmentParams{ctx, shipment, statusBefore}
+
+	// Record call args
+	mmUpdateShipment.UpdateShipmentMock.mutex.Lock()
+	mmUpdateShipment.UpdateShipmentMock.callArgs = append(mmUpdateShipment.UpdateShipmentMock.callArgs, mm_params)
+	mmUpdateShipment.UpdateShipmentMock.mutex.Unlock()
+
+	for _, e := range mmUpdateShipment.UpdateShipmentMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdateShipment.UpdateShipmentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateShipment.UpdateShipmentMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateShipment.UpdateShipmentMock.defaultExpectation.params
+		mm_got := ShipmentsRepositoryMockUpdateShipmentParams{ctx, shipment, statusBefore}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateShipment.t.Errorf("ShipmentsRepositoryMock.UpdateShipment got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateShipment.UpdateShipmentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateShipment.t.Fatal("No results are set for the ShipmentsRepositoryMock.UpdateShipment")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdateShipment.funcUpdateShipment != nil {
+		return mmUpdateShipment.funcUpdateShipment(ctx, shipment, statusBefore)
+	}
+	mmUpdateShipment.t.Fatalf("Unexpected call to ShipmentsRepositoryMock.UpdateShipment. %v %v %v", ctx, shipment, statusBefore)
+	return
+}
+
+// UpdateShipmentAfterCounter returns a count of finished ShipmentsRepositoryMock.UpdateShipment invocations
+func (mmUpdateShipment *ShipmentsRepositoryMock) UpdateShipmentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateShipment.afterUpdateShipmentCounter)
+}
+
+// UpdateShipmentBeforeCounter returns a count of ShipmentsRepositoryMock.UpdateShipment invocations
+func (mmUpdateShipment *ShipmentsRepositoryMock) UpdateShipmentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateShipment.beforeUpdateShipmentCounter)
+}
+
+// Calls returns a list of arguments used in each call to ShipmentsRepositoryMock.UpdateShipment.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateShipment *mShipmentsRepositoryMockUpdateShipment) Calls() []*ShipmentsRepositoryMockUpdateShipmentParams {
+	mmUpdateShipment.mutex.RLock()
+
+	argCopy := make([]*ShipmentsRepositoryMockUpdateShipmentParams, len(mmUpdateShipment.callArgs))
+	copy(argCopy, mmUpdateShipment.callArgs)
+
+	mmUpdateShipment.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateShipmentDone returns true if the count of the UpdateShipment invocations corresponds
+// the number of defined expectations
+func (m *ShipmentsRepositoryMock) MinimockUpdateShipmentDone() bool {
+	for _, e := range m.UpdateShipmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateShipmentMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdateShipmentCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateShipment != nil && mm_atomic.LoadUint64(&m.afterUpdateShipmentCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockUpdateShipmentInspect logs each unmet expectation
+func (m *ShipmentsRepositoryMock) MinimockUpdateShipmentInspect() {
+	for _, e := range m.UpdateShipmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ShipmentsRepositoryMock.UpdateShipment with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateShipmentMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdateShipmentCounter) < 1 {
+		if m.UpdateShipmentMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ShipmentsRepositoryMock.UpdateShipment")
+		} else {
+			m.t.Errorf("Expected call to ShipmentsRepositoryMock.UpdateShipment with params: %#v", *m.UpdateShipmentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateShipment != nil && mm_atomic.LoadUint64(&m.afterUpdateShipmentCounter) < 1 {
+		m.t.Error("Expected call to ShipmentsRepositoryMock.UpdateShipment")
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ShipmentsRepositoryMock) MinimockFinish() {
+	if !m.minimockDone() {
+		m.MinimockCreateShipmentInspect()
+
+		m.MinimockGetOrderShipmentsInspect()
+
+		m.MinimockGetShipmentInspect()
+
+		m.MinimockUpdateShipmentInspect()
+		m.t.FailNow()
+	}
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ShipmentsRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ShipmentsRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateShipmentDone() &&
+		m.MinimockGetOrderShipmentsDone() &&
+		m.MinimockGetShipmentDone() &&
+		m.MinimockUpdateShipmentDone()
+}
@@ -0,0 +1,1429 @@
+package domain
+
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
+
+//go:generate minimock -i route256/loms/internal/domain.WarehousesRepository -o ./zzz_warehouses_repo_minimock_test.go -n WarehousesRepositoryMock
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// WarehousesRepositoryMock implements WarehousesRepository
+type WarehousesRepositoryMock struct {
+	t minimock.Tester
+
+	funcChangeStock          func(ctx context.Context, movement StockMovement) (err error)
+	inspectFuncChangeStock   func(ctx context.Context, movement StockMovement)
+	afterChangeStockCounter  uint64
+	beforeChangeStockCounter uint64
+	ChangeStockMock          mWarehousesRepositoryMockChangeStock
+
+	funcCreateSeller          func(ctx context.Context, name string) (i1 int64, err error)
+	inspectFuncCreateSeller   func(ctx context.Context, name string)
+	afterCreateSellerCounter  uint64
+	beforeCreateSellerCounter uint64
+	CreateSellerMock          mWarehousesRepositoryMockCreateSeller
+
+	funcCreateWarehouse          func(ctx context.Context, name string, sellerID int64) (i1 int64, err error)
+	inspectFuncCreateWarehouse   func(ctx context.Context, name string, sellerID int64)
+	afterCreateWarehouseCounter  uint64
+	beforeCreateWarehouseCounter uint64
+	CreateWarehouseMock          mWarehousesRepositoryMockCreateWarehouse
+
+	funcListWarehouseStock          func(ctx context.Context, warehouseID int64) (wa1 []WarehouseStock, err error)
+	inspectFuncListWarehouseStock   func(ctx context.Context, warehouseID int64)
+	afterListWarehouseStockCounter  uint64
+	beforeListWarehouseStockCounter uint64
+	ListWarehouseStockMock          mWarehousesRepositoryMockListWarehouseStock
+
+	funcSoldItems          func(ctx context.Context, orderID int64) (ra1 []ReservedItem, err error)
+	inspectFuncSoldItems   func(ctx context.Context, orderID int64)
+	afterSoldItemsCounter  uint64
+	beforeSoldItemsCounter uint64
+	SoldItemsMock          mWarehousesRepositoryMockSoldItems
+
+	funcStockDrifts          func(ctx context.Context) (sa1 []StockDrift, err error)
+	inspectFuncStockDrifts   func(ctx context.Context)
+	afterStockDriftsCounter  uint64
+	beforeStockDriftsCounter uint64
+	StockDriftsMock          mWarehousesRepositoryMockStockDrifts
+}
+
+// NewWarehousesRepositoryMock returns a mock for WarehousesRepository
+func NewWarehousesRepositoryMock(t minimock.Tester) *WarehousesRepositoryMock {
+	m := &WarehousesRepositoryMock{t: t}
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ChangeStockMock = mWarehousesRepositoryMockChangeStock{mock: m}
+	m.ChangeStockMock.callArgs = []*WarehousesRepositoryMockChangeStockParams{}
+
+	m.CreateSellerMock = mWarehousesRepositoryMockCreateSeller{mock: m}
+	m.CreateSellerMock.callArgs = []*WarehousesRepositoryMockCreateSellerParams{}
+
+	m.CreateWarehouseMock = mWarehousesRepositoryMockCreateWarehouse{mock: m}
+	m.CreateWarehouseMock.callArgs = []*WarehousesRepositoryMockCreateWarehouseParams{}
+
+	m.ListWarehouseStockMock = mWarehousesRepositoryMockListWarehouseStock{mock: m}
+	m.ListWarehouseStockMock.callArgs = []*WarehousesRepositoryMockListWarehouseStockParams{}
+
+	m.SoldItemsMock = mWarehousesRepositoryMockSoldItems{mock: m}
+	m.SoldItemsMock.callArgs = []*WarehousesRepositoryMockSoldItemsParams{}
+
+	m.StockDriftsMock = mWarehousesRepositoryMockStockDrifts{mock: m}
+	m.StockDriftsMock.callArgs = []*WarehousesRepositoryMockStockDriftsParams{}
+
+	return m
+}
+
+type mWarehousesRepositoryMockChangeStock struct {
+	mock               *WarehousesRepositoryMock
+	defaultExpectation *WarehousesRepositoryMockChangeStockExpectation
+	expectations       []*WarehousesRepositoryMockChangeStockExpectation
+
+	callArgs []*WarehousesRepositoryMockChangeStockParams
+	mutex    sync.RWMutex
+}
+
+// WarehousesRepositoryMockChangeStockExpectation specifies expectation struct of the WarehousesRepository.ChangeStock
+type WarehousesRepositoryMockChangeStockExpectation struct {
+	mock    *WarehousesRepositoryMock
+	params  *WarehousesRepositoryMockChangeStockParams
+	results *WarehousesRepositoryMockChangeStockResults
+	Counter uint64
+}
+
+// WarehousesRepositoryMockChangeStockParams contains parameters of the WarehousesRepository.ChangeStock
+type WarehousesRepositoryMockChangeStockParams struct {
+	ctx      context.Context
+	movement StockMovement
+}
+
+// WarehousesRepositoryMockChangeStockResults contains results of the WarehousesRepository.ChangeStock
+type WarehousesRepositoryMockChangeStockResults struct {
+	err error
+}
+
+// Expect sets up expected params for WarehousesRepository.ChangeStock
+func (mmChangeStock *mWarehousesRepositoryMockChangeStock) Expect(ctx context.Context, movement StockMovement) *mWarehousesRepositoryMockChangeStock {
+	if mmChangeStock.mock.funcChangeStock != nil {
+		mmChangeStock.mock.t.Fatalf("WarehousesRepositoryMock.ChangeStock mock is already set by Set")
+	}
+
+	if mmChangeStock.defaultExpectation == nil {
+		mmChangeStock.defaultExpectation = &WarehousesRepositoryMockChangeStockExpectation{}
+	}
+
+	mmChangeStock.defaultExpectation.params = &WarehousesRepositoryMockChangeStockParams{ctx, movement}
+	for _, e := range mmChangeStock.expectations {
+		if minimock.Equal(e.params, mmChangeStock.defaultExpectation.params) {
+			mmChangeStock.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmChangeStock.defaultExpectation.params)
+		}
+	}
+
+	return mmChangeStock
+}
+
+// Inspect accepts an inspector function that has same arguments as the WarehousesRepository.ChangeStock
+func (mmChangeStock *mWarehousesRepositoryMockChangeStock) Inspect(f func(ctx context.Context, movement StockMovement)) *mWarehousesRepositoryMockChangeStock {
+	if mmChangeStock.mock.inspectFuncChangeStock != nil {
+		mmChangeStock.mock.t.Fatalf("Inspect function is already set for WarehousesRepositoryMock.ChangeStock")
+	}
+
+	mmChangeStock.mock.inspectFuncChangeStock = f
+
+	return mmChangeStock
+}
+
+// Return sets up results that will be returned by WarehousesRepository.ChangeStock
+func (mmChangeStock *mWarehousesRepositoryMockChangeStock) Return(err error) *WarehousesRepositoryMock {
+	if mmChangeStock.mock.funcChangeStock != nil {
+		mmChangeStock.mock.t.Fatalf("WarehousesRepositoryMock.ChangeStock mock is already set by Set")
+	}
+
+	if mmChangeStock.defaultExpectation == nil {
+		mmChangeStock.defaultExpectation = &WarehousesRepositoryMockChangeStockExpectation{mock: mmChangeStock.mock}
+	}
+	mmChangeStock.defaultExpectation.results = &WarehousesRepositoryMockChangeStockResults{err}
+	return mmChangeStock.mock
+}
+
+// Set uses given function f to mock the WarehousesRepository.ChangeStock method
+func (mmChangeStock *mWarehousesRepositoryMockChangeStock) Set(f func(ctx context.Context, movement StockMovement) (err error)) *WarehousesRepositoryMock {
+	if mmChangeStock.defaultExpectation != nil {
+		mmChangeStock.mock.t.Fatalf("Default expectation is already set for the WarehousesRepository.ChangeStock method")
+	}
+
+	if len(mmChangeStock.expectations) > 0 {
+		mmChangeStock.mock.t.Fatalf("Some expectations are already set for the WarehousesRepository.ChangeStock method")
+	}
+
+	mmChangeStock.mock.funcChangeStock = f
+	return mmChangeStock.mock
+}
+
+// When sets expectation for the WarehousesRepository.ChangeStock which will trigger the result defined by the following
+// Then helper
+func (mmChangeStock *mWarehousesRepositoryMockChangeStock) When(ctx context.Context, movement StockMovement) *WarehousesRepositoryMockChangeStockExpectation {
+	if mmChangeStock.mock.funcChangeStock != nil {
+		mmChangeStock.mock.t.Fatalf("WarehousesRepositoryMock.ChangeStock mock is already set by Set")
+	}
+
+	expectation := &WarehousesRepositoryMockChangeStockExpectation{
+		mock:   mmChangeStock.mock,
+		params: &WarehousesRepositoryMockChangeStockParams{ctx, movement},
+	}
+	mmChangeStock.expectations = append(mmChangeStock.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WarehousesRepository.ChangeStock return parameters for the expectation previously defined by the When method
+func (e *WarehousesRepositoryMockChangeStockExpectation) Then(err error) *WarehousesRepositoryMock {
+	e.results = &WarehousesRepositoryMockChangeStockResults{err}
+	return e.mock
+}
+
+// ChangeStock implements WarehousesRepository
+func (mmChangeStock *WarehousesRepositoryMock) ChangeStock(ctx context.Context, movement StockMovement) (err error) {
+	mm_atomic.AddUint64(&mmChangeStock.beforeChangeStockCounter, 1)
+	defer mm_atomic.AddUint64(&mmChangeStock.afterChangeStockCounter, 1)
+
+	if mmChangeStock.inspectFuncChangeStock != nil {
+		mmChangeStock.inspectFuncChangeStock(ctx, movement)
+	}
+
+	mm_params := &WarehousesRepositoryMockChangeStockParams{ctx, movement}
+
+	// Record call args
+	mmChangeStock.ChangeStockMock.mutex.Lock()
+	mmChangeStock.ChangeStockMock.callArgs = append(mmChangeStock.ChangeStockMock.callArgs, mm_params)
+	mmChangeStock.ChangeStockMock.mutex.Unlock()
+
+	for _, e := range mmChangeStock.ChangeStockMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmChangeStock.ChangeStockMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmChangeStock.ChangeStockMock.defaultExpectation.Counter, 1)
+		mm_want := mmChangeStock.ChangeStockMock.defaultExpectation.params
+		mm_got := WarehousesRepositoryMockChangeStockParams{ctx, movement}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmChangeStock.t.Errorf("WarehousesRepositoryMock.ChangeStock got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmChangeStock.ChangeStockMock.defaultExpectation.results
+		if mm_results == nil {
+			mmChangeStock.t.Fatal("No results are set for the WarehousesRepositoryMock.ChangeStock")
+		}
+		return (*mm_results).err
+	}
+	if mmChangeStock.funcChangeStock != nil {
+		return mmChangeStock.funcChangeStock(ctx, movement)
+	}
+	mmChangeStock.t.Fatalf("Unexpected call to WarehousesRepositoryMock.ChangeStock. %v %v", ctx, movement)
+	return
+}
+
+// ChangeStockAfterCounter returns a count of finished WarehousesRepositoryMock.ChangeStock invocations
+func (mmChangeStock *WarehousesRepositoryMock) ChangeStockAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmChangeStock.afterChangeStockCounter)
+}
+
+// ChangeStockBeforeCounter returns a count of WarehousesRepositoryMock.ChangeStock invocations
+func (mmChangeStock *WarehousesRepositoryMock) ChangeStockBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmChangeStock.beforeChangeStockCounter)
+}
+
+// Calls returns a list of arguments used in each call to WarehousesRepositoryMock.ChangeStock.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmChangeStock *mWarehousesRepositoryMockChangeStock) Calls() []*WarehousesRepositoryMockChangeStockParams {
+	mmChangeStock.mutex.RLock()
+
+	argCopy := make([]*WarehousesRepositoryMockChangeStockParams, len(mmChangeStock.callArgs))
+	copy(argCopy, mmChangeStock.callArgs)
+
+	mmChangeStock.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockChangeStockDone returns true if the count of the ChangeStock invocations corresponds
+// the number of defined expectations
+func (m *WarehousesRepositoryMock) MinimockChangeStockDone() bool {
+	for _, e := range m.ChangeStockMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ChangeStockMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterChangeStockCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcChangeStock != nil && mm_atomic.LoadUint64(&m.afterChangeStockCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockChangeStockInspect logs each unmet expectation
+func (m *WarehousesRepositoryMock) MinimockChangeStockInspect() {
+	for _, e := range m.ChangeStockMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WarehousesRepositoryMock.ChangeStock with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ChangeStockMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterChangeStockCounter) < 1 {
+		if m.ChangeStockMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to WarehousesRepositoryMock.ChangeStock")
+		} else {
+			m.t.Errorf("Expected call to WarehousesRepositoryMock.ChangeStock with params: %#v", *m.ChangeStockMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcChangeStock != nil && mm_atomic.LoadUint64(&m.afterChangeStockCounter) < 1 {
+		m.t.Error("Expected call to WarehousesRepositoryMock.ChangeStock")
+	}
+}
+
+type mWarehousesRepositoryMockCreateSeller struct {
+	mock               *WarehousesRepositoryMock
+	defaultExpectation *WarehousesRepositoryMockCreateSellerExpectation
+	expectations       []*WarehousesRepositoryMockCreateSellerExpectation
+
+	callArgs []*WarehousesRepositoryMockCreateSellerParams
+	mutex    sync.RWMutex
+}
+
+// WarehousesRepositoryMockCreateSellerExpectation specifies expectation struct of the WarehousesRepository.CreateSeller
+type WarehousesRepositoryMockCreateSellerExpectation struct {
+	mock    *WarehousesRepositoryMock
+	params  *WarehousesRepositoryMockCreateSellerParams
+	results *WarehousesRepositoryMockCreateSellerResults
+	Counter uint64
+}
+
+// WarehousesRepositoryMockCreateSellerParams contains parameters of the WarehousesRepository.CreateSeller
+type WarehousesRepositoryMockCreateSellerParams struct {
+	ctx  context.Context
+	name string
+}
+
+// WarehousesRepositoryMockCreateSellerResults contains results of the WarehousesRepository.CreateSeller
+type WarehousesRepositoryMockCreateSellerResults struct {
+	i1  int64
+	err error
+}
+
+// Expect sets up expected params for WarehousesRepository.CreateSeller
+func (mmCreateSeller *mWarehousesRepositoryMockCreateSeller) Expect(ctx context.Context, name string) *mWarehousesRepositoryMockCreateSeller {
+	if mmCreateSeller.mock.funcCreateSeller != nil {
+		mmCreateSeller.mock.t.Fatalf("WarehousesRepositoryMock.CreateSeller mock is already set by Set")
+	}
+
+	if mmCreateSeller.defaultExpectation == nil {
+		mmCreateSeller.defaultExpectation = &WarehousesRepositoryMockCreateSellerExpectation{}
+	}
+
+	mmCreateSeller.defaultExpectation.params = &WarehousesRepositoryMockCreateSellerParams{ctx, name}
+	for _, e := range mmCreateSeller.expectations {
+		if minimock.Equal(e.params, mmCreateSeller.defaultExpectation.params) {
+			mmCreateSeller.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateSeller.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateSeller
+}
+
+// Inspect accepts an inspector function that has same arguments as the WarehousesRepository.CreateSeller
+func (mmCreateSeller *mWarehousesRepositoryMockCreateSeller) Inspect(f func(ctx context.Context, name string)) *mWarehousesRepositoryMockCreateSeller {
+	if mmCreateSeller.mock.inspectFuncCreateSeller != nil {
+		mmCreateSeller.mock.t.Fatalf("Inspect function is already set for WarehousesRepositoryMock.CreateSeller")
+	}
+
+	mmCreateSeller.mock.inspectFuncCreateSeller = f
+
+	return mmCreateSeller
+}
+
+// Return sets up results that will be returned by WarehousesRepository.CreateSeller
+func (mmCreateSeller *mWarehousesRepositoryMockCreateSeller) Return(i1 int64, err error) *WarehousesRepositoryMock {
+	if mmCreateSeller.mock.funcCreateSeller != nil {
+		mmCreateSeller.mock.t.Fatalf("WarehousesRepositoryMock.CreateSeller mock is already set by Set")
+	}
+
+	if mmCreateSeller.defaultExpectation == nil {
+		mmCreateSeller.defaultExpectation = &WarehousesRepositoryMockCreateSellerExpectation{mock: mmCreateSeller.mock}
+	}
+	mmCreateSeller.defaultExpectation.results = &WarehousesRepositoryMockCreateSellerResults{i1, err}
+	return mmCreateSeller.mock
+}
+
+// Set uses given function f to mock the WarehousesRepository.CreateSeller method
+func (mmCreateSeller *mWarehousesRepositoryMockCreateSeller) Set(f func(ctx context.Context, name string) (i1 int64, err error)) *WarehousesRepositoryMock {
+	if mmCreateSeller.defaultExpectation != nil {
+		mmCreateSeller.mock.t.Fatalf("Default expectation is already set for the WarehousesRepository.CreateSeller method")
+	}
+
+	if len(mmCreateSeller.expectations) > 0 {
+		mmCreateSeller.mock.t.Fatalf("Some expectations are already set for the WarehousesRepository.CreateSeller method")
+	}
+
+	mmCreateSeller.mock.funcCreateSeller = f
+	return mmCreateSeller.mock
+}
+
+// When sets expectation for the WarehousesRepository.CreateSeller which will trigger the result defined by the following
+// Then helper
+func (mmCreateSeller *mWarehousesRepositoryMockCreateSeller) When(ctx context.Context, name string) *WarehousesRepositoryMockCreateSellerExpectation {
+	if mmCreateSeller.mock.funcCreateSeller != nil {
+		mmCreateSeller.mock.t.Fatalf("WarehousesRepositoryMock.CreateSeller mock is already set by Set")
+	}
+
+	expectation := &WarehousesRepositoryMockCreateSellerExpectation{
+		mock:   mmCreateSeller.mock,
+		params: &WarehousesRepositoryMockCreateSellerParams{ctx, name},
+	}
+	mmCreateSeller.expectations = append(mmCreateSeller.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WarehousesRepository.CreateSeller return parameters for the expectation previously defined by the When method
+func (e *WarehousesRepositoryMockCreateSellerExpectation) Then(i1 int64, err error) *WarehousesRepositoryMock {
+	e.results = &WarehousesRepositoryMockCreateSellerResults{i1, err}
+	return e.mock
+}
+
+// CreateSeller implements WarehousesRepository
+func (mmCreateSeller *WarehousesRepositoryMock) CreateSeller(ctx context.Context, name string) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreateSeller.beforeCreateSellerCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateSeller.afterCreateSellerCounter, 1)
+
+	if mmCreateSeller.inspectFuncCreateSeller != nil {
+		mmCreateSeller.inspectFuncCreateSeller(ctx, name)
+	}
+
+	mm_params := &WarehousesRepositoryMockCreateSellerParams{ctx, name}
+
+	// Record call args
+	mmCreateSeller.CreateSellerMock.mutex.Lock()
+	mmCreateSeller.CreateSellerMock.callArgs = append(mmCreateSeller.CreateSellerMock.callArgs, mm_params)
+	mmCreateSeller.CreateSellerMock.mutex.Unlock()
+
+	for _, e := range mmCreateSeller.CreateSellerMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreateSeller.CreateSellerMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateSeller.CreateSellerMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateSeller.CreateSellerMock.defaultExpectation.params
+		mm_got := WarehousesRepositoryMockCreateSellerParams{ctx, name}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateSeller.t.Errorf("WarehousesRepositoryMock.CreateSeller got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateSeller.CreateSellerMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateSeller.t.Fatal("No results are set for the WarehousesRepositoryMock.CreateSeller")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreateSeller.funcCreateSeller != nil {
+		return mmCreateSeller.funcCreateSeller(ctx, name)
+	}
+	mmCreateSeller.t.Fatalf("Unexpected call to WarehousesRepositoryMock.CreateSeller. %v %v", ctx, name)
+	return
+}
+
+// CreateSellerAfterCounter returns a count of finished WarehousesRepositoryMock.CreateSeller invocations
+func (mmCreateSeller *WarehousesRepositoryMock) CreateSellerAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateSeller.afterCreateSellerCounter)
+}
+
+// CreateSellerBeforeCounter returns a count of WarehousesRepositoryMock.CreateSeller invocations
+func (mmCreateSeller *WarehousesRepositoryMock) CreateSellerBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateSeller.beforeCreateSellerCounter)
+}
+
+// Calls returns a list of arguments used in each call to WarehousesRepositoryMock.CreateSeller.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateSeller *mWarehousesRepositoryMockCreateSeller) Calls() []*WarehousesRepositoryMockCreateSellerParams {
+	mmCreateSeller.mutex.RLock()
+
+	argCopy := make([]*WarehousesRepositoryMockCreateSellerParams, len(mmCreateSeller.callArgs))
+	copy(argCopy, mmCreateSeller.callArgs)
+
+	mmCreateSeller.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateSellerDone returns true if the count of the CreateSeller invocations corresponds
+// the number of defined expectations
+func (m *WarehousesRepositoryMock) MinimockCreateSellerDone() bool {
+	for _, e := range m.CreateSellerMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateSellerMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateSellerCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateSeller != nil && mm_atomic.LoadUint64(&m.afterCreateSellerCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockCreateSellerInspect logs each unmet expectation
+func (m *WarehousesRepositoryMock) MinimockCreateSellerInspect() {
+	for _, e := range m.CreateSellerMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WarehousesRepositoryMock.CreateSeller with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateSellerMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateSellerCounter) < 1 {
+		if m.CreateSellerMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to WarehousesRepositoryMock.CreateSeller")
+		} else {
+			m.t.Errorf("Expected call to WarehousesRepositoryMock.CreateSeller with params: %#v", *m.CreateSellerMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateSeller != nil && mm_atomic.LoadUint64(&m.afterCreateSellerCounter) < 1 {
+		m.t.Error("Expected call to WarehousesRepositoryMock.CreateSeller")
+	}
+}
+
+type mWarehousesRepositoryMockCreateWarehouse struct {
+	mock               *WarehousesRepositoryMock
+	defaultExpectation *WarehousesRepositoryMockCreateWarehouseExpectation
+	expectations       []*WarehousesRepositoryMockCreateWarehouseExpectation
+
+	callArgs []*WarehousesRepositoryMockCreateWarehouseParams
+	mutex    sync.RWMutex
+}
+
+// WarehousesRepositoryMockCreateWarehouseExpectation specifies expectation struct of the WarehousesRepository.CreateWarehouse
+type WarehousesRepositoryMockCreateWarehouseExpectation struct {
+	mock    *WarehousesRepositoryMock
+	params  *WarehousesRepositoryMockCreateWarehouseParams
+	results *WarehousesRepositoryMockCreateWarehouseResults
+	Counter uint64
+}
+
+// WarehousesRepositoryMockCreateWarehouseParams contains parameters of the WarehousesRepository.CreateWarehouse
+type WarehousesRepositoryMockCreateWarehouseParams struct {
+	ctx      context.Context
+	name     string
+	sellerID int64
+}
+
+// WarehousesRepositoryMockCreateWarehouseResults contains results of the WarehousesRepository.CreateWarehouse
+type WarehousesRepositoryMockCreateWarehouseResults struct {
+	i1  int64
+	err error
+}
+
+// Expect sets up expected params for WarehousesRepository.CreateWarehouse
+func (mmCreateWarehouse *mWarehousesRepositoryMockCreateWarehouse) Expect(ctx context.Context, name string, sellerID int64) *mWarehousesRepositoryMockCreateWarehouse {
+	if mmCreateWarehouse.mock.funcCreateWarehouse != nil {
+		mmCreateWarehouse.mock.t.Fatalf("WarehousesRepositoryMock.CreateWarehouse mock is already set by Set")
+	}
+
+	if mmCreateWarehouse.defaultExpectation == nil {
+		mmCreateWarehouse.defaultExpectation = &WarehousesRepositoryMockCreateWarehouseExpectation{}
+	}
+
+	mmCreateWarehouse.defaultExpectation.params = &WarehousesRepositoryMockCreateWarehouseParams{ctx, name, sellerID}
+	for _, e := range mmCreateWarehouse.expectations {
+		if minimock.Equal(e.params, mmCreateWarehouse.defaultExpectation.params) {
+			mmCreateWarehouse.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateWarehouse.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateWarehouse
+}
+
+// Inspect accepts an inspector function that has same arguments as the WarehousesRepository.CreateWarehouse
+func (mmCreateWarehouse *mWarehousesRepositoryMockCreateWarehouse) Inspect(f func(ctx context.Context, name string, sellerID int64)) *mWarehousesRepositoryMockCreateWarehouse {
+	if mmCreateWarehouse.mock.inspectFuncCreateWarehouse != nil {
+		mmCreateWarehouse.mock.t.Fatalf("Inspect function is already set for WarehousesRepositoryMock.CreateWarehouse")
+	}
+
+	mmCreateWarehouse.mock.inspectFuncCreateWarehouse = f
+
+	return mmCreateWarehouse
+}
+
+// Return sets up results that will be returned by WarehousesRepository.CreateWarehouse
+func (mmCreateWarehouse *mWarehousesRepositoryMockCreateWarehouse) Return(i1 int64, err error) *WarehousesRepositoryMock {
+	if mmCreateWarehouse.mock.funcCreateWarehouse != nil {
+		mmCreateWarehouse.mock.t.Fatalf("WarehousesRepositoryMock.CreateWarehouse mock is already set by Set")
+	}
+
+	if mmCreateWarehouse.defaultExpectation == nil {
+		mmCreateWarehouse.defaultExpectation = &WarehousesRepositoryMockCreateWarehouseExpectation{mock: mmCreateWarehouse.mock}
+	}
+	mmCreateWarehouse.defaultExpectation.results = &WarehousesRepositoryMockCreateWarehouseResults{i1, err}
+	return mmCreateWarehouse.mock
+}
+
+// Set uses given function f to mock the WarehousesRepository.CreateWarehouse method
+func (mmCreateWarehouse *mWarehousesRepositoryMockCreateWarehouse) Set(f func(ctx context.Context, name string, sellerID int64) (i1 int64, err error)) *WarehousesRepositoryMock {
+	if mmCreateWarehouse.defaultExpectation != nil {
+		mmCreateWarehouse.mock.t.Fatalf("Default expectation is already set for the WarehousesRepository.CreateWarehouse method")
+	}
+
+	if len(mmCreateWarehouse.expectations) > 0 {
+		mmCreateWarehouse.mock.t.Fatalf("Some expectations are already set for the WarehousesRepository.CreateWarehouse method")
+	}
+
+	mmCreateWarehouse.mock.funcCreateWarehouse = f
+	return mmCreateWarehouse.mock
+}
+
+// When sets expectation for the WarehousesRepository.CreateWarehouse which will trigger the result defined by the following
+// Then helper
+func (mmCreateWarehouse *mWarehousesRepositoryMockCreateWarehouse) When(ctx context.Context, name string, sellerID int64) *WarehousesRepositoryMockCreateWarehouseExpectation {
+	if mmCreateWarehouse.mock.funcCreateWarehouse != nil {
+		mmCreateWarehouse.mock.t.Fatalf("WarehousesRepositoryMock.CreateWarehouse mock is already set by Set")
+	}
+
+	expectation := &WarehousesRepositoryMockCreateWarehouseExpectation{
+		mock:   mmCreateWarehouse.mock,
+		params: &WarehousesRepositoryMockCreateWarehouseParams{ctx, name, sellerID},
+	}
+	mmCreateWarehouse.expectations = append(mmCreateWarehouse.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WarehousesRepository.CreateWarehouse return parameters for the expectation previously defined by the When method
+func (e *WarehousesRepositoryMockCreateWarehouseExpectation) Then(i1 int64, err error) *WarehousesRepositoryMock {
+	e.results = &WarehousesRepositoryMockCreateWarehouseResults{i1, err}
+	return e.mock
+}
+
+// CreateWarehouse implements WarehousesRepository
+func (mmCreateWarehouse *WarehousesRepositoryMock) CreateWarehouse(ctx context.Context, name string, sellerID int64) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreateWarehouse.beforeCreateWarehouseCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateWarehouse.afterCreateWarehouseCounter, 1)
+
+	if mmCreateWarehouse.inspectFuncCreateWarehouse != nil {
+		mmCreateWarehouse.inspectFuncCreateWarehouse(ctx, name, sellerID)
+	}
+
+	mm_params := &WarehousesRepositoryMockCreateWarehouseParams{ctx, name, sellerID}
+
+	// Record call args
+	mmCreateWarehouse.CreateWarehouseMock.mutex.Lock()
+	mmCreateWarehouse.CreateWarehouseMock.callArgs = append(mmCreateWarehouse.CreateWarehouseMock.callArgs, mm_params)
+	mmCreateWarehouse.CreateWarehouseMock.mutex.Unlock()
+
+	for _, e := range mmCreateWarehouse.CreateWarehouseMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreateWarehouse.CreateWarehouseMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateWarehouse.CreateWarehouseMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateWarehouse.CreateWarehouseMock.defaultExpectation.params
+		mm_got := WarehousesRepositoryMockCreateWarehouseParams{ctx, name, sellerID}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateWarehouse.t.Errorf("WarehousesRepositoryMock.CreateWarehouse got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateWarehouse.CreateWarehouseMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateWarehouse.t.Fatal("No results are set for the WarehousesRepositoryMock.CreateWarehouse")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreateWarehouse.funcCreateWarehouse != nil {
+		return mmCreateWarehouse.funcCreateWarehouse(ctx, name, sellerID)
+	}
+	mmCreateWarehouse.t.Fatalf("Unexpected call to WarehousesRepositoryMock.CreateWarehouse. %v %v %v", ctx, name, sellerID)
+	return
+}
+
+// CreateWarehouseAfterCounter returns a count of finished WarehousesRepositoryMock.CreateWarehouse invocations
+func (mmCreateWarehouse *WarehousesRepositoryMock) CreateWarehouseAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateWarehouse.afterCreateWarehouseCounter)
+}
+
+// CreateWarehouseBeforeCounter returns a count of WarehousesRepositoryMock.CreateWarehouse invocations
+func (mmCreateWarehouse *WarehousesRepositoryMock) CreateWarehouseBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateWarehouse.beforeCreateWarehouseCounter)
+}
+
+// Calls returns a list of arguments used in each call to WarehousesRepositoryMock.CreateWarehouse.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateWarehouse *mWarehousesRepositoryMockCreateWarehouse) Calls() []*WarehousesRepositoryMockCreateWarehouseParams {
+	mmCreateWarehouse.mutex.RLock()
+
+	argCopy := make([]*WarehousesRepositoryMockCreateWarehouseParams, len(mmCreateWarehouse.callArgs))
+	copy(argCopy, mmCreateWarehouse.callArgs)
+
+	mmCreateWarehouse.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateWarehouseDone returns true if the count of the CreateWarehouse invocations corresponds
+// the number of defined expectations
+func (m *WarehousesRepositoryMock) MinimockCreateWarehouseDone() bool {
+	for _, e := range m.CreateWarehouseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateWarehouseMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateWarehouseCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateWarehouse != nil && mm_atomic.LoadUint64(&m.afterCreateWarehouseCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockCreateWarehouseInspect logs each unmet expectation
+func (m *WarehousesRepositoryMock) MinimockCreateWarehouseInspect() {
+	for _, e := range m.CreateWarehouseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WarehousesRepositoryMock.CreateWarehouse with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateWarehouseMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCreateWarehouseCounter) < 1 {
+		if m.CreateWarehouseMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to WarehousesRepositoryMock.CreateWarehouse")
+		} else {
+			m.t.Errorf("Expected call to WarehousesRepositoryMock.CreateWarehouse with params: %#v", *m.CreateWarehouseMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateWarehouse != nil && mm_atomic.LoadUint64(&m.afterCreateWarehouseCounter) < 1 {
+		m.t.Error("Expected call to WarehousesRepositoryMock.CreateWarehouse")
+	}
+}
+
+type mWarehousesRepositoryMockListWarehouseStock struct {
+	mock               *WarehousesRepositoryMock
+	defaultExpectation *WarehousesRepositoryMockListWarehouseStockExpectation
+	expectations       []*WarehousesRepositoryMockListWarehouseStockExpectation
+
+	callArgs []*WarehousesRepositoryMockListWarehouseStockParams
+	mutex    sync.RWMutex
+}
+
+// WarehousesRepositoryMockListWarehouseStockExpectation specifies expectation struct of the WarehousesRepository.ListWarehouseStock
+type WarehousesRepositoryMockListWarehouseStockExpectation struct {
+	mock    *WarehousesRepositoryMock
+	params  *WarehousesRepositoryMockListWarehouseStockParams
+	results *WarehousesRepositoryMockListWarehouseStockResults
+	Counter uint64
+}
+
+// WarehousesRepositoryMockListWarehouseStockParams contains parameters of the WarehousesRepository.ListWarehouseStock
+type WarehousesRepositoryMockListWarehouseStockParams struct {
+	ctx         context.Context
+	warehouseID int64
+}
+
+// WarehousesRepositoryMockListWarehouseStockResults contains results of the WarehousesRepository.ListWarehouseStock
+type WarehousesRepositoryMockListWarehouseStockResults struct {
+	wa1 []WarehouseStock
+	err error
+}
+
+// Expect sets up expected params for WarehousesRepository.ListWarehouseStock
+func (mmListWarehouseStock *mWarehousesRepositoryMockListWarehouseStock) Expect(ctx context.Context, warehouseID int64) *mWarehousesRepositoryMockListWarehouseStock {
+	if mmListWarehouseStock.mock.funcListWarehouseStock != nil {
+		mmListWarehouseStock.mock.t.Fatalf("WarehousesRepositoryMock.ListWarehouseStock mock is already set by Set")
+	}
+
+	if mmListWarehouseStock.defaultExpectation == nil {
+		mmListWarehouseStock.defaultExpectation = &WarehousesRepositoryMockListWarehouseStockExpectation{}
+	}
+
+	mmListWarehouseStock.defaultExpectation.params = &WarehousesRepositoryMockListWarehouseStockParams{ctx, warehouseID}
+	for _, e := range mmListWarehouseStock.expectations {
+		if minimock.Equal(e.params, mmListWarehouseStock.defaultExpectation.params) {
+			mmListWarehouseStock.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListWarehouseStock.defaultExpectation.params)
+		}
+	}
+
+	return mmListWarehouseStock
+}
+
+// Inspect accepts an inspector function that has same arguments as the WarehousesRepository.ListWarehouseStock
+func (mmListWarehouseStock *mWarehousesRepositoryMockListWarehouseStock) Inspect(f func(ctx context.Context, warehouseID int64)) *mWarehousesRepositoryMockListWarehouseStock {
+	if mmListWarehouseStock.mock.inspectFuncListWarehouseStock != nil {
+		mmListWarehouseStock.mock.t.Fatalf("Inspect function is already set for WarehousesRepositoryMock.ListWarehouseStock")
+	}
+
+	mmListWarehouseStock.mock.inspectFuncListWarehouseStock = f
+
+	return mmListWarehouseStock
+}
+
+// Return sets up results that will be returned by WarehousesRepository.ListWarehouseStock
+func (mmListWarehouseStock *mWarehousesRepositoryMockListWarehouseStock) Return(wa1 []WarehouseStock, err error) *WarehousesRepositoryMock {
+	if mmListWarehouseStock.mock.funcListWarehouseStock != nil {
+		mmListWarehouseStock.mock.t.Fatalf("WarehousesRepositoryMock.ListWarehouseStock mock is already set by Set")
+	}
+
+	if mmListWarehouseStock.defaultExpectation == nil {
+		mmListWarehouseStock.defaultExpectation = &WarehousesRepositoryMockListWarehouseStockExpectation{mock: mmListWarehouseStock.mock}
+	}
+	mmListWarehouseStock.defaultExpectation.results = &WarehousesRepositoryMockListWarehouseStockResults{wa1, err}
+	return mmListWarehouseStock.mock
+}
+
+// Set uses given function f to mock the WarehousesRepository.ListWarehouseStock method
+func (mmListWarehouseStock *mWarehousesRepositoryMockListWarehouseStock) Set(f func(ctx context.Context, warehouseID int64) (wa1 []WarehouseStock, err error)) *WarehousesRepositoryMock {
+	if mmListWarehouseStock.defaultExpectation != nil {
+		mmListWarehouseStock.mock.t.Fatalf("Default expectation is already set for the WarehousesRepository.ListWarehouseStock method")
+	}
+
+	if len(mmListWarehouseStock.expectations) > 0 {
+		mmListWarehouseStock.mock.t.Fatalf("Some expectations are already set for the WarehousesRepository.ListWarehouseStock method")
+	}
+
+	mmListWarehouseStock.mock.funcListWarehouseStock = f
+	return mmListWarehouseStock.mock
+}
+
+// When sets expectation for the WarehousesRepository.ListWarehouseStock which will trigger the result defined by the following
+// Then helper
+func (mmListWarehouseStock *mWarehousesRepositoryMockListWarehouseStock) When(ctx context.Context, warehouseID int64) *WarehousesRepositoryMockListWarehouseStockExpectation {
+	if mmListWarehouseStock.mock.funcListWarehouseStock != nil {
+		mmListWarehouseStock.mock.t.Fatalf("WarehousesRepositoryMock.ListWarehouseStock mock is already set by Set")
+	}
+
+	expectation := &WarehousesRepositoryMockListWarehouseStockExpectation{
+		mock:   mmListWarehouseStock.mock,
+		params: &WarehousesRepositoryMockListWarehouseStockParams{ctx, warehouseID},
+	}
+	mmListWarehouseStock.expectations = append(mmListWarehouseStock.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WarehousesRepository.ListWarehouseStock return parameters for the expectation previously defined by the When method
+func (e *WarehousesRepositoryMockListWarehouseStockExpectation) Then(wa1 []WarehouseStock, err error) *WarehousesRepositoryMock {
+	e.results = &WarehousesRepositoryMockListWarehouseStockResults{wa1, err}
+	return e.mock
+}
+
+// ListWarehouseStock implements WarehousesRepository
+func (mmListWarehouseStock *WarehousesRepositoryMock) ListWarehouseStock(ctx context.Context, warehouseID int64) (wa1 []WarehouseStock, err error) {
+	mm_atomic.AddUint64(&mmListWarehouseStock.beforeListWarehouseStockCounter, 1)
+	defer mm_atomic.AddUint64(&mmListWarehouseStock.afterListWarehouseStockCounter, 1)
+
+	if mmListWarehouseStock.inspectFuncListWarehouseStock != nil {
+		mmListWarehouseStock.inspectFuncListWarehouseStock(ctx, warehouseID)
+	}
+
+	mm_params := &WarehousesRepositoryMockListWarehouseStockParams{ctx, warehouseID}
+
+	// Record call args
+	mmListWarehouseStock.ListWarehouseStockMock.mutex.Lock()
+	mmListWarehouseStock.ListWarehouseStockMock.callArgs = append(mmListWarehouseStock.ListWarehouseStockMock.callArgs, mm_params)
+	mmListWarehouseStock.ListWarehouseStockMock.mutex.Unlock()
+
+	for _, e := range mmListWarehouseStock.ListWarehouseStockMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.wa1, e.results.err
+		}
+	}
+
+	if mmListWarehouseStock.ListWarehouseStockMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListWarehouseStock.ListWarehouseStockMock.defaultExpectation.Counter, 1)
+		mm_want := mmListWarehouseStock.ListWarehouseStockMock.defaultExpectation.params
+		mm_got := WarehousesRepositoryMockListWarehouseStockParams{ctx, warehouseID}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListWarehouseStock.t.Errorf("WarehousesRepositoryMock.ListWarehouseStock got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListWarehouseStock.ListWarehouseStockMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListWarehouseStock.t.Fatal("No results are set for the WarehousesRepositoryMock.ListWarehouseStock")
+		}
+		return (*mm_results).wa1, (*mm_results).err
+	}
+	if mmListWarehouseStock.funcListWarehouseStock != nil {
+		return mmListWarehouseStock.funcListWarehouseStock(ctx, warehouseID)
+	}
+	mmListWarehouseStock.t.Fatalf("Unexpected call to WarehousesRepositoryMock.ListWarehouseStock. %v %v", ctx, warehouseID)
+	return
+}
+
+// ListWarehouseStockAfterCounter returns a count of finished WarehousesRepositoryMock.ListWarehouseStock invocations
+func (mmListWarehouseStock *WarehousesRepositoryMock) ListWarehouseStockAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListWarehouseStock.afterListWarehouseStockCounter)
+}
+
+// ListWarehouseStockBeforeCounter returns a count of WarehousesRepositoryMock.ListWarehouseStock invocations
+func (mmListWarehouseStock *WarehousesRepositoryMock) ListWarehouseStockBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListWarehouseStock.beforeListWarehouseStockCounter)
+}
+
+// Calls returns a list of arguments used in each call to WarehousesRepositoryMock.ListWarehouseStock.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListWarehouseStock *mWarehousesRepositoryMockListWarehouseStock) Calls() []*WarehousesRepositoryMockListWarehouseStockParams {
+	mmListWarehouseStock.mutex.RLock()
+
+	argCopy := make([]*WarehousesRepositoryMockListWarehouseStockParams, len(mmListWarehouseStock.callArgs))
+	copy(argCopy, mmListWarehouseStock.callArgs)
+
+	mmListWarehouseStock.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListWarehouseStockDone returns true if the count of the ListWarehouseStock invocations corresponds
+// the number of defined expectations
+func (m *WarehousesRepositoryMock) MinimockListWarehouseStockDone() bool {
+	for _, e := range m.ListWarehouseStockMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListWarehouseStockMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterListWarehouseStockCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListWarehouseStock != nil && mm_atomic.LoadUint64(&m.afterListWarehouseStockCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockListWarehouseStockInspect logs each unmet expectation
+func (m *WarehousesRepositoryMock) MinimockListWarehouseStockInspect() {
+	for _, e := range m.ListWarehouseStockMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WarehousesRepositoryMock.ListWarehouseStock with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListWarehouseStockMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterListWarehouseStockCounter) < 1 {
+		if m.ListWarehouseStockMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to WarehousesRepositoryMock.ListWarehouseStock")
+		} else {
+			m.t.Errorf("Expected call to WarehousesRepositoryMock.ListWarehouseStock with params: %#v", *m.ListWarehouseStockMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListWarehouseStock != nil && mm_atomic.LoadUint64(&m.afterListWarehouseStockCounter) < 1 {
+		m.t.Error("Expected call to WarehousesRepositoryMock.ListWarehouseStock")
+	}
+}
+
+type mWarehousesRepositoryMockSoldItems struct {
+	mock               *WarehousesRepositoryMock
+	defaultExpectation *WarehousesRepositoryMockSoldItemsExpectation
+	expectations       []*WarehousesRepositoryMockSoldItemsExpectation
+
+	callArgs []*WarehousesRepositoryMockSoldItemsParams
+	mutex    sync.RWMutex
+}
+
+// WarehousesRepositoryMockSoldItemsExpectation specifies expectation struct of the WarehousesRepository.SoldItems
+type WarehousesRepositoryMockSoldItemsExpectation struct {
+	mock    *WarehousesRepositoryMock
+	params  *WarehousesRepositoryMockSoldItemsParams
+	results *WarehousesRepositoryMockSoldItemsResults
+	Counter uint64
+}
+
+// WarehousesRepositoryMockSoldItemsParams contains parameters of the WarehousesRepository.SoldItems
+type WarehousesRepositoryMockSoldItemsParams struct {
+	ctx     context.Context
+	orderID int64
+}
+
+// WarehousesRepositoryMockSoldItemsResults contains results of the WarehousesRepository.SoldItems
+type WarehousesRepositoryMockSoldItemsResults struct {
+	ra1 []ReservedItem
+	err error
+}
+
+// Expect sets up expected params for WarehousesRepository.SoldItems
+func (mmSoldItems *mWarehousesRepositoryMockSoldItems) Expect(ctx context.Context, orderID int64) *mWarehousesRepositoryMockSoldItems {
+	if mmSoldItems.mock.funcSoldItems != nil {
+		mmSoldItems.mock.t.Fatalf("WarehousesRepositoryMock.SoldItems mock is already set by Set")
+	}
+
+	if mmSoldItems.defaultExpectation == nil {
+		mmSoldItems.defaultExpectation = &WarehousesRepositoryMockSoldItemsExpectation{}
+	}
+
+	mmSoldItems.defaultExpectation.params = &WarehousesRepositoryMockSoldItemsParams{ctx, orderID}
+	for _, e := range mmSoldItems.expectations {
+		if minimock.Equal(e.params, mmSoldItems.defaultExpectation.params) {
+			mmSoldItems.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSoldItems.defaultExpectation.params)
+		}
+	}
+
+	return mmSoldItems
+}
+
+// Inspect accepts an inspector function that has same arguments as the WarehousesRepository.SoldItems
+func (mmSoldItems *mWarehousesRepositoryMockSoldItems) Inspect(f func(ctx context.Context, orderID int64)) *mWarehousesRepositoryMockSoldItems {
+	if mmSoldItems.mock.inspectFuncSoldItems != nil {
+		mmSoldItems.mock.t.Fatalf("Inspect function is already set for WarehousesRepositoryMock.SoldItems")
+	}
+
+	mmSoldItems.mock.inspectFuncSoldItems = f
+
+	return mmSoldItems
+}
+
+// Return sets up results that will be returned by WarehousesRepository.SoldItems
+func (mmSoldItems *mWarehousesRepositoryMockSoldItems) Return(ra1 []ReservedItem, err error) *WarehousesRepositoryMock {
+	if mmSoldItems.mock.funcSoldItems != nil {
+		mmSoldItems.mock.t.Fatalf("WarehousesRepositoryMock.SoldItems mock is already set by Set")
+	}
+
+	if mmSoldItems.defaultExpectation == nil {
+		mmSoldItems.defaultExpectation = &WarehousesRepositoryMockSoldItemsExpectation{mock: mmSoldItems.mock}
+	}
+	mmSoldItems.defaultExpectation.results = &WarehousesRepositoryMockSoldItemsResults{ra1, err}
+	return mmSoldItems.mock
+}
+
+// Set uses given function f to mock the WarehousesRepository.SoldItems method
+func (mmSoldItems *mWarehousesRepositoryMockSoldItems) Set(f func(ctx context.Context, orderID int64) (ra1 []ReservedItem, err error)) *WarehousesRepositoryMock {
+	if mmSoldItems.defaultExpectation != nil {
+		mmSoldItems.mock.t.Fatalf("Default expectation is already set for the WarehousesRepository.SoldItems method")
+	}
+
+	if len(mmSoldItems.expectations) > 0 {
+		mmSoldItems.mock.t.Fatalf("Some expectations are already set for the WarehousesRepository.SoldItems method")
+	}
+
+	mmSoldItems.mock.funcSoldItems = f
+	return mmSoldItems.mock
+}
+
+// When sets expectation for the WarehousesRepository.SoldItems which will trigger the result defined by the following
+// Then helper
+func (mmSoldItems *mWarehousesRepositoryMockSoldItems) When(ctx context.Context, orderID int64) *WarehousesRepositoryMockSoldItemsExpectation {
+	if mmSoldItems.mock.funcSoldItems != nil {
+		mmSoldItems.mock.t.Fatalf("WarehousesRepositoryMock.SoldItems mock is already set by Set")
+	}
+
+	expectation := &WarehousesRepositoryMockSoldItemsExpectation{
+		mock:   mmSoldItems.mock,
+		params: &WarehousesRepositoryMockSoldItemsParams{ctx, orderID},
+	}
+	mmSoldItems.expectations = append(mmSoldItems.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WarehousesRepository.SoldItems return parameters for the expectation previously defined by the When method
+func (e *WarehousesRepositoryMockSoldItemsExpectation) Then(ra1 []ReservedItem, err error) *WarehousesRepositoryMock {
+	e.results = &WarehousesRepositoryMockSoldItemsResults{ra1, err}
+	return e.mock
+}
+
+// SoldItems implements WarehousesRepository
+func (mmSoldItems *WarehousesRepositoryMock) SoldItems(ctx context.Context, orderID int64) (ra1 []ReservedItem, err error) {
+	mm_atomic.AddUint64(&mmSoldItems.beforeSoldItemsCounter, 1)
+	defer mm_atomic.AddUint64(&mmSoldItems.afterSoldItemsCounter, 1)
+
+	if mmSoldItems.inspectFuncSoldItems != nil {
+		mmSoldItems.inspectFuncSoldItems(ctx, orderID)
+	}
+
+	mm_params := &WarehousesRepositoryMockSoldItemsParams{ctx, orderID}
+
+	// Record call args
+	mmSoldItems.SoldItemsMock.mutex.Lock()
+	mmSoldItems.SoldItemsMock.callArgs = append(mmSoldItems.SoldItemsMock.callArgs, mm_params)
+	mmSoldItems.SoldItemsMock.mutex.Unlock()
+
+	for _, e := range mmSoldItems.SoldItemsMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ra1, e.results.err
+		}
+	}
+
+	if mmSoldItems.SoldItemsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSoldItems.SoldItemsMock.defaultExpectation.Counter, 1)
+		mm_want := mmSoldItems.SoldItemsMock.defaultExpectation.params
+		mm_got := WarehousesRepositoryMockSoldItemsParams{ctx, orderID}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSoldItems.t.Errorf("WarehousesRepositoryMock.SoldItems got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSoldItems.SoldItemsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSoldItems.t.Fatal("No results are set for the WarehousesRepositoryMock.SoldItems")
+		}
+		return (*mm_results).ra1, (*mm_results).err
+	}
+	if mmSoldItems.funcSoldItems != nil {
+		return mmSoldItems.funcSoldItems(ctx, orderID)
+	}
+	mmSoldItems.t.Fatalf("Unexpected call to WarehousesRepositoryMock.SoldItems. %v %v", ctx, orderID)
+	return
+}
+
+// SoldItemsAfterCounter returns a count of finished WarehousesRepositoryMock.SoldItems invocations
+func (mmSoldItems *WarehousesRepositoryMock) SoldItemsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSoldItems.afterSoldItemsCounter)
+}
+
+// SoldItemsBeforeCounter returns a count of WarehousesRepositoryMock.SoldItems invocations
+func (mmSoldItems *WarehousesRepositoryMock) SoldItemsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSoldItems.beforeSoldItemsCounter)
+}
+
+// Calls returns a list of arguments used in each call to WarehousesRepositoryMock.SoldItems.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSoldItems *mWarehousesRepositoryMockSoldItems) Calls() []*WarehousesRepositoryMockSoldItemsParams {
+	mmSoldItems.mutex.RLock()
+
+	argCopy := make([]*WarehousesRepositoryMockSoldItemsParams, len(mmSoldItems.callArgs))
+	copy(argCopy, mmSoldItems.callArgs)
+
+	mmSoldItems.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSoldItemsDone returns true if the count of the SoldItems invocations corresponds
+// the number of defined expectations
+func (m *WarehousesRepositoryMock) MinimockSoldItemsDone() bool {
+	for _, e := range m.SoldItemsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SoldItemsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSoldItemsCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSoldItems != nil && mm_atomic.LoadUint64(&m.afterSoldItemsCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockSoldItemsInspect logs each unmet expectation
+func (m *WarehousesRepositoryMock) MinimockSoldItemsInspect() {
+	for _, e := range m.SoldItemsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WarehousesRepositoryMock.SoldItems with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SoldItemsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSoldItemsCounter) < 1 {
+		if m.SoldItemsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to WarehousesRepositoryMock.SoldItems")
+		} else {
+			m.t.Errorf("Expected call to WarehousesRepositoryMock.SoldItems with params: %#v", *m.SoldItemsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSoldItems != nil && mm_atomic.LoadUint64(&m.afterSoldItemsCounter) < 1 {
+		m.t.Error("Expected call to WarehousesRepositoryMock.SoldItems")
+	}
+}
+
+type mWarehousesRepositoryMockStockDrifts struct {
+	mock               *WarehousesRepositoryMock
+	defaultExpectation *WarehousesRepositoryMockStockDriftsExpectation
+	expectations       []*WarehousesRepositoryMockStockDriftsExpectation
+
+	callArgs []*WarehousesRepositoryMockStockDriftsParams
+	mutex    sync.RWMutex
+}
+
+// WarehousesRepositoryMockStockDriftsExpectation specifies expectation struct of the WarehousesRepository.StockDrifts
+type WarehousesRepositoryMockStockDriftsExpectation struct {
+	mock    *WarehousesRepositoryMock
+	params  *WarehousesRepositoryMockStockDriftsParams
+	results *WarehousesRepositoryMockStockDriftsResults
+	Counter uint64
+}
+
+// WarehousesRepositoryMockStockDriftsParams contains parameters of the WarehousesRepository.StockDrifts
+type WarehousesRepositoryMockStockDriftsParams struct {
+	ctx context.Context
+}
+
+// WarehousesRepositoryMockStockDriftsResults contains results of the WarehousesRepository.StockDrifts
+type WarehousesRepositoryMockStockDriftsResults struct {
+	sa1 []StockDrift
+	err error
+}
+
+// Expect sets up expected params for WarehousesRepository.StockDrifts
+func (mmStockDrifts *mWarehousesRepositoryMockStockDrifts) Expect(ctx context.Context) *mWarehousesRepositoryMockStockDrifts {
+	if mmStockDrifts.mock.funcStockDrifts != nil {
+		mmStockDrifts.mock.t.Fatalf("WarehousesRepositoryMock.StockDrifts mock is already set by Set")
+	}
+
+	if mmStockDrifts.defaultExpectation == nil {
+		mmStockDrifts.defaultExpectation = &WarehousesRepositoryMockStockDriftsExpectation{}
+	}
+
+	mmStockDrifts.defaultExpectation.params = &WarehousesRepositoryMockStockDriftsParams{ctx}
+	for _, e := range mmStockDrifts.expectations {
+		if minimock.Equal(e.params, mmStockDrifts.defaultExpectation.params) {
+			mmStockDrifts.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmStockDrifts.defaultExpectation.params)
+		}
+	}
+
+	return mmStockDrifts
+}
+
+// Inspect accepts an inspector function that has same arguments as the WarehousesRepository.StockDrifts
+func (mmStockDrifts *mWarehousesRepositoryMockStockDrifts) Inspect(f func(ctx context.Context)) *mWarehousesRepositoryMockStockDrifts {
+	if mmStockDrifts.mock.inspectFuncStockDrifts != nil {
+		mmStockDrifts.mock.t.Fatalf("Inspect function is already set for WarehousesRepositoryMock.StockDrifts")
+	}
+
+	mmStockDrifts.mock.inspectFuncStockDrifts = f
+
+	return mmStockDrifts
+}
+
+// Return sets up results that will be returned by WarehousesRepository.StockDrifts
+func (mmStockDrifts *mWarehousesRepositoryMockStockDrifts) Return(sa1 []StockDrift, err error) *WarehousesRepositoryMock {
+	if mmStockDrifts.mock.funcStockDrifts != nil {
+		mmStockDrifts.mock.t.Fatalf("WarehousesRepositoryMock.StockDrifts mock is already set by Set")
+	}
+
+	if mmStockDrifts.defaultExpectation == nil {
+		mmStockDrifts.defaultExpectation = &WarehousesRepositoryMockStockDriftsExpectation{mock: mmStockDrifts.mock}
+	}
+	mmStockDrifts.defaultExpectation.results = &WarehousesRepositoryMockStockDriftsResults{sa1, err}
+	return mmStockDrifts.mock
+}
+
+// Set uses given function f to mock the WarehousesRepository.StockDrifts method
+func (mmStockDrifts *mWarehousesRepositoryMockStockDrifts) Set(f func(ctx context.Context) (sa1 []StockDrift, err error)) *WarehousesRepositoryMock {
+	if mmStockDrifts.defaultExpectation != nil {
+		mmStockDrifts.mock.t.Fatalf("Default expectation is already set for the WarehousesRepository.StockDrifts method")
+	}
+
+	if len(mmStockDrifts.expectations) > 0 {
+		mmStockDrifts.mock.t.Fatalf("Some expectations are already set for the WarehousesRepository.StockDrifts method")
+	}
+
+	mmStockDrifts.mock.funcStockDrifts = f
+	return mmStockDrifts.mock
+}
+
+// When sets expectation for the WarehousesRepository.StockDrifts which will trigger the result defined by the following
+// Then helper
+func (mmStockDrifts *mWarehousesRepositoryMockStockDrifts) When(ctx context.Context) *WarehousesRepositoryMockStockDriftsExpectation {
+	if mmStockDrifts.mock.funcStockDrifts != nil {
+		mmStockDrifts.mock.t.Fatalf("WarehousesRepositoryMock.StockDrifts mock is already set by Set")
+	}
+
+	expectation := &WarehousesRepositoryMockStockDriftsExpectation{
+		mock:   mmStockDrifts.mock,
+		params: &WarehousesRepositoryMockStockDriftsParams{ctx},
+	}
+	mmStockDrifts.expectations = append(mmStockDrifts.expectations, expectation)
+	return expectation
+}
+
+// Then sets up WarehousesRepository.StockDrifts return parameters for the expectation previously defined by the When method
+func (e *WarehousesRepositoryMockStockDriftsExpectation) Then(sa1 []StockDrift, err error) *WarehousesRepositoryMock {
+	e.results = &WarehousesRepositoryMockStockDriftsResults{sa1, err}
+	return e.mock
+}
+
+// StockDrifts implements WarehousesRepository
+func (mmStockDrifts *WarehousesRepositoryMock) StockDrifts(ctx context.Context) (sa1 []StockDrift, err error) {
+	mm_atomic.AddUint64(&mmStockDrifts.beforeStockDriftsCounter, 1)
+	defer mm_atomic.AddUint64(&mmStockDrifts.afterStockDriftsCounter, 1)
+
+	if mmStockDrifts.inspectFuncStockDrifts != nil {
+		mmStockDrifts.inspectFuncStockDrifts(ctx)
+	}
+
+	mm_params := &WarehousesRepositoryMockStockDriftsParams{ctx}
+
+	// Record call args
+	mmStockDrifts.StockDriftsMock.mutex.Lock()
+	mmStockDrifts.StockDriftsMock.callArgs = append(mmStockDrifts.StockDriftsMock.callArgs, mm_params)
+	mmStockDrifts.StockDriftsMock.mutex.Unlock()
+
+	for _, e := range mmStockDrifts.StockDriftsMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmStockDrifts.StockDriftsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmStockDrifts.StockDriftsMock.defaultExpectation.Counter, 1)
+		mm_want := mmStockDrifts.StockDriftsMock.defaultExpectation.params
+		mm_got := WarehousesRepositoryMockStockDriftsParams{ctx}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmStockDrifts.t.Errorf("WarehousesRepositoryMock.StockDrifts got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmStockDrifts.StockDriftsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmStockDrifts.t.Fatal("No results are set for the WarehousesRepositoryMock.StockDrifts")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmStockDrifts.funcStockDrifts != nil {
+		return mmStockDrifts.funcStockDrifts(ctx)
+	}
+	mmStockDrifts.t.Fatalf("Unexpected call to WarehousesRepositoryMock.StockDrifts. %v", ctx)
+	return
+}
+
+// StockDriftsAfterCounter returns a count of finished WarehousesRepositoryMock.StockDrifts invocations
+func (mmStockDrifts *WarehousesRepositoryMock) StockDriftsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStockDrifts.afterStockDriftsCounter)
+}
+
+// StockDriftsBeforeCounter returns a count of WarehousesRepositoryMock.StockDrifts invocations
+func (mmStockDrifts *WarehousesRepositoryMock) StockDriftsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStockDrifts.beforeStockDriftsCounter)
+}
+
+// Calls returns a list of arguments used in each call to WarehousesRepositoryMock.StockDrifts.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmStockDrifts *mWarehousesRepositoryMockStockDrifts) Calls() []*WarehousesRepositoryMockStockDriftsParams {
+	mmStockDrifts.mutex.RLock()
+
+	argCopy := make([]*WarehousesRepositoryMockStockDriftsParams, len(mmStockDrifts.callArgs))
+	copy(argCopy, mmStockDrifts.callArgs)
+
+	mmStockDrifts.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockStockDriftsDone returns true if the count of the StockDrifts invocations corresponds
+// the number of defined expectations
+func (m *WarehousesRepositoryMock) MinimockStockDriftsDone() bool {
+	for _, e := range m.StockDriftsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.StockDriftsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterStockDriftsCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcStockDrifts != nil && mm_atomic.LoadUint64(&m.afterStockDriftsCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockStockDriftsInspect logs each unmet expectation
+func (m *WarehousesRepositoryMock) MinimockStockDriftsInspect() {
+	for _, e := range m.StockDriftsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to WarehousesRepositoryMock.StockDrifts with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.StockDriftsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterStockDriftsCounter) < 1 {
+		if m.StockDriftsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to WarehousesRepositoryMock.StockDrifts")
+		} else {
+			m.t.Errorf("Expected call to WarehousesRepositoryMock.StockDrifts with params: %#v", *m.StockDriftsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcStockDrifts != nil && mm_atomic.LoadUint64(&m.afterStockDriftsCounter) < 1 {
+		m.t.Error("Expected call to WarehousesRepositoryMock.StockDrifts")
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *WarehousesRepositoryMock) MinimockFinish() {
+	if !m.minimockDone() {
+		m.MinimockChangeStockInspect()
+
+		m.MinimockCreateSellerInspect()
+
+		m.MinimockCreateWarehouseInspect()
+
+		m.MinimockListWarehouseStockInspect()
+
+		m.MinimockSoldItemsInspect()
+
+		m.MinimockStockDriftsInspect()
+		m.t.FailNow()
+	}
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *WarehousesRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *WarehousesRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockChangeStockDone() &&
+		m.MinimockCreateSellerDone() &&
+		m.MinimockCreateWarehouseDone() &&
+		m.MinimockListWarehouseStockDone() &&
+		m.MinimockSoldItemsDone() &&
+		m.MinimockStockDriftsDone()
+}
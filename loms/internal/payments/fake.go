@@ -0,0 +1,100 @@
+package payments
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"route256/libs/logger"
+	"route256/loms/internal/domain"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+var _ domain.PaymentProvider = (*Fake)(nil)
+
+const (
+	fakeConfirmRetries = 5
+	fakeRequestTimeout = 5 * time.Second
+)
+
+type FakeConfig struct {
+	//Если не задан, платежи подтверждаются вручную запросом к вебхуку
+	WebhookURL   string
+	Secret       string
+	ConfirmDelay time.Duration
+}
+
+type Fake struct {
+	config FakeConfig
+	client *http.Client
+}
+
+func NewFake(config FakeConfig) *Fake {
+	return &Fake{
+		config: config,
+		client: &http.Client{Timeout: fakeRequestTimeout},
+	}
+}
+
+func (f *Fake) CreatePayment(ctx context.Context, intent domain.PaymentIntent) (*domain.Payment, error) {
+	id := make([]byte, 16)
+	_, err := rand.Read(id)
+	if err != nil {
+		return nil, errors.Wrap(err, "generate payment id")
+	}
+	payment := &domain.Payment{
+		ID:              "fake-" + hex.EncodeToString(id),
+		OrderID:         intent.OrderID,
+		Amount:          intent.Amount,
+		ConfirmationURL: fmt.Sprintf("https://pay.local/%s", hex.EncodeToString(id)),
+	}
+	if f.config.WebhookURL != "" {
+		go f.confirm(payment.ID)
+	}
+	return payment, nil
+}
+
+func (f *Fake) Refund(ctx context.Context, refund domain.Refund) error {
+	logger.Info("fake refund", zap.Int64("refund id", refund.ID), zap.String("payment id", refund.PaymentID), zap.Uint64("amount", refund.Amount))
+	return nil
+}
+
+func (f *Fake) confirm(paymentID string) {
+	body, err := json.Marshal(Notification{PaymentID: paymentID, Status: StatusSucceeded})
+	if err != nil {
+		logger.Error(context.Background(), "marshal fake notification", zap.Error(err))
+		return
+	}
+	for attempt := 0; attempt < fakeConfirmRetries; attempt++ {
+		time.Sleep(f.config.ConfirmDelay)
+		err = f.send(body)
+		if err == nil {
+			return
+		}
+	}
+	logger.Error(context.Background(), "send fake notification", zap.String("payment id", paymentID), zap.Error(err))
+}
+
+func (f *Fake) send(body []byte) error {
+	req, err := http.NewRequest(http.MethodPost, f.config.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "build request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, Sign(f.config.Secret, body))
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "send request")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package payments
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"route256/libs/logger"
+	"route256/loms/internal/domain"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	StatusSucceeded = "succeeded"
+	StatusFailed    = "failed"
+
+	maxNotificationSize = 1 << 16
+)
+
+type Notification struct {
+	PaymentID string `json:"payment_id"`
+	Status    string `json:"status"`
+}
+
+type PaymentConfirmer interface {
+	ConfirmPayment(ctx context.Context, paymentID string, succeeded bool) error
+}
+
+type Webhook struct {
+	secret    string
+	confirmer PaymentConfirmer
+}
+
+func NewWebhook(secret string, confirmer PaymentConfirmer) *Webhook {
+	return &Webhook{
+		secret:    secret,
+		confirmer: confirmer,
+	}
+}
+
+func (h *Webhook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxNotificationSize))
+	if err != nil {
+		http.Error(w, "read body", http.StatusBadRequest)
+		return
+	}
+	if !Verify(h.secret, body, r.Header.Get(SignatureHeader)) {
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+	var notification Notification
+	err = json.Unmarshal(body, &notification)
+	if err != nil || notification.PaymentID == "" ||
+		notification.Status != StatusSucceeded && notification.Status != StatusFailed {
+		http.Error(w, "invalid notification", http.StatusBadRequest)
+		return
+	}
+	err = h.confirmer.ConfirmPayment(r.Context(), notification.PaymentID, notification.Status == StatusSucceeded)
+	switch {
+	case err == nil:
+		w.WriteHeader(http.StatusOK)
+	case errors.Is(err, domain.ErrPaymentNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, domain.ErrPaymentFinished):
+		http.Error(w, err.Error(), http.StatusConflict)
+	default:
+		logger.Error(r.Context(), "confirm payment", zap.String("payment id", notification.PaymentID), zap.Error(err))
+		http.Error(w, "internal error", http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,91 @@
+package payments
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"route256/loms/internal/domain"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+type confirmerFunc func(ctx context.Context, paymentID string, succeeded bool) error
+
+func (f confirmerFunc) ConfirmPayment(ctx context.Context, paymentID string, succeeded bool) error {
+	return f(ctx, paymentID, succeeded)
+}
+
+func TestWebhook(t *testing.T) {
+	const secret = "secret"
+
+	tests := []struct {
+		name      string
+		body      string
+		signature string
+		confirm   error
+		want      int
+	}{
+		{
+			name: "succeeded",
+			body: `{"payment_id":"p1","status":"succeeded"}`,
+			want: http.StatusOK,
+		},
+		{
+			name: "failed",
+			body: `{"payment_id":"p1","status":"failed"}`,
+			want: http.StatusOK,
+		},
+		{
+			name:      "invalid signature",
+			body:      `{"payment_id":"p1","status":"succeeded"}`,
+			signature: Sign("other", []byte(`{"payment_id":"p1","status":"succeeded"}`)),
+			want:      http.StatusUnauthorized,
+		},
+		{
+			name: "unknown status",
+			body: `{"payment_id":"p1","status":"maybe"}`,
+			want: http.StatusBadRequest,
+		},
+		{
+			name:    "payment not found",
+			body:    `{"payment_id":"p1","status":"succeeded"}`,
+			confirm: domain.ErrPaymentNotFound,
+			want:    http.StatusNotFound,
+		},
+		{
+			name:    "payment finished",
+			body:    `{"payment_id":"p1","status":"succeeded"}`,
+			confirm: errors.Wrap(domain.ErrPaymentFinished, "confirm payment"),
+			want:    http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var called bool
+			webhook := NewWebhook(secret, confirmerFunc(func(ctx context.Context, paymentID string, succeeded bool) error {
+				called = true
+				require.Equal(t, "p1", paymentID)
+				require.Equal(t, bytes.Contains([]byte(tt.body), []byte(StatusSucceeded)), succeeded)
+				return tt.confirm
+			}))
+			signature := tt.signature
+			if signature == "" {
+				signature = Sign(secret, []byte(tt.body))
+			}
+			req := httptest.NewRequest(http.MethodPost, "/payments/webhook", bytes.NewBufferString(tt.body))
+			req.Header.Set(SignatureHeader, signature)
+			rec := httptest.NewRecorder()
+
+			webhook.ServeHTTP(rec, req)
+
+			require.Equal(t, tt.want, rec.Code)
+			require.Equal(t, tt.want != http.StatusUnauthorized && tt.want != http.StatusBadRequest, called)
+		})
+	}
+}
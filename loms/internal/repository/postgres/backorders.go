@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"route256/loms/internal/domain"
+	"route256/loms/internal/repository/schema"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/pkg/errors"
+)
+
+const backordersTable = "order_backorders"
+
+func (r *OrdersRepo) CreateBackorders(ctx context.Context, orderID int64, items []domain.OrderItem) error {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	query := sq.Insert(backordersTable).Columns("order_id", "sku", "seller_id", "count").PlaceholderFormat(sq.Dollar)
+	for _, item := range items {
+		query = query.Values(orderID, item.Sku, item.SellerID, item.Count)
+	}
+	rawQuery, args, err := query.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "build query")
+	}
+	_, err = db.Exec(ctx, rawQuery, args...)
+	if err != nil {
+		return errors.Wrap(err, "exec query")
+	}
+	return nil
+}
+
+func (r *OrdersRepo) GetBackorders(ctx context.Context, orderID int64) ([]domain.OrderItem, error) {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	query := sq.Select("sku", "seller_id", "count").From(backordersTable).
+		Where(sq.Eq{"order_id": orderID}).OrderBy("sku", "seller_id").PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "build query")
+	}
+	var items []schema.OrderItem
+	err = pgxscan.Select(ctx, db, &items, rawQuery, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "exec query")
+	}
+	result := make([]domain.OrderItem, 0, len(items))
+	for _, item := range items {
+		result = append(result, domain.OrderItem{Sku: item.Sku, SellerID: item.SellerID, Count: item.Count})
+	}
+	return result, nil
+}
+
+func (r *OrdersRepo) ListSkuBackorders(ctx context.Context, sku uint32, sellerID int64) ([]domain.Backorder, error) {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	query := sq.Select("order_id", "sku", "seller_id", "count").From(backordersTable).
+		Where(sq.Eq{"sku": sku, "seller_id": sellerID}).OrderBy("id").Suffix("FOR UPDATE").PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "build query")
+	}
+	var backorders []schema.Backorder
+	err = pgxscan.Select(ctx, db, &backorders, rawQuery, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "exec query")
+	}
+	result := make([]domain.Backorder, 0, len(backorders))
+	for _, backorder := range backorders {
+		result = append(result, domain.Backorder{
+			OrderID:  backorder.OrderID,
+			Sku:      backorder.Sku,
+			SellerID: backorder.SellerID,
+			Count:    backorder.Count,
+		})
+	}
+	return result, nil
+}
+
+func (r *OrdersRepo) UpdateBackorder(ctx context.Context, backorder domain.Backorder) error {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	where := sq.Eq{"order_id": backorder.OrderID, "sku": backorder.Sku, "seller_id": backorder.SellerID}
+	var query sq.Sqlizer = sq.Update(backordersTable).Set("count", backorder.Count).
+		Where(where).PlaceholderFormat(sq.Dollar)
+	if backorder.Count == 0 {
+		query = sq.Delete(backordersTable).Where(where).PlaceholderFormat(sq.Dollar)
+	}
+	rawQuery, args, err := query.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "build query")
+	}
+	_, err = db.Exec(ctx, rawQuery, args...)
+	if err != nil {
+		return errors.Wrap(err, "exec query")
+	}
+	return nil
+}
+
+func (r *OrdersRepo) DeleteBackorders(ctx context.Context, orderID int64) error {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	query := sq.Delete(backordersTable).Where(sq.Eq{"order_id": orderID}).PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err := query.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "build query")
+	}
+	_, err = db.Exec(ctx, rawQuery, args...)
+	if err != nil {
+		return errors.Wrap(err, "exec query")
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"context"
+	"route256/loms/internal/domain"
+	"route256/loms/internal/repository/schema"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/pkg/errors"
+)
+
+const historyTable = "order_status_history"
+
+var historyColumns = []string{"order_id", "old_status", "new_status", "reason", "created_at"}
+
+func (r *OrdersRepo) AddStatusChange(ctx context.Context, change domain.StatusChange) error {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	query := sq.Insert(historyTable).Columns("order_id", "old_status", "new_status", "reason").
+		Values(change.OrderID, string(change.From), string(change.To), change.Reason).PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err := query.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "build insert query")
+	}
+	_, err = db.Exec(ctx, rawQuery, args...)
+	if err != nil {
+		return errors.Wrap(err, "exec query")
+	}
+	return nil
+}
+
+func (r *OrdersRepo) GetOrderHistory(ctx context.Context, orderID int64) ([]domain.StatusChange, error) {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	query := sq.Select(historyColumns...).From(historyTable).
+		Where(sq.Eq{"order_id": orderID}).OrderBy("id").PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "build select query")
+	}
+	var changes []schema.StatusChange
+	err = pgxscan.Select(ctx, db, &changes, rawQuery, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "exec query")
+	}
+	result := make([]domain.StatusChange, 0, len(changes))
+	for _, change := range changes {
+		result = append(result, domain.StatusChange{
+			OrderID:   change.OrderID,
+			From:      domain.OrderStatus(change.OldStatus),
+			To:        domain.OrderStatus(change.NewStatus),
+			Reason:    change.Reason,
+			CreatedAt: change.CreatedAt,
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"context"
+	"route256/loms/internal/domain"
+	"route256/loms/internal/repository/schema"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+)
+
+const holdsTable = "stock_holds"
+
+func (r *OrdersRepo) HoldStock(ctx context.Context, user int64, item domain.ReservedItem, expiresAt time.Time) error {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return errors.Wrap(err, "run transaction")
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+	//Проверка stocks_reserved_check не даст удержать больше, чем свободно на складе
+	queryStock := sq.Update(stocksTable).Set("held", sq.Expr("held + ?", item.Count)).
+		Where(sq.Eq{"warehouse_id": item.WarehouseID, "sku": item.Sku}).PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err := queryStock.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "build update query")
+	}
+	cmd, err := tx.Exec(ctx, rawQuery, args...)
+	if err != nil {
+		if isCheckViolation(err) {
+			return domain.ErrCantReserveItem
+		}
+		return errors.Wrap(err, "exec update query")
+	}
+	if cmd.RowsAffected() == 0 {
+		return domain.ErrCantReserveItem
+	}
+	query := sq.Insert(holdsTable).Columns("user_id", "warehouse_id", "sku", "count", "expires_at").
+		Values(user, item.WarehouseID, item.Sku, item.Count, expiresAt).PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err = query.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "build insert query")
+	}
+	_, err = tx.Exec(ctx, rawQuery, args...)
+	if err != nil {
+		return errors.Wrap(err, "exec insert query")
+	}
+	for _, query := range movementQueries(domain.StockMovement{
+		WarehouseID: item.WarehouseID,
+		Sku:         item.Sku,
+		Kind:        domain.MovementHold,
+		Delta:       int64(item.Count),
+	}) {
+		rawQuery, args, err = query.ToSql()
+		if err != nil {
+			return errors.Wrap(err, "build movement query")
+		}
+		_, err = tx.Exec(ctx, rawQuery, args...)
+		if err != nil {
+			return errors.Wrap(err, "exec movement query")
+		}
+	}
+	err = tx.Commit(ctx)
+	if err != nil {
+		return errors.Wrap(err, "commit transaction")
+	}
+	return nil
+}
+
+func (r *OrdersRepo) ReleaseHolds(ctx context.Context, user int64, skus []uint32) ([]domain.ReservedItem, error) {
+	return r.releaseHolds(ctx, sq.And{sq.Eq{"user_id": user}, sq.Expr("sku = ANY(?)", skus)})
+}
+
+func (r *OrdersRepo) ReleaseExpiredHolds(ctx context.Context, now time.Time) ([]domain.ReservedItem, error) {
+	return r.releaseHolds(ctx, sq.LtOrEq{"expires_at": now})
+}
+
+func (r *OrdersRepo) releaseHolds(ctx context.Context, where sq.Sqlizer) ([]domain.ReservedItem, error) {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "run transaction")
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+	query := sq.Delete(holdsTable).Where(where).
+		Suffix("RETURNING warehouse_id, sku, count").PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "build delete query")
+	}
+	var releasedItems []schema.SoldedItem
+	err = pgxscan.Select(ctx, tx, &releasedItems, rawQuery, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "exec delete query")
+	}
+	b := &pgx.Batch{}
+	for _, item := range releasedItems {
+		queryUpdate := sq.Update(stocksTable).Set("held", sq.Expr("held-?", item.Count)).
+			Where(sq.Eq{"warehouse_id": item.WarehouseID, "sku": item.Sku}).PlaceholderFormat(sq.Dollar)
+		rawQuery, args, err = queryUpdate.ToSql()
+		if err != nil {
+			return nil, errors.Wrap(err, "build update query")
+		}
+		b.Queue(rawQuery, args...)
+		for _, query := range movementQueries(domain.StockMovement{
+			WarehouseID: item.WarehouseID,
+			Sku:         item.Sku,
+			Kind:        domain.MovementReleaseHold,
+			Delta:       -int64(item.Count),
+		}) {
+			rawQuery, args, err = query.ToSql()
+			if err != nil {
+				return nil, errors.Wrap(err, "build movement query")
+			}
+			b.Queue(rawQuery, args...)
+		}
+	}
+	br := tx.SendBatch(ctx, b)
+	defer br.Close()
+	for i := 0; i < b.Len(); i++ {
+		_, err := br.Exec()
+		if err != nil {
+			return nil, errors.Wrap(err, "process batch result")
+		}
+	}
+	br.Close()
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "commit transaction")
+	}
+	result := make([]domain.ReservedItem, 0, len(releasedItems))
+	for _, item := range releasedItems {
+		result = append(result, domain.ReservedItem{
+			OrderItem:   domain.OrderItem{Sku: item.Sku, Count: item.Count},
+			WarehouseID: item.WarehouseID,
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"route256/loms/internal/domain"
+	"route256/loms/internal/repository/schema"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/pkg/errors"
+)
+
+const notificationsTable = "order_notifications"
+
+func (r *OrdersRepo) CreateOrderNotification(ctx context.Context, order *domain.Order) error {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+
+	payload := schema.NotificationPayload{
+		ID:     order.ID,
+		Status: string(order.Status),
+		User:   order.User,
+		Items:  make([]schema.NotificationItem, 0, len(order.Items)),
+	}
+	for _, item := range order.Items {
+		payload.Items = append(payload.Items, schema.NotificationItem{Sku: item.Sku, Count: item.Count, SellerID: item.SellerID, Name: item.Name, Price: item.Price})
+	}
+	for _, shipment := range order.Shipments {
+		notificationShipment := schema.NotificationShipment{
+			ID:             shipment.ID,
+			WarehouseID:    shipment.WarehouseID,
+			SellerID:       shipment.SellerID,
+			Status:         string(shipment.Status),
+			TrackingNumber: shipment.TrackingNumber,
+			Items:          make([]schema.NotificationItem, 0, len(shipment.Items)),
+		}
+		for _, item := range shipment.Items {
+			notificationShipment.Items = append(notificationShipment.Items, schema.NotificationItem{Sku: item.Sku, Count: item.Count})
+		}
+		payload.Shipments = append(payload.Shipments, notificationShipment)
+	}
+	bytes, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Wrap(err, "marshal payload")
+	}
+	query := sq.Insert(notificationsTable).Columns("order_id", "payload").
+		Values(order.ID, bytes).PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err := query.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "build insert query")
+	}
+	_, err = db.Exec(ctx, rawQuery, args...)
+	if err != nil {
+		return errors.Wrap(err, "exec query")
+	}
+	return nil
+}
+
+func (r *OrdersRepo) GetUnsentOrderNotifications(ctx context.Context, limit uint64) ([]domain.OrderNotification, error) {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	query := sq.Select("id", "payload").From(notificationsTable).
+		Where(sq.Eq{"sent_at": nil}).OrderBy("id").Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED").PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "build select query")
+	}
+	var notifications []schema.OrderNotification
+	err = pgxscan.Select(ctx, db, &notifications, rawQuery, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "exec query")
+	}
+	result := make([]domain.OrderNotification, 0, len(notifications))
+	for _, notification := range notifications {
+		var payload schema.NotificationPayload
+		err = json.Unmarshal(notification.Payload, &payload)
+		if err != nil {
+			return nil, errors.Wrap(err, "unmarshal payload")
+		}
+		order := &domain.Order{
+			ID:     payload.ID,
+			Status: domain.OrderStatus(payload.Status),
+			User:   payload.User,
+			Items:  make([]domain.OrderItem, 0, len(payload.Items)),
+		}
+		for _, item := range payload.Items {
+			order.Items = append(order.Items, domain.OrderItem{Sku: item.Sku, Count: item.Count, SellerID: item.SellerID, Name: item.Name, Price: item.Price})
+		}
+		for _, shipment := range payload.Shipments {
+			orderShipment := domain.Shipment{
+				ID:             shipment.ID,
+				OrderID:        order.ID,
+				WarehouseID:    shipment.WarehouseID,
+				SellerID:       shipment.SellerID,
+				Status:         domain.ShipmentStatus(shipment.Status),
+				TrackingNumber: shipment.TrackingNumber,
+				Items:          make([]domain.OrderItem, 0, len(shipment.Items)),
+			}
+			for _, item := range shipment.Items {
+				orderShipment.Items = append(orderShipment.Items, domain.OrderItem{Sku: item.Sku, Count: item.Count})
+			}
+			order.Shipments = append(order.Shipments, orderShipment)
+		}
+		result = append(result, domain.OrderNotification{ID: notification.ID, Order: order})
+	}
+	return result, nil
+}
+
+func (r *OrdersRepo) MarkOrderNotificationsSent(ctx context.Context, ids []int64) error {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	query := sq.Update(notificationsTable).Set("sent_at", sq.Expr("now()")).
+		Where(sq.Eq{"id": ids}).PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err := query.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "build update query")
+	}
+	_, err = db.Exec(ctx, rawQuery, args...)
+	if err != nil {
+		return errors.Wrap(err, "exec query")
+	}
+	return nil
+}
@@ -5,9 +5,11 @@ import (
 	transactor "route256/libs/postgres_transactor"
 	"route256/loms/internal/domain"
 	"route256/loms/internal/repository/schema"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/georgysavva/scany/pgxscan"
+	"github.com/jackc/pgconn"
 	"github.com/jackc/pgx/v4"
 	"github.com/pkg/errors"
 )
@@ -25,8 +27,8 @@ func NewItemsRepo(provider transactor.QueryEngineProvider) *OrdersRepo {
 }
 
 var (
-	ordersColumns = []string{"id", "status", "user_id"}
-	itemColumns   = []string{"sku", "count"}
+	ordersColumns = []string{"id", "status", "user_id", "created_at", "COALESCE(preferred_warehouse_id, 0) AS preferred_warehouse_id", "allow_backorder"}
+	itemColumns   = []string{"sku", "count", "seller_id", "name", "price"}
 )
 
 const (
@@ -34,6 +36,9 @@ const (
 	itemsTable         = "order_items"
 	stocksTable        = "stocks"
 	reservedItemsTable = "reserved_items"
+
+	checkViolationCode  = "23514"
+	uniqueViolationCode = "23505"
 )
 
 func (r *OrdersRepo) CreateOrder(ctx context.Context, order *domain.Order) (int64, error) {
@@ -47,7 +52,16 @@ func (r *OrdersRepo) CreateOrder(ctx context.Context, order *domain.Order) (int6
 		_ = tx.Rollback(ctx)
 	}()
 
-	query := sq.Insert(ordersTable).Columns("status", "user_id").Values(order.Status, order.User).
+	var idempotencyKey *string
+	if order.IdempotencyKey != "" {
+		idempotencyKey = &order.IdempotencyKey
+	}
+	var preferredWarehouseID *int64
+	if order.PreferredWarehouseID != 0 {
+		preferredWarehouseID = &order.PreferredWarehouseID
+	}
+	query := sq.Insert(ordersTable).Columns("status", "user_id", "idempotency_key", "preferred_warehouse_id", "allow_backorder").
+		Values(string(order.Status), order.User, idempotencyKey, preferredWarehouseID, order.AllowBackorder).
 		Suffix("RETURNING id").PlaceholderFormat(sq.Dollar)
 
 	rawQuery, args, err := query.ToSql()
@@ -56,11 +70,14 @@ func (r *OrdersRepo) CreateOrder(ctx context.Context, order *domain.Order) (int6
 	}
 	err = pgxscan.Get(ctx, tx, &order.ID, rawQuery, args...)
 	if err != nil {
+		if isUniqueViolation(err) {
+			return 0, domain.ErrDuplicateIdempotencyKey
+		}
 		return 0, errors.Wrap(err, "exec orders query")
 	}
-	query = sq.Insert(itemsTable).Columns("order_id", "sku", "count").PlaceholderFormat(sq.Dollar)
+	query = sq.Insert(itemsTable).Columns("order_id", "sku", "count", "seller_id", "name", "price").PlaceholderFormat(sq.Dollar)
 	for _, item := range order.Items {
-		query = query.Values(order.ID, item.Sku, item.Count)
+		query = query.Values(order.ID, item.Sku, item.Count, item.SellerID, item.Name, item.Price)
 	}
 	rawQuery, args, err = query.ToSql()
 	if err != nil {
@@ -109,22 +126,105 @@ func (r *OrdersRepo) GetOrder(ctx context.Context, orderID int64) (*domain.Order
 		return nil, errors.Wrap(err, "query items")
 	}
 	result := &domain.Order{
-		ID:     order.ID,
-		User:   order.User,
-		Status: order.Status,
-		Items:  make([]domain.OrderItem, 0, len(items)),
+		ID:                   order.ID,
+		User:                 order.User,
+		Status:               domain.OrderStatus(order.Status),
+		CreatedAt:            order.CreatedAt,
+		Items:                make([]domain.OrderItem, 0, len(items)),
+		PreferredWarehouseID: order.PreferredWarehouseID,
+		AllowBackorder:       order.AllowBackorder,
 	}
 	for _, item := range items {
-		result.Items = append(result.Items, domain.OrderItem{Sku: item.Sku, Count: item.Count})
+		result.Items = append(result.Items, domain.OrderItem{Sku: item.Sku, Count: item.Count, SellerID: item.SellerID, Name: item.Name, Price: item.Price})
 	}
 	return result, nil
 }
 
-func (r *OrdersRepo) UpdateOrderStatus(ctx context.Context, id int64, status string, statusBefore string) error {
+func (r *OrdersRepo) GetOrderIDByIdempotencyKey(ctx context.Context, user int64, key string) (int64, error) {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	query := sq.Select("id").From(ordersTable).
+		Where(sq.Eq{"user_id": user, "idempotency_key": key}).PlaceholderFormat(sq.Dollar)
+
+	rawQuery, args, err := query.ToSql()
+	if err != nil {
+		return 0, errors.Wrap(err, "build query")
+	}
+	var id int64
+	err = pgxscan.Get(ctx, db, &id, rawQuery, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, domain.ErrOrderNotFound
+		}
+		return 0, errors.Wrap(err, "exec query")
+	}
+	return id, nil
+}
+
+func (r *OrdersRepo) UpdateOrderItems(ctx context.Context, orderID int64, items []domain.OrderItem) error {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return errors.Wrap(err, "run transaction")
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+	deleteQuery := sq.Delete(itemsTable).Where(sq.Eq{"order_id": orderID}).PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err := deleteQuery.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "build delete query")
+	}
+	_, err = tx.Exec(ctx, rawQuery, args...)
+	if err != nil {
+		return errors.Wrap(err, "exec delete query")
+	}
+	query := sq.Insert(itemsTable).Columns("order_id", "sku", "count", "seller_id", "name", "price").PlaceholderFormat(sq.Dollar)
+	for _, item := range items {
+		query = query.Values(orderID, item.Sku, item.Count, item.SellerID, item.Name, item.Price)
+	}
+	rawQuery, args, err = query.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "build insert query")
+	}
+	_, err = tx.Exec(ctx, rawQuery, args...)
+	if err != nil {
+		return errors.Wrap(err, "exec insert query")
+	}
+	err = tx.Commit(ctx)
+	if err != nil {
+		return errors.Wrap(err, "commit transaction")
+	}
+	return nil
+}
+
+func (r *OrdersRepo) ClaimNewOrder(ctx context.Context) (int64, error) {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	query := sq.Select("id").From(ordersTable).
+		Where(sq.Eq{"status": string(domain.StatusNew)}).
+		OrderBy("id").Limit(1).
+		Suffix("FOR UPDATE SKIP LOCKED").PlaceholderFormat(sq.Dollar)
+
+	rawQuery, args, err := query.ToSql()
+	if err != nil {
+		return 0, errors.Wrap(err, "build query")
+	}
+	var id int64
+	err = pgxscan.Get(ctx, db, &id, rawQuery, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, domain.ErrNoNewOrders
+		}
+		return 0, errors.Wrap(err, "exec query")
+	}
+	return id, nil
+}
+
+func (r *OrdersRepo) UpdateOrderStatus(ctx context.Context, id int64, status domain.OrderStatus, statusBefore domain.OrderStatus) error {
 	db := r.QueryEngineProvider.GetQueryEngine(ctx)
 
-	query := sq.Update(ordersTable).Set("status", status).
-		Where(sq.Eq{"id": id, "status": statusBefore}).PlaceholderFormat(sq.Dollar)
+	query := sq.Update(ordersTable).Set("status", string(status)).
+		Where(sq.Eq{"id": id, "status": string(statusBefore)}).PlaceholderFormat(sq.Dollar)
 
 	rawQuery, args, err := query.ToSql()
 	if err != nil {
@@ -140,94 +240,214 @@ func (r *OrdersRepo) UpdateOrderStatus(ctx context.Context, id int64, status str
 	return nil
 }
 
-func (r *OrdersRepo) ReserveStock(ctx context.Context, orderID int64, item domain.ReservedItem) error {
+func (r *OrdersRepo) SetPaymentDeadline(ctx context.Context, id int64, deadline time.Time) error {
+	return r.setDeadline(ctx, "payment_deadline", id, deadline)
+}
+
+func (r *OrdersRepo) SetBackorderDeadline(ctx context.Context, id int64, deadline time.Time) error {
+	return r.setDeadline(ctx, "backorder_deadline", id, deadline)
+}
+
+func (r *OrdersRepo) setDeadline(ctx context.Context, column string, id int64, deadline time.Time) error {
 	db := r.QueryEngineProvider.GetQueryEngine(ctx)
-	query := sq.Insert(reservedItemsTable).Columns("order_id", "warehouse_id", "sku", "count").
-		Values(orderID, item.WarehouseID, item.Sku, item.Count).PlaceholderFormat(sq.Dollar)
+
+	query := sq.Update(ordersTable).Set(column, deadline).
+		Where(sq.Eq{"id": id}).PlaceholderFormat(sq.Dollar)
+
 	rawQuery, args, err := query.ToSql()
 	if err != nil {
-		return errors.Wrap(err, "build insert query")
+		return errors.Wrap(err, "build query")
 	}
-	_, err = db.Exec(ctx, rawQuery, args...)
+	cmd, err := db.Exec(ctx, rawQuery, args...)
 	if err != nil {
 		return errors.Wrap(err, "exec query")
 	}
+	if cmd.RowsAffected() == 0 {
+		return domain.ErrOrderNotFound
+	}
 	return nil
 }
 
-func (r *OrdersRepo) UnReserveItems(ctx context.Context, orderID int64) error {
+func (r *OrdersRepo) GetExpiredOrders(ctx context.Context, status domain.OrderStatus, now time.Time, limit uint64) ([]int64, error) {
 	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	//Заказ, ждущий товар, ограничен сроком ожидания, а не оплаты
+	column := "payment_deadline"
+	if status == domain.StatusAwaitingStock {
+		column = "backorder_deadline"
+	}
+	query := sq.Select("id").From(ordersTable).
+		Where(sq.Eq{"status": string(status)}).
+		Where(sq.Lt{column: now}).
+		OrderBy(column).Limit(limit).PlaceholderFormat(sq.Dollar)
 
-	query := sq.Delete(reservedItemsTable).Where(sq.Eq{"order_id": orderID}).
-		PlaceholderFormat(sq.Dollar)
 	rawQuery, args, err := query.ToSql()
 	if err != nil {
-		return errors.Wrap(err, "build delete query")
+		return nil, errors.Wrap(err, "build query")
 	}
-	_, err = db.Exec(ctx, rawQuery, args...)
+	var ids []int64
+	err = pgxscan.Select(ctx, db, &ids, rawQuery, args...)
 	if err != nil {
-		return errors.Wrap(err, "exec query")
+		return nil, errors.Wrap(err, "exec query")
+	}
+	return ids, nil
+}
+
+func (r *OrdersRepo) ReserveStock(ctx context.Context, orderID int64, item domain.ReservedItem) error {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return errors.Wrap(err, "run transaction")
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+	//Проверка stocks_reserved_check не даст зарезервировать больше, чем есть на складе
+	queryStock := sq.Update(stocksTable).Set("reserved", sq.Expr("reserved + ?", item.Count)).
+		Where(sq.Eq{"warehouse_id": item.WarehouseID, "sku": item.Sku}).PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err := queryStock.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "build update query")
+	}
+	cmd, err := tx.Exec(ctx, rawQuery, args...)
+	if err != nil {
+		if isCheckViolation(err) {
+			return domain.ErrCantReserveItem
+		}
+		return errors.Wrap(err, "exec update query")
+	}
+	if cmd.RowsAffected() == 0 {
+		return domain.ErrCantReserveItem
+	}
+	query := sq.Insert(reservedItemsTable).Columns("order_id", "warehouse_id", "sku", "count", "seller_id").
+		Values(orderID, item.WarehouseID, item.Sku, item.Count, item.SellerID).PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err = query.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "build insert query")
+	}
+	_, err = tx.Exec(ctx, rawQuery, args...)
+	if err != nil {
+		return errors.Wrap(err, "exec insert query")
+	}
+	for _, query := range movementQueries(domain.StockMovement{
+		WarehouseID: item.WarehouseID,
+		Sku:         item.Sku,
+		Kind:        domain.MovementReserve,
+		Delta:       int64(item.Count),
+		OrderID:     orderID,
+	}) {
+		rawQuery, args, err = query.ToSql()
+		if err != nil {
+			return errors.Wrap(err, "build movement query")
+		}
+		_, err = tx.Exec(ctx, rawQuery, args...)
+		if err != nil {
+			return errors.Wrap(err, "exec movement query")
+		}
+	}
+	err = tx.Commit(ctx)
+	if err != nil {
+		return errors.Wrap(err, "commit transaction")
 	}
 	return nil
 }
 
+func (r *OrdersRepo) UnReserveItems(ctx context.Context, orderID int64) ([]domain.ReservedItem, error) {
+	items, err := r.releaseReservedItems(ctx, orderID, false)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]domain.ReservedItem, 0, len(items))
+	for _, item := range items {
+		result = append(result, domain.ReservedItem{
+			OrderItem:   domain.OrderItem{Sku: item.Sku, Count: item.Count, SellerID: item.SellerID},
+			WarehouseID: item.WarehouseID,
+		})
+	}
+	return result, nil
+}
+
 func (r *OrdersRepo) RemoveSoldItems(ctx context.Context, orderID int64) error {
+	_, err := r.releaseReservedItems(ctx, orderID, true)
+	return err
+}
+
+func (r *OrdersRepo) releaseReservedItems(ctx context.Context, orderID int64, sold bool) ([]schema.SoldedItem, error) {
 	db := r.QueryEngineProvider.GetQueryEngine(ctx)
 
 	tx, err := db.Begin(ctx)
 	if err != nil {
-		return errors.Wrap(err, "run transaction")
+		return nil, errors.Wrap(err, "run transaction")
 	}
 	defer func() {
 		_ = tx.Rollback(ctx)
 	}()
 	query := sq.Delete(reservedItemsTable).Where(sq.Eq{"order_id": orderID}).
-		Suffix("RETURNING warehouse_id, sku, count").PlaceholderFormat(sq.Dollar)
+		Suffix("RETURNING warehouse_id, seller_id, sku, count").PlaceholderFormat(sq.Dollar)
 	rawQuery, args, err := query.ToSql()
 	if err != nil {
-		return errors.Wrap(err, "build insert query")
+		return nil, errors.Wrap(err, "build delete query")
 	}
-	var soldedItems []schema.SoldedItem
-	err = pgxscan.Select(ctx, tx, &soldedItems, rawQuery, args...)
+	var releasedItems []schema.SoldedItem
+	err = pgxscan.Select(ctx, tx, &releasedItems, rawQuery, args...)
 	if err != nil {
-		return errors.Wrap(err, "exec insert query")
+		return nil, errors.Wrap(err, "exec delete query")
+	}
+	kind := domain.MovementRelease
+	if sold {
+		kind = domain.MovementSell
 	}
 	b := &pgx.Batch{}
-	for _, item := range soldedItems {
-		queryDelete := sq.Update(stocksTable).Set("count", sq.Expr("count-?", item.Count)).
+	for _, item := range releasedItems {
+		queryUpdate := sq.Update(stocksTable).Set("reserved", sq.Expr("reserved-?", item.Count)).
 			Where(sq.Eq{"warehouse_id": item.WarehouseID, "sku": item.Sku}).PlaceholderFormat(sq.Dollar)
-		rawQuery, args, err = queryDelete.ToSql()
+		if sold {
+			queryUpdate = queryUpdate.Set("count", sq.Expr("count-?", item.Count))
+		}
+		rawQuery, args, err = queryUpdate.ToSql()
 		if err != nil {
-			return errors.Wrap(err, "build delete query")
+			return nil, errors.Wrap(err, "build update query")
 		}
 		b.Queue(rawQuery, args...)
+		for _, query := range movementQueries(domain.StockMovement{
+			WarehouseID: item.WarehouseID,
+			Sku:         item.Sku,
+			Kind:        kind,
+			Delta:       -int64(item.Count),
+			OrderID:     orderID,
+		}) {
+			rawQuery, args, err = query.ToSql()
+			if err != nil {
+				return nil, errors.Wrap(err, "build movement query")
+			}
+			b.Queue(rawQuery, args...)
+		}
 	}
 	br := tx.SendBatch(ctx, b)
 	defer br.Close()
 	for i := 0; i < b.Len(); i++ {
 		_, err := br.Exec()
 		if err != nil {
-			return errors.Wrap(err, "process batch result")
+			return nil, errors.Wrap(err, "process batch result")
 		}
 	}
 	br.Close()
 	err = tx.Commit(ctx)
 	if err != nil {
-		return errors.Wrap(err, "commit transaction")
+		return nil, errors.Wrap(err, "commit transaction")
 	}
-	return nil
+	return releasedItems, nil
 }
 
-func (r *OrdersRepo) Stocks(ctx context.Context, sku uint32) ([]domain.Stock, error) {
+func (r *OrdersRepo) Stocks(ctx context.Context, sku uint32, sellerID int64) ([]domain.Stock, error) {
 	db := r.QueryEngineProvider.GetQueryEngine(ctx)
 	query := `
-	SELECT s.warehouse_id, 
-		(s.count - (SELECT COALESCE(SUM(r.count), 0) AS count FROM reserved_items r 
-			WHERE r.warehouse_id = s.warehouse_id AND sku = s.sku )) AS count 
-	FROM stocks s 
-		WHERE sku = $1 AND count > 0 ORDER BY count DESC`
+	SELECT s.warehouse_id, COALESCE(w.seller_id, 0) AS seller_id, s.count - s.reserved - s.held AS count
+	FROM stocks s
+		JOIN warehouses w ON w.id = s.warehouse_id
+		WHERE s.sku = $1 AND COALESCE(w.seller_id, 0) = $2 AND s.count - s.reserved - s.held > 0 ORDER BY count DESC`
 	var stocks []schema.Stock
-	err := pgxscan.Select(ctx, db, &stocks, query, sku)
+	err := pgxscan.Select(ctx, db, &stocks, query, sku, sellerID)
 	if err != nil {
 		return nil, errors.Wrap(err, "exec query stocks")
 	}
@@ -235,8 +455,69 @@ func (r *OrdersRepo) Stocks(ctx context.Context, sku uint32) ([]domain.Stock, er
 	for _, stock := range stocks {
 		result = append(result, domain.Stock{
 			WarehouseID: stock.WarehouseID,
+			SellerID:    stock.SellerID,
 			Count:       stock.Count,
 		})
 	}
 	return result, nil
 }
+
+func (r *OrdersRepo) BatchStocks(ctx context.Context, skus []uint32) (map[uint32][]domain.Stock, error) {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	query := `
+	SELECT s.sku, s.warehouse_id, COALESCE(w.seller_id, 0) AS seller_id, s.count - s.reserved - s.held AS count
+	FROM stocks s
+		JOIN warehouses w ON w.id = s.warehouse_id
+		WHERE s.sku = ANY($1) AND s.count - s.reserved - s.held > 0 ORDER BY s.sku, count DESC`
+	var stocks []schema.SkuStock
+	err := pgxscan.Select(ctx, db, &stocks, query, skus)
+	if err != nil {
+		return nil, errors.Wrap(err, "exec query stocks")
+	}
+	result := make(map[uint32][]domain.Stock, len(skus))
+	for _, stock := range stocks {
+		result[stock.Sku] = append(result[stock.Sku], domain.Stock{
+			WarehouseID: stock.WarehouseID,
+			SellerID:    stock.SellerID,
+			Count:       stock.Count,
+		})
+	}
+	return result, nil
+}
+
+func (r *OrdersRepo) UserStocks(ctx context.Context, user int64, skus []uint32) (map[uint32][]domain.Stock, error) {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	query := `
+	SELECT s.sku, s.warehouse_id, COALESCE(w.seller_id, 0) AS seller_id, s.count - s.reserved - s.held + COALESCE(h.count, 0) AS count
+	FROM stocks s
+		JOIN warehouses w ON w.id = s.warehouse_id
+		LEFT JOIN (
+			SELECT warehouse_id, sku, SUM(count) AS count FROM stock_holds
+			WHERE user_id = $2 AND sku = ANY($1) GROUP BY warehouse_id, sku
+		) h ON h.warehouse_id = s.warehouse_id AND h.sku = s.sku
+		WHERE s.sku = ANY($1) AND s.count - s.reserved - s.held + COALESCE(h.count, 0) > 0 ORDER BY s.sku, count DESC`
+	var stocks []schema.SkuStock
+	err := pgxscan.Select(ctx, db, &stocks, query, skus, user)
+	if err != nil {
+		return nil, errors.Wrap(err, "exec query stocks")
+	}
+	result := make(map[uint32][]domain.Stock, len(skus))
+	for _, stock := range stocks {
+		result[stock.Sku] = append(result[stock.Sku], domain.Stock{
+			WarehouseID: stock.WarehouseID,
+			SellerID:    stock.SellerID,
+			Count:       stock.Count,
+		})
+	}
+	return result, nil
+}
+
+func isCheckViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == checkViolationCode
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode
+}
@@ -0,0 +1,171 @@
+package repository
+
+import (
+	"context"
+	"route256/loms/internal/domain"
+	"route256/loms/internal/repository/schema"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+)
+
+var _ domain.PaymentsRepository = (*OrdersRepo)(nil)
+
+const (
+	paymentsTable = "payments"
+	refundsTable  = "refunds"
+)
+
+var paymentsColumns = []string{"id", "order_id", "amount", "status", "confirmation_url", "refunded_amount"}
+
+func (r *OrdersRepo) CreatePayment(ctx context.Context, payment *domain.Payment) error {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	query := sq.Insert(paymentsTable).Columns(paymentsColumns...).
+		Values(payment.ID, payment.OrderID, payment.Amount, string(payment.Status), payment.ConfirmationURL, payment.Refunded).
+		PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err := query.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "build query")
+	}
+	_, err = db.Exec(ctx, rawQuery, args...)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return domain.ErrPaymentExists
+		}
+		return errors.Wrap(err, "exec query")
+	}
+	return nil
+}
+
+func (r *OrdersRepo) GetPayment(ctx context.Context, id string) (*domain.Payment, error) {
+	return r.getPayment(ctx, sq.Eq{"id": id})
+}
+
+func (r *OrdersRepo) GetActivePayment(ctx context.Context, orderID int64) (*domain.Payment, error) {
+	return r.getPayment(ctx, sq.Eq{
+		"order_id": orderID,
+		"status":   []string{string(domain.PaymentPending), string(domain.PaymentSucceeded)},
+	})
+}
+
+func (r *OrdersRepo) getPayment(ctx context.Context, where sq.Sqlizer) (*domain.Payment, error) {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	query := sq.Select(paymentsColumns...).From(paymentsTable).
+		Where(where).PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "build query")
+	}
+	var payment schema.Payment
+	err = pgxscan.Get(ctx, db, &payment, rawQuery, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrPaymentNotFound
+		}
+		return nil, errors.Wrap(err, "exec query")
+	}
+	return &domain.Payment{
+		ID:              payment.ID,
+		OrderID:         payment.OrderID,
+		Amount:          payment.Amount,
+		Status:          domain.PaymentStatus(payment.Status),
+		ConfirmationURL: payment.ConfirmationURL,
+		Refunded:        payment.RefundedAmount,
+	}, nil
+}
+
+func (r *OrdersRepo) UpdatePaymentStatus(ctx context.Context, id string, status domain.PaymentStatus, statusBefore domain.PaymentStatus) error {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	query := sq.Update(paymentsTable).Set("status", string(status)).Set("updated_at", sq.Expr("now()")).
+		Where(sq.Eq{"id": id, "status": string(statusBefore)}).PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err := query.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "build query")
+	}
+	cmd, err := db.Exec(ctx, rawQuery, args...)
+	if err != nil {
+		return errors.Wrap(err, "exec query")
+	}
+	if cmd.RowsAffected() == 0 {
+		return domain.ErrPaymentNotFound
+	}
+	return nil
+}
+
+func (r *OrdersRepo) CreateRefund(ctx context.Context, paymentID string, amount uint64) error {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return errors.Wrap(err, "run transaction")
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+	query := sq.Insert(refundsTable).Columns("payment_id", "amount").
+		Values(paymentID, amount).PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err := query.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "build insert query")
+	}
+	_, err = tx.Exec(ctx, rawQuery, args...)
+	if err != nil {
+		return errors.Wrap(err, "exec insert query")
+	}
+	queryUpdate := sq.Update(paymentsTable).Set("refunded_amount", sq.Expr("refunded_amount + ?", amount)).
+		Set("updated_at", sq.Expr("now()")).Where(sq.Eq{"id": paymentID}).PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err = queryUpdate.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "build update query")
+	}
+	cmd, err := tx.Exec(ctx, rawQuery, args...)
+	if err != nil {
+		return errors.Wrap(err, "exec update query")
+	}
+	if cmd.RowsAffected() == 0 {
+		return domain.ErrPaymentNotFound
+	}
+	err = tx.Commit(ctx)
+	if err != nil {
+		return errors.Wrap(err, "commit transaction")
+	}
+	return nil
+}
+
+func (r *OrdersRepo) GetUnsentRefunds(ctx context.Context, limit uint64) ([]domain.Refund, error) {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	query := sq.Select("id", "payment_id", "amount").From(refundsTable).
+		Where(sq.Eq{"sent_at": nil}).OrderBy("id").Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED").PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "build select query")
+	}
+	var refunds []schema.Refund
+	err = pgxscan.Select(ctx, db, &refunds, rawQuery, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "exec query")
+	}
+	result := make([]domain.Refund, 0, len(refunds))
+	for _, refund := range refunds {
+		result = append(result, domain.Refund{ID: refund.ID, PaymentID: refund.PaymentID, Amount: refund.Amount})
+	}
+	return result, nil
+}
+
+func (r *OrdersRepo) MarkRefundsSent(ctx context.Context, ids []int64) error {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	query := sq.Update(refundsTable).Set("sent_at", sq.Expr("now()")).
+		Where(sq.Eq{"id": ids}).PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err := query.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "build update query")
+	}
+	_, err = db.Exec(ctx, rawQuery, args...)
+	if err != nil {
+		return errors.Wrap(err, "exec query")
+	}
+	return nil
+}
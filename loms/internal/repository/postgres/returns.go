@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+	"route256/loms/internal/domain"
+	"route256/loms/internal/repository/schema"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/pkg/errors"
+)
+
+const returnItemsTable = "order_return_items"
+
+func (r *OrdersRepo) CreateReturnItems(ctx context.Context, orderID int64, items []domain.OrderItem) error {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	query := sq.Insert(returnItemsTable).Columns("order_id", "sku", "count", "seller_id").PlaceholderFormat(sq.Dollar)
+	for _, item := range items {
+		query = query.Values(orderID, item.Sku, item.Count, item.SellerID)
+	}
+	rawQuery, args, err := query.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "build query")
+	}
+	_, err = db.Exec(ctx, rawQuery, args...)
+	if err != nil {
+		return errors.Wrap(err, "exec query")
+	}
+	return nil
+}
+
+func (r *OrdersRepo) GetReturnItems(ctx context.Context, orderID int64) ([]domain.OrderItem, error) {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	query := sq.Select("sku", "count", "seller_id").From(returnItemsTable).
+		Where(sq.Eq{"order_id": orderID}).OrderBy("sku", "seller_id").PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "build query")
+	}
+	var items []schema.OrderItem
+	err = pgxscan.Select(ctx, db, &items, rawQuery, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "exec query")
+	}
+	result := make([]domain.OrderItem, 0, len(items))
+	for _, item := range items {
+		result = append(result, domain.OrderItem{Sku: item.Sku, Count: item.Count, SellerID: item.SellerID})
+	}
+	return result, nil
+}
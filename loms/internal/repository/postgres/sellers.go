@@ -0,0 +1,195 @@
+package repository
+
+import (
+	"context"
+	"route256/loms/internal/domain"
+	"route256/loms/internal/repository/schema"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/pkg/errors"
+)
+
+const (
+	sellersTable          = "sellers"
+	sellerOrdersTable     = "seller_orders"
+	sellerOrderItemsTable = "seller_order_items"
+)
+
+func (r *OrdersRepo) CreateSeller(ctx context.Context, name string) (int64, error) {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	query := sq.Insert(sellersTable).Columns("name").Values(name).
+		Suffix("RETURNING id").PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err := query.ToSql()
+	if err != nil {
+		return 0, errors.Wrap(err, "build query")
+	}
+	var id int64
+	err = pgxscan.Get(ctx, db, &id, rawQuery, args...)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return 0, domain.ErrSellerExists
+		}
+		return 0, errors.Wrap(err, "exec query")
+	}
+	return id, nil
+}
+
+func (r *OrdersRepo) CreateSellerOrder(ctx context.Context, order domain.SellerOrder) error {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return errors.Wrap(err, "run transaction")
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+	query := sq.Insert(sellerOrdersTable).Columns("order_id", "seller_id", "status").
+		Values(order.OrderID, order.SellerID, string(order.Status)).
+		Suffix("RETURNING id").PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err := query.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "build seller order query")
+	}
+	var id int64
+	err = pgxscan.Get(ctx, tx, &id, rawQuery, args...)
+	if err != nil {
+		return errors.Wrap(err, "exec seller order query")
+	}
+	query = sq.Insert(sellerOrderItemsTable).Columns("seller_order_id", "sku", "count", "name", "price").
+		PlaceholderFormat(sq.Dollar)
+	for _, item := range order.Items {
+		query = query.Values(id, item.Sku, item.Count, item.Name, item.Price)
+	}
+	rawQuery, args, err = query.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "build items query")
+	}
+	_, err = tx.Exec(ctx, rawQuery, args...)
+	if err != nil {
+		return errors.Wrap(err, "exec items query")
+	}
+	err = tx.Commit(ctx)
+	if err != nil {
+		return errors.Wrap(err, "commit transaction")
+	}
+	return nil
+}
+
+func (r *OrdersRepo) UpdateSellerOrdersStatus(ctx context.Context, orderID int64, sellerIDs []int64, status domain.OrderStatus) error {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	query := sq.Update(sellerOrdersTable).
+		Set("status", string(status)).
+		Set("updated_at", sq.Expr("now()")).
+		Where(sq.Eq{"order_id": orderID}).PlaceholderFormat(sq.Dollar)
+	if len(sellerIDs) > 0 {
+		query = query.Where(sq.Eq{"seller_id": sellerIDs})
+	}
+	rawQuery, args, err := query.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "build query")
+	}
+	_, err = db.Exec(ctx, rawQuery, args...)
+	if err != nil {
+		return errors.Wrap(err, "exec query")
+	}
+	return nil
+}
+
+func (r *OrdersRepo) DeleteSellerOrders(ctx context.Context, orderID int64) error {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return errors.Wrap(err, "run transaction")
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+	itemsQuery := sq.Delete(sellerOrderItemsTable).
+		Where(sq.Expr("seller_order_id IN (SELECT id FROM "+sellerOrdersTable+" WHERE order_id = ?)", orderID)).
+		PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err := itemsQuery.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "build items query")
+	}
+	_, err = tx.Exec(ctx, rawQuery, args...)
+	if err != nil {
+		return errors.Wrap(err, "exec items query")
+	}
+	query := sq.Delete(sellerOrdersTable).Where(sq.Eq{"order_id": orderID}).PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err = query.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "build seller orders query")
+	}
+	_, err = tx.Exec(ctx, rawQuery, args...)
+	if err != nil {
+		return errors.Wrap(err, "exec seller orders query")
+	}
+	err = tx.Commit(ctx)
+	if err != nil {
+		return errors.Wrap(err, "commit transaction")
+	}
+	return nil
+}
+
+func (r *OrdersRepo) ListSellerOrders(ctx context.Context, q domain.SellerOrdersQuery) ([]domain.SellerOrder, error) {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	query := sq.Select("so.id", "so.order_id", "so.seller_id", "so.status", "o.created_at").
+		From(sellerOrdersTable + " so").Join(ordersTable + " o ON o.id = so.order_id").
+		Where(sq.Eq{"so.seller_id": q.SellerID}).
+		OrderBy("so.id DESC").Limit(q.Limit).PlaceholderFormat(sq.Dollar)
+	if q.Status != "" {
+		query = query.Where(sq.Eq{"so.status": string(q.Status)})
+	}
+	if q.BeforeID > 0 {
+		query = query.Where(sq.Lt{"so.id": q.BeforeID})
+	}
+	rawQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "build orders query")
+	}
+	var orders []schema.SellerOrder
+	err = pgxscan.Select(ctx, db, &orders, rawQuery, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "query orders")
+	}
+	if len(orders) == 0 {
+		return nil, nil
+	}
+	ids := make([]int64, 0, len(orders))
+	for _, order := range orders {
+		ids = append(ids, order.ID)
+	}
+
+	//Товары всех заказов страницы загружаем одним запросом
+	itemsQuery := sq.Select("seller_order_id", "sku", "count", "name", "price").From(sellerOrderItemsTable).
+		Where(sq.Eq{"seller_order_id": ids}).OrderBy("seller_order_id", "sku").PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err = itemsQuery.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "build items query")
+	}
+	var items []schema.SellerOrderItem
+	err = pgxscan.Select(ctx, db, &items, rawQuery, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "query items")
+	}
+	orderItems := make(map[int64][]domain.OrderItem, len(orders))
+	for _, item := range items {
+		orderItems[item.SellerOrderID] = append(orderItems[item.SellerOrderID],
+			domain.OrderItem{Sku: item.Sku, Count: item.Count, Name: item.Name, Price: item.Price})
+	}
+	result := make([]domain.SellerOrder, 0, len(orders))
+	for _, order := range orders {
+		result = append(result, domain.SellerOrder{
+			ID:        order.ID,
+			OrderID:   order.OrderID,
+			SellerID:  order.SellerID,
+			Status:    domain.OrderStatus(order.Status),
+			Items:     orderItems[order.ID],
+			CreatedAt: order.CreatedAt,
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,153 @@
+package repository
+
+import (
+	"context"
+	"route256/loms/internal/domain"
+	"route256/loms/internal/repository/schema"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/pkg/errors"
+)
+
+var _ domain.ShipmentsRepository = (*OrdersRepo)(nil)
+
+const (
+	shipmentsTable     = "shipments"
+	shipmentItemsTable = "shipment_items"
+)
+
+var shipmentsColumns = []string{"s.id", "s.order_id", "COALESCE(s.warehouse_id, 0) AS warehouse_id", "COALESCE(w.seller_id, 0) AS seller_id", "s.status", "s.tracking_number"}
+
+func (r *OrdersRepo) CreateShipment(ctx context.Context, shipment *domain.Shipment) (int64, error) {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "run transaction")
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+	//Отправление заказа, проданного до журнала движений, не привязано к складу
+	var warehouseID interface{}
+	if shipment.WarehouseID != 0 {
+		warehouseID = shipment.WarehouseID
+	}
+	query := sq.Insert(shipmentsTable).Columns("order_id", "warehouse_id", "status", "tracking_number").
+		Values(shipment.OrderID, warehouseID, string(shipment.Status), shipment.TrackingNumber).
+		Suffix("RETURNING id").PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err := query.ToSql()
+	if err != nil {
+		return 0, errors.Wrap(err, "build shipment query")
+	}
+	var id int64
+	err = pgxscan.Get(ctx, tx, &id, rawQuery, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "exec shipment query")
+	}
+	query = sq.Insert(shipmentItemsTable).Columns("shipment_id", "sku", "count").PlaceholderFormat(sq.Dollar)
+	for _, item := range shipment.Items {
+		query = query.Values(id, item.Sku, item.Count)
+	}
+	rawQuery, args, err = query.ToSql()
+	if err != nil {
+		return 0, errors.Wrap(err, "build items query")
+	}
+	_, err = tx.Exec(ctx, rawQuery, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "exec items query")
+	}
+	err = tx.Commit(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "commit transaction")
+	}
+	return id, nil
+}
+
+func (r *OrdersRepo) GetShipment(ctx context.Context, id int64) (*domain.Shipment, error) {
+	shipments, err := r.getShipments(ctx, sq.Eq{"s.id": id})
+	if err != nil {
+		return nil, err
+	}
+	if len(shipments) == 0 {
+		return nil, domain.ErrShipmentNotFound
+	}
+	return &shipments[0], nil
+}
+
+func (r *OrdersRepo) GetOrderShipments(ctx context.Context, orderID int64) ([]domain.Shipment, error) {
+	return r.getShipments(ctx, sq.Eq{"s.order_id": orderID})
+}
+
+func (r *OrdersRepo) UpdateShipment(ctx context.Context, shipment *domain.Shipment, statusBefore domain.ShipmentStatus) error {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	query := sq.Update(shipmentsTable).
+		Set("status", string(shipment.Status)).
+		Set("tracking_number", shipment.TrackingNumber).
+		Set("updated_at", sq.Expr("now()")).
+		Where(sq.Eq{"id": shipment.ID, "status": string(statusBefore)}).PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err := query.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "build query")
+	}
+	cmd, err := db.Exec(ctx, rawQuery, args...)
+	if err != nil {
+		return errors.Wrap(err, "exec query")
+	}
+	if cmd.RowsAffected() == 0 {
+		return domain.ErrShipmentNotFound
+	}
+	return nil
+}
+
+func (r *OrdersRepo) getShipments(ctx context.Context, where sq.Eq) ([]domain.Shipment, error) {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	query := sq.Select(shipmentsColumns...).From(shipmentsTable + " s").
+		LeftJoin(warehousesTable + " w ON w.id = s.warehouse_id").
+		Where(where).OrderBy("s.id").PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "build shipments query")
+	}
+	var shipments []schema.Shipment
+	err = pgxscan.Select(ctx, db, &shipments, rawQuery, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "query shipments")
+	}
+	if len(shipments) == 0 {
+		return nil, nil
+	}
+	ids := make([]int64, 0, len(shipments))
+	for _, shipment := range shipments {
+		ids = append(ids, shipment.ID)
+	}
+	query = sq.Select("shipment_id", "sku", "count").From(shipmentItemsTable).
+		Where(sq.Eq{"shipment_id": ids}).OrderBy("shipment_id", "sku").PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err = query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "build items query")
+	}
+	var items []schema.ShipmentItem
+	err = pgxscan.Select(ctx, db, &items, rawQuery, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "query items")
+	}
+	shipmentItems := make(map[int64][]domain.OrderItem, len(shipments))
+	for _, item := range items {
+		shipmentItems[item.ShipmentID] = append(shipmentItems[item.ShipmentID], domain.OrderItem{Sku: item.Sku, Count: item.Count})
+	}
+	result := make([]domain.Shipment, 0, len(shipments))
+	for _, shipment := range shipments {
+		result = append(result, domain.Shipment{
+			ID:             shipment.ID,
+			OrderID:        shipment.OrderID,
+			WarehouseID:    shipment.WarehouseID,
+			SellerID:       shipment.SellerID,
+			Status:         domain.ShipmentStatus(shipment.Status),
+			TrackingNumber: shipment.TrackingNumber,
+			Items:          shipmentItems[shipment.ID],
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"route256/loms/internal/domain"
+	"route256/loms/internal/repository/schema"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/pkg/errors"
+)
+
+const shortagesTable = "order_shortages"
+
+func (r *OrdersRepo) CreateShortages(ctx context.Context, orderID int64, shortages []domain.ItemShortage) error {
+	if len(shortages) == 0 {
+		return nil
+	}
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	query := sq.Insert(shortagesTable).Columns("order_id", "sku", "seller_id", "requested", "missing").PlaceholderFormat(sq.Dollar)
+	for _, shortage := range shortages {
+		query = query.Values(orderID, shortage.Sku, shortage.SellerID, shortage.Requested, shortage.Missing)
+	}
+	rawQuery, args, err := query.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "build query")
+	}
+	_, err = db.Exec(ctx, rawQuery, args...)
+	if err != nil {
+		return errors.Wrap(err, "exec query")
+	}
+	return nil
+}
+
+func (r *OrdersRepo) GetShortages(ctx context.Context, orderID int64) ([]domain.ItemShortage, error) {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	query := sq.Select("sku", "seller_id", "requested", "missing").From(shortagesTable).
+		Where(sq.Eq{"order_id": orderID}).OrderBy("sku", "seller_id").PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "build query")
+	}
+	var shortages []schema.ItemShortage
+	err = pgxscan.Select(ctx, db, &shortages, rawQuery, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "exec query")
+	}
+	result := make([]domain.ItemShortage, 0, len(shortages))
+	for _, shortage := range shortages {
+		result = append(result, domain.ItemShortage{
+			Sku:       shortage.Sku,
+			SellerID:  shortage.SellerID,
+			Requested: shortage.Requested,
+			Missing:   shortage.Missing,
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"context"
+	"route256/loms/internal/domain"
+	"route256/loms/internal/repository/schema"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/pkg/errors"
+)
+
+const stockChangesTable = "stock_change_notifications"
+
+func (r *OrdersRepo) GetUnsentStockChanges(ctx context.Context, limit uint64) ([]domain.StockChangeNotification, error) {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	query := sq.Select("id", "warehouse_id", "sku", "delta").From(stockChangesTable).
+		Where(sq.Eq{"sent_at": nil}).OrderBy("id").Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED").PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "build select query")
+	}
+	var changes []schema.StockChangeNotification
+	err = pgxscan.Select(ctx, db, &changes, rawQuery, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "exec query")
+	}
+	result := make([]domain.StockChangeNotification, 0, len(changes))
+	for _, change := range changes {
+		result = append(result, domain.StockChangeNotification{
+			ID: change.ID,
+			Change: domain.StockChange{
+				WarehouseID: change.WarehouseID,
+				Sku:         change.Sku,
+				Delta:       change.Delta,
+			},
+		})
+	}
+	return result, nil
+}
+
+func (r *OrdersRepo) MarkStockChangesSent(ctx context.Context, ids []int64) error {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	query := sq.Update(stockChangesTable).Set("sent_at", sq.Expr("now()")).
+		Where(sq.Eq{"id": ids}).PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err := query.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "build update query")
+	}
+	_, err = db.Exec(ctx, rawQuery, args...)
+	if err != nil {
+		return errors.Wrap(err, "exec query")
+	}
+	return nil
+}
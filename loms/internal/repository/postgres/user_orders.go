@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"route256/loms/internal/domain"
+	"route256/loms/internal/repository/schema"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/pkg/errors"
+)
+
+func (r *OrdersRepo) ListUserOrders(ctx context.Context, q domain.UserOrdersQuery) ([]*domain.Order, error) {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	query := sq.Select(ordersColumns...).From(ordersTable).
+		Where(sq.Eq{"user_id": q.User}).
+		OrderBy("id DESC").Limit(q.Limit).PlaceholderFormat(sq.Dollar)
+	if q.Status != "" {
+		query = query.Where(sq.Eq{"status": string(q.Status)})
+	}
+	if !q.CreatedFrom.IsZero() {
+		query = query.Where(sq.GtOrEq{"created_at": q.CreatedFrom})
+	}
+	if !q.CreatedTo.IsZero() {
+		query = query.Where(sq.Lt{"created_at": q.CreatedTo})
+	}
+	if q.BeforeID > 0 {
+		query = query.Where(sq.Lt{"id": q.BeforeID})
+	}
+	rawQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "build orders query")
+	}
+	var orders []schema.Order
+	err = pgxscan.Select(ctx, db, &orders, rawQuery, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "query orders")
+	}
+	if len(orders) == 0 {
+		return nil, nil
+	}
+
+	result := make([]*domain.Order, 0, len(orders))
+	byID := make(map[int64]*domain.Order, len(orders))
+	ids := make([]int64, 0, len(orders))
+	for _, order := range orders {
+		o := &domain.Order{
+			ID:                   order.ID,
+			User:                 order.User,
+			Status:               domain.OrderStatus(order.Status),
+			CreatedAt:            order.CreatedAt,
+			PreferredWarehouseID: order.PreferredWarehouseID,
+			AllowBackorder:       order.AllowBackorder,
+		}
+		result = append(result, o)
+		byID[order.ID] = o
+		ids = append(ids, order.ID)
+	}
+
+	//Товары всех заказов страницы загружаем одним запросом
+	itemsQuery := sq.Select("order_id", "sku", "count", "seller_id", "name", "price").From(itemsTable).
+		Where(sq.Eq{"order_id": ids}).OrderBy("order_id").PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err = itemsQuery.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "build items query")
+	}
+	var items []schema.OrderedItem
+	err = pgxscan.Select(ctx, db, &items, rawQuery, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "query items")
+	}
+	for _, item := range items {
+		order := byID[item.OrderID]
+		order.Items = append(order.Items, domain.OrderItem{Sku: item.Sku, Count: item.Count, SellerID: item.SellerID, Name: item.Name, Price: item.Price})
+	}
+	return result, nil
+}
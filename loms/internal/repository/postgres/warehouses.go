@@ -0,0 +1,264 @@
+package repository
+
+import (
+	"context"
+	"route256/loms/internal/domain"
+	"route256/loms/internal/repository/schema"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+)
+
+var _ domain.WarehousesRepository = (*OrdersRepo)(nil)
+
+const (
+	warehousesTable     = "warehouses"
+	stockMovementsTable = "stock_movements"
+
+	foreignKeyViolationCode = "23503"
+	numericOutOfRangeCode   = "22003"
+)
+
+func (r *OrdersRepo) CreateWarehouse(ctx context.Context, name string, sellerID int64) (int64, error) {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	//Склады маркетплейса хранятся без продавца
+	var seller interface{}
+	if sellerID != 0 {
+		seller = sellerID
+	}
+	query := sq.Insert(warehousesTable).Columns("name", "seller_id").Values(name, seller).
+		Suffix("RETURNING id").PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err := query.ToSql()
+	if err != nil {
+		return 0, errors.Wrap(err, "build query")
+	}
+	var id int64
+	err = pgxscan.Get(ctx, db, &id, rawQuery, args...)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return 0, domain.ErrWarehouseExists
+		}
+		if isForeignKeyViolation(err) {
+			return 0, domain.ErrSellerNotFound
+		}
+		return 0, errors.Wrap(err, "exec query")
+	}
+	return id, nil
+}
+
+func (r *OrdersRepo) ChangeStock(ctx context.Context, movement domain.StockMovement) error {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return errors.Wrap(err, "run transaction")
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+	//Проверка stocks_reserved_check не даст опустить остаток ниже резерва
+	var queryStock sq.Sqlizer
+	if movement.Delta < 0 {
+		//Списывать можно только существующий остаток
+		queryStock = sq.Update(stocksTable).Set("count", sq.Expr("count + ?", movement.Delta)).
+			Where(sq.Eq{"warehouse_id": movement.WarehouseID, "sku": movement.Sku}).PlaceholderFormat(sq.Dollar)
+	} else {
+		queryStock = sq.Insert(stocksTable).Columns("warehouse_id", "sku", "count").
+			Values(movement.WarehouseID, movement.Sku, movement.Delta).
+			Suffix("ON CONFLICT(warehouse_id, sku) DO UPDATE SET count = stocks.count + EXCLUDED.count").
+			PlaceholderFormat(sq.Dollar)
+	}
+	rawQuery, args, err := queryStock.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "build stock query")
+	}
+	tag, err := tx.Exec(ctx, rawQuery, args...)
+	if err != nil {
+		if isCheckViolation(err) {
+			return domain.ErrStockBelowReserved
+		}
+		if isForeignKeyViolation(err) {
+			return domain.ErrWarehouseNotFound
+		}
+		if isNumericOutOfRange(err) {
+			return domain.ErrStockChangeOutOfRange
+		}
+		return errors.Wrap(err, "exec stock query")
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrStockNotFound
+	}
+	for _, query := range movementQueries(movement) {
+		rawQuery, args, err = query.ToSql()
+		if err != nil {
+			return errors.Wrap(err, "build movement query")
+		}
+		_, err = tx.Exec(ctx, rawQuery, args...)
+		if err != nil {
+			return errors.Wrap(err, "exec movement query")
+		}
+	}
+	err = tx.Commit(ctx)
+	if err != nil {
+		return errors.Wrap(err, "commit transaction")
+	}
+	return nil
+}
+
+func (r *OrdersRepo) ListWarehouseStock(ctx context.Context, warehouseID int64) ([]domain.WarehouseStock, error) {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	query := sq.Select("id").From(warehousesTable).
+		Where(sq.Eq{"id": warehouseID}).PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "build warehouse query")
+	}
+	var id int64
+	err = pgxscan.Get(ctx, db, &id, rawQuery, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrWarehouseNotFound
+		}
+		return nil, errors.Wrap(err, "query warehouse")
+	}
+
+	query = sq.Select("sku", "count", "reserved", "held").From(stocksTable).
+		Where(sq.Eq{"warehouse_id": warehouseID}).OrderBy("sku").PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err = query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "build stocks query")
+	}
+	var stocks []schema.WarehouseStock
+	err = pgxscan.Select(ctx, db, &stocks, rawQuery, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "query stocks")
+	}
+	result := make([]domain.WarehouseStock, 0, len(stocks))
+	for _, stock := range stocks {
+		result = append(result, domain.WarehouseStock{
+			Sku:      stock.Sku,
+			Count:    stock.Count,
+			Reserved: stock.Reserved,
+			Held:     stock.Held,
+		})
+	}
+	return result, nil
+}
+
+func (r *OrdersRepo) StockDrifts(ctx context.Context) ([]domain.StockDrift, error) {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	query := `
+	WITH ledger AS (
+		SELECT warehouse_id, sku,
+			COALESCE(SUM(delta) FILTER (WHERE kind IN ('receive', 'adjust', 'sell')), 0) AS count,
+			COALESCE(SUM(delta) FILTER (WHERE kind IN ('reserve', 'release', 'sell')), 0) AS reserved,
+			COALESCE(SUM(delta) FILTER (WHERE kind IN ('hold', 'release_hold')), 0) AS held
+		FROM stock_movements GROUP BY warehouse_id, sku
+	), reservations AS (
+		SELECT warehouse_id, sku, SUM(count) AS count FROM reserved_items GROUP BY warehouse_id, sku
+	), holds AS (
+		SELECT warehouse_id, sku, SUM(count) AS count FROM stock_holds GROUP BY warehouse_id, sku
+	), keys AS (
+		SELECT warehouse_id, sku FROM stocks
+		UNION SELECT warehouse_id, sku FROM ledger
+		UNION SELECT warehouse_id, sku FROM reservations
+		UNION SELECT warehouse_id, sku FROM holds
+	)
+	SELECT k.warehouse_id, k.sku,
+		COALESCE(s.count, 0) AS count,
+		COALESCE(s.reserved, 0) AS reserved,
+		COALESCE(s.held, 0) AS held,
+		COALESCE(l.count, 0) AS ledger_count,
+		COALESCE(l.reserved, 0) AS ledger_reserved,
+		COALESCE(l.held, 0) AS ledger_held,
+		COALESCE(r.count, 0) AS reserved_items,
+		COALESCE(h.count, 0) AS holds
+	FROM keys k
+	LEFT JOIN stocks s ON s.warehouse_id = k.warehouse_id AND s.sku = k.sku
+	LEFT JOIN ledger l ON l.warehouse_id = k.warehouse_id AND l.sku = k.sku
+	LEFT JOIN reservations r ON r.warehouse_id = k.warehouse_id AND r.sku = k.sku
+	LEFT JOIN holds h ON h.warehouse_id = k.warehouse_id AND h.sku = k.sku
+	WHERE COALESCE(s.count, 0) <> COALESCE(l.count, 0)
+		OR COALESCE(s.reserved, 0) <> COALESCE(l.reserved, 0)
+		OR COALESCE(s.reserved, 0) <> COALESCE(r.count, 0)
+		OR COALESCE(s.held, 0) <> COALESCE(l.held, 0)
+		OR COALESCE(s.held, 0) <> COALESCE(h.count, 0)
+	ORDER BY k.warehouse_id, k.sku`
+	var drifts []schema.StockDrift
+	err := pgxscan.Select(ctx, db, &drifts, query)
+	if err != nil {
+		return nil, errors.Wrap(err, "exec query stock drifts")
+	}
+	result := make([]domain.StockDrift, 0, len(drifts))
+	for _, drift := range drifts {
+		result = append(result, domain.StockDrift{
+			WarehouseID:    drift.WarehouseID,
+			Sku:            drift.Sku,
+			Count:          drift.Count,
+			Reserved:       drift.Reserved,
+			Held:           drift.Held,
+			LedgerCount:    drift.LedgerCount,
+			LedgerReserved: drift.LedgerReserved,
+			LedgerHeld:     drift.LedgerHeld,
+			ReservedItems:  drift.ReservedItems,
+			Holds:          drift.Holds,
+		})
+	}
+	return result, nil
+}
+
+func (r *OrdersRepo) SoldItems(ctx context.Context, orderID int64) ([]domain.ReservedItem, error) {
+	db := r.QueryEngineProvider.GetQueryEngine(ctx)
+	query := sq.Select("m.warehouse_id", "COALESCE(w.seller_id, 0) AS seller_id", "m.sku", "-SUM(m.delta) AS count").
+		From(stockMovementsTable+" m").Join(warehousesTable+" w ON w.id = m.warehouse_id").
+		Where(sq.Eq{"m.order_id": orderID, "m.kind": string(domain.MovementSell)}).
+		GroupBy("m.warehouse_id", "w.seller_id", "m.sku").Having("SUM(m.delta) <> 0").OrderBy("m.warehouse_id", "m.sku").
+		PlaceholderFormat(sq.Dollar)
+	rawQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "build query")
+	}
+	var items []schema.SoldedItem
+	err = pgxscan.Select(ctx, db, &items, rawQuery, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "exec query")
+	}
+	result := make([]domain.ReservedItem, 0, len(items))
+	for _, item := range items {
+		result = append(result, domain.ReservedItem{
+			OrderItem:   domain.OrderItem{Sku: item.Sku, Count: item.Count, SellerID: item.SellerID},
+			WarehouseID: item.WarehouseID,
+		})
+	}
+	return result, nil
+}
+
+func movementQueries(movement domain.StockMovement) []sq.InsertBuilder {
+	var orderID interface{}
+	if movement.OrderID != 0 {
+		orderID = movement.OrderID
+	}
+	queries := []sq.InsertBuilder{
+		sq.Insert(stockMovementsTable).Columns("warehouse_id", "sku", "kind", "delta", "reason", "order_id").
+			Values(movement.WarehouseID, movement.Sku, string(movement.Kind), movement.Delta, movement.Reason, orderID).
+			PlaceholderFormat(sq.Dollar),
+	}
+	if delta := movement.AvailableDelta(); delta != 0 {
+		queries = append(queries, sq.Insert(stockChangesTable).Columns("warehouse_id", "sku", "delta").
+			Values(movement.WarehouseID, movement.Sku, delta).PlaceholderFormat(sq.Dollar))
+	}
+	return queries
+}
+
+func isForeignKeyViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationCode
+}
+
+func isNumericOutOfRange(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == numericOutOfRangeCode
+}
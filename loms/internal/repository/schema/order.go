@@ -1,18 +1,135 @@
 package schema
 
+import "time"
+
 type Order struct {
-	ID     int64  `db:"id"`
-	Status string `db:"status"`
-	User   int64  `db:"user_id"`
+	ID                   int64     `db:"id"`
+	Status               string    `db:"status"`
+	User                 int64     `db:"user_id"`
+	CreatedAt            time.Time `db:"created_at"`
+	PreferredWarehouseID int64     `db:"preferred_warehouse_id"`
+	AllowBackorder       bool      `db:"allow_backorder"`
 }
 
 type OrderItem struct {
-	Sku   uint32 `db:"sku"`
-	Count uint16 `db:"count"`
+	Sku      uint32 `db:"sku"`
+	Count    uint16 `db:"count"`
+	SellerID int64  `db:"seller_id"`
+	Name     string `db:"name"`
+	Price    uint32 `db:"price"`
+}
+
+type OrderedItem struct {
+	OrderID  int64  `db:"order_id"`
+	Sku      uint32 `db:"sku"`
+	Count    uint16 `db:"count"`
+	SellerID int64  `db:"seller_id"`
+	Name     string `db:"name"`
+	Price    uint32 `db:"price"`
 }
 
 type SoldedItem struct {
 	Sku         uint32 `db:"sku"`
 	Count       uint16 `db:"count"`
 	WarehouseID int64  `db:"warehouse_id"`
+	SellerID    int64  `db:"seller_id"`
+}
+
+type Backorder struct {
+	OrderID  int64  `db:"order_id"`
+	Sku      uint32 `db:"sku"`
+	SellerID int64  `db:"seller_id"`
+	Count    uint16 `db:"count"`
+}
+
+type ItemShortage struct {
+	Sku       uint32 `db:"sku"`
+	SellerID  int64  `db:"seller_id"`
+	Requested uint64 `db:"requested"`
+	Missing   uint64 `db:"missing"`
+}
+
+type OrderNotification struct {
+	ID      int64  `db:"id"`
+	Payload []byte `db:"payload"`
+}
+
+type NotificationPayload struct {
+	ID     int64              `json:"id"`
+	Status string             `json:"status"`
+	User   int64              `json:"user"`
+	Items  []NotificationItem `json:"items"`
+	//Заполняется для собираемых, отправленных и доставленных заказов
+	Shipments []NotificationShipment `json:"shipments,omitempty"`
+}
+
+type NotificationItem struct {
+	Sku      uint32 `json:"sku"`
+	Count    uint16 `json:"count"`
+	SellerID int64  `json:"seller_id,omitempty"`
+	Name     string `json:"name,omitempty"`
+	Price    uint32 `json:"price,omitempty"`
+}
+
+type StatusChange struct {
+	OrderID   int64     `db:"order_id"`
+	OldStatus string    `db:"old_status"`
+	NewStatus string    `db:"new_status"`
+	Reason    string    `db:"reason"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+type Payment struct {
+	ID              string `db:"id"`
+	OrderID         int64  `db:"order_id"`
+	Amount          uint64 `db:"amount"`
+	Status          string `db:"status"`
+	ConfirmationURL string `db:"confirmation_url"`
+	RefundedAmount  uint64 `db:"refunded_amount"`
+}
+
+type Refund struct {
+	ID        int64  `db:"id"`
+	PaymentID string `db:"payment_id"`
+	Amount    uint64 `db:"amount"`
+}
+
+type Shipment struct {
+	ID             int64  `db:"id"`
+	OrderID        int64  `db:"order_id"`
+	WarehouseID    int64  `db:"warehouse_id"`
+	SellerID       int64  `db:"seller_id"`
+	Status         string `db:"status"`
+	TrackingNumber string `db:"tracking_number"`
+}
+
+type ShipmentItem struct {
+	ShipmentID int64  `db:"shipment_id"`
+	Sku        uint32 `db:"sku"`
+	Count      uint16 `db:"count"`
+}
+
+type SellerOrder struct {
+	ID        int64     `db:"id"`
+	OrderID   int64     `db:"order_id"`
+	SellerID  int64     `db:"seller_id"`
+	Status    string    `db:"status"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+type SellerOrderItem struct {
+	SellerOrderID int64  `db:"seller_order_id"`
+	Sku           uint32 `db:"sku"`
+	Count         uint16 `db:"count"`
+	Name          string `db:"name"`
+	Price         uint32 `db:"price"`
+}
+
+type NotificationShipment struct {
+	ID             int64              `json:"id"`
+	WarehouseID    int64              `json:"warehouse_id"`
+	SellerID       int64              `json:"seller_id,omitempty"`
+	Status         string             `json:"status"`
+	TrackingNumber string             `json:"tracking_number,omitempty"`
+	Items          []NotificationItem `json:"items"`
 }
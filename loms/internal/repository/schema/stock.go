@@ -2,5 +2,40 @@ package schema
 
 type Stock struct {
 	WarehouseID int64  `db:"warehouse_id"`
+	SellerID    int64  `db:"seller_id"`
 	Count       uint64 `db:"count"`
 }
+
+type SkuStock struct {
+	Sku         uint32 `db:"sku"`
+	WarehouseID int64  `db:"warehouse_id"`
+	SellerID    int64  `db:"seller_id"`
+	Count       uint64 `db:"count"`
+}
+
+type WarehouseStock struct {
+	Sku      uint32 `db:"sku"`
+	Count    uint64 `db:"count"`
+	Reserved uint64 `db:"reserved"`
+	Held     uint64 `db:"held"`
+}
+
+type StockDrift struct {
+	WarehouseID    int64  `db:"warehouse_id"`
+	Sku            uint32 `db:"sku"`
+	Count          int64  `db:"count"`
+	Reserved       int64  `db:"reserved"`
+	Held           int64  `db:"held"`
+	LedgerCount    int64  `db:"ledger_count"`
+	LedgerReserved int64  `db:"ledger_reserved"`
+	LedgerHeld     int64  `db:"ledger_held"`
+	ReservedItems  int64  `db:"reserved_items"`
+	Holds          int64  `db:"holds"`
+}
+
+type StockChangeNotification struct {
+	ID          int64  `db:"id"`
+	WarehouseID int64  `db:"warehouse_id"`
+	Sku         uint32 `db:"sku"`
+	Delta       int64  `db:"delta"`
+}
@@ -0,0 +1,79 @@
+package reserver
+
+import (
+	"context"
+	"route256/libs/logger"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	defaultWorkers      = 4
+	defaultPollInterval = 500 * time.Millisecond
+	jobTimeout          = 5 * time.Second
+)
+
+type OrdersReserver interface {
+	ReserveNextOrder(ctx context.Context) (bool, error)
+}
+
+type Config struct {
+	Workers      uint16
+	PollInterval time.Duration
+}
+
+type Pool struct {
+	reserver OrdersReserver
+	config   Config
+}
+
+func New(reserver OrdersReserver, config Config) *Pool {
+	if config.Workers == 0 {
+		config.Workers = defaultWorkers
+	}
+	if config.PollInterval == 0 {
+		config.PollInterval = defaultPollInterval
+	}
+	return &Pool{
+		reserver: reserver,
+		config:   config,
+	}
+}
+
+func (p *Pool) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	wg.Add(int(p.config.Workers))
+	for i := 0; i < int(p.config.Workers); i++ {
+		go func() {
+			defer wg.Done()
+			p.work(ctx)
+		}()
+	}
+	wg.Wait()
+}
+
+func (p *Pool) work(ctx context.Context) {
+	for {
+		//Разбираем очередь, пока есть заказы
+		for ctx.Err() == nil && p.reserveNext() {
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(p.config.PollInterval):
+		}
+	}
+}
+
+func (p *Pool) reserveNext() bool {
+	//Не используем контекст приложения, чтобы при остановке дождаться завершения начатого резервирования
+	ctx, cancel := context.WithTimeout(context.Background(), jobTimeout)
+	defer cancel()
+	processed, err := p.reserver.ReserveNextOrder(ctx)
+	if err != nil {
+		logger.Error(ctx, "reserve order", zap.Error(err))
+	}
+	return processed
+}
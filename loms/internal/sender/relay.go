@@ -0,0 +1,131 @@
+package sender
+
+import (
+	"context"
+	"route256/libs/logger"
+	transactor "route256/libs/postgres_transactor"
+	"route256/loms/internal/domain"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultInterval  = time.Second
+	defaultBatchSize = 100
+	defaultRetries   = 3
+	retryBackoff     = 100 * time.Millisecond
+)
+
+type TransactionManager interface {
+	RunTransaction(ctx context.Context, isoLevel string, f func(ctxTX context.Context) error) error
+}
+
+type OutboxRepository interface {
+	GetUnsentOrderNotifications(ctx context.Context, limit uint64) ([]domain.OrderNotification, error)
+	MarkOrderNotificationsSent(ctx context.Context, ids []int64) error
+}
+
+type RelayConfig struct {
+	Interval  time.Duration
+	BatchSize uint64
+	Retries   uint8
+}
+
+type Relay struct {
+	repo   OutboxRepository
+	tm     TransactionManager
+	sender *orderSender
+	config RelayConfig
+}
+
+func NewRelay(repo OutboxRepository, tm TransactionManager, sender *orderSender, config RelayConfig) *Relay {
+	if config.Interval == 0 {
+		config.Interval = defaultInterval
+	}
+	if config.BatchSize == 0 {
+		config.BatchSize = defaultBatchSize
+	}
+	if config.Retries == 0 {
+		config.Retries = defaultRetries
+	}
+	return &Relay{
+		repo:   repo,
+		tm:     tm,
+		sender: sender,
+		config: config,
+	}
+}
+
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.config.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := r.relay(ctx)
+			if err != nil {
+				logger.Error(ctx, "relay order notifications", zap.Error(err))
+			}
+		}
+	}
+}
+
+func (r *Relay) relay(ctx context.Context) error {
+	var sendErr error
+	err := r.tm.RunTransaction(ctx, transactor.IsoLevelReadCommitted, func(ctxTX context.Context) error {
+		notifications, err := r.repo.GetUnsentOrderNotifications(ctxTX, r.config.BatchSize)
+		if err != nil {
+			return errors.Wrap(err, "get unsent notifications")
+		}
+		sent := make([]int64, 0, len(notifications))
+		for _, notification := range notifications {
+			//Останавливаемся на первой ошибке, чтобы не нарушить порядок событий по заказу
+			sendErr = r.send(ctx, notification.Order)
+			if sendErr != nil {
+				break
+			}
+			sent = append(sent, notification.ID)
+		}
+		if len(sent) == 0 {
+			return nil
+		}
+		err = r.repo.MarkOrderNotificationsSent(ctxTX, sent)
+		if err != nil {
+			return errors.Wrap(err, "mark notifications sent")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return sendErr
+}
+
+func (r *Relay) send(ctx context.Context, order *domain.Order) error {
+	err := withRetries(ctx, r.config.Retries, func() error {
+		return r.sender.SendOrder(order)
+	})
+	return errors.Wrap(err, "send order")
+}
+
+func withRetries(ctx context.Context, retries uint8, f func() error) error {
+	var err error
+	for attempt := uint8(0); attempt < retries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(retryBackoff << (attempt - 1)):
+			}
+		}
+		err = f()
+		if err == nil {
+			return nil
+		}
+	}
+	return err
+}
@@ -14,8 +14,6 @@ import (
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
-var _ domain.NotificationsSender = (*orderSender)(nil)
-
 type orderSender struct {
 	producer sarama.SyncProducer
 	topic    string
@@ -30,18 +28,13 @@ func NewOrderSender(producer sarama.SyncProducer, topic string) *orderSender {
 
 func (s *orderSender) SendOrder(order *domain.Order) error {
 	orderpb := &desc.Order{
-		Id:     order.ID,
-		Status: loms.StatusToStatusCode(order.Status),
-		User:   order.User,
-	}
-	items := make([]*desc.Item, 0, len(order.Items))
-	for _, item := range order.Items {
-		items = append(items, &desc.Item{
-			Sku:   item.Sku,
-			Count: uint32(item.Count),
-		})
+		Id:         order.ID,
+		Status:     loms.StatusToStatusCode(order.Status),
+		User:       order.User,
+		Items:      loms.ItemsToProto(order.Items),
+		TotalPrice: order.TotalPrice(),
+		Shipments:  loms.ShipmentsToProto(order.Shipments),
 	}
-	orderpb.Items = items
 	bytes, err := protojson.Marshal(orderpb)
 	if err != nil {
 		return errors.Wrap(err, "marshal order")
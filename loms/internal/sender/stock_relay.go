@@ -0,0 +1,92 @@
+package sender
+
+import (
+	"context"
+	"route256/libs/logger"
+	transactor "route256/libs/postgres_transactor"
+	"route256/loms/internal/domain"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+type StockOutboxRepository interface {
+	GetUnsentStockChanges(ctx context.Context, limit uint64) ([]domain.StockChangeNotification, error)
+	MarkStockChangesSent(ctx context.Context, ids []int64) error
+}
+
+type StockRelay struct {
+	repo   StockOutboxRepository
+	tm     TransactionManager
+	sender *stockSender
+	config RelayConfig
+}
+
+func NewStockRelay(repo StockOutboxRepository, tm TransactionManager, sender *stockSender, config RelayConfig) *StockRelay {
+	if config.Interval == 0 {
+		config.Interval = defaultInterval
+	}
+	if config.BatchSize == 0 {
+		config.BatchSize = defaultBatchSize
+	}
+	if config.Retries == 0 {
+		config.Retries = defaultRetries
+	}
+	return &StockRelay{
+		repo:   repo,
+		tm:     tm,
+		sender: sender,
+		config: config,
+	}
+}
+
+func (r *StockRelay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.config.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := r.relay(ctx)
+			if err != nil {
+				logger.Error(ctx, "relay stock changes", zap.Error(err))
+			}
+		}
+	}
+}
+
+func (r *StockRelay) relay(ctx context.Context) error {
+	var sendErr error
+	err := r.tm.RunTransaction(ctx, transactor.IsoLevelReadCommitted, func(ctxTX context.Context) error {
+		changes, err := r.repo.GetUnsentStockChanges(ctxTX, r.config.BatchSize)
+		if err != nil {
+			return errors.Wrap(err, "get unsent stock changes")
+		}
+		sent := make([]int64, 0, len(changes))
+		for _, change := range changes {
+			change := change
+			sendErr = withRetries(ctx, r.config.Retries, func() error {
+				return r.sender.SendStockChange(change.Change)
+			})
+			if sendErr != nil {
+				sendErr = errors.Wrap(sendErr, "send stock change")
+				break
+			}
+			sent = append(sent, change.ID)
+		}
+		if len(sent) == 0 {
+			return nil
+		}
+		err = r.repo.MarkStockChangesSent(ctxTX, sent)
+		if err != nil {
+			return errors.Wrap(err, "mark stock changes sent")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return sendErr
+}
@@ -0,0 +1,54 @@
+package sender
+
+import (
+	"fmt"
+	"route256/libs/logger"
+	"route256/loms/internal/domain"
+	desc "route256/loms/pkg/loms/v1"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+type stockSender struct {
+	producer sarama.SyncProducer
+	topic    string
+}
+
+func NewStockSender(producer sarama.SyncProducer, topic string) *stockSender {
+	return &stockSender{
+		producer: producer,
+		topic:    topic,
+	}
+}
+
+func (s *stockSender) SendStockChange(change domain.StockChange) error {
+	bytes, err := protojson.Marshal(&desc.StockChange{
+		WarehouseID: change.WarehouseID,
+		Sku:         change.Sku,
+		Delta:       change.Delta,
+	})
+	if err != nil {
+		return errors.Wrap(err, "marshal stock change")
+	}
+
+	//Ключ по sku сохраняет порядок изменений одного товара
+	msg := &sarama.ProducerMessage{
+		Topic:     s.topic,
+		Partition: -1,
+		Value:     sarama.ByteEncoder(bytes),
+		Key:       sarama.StringEncoder(fmt.Sprint(change.Sku)),
+		Timestamp: time.Now(),
+	}
+
+	partition, offset, err := s.producer.SendMessage(msg)
+	if err != nil {
+		return errors.Wrap(err, "send message")
+	}
+
+	logger.Debug("stock change", zap.Uint32("sku", change.Sku), zap.Int32("partition", partition), zap.Int64("offset", offset))
+	return nil
+}
@@ -0,0 +1,89 @@
+package stockwatch
+
+import (
+	"context"
+	"route256/libs/logger"
+	"route256/loms/internal/domain"
+	desc "route256/loms/pkg/loms/v1"
+	"sync"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const subscriberBuffer = 100
+
+type Hub struct {
+	mu          sync.Mutex
+	subscribers map[*subscriber]struct{}
+}
+
+type subscriber struct {
+	skus    map[uint32]struct{}
+	changes chan domain.StockChange
+}
+
+func New() *Hub {
+	return &Hub{
+		subscribers: make(map[*subscriber]struct{}),
+	}
+}
+
+func (h *Hub) Subscribe(skus []uint32) (<-chan domain.StockChange, func()) {
+	sub := &subscriber{
+		changes: make(chan domain.StockChange, subscriberBuffer),
+	}
+	if len(skus) > 0 {
+		sub.skus = make(map[uint32]struct{}, len(skus))
+		for _, sku := range skus {
+			sub.skus[sku] = struct{}{}
+		}
+	}
+	h.mu.Lock()
+	h.subscribers[sub] = struct{}{}
+	h.mu.Unlock()
+	return sub.changes, func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		h.remove(sub)
+	}
+}
+
+func (h *Hub) Publish(change domain.StockChange) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for sub := range h.subscribers {
+		if sub.skus != nil {
+			if _, ok := sub.skus[change.Sku]; !ok {
+				continue
+			}
+		}
+		select {
+		case sub.changes <- change:
+		default:
+			h.remove(sub)
+		}
+	}
+}
+
+func (h *Hub) HandleMessage(value []byte) {
+	var change desc.StockChange
+	err := protojson.Unmarshal(value, &change)
+	if err != nil {
+		logger.Error(context.Background(), "unmarshal stock change", zap.Error(err))
+		return
+	}
+	h.Publish(domain.StockChange{
+		WarehouseID: change.GetWarehouseID(),
+		Sku:         change.GetSku(),
+		Delta:       change.GetDelta(),
+	})
+}
+
+func (h *Hub) remove(sub *subscriber) {
+	if _, ok := h.subscribers[sub]; !ok {
+		return
+	}
+	delete(h.subscribers, sub)
+	close(sub.changes)
+}
@@ -0,0 +1,38 @@
+package stockwatch
+
+import (
+	"route256/loms/internal/domain"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHub(t *testing.T) {
+	hub := New()
+	change := domain.StockChange{WarehouseID: 1, Sku: 10, Delta: -2}
+	other := domain.StockChange{WarehouseID: 1, Sku: 20, Delta: 5}
+
+	filtered, unsubscribeFiltered := hub.Subscribe([]uint32{change.Sku})
+	all, unsubscribeAll := hub.Subscribe(nil)
+	hub.Publish(change)
+	hub.Publish(other)
+	require.Equal(t, change, <-filtered)
+	require.Len(t, filtered, 0)
+	require.Equal(t, change, <-all)
+	require.Equal(t, other, <-all)
+
+	//Отписка повторно не закрывает канал
+	unsubscribeFiltered()
+	unsubscribeFiltered()
+	_, ok := <-filtered
+	require.False(t, ok)
+
+	//Отстающий подписчик отключается, а не теряет изменения молча
+	for i := 0; i <= subscriberBuffer; i++ {
+		hub.Publish(change)
+	}
+	for range all {
+	}
+	unsubscribeAll()
+	require.Empty(t, hub.subscribers)
+}
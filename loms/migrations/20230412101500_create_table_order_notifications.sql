@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS order_notifications (
+    id bigserial PRIMARY KEY,
+    order_id bigint NOT NULL REFERENCES orders (id),
+    payload jsonb NOT NULL,
+    created_at timestamp NOT NULL DEFAULT now(),
+    sent_at timestamp
+);
+CREATE INDEX IF NOT EXISTS idx_order_notifications_unsent ON order_notifications (id) WHERE sent_at IS NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_order_notifications_unsent;
+DROP TABLE IF EXISTS order_notifications;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS payment_deadline timestamp;
+CREATE INDEX IF NOT EXISTS idx_orders_payment_deadline ON orders (payment_deadline) WHERE status = 'awaiting payment';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_orders_payment_deadline;
+ALTER TABLE orders DROP COLUMN IF EXISTS payment_deadline;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE stocks ADD COLUMN IF NOT EXISTS reserved integer NOT NULL DEFAULT 0;
+UPDATE stocks s SET reserved = COALESCE((SELECT SUM(r.count) FROM reserved_items r
+    WHERE r.warehouse_id = s.warehouse_id AND r.sku = s.sku), 0);
+ALTER TABLE stocks ADD CONSTRAINT stocks_reserved_check CHECK (reserved >= 0 AND reserved <= count);
+CREATE INDEX IF NOT EXISTS idx_reserved_items_order_id ON reserved_items (order_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_reserved_items_order_id;
+ALTER TABLE stocks DROP CONSTRAINT IF EXISTS stocks_reserved_check;
+ALTER TABLE stocks DROP COLUMN IF EXISTS reserved;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS order_status_history (
+    id bigserial PRIMARY KEY,
+    order_id bigint NOT NULL REFERENCES orders (id),
+    old_status text NOT NULL,
+    new_status text NOT NULL,
+    reason text NOT NULL DEFAULT '',
+    created_at timestamp NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS idx_order_status_history_order_id ON order_status_history (order_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_order_status_history_order_id;
+DROP TABLE IF EXISTS order_status_history;
+-- +goose StatementEnd
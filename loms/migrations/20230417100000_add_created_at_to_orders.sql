@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS created_at timestamp NOT NULL DEFAULT now();
+CREATE INDEX IF NOT EXISTS idx_orders_user_id_id ON orders (user_id, id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_orders_user_id_id;
+ALTER TABLE orders DROP COLUMN IF EXISTS created_at;
+-- +goose StatementEnd
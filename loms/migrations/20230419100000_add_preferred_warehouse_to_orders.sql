@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS preferred_warehouse_id bigint;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE orders DROP COLUMN IF EXISTS preferred_warehouse_id;
+-- +goose StatementEnd
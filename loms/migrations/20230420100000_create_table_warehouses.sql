@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS warehouses (
+    id bigserial PRIMARY KEY,
+    name text NOT NULL UNIQUE,
+    created_at timestamp NOT NULL DEFAULT now()
+);
+INSERT INTO warehouses (id, name)
+    SELECT DISTINCT warehouse_id, 'warehouse ' || warehouse_id FROM stocks
+    ON CONFLICT DO NOTHING;
+SELECT setval(pg_get_serial_sequence('warehouses', 'id'), COALESCE(MAX(id), 0) + 1, false) FROM warehouses;
+ALTER TABLE stocks ADD CONSTRAINT stocks_warehouse_id_fkey FOREIGN KEY (warehouse_id) REFERENCES warehouses (id);
+
+CREATE TABLE IF NOT EXISTS stock_movements (
+    id bigserial PRIMARY KEY,
+    warehouse_id bigint NOT NULL REFERENCES warehouses (id),
+    sku integer NOT NULL,
+    kind text NOT NULL,
+    delta integer NOT NULL,
+    reason text NOT NULL DEFAULT '',
+    created_at timestamp NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS idx_stock_movements_warehouse_id_sku ON stock_movements (warehouse_id, sku);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_stock_movements_warehouse_id_sku;
+DROP TABLE IF EXISTS stock_movements;
+ALTER TABLE stocks DROP CONSTRAINT IF EXISTS stocks_warehouse_id_fkey;
+DROP TABLE IF EXISTS warehouses;
+-- +goose StatementEnd
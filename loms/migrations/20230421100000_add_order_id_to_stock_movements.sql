@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE stock_movements ADD COLUMN IF NOT EXISTS order_id bigint;
+CREATE INDEX IF NOT EXISTS idx_stock_movements_order_id ON stock_movements (order_id);
+
+-- Начальные остатки и текущие резервы переносятся в журнал, чтобы сверка сходилась
+INSERT INTO stock_movements (warehouse_id, sku, kind, delta, reason)
+    SELECT s.warehouse_id, s.sku, 'receive', s.count - COALESCE(m.delta, 0), 'opening balance'
+    FROM stocks s
+    LEFT JOIN (
+        SELECT warehouse_id, sku, SUM(delta) AS delta FROM stock_movements
+        WHERE kind IN ('receive', 'adjust') GROUP BY warehouse_id, sku
+    ) m ON m.warehouse_id = s.warehouse_id AND m.sku = s.sku
+    WHERE s.count - COALESCE(m.delta, 0) <> 0;
+INSERT INTO stock_movements (warehouse_id, sku, kind, delta, order_id)
+    SELECT warehouse_id, sku, 'reserve', count, order_id FROM reserved_items;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM stock_movements WHERE kind IN ('reserve', 'release', 'sell') OR reason = 'opening balance';
+DROP INDEX IF EXISTS idx_stock_movements_order_id;
+ALTER TABLE stock_movements DROP COLUMN IF EXISTS order_id;
+-- +goose StatementEnd
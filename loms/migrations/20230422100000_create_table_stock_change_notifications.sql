@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS stock_change_notifications (
+    id bigserial PRIMARY KEY,
+    warehouse_id bigint NOT NULL,
+    sku integer NOT NULL,
+    delta integer NOT NULL,
+    created_at timestamp NOT NULL DEFAULT now(),
+    sent_at timestamp
+);
+CREATE INDEX IF NOT EXISTS idx_stock_change_notifications_unsent ON stock_change_notifications (id) WHERE sent_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_stock_change_notifications_unsent;
+DROP TABLE IF EXISTS stock_change_notifications;
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE stocks ADD COLUMN IF NOT EXISTS held integer NOT NULL DEFAULT 0;
+ALTER TABLE stocks DROP CONSTRAINT IF EXISTS stocks_reserved_check;
+ALTER TABLE stocks ADD CONSTRAINT stocks_reserved_check CHECK (reserved >= 0 AND held >= 0 AND reserved + held <= count);
+
+CREATE TABLE IF NOT EXISTS stock_holds (
+    id bigserial PRIMARY KEY,
+    user_id bigint NOT NULL,
+    warehouse_id bigint NOT NULL,
+    sku integer NOT NULL,
+    count integer NOT NULL,
+    expires_at timestamp NOT NULL,
+    created_at timestamp NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS idx_stock_holds_user_id_sku ON stock_holds (user_id, sku);
+CREATE INDEX IF NOT EXISTS idx_stock_holds_expires_at ON stock_holds (expires_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_stock_holds_expires_at;
+DROP INDEX IF EXISTS idx_stock_holds_user_id_sku;
+DROP TABLE IF EXISTS stock_holds;
+ALTER TABLE stocks DROP CONSTRAINT IF EXISTS stocks_reserved_check;
+ALTER TABLE stocks ADD CONSTRAINT stocks_reserved_check CHECK (reserved >= 0 AND reserved <= count);
+ALTER TABLE stocks DROP COLUMN IF EXISTS held;
+-- +goose StatementEnd
@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS payments (
+    id text PRIMARY KEY,
+    order_id bigint NOT NULL REFERENCES orders (id),
+    amount bigint NOT NULL,
+    status text NOT NULL,
+    confirmation_url text NOT NULL DEFAULT '',
+    refunded_amount bigint NOT NULL DEFAULT 0,
+    created_at timestamp NOT NULL DEFAULT now(),
+    updated_at timestamp NOT NULL DEFAULT now()
+);
+-- У заказа может быть только один неоплаченный или оплаченный платеж
+CREATE UNIQUE INDEX IF NOT EXISTS idx_payments_order_id_active ON payments (order_id) WHERE status IN ('pending', 'succeeded');
+-- Возвраты отправляются провайдеру после коммита, как уведомления из order_notifications
+CREATE TABLE IF NOT EXISTS refunds (
+    id bigserial PRIMARY KEY,
+    payment_id text NOT NULL REFERENCES payments (id),
+    amount bigint NOT NULL,
+    created_at timestamp NOT NULL DEFAULT now(),
+    sent_at timestamp
+);
+CREATE INDEX IF NOT EXISTS idx_refunds_unsent ON refunds (id) WHERE sent_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS refunds;
+DROP INDEX IF EXISTS idx_payments_order_id_active;
+DROP TABLE IF EXISTS payments;
+-- +goose StatementEnd
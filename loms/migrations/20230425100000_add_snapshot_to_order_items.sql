@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE order_items ADD COLUMN IF NOT EXISTS name text NOT NULL DEFAULT '';
+ALTER TABLE order_items ADD COLUMN IF NOT EXISTS price bigint NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE order_items DROP COLUMN IF EXISTS price;
+ALTER TABLE order_items DROP COLUMN IF EXISTS name;
+-- +goose StatementEnd
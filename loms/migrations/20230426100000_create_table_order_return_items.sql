@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS order_return_items (
+    order_id bigint NOT NULL REFERENCES orders (id),
+    sku integer NOT NULL,
+    count int4 NOT NULL CHECK (count > 0),
+    created_at timestamp NOT NULL DEFAULT now(),
+    PRIMARY KEY (order_id, sku)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS order_return_items;
+-- +goose StatementEnd
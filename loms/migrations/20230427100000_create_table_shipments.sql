@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS shipments (
+    id bigserial PRIMARY KEY,
+    order_id bigint NOT NULL REFERENCES orders (id),
+    warehouse_id bigint NOT NULL REFERENCES warehouses (id),
+    status text NOT NULL,
+    tracking_number text NOT NULL DEFAULT '',
+    created_at timestamp NOT NULL DEFAULT now(),
+    updated_at timestamp NOT NULL DEFAULT now(),
+    UNIQUE (order_id, warehouse_id)
+);
+CREATE TABLE IF NOT EXISTS shipment_items (
+    shipment_id bigint NOT NULL REFERENCES shipments (id),
+    sku integer NOT NULL,
+    count int4 NOT NULL CHECK (count > 0),
+    PRIMARY KEY (shipment_id, sku)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS shipment_items;
+DROP TABLE IF EXISTS shipments;
+-- +goose StatementEnd
@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS sellers (
+    id bigserial PRIMARY KEY,
+    name text NOT NULL UNIQUE,
+    created_at timestamp NOT NULL DEFAULT now()
+);
+ALTER TABLE warehouses ADD COLUMN IF NOT EXISTS seller_id bigint REFERENCES sellers (id);
+
+CREATE TABLE IF NOT EXISTS seller_orders (
+    id bigserial PRIMARY KEY,
+    order_id bigint NOT NULL REFERENCES orders (id),
+    seller_id bigint NOT NULL,
+    status text NOT NULL,
+    created_at timestamp NOT NULL DEFAULT now(),
+    updated_at timestamp NOT NULL DEFAULT now(),
+    UNIQUE (order_id, seller_id)
+);
+CREATE INDEX IF NOT EXISTS idx_seller_orders_seller_id_id ON seller_orders (seller_id, id);
+CREATE TABLE IF NOT EXISTS seller_order_items (
+    seller_order_id bigint NOT NULL REFERENCES seller_orders (id),
+    sku integer NOT NULL,
+    count int4 NOT NULL CHECK (count > 0),
+    name text NOT NULL DEFAULT '',
+    price bigint NOT NULL DEFAULT 0,
+    PRIMARY KEY (seller_order_id, sku)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS seller_order_items;
+DROP INDEX IF EXISTS idx_seller_orders_seller_id_id;
+DROP TABLE IF EXISTS seller_orders;
+ALTER TABLE warehouses DROP COLUMN IF EXISTS seller_id;
+DROP TABLE IF EXISTS sellers;
+-- +goose StatementEnd
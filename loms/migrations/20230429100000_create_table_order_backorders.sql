@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS allow_backorder boolean NOT NULL DEFAULT false;
+
+CREATE TABLE IF NOT EXISTS order_backorders (
+    id bigserial PRIMARY KEY,
+    order_id bigint NOT NULL REFERENCES orders (id),
+    sku integer NOT NULL,
+    count int4 NOT NULL CHECK (count > 0),
+    created_at timestamp NOT NULL DEFAULT now(),
+    UNIQUE (order_id, sku)
+);
+CREATE INDEX IF NOT EXISTS idx_order_backorders_sku_id ON order_backorders (sku, id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_order_backorders_sku_id;
+DROP TABLE IF EXISTS order_backorders;
+ALTER TABLE orders DROP COLUMN IF EXISTS allow_backorder;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS order_shortages (
+    order_id bigint NOT NULL REFERENCES orders (id),
+    sku integer NOT NULL,
+    requested int8 NOT NULL CHECK (requested > 0),
+    missing int8 NOT NULL CHECK (missing > 0),
+    PRIMARY KEY (order_id, sku)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS order_shortages;
+-- +goose StatementEnd
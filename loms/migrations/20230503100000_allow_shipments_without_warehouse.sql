@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Заказы, оплаченные до журнала движений, не знают складов списания и отправляются без склада
+ALTER TABLE shipments ALTER COLUMN warehouse_id DROP NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM shipment_items WHERE shipment_id IN (SELECT id FROM shipments WHERE warehouse_id IS NULL);
+DELETE FROM shipments WHERE warehouse_id IS NULL;
+ALTER TABLE shipments ALTER COLUMN warehouse_id SET NOT NULL;
+-- +goose StatementEnd
@@ -0,0 +1,43 @@
+-- +goose Up
+-- +goose StatementBegin
+-- 0 - предложение самого маркетплейса, как и в seller_orders
+ALTER TABLE order_items ADD COLUMN IF NOT EXISTS seller_id bigint NOT NULL DEFAULT 0;
+
+ALTER TABLE reserved_items ADD COLUMN IF NOT EXISTS seller_id bigint NOT NULL DEFAULT 0;
+UPDATE reserved_items r SET seller_id = w.seller_id
+    FROM warehouses w WHERE w.id = r.warehouse_id AND w.seller_id IS NOT NULL;
+
+ALTER TABLE order_backorders ADD COLUMN IF NOT EXISTS seller_id bigint NOT NULL DEFAULT 0;
+ALTER TABLE order_backorders DROP CONSTRAINT IF EXISTS order_backorders_order_id_sku_key;
+ALTER TABLE order_backorders ADD CONSTRAINT order_backorders_order_id_sku_seller_id_key UNIQUE (order_id, sku, seller_id);
+DROP INDEX IF EXISTS idx_order_backorders_sku_id;
+CREATE INDEX IF NOT EXISTS idx_order_backorders_sku_seller_id_id ON order_backorders (sku, seller_id, id);
+
+ALTER TABLE order_shortages ADD COLUMN IF NOT EXISTS seller_id bigint NOT NULL DEFAULT 0;
+ALTER TABLE order_shortages DROP CONSTRAINT IF EXISTS order_shortages_pkey;
+ALTER TABLE order_shortages ADD PRIMARY KEY (order_id, sku, seller_id);
+
+ALTER TABLE order_return_items ADD COLUMN IF NOT EXISTS seller_id bigint NOT NULL DEFAULT 0;
+ALTER TABLE order_return_items DROP CONSTRAINT IF EXISTS order_return_items_pkey;
+ALTER TABLE order_return_items ADD PRIMARY KEY (order_id, sku, seller_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE order_return_items DROP CONSTRAINT IF EXISTS order_return_items_pkey;
+ALTER TABLE order_return_items DROP COLUMN IF EXISTS seller_id;
+ALTER TABLE order_return_items ADD PRIMARY KEY (order_id, sku);
+
+ALTER TABLE order_shortages DROP CONSTRAINT IF EXISTS order_shortages_pkey;
+ALTER TABLE order_shortages DROP COLUMN IF EXISTS seller_id;
+ALTER TABLE order_shortages ADD PRIMARY KEY (order_id, sku);
+
+DROP INDEX IF EXISTS idx_order_backorders_sku_seller_id_id;
+CREATE INDEX IF NOT EXISTS idx_order_backorders_sku_id ON order_backorders (sku, id);
+ALTER TABLE order_backorders DROP CONSTRAINT IF EXISTS order_backorders_order_id_sku_seller_id_key;
+ALTER TABLE order_backorders DROP COLUMN IF EXISTS seller_id;
+ALTER TABLE order_backorders ADD CONSTRAINT order_backorders_order_id_sku_key UNIQUE (order_id, sku);
+
+ALTER TABLE reserved_items DROP COLUMN IF EXISTS seller_id;
+ALTER TABLE order_items DROP COLUMN IF EXISTS seller_id;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Заказы, ожидающие товар, отменяются по истечении срока ожидания
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS backorder_deadline timestamp;
+UPDATE orders SET backorder_deadline = now() + interval '72 hours'
+    WHERE status = 'awaiting stock';
+CREATE INDEX IF NOT EXISTS idx_orders_backorder_deadline ON orders (backorder_deadline) WHERE status = 'awaiting stock';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_orders_backorder_deadline;
+ALTER TABLE orders DROP COLUMN IF EXISTS backorder_deadline;
+-- +goose StatementEnd
@@ -78,6 +78,21 @@ func (m *Item) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for Name
+
+	// no validation rules for Price
+
+	if m.GetSellerID() < 0 {
+		err := ItemValidationError{
+			field:  "SellerID",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return ItemMultiError(errors)
 	}
@@ -222,6 +237,32 @@ func (m *CreateOrderRequest) validate(all bool) error {
 
 	}
 
+	if utf8.RuneCountInString(m.GetIdempotencyKey()) > 64 {
+		err := CreateOrderRequestValidationError{
+			field:  "IdempotencyKey",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPreferredWarehouseID() < 0 {
+		err := CreateOrderRequestValidationError{
+			field:  "PreferredWarehouseID",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ReserveNow
+
+	// no validation rules for AllowBackorder
+
 	if len(errors) > 0 {
 		return CreateOrderRequestMultiError(errors)
 	}
@@ -326,6 +367,42 @@ func (m *CreateOrderResponse) validate(all bool) error {
 
 	// no validation rules for OrderID
 
+	// no validation rules for Status
+
+	for idx, item := range m.GetShortages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateOrderResponseValidationError{
+						field:  fmt.Sprintf("Shortages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateOrderResponseValidationError{
+						field:  fmt.Sprintf("Shortages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateOrderResponseValidationError{
+					field:  fmt.Sprintf("Shortages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return CreateOrderResponseMultiError(errors)
 	}
@@ -406,6 +483,113 @@ var _ interface {
 	ErrorName() string
 } = CreateOrderResponseValidationError{}
 
+// Validate checks the field values on ItemShortage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ItemShortage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ItemShortage with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ItemShortageMultiError, or
+// nil if none found.
+func (m *ItemShortage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ItemShortage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Sku
+
+	// no validation rules for Requested
+
+	// no validation rules for Missing
+
+	// no validation rules for SellerID
+
+	if len(errors) > 0 {
+		return ItemShortageMultiError(errors)
+	}
+
+	return nil
+}
+
+// ItemShortageMultiError is an error wrapping multiple validation errors
+// returned by ItemShortage.ValidateAll() if the designated constraints aren't met.
+type ItemShortageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ItemShortageMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ItemShortageMultiError) AllErrors() []error { return m }
+
+// ItemShortageValidationError is the validation error returned by
+// ItemShortage.Validate if the designated constraints aren't met.
+type ItemShortageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ItemShortageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ItemShortageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ItemShortageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ItemShortageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ItemShortageValidationError) ErrorName() string { return "ItemShortageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ItemShortageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sItemShortage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ItemShortageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ItemShortageValidationError{}
+
 // Validate checks the field values on ListOrderRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -517,21 +701,22 @@ var _ interface {
 	ErrorName() string
 } = ListOrderRequestValidationError{}
 
-// Validate checks the field values on Order with the rules defined in the
+// Validate checks the field values on Shipment with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Order) Validate() error {
+func (m *Shipment) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Order with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in OrderMultiError, or nil if none found.
-func (m *Order) ValidateAll() error {
+// ValidateAll checks the field values on Shipment with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ShipmentMultiError, or nil
+// if none found.
+func (m *Shipment) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Order) validate(all bool) error {
+func (m *Shipment) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -540,9 +725,11 @@ func (m *Order) validate(all bool) error {
 
 	// no validation rules for Id
 
+	// no validation rules for WarehouseID
+
 	// no validation rules for Status
 
-	// no validation rules for User
+	// no validation rules for TrackingNumber
 
 	for idx, item := range m.GetItems() {
 		_, _ = idx, item
@@ -551,7 +738,7 @@ func (m *Order) validate(all bool) error {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, OrderValidationError{
+					errors = append(errors, ShipmentValidationError{
 						field:  fmt.Sprintf("Items[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -559,7 +746,7 @@ func (m *Order) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, OrderValidationError{
+					errors = append(errors, ShipmentValidationError{
 						field:  fmt.Sprintf("Items[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -568,7 +755,7 @@ func (m *Order) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return OrderValidationError{
+				return ShipmentValidationError{
 					field:  fmt.Sprintf("Items[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -578,19 +765,21 @@ func (m *Order) validate(all bool) error {
 
 	}
 
+	// no validation rules for SellerID
+
 	if len(errors) > 0 {
-		return OrderMultiError(errors)
+		return ShipmentMultiError(errors)
 	}
 
 	return nil
 }
 
-// OrderMultiError is an error wrapping multiple validation errors returned by
-// Order.ValidateAll() if the designated constraints aren't met.
-type OrderMultiError []error
+// ShipmentMultiError is an error wrapping multiple validation errors returned
+// by Shipment.ValidateAll() if the designated constraints aren't met.
+type ShipmentMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m OrderMultiError) Error() string {
+func (m ShipmentMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -599,11 +788,11 @@ func (m OrderMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m OrderMultiError) AllErrors() []error { return m }
+func (m ShipmentMultiError) AllErrors() []error { return m }
 
-// OrderValidationError is the validation error returned by Order.Validate if
-// the designated constraints aren't met.
-type OrderValidationError struct {
+// ShipmentValidationError is the validation error returned by
+// Shipment.Validate if the designated constraints aren't met.
+type ShipmentValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -611,22 +800,22 @@ type OrderValidationError struct {
 }
 
 // Field function returns field value.
-func (e OrderValidationError) Field() string { return e.field }
+func (e ShipmentValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e OrderValidationError) Reason() string { return e.reason }
+func (e ShipmentValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e OrderValidationError) Cause() error { return e.cause }
+func (e ShipmentValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e OrderValidationError) Key() bool { return e.key }
+func (e ShipmentValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e OrderValidationError) ErrorName() string { return "OrderValidationError" }
+func (e ShipmentValidationError) ErrorName() string { return "ShipmentValidationError" }
 
 // Error satisfies the builtin error interface
-func (e OrderValidationError) Error() string {
+func (e ShipmentValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -638,14 +827,14 @@ func (e OrderValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sOrder.%s: %s%s",
+		"invalid %sShipment.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = OrderValidationError{}
+var _ error = ShipmentValidationError{}
 
 var _ interface {
 	Field() string
@@ -653,30 +842,31 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = OrderValidationError{}
+} = ShipmentValidationError{}
 
-// Validate checks the field values on ListOrderResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ListOrderResponse) Validate() error {
+// Validate checks the field values on Order with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Order) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListOrderResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListOrderResponseMultiError, or nil if none found.
-func (m *ListOrderResponse) ValidateAll() error {
+// ValidateAll checks the field values on Order with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in OrderMultiError, or nil if none found.
+func (m *Order) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListOrderResponse) validate(all bool) error {
+func (m *Order) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
 	// no validation rules for Status
 
 	// no validation rules for User
@@ -688,7 +878,7 @@ func (m *ListOrderResponse) validate(all bool) error {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListOrderResponseValidationError{
+					errors = append(errors, OrderValidationError{
 						field:  fmt.Sprintf("Items[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -696,7 +886,7 @@ func (m *ListOrderResponse) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListOrderResponseValidationError{
+					errors = append(errors, OrderValidationError{
 						field:  fmt.Sprintf("Items[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -705,7 +895,7 @@ func (m *ListOrderResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListOrderResponseValidationError{
+				return OrderValidationError{
 					field:  fmt.Sprintf("Items[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -715,20 +905,84 @@ func (m *ListOrderResponse) validate(all bool) error {
 
 	}
 
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for TotalPrice
+
+	for idx, item := range m.GetShipments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OrderValidationError{
+						field:  fmt.Sprintf("Shipments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OrderValidationError{
+						field:  fmt.Sprintf("Shipments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OrderValidationError{
+					field:  fmt.Sprintf("Shipments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return ListOrderResponseMultiError(errors)
+		return OrderMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListOrderResponseMultiError is an error wrapping multiple validation errors
-// returned by ListOrderResponse.ValidateAll() if the designated constraints
-// aren't met.
-type ListOrderResponseMultiError []error
+// OrderMultiError is an error wrapping multiple validation errors returned by
+// Order.ValidateAll() if the designated constraints aren't met.
+type OrderMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListOrderResponseMultiError) Error() string {
+func (m OrderMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -737,36 +991,4551 @@ func (m ListOrderResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListOrderResponseMultiError) AllErrors() []error { return m }
+func (m OrderMultiError) AllErrors() []error { return m }
 
-// ListOrderResponseValidationError is the validation error returned by
-// ListOrderResponse.Validate if the designated constraints aren't met.
-type ListOrderResponseValidationError struct {
-	field  string
-	reason string
+// OrderValidationError is the validation error returned by Order.Validate if
+// the designated constraints aren't met.
+type OrderValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderValidationError) ErrorName() string { return "OrderValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrder.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderValidationError{}
+
+// Validate checks the field values on ListOrderResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListOrderResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListOrderResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListOrderResponseMultiError, or nil if none found.
+func (m *ListOrderResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListOrderResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	// no validation rules for User
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListOrderResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListOrderResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListOrderResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for FailureReason
+
+	// no validation rules for TotalPrice
+
+	for idx, item := range m.GetReturnItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListOrderResponseValidationError{
+						field:  fmt.Sprintf("ReturnItems[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListOrderResponseValidationError{
+						field:  fmt.Sprintf("ReturnItems[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListOrderResponseValidationError{
+					field:  fmt.Sprintf("ReturnItems[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetShipments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListOrderResponseValidationError{
+						field:  fmt.Sprintf("Shipments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListOrderResponseValidationError{
+						field:  fmt.Sprintf("Shipments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListOrderResponseValidationError{
+					field:  fmt.Sprintf("Shipments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetBackorderedItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListOrderResponseValidationError{
+						field:  fmt.Sprintf("BackorderedItems[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListOrderResponseValidationError{
+						field:  fmt.Sprintf("BackorderedItems[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListOrderResponseValidationError{
+					field:  fmt.Sprintf("BackorderedItems[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListOrderResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListOrderResponseMultiError is an error wrapping multiple validation errors
+// returned by ListOrderResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListOrderResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListOrderResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListOrderResponseMultiError) AllErrors() []error { return m }
+
+// ListOrderResponseValidationError is the validation error returned by
+// ListOrderResponse.Validate if the designated constraints aren't met.
+type ListOrderResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListOrderResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListOrderResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListOrderResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListOrderResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListOrderResponseValidationError) ErrorName() string {
+	return "ListOrderResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListOrderResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListOrderResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListOrderResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListOrderResponseValidationError{}
+
+// Validate checks the field values on OrderPayedRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *OrderPayedRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderPayedRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrderPayedRequestMultiError, or nil if none found.
+func (m *OrderPayedRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderPayedRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOrderID() <= 0 {
+		err := OrderPayedRequestValidationError{
+			field:  "OrderID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return OrderPayedRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderPayedRequestMultiError is an error wrapping multiple validation errors
+// returned by OrderPayedRequest.ValidateAll() if the designated constraints
+// aren't met.
+type OrderPayedRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderPayedRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderPayedRequestMultiError) AllErrors() []error { return m }
+
+// OrderPayedRequestValidationError is the validation error returned by
+// OrderPayedRequest.Validate if the designated constraints aren't met.
+type OrderPayedRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderPayedRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderPayedRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderPayedRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderPayedRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderPayedRequestValidationError) ErrorName() string {
+	return "OrderPayedRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OrderPayedRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderPayedRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderPayedRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderPayedRequestValidationError{}
+
+// Validate checks the field values on InitiatePaymentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InitiatePaymentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InitiatePaymentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InitiatePaymentRequestMultiError, or nil if none found.
+func (m *InitiatePaymentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InitiatePaymentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOrderID() <= 0 {
+		err := InitiatePaymentRequestValidationError{
+			field:  "OrderID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return InitiatePaymentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InitiatePaymentRequestMultiError is an error wrapping multiple validation
+// errors returned by InitiatePaymentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type InitiatePaymentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InitiatePaymentRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InitiatePaymentRequestMultiError) AllErrors() []error { return m }
+
+// InitiatePaymentRequestValidationError is the validation error returned by
+// InitiatePaymentRequest.Validate if the designated constraints aren't met.
+type InitiatePaymentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InitiatePaymentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InitiatePaymentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InitiatePaymentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InitiatePaymentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InitiatePaymentRequestValidationError) ErrorName() string {
+	return "InitiatePaymentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InitiatePaymentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInitiatePaymentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InitiatePaymentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InitiatePaymentRequestValidationError{}
+
+// Validate checks the field values on InitiatePaymentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InitiatePaymentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InitiatePaymentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InitiatePaymentResponseMultiError, or nil if none found.
+func (m *InitiatePaymentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InitiatePaymentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PaymentID
+
+	// no validation rules for Amount
+
+	// no validation rules for ConfirmationURL
+
+	if len(errors) > 0 {
+		return InitiatePaymentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InitiatePaymentResponseMultiError is an error wrapping multiple validation
+// errors returned by InitiatePaymentResponse.ValidateAll() if the designated
+// constraints aren't met.
+type InitiatePaymentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InitiatePaymentResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InitiatePaymentResponseMultiError) AllErrors() []error { return m }
+
+// InitiatePaymentResponseValidationError is the validation error returned by
+// InitiatePaymentResponse.Validate if the designated constraints aren't met.
+type InitiatePaymentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InitiatePaymentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InitiatePaymentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InitiatePaymentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InitiatePaymentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InitiatePaymentResponseValidationError) ErrorName() string {
+	return "InitiatePaymentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InitiatePaymentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInitiatePaymentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InitiatePaymentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InitiatePaymentResponseValidationError{}
+
+// Validate checks the field values on RequestReturnRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestReturnRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestReturnRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RequestReturnRequestMultiError, or nil if none found.
+func (m *RequestReturnRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestReturnRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOrderID() <= 0 {
+		err := RequestReturnRequestValidationError{
+			field:  "OrderID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetItems()) < 1 {
+		err := RequestReturnRequestValidationError{
+			field:  "Items",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RequestReturnRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RequestReturnRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RequestReturnRequestValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RequestReturnRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequestReturnRequestMultiError is an error wrapping multiple validation
+// errors returned by RequestReturnRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RequestReturnRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestReturnRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestReturnRequestMultiError) AllErrors() []error { return m }
+
+// RequestReturnRequestValidationError is the validation error returned by
+// RequestReturnRequest.Validate if the designated constraints aren't met.
+type RequestReturnRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestReturnRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestReturnRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestReturnRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestReturnRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestReturnRequestValidationError) ErrorName() string {
+	return "RequestReturnRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestReturnRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestReturnRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestReturnRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestReturnRequestValidationError{}
+
+// Validate checks the field values on CompleteReturnRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CompleteReturnRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CompleteReturnRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CompleteReturnRequestMultiError, or nil if none found.
+func (m *CompleteReturnRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CompleteReturnRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOrderID() <= 0 {
+		err := CompleteReturnRequestValidationError{
+			field:  "OrderID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetWarehouseID() <= 0 {
+		err := CompleteReturnRequestValidationError{
+			field:  "WarehouseID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CompleteReturnRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CompleteReturnRequestMultiError is an error wrapping multiple validation
+// errors returned by CompleteReturnRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CompleteReturnRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CompleteReturnRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CompleteReturnRequestMultiError) AllErrors() []error { return m }
+
+// CompleteReturnRequestValidationError is the validation error returned by
+// CompleteReturnRequest.Validate if the designated constraints aren't met.
+type CompleteReturnRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CompleteReturnRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CompleteReturnRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CompleteReturnRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CompleteReturnRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CompleteReturnRequestValidationError) ErrorName() string {
+	return "CompleteReturnRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CompleteReturnRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCompleteReturnRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CompleteReturnRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CompleteReturnRequestValidationError{}
+
+// Validate checks the field values on AssembleOrderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AssembleOrderRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AssembleOrderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AssembleOrderRequestMultiError, or nil if none found.
+func (m *AssembleOrderRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AssembleOrderRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOrderID() <= 0 {
+		err := AssembleOrderRequestValidationError{
+			field:  "OrderID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AssembleOrderRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AssembleOrderRequestMultiError is an error wrapping multiple validation
+// errors returned by AssembleOrderRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AssembleOrderRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AssembleOrderRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AssembleOrderRequestMultiError) AllErrors() []error { return m }
+
+// AssembleOrderRequestValidationError is the validation error returned by
+// AssembleOrderRequest.Validate if the designated constraints aren't met.
+type AssembleOrderRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AssembleOrderRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AssembleOrderRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AssembleOrderRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AssembleOrderRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AssembleOrderRequestValidationError) ErrorName() string {
+	return "AssembleOrderRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AssembleOrderRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAssembleOrderRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AssembleOrderRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AssembleOrderRequestValidationError{}
+
+// Validate checks the field values on AssembleOrderResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AssembleOrderResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AssembleOrderResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AssembleOrderResponseMultiError, or nil if none found.
+func (m *AssembleOrderResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AssembleOrderResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetShipments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AssembleOrderResponseValidationError{
+						field:  fmt.Sprintf("Shipments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AssembleOrderResponseValidationError{
+						field:  fmt.Sprintf("Shipments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AssembleOrderResponseValidationError{
+					field:  fmt.Sprintf("Shipments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AssembleOrderResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AssembleOrderResponseMultiError is an error wrapping multiple validation
+// errors returned by AssembleOrderResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AssembleOrderResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AssembleOrderResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AssembleOrderResponseMultiError) AllErrors() []error { return m }
+
+// AssembleOrderResponseValidationError is the validation error returned by
+// AssembleOrderResponse.Validate if the designated constraints aren't met.
+type AssembleOrderResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AssembleOrderResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AssembleOrderResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AssembleOrderResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AssembleOrderResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AssembleOrderResponseValidationError) ErrorName() string {
+	return "AssembleOrderResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AssembleOrderResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAssembleOrderResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AssembleOrderResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AssembleOrderResponseValidationError{}
+
+// Validate checks the field values on ShipShipmentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ShipShipmentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ShipShipmentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ShipShipmentRequestMultiError, or nil if none found.
+func (m *ShipShipmentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ShipShipmentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetShipmentID() <= 0 {
+		err := ShipShipmentRequestValidationError{
+			field:  "ShipmentID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetTrackingNumber()) < 1 {
+		err := ShipShipmentRequestValidationError{
+			field:  "TrackingNumber",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ShipShipmentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ShipShipmentRequestMultiError is an error wrapping multiple validation
+// errors returned by ShipShipmentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ShipShipmentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ShipShipmentRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ShipShipmentRequestMultiError) AllErrors() []error { return m }
+
+// ShipShipmentRequestValidationError is the validation error returned by
+// ShipShipmentRequest.Validate if the designated constraints aren't met.
+type ShipShipmentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ShipShipmentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ShipShipmentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ShipShipmentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ShipShipmentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ShipShipmentRequestValidationError) ErrorName() string {
+	return "ShipShipmentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ShipShipmentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sShipShipmentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ShipShipmentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ShipShipmentRequestValidationError{}
+
+// Validate checks the field values on DeliverShipmentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeliverShipmentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeliverShipmentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeliverShipmentRequestMultiError, or nil if none found.
+func (m *DeliverShipmentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeliverShipmentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetShipmentID() <= 0 {
+		err := DeliverShipmentRequestValidationError{
+			field:  "ShipmentID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeliverShipmentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeliverShipmentRequestMultiError is an error wrapping multiple validation
+// errors returned by DeliverShipmentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeliverShipmentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeliverShipmentRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeliverShipmentRequestMultiError) AllErrors() []error { return m }
+
+// DeliverShipmentRequestValidationError is the validation error returned by
+// DeliverShipmentRequest.Validate if the designated constraints aren't met.
+type DeliverShipmentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeliverShipmentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeliverShipmentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeliverShipmentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeliverShipmentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeliverShipmentRequestValidationError) ErrorName() string {
+	return "DeliverShipmentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeliverShipmentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeliverShipmentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeliverShipmentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeliverShipmentRequestValidationError{}
+
+// Validate checks the field values on CancelOrderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelOrderRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelOrderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelOrderRequestMultiError, or nil if none found.
+func (m *CancelOrderRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelOrderRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOrderID() <= 0 {
+		err := CancelOrderRequestValidationError{
+			field:  "OrderID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CancelOrderRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelOrderRequestMultiError is an error wrapping multiple validation errors
+// returned by CancelOrderRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CancelOrderRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelOrderRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelOrderRequestMultiError) AllErrors() []error { return m }
+
+// CancelOrderRequestValidationError is the validation error returned by
+// CancelOrderRequest.Validate if the designated constraints aren't met.
+type CancelOrderRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelOrderRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelOrderRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelOrderRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelOrderRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelOrderRequestValidationError) ErrorName() string {
+	return "CancelOrderRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelOrderRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelOrderRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelOrderRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelOrderRequestValidationError{}
+
+// Validate checks the field values on StocksRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StocksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StocksRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StocksRequestMultiError, or
+// nil if none found.
+func (m *StocksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StocksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSku() <= 0 {
+		err := StocksRequestValidationError{
+			field:  "Sku",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSellerID() < 0 {
+		err := StocksRequestValidationError{
+			field:  "SellerID",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return StocksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StocksRequestMultiError is an error wrapping multiple validation errors
+// returned by StocksRequest.ValidateAll() if the designated constraints
+// aren't met.
+type StocksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StocksRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StocksRequestMultiError) AllErrors() []error { return m }
+
+// StocksRequestValidationError is the validation error returned by
+// StocksRequest.Validate if the designated constraints aren't met.
+type StocksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StocksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StocksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StocksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StocksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StocksRequestValidationError) ErrorName() string { return "StocksRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StocksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStocksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StocksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StocksRequestValidationError{}
+
+// Validate checks the field values on Stock with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Stock) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Stock with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in StockMultiError, or nil if none found.
+func (m *Stock) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Stock) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for WarehouseID
+
+	// no validation rules for Count
+
+	// no validation rules for SellerID
+
+	if len(errors) > 0 {
+		return StockMultiError(errors)
+	}
+
+	return nil
+}
+
+// StockMultiError is an error wrapping multiple validation errors returned by
+// Stock.ValidateAll() if the designated constraints aren't met.
+type StockMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StockMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StockMultiError) AllErrors() []error { return m }
+
+// StockValidationError is the validation error returned by Stock.Validate if
+// the designated constraints aren't met.
+type StockValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StockValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StockValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StockValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StockValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StockValidationError) ErrorName() string { return "StockValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StockValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStock.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StockValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StockValidationError{}
+
+// Validate checks the field values on StocksResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StocksResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StocksResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StocksResponseMultiError,
+// or nil if none found.
+func (m *StocksResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StocksResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetStocks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StocksResponseValidationError{
+						field:  fmt.Sprintf("Stocks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StocksResponseValidationError{
+						field:  fmt.Sprintf("Stocks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StocksResponseValidationError{
+					field:  fmt.Sprintf("Stocks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return StocksResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StocksResponseMultiError is an error wrapping multiple validation errors
+// returned by StocksResponse.ValidateAll() if the designated constraints
+// aren't met.
+type StocksResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StocksResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StocksResponseMultiError) AllErrors() []error { return m }
+
+// StocksResponseValidationError is the validation error returned by
+// StocksResponse.Validate if the designated constraints aren't met.
+type StocksResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StocksResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StocksResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StocksResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StocksResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StocksResponseValidationError) ErrorName() string { return "StocksResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StocksResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStocksResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StocksResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StocksResponseValidationError{}
+
+// Validate checks the field values on BatchStocksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchStocksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchStocksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchStocksRequestMultiError, or nil if none found.
+func (m *BatchStocksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchStocksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetSkus()); l < 1 || l > 500 {
+		err := BatchStocksRequestValidationError{
+			field:  "Skus",
+			reason: "value must contain between 1 and 500 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_BatchStocksRequest_Skus_Unique := make(map[uint32]struct{}, len(m.GetSkus()))
+
+	for idx, item := range m.GetSkus() {
+		_, _ = idx, item
+
+		if _, exists := _BatchStocksRequest_Skus_Unique[item]; exists {
+			err := BatchStocksRequestValidationError{
+				field:  fmt.Sprintf("Skus[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_BatchStocksRequest_Skus_Unique[item] = struct{}{}
+		}
+
+		if item <= 0 {
+			err := BatchStocksRequestValidationError{
+				field:  fmt.Sprintf("Skus[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchStocksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchStocksRequestMultiError is an error wrapping multiple validation errors
+// returned by BatchStocksRequest.ValidateAll() if the designated constraints
+// aren't met.
+type BatchStocksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchStocksRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchStocksRequestMultiError) AllErrors() []error { return m }
+
+// BatchStocksRequestValidationError is the validation error returned by
+// BatchStocksRequest.Validate if the designated constraints aren't met.
+type BatchStocksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchStocksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchStocksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchStocksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchStocksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchStocksRequestValidationError) ErrorName() string {
+	return "BatchStocksRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchStocksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchStocksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchStocksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchStocksRequestValidationError{}
+
+// Validate checks the field values on SkuStocks with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SkuStocks) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SkuStocks with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SkuStocksMultiError, or nil
+// if none found.
+func (m *SkuStocks) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SkuStocks) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Sku
+
+	for idx, item := range m.GetStocks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SkuStocksValidationError{
+						field:  fmt.Sprintf("Stocks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SkuStocksValidationError{
+						field:  fmt.Sprintf("Stocks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SkuStocksValidationError{
+					field:  fmt.Sprintf("Stocks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SkuStocksMultiError(errors)
+	}
+
+	return nil
+}
+
+// SkuStocksMultiError is an error wrapping multiple validation errors returned
+// by SkuStocks.ValidateAll() if the designated constraints aren't met.
+type SkuStocksMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SkuStocksMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SkuStocksMultiError) AllErrors() []error { return m }
+
+// SkuStocksValidationError is the validation error returned by
+// SkuStocks.Validate if the designated constraints aren't met.
+type SkuStocksValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SkuStocksValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SkuStocksValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SkuStocksValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SkuStocksValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SkuStocksValidationError) ErrorName() string { return "SkuStocksValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SkuStocksValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSkuStocks.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SkuStocksValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SkuStocksValidationError{}
+
+// Validate checks the field values on BatchStocksResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchStocksResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchStocksResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchStocksResponseMultiError, or nil if none found.
+func (m *BatchStocksResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchStocksResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchStocksResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchStocksResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchStocksResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchStocksResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchStocksResponseMultiError is an error wrapping multiple validation
+// errors returned by BatchStocksResponse.ValidateAll() if the designated
+// constraints aren't met.
+type BatchStocksResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchStocksResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchStocksResponseMultiError) AllErrors() []error { return m }
+
+// BatchStocksResponseValidationError is the validation error returned by
+// BatchStocksResponse.Validate if the designated constraints aren't met.
+type BatchStocksResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchStocksResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchStocksResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchStocksResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchStocksResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchStocksResponseValidationError) ErrorName() string {
+	return "BatchStocksResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchStocksResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchStocksResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchStocksResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchStocksResponseValidationError{}
+
+// Validate checks the field values on WatchStocksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchStocksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchStocksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchStocksRequestMultiError, or nil if none found.
+func (m *WatchStocksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchStocksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetSkus()) > 500 {
+		err := WatchStocksRequestValidationError{
+			field:  "Skus",
+			reason: "value must contain no more than 500 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_WatchStocksRequest_Skus_Unique := make(map[uint32]struct{}, len(m.GetSkus()))
+
+	for idx, item := range m.GetSkus() {
+		_, _ = idx, item
+
+		if _, exists := _WatchStocksRequest_Skus_Unique[item]; exists {
+			err := WatchStocksRequestValidationError{
+				field:  fmt.Sprintf("Skus[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_WatchStocksRequest_Skus_Unique[item] = struct{}{}
+		}
+
+		if item <= 0 {
+			err := WatchStocksRequestValidationError{
+				field:  fmt.Sprintf("Skus[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return WatchStocksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchStocksRequestMultiError is an error wrapping multiple validation errors
+// returned by WatchStocksRequest.ValidateAll() if the designated constraints
+// aren't met.
+type WatchStocksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchStocksRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchStocksRequestMultiError) AllErrors() []error { return m }
+
+// WatchStocksRequestValidationError is the validation error returned by
+// WatchStocksRequest.Validate if the designated constraints aren't met.
+type WatchStocksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchStocksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchStocksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchStocksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchStocksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchStocksRequestValidationError) ErrorName() string {
+	return "WatchStocksRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchStocksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchStocksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchStocksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchStocksRequestValidationError{}
+
+// Validate checks the field values on StockChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StockChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StockChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StockChangeMultiError, or
+// nil if none found.
+func (m *StockChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StockChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for WarehouseID
+
+	// no validation rules for Sku
+
+	// no validation rules for Delta
+
+	if len(errors) > 0 {
+		return StockChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// StockChangeMultiError is an error wrapping multiple validation errors
+// returned by StockChange.ValidateAll() if the designated constraints aren't met.
+type StockChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StockChangeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StockChangeMultiError) AllErrors() []error { return m }
+
+// StockChangeValidationError is the validation error returned by
+// StockChange.Validate if the designated constraints aren't met.
+type StockChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StockChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StockChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StockChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StockChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StockChangeValidationError) ErrorName() string { return "StockChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StockChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStockChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StockChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StockChangeValidationError{}
+
+// Validate checks the field values on HoldStockRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *HoldStockRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HoldStockRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// HoldStockRequestMultiError, or nil if none found.
+func (m *HoldStockRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HoldStockRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUser() <= 0 {
+		err := HoldStockRequestValidationError{
+			field:  "User",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSku() <= 0 {
+		err := HoldStockRequestValidationError{
+			field:  "Sku",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetCount(); val <= 0 || val > 65535 {
+		err := HoldStockRequestValidationError{
+			field:  "Count",
+			reason: "value must be inside range (0, 65535]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetTtlSeconds(); val <= 0 || val > 86400 {
+		err := HoldStockRequestValidationError{
+			field:  "TtlSeconds",
+			reason: "value must be inside range (0, 86400]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return HoldStockRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// HoldStockRequestMultiError is an error wrapping multiple validation errors
+// returned by HoldStockRequest.ValidateAll() if the designated constraints
+// aren't met.
+type HoldStockRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HoldStockRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HoldStockRequestMultiError) AllErrors() []error { return m }
+
+// HoldStockRequestValidationError is the validation error returned by
+// HoldStockRequest.Validate if the designated constraints aren't met.
+type HoldStockRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HoldStockRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HoldStockRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HoldStockRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HoldStockRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HoldStockRequestValidationError) ErrorName() string { return "HoldStockRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HoldStockRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHoldStockRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HoldStockRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HoldStockRequestValidationError{}
+
+// Validate checks the field values on ReleaseHoldRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReleaseHoldRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReleaseHoldRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReleaseHoldRequestMultiError, or nil if none found.
+func (m *ReleaseHoldRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReleaseHoldRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUser() <= 0 {
+		err := ReleaseHoldRequestValidationError{
+			field:  "User",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSku() <= 0 {
+		err := ReleaseHoldRequestValidationError{
+			field:  "Sku",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReleaseHoldRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReleaseHoldRequestMultiError is an error wrapping multiple validation errors
+// returned by ReleaseHoldRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ReleaseHoldRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReleaseHoldRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReleaseHoldRequestMultiError) AllErrors() []error { return m }
+
+// ReleaseHoldRequestValidationError is the validation error returned by
+// ReleaseHoldRequest.Validate if the designated constraints aren't met.
+type ReleaseHoldRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReleaseHoldRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReleaseHoldRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReleaseHoldRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReleaseHoldRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReleaseHoldRequestValidationError) ErrorName() string {
+	return "ReleaseHoldRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReleaseHoldRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReleaseHoldRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReleaseHoldRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReleaseHoldRequestValidationError{}
+
+// Validate checks the field values on GetOrderHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetOrderHistoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOrderHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetOrderHistoryRequestMultiError, or nil if none found.
+func (m *GetOrderHistoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOrderHistoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOrderID() <= 0 {
+		err := GetOrderHistoryRequestValidationError{
+			field:  "OrderID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetOrderHistoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetOrderHistoryRequestMultiError is an error wrapping multiple validation
+// errors returned by GetOrderHistoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetOrderHistoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOrderHistoryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOrderHistoryRequestMultiError) AllErrors() []error { return m }
+
+// GetOrderHistoryRequestValidationError is the validation error returned by
+// GetOrderHistoryRequest.Validate if the designated constraints aren't met.
+type GetOrderHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOrderHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOrderHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOrderHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOrderHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOrderHistoryRequestValidationError) ErrorName() string {
+	return "GetOrderHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetOrderHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOrderHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOrderHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOrderHistoryRequestValidationError{}
+
+// Validate checks the field values on StatusChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StatusChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StatusChange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StatusChangeMultiError, or
+// nil if none found.
+func (m *StatusChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StatusChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for From
+
+	// no validation rules for To
+
+	// no validation rules for Reason
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StatusChangeValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StatusChangeValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StatusChangeValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StatusChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// StatusChangeMultiError is an error wrapping multiple validation errors
+// returned by StatusChange.ValidateAll() if the designated constraints aren't met.
+type StatusChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StatusChangeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StatusChangeMultiError) AllErrors() []error { return m }
+
+// StatusChangeValidationError is the validation error returned by
+// StatusChange.Validate if the designated constraints aren't met.
+type StatusChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StatusChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StatusChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StatusChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StatusChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StatusChangeValidationError) ErrorName() string { return "StatusChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StatusChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStatusChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StatusChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StatusChangeValidationError{}
+
+// Validate checks the field values on GetOrderHistoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetOrderHistoryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOrderHistoryResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetOrderHistoryResponseMultiError, or nil if none found.
+func (m *GetOrderHistoryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOrderHistoryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetOrderHistoryResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetOrderHistoryResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetOrderHistoryResponseValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetOrderHistoryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetOrderHistoryResponseMultiError is an error wrapping multiple validation
+// errors returned by GetOrderHistoryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetOrderHistoryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOrderHistoryResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOrderHistoryResponseMultiError) AllErrors() []error { return m }
+
+// GetOrderHistoryResponseValidationError is the validation error returned by
+// GetOrderHistoryResponse.Validate if the designated constraints aren't met.
+type GetOrderHistoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOrderHistoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOrderHistoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOrderHistoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOrderHistoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOrderHistoryResponseValidationError) ErrorName() string {
+	return "GetOrderHistoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetOrderHistoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOrderHistoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOrderHistoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOrderHistoryResponseValidationError{}
+
+// Validate checks the field values on ListUserOrdersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListUserOrdersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUserOrdersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUserOrdersRequestMultiError, or nil if none found.
+func (m *ListUserOrdersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUserOrdersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUser() <= 0 {
+		err := ListUserOrdersRequestValidationError{
+			field:  "User",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := OrderStatus_name[int32(m.GetStatus())]; !ok {
+		err := ListUserOrdersRequestValidationError{
+			field:  "Status",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListUserOrdersRequestValidationError{
+					field:  "CreatedFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListUserOrdersRequestValidationError{
+					field:  "CreatedFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListUserOrdersRequestValidationError{
+				field:  "CreatedFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListUserOrdersRequestValidationError{
+					field:  "CreatedTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListUserOrdersRequestValidationError{
+					field:  "CreatedTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListUserOrdersRequestValidationError{
+				field:  "CreatedTo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for PageToken
+
+	if m.GetPageSize() > 100 {
+		err := ListUserOrdersRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListUserOrdersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUserOrdersRequestMultiError is an error wrapping multiple validation
+// errors returned by ListUserOrdersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListUserOrdersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUserOrdersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUserOrdersRequestMultiError) AllErrors() []error { return m }
+
+// ListUserOrdersRequestValidationError is the validation error returned by
+// ListUserOrdersRequest.Validate if the designated constraints aren't met.
+type ListUserOrdersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUserOrdersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUserOrdersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUserOrdersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUserOrdersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUserOrdersRequestValidationError) ErrorName() string {
+	return "ListUserOrdersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUserOrdersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUserOrdersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUserOrdersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUserOrdersRequestValidationError{}
+
+// Validate checks the field values on ListUserOrdersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListUserOrdersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUserOrdersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUserOrdersResponseMultiError, or nil if none found.
+func (m *ListUserOrdersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUserOrdersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetOrders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListUserOrdersResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListUserOrdersResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListUserOrdersResponseValidationError{
+					field:  fmt.Sprintf("Orders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListUserOrdersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUserOrdersResponseMultiError is an error wrapping multiple validation
+// errors returned by ListUserOrdersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListUserOrdersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUserOrdersResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUserOrdersResponseMultiError) AllErrors() []error { return m }
+
+// ListUserOrdersResponseValidationError is the validation error returned by
+// ListUserOrdersResponse.Validate if the designated constraints aren't met.
+type ListUserOrdersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUserOrdersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUserOrdersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUserOrdersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUserOrdersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUserOrdersResponseValidationError) ErrorName() string {
+	return "ListUserOrdersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUserOrdersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUserOrdersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUserOrdersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUserOrdersResponseValidationError{}
+
+// Validate checks the field values on UpdateOrderItemsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateOrderItemsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateOrderItemsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateOrderItemsRequestMultiError, or nil if none found.
+func (m *UpdateOrderItemsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateOrderItemsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOrderID() <= 0 {
+		err := UpdateOrderItemsRequestValidationError{
+			field:  "OrderID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetItems()) < 1 {
+		err := UpdateOrderItemsRequestValidationError{
+			field:  "Items",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateOrderItemsRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateOrderItemsRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateOrderItemsRequestValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateOrderItemsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateOrderItemsRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateOrderItemsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateOrderItemsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateOrderItemsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateOrderItemsRequestMultiError) AllErrors() []error { return m }
+
+// UpdateOrderItemsRequestValidationError is the validation error returned by
+// UpdateOrderItemsRequest.Validate if the designated constraints aren't met.
+type UpdateOrderItemsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateOrderItemsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateOrderItemsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateOrderItemsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateOrderItemsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateOrderItemsRequestValidationError) ErrorName() string {
+	return "UpdateOrderItemsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateOrderItemsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateOrderItemsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateOrderItemsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateOrderItemsRequestValidationError{}
+
+// Validate checks the field values on CreateSellerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSellerRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSellerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateSellerRequestMultiError, or nil if none found.
+func (m *CreateSellerRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSellerRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 255 {
+		err := CreateSellerRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateSellerRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateSellerRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateSellerRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateSellerRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSellerRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSellerRequestMultiError) AllErrors() []error { return m }
+
+// CreateSellerRequestValidationError is the validation error returned by
+// CreateSellerRequest.Validate if the designated constraints aren't met.
+type CreateSellerRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSellerRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSellerRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSellerRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSellerRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSellerRequestValidationError) ErrorName() string {
+	return "CreateSellerRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSellerRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSellerRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSellerRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSellerRequestValidationError{}
+
+// Validate checks the field values on CreateSellerResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSellerResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSellerResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateSellerResponseMultiError, or nil if none found.
+func (m *CreateSellerResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSellerResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SellerID
+
+	if len(errors) > 0 {
+		return CreateSellerResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateSellerResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateSellerResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateSellerResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSellerResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSellerResponseMultiError) AllErrors() []error { return m }
+
+// CreateSellerResponseValidationError is the validation error returned by
+// CreateSellerResponse.Validate if the designated constraints aren't met.
+type CreateSellerResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSellerResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSellerResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSellerResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSellerResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSellerResponseValidationError) ErrorName() string {
+	return "CreateSellerResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSellerResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSellerResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSellerResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSellerResponseValidationError{}
+
+// Validate checks the field values on ListSellerOrdersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSellerOrdersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSellerOrdersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSellerOrdersRequestMultiError, or nil if none found.
+func (m *ListSellerOrdersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSellerOrdersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSellerID() < 0 {
+		err := ListSellerOrdersRequestValidationError{
+			field:  "SellerID",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := OrderStatus_name[int32(m.GetStatus())]; !ok {
+		err := ListSellerOrdersRequestValidationError{
+			field:  "Status",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if m.GetPageSize() > 100 {
+		err := ListSellerOrdersRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListSellerOrdersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSellerOrdersRequestMultiError is an error wrapping multiple validation
+// errors returned by ListSellerOrdersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListSellerOrdersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSellerOrdersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSellerOrdersRequestMultiError) AllErrors() []error { return m }
+
+// ListSellerOrdersRequestValidationError is the validation error returned by
+// ListSellerOrdersRequest.Validate if the designated constraints aren't met.
+type ListSellerOrdersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSellerOrdersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSellerOrdersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSellerOrdersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSellerOrdersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSellerOrdersRequestValidationError) ErrorName() string {
+	return "ListSellerOrdersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSellerOrdersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSellerOrdersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSellerOrdersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSellerOrdersRequestValidationError{}
+
+// Validate checks the field values on SellerOrder with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SellerOrder) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SellerOrder with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SellerOrderMultiError, or
+// nil if none found.
+func (m *SellerOrder) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SellerOrder) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for OrderID
+
+	// no validation rules for SellerID
+
+	// no validation rules for Status
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SellerOrderValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SellerOrderValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SellerOrderValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SellerOrderValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SellerOrderValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SellerOrderValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SellerOrderMultiError(errors)
+	}
+
+	return nil
+}
+
+// SellerOrderMultiError is an error wrapping multiple validation errors
+// returned by SellerOrder.ValidateAll() if the designated constraints aren't met.
+type SellerOrderMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SellerOrderMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SellerOrderMultiError) AllErrors() []error { return m }
+
+// SellerOrderValidationError is the validation error returned by
+// SellerOrder.Validate if the designated constraints aren't met.
+type SellerOrderValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SellerOrderValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SellerOrderValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SellerOrderValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SellerOrderValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SellerOrderValidationError) ErrorName() string { return "SellerOrderValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SellerOrderValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSellerOrder.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SellerOrderValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SellerOrderValidationError{}
+
+// Validate checks the field values on ListSellerOrdersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSellerOrdersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSellerOrdersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSellerOrdersResponseMultiError, or nil if none found.
+func (m *ListSellerOrdersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSellerOrdersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetOrders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSellerOrdersResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSellerOrdersResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSellerOrdersResponseValidationError{
+					field:  fmt.Sprintf("Orders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListSellerOrdersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSellerOrdersResponseMultiError is an error wrapping multiple validation
+// errors returned by ListSellerOrdersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListSellerOrdersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSellerOrdersResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSellerOrdersResponseMultiError) AllErrors() []error { return m }
+
+// ListSellerOrdersResponseValidationError is the validation error returned by
+// ListSellerOrdersResponse.Validate if the designated constraints aren't met.
+type ListSellerOrdersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSellerOrdersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSellerOrdersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSellerOrdersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSellerOrdersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSellerOrdersResponseValidationError) ErrorName() string {
+	return "ListSellerOrdersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSellerOrdersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSellerOrdersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSellerOrdersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSellerOrdersResponseValidationError{}
+
+// Validate checks the field values on CreateWarehouseRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateWarehouseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateWarehouseRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateWarehouseRequestMultiError, or nil if none found.
+func (m *CreateWarehouseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateWarehouseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 255 {
+		err := CreateWarehouseRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSellerID() < 0 {
+		err := CreateWarehouseRequestValidationError{
+			field:  "SellerID",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateWarehouseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateWarehouseRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateWarehouseRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateWarehouseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateWarehouseRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateWarehouseRequestMultiError) AllErrors() []error { return m }
+
+// CreateWarehouseRequestValidationError is the validation error returned by
+// CreateWarehouseRequest.Validate if the designated constraints aren't met.
+type CreateWarehouseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateWarehouseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateWarehouseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateWarehouseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateWarehouseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateWarehouseRequestValidationError) ErrorName() string {
+	return "CreateWarehouseRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateWarehouseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateWarehouseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateWarehouseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateWarehouseRequestValidationError{}
+
+// Validate checks the field values on CreateWarehouseResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateWarehouseResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateWarehouseResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateWarehouseResponseMultiError, or nil if none found.
+func (m *CreateWarehouseResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateWarehouseResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for WarehouseID
+
+	if len(errors) > 0 {
+		return CreateWarehouseResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateWarehouseResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateWarehouseResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateWarehouseResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateWarehouseResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateWarehouseResponseMultiError) AllErrors() []error { return m }
+
+// CreateWarehouseResponseValidationError is the validation error returned by
+// CreateWarehouseResponse.Validate if the designated constraints aren't met.
+type CreateWarehouseResponseValidationError struct {
+	field  string
+	reason string
 	cause  error
 	key    bool
 }
 
 // Field function returns field value.
-func (e ListOrderResponseValidationError) Field() string { return e.field }
+func (e CreateWarehouseResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListOrderResponseValidationError) Reason() string { return e.reason }
+func (e CreateWarehouseResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListOrderResponseValidationError) Cause() error { return e.cause }
+func (e CreateWarehouseResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListOrderResponseValidationError) Key() bool { return e.key }
+func (e CreateWarehouseResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListOrderResponseValidationError) ErrorName() string {
-	return "ListOrderResponseValidationError"
+func (e CreateWarehouseResponseValidationError) ErrorName() string {
+	return "CreateWarehouseResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListOrderResponseValidationError) Error() string {
+func (e CreateWarehouseResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -778,14 +5547,14 @@ func (e ListOrderResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListOrderResponse.%s: %s%s",
+		"invalid %sCreateWarehouseResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListOrderResponseValidationError{}
+var _ error = CreateWarehouseResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -793,33 +5562,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListOrderResponseValidationError{}
+} = CreateWarehouseResponseValidationError{}
 
-// Validate checks the field values on OrderPayedRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *OrderPayedRequest) Validate() error {
+// Validate checks the field values on ReceiveStockRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReceiveStockRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on OrderPayedRequest with the rules
+// ValidateAll checks the field values on ReceiveStockRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// OrderPayedRequestMultiError, or nil if none found.
-func (m *OrderPayedRequest) ValidateAll() error {
+// ReceiveStockRequestMultiError, or nil if none found.
+func (m *ReceiveStockRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *OrderPayedRequest) validate(all bool) error {
+func (m *ReceiveStockRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetOrderID() <= 0 {
-		err := OrderPayedRequestValidationError{
-			field:  "OrderID",
+	if m.GetWarehouseID() <= 0 {
+		err := ReceiveStockRequestValidationError{
+			field:  "WarehouseID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSku() <= 0 {
+		err := ReceiveStockRequestValidationError{
+			field:  "Sku",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCount() <= 0 {
+		err := ReceiveStockRequestValidationError{
+			field:  "Count",
 			reason: "value must be greater than 0",
 		}
 		if !all {
@@ -829,19 +5620,19 @@ func (m *OrderPayedRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return OrderPayedRequestMultiError(errors)
+		return ReceiveStockRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// OrderPayedRequestMultiError is an error wrapping multiple validation errors
-// returned by OrderPayedRequest.ValidateAll() if the designated constraints
-// aren't met.
-type OrderPayedRequestMultiError []error
+// ReceiveStockRequestMultiError is an error wrapping multiple validation
+// errors returned by ReceiveStockRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReceiveStockRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m OrderPayedRequestMultiError) Error() string {
+func (m ReceiveStockRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -850,11 +5641,11 @@ func (m OrderPayedRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m OrderPayedRequestMultiError) AllErrors() []error { return m }
+func (m ReceiveStockRequestMultiError) AllErrors() []error { return m }
 
-// OrderPayedRequestValidationError is the validation error returned by
-// OrderPayedRequest.Validate if the designated constraints aren't met.
-type OrderPayedRequestValidationError struct {
+// ReceiveStockRequestValidationError is the validation error returned by
+// ReceiveStockRequest.Validate if the designated constraints aren't met.
+type ReceiveStockRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -862,24 +5653,24 @@ type OrderPayedRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e OrderPayedRequestValidationError) Field() string { return e.field }
+func (e ReceiveStockRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e OrderPayedRequestValidationError) Reason() string { return e.reason }
+func (e ReceiveStockRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e OrderPayedRequestValidationError) Cause() error { return e.cause }
+func (e ReceiveStockRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e OrderPayedRequestValidationError) Key() bool { return e.key }
+func (e ReceiveStockRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e OrderPayedRequestValidationError) ErrorName() string {
-	return "OrderPayedRequestValidationError"
+func (e ReceiveStockRequestValidationError) ErrorName() string {
+	return "ReceiveStockRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e OrderPayedRequestValidationError) Error() string {
+func (e ReceiveStockRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -891,14 +5682,14 @@ func (e OrderPayedRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sOrderPayedRequest.%s: %s%s",
+		"invalid %sReceiveStockRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = OrderPayedRequestValidationError{}
+var _ error = ReceiveStockRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -906,33 +5697,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = OrderPayedRequestValidationError{}
+} = ReceiveStockRequestValidationError{}
 
-// Validate checks the field values on CancelOrderRequest with the rules
+// Validate checks the field values on AdjustStockRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CancelOrderRequest) Validate() error {
+func (m *AdjustStockRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CancelOrderRequest with the rules
+// ValidateAll checks the field values on AdjustStockRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CancelOrderRequestMultiError, or nil if none found.
-func (m *CancelOrderRequest) ValidateAll() error {
+// AdjustStockRequestMultiError, or nil if none found.
+func (m *AdjustStockRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CancelOrderRequest) validate(all bool) error {
+func (m *AdjustStockRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetOrderID() <= 0 {
-		err := CancelOrderRequestValidationError{
-			field:  "OrderID",
+	if m.GetWarehouseID() <= 0 {
+		err := AdjustStockRequestValidationError{
+			field:  "WarehouseID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSku() <= 0 {
+		err := AdjustStockRequestValidationError{
+			field:  "Sku",
 			reason: "value must be greater than 0",
 		}
 		if !all {
@@ -941,20 +5743,42 @@ func (m *CancelOrderRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if _, ok := _AdjustStockRequest_Delta_NotInLookup[m.GetDelta()]; ok {
+		err := AdjustStockRequestValidationError{
+			field:  "Delta",
+			reason: "value must not be in list [0]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetReason()); l < 1 || l > 255 {
+		err := AdjustStockRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return CancelOrderRequestMultiError(errors)
+		return AdjustStockRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CancelOrderRequestMultiError is an error wrapping multiple validation errors
-// returned by CancelOrderRequest.ValidateAll() if the designated constraints
+// AdjustStockRequestMultiError is an error wrapping multiple validation errors
+// returned by AdjustStockRequest.ValidateAll() if the designated constraints
 // aren't met.
-type CancelOrderRequestMultiError []error
+type AdjustStockRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CancelOrderRequestMultiError) Error() string {
+func (m AdjustStockRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -963,11 +5787,11 @@ func (m CancelOrderRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CancelOrderRequestMultiError) AllErrors() []error { return m }
+func (m AdjustStockRequestMultiError) AllErrors() []error { return m }
 
-// CancelOrderRequestValidationError is the validation error returned by
-// CancelOrderRequest.Validate if the designated constraints aren't met.
-type CancelOrderRequestValidationError struct {
+// AdjustStockRequestValidationError is the validation error returned by
+// AdjustStockRequest.Validate if the designated constraints aren't met.
+type AdjustStockRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -975,24 +5799,24 @@ type CancelOrderRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CancelOrderRequestValidationError) Field() string { return e.field }
+func (e AdjustStockRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CancelOrderRequestValidationError) Reason() string { return e.reason }
+func (e AdjustStockRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CancelOrderRequestValidationError) Cause() error { return e.cause }
+func (e AdjustStockRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CancelOrderRequestValidationError) Key() bool { return e.key }
+func (e AdjustStockRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CancelOrderRequestValidationError) ErrorName() string {
-	return "CancelOrderRequestValidationError"
+func (e AdjustStockRequestValidationError) ErrorName() string {
+	return "AdjustStockRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CancelOrderRequestValidationError) Error() string {
+func (e AdjustStockRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1004,14 +5828,14 @@ func (e CancelOrderRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCancelOrderRequest.%s: %s%s",
+		"invalid %sAdjustStockRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CancelOrderRequestValidationError{}
+var _ error = AdjustStockRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1019,33 +5843,37 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CancelOrderRequestValidationError{}
+} = AdjustStockRequestValidationError{}
 
-// Validate checks the field values on StocksRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *StocksRequest) Validate() error {
+var _AdjustStockRequest_Delta_NotInLookup = map[int64]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on ListWarehouseStockRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListWarehouseStockRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on StocksRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in StocksRequestMultiError, or
-// nil if none found.
-func (m *StocksRequest) ValidateAll() error {
+// ValidateAll checks the field values on ListWarehouseStockRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListWarehouseStockRequestMultiError, or nil if none found.
+func (m *ListWarehouseStockRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *StocksRequest) validate(all bool) error {
+func (m *ListWarehouseStockRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetSku() <= 0 {
-		err := StocksRequestValidationError{
-			field:  "Sku",
+	if m.GetWarehouseID() <= 0 {
+		err := ListWarehouseStockRequestValidationError{
+			field:  "WarehouseID",
 			reason: "value must be greater than 0",
 		}
 		if !all {
@@ -1055,19 +5883,19 @@ func (m *StocksRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return StocksRequestMultiError(errors)
+		return ListWarehouseStockRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// StocksRequestMultiError is an error wrapping multiple validation errors
-// returned by StocksRequest.ValidateAll() if the designated constraints
-// aren't met.
-type StocksRequestMultiError []error
+// ListWarehouseStockRequestMultiError is an error wrapping multiple validation
+// errors returned by ListWarehouseStockRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListWarehouseStockRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m StocksRequestMultiError) Error() string {
+func (m ListWarehouseStockRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1076,11 +5904,11 @@ func (m StocksRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m StocksRequestMultiError) AllErrors() []error { return m }
+func (m ListWarehouseStockRequestMultiError) AllErrors() []error { return m }
 
-// StocksRequestValidationError is the validation error returned by
-// StocksRequest.Validate if the designated constraints aren't met.
-type StocksRequestValidationError struct {
+// ListWarehouseStockRequestValidationError is the validation error returned by
+// ListWarehouseStockRequest.Validate if the designated constraints aren't met.
+type ListWarehouseStockRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1088,22 +5916,24 @@ type StocksRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e StocksRequestValidationError) Field() string { return e.field }
+func (e ListWarehouseStockRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e StocksRequestValidationError) Reason() string { return e.reason }
+func (e ListWarehouseStockRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e StocksRequestValidationError) Cause() error { return e.cause }
+func (e ListWarehouseStockRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e StocksRequestValidationError) Key() bool { return e.key }
+func (e ListWarehouseStockRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e StocksRequestValidationError) ErrorName() string { return "StocksRequestValidationError" }
+func (e ListWarehouseStockRequestValidationError) ErrorName() string {
+	return "ListWarehouseStockRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e StocksRequestValidationError) Error() string {
+func (e ListWarehouseStockRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1115,14 +5945,14 @@ func (e StocksRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sStocksRequest.%s: %s%s",
+		"invalid %sListWarehouseStockRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = StocksRequestValidationError{}
+var _ error = ListWarehouseStockRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1130,46 +5960,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = StocksRequestValidationError{}
+} = ListWarehouseStockRequestValidationError{}
 
-// Validate checks the field values on Stock with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on WarehouseStock with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Stock) Validate() error {
+func (m *WarehouseStock) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Stock with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in StockMultiError, or nil if none found.
-func (m *Stock) ValidateAll() error {
+// ValidateAll checks the field values on WarehouseStock with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WarehouseStockMultiError,
+// or nil if none found.
+func (m *WarehouseStock) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Stock) validate(all bool) error {
+func (m *WarehouseStock) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for WarehouseID
+	// no validation rules for Sku
 
 	// no validation rules for Count
 
+	// no validation rules for Reserved
+
+	// no validation rules for Held
+
 	if len(errors) > 0 {
-		return StockMultiError(errors)
+		return WarehouseStockMultiError(errors)
 	}
 
 	return nil
 }
 
-// StockMultiError is an error wrapping multiple validation errors returned by
-// Stock.ValidateAll() if the designated constraints aren't met.
-type StockMultiError []error
+// WarehouseStockMultiError is an error wrapping multiple validation errors
+// returned by WarehouseStock.ValidateAll() if the designated constraints
+// aren't met.
+type WarehouseStockMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m StockMultiError) Error() string {
+func (m WarehouseStockMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1178,11 +6014,11 @@ func (m StockMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m StockMultiError) AllErrors() []error { return m }
+func (m WarehouseStockMultiError) AllErrors() []error { return m }
 
-// StockValidationError is the validation error returned by Stock.Validate if
-// the designated constraints aren't met.
-type StockValidationError struct {
+// WarehouseStockValidationError is the validation error returned by
+// WarehouseStock.Validate if the designated constraints aren't met.
+type WarehouseStockValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1190,22 +6026,22 @@ type StockValidationError struct {
 }
 
 // Field function returns field value.
-func (e StockValidationError) Field() string { return e.field }
+func (e WarehouseStockValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e StockValidationError) Reason() string { return e.reason }
+func (e WarehouseStockValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e StockValidationError) Cause() error { return e.cause }
+func (e WarehouseStockValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e StockValidationError) Key() bool { return e.key }
+func (e WarehouseStockValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e StockValidationError) ErrorName() string { return "StockValidationError" }
+func (e WarehouseStockValidationError) ErrorName() string { return "WarehouseStockValidationError" }
 
 // Error satisfies the builtin error interface
-func (e StockValidationError) Error() string {
+func (e WarehouseStockValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1217,14 +6053,14 @@ func (e StockValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sStock.%s: %s%s",
+		"invalid %sWarehouseStock.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = StockValidationError{}
+var _ error = WarehouseStockValidationError{}
 
 var _ interface {
 	Field() string
@@ -1232,24 +6068,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = StockValidationError{}
+} = WarehouseStockValidationError{}
 
-// Validate checks the field values on StocksResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *StocksResponse) Validate() error {
+// Validate checks the field values on ListWarehouseStockResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListWarehouseStockResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on StocksResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in StocksResponseMultiError,
-// or nil if none found.
-func (m *StocksResponse) ValidateAll() error {
+// ValidateAll checks the field values on ListWarehouseStockResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListWarehouseStockResponseMultiError, or nil if none found.
+func (m *ListWarehouseStockResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *StocksResponse) validate(all bool) error {
+func (m *ListWarehouseStockResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1263,7 +6099,7 @@ func (m *StocksResponse) validate(all bool) error {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, StocksResponseValidationError{
+					errors = append(errors, ListWarehouseStockResponseValidationError{
 						field:  fmt.Sprintf("Stocks[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -1271,7 +6107,7 @@ func (m *StocksResponse) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, StocksResponseValidationError{
+					errors = append(errors, ListWarehouseStockResponseValidationError{
 						field:  fmt.Sprintf("Stocks[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -1280,7 +6116,7 @@ func (m *StocksResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return StocksResponseValidationError{
+				return ListWarehouseStockResponseValidationError{
 					field:  fmt.Sprintf("Stocks[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1291,19 +6127,19 @@ func (m *StocksResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return StocksResponseMultiError(errors)
+		return ListWarehouseStockResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// StocksResponseMultiError is an error wrapping multiple validation errors
-// returned by StocksResponse.ValidateAll() if the designated constraints
-// aren't met.
-type StocksResponseMultiError []error
+// ListWarehouseStockResponseMultiError is an error wrapping multiple
+// validation errors returned by ListWarehouseStockResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListWarehouseStockResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m StocksResponseMultiError) Error() string {
+func (m ListWarehouseStockResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1312,11 +6148,11 @@ func (m StocksResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m StocksResponseMultiError) AllErrors() []error { return m }
+func (m ListWarehouseStockResponseMultiError) AllErrors() []error { return m }
 
-// StocksResponseValidationError is the validation error returned by
-// StocksResponse.Validate if the designated constraints aren't met.
-type StocksResponseValidationError struct {
+// ListWarehouseStockResponseValidationError is the validation error returned
+// by ListWarehouseStockResponse.Validate if the designated constraints aren't met.
+type ListWarehouseStockResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1324,22 +6160,24 @@ type StocksResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e StocksResponseValidationError) Field() string { return e.field }
+func (e ListWarehouseStockResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e StocksResponseValidationError) Reason() string { return e.reason }
+func (e ListWarehouseStockResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e StocksResponseValidationError) Cause() error { return e.cause }
+func (e ListWarehouseStockResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e StocksResponseValidationError) Key() bool { return e.key }
+func (e ListWarehouseStockResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e StocksResponseValidationError) ErrorName() string { return "StocksResponseValidationError" }
+func (e ListWarehouseStockResponseValidationError) ErrorName() string {
+	return "ListWarehouseStockResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e StocksResponseValidationError) Error() string {
+func (e ListWarehouseStockResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1351,14 +6189,14 @@ func (e StocksResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sStocksResponse.%s: %s%s",
+		"invalid %sListWarehouseStockResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = StocksResponseValidationError{}
+var _ error = ListWarehouseStockResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1366,4 +6204,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = StocksResponseValidationError{}
+} = ListWarehouseStockResponseValidationError{}
@@ -27,12 +27,50 @@ type LOMSV1Client interface {
 	CreateOrder(ctx context.Context, in *CreateOrderRequest, opts ...grpc.CallOption) (*CreateOrderResponse, error)
 	// Показывает информацию по заказу
 	ListOrder(ctx context.Context, in *ListOrderRequest, opts ...grpc.CallOption) (*ListOrderResponse, error)
-	// Помечает заказ оплаченным
+	// Помечает заказ оплаченным без платежа
 	OrderPayed(ctx context.Context, in *OrderPayedRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Создает платеж по заказу у платежного провайдера
+	InitiatePayment(ctx context.Context, in *InitiatePaymentRequest, opts ...grpc.CallOption) (*InitiatePaymentResponse, error)
+	// Регистрирует возврат части товаров оплаченного заказа
+	RequestReturn(ctx context.Context, in *RequestReturnRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Принимает возвращенные товары на склад и возвращает деньги за них
+	CompleteReturn(ctx context.Context, in *CompleteReturnRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Начинает сборку оплаченного заказа, по отправлению на каждый склад
+	AssembleOrder(ctx context.Context, in *AssembleOrderRequest, opts ...grpc.CallOption) (*AssembleOrderResponse, error)
+	// Отмечает отправление переданным в доставку
+	ShipShipment(ctx context.Context, in *ShipShipmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Отмечает отправление доставленным
+	DeliverShipment(ctx context.Context, in *DeliverShipmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Отменяет заказ
 	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Возвращает количество товаров, которые можно купить с разных складов.
 	Stocks(ctx context.Context, in *StocksRequest, opts ...grpc.CallOption) (*StocksResponse, error)
+	// Возвращает количество товаров, которые можно купить с разных складов, сразу для нескольких sku
+	BatchStocks(ctx context.Context, in *BatchStocksRequest, opts ...grpc.CallOption) (*BatchStocksResponse, error)
+	// Присылает изменения количества товаров, доступных для покупки, по мере их появления
+	WatchStocks(ctx context.Context, in *WatchStocksRequest, opts ...grpc.CallOption) (LOMSV1_WatchStocksClient, error)
+	// Удерживает товар для корзины пользователя на время ttl
+	HoldStock(ctx context.Context, in *HoldStockRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Снимает удержание товара для корзины пользователя
+	ReleaseHold(ctx context.Context, in *ReleaseHoldRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Показывает историю изменения статусов заказа
+	GetOrderHistory(ctx context.Context, in *GetOrderHistoryRequest, opts ...grpc.CallOption) (*GetOrderHistoryResponse, error)
+	// Показывает заказы пользователя, начиная с новых
+	ListUserOrders(ctx context.Context, in *ListUserOrdersRequest, opts ...grpc.CallOption) (*ListUserOrdersResponse, error)
+	// Изменяет состав заказа, ожидающего оплаты, и пересчитывает резерв
+	UpdateOrderItems(ctx context.Context, in *UpdateOrderItemsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Регистрирует продавца маркетплейса
+	CreateSeller(ctx context.Context, in *CreateSellerRequest, opts ...grpc.CallOption) (*CreateSellerResponse, error)
+	// Показывает заказы продавца, начиная с новых
+	ListSellerOrders(ctx context.Context, in *ListSellerOrdersRequest, opts ...grpc.CallOption) (*ListSellerOrdersResponse, error)
+	// Регистрирует новый склад
+	CreateWarehouse(ctx context.Context, in *CreateWarehouseRequest, opts ...grpc.CallOption) (*CreateWarehouseResponse, error)
+	// Принимает товар на склад
+	ReceiveStock(ctx context.Context, in *ReceiveStockRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Корректирует остаток товара на складе, например, после инвентаризации
+	AdjustStock(ctx context.Context, in *AdjustStockRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Показывает остатки и резервы всех товаров склада
+	ListWarehouseStock(ctx context.Context, in *ListWarehouseStockRequest, opts ...grpc.CallOption) (*ListWarehouseStockResponse, error)
 }
 
 type lOMSV1Client struct {
@@ -70,6 +108,60 @@ func (c *lOMSV1Client) OrderPayed(ctx context.Context, in *OrderPayedRequest, op
 	return out, nil
 }
 
+func (c *lOMSV1Client) InitiatePayment(ctx context.Context, in *InitiatePaymentRequest, opts ...grpc.CallOption) (*InitiatePaymentResponse, error) {
+	out := new(InitiatePaymentResponse)
+	err := c.cc.Invoke(ctx, "/loms_v1.LOMSV1/InitiatePayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lOMSV1Client) RequestReturn(ctx context.Context, in *RequestReturnRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/loms_v1.LOMSV1/RequestReturn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lOMSV1Client) CompleteReturn(ctx context.Context, in *CompleteReturnRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/loms_v1.LOMSV1/CompleteReturn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lOMSV1Client) AssembleOrder(ctx context.Context, in *AssembleOrderRequest, opts ...grpc.CallOption) (*AssembleOrderResponse, error) {
+	out := new(AssembleOrderResponse)
+	err := c.cc.Invoke(ctx, "/loms_v1.LOMSV1/AssembleOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lOMSV1Client) ShipShipment(ctx context.Context, in *ShipShipmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/loms_v1.LOMSV1/ShipShipment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lOMSV1Client) DeliverShipment(ctx context.Context, in *DeliverShipmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/loms_v1.LOMSV1/DeliverShipment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lOMSV1Client) CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/loms_v1.LOMSV1/CancelOrder", in, out, opts...)
@@ -88,6 +180,146 @@ func (c *lOMSV1Client) Stocks(ctx context.Context, in *StocksRequest, opts ...gr
 	return out, nil
 }
 
+func (c *lOMSV1Client) BatchStocks(ctx context.Context, in *BatchStocksRequest, opts ...grpc.CallOption) (*BatchStocksResponse, error) {
+	out := new(BatchStocksResponse)
+	err := c.cc.Invoke(ctx, "/loms_v1.LOMSV1/BatchStocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lOMSV1Client) WatchStocks(ctx context.Context, in *WatchStocksRequest, opts ...grpc.CallOption) (LOMSV1_WatchStocksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &LOMSV1_ServiceDesc.Streams[0], "/loms_v1.LOMSV1/WatchStocks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lOMSV1WatchStocksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type LOMSV1_WatchStocksClient interface {
+	Recv() (*StockChange, error)
+	grpc.ClientStream
+}
+
+type lOMSV1WatchStocksClient struct {
+	grpc.ClientStream
+}
+
+func (x *lOMSV1WatchStocksClient) Recv() (*StockChange, error) {
+	m := new(StockChange)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lOMSV1Client) HoldStock(ctx context.Context, in *HoldStockRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/loms_v1.LOMSV1/HoldStock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lOMSV1Client) ReleaseHold(ctx context.Context, in *ReleaseHoldRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/loms_v1.LOMSV1/ReleaseHold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lOMSV1Client) GetOrderHistory(ctx context.Context, in *GetOrderHistoryRequest, opts ...grpc.CallOption) (*GetOrderHistoryResponse, error) {
+	out := new(GetOrderHistoryResponse)
+	err := c.cc.Invoke(ctx, "/loms_v1.LOMSV1/GetOrderHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lOMSV1Client) ListUserOrders(ctx context.Context, in *ListUserOrdersRequest, opts ...grpc.CallOption) (*ListUserOrdersResponse, error) {
+	out := new(ListUserOrdersResponse)
+	err := c.cc.Invoke(ctx, "/loms_v1.LOMSV1/ListUserOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lOMSV1Client) UpdateOrderItems(ctx context.Context, in *UpdateOrderItemsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/loms_v1.LOMSV1/UpdateOrderItems", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lOMSV1Client) CreateSeller(ctx context.Context, in *CreateSellerRequest, opts ...grpc.CallOption) (*CreateSellerResponse, error) {
+	out := new(CreateSellerResponse)
+	err := c.cc.Invoke(ctx, "/loms_v1.LOMSV1/CreateSeller", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lOMSV1Client) ListSellerOrders(ctx context.Context, in *ListSellerOrdersRequest, opts ...grpc.CallOption) (*ListSellerOrdersResponse, error) {
+	out := new(ListSellerOrdersResponse)
+	err := c.cc.Invoke(ctx, "/loms_v1.LOMSV1/ListSellerOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lOMSV1Client) CreateWarehouse(ctx context.Context, in *CreateWarehouseRequest, opts ...grpc.CallOption) (*CreateWarehouseResponse, error) {
+	out := new(CreateWarehouseResponse)
+	err := c.cc.Invoke(ctx, "/loms_v1.LOMSV1/CreateWarehouse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lOMSV1Client) ReceiveStock(ctx context.Context, in *ReceiveStockRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/loms_v1.LOMSV1/ReceiveStock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lOMSV1Client) AdjustStock(ctx context.Context, in *AdjustStockRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/loms_v1.LOMSV1/AdjustStock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lOMSV1Client) ListWarehouseStock(ctx context.Context, in *ListWarehouseStockRequest, opts ...grpc.CallOption) (*ListWarehouseStockResponse, error) {
+	out := new(ListWarehouseStockResponse)
+	err := c.cc.Invoke(ctx, "/loms_v1.LOMSV1/ListWarehouseStock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LOMSV1Server is the server API for LOMSV1 service.
 // All implementations must embed UnimplementedLOMSV1Server
 // for forward compatibility
@@ -96,12 +328,50 @@ type LOMSV1Server interface {
 	CreateOrder(context.Context, *CreateOrderRequest) (*CreateOrderResponse, error)
 	// Показывает информацию по заказу
 	ListOrder(context.Context, *ListOrderRequest) (*ListOrderResponse, error)
-	// Помечает заказ оплаченным
+	// Помечает заказ оплаченным без платежа
 	OrderPayed(context.Context, *OrderPayedRequest) (*emptypb.Empty, error)
+	// Создает платеж по заказу у платежного провайдера
+	InitiatePayment(context.Context, *InitiatePaymentRequest) (*InitiatePaymentResponse, error)
+	// Регистрирует возврат части товаров оплаченного заказа
+	RequestReturn(context.Context, *RequestReturnRequest) (*emptypb.Empty, error)
+	// Принимает возвращенные товары на склад и возвращает деньги за них
+	CompleteReturn(context.Context, *CompleteReturnRequest) (*emptypb.Empty, error)
+	// Начинает сборку оплаченного заказа, по отправлению на каждый склад
+	AssembleOrder(context.Context, *AssembleOrderRequest) (*AssembleOrderResponse, error)
+	// Отмечает отправление переданным в доставку
+	ShipShipment(context.Context, *ShipShipmentRequest) (*emptypb.Empty, error)
+	// Отмечает отправление доставленным
+	DeliverShipment(context.Context, *DeliverShipmentRequest) (*emptypb.Empty, error)
 	// Отменяет заказ
 	CancelOrder(context.Context, *CancelOrderRequest) (*emptypb.Empty, error)
 	// Возвращает количество товаров, которые можно купить с разных складов.
 	Stocks(context.Context, *StocksRequest) (*StocksResponse, error)
+	// Возвращает количество товаров, которые можно купить с разных складов, сразу для нескольких sku
+	BatchStocks(context.Context, *BatchStocksRequest) (*BatchStocksResponse, error)
+	// Присылает изменения количества товаров, доступных для покупки, по мере их появления
+	WatchStocks(*WatchStocksRequest, LOMSV1_WatchStocksServer) error
+	// Удерживает товар для корзины пользователя на время ttl
+	HoldStock(context.Context, *HoldStockRequest) (*emptypb.Empty, error)
+	// Снимает удержание товара для корзины пользователя
+	ReleaseHold(context.Context, *ReleaseHoldRequest) (*emptypb.Empty, error)
+	// Показывает историю изменения статусов заказа
+	GetOrderHistory(context.Context, *GetOrderHistoryRequest) (*GetOrderHistoryResponse, error)
+	// Показывает заказы пользователя, начиная с новых
+	ListUserOrders(context.Context, *ListUserOrdersRequest) (*ListUserOrdersResponse, error)
+	// Изменяет состав заказа, ожидающего оплаты, и пересчитывает резерв
+	UpdateOrderItems(context.Context, *UpdateOrderItemsRequest) (*emptypb.Empty, error)
+	// Регистрирует продавца маркетплейса
+	CreateSeller(context.Context, *CreateSellerRequest) (*CreateSellerResponse, error)
+	// Показывает заказы продавца, начиная с новых
+	ListSellerOrders(context.Context, *ListSellerOrdersRequest) (*ListSellerOrdersResponse, error)
+	// Регистрирует новый склад
+	CreateWarehouse(context.Context, *CreateWarehouseRequest) (*CreateWarehouseResponse, error)
+	// Принимает товар на склад
+	ReceiveStock(context.Context, *ReceiveStockRequest) (*emptypb.Empty, error)
+	// Корректирует остаток товара на складе, например, после инвентаризации
+	AdjustStock(context.Context, *AdjustStockRequest) (*emptypb.Empty, error)
+	// Показывает остатки и резервы всех товаров склада
+	ListWarehouseStock(context.Context, *ListWarehouseStockRequest) (*ListWarehouseStockResponse, error)
 	mustEmbedUnimplementedLOMSV1Server()
 }
 
@@ -118,12 +388,69 @@ func (UnimplementedLOMSV1Server) ListOrder(context.Context, *ListOrderRequest) (
 func (UnimplementedLOMSV1Server) OrderPayed(context.Context, *OrderPayedRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OrderPayed not implemented")
 }
+func (UnimplementedLOMSV1Server) InitiatePayment(context.Context, *InitiatePaymentRequest) (*InitiatePaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitiatePayment not implemented")
+}
+func (UnimplementedLOMSV1Server) RequestReturn(context.Context, *RequestReturnRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestReturn not implemented")
+}
+func (UnimplementedLOMSV1Server) CompleteReturn(context.Context, *CompleteReturnRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteReturn not implemented")
+}
+func (UnimplementedLOMSV1Server) AssembleOrder(context.Context, *AssembleOrderRequest) (*AssembleOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssembleOrder not implemented")
+}
+func (UnimplementedLOMSV1Server) ShipShipment(context.Context, *ShipShipmentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShipShipment not implemented")
+}
+func (UnimplementedLOMSV1Server) DeliverShipment(context.Context, *DeliverShipmentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeliverShipment not implemented")
+}
 func (UnimplementedLOMSV1Server) CancelOrder(context.Context, *CancelOrderRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
 }
 func (UnimplementedLOMSV1Server) Stocks(context.Context, *StocksRequest) (*StocksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Stocks not implemented")
 }
+func (UnimplementedLOMSV1Server) BatchStocks(context.Context, *BatchStocksRequest) (*BatchStocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchStocks not implemented")
+}
+func (UnimplementedLOMSV1Server) WatchStocks(*WatchStocksRequest, LOMSV1_WatchStocksServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchStocks not implemented")
+}
+func (UnimplementedLOMSV1Server) HoldStock(context.Context, *HoldStockRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HoldStock not implemented")
+}
+func (UnimplementedLOMSV1Server) ReleaseHold(context.Context, *ReleaseHoldRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseHold not implemented")
+}
+func (UnimplementedLOMSV1Server) GetOrderHistory(context.Context, *GetOrderHistoryRequest) (*GetOrderHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderHistory not implemented")
+}
+func (UnimplementedLOMSV1Server) ListUserOrders(context.Context, *ListUserOrdersRequest) (*ListUserOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserOrders not implemented")
+}
+func (UnimplementedLOMSV1Server) UpdateOrderItems(context.Context, *UpdateOrderItemsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrderItems not implemented")
+}
+func (UnimplementedLOMSV1Server) CreateSeller(context.Context, *CreateSellerRequest) (*CreateSellerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSeller not implemented")
+}
+func (UnimplementedLOMSV1Server) ListSellerOrders(context.Context, *ListSellerOrdersRequest) (*ListSellerOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSellerOrders not implemented")
+}
+func (UnimplementedLOMSV1Server) CreateWarehouse(context.Context, *CreateWarehouseRequest) (*CreateWarehouseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWarehouse not implemented")
+}
+func (UnimplementedLOMSV1Server) ReceiveStock(context.Context, *ReceiveStockRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReceiveStock not implemented")
+}
+func (UnimplementedLOMSV1Server) AdjustStock(context.Context, *AdjustStockRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdjustStock not implemented")
+}
+func (UnimplementedLOMSV1Server) ListWarehouseStock(context.Context, *ListWarehouseStockRequest) (*ListWarehouseStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWarehouseStock not implemented")
+}
 func (UnimplementedLOMSV1Server) mustEmbedUnimplementedLOMSV1Server() {}
 
 // UnsafeLOMSV1Server may be embedded to opt out of forward compatibility for this service.
@@ -191,6 +518,114 @@ func _LOMSV1_OrderPayed_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LOMSV1_InitiatePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InitiatePaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LOMSV1Server).InitiatePayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/loms_v1.LOMSV1/InitiatePayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LOMSV1Server).InitiatePayment(ctx, req.(*InitiatePaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LOMSV1_RequestReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestReturnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LOMSV1Server).RequestReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/loms_v1.LOMSV1/RequestReturn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LOMSV1Server).RequestReturn(ctx, req.(*RequestReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LOMSV1_CompleteReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteReturnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LOMSV1Server).CompleteReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/loms_v1.LOMSV1/CompleteReturn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LOMSV1Server).CompleteReturn(ctx, req.(*CompleteReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LOMSV1_AssembleOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssembleOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LOMSV1Server).AssembleOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/loms_v1.LOMSV1/AssembleOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LOMSV1Server).AssembleOrder(ctx, req.(*AssembleOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LOMSV1_ShipShipment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShipShipmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LOMSV1Server).ShipShipment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/loms_v1.LOMSV1/ShipShipment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LOMSV1Server).ShipShipment(ctx, req.(*ShipShipmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LOMSV1_DeliverShipment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeliverShipmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LOMSV1Server).DeliverShipment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/loms_v1.LOMSV1/DeliverShipment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LOMSV1Server).DeliverShipment(ctx, req.(*DeliverShipmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LOMSV1_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CancelOrderRequest)
 	if err := dec(in); err != nil {
@@ -227,6 +662,243 @@ func _LOMSV1_Stocks_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LOMSV1_BatchStocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchStocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LOMSV1Server).BatchStocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/loms_v1.LOMSV1/BatchStocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LOMSV1Server).BatchStocks(ctx, req.(*BatchStocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LOMSV1_WatchStocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchStocksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LOMSV1Server).WatchStocks(m, &lOMSV1WatchStocksServer{stream})
+}
+
+type LOMSV1_WatchStocksServer interface {
+	Send(*StockChange) error
+	grpc.ServerStream
+}
+
+type lOMSV1WatchStocksServer struct {
+	grpc.ServerStream
+}
+
+func (x *lOMSV1WatchStocksServer) Send(m *StockChange) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _LOMSV1_HoldStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HoldStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LOMSV1Server).HoldStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/loms_v1.LOMSV1/HoldStock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LOMSV1Server).HoldStock(ctx, req.(*HoldStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LOMSV1_ReleaseHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LOMSV1Server).ReleaseHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/loms_v1.LOMSV1/ReleaseHold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LOMSV1Server).ReleaseHold(ctx, req.(*ReleaseHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LOMSV1_GetOrderHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LOMSV1Server).GetOrderHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/loms_v1.LOMSV1/GetOrderHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LOMSV1Server).GetOrderHistory(ctx, req.(*GetOrderHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LOMSV1_ListUserOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LOMSV1Server).ListUserOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/loms_v1.LOMSV1/ListUserOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LOMSV1Server).ListUserOrders(ctx, req.(*ListUserOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LOMSV1_UpdateOrderItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOrderItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LOMSV1Server).UpdateOrderItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/loms_v1.LOMSV1/UpdateOrderItems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LOMSV1Server).UpdateOrderItems(ctx, req.(*UpdateOrderItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LOMSV1_CreateSeller_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSellerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LOMSV1Server).CreateSeller(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/loms_v1.LOMSV1/CreateSeller",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LOMSV1Server).CreateSeller(ctx, req.(*CreateSellerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LOMSV1_ListSellerOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSellerOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LOMSV1Server).ListSellerOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/loms_v1.LOMSV1/ListSellerOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LOMSV1Server).ListSellerOrders(ctx, req.(*ListSellerOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LOMSV1_CreateWarehouse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWarehouseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LOMSV1Server).CreateWarehouse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/loms_v1.LOMSV1/CreateWarehouse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LOMSV1Server).CreateWarehouse(ctx, req.(*CreateWarehouseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LOMSV1_ReceiveStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReceiveStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LOMSV1Server).ReceiveStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/loms_v1.LOMSV1/ReceiveStock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LOMSV1Server).ReceiveStock(ctx, req.(*ReceiveStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LOMSV1_AdjustStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdjustStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LOMSV1Server).AdjustStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/loms_v1.LOMSV1/AdjustStock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LOMSV1Server).AdjustStock(ctx, req.(*AdjustStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LOMSV1_ListWarehouseStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWarehouseStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LOMSV1Server).ListWarehouseStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/loms_v1.LOMSV1/ListWarehouseStock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LOMSV1Server).ListWarehouseStock(ctx, req.(*ListWarehouseStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LOMSV1_ServiceDesc is the grpc.ServiceDesc for LOMSV1 service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -246,6 +918,30 @@ var LOMSV1_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "OrderPayed",
 			Handler:    _LOMSV1_OrderPayed_Handler,
 		},
+		{
+			MethodName: "InitiatePayment",
+			Handler:    _LOMSV1_InitiatePayment_Handler,
+		},
+		{
+			MethodName: "RequestReturn",
+			Handler:    _LOMSV1_RequestReturn_Handler,
+		},
+		{
+			MethodName: "CompleteReturn",
+			Handler:    _LOMSV1_CompleteReturn_Handler,
+		},
+		{
+			MethodName: "AssembleOrder",
+			Handler:    _LOMSV1_AssembleOrder_Handler,
+		},
+		{
+			MethodName: "ShipShipment",
+			Handler:    _LOMSV1_ShipShipment_Handler,
+		},
+		{
+			MethodName: "DeliverShipment",
+			Handler:    _LOMSV1_DeliverShipment_Handler,
+		},
 		{
 			MethodName: "CancelOrder",
 			Handler:    _LOMSV1_CancelOrder_Handler,
@@ -254,7 +950,61 @@ var LOMSV1_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Stocks",
 			Handler:    _LOMSV1_Stocks_Handler,
 		},
+		{
+			MethodName: "BatchStocks",
+			Handler:    _LOMSV1_BatchStocks_Handler,
+		},
+		{
+			MethodName: "HoldStock",
+			Handler:    _LOMSV1_HoldStock_Handler,
+		},
+		{
+			MethodName: "ReleaseHold",
+			Handler:    _LOMSV1_ReleaseHold_Handler,
+		},
+		{
+			MethodName: "GetOrderHistory",
+			Handler:    _LOMSV1_GetOrderHistory_Handler,
+		},
+		{
+			MethodName: "ListUserOrders",
+			Handler:    _LOMSV1_ListUserOrders_Handler,
+		},
+		{
+			MethodName: "UpdateOrderItems",
+			Handler:    _LOMSV1_UpdateOrderItems_Handler,
+		},
+		{
+			MethodName: "CreateSeller",
+			Handler:    _LOMSV1_CreateSeller_Handler,
+		},
+		{
+			MethodName: "ListSellerOrders",
+			Handler:    _LOMSV1_ListSellerOrders_Handler,
+		},
+		{
+			MethodName: "CreateWarehouse",
+			Handler:    _LOMSV1_CreateWarehouse_Handler,
+		},
+		{
+			MethodName: "ReceiveStock",
+			Handler:    _LOMSV1_ReceiveStock_Handler,
+		},
+		{
+			MethodName: "AdjustStock",
+			Handler:    _LOMSV1_AdjustStock_Handler,
+		},
+		{
+			MethodName: "ListWarehouseStock",
+			Handler:    _LOMSV1_ListWarehouseStock_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchStocks",
+			Handler:       _LOMSV1_WatchStocks_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "service.proto",
 }